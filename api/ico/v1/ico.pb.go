@@ -33,6 +33,13 @@ type ICOInfo struct {
 	Price     string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
 	NumToken  string `protobuf:"bytes,4,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
 	PriceGap  string `protobuf:"bytes,5,opt,name=price_gap,json=priceGap,proto3" json:"price_gap,omitempty"`
+	// Bounds on the value raised, in the unit of the price, empty for none.
+	SoftCap string `protobuf:"bytes,6,opt,name=soft_cap,json=softCap,proto3" json:"soft_cap,omitempty"`
+	HardCap string `protobuf:"bytes,7,opt,name=hard_cap,json=hardCap,proto3" json:"hard_cap,omitempty"`
+	Raised  string `protobuf:"bytes,8,opt,name=raised,proto3" json:"raised,omitempty"`
+	// open until the round ends, then ended. capped once it reached its hard
+	// cap, failed when it ended below its soft cap and its purchases are refunded.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *ICOInfo) Reset() {
@@ -102,6 +109,34 @@ func (x *ICOInfo) GetPriceGap() string {
 	return ""
 }
 
+func (x *ICOInfo) GetSoftCap() string {
+	if x != nil {
+		return x.SoftCap
+	}
+	return ""
+}
+
+func (x *ICOInfo) GetHardCap() string {
+	if x != nil {
+		return x.HardCap
+	}
+	return ""
+}
+
+func (x *ICOInfo) GetRaised() string {
+	if x != nil {
+		return x.Raised
+	}
+	return ""
+}
+
+func (x *ICOInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type GetICOInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,13 +213,19 @@ type ICORound struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId     int32                  `protobuf:"varint,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	RoundName   string                 `protobuf:"bytes,2,opt,name=round_name,json=roundName,proto3" json:"round_name,omitempty"`
-	SubRound    int32                  `protobuf:"varint,3,opt,name=sub_round,json=subRound,proto3" json:"sub_round,omitempty"`
+	RoundId   int32  `protobuf:"varint,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	RoundName string `protobuf:"bytes,2,opt,name=round_name,json=roundName,proto3" json:"round_name,omitempty"`
+	SubRound  int32  `protobuf:"varint,3,opt,name=sub_round,json=subRound,proto3" json:"sub_round,omitempty"`
+	// The price of the next token, on a bonding curve or a Dutch auction it
+	// moves within the sub-round.
 	Price       string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	BoughtToken string                 `protobuf:"bytes,5,opt,name=bought_token,json=boughtToken,proto3" json:"bought_token,omitempty"`
 	TotalToken  string                 `protobuf:"bytes,6,opt,name=total_token,json=totalToken,proto3" json:"total_token,omitempty"`
 	EndAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// While paused purchases are refused and the countdown stands at
+	// end_at - paused_at, end_at moves by the paused time on resume.
+	Paused   bool                   `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
 }
 
 func (x *ICORound) Reset() {
@@ -268,6 +309,20 @@ func (x *ICORound) GetEndAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ICORound) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ICORound) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
 type GetCurrentRoundResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -348,6 +403,15 @@ type AddICOCouponRequest struct {
 	Coupon   string `protobuf:"bytes,2,opt,name=coupon,proto3" json:"coupon,omitempty"`
 	Reward   string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
 	Cashback string `protobuf:"bytes,4,opt,name=cashback,proto3" json:"cashback,omitempty"`
+	// The coupon applies in [starts_at, ends_at), an unset bound is open.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Caps the redemptions of all users, 0 is no cap.
+	MaxUses int32 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// A user redeems the coupon once.
+	SingleUse bool `protobuf:"varint,8,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	// The least a purchase buys to apply the coupon, in tokens.
+	MinPurchase string `protobuf:"bytes,9,opt,name=min_purchase,json=minPurchase,proto3" json:"min_purchase,omitempty"`
 }
 
 func (x *AddICOCouponRequest) Reset() {
@@ -410,16 +474,58 @@ func (x *AddICOCouponRequest) GetCashback() string {
 	return ""
 }
 
-type GetCouponRequest struct {
+func (x *AddICOCouponRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AddICOCouponRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AddICOCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *AddICOCouponRequest) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *AddICOCouponRequest) GetMinPurchase() string {
+	if x != nil {
+		return x.MinPurchase
+	}
+	return ""
+}
+
+type UpdateICOCouponRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Coupon string `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Coupon      string                 `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Reward      string                 `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	Cashback    string                 `protobuf:"bytes,3,opt,name=cashback,proto3" json:"cashback,omitempty"`
+	StartsAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses     int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	SingleUse   bool                   `protobuf:"varint,7,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	MinPurchase string                 `protobuf:"bytes,8,opt,name=min_purchase,json=minPurchase,proto3" json:"min_purchase,omitempty"`
 }
 
-func (x *GetCouponRequest) Reset() {
-	*x = GetCouponRequest{}
+func (x *UpdateICOCouponRequest) Reset() {
+	*x = UpdateICOCouponRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ico_v1_ico_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,13 +533,13 @@ func (x *GetCouponRequest) Reset() {
 	}
 }
 
-func (x *GetCouponRequest) String() string {
+func (x *UpdateICOCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponRequest) ProtoMessage() {}
+func (*UpdateICOCouponRequest) ProtoMessage() {}
 
-func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateICOCouponRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ico_v1_ico_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,31 +551,96 @@ func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
-func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateICOCouponRequest.ProtoReflect.Descriptor instead.
+func (*UpdateICOCouponRequest) Descriptor() ([]byte, []int) {
 	return file_ico_v1_ico_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCouponRequest) GetCoupon() string {
+func (x *UpdateICOCouponRequest) GetCoupon() string {
 	if x != nil {
 		return x.Coupon
 	}
 	return ""
 }
 
-type GetCouponResponse struct {
+func (x *UpdateICOCouponRequest) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+func (x *UpdateICOCouponRequest) GetCashback() string {
+	if x != nil {
+		return x.Cashback
+	}
+	return ""
+}
+
+func (x *UpdateICOCouponRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *UpdateICOCouponRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *UpdateICOCouponRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *UpdateICOCouponRequest) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *UpdateICOCouponRequest) GetMinPurchase() string {
+	if x != nil {
+		return x.MinPurchase
+	}
+	return ""
+}
+
+type GenerateICOCouponsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string  `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Data   *Coupon `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-}
-
-func (x *GetCouponResponse) Reset() {
-	*x = GetCouponResponse{}
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Uppercased, the codes start with it.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The random characters after the prefix, 8 by default.
+	Length int32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// Distinct letters and digits, uppercased. Defaults to the letters and
+	// digits but 0, 1, I and O.
+	Alphabet string `protobuf:"bytes,4,opt,name=alphabet,proto3" json:"alphabet,omitempty"`
+	// At most 10000, and at most a tenth of the codes the alphabet and length
+	// can make.
+	Count       int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Reward      string                 `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	Cashback    string                 `protobuf:"bytes,7,opt,name=cashback,proto3" json:"cashback,omitempty"`
+	StartsAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses     int32                  `protobuf:"varint,10,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	SingleUse   bool                   `protobuf:"varint,11,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	MinPurchase string                 `protobuf:"bytes,12,opt,name=min_purchase,json=minPurchase,proto3" json:"min_purchase,omitempty"`
+	// "csv" also returns the coupons as a CSV file, empty only the codes.
+	Format string `protobuf:"bytes,13,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *GenerateICOCouponsRequest) Reset() {
+	*x = GenerateICOCouponsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ico_v1_ico_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,13 +648,13 @@ func (x *GetCouponResponse) Reset() {
 	}
 }
 
-func (x *GetCouponResponse) String() string {
+func (x *GenerateICOCouponsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCouponResponse) ProtoMessage() {}
+func (*GenerateICOCouponsRequest) ProtoMessage() {}
 
-func (x *GetCouponResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateICOCouponsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ico_v1_ico_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,52 +666,115 @@ func (x *GetCouponResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCouponResponse.ProtoReflect.Descriptor instead.
-func (*GetCouponResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateICOCouponsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateICOCouponsRequest) Descriptor() ([]byte, []int) {
 	return file_ico_v1_ico_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetCouponResponse) GetCode() int64 {
+func (x *GenerateICOCouponsRequest) GetUserId() string {
 	if x != nil {
-		return x.Code
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
 	}
 	return 0
 }
 
-func (x *GetCouponResponse) GetMsg() string {
+func (x *GenerateICOCouponsRequest) GetAlphabet() string {
 	if x != nil {
-		return x.Msg
+		return x.Alphabet
 	}
 	return ""
 }
 
-func (x *GetCouponResponse) GetMsgKey() string {
+func (x *GenerateICOCouponsRequest) GetCount() int32 {
 	if x != nil {
-		return x.MsgKey
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GenerateICOCouponsRequest) GetReward() string {
+	if x != nil {
+		return x.Reward
 	}
 	return ""
 }
 
-func (x *GetCouponResponse) GetData() *Coupon {
+func (x *GenerateICOCouponsRequest) GetCashback() string {
 	if x != nil {
-		return x.Data
+		return x.Cashback
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
 	}
 	return nil
 }
 
-type Coupon struct {
+func (x *GenerateICOCouponsRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *GenerateICOCouponsRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *GenerateICOCouponsRequest) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *GenerateICOCouponsRequest) GetMinPurchase() string {
+	if x != nil {
+		return x.MinPurchase
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GenerateICOCouponsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Coupon   string `protobuf:"bytes,2,opt,name=coupon,proto3" json:"coupon,omitempty"`
-	Reward   string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
-	CashBack string `protobuf:"bytes,4,opt,name=cash_back,json=cashBack,proto3" json:"cash_back,omitempty"`
+	Code   int64                            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                           `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *GenerateICOCouponsResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Coupon) Reset() {
-	*x = Coupon{}
+func (x *GenerateICOCouponsResponse) Reset() {
+	*x = GenerateICOCouponsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ico_v1_ico_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +782,13 @@ func (x *Coupon) Reset() {
 	}
 }
 
-func (x *Coupon) String() string {
+func (x *GenerateICOCouponsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Coupon) ProtoMessage() {}
+func (*GenerateICOCouponsResponse) ProtoMessage() {}
 
-func (x *Coupon) ProtoReflect() protoreflect.Message {
+func (x *GenerateICOCouponsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ico_v1_ico_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,50 +800,50 @@ func (x *Coupon) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
-func (*Coupon) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateICOCouponsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateICOCouponsResponse) Descriptor() ([]byte, []int) {
 	return file_ico_v1_ico_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Coupon) GetUserId() string {
+func (x *GenerateICOCouponsResponse) GetCode() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *Coupon) GetCoupon() string {
+func (x *GenerateICOCouponsResponse) GetMsg() string {
 	if x != nil {
-		return x.Coupon
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *Coupon) GetReward() string {
+func (x *GenerateICOCouponsResponse) GetMsgKey() string {
 	if x != nil {
-		return x.Reward
+		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *Coupon) GetCashBack() string {
+func (x *GenerateICOCouponsResponse) GetData() *GenerateICOCouponsResponse_Data {
 	if x != nil {
-		return x.CashBack
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetBuyICOUserHistoryRequest struct {
+type ListICOCouponsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Next  string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
-	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WithDeleted bool   `protobuf:"varint,2,opt,name=with_deleted,json=withDeleted,proto3" json:"with_deleted,omitempty"`
 }
 
-func (x *GetBuyICOUserHistoryRequest) Reset() {
-	*x = GetBuyICOUserHistoryRequest{}
+func (x *ListICOCouponsRequest) Reset() {
+	*x = ListICOCouponsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ico_v1_ico_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,13 +851,13 @@ func (x *GetBuyICOUserHistoryRequest) Reset() {
 	}
 }
 
-func (x *GetBuyICOUserHistoryRequest) String() string {
+func (x *ListICOCouponsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBuyICOUserHistoryRequest) ProtoMessage() {}
+func (*ListICOCouponsRequest) ProtoMessage() {}
 
-func (x *GetBuyICOUserHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ListICOCouponsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ico_v1_ico_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,40 +869,38 @@ func (x *GetBuyICOUserHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBuyICOUserHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetBuyICOUserHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListICOCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListICOCouponsRequest) Descriptor() ([]byte, []int) {
 	return file_ico_v1_ico_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetBuyICOUserHistoryRequest) GetNext() string {
+func (x *ListICOCouponsRequest) GetUserId() string {
 	if x != nil {
-		return x.Next
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetBuyICOUserHistoryRequest) GetLimit() int32 {
+func (x *ListICOCouponsRequest) GetWithDeleted() bool {
 	if x != nil {
-		return x.Limit
+		return x.WithDeleted
 	}
-	return 0
+	return false
 }
 
-type GetBuyICOUserHistoryResponse struct {
+type ListICOCouponsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64                                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string                               `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string                               `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Total  int32                                `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Data   []*GetBuyICOUserHistoryResponse_Data `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
-	Next   string                               `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+	Code   int64     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string    `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   []*Coupon `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetBuyICOUserHistoryResponse) Reset() {
-	*x = GetBuyICOUserHistoryResponse{}
+func (x *ListICOCouponsResponse) Reset() {
+	*x = ListICOCouponsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ico_v1_ico_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,13 +908,13 @@ func (x *GetBuyICOUserHistoryResponse) Reset() {
 	}
 }
 
-func (x *GetBuyICOUserHistoryResponse) String() string {
+func (x *ListICOCouponsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBuyICOUserHistoryResponse) ProtoMessage() {}
+func (*ListICOCouponsResponse) ProtoMessage() {}
 
-func (x *GetBuyICOUserHistoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ListICOCouponsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ico_v1_ico_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,70 +926,816 @@ func (x *GetBuyICOUserHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBuyICOUserHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetBuyICOUserHistoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListICOCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListICOCouponsResponse) Descriptor() ([]byte, []int) {
 	return file_ico_v1_ico_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetBuyICOUserHistoryResponse) GetCode() int64 {
+func (x *ListICOCouponsResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetBuyICOUserHistoryResponse) GetMsg() string {
+func (x *ListICOCouponsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetBuyICOUserHistoryResponse) GetMsgKey() string {
+func (x *ListICOCouponsResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *GetBuyICOUserHistoryResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetBuyICOUserHistoryResponse) GetData() []*GetBuyICOUserHistoryResponse_Data {
+func (x *ListICOCouponsResponse) GetData() []*Coupon {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *GetBuyICOUserHistoryResponse) GetNext() string {
-	if x != nil {
-		return x.Next
-	}
-	return ""
-}
-
-type GetBuyICOUserHistoryResponse_Data struct {
+type GetCouponRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	NumToken string `protobuf:"bytes,3,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
-	Order    int32  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
-	Time     string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
-	Stack    string `protobuf:"bytes,6,opt,name=stack,proto3" json:"stack,omitempty"`
+	Coupon string `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+}
+
+func (x *GetCouponRequest) Reset() {
+	*x = GetCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponRequest) ProtoMessage() {}
+
+func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCouponRequest) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
+}
+
+type GetCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string  `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *Coupon `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetCouponResponse) Reset() {
+	*x = GetCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponResponse) ProtoMessage() {}
+
+func (x *GetCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponResponse.ProtoReflect.Descriptor instead.
+func (*GetCouponResponse) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCouponResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCouponResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetCouponResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *GetCouponResponse) GetData() *Coupon {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Coupon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Coupon   string                 `protobuf:"bytes,2,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Reward   string                 `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	CashBack string                 `protobuf:"bytes,4,opt,name=cash_back,json=cashBack,proto3" json:"cash_back,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses  int32                  `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// The redemptions so far.
+	Used        int32                  `protobuf:"varint,8,opt,name=used,proto3" json:"used,omitempty"`
+	SingleUse   bool                   `protobuf:"varint,9,opt,name=single_use,json=singleUse,proto3" json:"single_use,omitempty"`
+	MinPurchase string                 `protobuf:"bytes,10,opt,name=min_purchase,json=minPurchase,proto3" json:"min_purchase,omitempty"`
+	DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Coupon) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Coupon) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
+}
+
+func (x *Coupon) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+func (x *Coupon) GetCashBack() string {
+	if x != nil {
+		return x.CashBack
+	}
+	return ""
+}
+
+func (x *Coupon) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Coupon) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Coupon) GetSingleUse() bool {
+	if x != nil {
+		return x.SingleUse
+	}
+	return false
+}
+
+func (x *Coupon) GetMinPurchase() string {
+	if x != nil {
+		return x.MinPurchase
+	}
+	return ""
+}
+
+func (x *Coupon) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type GetBuyICOUserHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Next  string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetBuyICOUserHistoryRequest) Reset() {
+	*x = GetBuyICOUserHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuyICOUserHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyICOUserHistoryRequest) ProtoMessage() {}
+
+func (x *GetBuyICOUserHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyICOUserHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBuyICOUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBuyICOUserHistoryRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *GetBuyICOUserHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetBuyICOUserHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64                                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                               `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                               `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Total  int32                                `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Data   []*GetBuyICOUserHistoryResponse_Data `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Next   string                               `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+	// The rank of the caller, on the page or not. Unset when they bought nothing.
+	Me *GetBuyICOUserHistoryResponse_Data `protobuf:"bytes,7,opt,name=me,proto3" json:"me,omitempty"`
+}
+
+func (x *GetBuyICOUserHistoryResponse) Reset() {
+	*x = GetBuyICOUserHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuyICOUserHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyICOUserHistoryResponse) ProtoMessage() {}
+
+func (x *GetBuyICOUserHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyICOUserHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBuyICOUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetData() []*GetBuyICOUserHistoryResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *GetBuyICOUserHistoryResponse) GetMe() *GetBuyICOUserHistoryResponse_Data {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+type PreviewBuyICORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The symbol paid with, e.g. USDT.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Coupon string `protobuf:"bytes,3,opt,name=coupon,proto3" json:"coupon,omitempty"`
+}
+
+func (x *PreviewBuyICORequest) Reset() {
+	*x = PreviewBuyICORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewBuyICORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewBuyICORequest) ProtoMessage() {}
+
+func (x *PreviewBuyICORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewBuyICORequest.ProtoReflect.Descriptor instead.
+func (*PreviewBuyICORequest) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreviewBuyICORequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PreviewBuyICORequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PreviewBuyICORequest) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
+}
+
+// The part of a purchase a sub-round sells.
+type PreviewLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId  int32 `protobuf:"varint,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	SubRound int32 `protobuf:"varint,2,opt,name=sub_round,json=subRound,proto3" json:"sub_round,omitempty"`
+	// Average price of the tokens.
+	Price    string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	NumToken string `protobuf:"bytes,4,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
+	// What a token costs in the symbol.
+	Rate string `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *PreviewLine) Reset() {
+	*x = PreviewLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewLine) ProtoMessage() {}
+
+func (x *PreviewLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewLine.ProtoReflect.Descriptor instead.
+func (*PreviewLine) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PreviewLine) GetRoundId() int32 {
+	if x != nil {
+		return x.RoundId
+	}
+	return 0
+}
+
+func (x *PreviewLine) GetSubRound() int32 {
+	if x != nil {
+		return x.SubRound
+	}
+	return 0
+}
+
+func (x *PreviewLine) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PreviewLine) GetNumToken() string {
+	if x != nil {
+		return x.NumToken
+	}
+	return ""
+}
+
+func (x *PreviewLine) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type ICOPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines      []*PreviewLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	TotalToken string         `protobuf:"bytes,2,opt,name=total_token,json=totalToken,proto3" json:"total_token,omitempty"`
+	// Paid back by the coupon, in the symbol.
+	Cashback string `protobuf:"bytes,3,opt,name=cashback,proto3" json:"cashback,omitempty"`
+	// The part of the amount that buys no token.
+	Rounding string `protobuf:"bytes,4,opt,name=rounding,proto3" json:"rounding,omitempty"`
+}
+
+func (x *ICOPreview) Reset() {
+	*x = ICOPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ICOPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICOPreview) ProtoMessage() {}
+
+func (x *ICOPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICOPreview.ProtoReflect.Descriptor instead.
+func (*ICOPreview) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ICOPreview) GetLines() []*PreviewLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ICOPreview) GetTotalToken() string {
+	if x != nil {
+		return x.TotalToken
+	}
+	return ""
+}
+
+func (x *ICOPreview) GetCashback() string {
+	if x != nil {
+		return x.Cashback
+	}
+	return ""
+}
+
+func (x *ICOPreview) GetRounding() string {
+	if x != nil {
+		return x.Rounding
+	}
+	return ""
+}
+
+type PreviewBuyICOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string      `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *ICOPreview `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PreviewBuyICOResponse) Reset() {
+	*x = PreviewBuyICOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewBuyICOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewBuyICOResponse) ProtoMessage() {}
+
+func (x *PreviewBuyICOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewBuyICOResponse.ProtoReflect.Descriptor instead.
+func (*PreviewBuyICOResponse) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PreviewBuyICOResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PreviewBuyICOResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *PreviewBuyICOResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *PreviewBuyICOResponse) GetData() *ICOPreview {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GenerateICOCouponsResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// On COUPON_CODES_EXHAUSTED, the coupons generated before.
+	Coupons     []string `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	FileName    string   `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string   `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content     []byte   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GenerateICOCouponsResponse_Data) Reset() {
+	*x = GenerateICOCouponsResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ico_v1_ico_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateICOCouponsResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateICOCouponsResponse_Data) ProtoMessage() {}
+
+func (x *GenerateICOCouponsResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_ico_v1_ico_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateICOCouponsResponse_Data.ProtoReflect.Descriptor instead.
+func (*GenerateICOCouponsResponse_Data) Descriptor() ([]byte, []int) {
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GenerateICOCouponsResponse_Data) GetCoupons() []string {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *GenerateICOCouponsResponse_Data) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsResponse_Data) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GenerateICOCouponsResponse_Data) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetBuyICOUserHistoryResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	NumToken string `protobuf:"bytes,3,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
+	Order    int32  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	Time     string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// Always 0, the tokens are not staked.
+	Stack string `protobuf:"bytes,6,opt,name=stack,proto3" json:"stack,omitempty"`
 }
 
 func (x *GetBuyICOUserHistoryResponse_Data) Reset() {
 	*x = GetBuyICOUserHistoryResponse_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ico_v1_ico_proto_msgTypes[10]
+		mi := &file_ico_v1_ico_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -770,7 +1748,7 @@ func (x *GetBuyICOUserHistoryResponse_Data) String() string {
 func (*GetBuyICOUserHistoryResponse_Data) ProtoMessage() {}
 
 func (x *GetBuyICOUserHistoryResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_ico_v1_ico_proto_msgTypes[10]
+	mi := &file_ico_v1_ico_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +1761,7 @@ func (x *GetBuyICOUserHistoryResponse_Data) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetBuyICOUserHistoryResponse_Data.ProtoReflect.Descriptor instead.
 func (*GetBuyICOUserHistoryResponse_Data) Descriptor() ([]byte, []int) {
-	return file_ico_v1_ico_proto_rawDescGZIP(), []int{9, 0}
+	return file_ico_v1_ico_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *GetBuyICOUserHistoryResponse_Data) GetFullName() string {
@@ -838,7 +1816,7 @@ var file_ico_v1_ico_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a, 0x07, 0x49, 0x43, 0x4f, 0x49, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x01, 0x0a, 0x07, 0x49, 0x43, 0x4f, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a,
 	0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -847,128 +1825,327 @@ var file_ico_v1_ico_proto_rawDesc = []byte{
 	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
 	0x1b, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x47, 0x61, 0x70, 0x22, 0x78, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65,
-	0x79, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xee, 0x01, 0x0a, 0x08, 0x49, 0x43, 0x4f, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x73, 0x75, 0x62, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x47, 0x61, 0x70, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x6f, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x6f, 0x66, 0x74, 0x43, 0x61, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x5f,
+	0x63, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x72, 0x64, 0x43,
+	0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x69, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x69, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x78, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbf, 0x02, 0x0a,
+	0x08, 0x49, 0x43, 0x4f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x75, 0x62, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x67, 0x68, 0x74,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f,
+	0x75, 0x67, 0x68, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x6e,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7e,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x43, 0x4f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc5,
+	0x02, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75,
+	0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x5f, 0x75, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x55, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x22, 0xad, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73,
+	0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73,
+	0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x53, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x43, 0x4f, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x63,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22, 0x76, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f,
 	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65,
-	0x79, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x43, 0x4f, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7a, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x49, 0x43,
-	0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62,
-	0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62,
-	0x61, 0x63, 0x6b, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22,
-	0x76, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73,
-	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67,
-	0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f,
-	0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f,
-	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
-	0x75, 0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61,
-	0x73, 0x68, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x61, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x75,
-	0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xdf, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73,
-	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x79, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x88, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x73,
+	0x68, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12,
+	0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e,
+	0x64, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f,
+	0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x12, 0x39, 0x0a, 0x02, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43,
+	0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x02, 0x6d, 0x65, 0x1a, 0x96, 0x01,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x22, 0x5e, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x75, 0x62, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0a, 0x49, 0x43, 0x4f, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x7e, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b,
 	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x63, 0x6b, 0x32, 0xa5, 0x04, 0x0a, 0x0a, 0x49, 0x43, 0x4f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x43, 0x4f, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xfa, 0x09, 0x0a, 0x0a, 0x49, 0x43, 0x4f,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x43,
+	0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e,
+	0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x80, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49,
+	0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e,
+	0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f,
+	0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x65, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
+	0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x7d, 0x12, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x12, 0x1c, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x67, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x49,
+	0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x43, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x80,
-	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69,
-	0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x55,
-	0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x69,
-	0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x65, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
-	0x7d, 0x12, 0x67, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f,
-	0x6e, 0x12, 0x1b, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x43,
-	0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
-	0x2a, 0x22, 0x17, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x63, 0x6f,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79,
-	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a,
+	0x01, 0x2a, 0x22, 0x17, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x63,
+	0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x79, 0x0a, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x1e,
+	0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x43,
+	0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x1a, 0x20, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2f, 0x7b, 0x63,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x7d, 0x12, 0x70, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x69, 0x63, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2f,
+	0x7b, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x7d, 0x12, 0x7c, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x69,
+	0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x7d, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e,
+	0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49,
+	0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22,
+	0x21, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x63, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x43, 0x4f, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x73, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69,
+	0x63, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -983,43 +2160,80 @@ func file_ico_v1_ico_proto_rawDescGZIP() []byte {
 	return file_ico_v1_ico_proto_rawDescData
 }
 
-var file_ico_v1_ico_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ico_v1_ico_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_ico_v1_ico_proto_goTypes = []interface{}{
 	(*ICOInfo)(nil),                           // 0: ico.v1.ICOInfo
 	(*GetICOInfoResponse)(nil),                // 1: ico.v1.GetICOInfoResponse
 	(*ICORound)(nil),                          // 2: ico.v1.ICORound
 	(*GetCurrentRoundResponse)(nil),           // 3: ico.v1.GetCurrentRoundResponse
 	(*AddICOCouponRequest)(nil),               // 4: ico.v1.AddICOCouponRequest
-	(*GetCouponRequest)(nil),                  // 5: ico.v1.GetCouponRequest
-	(*GetCouponResponse)(nil),                 // 6: ico.v1.GetCouponResponse
-	(*Coupon)(nil),                            // 7: ico.v1.Coupon
-	(*GetBuyICOUserHistoryRequest)(nil),       // 8: ico.v1.GetBuyICOUserHistoryRequest
-	(*GetBuyICOUserHistoryResponse)(nil),      // 9: ico.v1.GetBuyICOUserHistoryResponse
-	(*GetBuyICOUserHistoryResponse_Data)(nil), // 10: ico.v1.GetBuyICOUserHistoryResponse.Data
-	(*timestamppb.Timestamp)(nil),             // 11: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 12: google.protobuf.Empty
+	(*UpdateICOCouponRequest)(nil),            // 5: ico.v1.UpdateICOCouponRequest
+	(*GenerateICOCouponsRequest)(nil),         // 6: ico.v1.GenerateICOCouponsRequest
+	(*GenerateICOCouponsResponse)(nil),        // 7: ico.v1.GenerateICOCouponsResponse
+	(*ListICOCouponsRequest)(nil),             // 8: ico.v1.ListICOCouponsRequest
+	(*ListICOCouponsResponse)(nil),            // 9: ico.v1.ListICOCouponsResponse
+	(*GetCouponRequest)(nil),                  // 10: ico.v1.GetCouponRequest
+	(*GetCouponResponse)(nil),                 // 11: ico.v1.GetCouponResponse
+	(*Coupon)(nil),                            // 12: ico.v1.Coupon
+	(*GetBuyICOUserHistoryRequest)(nil),       // 13: ico.v1.GetBuyICOUserHistoryRequest
+	(*GetBuyICOUserHistoryResponse)(nil),      // 14: ico.v1.GetBuyICOUserHistoryResponse
+	(*PreviewBuyICORequest)(nil),              // 15: ico.v1.PreviewBuyICORequest
+	(*PreviewLine)(nil),                       // 16: ico.v1.PreviewLine
+	(*ICOPreview)(nil),                        // 17: ico.v1.ICOPreview
+	(*PreviewBuyICOResponse)(nil),             // 18: ico.v1.PreviewBuyICOResponse
+	(*GenerateICOCouponsResponse_Data)(nil),   // 19: ico.v1.GenerateICOCouponsResponse.Data
+	(*GetBuyICOUserHistoryResponse_Data)(nil), // 20: ico.v1.GetBuyICOUserHistoryResponse.Data
+	(*timestamppb.Timestamp)(nil),             // 21: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 22: google.protobuf.Empty
 }
 var file_ico_v1_ico_proto_depIdxs = []int32{
 	0,  // 0: ico.v1.GetICOInfoResponse.data:type_name -> ico.v1.ICOInfo
-	11, // 1: ico.v1.ICORound.end_at:type_name -> google.protobuf.Timestamp
-	2,  // 2: ico.v1.GetCurrentRoundResponse.data:type_name -> ico.v1.ICORound
-	7,  // 3: ico.v1.GetCouponResponse.data:type_name -> ico.v1.Coupon
-	10, // 4: ico.v1.GetBuyICOUserHistoryResponse.data:type_name -> ico.v1.GetBuyICOUserHistoryResponse.Data
-	12, // 5: ico.v1.ICOService.GetICOInfo:input_type -> google.protobuf.Empty
-	8,  // 6: ico.v1.ICOService.GetBuyICOUserHistory:input_type -> ico.v1.GetBuyICOUserHistoryRequest
-	12, // 7: ico.v1.ICOService.GetCurrentRound:input_type -> google.protobuf.Empty
-	5,  // 8: ico.v1.ICOService.GetCoupon:input_type -> ico.v1.GetCouponRequest
-	4,  // 9: ico.v1.ICOService.AddICOCoupon:input_type -> ico.v1.AddICOCouponRequest
-	1,  // 10: ico.v1.ICOService.GetICOInfo:output_type -> ico.v1.GetICOInfoResponse
-	9,  // 11: ico.v1.ICOService.GetBuyICOUserHistory:output_type -> ico.v1.GetBuyICOUserHistoryResponse
-	3,  // 12: ico.v1.ICOService.GetCurrentRound:output_type -> ico.v1.GetCurrentRoundResponse
-	6,  // 13: ico.v1.ICOService.GetCoupon:output_type -> ico.v1.GetCouponResponse
-	12, // 14: ico.v1.ICOService.AddICOCoupon:output_type -> google.protobuf.Empty
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	21, // 1: ico.v1.ICORound.end_at:type_name -> google.protobuf.Timestamp
+	21, // 2: ico.v1.ICORound.paused_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: ico.v1.GetCurrentRoundResponse.data:type_name -> ico.v1.ICORound
+	21, // 4: ico.v1.AddICOCouponRequest.starts_at:type_name -> google.protobuf.Timestamp
+	21, // 5: ico.v1.AddICOCouponRequest.ends_at:type_name -> google.protobuf.Timestamp
+	21, // 6: ico.v1.UpdateICOCouponRequest.starts_at:type_name -> google.protobuf.Timestamp
+	21, // 7: ico.v1.UpdateICOCouponRequest.ends_at:type_name -> google.protobuf.Timestamp
+	21, // 8: ico.v1.GenerateICOCouponsRequest.starts_at:type_name -> google.protobuf.Timestamp
+	21, // 9: ico.v1.GenerateICOCouponsRequest.ends_at:type_name -> google.protobuf.Timestamp
+	19, // 10: ico.v1.GenerateICOCouponsResponse.data:type_name -> ico.v1.GenerateICOCouponsResponse.Data
+	12, // 11: ico.v1.ListICOCouponsResponse.data:type_name -> ico.v1.Coupon
+	12, // 12: ico.v1.GetCouponResponse.data:type_name -> ico.v1.Coupon
+	21, // 13: ico.v1.Coupon.starts_at:type_name -> google.protobuf.Timestamp
+	21, // 14: ico.v1.Coupon.ends_at:type_name -> google.protobuf.Timestamp
+	21, // 15: ico.v1.Coupon.deleted_at:type_name -> google.protobuf.Timestamp
+	20, // 16: ico.v1.GetBuyICOUserHistoryResponse.data:type_name -> ico.v1.GetBuyICOUserHistoryResponse.Data
+	20, // 17: ico.v1.GetBuyICOUserHistoryResponse.me:type_name -> ico.v1.GetBuyICOUserHistoryResponse.Data
+	16, // 18: ico.v1.ICOPreview.lines:type_name -> ico.v1.PreviewLine
+	17, // 19: ico.v1.PreviewBuyICOResponse.data:type_name -> ico.v1.ICOPreview
+	22, // 20: ico.v1.ICOService.GetICOInfo:input_type -> google.protobuf.Empty
+	13, // 21: ico.v1.ICOService.GetBuyICOUserHistory:input_type -> ico.v1.GetBuyICOUserHistoryRequest
+	22, // 22: ico.v1.ICOService.GetCurrentRound:input_type -> google.protobuf.Empty
+	10, // 23: ico.v1.ICOService.GetCoupon:input_type -> ico.v1.GetCouponRequest
+	15, // 24: ico.v1.ICOService.PreviewBuyICO:input_type -> ico.v1.PreviewBuyICORequest
+	4,  // 25: ico.v1.ICOService.AddICOCoupon:input_type -> ico.v1.AddICOCouponRequest
+	5,  // 26: ico.v1.ICOService.UpdateICOCoupon:input_type -> ico.v1.UpdateICOCouponRequest
+	10, // 27: ico.v1.ICOService.DeleteICOCoupon:input_type -> ico.v1.GetCouponRequest
+	10, // 28: ico.v1.ICOService.RestoreICOCoupon:input_type -> ico.v1.GetCouponRequest
+	6,  // 29: ico.v1.ICOService.GenerateICOCoupons:input_type -> ico.v1.GenerateICOCouponsRequest
+	8,  // 30: ico.v1.ICOService.ListICOCoupons:input_type -> ico.v1.ListICOCouponsRequest
+	1,  // 31: ico.v1.ICOService.GetICOInfo:output_type -> ico.v1.GetICOInfoResponse
+	14, // 32: ico.v1.ICOService.GetBuyICOUserHistory:output_type -> ico.v1.GetBuyICOUserHistoryResponse
+	3,  // 33: ico.v1.ICOService.GetCurrentRound:output_type -> ico.v1.GetCurrentRoundResponse
+	11, // 34: ico.v1.ICOService.GetCoupon:output_type -> ico.v1.GetCouponResponse
+	18, // 35: ico.v1.ICOService.PreviewBuyICO:output_type -> ico.v1.PreviewBuyICOResponse
+	22, // 36: ico.v1.ICOService.AddICOCoupon:output_type -> google.protobuf.Empty
+	11, // 37: ico.v1.ICOService.UpdateICOCoupon:output_type -> ico.v1.GetCouponResponse
+	11, // 38: ico.v1.ICOService.DeleteICOCoupon:output_type -> ico.v1.GetCouponResponse
+	11, // 39: ico.v1.ICOService.RestoreICOCoupon:output_type -> ico.v1.GetCouponResponse
+	7,  // 40: ico.v1.ICOService.GenerateICOCoupons:output_type -> ico.v1.GenerateICOCouponsResponse
+	9,  // 41: ico.v1.ICOService.ListICOCoupons:output_type -> ico.v1.ListICOCouponsResponse
+	31, // [31:42] is the sub-list for method output_type
+	20, // [20:31] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_ico_v1_ico_proto_init() }
@@ -1089,7 +2303,7 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCouponRequest); i {
+			switch v := v.(*UpdateICOCouponRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1101,7 +2315,7 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCouponResponse); i {
+			switch v := v.(*GenerateICOCouponsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1113,7 +2327,7 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coupon); i {
+			switch v := v.(*GenerateICOCouponsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1125,7 +2339,7 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBuyICOUserHistoryRequest); i {
+			switch v := v.(*ListICOCouponsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1137,7 +2351,7 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBuyICOUserHistoryResponse); i {
+			switch v := v.(*ListICOCouponsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1149,6 +2363,126 @@ func file_ico_v1_ico_proto_init() {
 			}
 		}
 		file_ico_v1_ico_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coupon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuyICOUserHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuyICOUserHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewBuyICORequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICOPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewBuyICOResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateICOCouponsResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ico_v1_ico_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBuyICOUserHistoryResponse_Data); i {
 			case 0:
 				return &v.state
@@ -1167,7 +2501,7 @@ func file_ico_v1_ico_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ico_v1_ico_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,7 +24,13 @@ const (
 	ICOService_GetBuyICOUserHistory_FullMethodName = "/ico.v1.ICOService/GetBuyICOUserHistory"
 	ICOService_GetCurrentRound_FullMethodName      = "/ico.v1.ICOService/GetCurrentRound"
 	ICOService_GetCoupon_FullMethodName            = "/ico.v1.ICOService/GetCoupon"
+	ICOService_PreviewBuyICO_FullMethodName        = "/ico.v1.ICOService/PreviewBuyICO"
 	ICOService_AddICOCoupon_FullMethodName         = "/ico.v1.ICOService/AddICOCoupon"
+	ICOService_UpdateICOCoupon_FullMethodName      = "/ico.v1.ICOService/UpdateICOCoupon"
+	ICOService_DeleteICOCoupon_FullMethodName      = "/ico.v1.ICOService/DeleteICOCoupon"
+	ICOService_RestoreICOCoupon_FullMethodName     = "/ico.v1.ICOService/RestoreICOCoupon"
+	ICOService_GenerateICOCoupons_FullMethodName   = "/ico.v1.ICOService/GenerateICOCoupons"
+	ICOService_ListICOCoupons_FullMethodName       = "/ico.v1.ICOService/ListICOCoupons"
 )
 
 // ICOServiceClient is the client API for ICOService service.
@@ -33,10 +39,26 @@ const (
 type ICOServiceClient interface {
 	// Sends a greeting
 	GetICOInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetICOInfoResponse, error)
+	// The leaderboard of the buyers, biggest first.
 	GetBuyICOUserHistory(ctx context.Context, in *GetBuyICOUserHistoryRequest, opts ...grpc.CallOption) (*GetBuyICOUserHistoryResponse, error)
 	GetCurrentRound(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCurrentRoundResponse, error)
 	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
+	// What BuyICO would buy now for amount in symbol, sub-round by sub-round. It
+	// writes nothing and takes no lock, so a concurrent purchase can make the
+	// real one buy less.
+	PreviewBuyICO(ctx context.Context, in *PreviewBuyICORequest, opts ...grpc.CallOption) (*PreviewBuyICOResponse, error)
+	// Fails with COUPON_EXISTS when the code is taken, by a deleted coupon too.
 	AddICOCoupon(ctx context.Context, in *AddICOCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replaces the terms of a coupon, deleted or not. Its owner and uses stay.
+	UpdateICOCoupon(ctx context.Context, in *UpdateICOCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
+	// Purchases stop applying a deleted coupon, its code stays taken.
+	DeleteICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
+	RestoreICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
+	// Generates count coupons of a user sharing the same terms. The codes are
+	// the prefix and random characters of the alphabet, none taken before.
+	GenerateICOCoupons(ctx context.Context, in *GenerateICOCouponsRequest, opts ...grpc.CallOption) (*GenerateICOCouponsResponse, error)
+	// The coupons a user owns, newest first.
+	ListICOCoupons(ctx context.Context, in *ListICOCouponsRequest, opts ...grpc.CallOption) (*ListICOCouponsResponse, error)
 }
 
 type iCOServiceClient struct {
@@ -83,6 +105,15 @@ func (c *iCOServiceClient) GetCoupon(ctx context.Context, in *GetCouponRequest,
 	return out, nil
 }
 
+func (c *iCOServiceClient) PreviewBuyICO(ctx context.Context, in *PreviewBuyICORequest, opts ...grpc.CallOption) (*PreviewBuyICOResponse, error) {
+	out := new(PreviewBuyICOResponse)
+	err := c.cc.Invoke(ctx, ICOService_PreviewBuyICO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iCOServiceClient) AddICOCoupon(ctx context.Context, in *AddICOCouponRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ICOService_AddICOCoupon_FullMethodName, in, out, opts...)
@@ -92,16 +123,77 @@ func (c *iCOServiceClient) AddICOCoupon(ctx context.Context, in *AddICOCouponReq
 	return out, nil
 }
 
+func (c *iCOServiceClient) UpdateICOCoupon(ctx context.Context, in *UpdateICOCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error) {
+	out := new(GetCouponResponse)
+	err := c.cc.Invoke(ctx, ICOService_UpdateICOCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iCOServiceClient) DeleteICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error) {
+	out := new(GetCouponResponse)
+	err := c.cc.Invoke(ctx, ICOService_DeleteICOCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iCOServiceClient) RestoreICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error) {
+	out := new(GetCouponResponse)
+	err := c.cc.Invoke(ctx, ICOService_RestoreICOCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iCOServiceClient) GenerateICOCoupons(ctx context.Context, in *GenerateICOCouponsRequest, opts ...grpc.CallOption) (*GenerateICOCouponsResponse, error) {
+	out := new(GenerateICOCouponsResponse)
+	err := c.cc.Invoke(ctx, ICOService_GenerateICOCoupons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iCOServiceClient) ListICOCoupons(ctx context.Context, in *ListICOCouponsRequest, opts ...grpc.CallOption) (*ListICOCouponsResponse, error) {
+	out := new(ListICOCouponsResponse)
+	err := c.cc.Invoke(ctx, ICOService_ListICOCoupons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ICOServiceServer is the server API for ICOService service.
 // All implementations must embed UnimplementedICOServiceServer
 // for forward compatibility
 type ICOServiceServer interface {
 	// Sends a greeting
 	GetICOInfo(context.Context, *emptypb.Empty) (*GetICOInfoResponse, error)
+	// The leaderboard of the buyers, biggest first.
 	GetBuyICOUserHistory(context.Context, *GetBuyICOUserHistoryRequest) (*GetBuyICOUserHistoryResponse, error)
 	GetCurrentRound(context.Context, *emptypb.Empty) (*GetCurrentRoundResponse, error)
 	GetCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
+	// What BuyICO would buy now for amount in symbol, sub-round by sub-round. It
+	// writes nothing and takes no lock, so a concurrent purchase can make the
+	// real one buy less.
+	PreviewBuyICO(context.Context, *PreviewBuyICORequest) (*PreviewBuyICOResponse, error)
+	// Fails with COUPON_EXISTS when the code is taken, by a deleted coupon too.
 	AddICOCoupon(context.Context, *AddICOCouponRequest) (*emptypb.Empty, error)
+	// Replaces the terms of a coupon, deleted or not. Its owner and uses stay.
+	UpdateICOCoupon(context.Context, *UpdateICOCouponRequest) (*GetCouponResponse, error)
+	// Purchases stop applying a deleted coupon, its code stays taken.
+	DeleteICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
+	RestoreICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
+	// Generates count coupons of a user sharing the same terms. The codes are
+	// the prefix and random characters of the alphabet, none taken before.
+	GenerateICOCoupons(context.Context, *GenerateICOCouponsRequest) (*GenerateICOCouponsResponse, error)
+	// The coupons a user owns, newest first.
+	ListICOCoupons(context.Context, *ListICOCouponsRequest) (*ListICOCouponsResponse, error)
 	mustEmbedUnimplementedICOServiceServer()
 }
 
@@ -121,9 +213,27 @@ func (UnimplementedICOServiceServer) GetCurrentRound(context.Context, *emptypb.E
 func (UnimplementedICOServiceServer) GetCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCoupon not implemented")
 }
+func (UnimplementedICOServiceServer) PreviewBuyICO(context.Context, *PreviewBuyICORequest) (*PreviewBuyICOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewBuyICO not implemented")
+}
 func (UnimplementedICOServiceServer) AddICOCoupon(context.Context, *AddICOCouponRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddICOCoupon not implemented")
 }
+func (UnimplementedICOServiceServer) UpdateICOCoupon(context.Context, *UpdateICOCouponRequest) (*GetCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateICOCoupon not implemented")
+}
+func (UnimplementedICOServiceServer) DeleteICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteICOCoupon not implemented")
+}
+func (UnimplementedICOServiceServer) RestoreICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreICOCoupon not implemented")
+}
+func (UnimplementedICOServiceServer) GenerateICOCoupons(context.Context, *GenerateICOCouponsRequest) (*GenerateICOCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateICOCoupons not implemented")
+}
+func (UnimplementedICOServiceServer) ListICOCoupons(context.Context, *ListICOCouponsRequest) (*ListICOCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListICOCoupons not implemented")
+}
 func (UnimplementedICOServiceServer) mustEmbedUnimplementedICOServiceServer() {}
 
 // UnsafeICOServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -209,6 +319,24 @@ func _ICOService_GetCoupon_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ICOService_PreviewBuyICO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewBuyICORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).PreviewBuyICO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_PreviewBuyICO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).PreviewBuyICO(ctx, req.(*PreviewBuyICORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ICOService_AddICOCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddICOCouponRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +355,96 @@ func _ICOService_AddICOCoupon_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ICOService_UpdateICOCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateICOCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).UpdateICOCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_UpdateICOCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).UpdateICOCoupon(ctx, req.(*UpdateICOCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ICOService_DeleteICOCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).DeleteICOCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_DeleteICOCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).DeleteICOCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ICOService_RestoreICOCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).RestoreICOCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_RestoreICOCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).RestoreICOCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ICOService_GenerateICOCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateICOCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).GenerateICOCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_GenerateICOCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).GenerateICOCoupons(ctx, req.(*GenerateICOCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ICOService_ListICOCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListICOCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICOServiceServer).ListICOCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ICOService_ListICOCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICOServiceServer).ListICOCoupons(ctx, req.(*ListICOCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ICOService_ServiceDesc is the grpc.ServiceDesc for ICOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -250,10 +468,34 @@ var ICOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCoupon",
 			Handler:    _ICOService_GetCoupon_Handler,
 		},
+		{
+			MethodName: "PreviewBuyICO",
+			Handler:    _ICOService_PreviewBuyICO_Handler,
+		},
 		{
 			MethodName: "AddICOCoupon",
 			Handler:    _ICOService_AddICOCoupon_Handler,
 		},
+		{
+			MethodName: "UpdateICOCoupon",
+			Handler:    _ICOService_UpdateICOCoupon_Handler,
+		},
+		{
+			MethodName: "DeleteICOCoupon",
+			Handler:    _ICOService_DeleteICOCoupon_Handler,
+		},
+		{
+			MethodName: "RestoreICOCoupon",
+			Handler:    _ICOService_RestoreICOCoupon_Handler,
+		},
+		{
+			MethodName: "GenerateICOCoupons",
+			Handler:    _ICOService_GenerateICOCoupons_Handler,
+		},
+		{
+			MethodName: "ListICOCoupons",
+			Handler:    _ICOService_ListICOCoupons_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ico/v1/ico.proto",
@@ -21,18 +21,40 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationICOServiceAddICOCoupon = "/ico.v1.ICOService/AddICOCoupon"
+const OperationICOServiceDeleteICOCoupon = "/ico.v1.ICOService/DeleteICOCoupon"
+const OperationICOServiceGenerateICOCoupons = "/ico.v1.ICOService/GenerateICOCoupons"
 const OperationICOServiceGetBuyICOUserHistory = "/ico.v1.ICOService/GetBuyICOUserHistory"
 const OperationICOServiceGetCoupon = "/ico.v1.ICOService/GetCoupon"
 const OperationICOServiceGetCurrentRound = "/ico.v1.ICOService/GetCurrentRound"
 const OperationICOServiceGetICOInfo = "/ico.v1.ICOService/GetICOInfo"
+const OperationICOServiceListICOCoupons = "/ico.v1.ICOService/ListICOCoupons"
+const OperationICOServicePreviewBuyICO = "/ico.v1.ICOService/PreviewBuyICO"
+const OperationICOServiceRestoreICOCoupon = "/ico.v1.ICOService/RestoreICOCoupon"
+const OperationICOServiceUpdateICOCoupon = "/ico.v1.ICOService/UpdateICOCoupon"
 
 type ICOServiceHTTPServer interface {
+	// AddICOCoupon Fails with COUPON_EXISTS when the code is taken, by a deleted coupon too.
 	AddICOCoupon(context.Context, *AddICOCouponRequest) (*emptypb.Empty, error)
+	// DeleteICOCoupon Purchases stop applying a deleted coupon, its code stays taken.
+	DeleteICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
+	// GenerateICOCoupons Generates count coupons of a user sharing the same terms. The codes are
+	// the prefix and random characters of the alphabet, none taken before.
+	GenerateICOCoupons(context.Context, *GenerateICOCouponsRequest) (*GenerateICOCouponsResponse, error)
+	// GetBuyICOUserHistory The leaderboard of the buyers, biggest first.
 	GetBuyICOUserHistory(context.Context, *GetBuyICOUserHistoryRequest) (*GetBuyICOUserHistoryResponse, error)
 	GetCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
 	GetCurrentRound(context.Context, *emptypb.Empty) (*GetCurrentRoundResponse, error)
 	// GetICOInfo Sends a greeting
 	GetICOInfo(context.Context, *emptypb.Empty) (*GetICOInfoResponse, error)
+	// ListICOCoupons The coupons a user owns, newest first.
+	ListICOCoupons(context.Context, *ListICOCouponsRequest) (*ListICOCouponsResponse, error)
+	// PreviewBuyICO What BuyICO would buy now for amount in symbol, sub-round by sub-round. It
+	// writes nothing and takes no lock, so a concurrent purchase can make the
+	// real one buy less.
+	PreviewBuyICO(context.Context, *PreviewBuyICORequest) (*PreviewBuyICOResponse, error)
+	RestoreICOCoupon(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
+	// UpdateICOCoupon Replaces the terms of a coupon, deleted or not. Its owner and uses stay.
+	UpdateICOCoupon(context.Context, *UpdateICOCouponRequest) (*GetCouponResponse, error)
 }
 
 func RegisterICOServiceHTTPServer(s *http.Server, srv ICOServiceHTTPServer) {
@@ -41,7 +63,13 @@ func RegisterICOServiceHTTPServer(s *http.Server, srv ICOServiceHTTPServer) {
 	r.GET("/api/ico/v1/histories", _ICOService_GetBuyICOUserHistory0_HTTP_Handler(srv))
 	r.GET("/api/ico/v1/current", _ICOService_GetCurrentRound0_HTTP_Handler(srv))
 	r.GET("/api/ico/v1/coupon/{coupon}", _ICOService_GetCoupon0_HTTP_Handler(srv))
+	r.GET("/api/ico/v1/preview", _ICOService_PreviewBuyICO0_HTTP_Handler(srv))
 	r.POST("/internal/ico/v1/coupon", _ICOService_AddICOCoupon0_HTTP_Handler(srv))
+	r.PUT("/internal/ico/v1/coupon/{coupon}", _ICOService_UpdateICOCoupon0_HTTP_Handler(srv))
+	r.DELETE("/internal/ico/v1/coupon/{coupon}", _ICOService_DeleteICOCoupon0_HTTP_Handler(srv))
+	r.POST("/internal/ico/v1/coupon/{coupon}/restore", _ICOService_RestoreICOCoupon0_HTTP_Handler(srv))
+	r.POST("/internal/ico/v1/coupons/generate", _ICOService_GenerateICOCoupons0_HTTP_Handler(srv))
+	r.GET("/internal/ico/v1/coupons", _ICOService_ListICOCoupons0_HTTP_Handler(srv))
 }
 
 func _ICOService_GetICOInfo0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
@@ -123,6 +151,25 @@ func _ICOService_GetCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http
 	}
 }
 
+func _ICOService_PreviewBuyICO0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PreviewBuyICORequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServicePreviewBuyICO)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewBuyICO(ctx, req.(*PreviewBuyICORequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreviewBuyICOResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ICOService_AddICOCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AddICOCouponRequest
@@ -145,12 +192,131 @@ func _ICOService_AddICOCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx h
 	}
 }
 
+func _ICOService_UpdateICOCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateICOCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServiceUpdateICOCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateICOCoupon(ctx, req.(*UpdateICOCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ICOService_DeleteICOCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServiceDeleteICOCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteICOCoupon(ctx, req.(*GetCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ICOService_RestoreICOCoupon0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCouponRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServiceRestoreICOCoupon)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreICOCoupon(ctx, req.(*GetCouponRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCouponResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ICOService_GenerateICOCoupons0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GenerateICOCouponsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServiceGenerateICOCoupons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateICOCoupons(ctx, req.(*GenerateICOCouponsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GenerateICOCouponsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ICOService_ListICOCoupons0_HTTP_Handler(srv ICOServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListICOCouponsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationICOServiceListICOCoupons)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListICOCoupons(ctx, req.(*ListICOCouponsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListICOCouponsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ICOServiceHTTPClient interface {
 	AddICOCoupon(ctx context.Context, req *AddICOCouponRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteICOCoupon(ctx context.Context, req *GetCouponRequest, opts ...http.CallOption) (rsp *GetCouponResponse, err error)
+	GenerateICOCoupons(ctx context.Context, req *GenerateICOCouponsRequest, opts ...http.CallOption) (rsp *GenerateICOCouponsResponse, err error)
 	GetBuyICOUserHistory(ctx context.Context, req *GetBuyICOUserHistoryRequest, opts ...http.CallOption) (rsp *GetBuyICOUserHistoryResponse, err error)
 	GetCoupon(ctx context.Context, req *GetCouponRequest, opts ...http.CallOption) (rsp *GetCouponResponse, err error)
 	GetCurrentRound(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetCurrentRoundResponse, err error)
 	GetICOInfo(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetICOInfoResponse, err error)
+	ListICOCoupons(ctx context.Context, req *ListICOCouponsRequest, opts ...http.CallOption) (rsp *ListICOCouponsResponse, err error)
+	PreviewBuyICO(ctx context.Context, req *PreviewBuyICORequest, opts ...http.CallOption) (rsp *PreviewBuyICOResponse, err error)
+	RestoreICOCoupon(ctx context.Context, req *GetCouponRequest, opts ...http.CallOption) (rsp *GetCouponResponse, err error)
+	UpdateICOCoupon(ctx context.Context, req *UpdateICOCouponRequest, opts ...http.CallOption) (rsp *GetCouponResponse, err error)
 }
 
 type ICOServiceHTTPClientImpl struct {
@@ -174,6 +340,32 @@ func (c *ICOServiceHTTPClientImpl) AddICOCoupon(ctx context.Context, in *AddICOC
 	return &out, err
 }
 
+func (c *ICOServiceHTTPClientImpl) DeleteICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...http.CallOption) (*GetCouponResponse, error) {
+	var out GetCouponResponse
+	pattern := "/internal/ico/v1/coupon/{coupon}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationICOServiceDeleteICOCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ICOServiceHTTPClientImpl) GenerateICOCoupons(ctx context.Context, in *GenerateICOCouponsRequest, opts ...http.CallOption) (*GenerateICOCouponsResponse, error) {
+	var out GenerateICOCouponsResponse
+	pattern := "/internal/ico/v1/coupons/generate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationICOServiceGenerateICOCoupons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ICOServiceHTTPClientImpl) GetBuyICOUserHistory(ctx context.Context, in *GetBuyICOUserHistoryRequest, opts ...http.CallOption) (*GetBuyICOUserHistoryResponse, error) {
 	var out GetBuyICOUserHistoryResponse
 	pattern := "/api/ico/v1/histories"
@@ -225,3 +417,55 @@ func (c *ICOServiceHTTPClientImpl) GetICOInfo(ctx context.Context, in *emptypb.E
 	}
 	return &out, err
 }
+
+func (c *ICOServiceHTTPClientImpl) ListICOCoupons(ctx context.Context, in *ListICOCouponsRequest, opts ...http.CallOption) (*ListICOCouponsResponse, error) {
+	var out ListICOCouponsResponse
+	pattern := "/internal/ico/v1/coupons"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationICOServiceListICOCoupons))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ICOServiceHTTPClientImpl) PreviewBuyICO(ctx context.Context, in *PreviewBuyICORequest, opts ...http.CallOption) (*PreviewBuyICOResponse, error) {
+	var out PreviewBuyICOResponse
+	pattern := "/api/ico/v1/preview"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationICOServicePreviewBuyICO))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ICOServiceHTTPClientImpl) RestoreICOCoupon(ctx context.Context, in *GetCouponRequest, opts ...http.CallOption) (*GetCouponResponse, error) {
+	var out GetCouponResponse
+	pattern := "/internal/ico/v1/coupon/{coupon}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationICOServiceRestoreICOCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ICOServiceHTTPClientImpl) UpdateICOCoupon(ctx context.Context, in *UpdateICOCouponRequest, opts ...http.CallOption) (*GetCouponResponse, error) {
+	var out GetCouponResponse
+	pattern := "/internal/ico/v1/coupon/{coupon}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationICOServiceUpdateICOCoupon))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -9,7 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -159,6 +159,58 @@ func (UsdtType) EnumDescriptor() ([]byte, []int) {
 	return file_wallet_v1_model_proto_rawDescGZIP(), []int{2}
 }
 
+type AlertType int32
+
+const (
+	// Balance of the symbol, all wallet types, drops below the threshold.
+	AlertType_LOW_BALANCE AlertType = 0
+	// A single debit of the symbol is larger than the threshold.
+	AlertType_LARGE_DEBIT AlertType = 1
+	// A reward of at least the threshold is received, 0 for any reward.
+	AlertType_REWARD_RECEIVED AlertType = 2
+)
+
+// Enum value maps for AlertType.
+var (
+	AlertType_name = map[int32]string{
+		0: "LOW_BALANCE",
+		1: "LARGE_DEBIT",
+		2: "REWARD_RECEIVED",
+	}
+	AlertType_value = map[string]int32{
+		"LOW_BALANCE":     0,
+		"LARGE_DEBIT":     1,
+		"REWARD_RECEIVED": 2,
+	}
+)
+
+func (x AlertType) Enum() *AlertType {
+	p := new(AlertType)
+	*p = x
+	return p
+}
+
+func (x AlertType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_v1_model_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertType) Type() protoreflect.EnumType {
+	return &file_wallet_v1_model_proto_enumTypes[3]
+}
+
+func (x AlertType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertType.Descriptor instead.
+func (AlertType) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
 type UserWallet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -522,19 +574,17 @@ func (x *GetWalletHistoryResponse) GetData() *GetWalletHistoryResponse_Data {
 	return nil
 }
 
-type ChargeFeeRequest struct {
+type GetMyICOPurchasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
-	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	TypeFee  UsdtType   `protobuf:"varint,4,opt,name=type_fee,json=typeFee,proto3,enum=wallet.v1.UsdtType" json:"type_fee,omitempty"`
+	Next  string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *ChargeFeeRequest) Reset() {
-	*x = ChargeFeeRequest{}
+func (x *GetMyICOPurchasesRequest) Reset() {
+	*x = GetMyICOPurchasesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wallet_v1_model_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +592,13 @@ func (x *ChargeFeeRequest) Reset() {
 	}
 }
 
-func (x *ChargeFeeRequest) String() string {
+func (x *GetMyICOPurchasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChargeFeeRequest) ProtoMessage() {}
+func (*GetMyICOPurchasesRequest) ProtoMessage() {}
 
-func (x *ChargeFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMyICOPurchasesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wallet_v1_model_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,52 +610,52 @@ func (x *ChargeFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChargeFeeRequest.ProtoReflect.Descriptor instead.
-func (*ChargeFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMyICOPurchasesRequest.ProtoReflect.Descriptor instead.
+func (*GetMyICOPurchasesRequest) Descriptor() ([]byte, []int) {
 	return file_wallet_v1_model_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ChargeFeeRequest) GetSymbol() SymbolType {
-	if x != nil {
-		return x.Symbol
-	}
-	return SymbolType_IND
-}
-
-func (x *ChargeFeeRequest) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *ChargeFeeRequest) GetSourceId() string {
+func (x *GetMyICOPurchasesRequest) GetNext() string {
 	if x != nil {
-		return x.SourceId
+		return x.Next
 	}
 	return ""
 }
 
-func (x *ChargeFeeRequest) GetTypeFee() UsdtType {
+func (x *GetMyICOPurchasesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.TypeFee
+		return x.Limit
 	}
-	return UsdtType_USDT_TYPE
+	return 0
 }
 
-type ChargeFeeResponse struct {
+type ICOPurchase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Fee    string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
-}
-
-func (x *ChargeFeeResponse) Reset() {
-	*x = ChargeFeeResponse{}
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoundId  int32  `protobuf:"varint,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	SubRound int32  `protobuf:"varint,3,opt,name=sub_round,json=subRound,proto3" json:"sub_round,omitempty"`
+	// Average price of the tokens.
+	Price    string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	NumToken string `protobuf:"bytes,5,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
+	// ICO, or how a deposit bought the tokens.
+	Type      string `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt int32  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// What the purchase paid for the tokens, empty on early purchases.
+	Symbol   string `protobuf:"bytes,8,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount   string `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	SourceId string `protobuf:"bytes,10,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// The transaction that credited the tokens, and the coupon commission and
+	// cashback paid with the purchase.
+	Transaction *Transaction `protobuf:"bytes,11,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Commission  *Transaction `protobuf:"bytes,12,opt,name=commission,proto3" json:"commission,omitempty"`
+	Cashback    *Transaction `protobuf:"bytes,13,opt,name=cashback,proto3" json:"cashback,omitempty"`
+}
+
+func (x *ICOPurchase) Reset() {
+	*x = ICOPurchase{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wallet_v1_model_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,13 +663,13 @@ func (x *ChargeFeeResponse) Reset() {
 	}
 }
 
-func (x *ChargeFeeResponse) String() string {
+func (x *ICOPurchase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChargeFeeResponse) ProtoMessage() {}
+func (*ICOPurchase) ProtoMessage() {}
 
-func (x *ChargeFeeResponse) ProtoReflect() protoreflect.Message {
+func (x *ICOPurchase) ProtoReflect() protoreflect.Message {
 	mi := &file_wallet_v1_model_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,138 +681,130 @@ func (x *ChargeFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChargeFeeResponse.ProtoReflect.Descriptor instead.
-func (*ChargeFeeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ICOPurchase.ProtoReflect.Descriptor instead.
+func (*ICOPurchase) Descriptor() ([]byte, []int) {
 	return file_wallet_v1_model_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ChargeFeeResponse) GetCode() int64 {
+func (x *ICOPurchase) GetId() string {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ChargeFeeResponse) GetMsg() string {
+func (x *ICOPurchase) GetRoundId() int32 {
 	if x != nil {
-		return x.Msg
+		return x.RoundId
 	}
-	return ""
+	return 0
 }
 
-func (x *ChargeFeeResponse) GetMsgKey() string {
+func (x *ICOPurchase) GetSubRound() int32 {
 	if x != nil {
-		return x.MsgKey
+		return x.SubRound
 	}
-	return ""
+	return 0
 }
 
-func (x *ChargeFeeResponse) GetFee() string {
+func (x *ICOPurchase) GetPrice() string {
 	if x != nil {
-		return x.Fee
+		return x.Price
 	}
 	return ""
 }
 
-type DepositRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
-	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	IcoType  string     `protobuf:"bytes,4,opt,name=ico_type,json=icoType,proto3" json:"ico_type,omitempty"`
-}
-
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ICOPurchase) GetNumToken() string {
+	if x != nil {
+		return x.NumToken
 	}
+	return ""
 }
 
-func (x *DepositRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DepositRequest) ProtoMessage() {}
-
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ICOPurchase) GetType() string {
+	if x != nil {
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{7}
+func (x *ICOPurchase) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *DepositRequest) GetSymbol() SymbolType {
+func (x *ICOPurchase) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
-	return SymbolType_IND
+	return ""
 }
 
-func (x *DepositRequest) GetAmount() string {
+func (x *ICOPurchase) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *DepositRequest) GetSourceId() string {
+func (x *ICOPurchase) GetSourceId() string {
 	if x != nil {
 		return x.SourceId
 	}
 	return ""
 }
 
-func (x *DepositRequest) GetIcoType() string {
+func (x *ICOPurchase) GetTransaction() *Transaction {
 	if x != nil {
-		return x.IcoType
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
-type DepositResponse struct {
+func (x *ICOPurchase) GetCommission() *Transaction {
+	if x != nil {
+		return x.Commission
+	}
+	return nil
+}
+
+func (x *ICOPurchase) GetCashback() *Transaction {
+	if x != nil {
+		return x.Cashback
+	}
+	return nil
+}
+
+type GetMyICOPurchasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string                `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string                `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Data   *DepositResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Code   int64                           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                          `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *GetMyICOPurchasesResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
+func (x *GetMyICOPurchasesResponse) Reset() {
+	*x = GetMyICOPurchasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[8]
+		mi := &file_wallet_v1_model_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositResponse) String() string {
+func (x *GetMyICOPurchasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (*GetMyICOPurchasesResponse) ProtoMessage() {}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[8]
+func (x *GetMyICOPurchasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,40 +815,40 @@ func (x *DepositResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetMyICOPurchasesResponse.ProtoReflect.Descriptor instead.
+func (*GetMyICOPurchasesResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DepositResponse) GetCode() int64 {
+func (x *GetMyICOPurchasesResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *DepositResponse) GetMsg() string {
+func (x *GetMyICOPurchasesResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *DepositResponse) GetMsgKey() string {
+func (x *GetMyICOPurchasesResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *DepositResponse) GetData() *DepositResponse_Data {
+func (x *GetMyICOPurchasesResponse) GetData() *GetMyICOPurchasesResponse_Data {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type BuyICORequest struct {
+type ChargeFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -814,26 +856,28 @@ type BuyICORequest struct {
 	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
 	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Coupon   string     `protobuf:"bytes,4,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	TypeFee  UsdtType   `protobuf:"varint,4,opt,name=type_fee,json=typeFee,proto3,enum=wallet.v1.UsdtType" json:"type_fee,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *BuyICORequest) Reset() {
-	*x = BuyICORequest{}
+func (x *ChargeFeeRequest) Reset() {
+	*x = ChargeFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[9]
+		mi := &file_wallet_v1_model_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuyICORequest) String() string {
+func (x *ChargeFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyICORequest) ProtoMessage() {}
+func (*ChargeFeeRequest) ProtoMessage() {}
 
-func (x *BuyICORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[9]
+func (x *ChargeFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,67 +888,74 @@ func (x *BuyICORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyICORequest.ProtoReflect.Descriptor instead.
-func (*BuyICORequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ChargeFeeRequest.ProtoReflect.Descriptor instead.
+func (*ChargeFeeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BuyICORequest) GetSymbol() SymbolType {
+func (x *ChargeFeeRequest) GetSymbol() SymbolType {
 	if x != nil {
 		return x.Symbol
 	}
 	return SymbolType_IND
 }
 
-func (x *BuyICORequest) GetAmount() string {
+func (x *ChargeFeeRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *BuyICORequest) GetSourceId() string {
+func (x *ChargeFeeRequest) GetSourceId() string {
 	if x != nil {
 		return x.SourceId
 	}
 	return ""
 }
 
-func (x *BuyICORequest) GetCoupon() string {
+func (x *ChargeFeeRequest) GetTypeFee() UsdtType {
 	if x != nil {
-		return x.Coupon
+		return x.TypeFee
+	}
+	return UsdtType_USDT_TYPE
+}
+
+func (x *ChargeFeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type BuyICOResponse struct {
+type ChargeFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string               `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string               `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Data   *BuyICOResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Fee    string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *BuyICOResponse) Reset() {
-	*x = BuyICOResponse{}
+func (x *ChargeFeeResponse) Reset() {
+	*x = ChargeFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[10]
+		mi := &file_wallet_v1_model_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuyICOResponse) String() string {
+func (x *ChargeFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyICOResponse) ProtoMessage() {}
+func (*ChargeFeeResponse) ProtoMessage() {}
 
-func (x *BuyICOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[10]
+func (x *ChargeFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,40 +966,40 @@ func (x *BuyICOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyICOResponse.ProtoReflect.Descriptor instead.
-func (*BuyICOResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ChargeFeeResponse.ProtoReflect.Descriptor instead.
+func (*ChargeFeeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BuyICOResponse) GetCode() int64 {
+func (x *ChargeFeeResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *BuyICOResponse) GetMsg() string {
+func (x *ChargeFeeResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *BuyICOResponse) GetMsgKey() string {
+func (x *ChargeFeeResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *BuyICOResponse) GetData() *BuyICOResponse_Data {
+func (x *ChargeFeeResponse) GetFee() string {
 	if x != nil {
-		return x.Data
+		return x.Fee
 	}
-	return nil
+	return ""
 }
 
-type SubsciptionRequest struct {
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -956,25 +1007,28 @@ type SubsciptionRequest struct {
 	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
 	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	IcoType  string     `protobuf:"bytes,4,opt,name=ico_type,json=icoType,proto3" json:"ico_type,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *SubsciptionRequest) Reset() {
-	*x = SubsciptionRequest{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[11]
+		mi := &file_wallet_v1_model_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubsciptionRequest) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubsciptionRequest) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *SubsciptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[11]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,59 +1039,74 @@ func (x *SubsciptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubsciptionRequest.ProtoReflect.Descriptor instead.
-func (*SubsciptionRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SubsciptionRequest) GetSymbol() SymbolType {
+func (x *DepositRequest) GetSymbol() SymbolType {
 	if x != nil {
 		return x.Symbol
 	}
 	return SymbolType_IND
 }
 
-func (x *SubsciptionRequest) GetAmount() string {
+func (x *DepositRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *SubsciptionRequest) GetSourceId() string {
+func (x *DepositRequest) GetSourceId() string {
 	if x != nil {
 		return x.SourceId
 	}
 	return ""
 }
 
-type SubsciptionResponse struct {
+func (x *DepositRequest) GetIcoType() string {
+	if x != nil {
+		return x.IcoType
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Code   int64                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *DepositResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SubsciptionResponse) Reset() {
-	*x = SubsciptionResponse{}
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[12]
+		mi := &file_wallet_v1_model_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubsciptionResponse) String() string {
+func (x *DepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubsciptionResponse) ProtoMessage() {}
+func (*DepositResponse) ProtoMessage() {}
 
-func (x *SubsciptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[12]
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,33 +1117,40 @@ func (x *SubsciptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubsciptionResponse.ProtoReflect.Descriptor instead.
-func (*SubsciptionResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SubsciptionResponse) GetCode() int64 {
+func (x *DepositResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *SubsciptionResponse) GetMsg() string {
+func (x *DepositResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *SubsciptionResponse) GetMsgKey() string {
+func (x *DepositResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-type ReferralRewardRequest struct {
+func (x *DepositResponse) GetData() *DepositResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BuyICORequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1082,25 +1158,28 @@ type ReferralRewardRequest struct {
 	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
 	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Coupon   string     `protobuf:"bytes,4,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *ReferralRewardRequest) Reset() {
-	*x = ReferralRewardRequest{}
+func (x *BuyICORequest) Reset() {
+	*x = BuyICORequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[13]
+		mi := &file_wallet_v1_model_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReferralRewardRequest) String() string {
+func (x *BuyICORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferralRewardRequest) ProtoMessage() {}
+func (*BuyICORequest) ProtoMessage() {}
 
-func (x *ReferralRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[13]
+func (x *BuyICORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,59 +1190,75 @@ func (x *ReferralRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferralRewardRequest.ProtoReflect.Descriptor instead.
-func (*ReferralRewardRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BuyICORequest.ProtoReflect.Descriptor instead.
+func (*BuyICORequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReferralRewardRequest) GetSymbol() SymbolType {
+func (x *BuyICORequest) GetSymbol() SymbolType {
 	if x != nil {
 		return x.Symbol
 	}
 	return SymbolType_IND
 }
 
-func (x *ReferralRewardRequest) GetAmount() string {
+func (x *BuyICORequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *ReferralRewardRequest) GetSourceId() string {
+func (x *BuyICORequest) GetSourceId() string {
 	if x != nil {
 		return x.SourceId
 	}
 	return ""
 }
 
-type ReferralRewardResponse struct {
+func (x *BuyICORequest) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
+}
+
+func (x *BuyICORequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BuyICOResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Code   int64                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *BuyICOResponse_Data  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Limit  *BuyICOResponse_Limit `protobuf:"bytes,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *ReferralRewardResponse) Reset() {
-	*x = ReferralRewardResponse{}
+func (x *BuyICOResponse) Reset() {
+	*x = BuyICOResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[14]
+		mi := &file_wallet_v1_model_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReferralRewardResponse) String() string {
+func (x *BuyICOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferralRewardResponse) ProtoMessage() {}
+func (*BuyICOResponse) ProtoMessage() {}
 
-func (x *ReferralRewardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[14]
+func (x *BuyICOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,33 +1269,47 @@ func (x *ReferralRewardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferralRewardResponse.ProtoReflect.Descriptor instead.
-func (*ReferralRewardResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BuyICOResponse.ProtoReflect.Descriptor instead.
+func (*BuyICOResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ReferralRewardResponse) GetCode() int64 {
+func (x *BuyICOResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ReferralRewardResponse) GetMsg() string {
+func (x *BuyICOResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ReferralRewardResponse) GetMsgKey() string {
+func (x *BuyICOResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-type MarketingRewardRequest struct {
+func (x *BuyICOResponse) GetData() *BuyICOResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BuyICOResponse) GetLimit() *BuyICOResponse_Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type SubsciptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1208,25 +1317,27 @@ type MarketingRewardRequest struct {
 	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
 	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *MarketingRewardRequest) Reset() {
-	*x = MarketingRewardRequest{}
+func (x *SubsciptionRequest) Reset() {
+	*x = SubsciptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[15]
+		mi := &file_wallet_v1_model_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketingRewardRequest) String() string {
+func (x *SubsciptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketingRewardRequest) ProtoMessage() {}
+func (*SubsciptionRequest) ProtoMessage() {}
 
-func (x *MarketingRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[15]
+func (x *SubsciptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,60 +1348,66 @@ func (x *MarketingRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketingRewardRequest.ProtoReflect.Descriptor instead.
-func (*MarketingRewardRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SubsciptionRequest.ProtoReflect.Descriptor instead.
+func (*SubsciptionRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MarketingRewardRequest) GetSymbol() SymbolType {
+func (x *SubsciptionRequest) GetSymbol() SymbolType {
 	if x != nil {
 		return x.Symbol
 	}
 	return SymbolType_IND
 }
 
-func (x *MarketingRewardRequest) GetAmount() string {
+func (x *SubsciptionRequest) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *MarketingRewardRequest) GetSourceId() string {
+func (x *SubsciptionRequest) GetSourceId() string {
 	if x != nil {
 		return x.SourceId
 	}
 	return ""
 }
 
-type MarketingRewardResponse struct {
+func (x *SubsciptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SubsciptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64                         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string                        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string                        `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Data   *MarketingRewardResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
 }
 
-func (x *MarketingRewardResponse) Reset() {
-	*x = MarketingRewardResponse{}
+func (x *SubsciptionResponse) Reset() {
+	*x = SubsciptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[16]
+		mi := &file_wallet_v1_model_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketingRewardResponse) String() string {
+func (x *SubsciptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketingRewardResponse) ProtoMessage() {}
+func (*SubsciptionResponse) ProtoMessage() {}
 
-func (x *MarketingRewardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[16]
+func (x *SubsciptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,64 +1418,61 @@ func (x *MarketingRewardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketingRewardResponse.ProtoReflect.Descriptor instead.
-func (*MarketingRewardResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SubsciptionResponse.ProtoReflect.Descriptor instead.
+func (*SubsciptionResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MarketingRewardResponse) GetCode() int64 {
+func (x *SubsciptionResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *MarketingRewardResponse) GetMsg() string {
+func (x *SubsciptionResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *MarketingRewardResponse) GetMsgKey() string {
+func (x *SubsciptionResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *MarketingRewardResponse) GetData() *MarketingRewardResponse_Data {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type CurrentRateRequest struct {
+type ReferralRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *CurrentRateRequest) Reset() {
-	*x = CurrentRateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[17]
+func (x *ReferralRewardRequest) Reset() {
+	*x = ReferralRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrentRateRequest) String() string {
+func (x *ReferralRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrentRateRequest) ProtoMessage() {}
+func (*ReferralRewardRequest) ProtoMessage() {}
 
-func (x *CurrentRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[17]
+func (x *ReferralRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,46 +1483,66 @@ func (x *CurrentRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrentRateRequest.ProtoReflect.Descriptor instead.
-func (*CurrentRateRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ReferralRewardRequest.ProtoReflect.Descriptor instead.
+func (*ReferralRewardRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CurrentRateRequest) GetSymbol() string {
+func (x *ReferralRewardRequest) GetSymbol() SymbolType {
 	if x != nil {
 		return x.Symbol
 	}
+	return SymbolType_IND
+}
+
+func (x *ReferralRewardRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
 	return ""
 }
 
-type CurrentRate struct {
+func (x *ReferralRewardRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ReferralRewardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReferralRewardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   int64             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey string            `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	Data   *CurrentRate_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
 }
 
-func (x *CurrentRate) Reset() {
-	*x = CurrentRate{}
+func (x *ReferralRewardResponse) Reset() {
+	*x = ReferralRewardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[18]
+		mi := &file_wallet_v1_model_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CurrentRate) String() string {
+func (x *ReferralRewardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrentRate) ProtoMessage() {}
+func (*ReferralRewardResponse) ProtoMessage() {}
 
-func (x *CurrentRate) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[18]
+func (x *ReferralRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,65 +1553,59 @@ func (x *CurrentRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrentRate.ProtoReflect.Descriptor instead.
-func (*CurrentRate) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReferralRewardResponse.ProtoReflect.Descriptor instead.
+func (*ReferralRewardResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CurrentRate) GetCode() int64 {
+func (x *ReferralRewardResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *CurrentRate) GetMsg() string {
+func (x *ReferralRewardResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *CurrentRate) GetMsgKey() string {
+func (x *ReferralRewardResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *CurrentRate) GetData() *CurrentRate_Data {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type CalcChargeFeeRequest struct {
+type SetReferrerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The user referred.
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReferrerId string `protobuf:"bytes,2,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`
 }
 
-func (x *CalcChargeFeeRequest) Reset() {
-	*x = CalcChargeFeeRequest{}
+func (x *SetReferrerRequest) Reset() {
+	*x = SetReferrerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[19]
+		mi := &file_wallet_v1_model_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CalcChargeFeeRequest) String() string {
+func (x *SetReferrerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalcChargeFeeRequest) ProtoMessage() {}
+func (*SetReferrerRequest) ProtoMessage() {}
 
-func (x *CalcChargeFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[19]
+func (x *SetReferrerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,53 +1616,52 @@ func (x *CalcChargeFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalcChargeFeeRequest.ProtoReflect.Descriptor instead.
-func (*CalcChargeFeeRequest) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetReferrerRequest.ProtoReflect.Descriptor instead.
+func (*SetReferrerRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CalcChargeFeeRequest) GetSymbol() string {
+func (x *SetReferrerRequest) GetUserId() string {
 	if x != nil {
-		return x.Symbol
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CalcChargeFeeRequest) GetAmount() string {
+func (x *SetReferrerRequest) GetReferrerId() string {
 	if x != nil {
-		return x.Amount
+		return x.ReferrerId
 	}
 	return ""
 }
 
-type CalcChargeFeeResponse struct {
+type SetReferrerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code     int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg      string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	MsgKey   string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
-	IsEnough bool   `protobuf:"varint,4,opt,name=is_enough,json=isEnough,proto3" json:"is_enough,omitempty"`
+	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
 }
 
-func (x *CalcChargeFeeResponse) Reset() {
-	*x = CalcChargeFeeResponse{}
+func (x *SetReferrerResponse) Reset() {
+	*x = SetReferrerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[20]
+		mi := &file_wallet_v1_model_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CalcChargeFeeResponse) String() string {
+func (x *SetReferrerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalcChargeFeeResponse) ProtoMessage() {}
+func (*SetReferrerResponse) ProtoMessage() {}
 
-func (x *CalcChargeFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[20]
+func (x *SetReferrerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,65 +1672,60 @@ func (x *CalcChargeFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalcChargeFeeResponse.ProtoReflect.Descriptor instead.
-func (*CalcChargeFeeResponse) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetReferrerResponse.ProtoReflect.Descriptor instead.
+func (*SetReferrerResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CalcChargeFeeResponse) GetCode() int64 {
+func (x *SetReferrerResponse) GetCode() int64 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *CalcChargeFeeResponse) GetMsg() string {
+func (x *SetReferrerResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *CalcChargeFeeResponse) GetMsgKey() string {
+func (x *SetReferrerResponse) GetMsgKey() string {
 	if x != nil {
 		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *CalcChargeFeeResponse) GetIsEnough() bool {
-	if x != nil {
-		return x.IsEnough
-	}
-	return false
-}
-
-type GetWalletHistoryResponse_Data struct {
+type RefundICOPurchaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Histories []*Transaction `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
-	Next      string         `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	// The buyer.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The payment of the purchase.
+	SourceId string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
-func (x *GetWalletHistoryResponse_Data) Reset() {
-	*x = GetWalletHistoryResponse_Data{}
+func (x *RefundICOPurchaseRequest) Reset() {
+	*x = RefundICOPurchaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[21]
+		mi := &file_wallet_v1_model_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWalletHistoryResponse_Data) String() string {
+func (x *RefundICOPurchaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletHistoryResponse_Data) ProtoMessage() {}
+func (*RefundICOPurchaseRequest) ProtoMessage() {}
 
-func (x *GetWalletHistoryResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[21]
+func (x *RefundICOPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,52 +1736,53 @@ func (x *GetWalletHistoryResponse_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletHistoryResponse_Data.ProtoReflect.Descriptor instead.
-func (*GetWalletHistoryResponse_Data) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use RefundICOPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*RefundICOPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetWalletHistoryResponse_Data) GetHistories() []*Transaction {
+func (x *RefundICOPurchaseRequest) GetUserId() string {
 	if x != nil {
-		return x.Histories
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWalletHistoryResponse_Data) GetNext() string {
+func (x *RefundICOPurchaseRequest) GetSourceId() string {
 	if x != nil {
-		return x.Next
+		return x.SourceId
 	}
 	return ""
 }
 
-type DepositResponse_Data struct {
+type RefundICOPurchaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string     `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Symbol SymbolType `protobuf:"varint,2,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
-	Rate   string     `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Code   int64                           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                          `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *RefundICOPurchaseResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *DepositResponse_Data) Reset() {
-	*x = DepositResponse_Data{}
+func (x *RefundICOPurchaseResponse) Reset() {
+	*x = RefundICOPurchaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[22]
+		mi := &file_wallet_v1_model_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositResponse_Data) String() string {
+func (x *RefundICOPurchaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse_Data) ProtoMessage() {}
+func (*RefundICOPurchaseResponse) ProtoMessage() {}
 
-func (x *DepositResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[22]
+func (x *RefundICOPurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,59 +1793,65 @@ func (x *DepositResponse_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse_Data.ProtoReflect.Descriptor instead.
-func (*DepositResponse_Data) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use RefundICOPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*RefundICOPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DepositResponse_Data) GetAmount() string {
+func (x *RefundICOPurchaseResponse) GetCode() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *DepositResponse_Data) GetSymbol() SymbolType {
+func (x *RefundICOPurchaseResponse) GetMsg() string {
 	if x != nil {
-		return x.Symbol
+		return x.Msg
 	}
-	return SymbolType_IND
+	return ""
 }
 
-func (x *DepositResponse_Data) GetRate() string {
+func (x *RefundICOPurchaseResponse) GetMsgKey() string {
 	if x != nil {
-		return x.Rate
+		return x.MsgKey
 	}
 	return ""
 }
 
-type BuyICOResponse_Data struct {
+func (x *RefundICOPurchaseResponse) GetData() *RefundICOPurchaseResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetMyReferralEarningsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string     `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Symbol SymbolType `protobuf:"varint,2,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
-	Rate   string     `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Next  string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *BuyICOResponse_Data) Reset() {
-	*x = BuyICOResponse_Data{}
+func (x *GetMyReferralEarningsRequest) Reset() {
+	*x = GetMyReferralEarningsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[23]
+		mi := &file_wallet_v1_model_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuyICOResponse_Data) String() string {
+func (x *GetMyReferralEarningsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuyICOResponse_Data) ProtoMessage() {}
+func (*GetMyReferralEarningsRequest) ProtoMessage() {}
 
-func (x *BuyICOResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[23]
+func (x *GetMyReferralEarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,57 +1862,58 @@ func (x *BuyICOResponse_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuyICOResponse_Data.ProtoReflect.Descriptor instead.
-func (*BuyICOResponse_Data) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use GetMyReferralEarningsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyReferralEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *BuyICOResponse_Data) GetAmount() string {
+func (x *GetMyReferralEarningsRequest) GetNext() string {
 	if x != nil {
-		return x.Amount
+		return x.Next
 	}
 	return ""
 }
 
-func (x *BuyICOResponse_Data) GetSymbol() SymbolType {
-	if x != nil {
-		return x.Symbol
-	}
-	return SymbolType_IND
-}
-
-func (x *BuyICOResponse_Data) GetRate() string {
+func (x *GetMyReferralEarningsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Rate
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type MarketingRewardResponse_Data struct {
+type ReferralCommission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *MarketingRewardResponse_Data) Reset() {
-	*x = MarketingRewardResponse_Data{}
+	// 2 when the user referred the coupon owner, 3 when they referred their
+	// referrer and so on.
+	Level     int32  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Coupon    string `protobuf:"bytes,3,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Symbol    string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	SourceId  string `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	CreatedAt int32  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ReferralCommission) Reset() {
+	*x = ReferralCommission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[24]
+		mi := &file_wallet_v1_model_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarketingRewardResponse_Data) String() string {
+func (x *ReferralCommission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketingRewardResponse_Data) ProtoMessage() {}
+func (*ReferralCommission) ProtoMessage() {}
 
-func (x *MarketingRewardResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[24]
+func (x *ReferralCommission) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,31 +1924,1573 @@ func (x *MarketingRewardResponse_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketingRewardResponse_Data.ProtoReflect.Descriptor instead.
-func (*MarketingRewardResponse_Data) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use ReferralCommission.ProtoReflect.Descriptor instead.
+func (*ReferralCommission) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MarketingRewardResponse_Data) GetId() string {
+func (x *ReferralCommission) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CurrentRate_Data struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReferralCommission) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
 
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Rate   string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+func (x *ReferralCommission) GetCoupon() string {
+	if x != nil {
+		return x.Coupon
+	}
+	return ""
 }
 
-func (x *CurrentRate_Data) Reset() {
-	*x = CurrentRate_Data{}
+func (x *ReferralCommission) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReferralCommission) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReferralCommission) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ReferralCommission) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetMyReferralEarningsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64                               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                              `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *GetMyReferralEarningsResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetMyReferralEarningsResponse) Reset() {
+	*x = GetMyReferralEarningsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyReferralEarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyReferralEarningsResponse) ProtoMessage() {}
+
+func (x *GetMyReferralEarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyReferralEarningsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyReferralEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMyReferralEarningsResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMyReferralEarningsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMyReferralEarningsResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *GetMyReferralEarningsResponse) GetData() *GetMyReferralEarningsResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MarketingRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol   SymbolType `protobuf:"varint,1,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Amount   string     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SourceId string     `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Wallet owner the call acts on, required.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *MarketingRewardRequest) Reset() {
+	*x = MarketingRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketingRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketingRewardRequest) ProtoMessage() {}
+
+func (x *MarketingRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketingRewardRequest.ProtoReflect.Descriptor instead.
+func (*MarketingRewardRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarketingRewardRequest) GetSymbol() SymbolType {
+	if x != nil {
+		return x.Symbol
+	}
+	return SymbolType_IND
+}
+
+func (x *MarketingRewardRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *MarketingRewardRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MarketingRewardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarketingRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64                         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string                        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string                        `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *MarketingRewardResponse_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *MarketingRewardResponse) Reset() {
+	*x = MarketingRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketingRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketingRewardResponse) ProtoMessage() {}
+
+func (x *MarketingRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketingRewardResponse.ProtoReflect.Descriptor instead.
+func (*MarketingRewardResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarketingRewardResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MarketingRewardResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *MarketingRewardResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *MarketingRewardResponse) GetData() *MarketingRewardResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CurrentRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *CurrentRateRequest) Reset() {
+	*x = CurrentRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentRateRequest) ProtoMessage() {}
+
+func (x *CurrentRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentRateRequest.ProtoReflect.Descriptor instead.
+func (*CurrentRateRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CurrentRateRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type CurrentRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string            `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *CurrentRate_Data `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CurrentRate) Reset() {
+	*x = CurrentRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentRate) ProtoMessage() {}
+
+func (x *CurrentRate) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentRate.ProtoReflect.Descriptor instead.
+func (*CurrentRate) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CurrentRate) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CurrentRate) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CurrentRate) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *CurrentRate) GetData() *CurrentRate_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CalcChargeFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CalcChargeFeeRequest) Reset() {
+	*x = CalcChargeFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcChargeFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcChargeFeeRequest) ProtoMessage() {}
+
+func (x *CalcChargeFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcChargeFeeRequest.ProtoReflect.Descriptor instead.
+func (*CalcChargeFeeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CalcChargeFeeRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CalcChargeFeeRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type CalcChargeFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code     int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg      string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey   string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	IsEnough bool   `protobuf:"varint,4,opt,name=is_enough,json=isEnough,proto3" json:"is_enough,omitempty"`
+}
+
+func (x *CalcChargeFeeResponse) Reset() {
+	*x = CalcChargeFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcChargeFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcChargeFeeResponse) ProtoMessage() {}
+
+func (x *CalcChargeFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcChargeFeeResponse.ProtoReflect.Descriptor instead.
+func (*CalcChargeFeeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CalcChargeFeeResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CalcChargeFeeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CalcChargeFeeResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *CalcChargeFeeResponse) GetIsEnough() bool {
+	if x != nil {
+		return x.IsEnough
+	}
+	return false
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      AlertType              `protobuf:"varint,2,opt,name=type,proto3,enum=wallet.v1.AlertType" json:"type,omitempty"`
+	Symbol    SymbolType             `protobuf:"varint,3,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Threshold string                 `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertRule) GetType() AlertType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertType_LOW_BALANCE
+}
+
+func (x *AlertRule) GetSymbol() SymbolType {
+	if x != nil {
+		return x.Symbol
+	}
+	return SymbolType_IND
+}
+
+func (x *AlertRule) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *AlertRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      AlertType  `protobuf:"varint,1,opt,name=type,proto3,enum=wallet.v1.AlertType" json:"type,omitempty"`
+	Symbol    SymbolType `protobuf:"varint,2,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Threshold string     `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *SetAlertRuleRequest) Reset() {
+	*x = SetAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlertRuleRequest) ProtoMessage() {}
+
+func (x *SetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetAlertRuleRequest) GetType() AlertType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertType_LOW_BALANCE
+}
+
+func (x *SetAlertRuleRequest) GetSymbol() SymbolType {
+	if x != nil {
+		return x.Symbol
+	}
+	return SymbolType_IND
+}
+
+func (x *SetAlertRuleRequest) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+type SetAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string     `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   *AlertRule `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SetAlertRuleResponse) Reset() {
+	*x = SetAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlertRuleResponse) ProtoMessage() {}
+
+func (x *SetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetAlertRuleResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetAlertRuleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetAlertRuleResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *SetAlertRuleResponse) GetData() *AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAlertRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string       `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string       `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+	Data   []*AlertRule `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetAlertRulesResponse) Reset() {
+	*x = GetAlertRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesResponse) ProtoMessage() {}
+
+func (x *GetAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAlertRulesResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAlertRulesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAlertRulesResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *GetAlertRulesResponse) GetData() []*AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code   int64  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	MsgKey string `protobuf:"bytes,3,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteAlertRuleResponse) GetCode() int64 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteAlertRuleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteAlertRuleResponse) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+type GetWalletHistoryResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Histories []*Transaction `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	Next      string         `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *GetWalletHistoryResponse_Data) Reset() {
+	*x = GetWalletHistoryResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWalletHistoryResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletHistoryResponse_Data) ProtoMessage() {}
+
+func (x *GetWalletHistoryResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletHistoryResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetWalletHistoryResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GetWalletHistoryResponse_Data) GetHistories() []*Transaction {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+func (x *GetWalletHistoryResponse_Data) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type GetMyICOPurchasesResponse_Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalToken   string `protobuf:"bytes,1,opt,name=total_token,json=totalToken,proto3" json:"total_token,omitempty"`
+	AveragePrice string `protobuf:"bytes,2,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	// What the user paid per symbol.
+	Spend map[string]string `protobuf:"bytes,3,rep,name=spend,proto3" json:"spend,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetMyICOPurchasesResponse_Summary) Reset() {
+	*x = GetMyICOPurchasesResponse_Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyICOPurchasesResponse_Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyICOPurchasesResponse_Summary) ProtoMessage() {}
+
+func (x *GetMyICOPurchasesResponse_Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyICOPurchasesResponse_Summary.ProtoReflect.Descriptor instead.
+func (*GetMyICOPurchasesResponse_Summary) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetMyICOPurchasesResponse_Summary) GetTotalToken() string {
+	if x != nil {
+		return x.TotalToken
+	}
+	return ""
+}
+
+func (x *GetMyICOPurchasesResponse_Summary) GetAveragePrice() string {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return ""
+}
+
+func (x *GetMyICOPurchasesResponse_Summary) GetSpend() map[string]string {
+	if x != nil {
+		return x.Spend
+	}
+	return nil
+}
+
+type GetMyICOPurchasesResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purchases []*ICOPurchase                     `protobuf:"bytes,1,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	Next      string                             `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Summary   *GetMyICOPurchasesResponse_Summary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *GetMyICOPurchasesResponse_Data) Reset() {
+	*x = GetMyICOPurchasesResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyICOPurchasesResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyICOPurchasesResponse_Data) ProtoMessage() {}
+
+func (x *GetMyICOPurchasesResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyICOPurchasesResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetMyICOPurchasesResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *GetMyICOPurchasesResponse_Data) GetPurchases() []*ICOPurchase {
+	if x != nil {
+		return x.Purchases
+	}
+	return nil
+}
+
+func (x *GetMyICOPurchasesResponse_Data) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *GetMyICOPurchasesResponse_Data) GetSummary() *GetMyICOPurchasesResponse_Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type DepositResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string     `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Symbol SymbolType `protobuf:"varint,2,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Rate   string     `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *DepositResponse_Data) Reset() {
+	*x = DepositResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse_Data) ProtoMessage() {}
+
+func (x *DepositResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse_Data.ProtoReflect.Descriptor instead.
+func (*DepositResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *DepositResponse_Data) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DepositResponse_Data) GetSymbol() SymbolType {
+	if x != nil {
+		return x.Symbol
+	}
+	return SymbolType_IND
+}
+
+func (x *DepositResponse_Data) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type BuyICOResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string     `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Symbol SymbolType `protobuf:"varint,2,opt,name=symbol,proto3,enum=wallet.v1.SymbolType" json:"symbol,omitempty"`
+	Rate   string     `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *BuyICOResponse_Data) Reset() {
+	*x = BuyICOResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyICOResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyICOResponse_Data) ProtoMessage() {}
+
+func (x *BuyICOResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyICOResponse_Data.ProtoReflect.Descriptor instead.
+func (*BuyICOResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *BuyICOResponse_Data) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BuyICOResponse_Data) GetSymbol() SymbolType {
+	if x != nil {
+		return x.Symbol
+	}
+	return SymbolType_IND
+}
+
+func (x *BuyICOResponse_Data) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// Why a purchase broke the limits of the round, msg_key is the reason.
+type BuyICOResponse_Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The tier of the user, empty when the round has no tier limits.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	// The bound that was broken, in tokens, the hard cap of the round in the
+	// unit of its price for ICO_ABOVE_HARD_CAP.
+	Limit string `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Tokens the user can still buy in the round, for ICO_ABOVE_MAX_PER_USER.
+	// What the round can still raise, for ICO_ABOVE_HARD_CAP.
+	Remaining string `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *BuyICOResponse_Limit) Reset() {
+	*x = BuyICOResponse_Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyICOResponse_Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyICOResponse_Limit) ProtoMessage() {}
+
+func (x *BuyICOResponse_Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyICOResponse_Limit.ProtoReflect.Descriptor instead.
+func (*BuyICOResponse_Limit) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *BuyICOResponse_Limit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuyICOResponse_Limit) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *BuyICOResponse_Limit) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *BuyICOResponse_Limit) GetRemaining() string {
+	if x != nil {
+		return x.Remaining
+	}
+	return ""
+}
+
+type RefundICOPurchaseResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// The tokens given back.
+	NumToken string `protobuf:"bytes,2,opt,name=num_token,json=numToken,proto3" json:"num_token,omitempty"`
+	// What the purchase paid, to refund.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *RefundICOPurchaseResponse_Data) Reset() {
+	*x = RefundICOPurchaseResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundICOPurchaseResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundICOPurchaseResponse_Data) ProtoMessage() {}
+
+func (x *RefundICOPurchaseResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundICOPurchaseResponse_Data.ProtoReflect.Descriptor instead.
+func (*RefundICOPurchaseResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *RefundICOPurchaseResponse_Data) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *RefundICOPurchaseResponse_Data) GetNumToken() string {
+	if x != nil {
+		return x.NumToken
+	}
+	return ""
+}
+
+func (x *RefundICOPurchaseResponse_Data) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundICOPurchaseResponse_Data) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type GetMyReferralEarningsResponse_Earning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level       int32  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount      string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Commissions int32  `protobuf:"varint,4,opt,name=commissions,proto3" json:"commissions,omitempty"`
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) Reset() {
+	*x = GetMyReferralEarningsResponse_Earning{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wallet_v1_model_proto_msgTypes[25]
+		mi := &file_wallet_v1_model_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyReferralEarningsResponse_Earning) ProtoMessage() {}
+
+func (x *GetMyReferralEarningsResponse_Earning) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyReferralEarningsResponse_Earning.ProtoReflect.Descriptor instead.
+func (*GetMyReferralEarningsResponse_Earning) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GetMyReferralEarningsResponse_Earning) GetCommissions() int32 {
+	if x != nil {
+		return x.Commissions
+	}
+	return 0
+}
+
+type GetMyReferralEarningsResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How many users the user referred.
+	Referrals   int32                                    `protobuf:"varint,1,opt,name=referrals,proto3" json:"referrals,omitempty"`
+	Earnings    []*GetMyReferralEarningsResponse_Earning `protobuf:"bytes,2,rep,name=earnings,proto3" json:"earnings,omitempty"`
+	Commissions []*ReferralCommission                    `protobuf:"bytes,3,rep,name=commissions,proto3" json:"commissions,omitempty"`
+	Next        string                                   `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *GetMyReferralEarningsResponse_Data) Reset() {
+	*x = GetMyReferralEarningsResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyReferralEarningsResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyReferralEarningsResponse_Data) ProtoMessage() {}
+
+func (x *GetMyReferralEarningsResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyReferralEarningsResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetMyReferralEarningsResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{24, 1}
+}
+
+func (x *GetMyReferralEarningsResponse_Data) GetReferrals() int32 {
+	if x != nil {
+		return x.Referrals
+	}
+	return 0
+}
+
+func (x *GetMyReferralEarningsResponse_Data) GetEarnings() []*GetMyReferralEarningsResponse_Earning {
+	if x != nil {
+		return x.Earnings
+	}
+	return nil
+}
+
+func (x *GetMyReferralEarningsResponse_Data) GetCommissions() []*ReferralCommission {
+	if x != nil {
+		return x.Commissions
+	}
+	return nil
+}
+
+func (x *GetMyReferralEarningsResponse_Data) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type MarketingRewardResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MarketingRewardResponse_Data) Reset() {
+	*x = MarketingRewardResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketingRewardResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketingRewardResponse_Data) ProtoMessage() {}
+
+func (x *MarketingRewardResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_v1_model_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketingRewardResponse_Data.ProtoReflect.Descriptor instead.
+func (*MarketingRewardResponse_Data) Descriptor() ([]byte, []int) {
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *MarketingRewardResponse_Data) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CurrentRate_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Rate   string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *CurrentRate_Data) Reset() {
+	*x = CurrentRate_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wallet_v1_model_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1831,7 +3503,7 @@ func (x *CurrentRate_Data) String() string {
 func (*CurrentRate_Data) ProtoMessage() {}
 
 func (x *CurrentRate_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_v1_model_proto_msgTypes[25]
+	mi := &file_wallet_v1_model_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +3516,7 @@ func (x *CurrentRate_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrentRate_Data.ProtoReflect.Descriptor instead.
 func (*CurrentRate_Data) Descriptor() ([]byte, []int) {
-	return file_wallet_v1_model_proto_rawDescGZIP(), []int{18, 0}
+	return file_wallet_v1_model_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *CurrentRate_Data) GetSymbol() string {
@@ -1919,152 +3591,366 @@ var file_wallet_v1_model_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78,
-	0x74, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x64, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x46, 0x65, 0x65, 0x22, 0x64, 0x0a, 0x11, 0x43, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65,
-	0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x74, 0x22, 0x44, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xae, 0x03, 0x0a, 0x0b, 0x49, 0x43, 0x4f, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x75, 0x62, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x8f, 0x04, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
 	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07,
 	0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x61, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x8b, 0x01,
-	0x0a, 0x0d, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0xd8, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x98, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
 	0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16,
 	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
 	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x0e,
-	0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x1a, 0x61, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79,
+	0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x73, 0x64, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65,
+	0x46, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x11,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe8, 0x01,
+	0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x61, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x42, 0x75, 0x79,
+	0x49, 0x43, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79,
 	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c,
 	0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70,
 	0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x54,
-	0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x86, 0x03, 0x0a, 0x0e, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65,
+	0x79, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x49,
+	0x43, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x79, 0x49, 0x43, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x61, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x1a,
+	0x67, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b,
+	0x65, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x16, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b,
+	0x65, 0x79, 0x22, 0x4e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x54, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x8b, 0x02, 0x0a, 0x19, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x70, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d,
+	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xde, 0x03, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d,
 	0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73,
-	0x67, 0x4b, 0x65, 0x79, 0x22, 0x7b, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
-	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x22, 0x57, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x7c, 0x0a, 0x16, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x17, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73,
-	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67,
-	0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x16, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07,
-	0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x61,
-	0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x71, 0x0a, 0x07, 0x45, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xc7, 0x01, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x73, 0x12, 0x4c, 0x0a, 0x08, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x3f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xad, 0x01, 0x0a,
+	0x17, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x12,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x73, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x65, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x45, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x2a, 0x28, 0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x07,
-	0x0a, 0x03, 0x56, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x54, 0x10,
-	0x02, 0x2a, 0x22, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x57,
-	0x41, 0x52, 0x44, 0x10, 0x01, 0x2a, 0x19, 0x0a, 0x08, 0x55, 0x73, 0x64, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x53, 0x44, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00,
-	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
-	0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x46,
+	0x0a, 0x14, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x45, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x22, 0xcd, 0x01, 0x0a, 0x09,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x7f, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b, 0x65,
+	0x79, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x73, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73,
+	0x67, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x28,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x73, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x67, 0x4b,
+	0x65, 0x79, 0x2a, 0x28, 0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x54, 0x10, 0x02, 0x2a, 0x22, 0x0a, 0x0a,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x10, 0x01,
+	0x2a, 0x19, 0x0a, 0x08, 0x55, 0x73, 0x64, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09,
+	0x55, 0x53, 0x44, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x2a, 0x42, 0x0a, 0x09, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x4f, 0x57, 0x5f,
+	0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x52,
+	0x47, 0x45, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45,
+	0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x42,
+	0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
+	0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2079,64 +3965,108 @@ func file_wallet_v1_model_proto_rawDescGZIP() []byte {
 	return file_wallet_v1_model_proto_rawDescData
 }
 
-var file_wallet_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_wallet_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_wallet_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_wallet_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_wallet_v1_model_proto_goTypes = []interface{}{
-	(SymbolType)(0),                       // 0: wallet.v1.SymbolType
-	(WalletType)(0),                       // 1: wallet.v1.WalletType
-	(UsdtType)(0),                         // 2: wallet.v1.UsdtType
-	(*UserWallet)(nil),                    // 3: wallet.v1.UserWallet
-	(*UserWalletResponse)(nil),            // 4: wallet.v1.UserWalletResponse
-	(*Transaction)(nil),                   // 5: wallet.v1.Transaction
-	(*GetWalletHistoryRequest)(nil),       // 6: wallet.v1.GetWalletHistoryRequest
-	(*GetWalletHistoryResponse)(nil),      // 7: wallet.v1.GetWalletHistoryResponse
-	(*ChargeFeeRequest)(nil),              // 8: wallet.v1.ChargeFeeRequest
-	(*ChargeFeeResponse)(nil),             // 9: wallet.v1.ChargeFeeResponse
-	(*DepositRequest)(nil),                // 10: wallet.v1.DepositRequest
-	(*DepositResponse)(nil),               // 11: wallet.v1.DepositResponse
-	(*BuyICORequest)(nil),                 // 12: wallet.v1.BuyICORequest
-	(*BuyICOResponse)(nil),                // 13: wallet.v1.BuyICOResponse
-	(*SubsciptionRequest)(nil),            // 14: wallet.v1.SubsciptionRequest
-	(*SubsciptionResponse)(nil),           // 15: wallet.v1.SubsciptionResponse
-	(*ReferralRewardRequest)(nil),         // 16: wallet.v1.ReferralRewardRequest
-	(*ReferralRewardResponse)(nil),        // 17: wallet.v1.ReferralRewardResponse
-	(*MarketingRewardRequest)(nil),        // 18: wallet.v1.MarketingRewardRequest
-	(*MarketingRewardResponse)(nil),       // 19: wallet.v1.MarketingRewardResponse
-	(*CurrentRateRequest)(nil),            // 20: wallet.v1.CurrentRateRequest
-	(*CurrentRate)(nil),                   // 21: wallet.v1.CurrentRate
-	(*CalcChargeFeeRequest)(nil),          // 22: wallet.v1.CalcChargeFeeRequest
-	(*CalcChargeFeeResponse)(nil),         // 23: wallet.v1.CalcChargeFeeResponse
-	(*GetWalletHistoryResponse_Data)(nil), // 24: wallet.v1.GetWalletHistoryResponse.Data
-	(*DepositResponse_Data)(nil),          // 25: wallet.v1.DepositResponse.Data
-	(*BuyICOResponse_Data)(nil),           // 26: wallet.v1.BuyICOResponse.Data
-	(*MarketingRewardResponse_Data)(nil),  // 27: wallet.v1.MarketingRewardResponse.Data
-	(*CurrentRate_Data)(nil),              // 28: wallet.v1.CurrentRate.Data
+	(SymbolType)(0),                               // 0: wallet.v1.SymbolType
+	(WalletType)(0),                               // 1: wallet.v1.WalletType
+	(UsdtType)(0),                                 // 2: wallet.v1.UsdtType
+	(AlertType)(0),                                // 3: wallet.v1.AlertType
+	(*UserWallet)(nil),                            // 4: wallet.v1.UserWallet
+	(*UserWalletResponse)(nil),                    // 5: wallet.v1.UserWalletResponse
+	(*Transaction)(nil),                           // 6: wallet.v1.Transaction
+	(*GetWalletHistoryRequest)(nil),               // 7: wallet.v1.GetWalletHistoryRequest
+	(*GetWalletHistoryResponse)(nil),              // 8: wallet.v1.GetWalletHistoryResponse
+	(*GetMyICOPurchasesRequest)(nil),              // 9: wallet.v1.GetMyICOPurchasesRequest
+	(*ICOPurchase)(nil),                           // 10: wallet.v1.ICOPurchase
+	(*GetMyICOPurchasesResponse)(nil),             // 11: wallet.v1.GetMyICOPurchasesResponse
+	(*ChargeFeeRequest)(nil),                      // 12: wallet.v1.ChargeFeeRequest
+	(*ChargeFeeResponse)(nil),                     // 13: wallet.v1.ChargeFeeResponse
+	(*DepositRequest)(nil),                        // 14: wallet.v1.DepositRequest
+	(*DepositResponse)(nil),                       // 15: wallet.v1.DepositResponse
+	(*BuyICORequest)(nil),                         // 16: wallet.v1.BuyICORequest
+	(*BuyICOResponse)(nil),                        // 17: wallet.v1.BuyICOResponse
+	(*SubsciptionRequest)(nil),                    // 18: wallet.v1.SubsciptionRequest
+	(*SubsciptionResponse)(nil),                   // 19: wallet.v1.SubsciptionResponse
+	(*ReferralRewardRequest)(nil),                 // 20: wallet.v1.ReferralRewardRequest
+	(*ReferralRewardResponse)(nil),                // 21: wallet.v1.ReferralRewardResponse
+	(*SetReferrerRequest)(nil),                    // 22: wallet.v1.SetReferrerRequest
+	(*SetReferrerResponse)(nil),                   // 23: wallet.v1.SetReferrerResponse
+	(*RefundICOPurchaseRequest)(nil),              // 24: wallet.v1.RefundICOPurchaseRequest
+	(*RefundICOPurchaseResponse)(nil),             // 25: wallet.v1.RefundICOPurchaseResponse
+	(*GetMyReferralEarningsRequest)(nil),          // 26: wallet.v1.GetMyReferralEarningsRequest
+	(*ReferralCommission)(nil),                    // 27: wallet.v1.ReferralCommission
+	(*GetMyReferralEarningsResponse)(nil),         // 28: wallet.v1.GetMyReferralEarningsResponse
+	(*MarketingRewardRequest)(nil),                // 29: wallet.v1.MarketingRewardRequest
+	(*MarketingRewardResponse)(nil),               // 30: wallet.v1.MarketingRewardResponse
+	(*CurrentRateRequest)(nil),                    // 31: wallet.v1.CurrentRateRequest
+	(*CurrentRate)(nil),                           // 32: wallet.v1.CurrentRate
+	(*CalcChargeFeeRequest)(nil),                  // 33: wallet.v1.CalcChargeFeeRequest
+	(*CalcChargeFeeResponse)(nil),                 // 34: wallet.v1.CalcChargeFeeResponse
+	(*AlertRule)(nil),                             // 35: wallet.v1.AlertRule
+	(*SetAlertRuleRequest)(nil),                   // 36: wallet.v1.SetAlertRuleRequest
+	(*SetAlertRuleResponse)(nil),                  // 37: wallet.v1.SetAlertRuleResponse
+	(*GetAlertRulesResponse)(nil),                 // 38: wallet.v1.GetAlertRulesResponse
+	(*DeleteAlertRuleRequest)(nil),                // 39: wallet.v1.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),               // 40: wallet.v1.DeleteAlertRuleResponse
+	(*GetWalletHistoryResponse_Data)(nil),         // 41: wallet.v1.GetWalletHistoryResponse.Data
+	(*GetMyICOPurchasesResponse_Summary)(nil),     // 42: wallet.v1.GetMyICOPurchasesResponse.Summary
+	(*GetMyICOPurchasesResponse_Data)(nil),        // 43: wallet.v1.GetMyICOPurchasesResponse.Data
+	nil,                                           // 44: wallet.v1.GetMyICOPurchasesResponse.Summary.SpendEntry
+	(*DepositResponse_Data)(nil),                  // 45: wallet.v1.DepositResponse.Data
+	(*BuyICOResponse_Data)(nil),                   // 46: wallet.v1.BuyICOResponse.Data
+	(*BuyICOResponse_Limit)(nil),                  // 47: wallet.v1.BuyICOResponse.Limit
+	(*RefundICOPurchaseResponse_Data)(nil),        // 48: wallet.v1.RefundICOPurchaseResponse.Data
+	(*GetMyReferralEarningsResponse_Earning)(nil), // 49: wallet.v1.GetMyReferralEarningsResponse.Earning
+	(*GetMyReferralEarningsResponse_Data)(nil),    // 50: wallet.v1.GetMyReferralEarningsResponse.Data
+	(*MarketingRewardResponse_Data)(nil),          // 51: wallet.v1.MarketingRewardResponse.Data
+	(*CurrentRate_Data)(nil),                      // 52: wallet.v1.CurrentRate.Data
+	(*timestamppb.Timestamp)(nil),                 // 53: google.protobuf.Timestamp
 }
 var file_wallet_v1_model_proto_depIdxs = []int32{
 	0,  // 0: wallet.v1.UserWallet.symbol:type_name -> wallet.v1.SymbolType
 	1,  // 1: wallet.v1.UserWallet.wallet_type:type_name -> wallet.v1.WalletType
-	3,  // 2: wallet.v1.UserWalletResponse.data:type_name -> wallet.v1.UserWallet
+	4,  // 2: wallet.v1.UserWalletResponse.data:type_name -> wallet.v1.UserWallet
 	0,  // 3: wallet.v1.Transaction.symbol:type_name -> wallet.v1.SymbolType
-	24, // 4: wallet.v1.GetWalletHistoryResponse.data:type_name -> wallet.v1.GetWalletHistoryResponse.Data
-	0,  // 5: wallet.v1.ChargeFeeRequest.symbol:type_name -> wallet.v1.SymbolType
-	2,  // 6: wallet.v1.ChargeFeeRequest.type_fee:type_name -> wallet.v1.UsdtType
-	0,  // 7: wallet.v1.DepositRequest.symbol:type_name -> wallet.v1.SymbolType
-	25, // 8: wallet.v1.DepositResponse.data:type_name -> wallet.v1.DepositResponse.Data
-	0,  // 9: wallet.v1.BuyICORequest.symbol:type_name -> wallet.v1.SymbolType
-	26, // 10: wallet.v1.BuyICOResponse.data:type_name -> wallet.v1.BuyICOResponse.Data
-	0,  // 11: wallet.v1.SubsciptionRequest.symbol:type_name -> wallet.v1.SymbolType
-	0,  // 12: wallet.v1.ReferralRewardRequest.symbol:type_name -> wallet.v1.SymbolType
-	0,  // 13: wallet.v1.MarketingRewardRequest.symbol:type_name -> wallet.v1.SymbolType
-	27, // 14: wallet.v1.MarketingRewardResponse.data:type_name -> wallet.v1.MarketingRewardResponse.Data
-	28, // 15: wallet.v1.CurrentRate.data:type_name -> wallet.v1.CurrentRate.Data
-	5,  // 16: wallet.v1.GetWalletHistoryResponse.Data.histories:type_name -> wallet.v1.Transaction
-	0,  // 17: wallet.v1.DepositResponse.Data.symbol:type_name -> wallet.v1.SymbolType
-	0,  // 18: wallet.v1.BuyICOResponse.Data.symbol:type_name -> wallet.v1.SymbolType
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	41, // 4: wallet.v1.GetWalletHistoryResponse.data:type_name -> wallet.v1.GetWalletHistoryResponse.Data
+	6,  // 5: wallet.v1.ICOPurchase.transaction:type_name -> wallet.v1.Transaction
+	6,  // 6: wallet.v1.ICOPurchase.commission:type_name -> wallet.v1.Transaction
+	6,  // 7: wallet.v1.ICOPurchase.cashback:type_name -> wallet.v1.Transaction
+	43, // 8: wallet.v1.GetMyICOPurchasesResponse.data:type_name -> wallet.v1.GetMyICOPurchasesResponse.Data
+	0,  // 9: wallet.v1.ChargeFeeRequest.symbol:type_name -> wallet.v1.SymbolType
+	2,  // 10: wallet.v1.ChargeFeeRequest.type_fee:type_name -> wallet.v1.UsdtType
+	0,  // 11: wallet.v1.DepositRequest.symbol:type_name -> wallet.v1.SymbolType
+	45, // 12: wallet.v1.DepositResponse.data:type_name -> wallet.v1.DepositResponse.Data
+	0,  // 13: wallet.v1.BuyICORequest.symbol:type_name -> wallet.v1.SymbolType
+	46, // 14: wallet.v1.BuyICOResponse.data:type_name -> wallet.v1.BuyICOResponse.Data
+	47, // 15: wallet.v1.BuyICOResponse.limit:type_name -> wallet.v1.BuyICOResponse.Limit
+	0,  // 16: wallet.v1.SubsciptionRequest.symbol:type_name -> wallet.v1.SymbolType
+	0,  // 17: wallet.v1.ReferralRewardRequest.symbol:type_name -> wallet.v1.SymbolType
+	48, // 18: wallet.v1.RefundICOPurchaseResponse.data:type_name -> wallet.v1.RefundICOPurchaseResponse.Data
+	50, // 19: wallet.v1.GetMyReferralEarningsResponse.data:type_name -> wallet.v1.GetMyReferralEarningsResponse.Data
+	0,  // 20: wallet.v1.MarketingRewardRequest.symbol:type_name -> wallet.v1.SymbolType
+	51, // 21: wallet.v1.MarketingRewardResponse.data:type_name -> wallet.v1.MarketingRewardResponse.Data
+	52, // 22: wallet.v1.CurrentRate.data:type_name -> wallet.v1.CurrentRate.Data
+	3,  // 23: wallet.v1.AlertRule.type:type_name -> wallet.v1.AlertType
+	0,  // 24: wallet.v1.AlertRule.symbol:type_name -> wallet.v1.SymbolType
+	53, // 25: wallet.v1.AlertRule.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 26: wallet.v1.SetAlertRuleRequest.type:type_name -> wallet.v1.AlertType
+	0,  // 27: wallet.v1.SetAlertRuleRequest.symbol:type_name -> wallet.v1.SymbolType
+	35, // 28: wallet.v1.SetAlertRuleResponse.data:type_name -> wallet.v1.AlertRule
+	35, // 29: wallet.v1.GetAlertRulesResponse.data:type_name -> wallet.v1.AlertRule
+	6,  // 30: wallet.v1.GetWalletHistoryResponse.Data.histories:type_name -> wallet.v1.Transaction
+	44, // 31: wallet.v1.GetMyICOPurchasesResponse.Summary.spend:type_name -> wallet.v1.GetMyICOPurchasesResponse.Summary.SpendEntry
+	10, // 32: wallet.v1.GetMyICOPurchasesResponse.Data.purchases:type_name -> wallet.v1.ICOPurchase
+	42, // 33: wallet.v1.GetMyICOPurchasesResponse.Data.summary:type_name -> wallet.v1.GetMyICOPurchasesResponse.Summary
+	0,  // 34: wallet.v1.DepositResponse.Data.symbol:type_name -> wallet.v1.SymbolType
+	0,  // 35: wallet.v1.BuyICOResponse.Data.symbol:type_name -> wallet.v1.SymbolType
+	49, // 36: wallet.v1.GetMyReferralEarningsResponse.Data.earnings:type_name -> wallet.v1.GetMyReferralEarningsResponse.Earning
+	27, // 37: wallet.v1.GetMyReferralEarningsResponse.Data.commissions:type_name -> wallet.v1.ReferralCommission
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_wallet_v1_model_proto_init() }
@@ -2157,8 +4087,32 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserWalletResponse); i {
+		file_wallet_v1_model_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2169,8 +4123,8 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transaction); i {
+		file_wallet_v1_model_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2181,8 +4135,8 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletHistoryRequest); i {
+		file_wallet_v1_model_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyICOPurchasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2193,8 +4147,8 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletHistoryResponse); i {
+		file_wallet_v1_model_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICOPurchase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2205,7 +4159,19 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyICOPurchasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChargeFeeRequest); i {
 			case 0:
 				return &v.state
@@ -2217,7 +4183,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChargeFeeResponse); i {
 			case 0:
 				return &v.state
@@ -2229,7 +4195,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositRequest); i {
 			case 0:
 				return &v.state
@@ -2241,7 +4207,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositResponse); i {
 			case 0:
 				return &v.state
@@ -2253,7 +4219,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuyICORequest); i {
 			case 0:
 				return &v.state
@@ -2265,7 +4231,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuyICOResponse); i {
 			case 0:
 				return &v.state
@@ -2277,7 +4243,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubsciptionRequest); i {
 			case 0:
 				return &v.state
@@ -2289,7 +4255,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubsciptionResponse); i {
 			case 0:
 				return &v.state
@@ -2301,7 +4267,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReferralRewardRequest); i {
 			case 0:
 				return &v.state
@@ -2313,7 +4279,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReferralRewardResponse); i {
 			case 0:
 				return &v.state
@@ -2325,7 +4291,91 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReferrerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReferrerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundICOPurchaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundICOPurchaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyReferralEarningsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferralCommission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyReferralEarningsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketingRewardRequest); i {
 			case 0:
 				return &v.state
@@ -2337,7 +4387,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketingRewardResponse); i {
 			case 0:
 				return &v.state
@@ -2349,7 +4399,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CurrentRateRequest); i {
 			case 0:
 				return &v.state
@@ -2361,7 +4411,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CurrentRate); i {
 			case 0:
 				return &v.state
@@ -2373,7 +4423,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CalcChargeFeeRequest); i {
 			case 0:
 				return &v.state
@@ -2385,7 +4435,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CalcChargeFeeResponse); i {
 			case 0:
 				return &v.state
@@ -2397,7 +4447,79 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlertRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAlertRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAlertRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletHistoryResponse_Data); i {
 			case 0:
 				return &v.state
@@ -2409,7 +4531,31 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyICOPurchasesResponse_Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyICOPurchasesResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositResponse_Data); i {
 			case 0:
 				return &v.state
@@ -2421,7 +4567,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuyICOResponse_Data); i {
 			case 0:
 				return &v.state
@@ -2433,7 +4579,55 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyICOResponse_Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundICOPurchaseResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyReferralEarningsResponse_Earning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyReferralEarningsResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wallet_v1_model_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketingRewardResponse_Data); i {
 			case 0:
 				return &v.state
@@ -2445,7 +4639,7 @@ func file_wallet_v1_model_proto_init() {
 				return nil
 			}
 		}
-		file_wallet_v1_model_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_wallet_v1_model_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CurrentRate_Data); i {
 			case 0:
 				return &v.state
@@ -2463,8 +4657,8 @@ func file_wallet_v1_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wallet_v1_model_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   26,
+			NumEnums:      4,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,7 +32,7 @@ var file_wallet_v1_transaction_service_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x77, 0x61, 0x6c,
 	0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0x84, 0x06, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x74, 0x6f, 0x32, 0x88, 0x08, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x09, 0x43, 0x68, 0x61,
 	0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
 	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
@@ -69,39 +69,59 @@ var file_wallet_v1_transaction_service_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
 	0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x77,
 	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12,
-	0x54, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65,
-	0x12, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
-	0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x12, 0x21, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x12, 0x1d,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x23, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x12, 0x54, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x17, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x21, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x34, 0x5a,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x69,
+	0x6b, 0x61, 0x79, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31,
+	0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_wallet_v1_transaction_service_proto_goTypes = []interface{}{
-	(*ChargeFeeRequest)(nil),        // 0: wallet.v1.ChargeFeeRequest
-	(*DepositRequest)(nil),          // 1: wallet.v1.DepositRequest
-	(*BuyICORequest)(nil),           // 2: wallet.v1.BuyICORequest
-	(*SubsciptionRequest)(nil),      // 3: wallet.v1.SubsciptionRequest
-	(*ReferralRewardRequest)(nil),   // 4: wallet.v1.ReferralRewardRequest
-	(*CalcChargeFeeRequest)(nil),    // 5: wallet.v1.CalcChargeFeeRequest
-	(*MarketingRewardRequest)(nil),  // 6: wallet.v1.MarketingRewardRequest
-	(*ChargeFeeResponse)(nil),       // 7: wallet.v1.ChargeFeeResponse
-	(*DepositResponse)(nil),         // 8: wallet.v1.DepositResponse
-	(*BuyICOResponse)(nil),          // 9: wallet.v1.BuyICOResponse
-	(*SubsciptionResponse)(nil),     // 10: wallet.v1.SubsciptionResponse
-	(*ReferralRewardResponse)(nil),  // 11: wallet.v1.ReferralRewardResponse
-	(*CalcChargeFeeResponse)(nil),   // 12: wallet.v1.CalcChargeFeeResponse
-	(*MarketingRewardResponse)(nil), // 13: wallet.v1.MarketingRewardResponse
+	(*ChargeFeeRequest)(nil),          // 0: wallet.v1.ChargeFeeRequest
+	(*DepositRequest)(nil),            // 1: wallet.v1.DepositRequest
+	(*BuyICORequest)(nil),             // 2: wallet.v1.BuyICORequest
+	(*SubsciptionRequest)(nil),        // 3: wallet.v1.SubsciptionRequest
+	(*ReferralRewardRequest)(nil),     // 4: wallet.v1.ReferralRewardRequest
+	(*SetReferrerRequest)(nil),        // 5: wallet.v1.SetReferrerRequest
+	(*RefundICOPurchaseRequest)(nil),  // 6: wallet.v1.RefundICOPurchaseRequest
+	(*CalcChargeFeeRequest)(nil),      // 7: wallet.v1.CalcChargeFeeRequest
+	(*MarketingRewardRequest)(nil),    // 8: wallet.v1.MarketingRewardRequest
+	(*ChargeFeeResponse)(nil),         // 9: wallet.v1.ChargeFeeResponse
+	(*DepositResponse)(nil),           // 10: wallet.v1.DepositResponse
+	(*BuyICOResponse)(nil),            // 11: wallet.v1.BuyICOResponse
+	(*SubsciptionResponse)(nil),       // 12: wallet.v1.SubsciptionResponse
+	(*ReferralRewardResponse)(nil),    // 13: wallet.v1.ReferralRewardResponse
+	(*SetReferrerResponse)(nil),       // 14: wallet.v1.SetReferrerResponse
+	(*RefundICOPurchaseResponse)(nil), // 15: wallet.v1.RefundICOPurchaseResponse
+	(*CalcChargeFeeResponse)(nil),     // 16: wallet.v1.CalcChargeFeeResponse
+	(*MarketingRewardResponse)(nil),   // 17: wallet.v1.MarketingRewardResponse
 }
 var file_wallet_v1_transaction_service_proto_depIdxs = []int32{
 	0,  // 0: wallet.v1.TransactionService.ChargeFee:input_type -> wallet.v1.ChargeFeeRequest
@@ -109,17 +129,21 @@ var file_wallet_v1_transaction_service_proto_depIdxs = []int32{
 	2,  // 2: wallet.v1.TransactionService.BuyICO:input_type -> wallet.v1.BuyICORequest
 	3,  // 3: wallet.v1.TransactionService.Subscription:input_type -> wallet.v1.SubsciptionRequest
 	4,  // 4: wallet.v1.TransactionService.ReferralReward:input_type -> wallet.v1.ReferralRewardRequest
-	5,  // 5: wallet.v1.TransactionService.CalcChargeFee:input_type -> wallet.v1.CalcChargeFeeRequest
-	6,  // 6: wallet.v1.TransactionService.MarketingRewardInternal:input_type -> wallet.v1.MarketingRewardRequest
-	7,  // 7: wallet.v1.TransactionService.ChargeFee:output_type -> wallet.v1.ChargeFeeResponse
-	8,  // 8: wallet.v1.TransactionService.Deposit:output_type -> wallet.v1.DepositResponse
-	9,  // 9: wallet.v1.TransactionService.BuyICO:output_type -> wallet.v1.BuyICOResponse
-	10, // 10: wallet.v1.TransactionService.Subscription:output_type -> wallet.v1.SubsciptionResponse
-	11, // 11: wallet.v1.TransactionService.ReferralReward:output_type -> wallet.v1.ReferralRewardResponse
-	12, // 12: wallet.v1.TransactionService.CalcChargeFee:output_type -> wallet.v1.CalcChargeFeeResponse
-	13, // 13: wallet.v1.TransactionService.MarketingRewardInternal:output_type -> wallet.v1.MarketingRewardResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	5,  // 5: wallet.v1.TransactionService.SetReferrer:input_type -> wallet.v1.SetReferrerRequest
+	6,  // 6: wallet.v1.TransactionService.RefundICOPurchase:input_type -> wallet.v1.RefundICOPurchaseRequest
+	7,  // 7: wallet.v1.TransactionService.CalcChargeFee:input_type -> wallet.v1.CalcChargeFeeRequest
+	8,  // 8: wallet.v1.TransactionService.MarketingRewardInternal:input_type -> wallet.v1.MarketingRewardRequest
+	9,  // 9: wallet.v1.TransactionService.ChargeFee:output_type -> wallet.v1.ChargeFeeResponse
+	10, // 10: wallet.v1.TransactionService.Deposit:output_type -> wallet.v1.DepositResponse
+	11, // 11: wallet.v1.TransactionService.BuyICO:output_type -> wallet.v1.BuyICOResponse
+	12, // 12: wallet.v1.TransactionService.Subscription:output_type -> wallet.v1.SubsciptionResponse
+	13, // 13: wallet.v1.TransactionService.ReferralReward:output_type -> wallet.v1.ReferralRewardResponse
+	14, // 14: wallet.v1.TransactionService.SetReferrer:output_type -> wallet.v1.SetReferrerResponse
+	15, // 15: wallet.v1.TransactionService.RefundICOPurchase:output_type -> wallet.v1.RefundICOPurchaseResponse
+	16, // 16: wallet.v1.TransactionService.CalcChargeFee:output_type -> wallet.v1.CalcChargeFeeResponse
+	17, // 17: wallet.v1.TransactionService.MarketingRewardInternal:output_type -> wallet.v1.MarketingRewardResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -24,6 +24,8 @@ const (
 	TransactionService_BuyICO_FullMethodName                  = "/wallet.v1.TransactionService/BuyICO"
 	TransactionService_Subscription_FullMethodName            = "/wallet.v1.TransactionService/Subscription"
 	TransactionService_ReferralReward_FullMethodName          = "/wallet.v1.TransactionService/ReferralReward"
+	TransactionService_SetReferrer_FullMethodName             = "/wallet.v1.TransactionService/SetReferrer"
+	TransactionService_RefundICOPurchase_FullMethodName       = "/wallet.v1.TransactionService/RefundICOPurchase"
 	TransactionService_CalcChargeFee_FullMethodName           = "/wallet.v1.TransactionService/CalcChargeFee"
 	TransactionService_MarketingRewardInternal_FullMethodName = "/wallet.v1.TransactionService/MarketingRewardInternal"
 )
@@ -37,6 +39,13 @@ type TransactionServiceClient interface {
 	BuyICO(ctx context.Context, in *BuyICORequest, opts ...grpc.CallOption) (*BuyICOResponse, error)
 	Subscription(ctx context.Context, in *SubsciptionRequest, opts ...grpc.CallOption) (*SubsciptionResponse, error)
 	ReferralReward(ctx context.Context, in *ReferralRewardRequest, opts ...grpc.CallOption) (*ReferralRewardResponse, error)
+	// Records who referred a user, once. Their referrers earn commissions on
+	// the purchases made with the coupons of the user.
+	SetReferrer(ctx context.Context, in *SetReferrerRequest, opts ...grpc.CallOption) (*SetReferrerResponse, error)
+	// Refunds an ICO purchase within the refund window: the buyer gives the
+	// tokens back and loses the cashback, the coupon owner and referrers the
+	// commissions. The caller refunds the payment, amount in symbol.
+	RefundICOPurchase(ctx context.Context, in *RefundICOPurchaseRequest, opts ...grpc.CallOption) (*RefundICOPurchaseResponse, error)
 	CalcChargeFee(ctx context.Context, in *CalcChargeFeeRequest, opts ...grpc.CallOption) (*CalcChargeFeeResponse, error)
 	MarketingRewardInternal(ctx context.Context, in *MarketingRewardRequest, opts ...grpc.CallOption) (*MarketingRewardResponse, error)
 }
@@ -94,6 +103,24 @@ func (c *transactionServiceClient) ReferralReward(ctx context.Context, in *Refer
 	return out, nil
 }
 
+func (c *transactionServiceClient) SetReferrer(ctx context.Context, in *SetReferrerRequest, opts ...grpc.CallOption) (*SetReferrerResponse, error) {
+	out := new(SetReferrerResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetReferrer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) RefundICOPurchase(ctx context.Context, in *RefundICOPurchaseRequest, opts ...grpc.CallOption) (*RefundICOPurchaseResponse, error) {
+	out := new(RefundICOPurchaseResponse)
+	err := c.cc.Invoke(ctx, TransactionService_RefundICOPurchase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) CalcChargeFee(ctx context.Context, in *CalcChargeFeeRequest, opts ...grpc.CallOption) (*CalcChargeFeeResponse, error) {
 	out := new(CalcChargeFeeResponse)
 	err := c.cc.Invoke(ctx, TransactionService_CalcChargeFee_FullMethodName, in, out, opts...)
@@ -121,6 +148,13 @@ type TransactionServiceServer interface {
 	BuyICO(context.Context, *BuyICORequest) (*BuyICOResponse, error)
 	Subscription(context.Context, *SubsciptionRequest) (*SubsciptionResponse, error)
 	ReferralReward(context.Context, *ReferralRewardRequest) (*ReferralRewardResponse, error)
+	// Records who referred a user, once. Their referrers earn commissions on
+	// the purchases made with the coupons of the user.
+	SetReferrer(context.Context, *SetReferrerRequest) (*SetReferrerResponse, error)
+	// Refunds an ICO purchase within the refund window: the buyer gives the
+	// tokens back and loses the cashback, the coupon owner and referrers the
+	// commissions. The caller refunds the payment, amount in symbol.
+	RefundICOPurchase(context.Context, *RefundICOPurchaseRequest) (*RefundICOPurchaseResponse, error)
 	CalcChargeFee(context.Context, *CalcChargeFeeRequest) (*CalcChargeFeeResponse, error)
 	MarketingRewardInternal(context.Context, *MarketingRewardRequest) (*MarketingRewardResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
@@ -145,6 +179,12 @@ func (UnimplementedTransactionServiceServer) Subscription(context.Context, *Subs
 func (UnimplementedTransactionServiceServer) ReferralReward(context.Context, *ReferralRewardRequest) (*ReferralRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReferralReward not implemented")
 }
+func (UnimplementedTransactionServiceServer) SetReferrer(context.Context, *SetReferrerRequest) (*SetReferrerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReferrer not implemented")
+}
+func (UnimplementedTransactionServiceServer) RefundICOPurchase(context.Context, *RefundICOPurchaseRequest) (*RefundICOPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundICOPurchase not implemented")
+}
 func (UnimplementedTransactionServiceServer) CalcChargeFee(context.Context, *CalcChargeFeeRequest) (*CalcChargeFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcChargeFee not implemented")
 }
@@ -254,6 +294,42 @@ func _TransactionService_ReferralReward_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_SetReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReferrerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetReferrer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetReferrer(ctx, req.(*SetReferrerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_RefundICOPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundICOPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RefundICOPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RefundICOPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RefundICOPurchase(ctx, req.(*RefundICOPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_CalcChargeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalcChargeFeeRequest)
 	if err := dec(in); err != nil {
@@ -317,6 +393,14 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReferralReward",
 			Handler:    _TransactionService_ReferralReward_Handler,
 		},
+		{
+			MethodName: "SetReferrer",
+			Handler:    _TransactionService_SetReferrer_Handler,
+		},
+		{
+			MethodName: "RefundICOPurchase",
+			Handler:    _TransactionService_RefundICOPurchase_Handler,
+		},
 		{
 			MethodName: "CalcChargeFee",
 			Handler:    _TransactionService_CalcChargeFee_Handler,
@@ -23,6 +23,8 @@ const OperationTransactionServiceBuyICO = "/wallet.v1.TransactionService/BuyICO"
 const OperationTransactionServiceChargeFee = "/wallet.v1.TransactionService/ChargeFee"
 const OperationTransactionServiceDeposit = "/wallet.v1.TransactionService/Deposit"
 const OperationTransactionServiceReferralReward = "/wallet.v1.TransactionService/ReferralReward"
+const OperationTransactionServiceRefundICOPurchase = "/wallet.v1.TransactionService/RefundICOPurchase"
+const OperationTransactionServiceSetReferrer = "/wallet.v1.TransactionService/SetReferrer"
 const OperationTransactionServiceSubscription = "/wallet.v1.TransactionService/Subscription"
 
 type TransactionServiceHTTPServer interface {
@@ -30,6 +32,13 @@ type TransactionServiceHTTPServer interface {
 	ChargeFee(context.Context, *ChargeFeeRequest) (*ChargeFeeResponse, error)
 	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
 	ReferralReward(context.Context, *ReferralRewardRequest) (*ReferralRewardResponse, error)
+	// RefundICOPurchase Refunds an ICO purchase within the refund window: the buyer gives the
+	// tokens back and loses the cashback, the coupon owner and referrers the
+	// commissions. The caller refunds the payment, amount in symbol.
+	RefundICOPurchase(context.Context, *RefundICOPurchaseRequest) (*RefundICOPurchaseResponse, error)
+	// SetReferrer Records who referred a user, once. Their referrers earn commissions on
+	// the purchases made with the coupons of the user.
+	SetReferrer(context.Context, *SetReferrerRequest) (*SetReferrerResponse, error)
 	Subscription(context.Context, *SubsciptionRequest) (*SubsciptionResponse, error)
 }
 
@@ -40,6 +49,8 @@ func RegisterTransactionServiceHTTPServer(s *http.Server, srv TransactionService
 	r.POST("/internal/wallet/v1/ico", _TransactionService_BuyICO0_HTTP_Handler(srv))
 	r.POST("/internal/wallet/v1/subscription", _TransactionService_Subscription0_HTTP_Handler(srv))
 	r.POST("/internal/wallet/v1/charge", _TransactionService_ReferralReward0_HTTP_Handler(srv))
+	r.POST("/internal/wallet/v1/referrals", _TransactionService_SetReferrer0_HTTP_Handler(srv))
+	r.POST("/internal/wallet/v1/ico/refund", _TransactionService_RefundICOPurchase0_HTTP_Handler(srv))
 }
 
 func _TransactionService_ChargeFee0_HTTP_Handler(srv TransactionServiceHTTPServer) func(ctx http.Context) error {
@@ -152,11 +163,57 @@ func _TransactionService_ReferralReward0_HTTP_Handler(srv TransactionServiceHTTP
 	}
 }
 
+func _TransactionService_SetReferrer0_HTTP_Handler(srv TransactionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetReferrerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionServiceSetReferrer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetReferrer(ctx, req.(*SetReferrerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetReferrerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TransactionService_RefundICOPurchase0_HTTP_Handler(srv TransactionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefundICOPurchaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionServiceRefundICOPurchase)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefundICOPurchase(ctx, req.(*RefundICOPurchaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefundICOPurchaseResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TransactionServiceHTTPClient interface {
 	BuyICO(ctx context.Context, req *BuyICORequest, opts ...http.CallOption) (rsp *BuyICOResponse, err error)
 	ChargeFee(ctx context.Context, req *ChargeFeeRequest, opts ...http.CallOption) (rsp *ChargeFeeResponse, err error)
 	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositResponse, err error)
 	ReferralReward(ctx context.Context, req *ReferralRewardRequest, opts ...http.CallOption) (rsp *ReferralRewardResponse, err error)
+	RefundICOPurchase(ctx context.Context, req *RefundICOPurchaseRequest, opts ...http.CallOption) (rsp *RefundICOPurchaseResponse, err error)
+	SetReferrer(ctx context.Context, req *SetReferrerRequest, opts ...http.CallOption) (rsp *SetReferrerResponse, err error)
 	Subscription(ctx context.Context, req *SubsciptionRequest, opts ...http.CallOption) (rsp *SubsciptionResponse, err error)
 }
 
@@ -220,6 +277,32 @@ func (c *TransactionServiceHTTPClientImpl) ReferralReward(ctx context.Context, i
 	return &out, err
 }
 
+func (c *TransactionServiceHTTPClientImpl) RefundICOPurchase(ctx context.Context, in *RefundICOPurchaseRequest, opts ...http.CallOption) (*RefundICOPurchaseResponse, error) {
+	var out RefundICOPurchaseResponse
+	pattern := "/internal/wallet/v1/ico/refund"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionServiceRefundICOPurchase))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *TransactionServiceHTTPClientImpl) SetReferrer(ctx context.Context, in *SetReferrerRequest, opts ...http.CallOption) (*SetReferrerResponse, error) {
+	var out SetReferrerResponse
+	pattern := "/internal/wallet/v1/referrals"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionServiceSetReferrer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *TransactionServiceHTTPClientImpl) Subscription(ctx context.Context, in *SubsciptionRequest, opts ...http.CallOption) (*SubsciptionResponse, error) {
 	var out SubsciptionResponse
 	pattern := "/internal/wallet/v1/subscription"
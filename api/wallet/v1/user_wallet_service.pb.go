@@ -32,7 +32,7 @@ var file_wallet_v1_user_wallet_service_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x77, 0x61, 0x6c,
 	0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0xd3, 0x02, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x6f, 0x32, 0xed, 0x04, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65,
 	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57,
 	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
@@ -48,35 +48,60 @@ var file_wallet_v1_user_wallet_service_proto_rawDesc = []byte{
 	0x6c, 0x6c, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x1d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x00, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49,
+	0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x49, 0x43, 0x4f,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x49, 0x43, 0x4f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x63, 0x6f, 0x2f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x90, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x63, 0x6f, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x12,
+	0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x42, 0x79, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1d, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x22, 0x00, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x69, 0x6e, 0x64, 0x69, 0x6b, 0x61, 0x79, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_wallet_v1_user_wallet_service_proto_goTypes = []interface{}{
-	(*emptypb.Empty)(nil),            // 0: google.protobuf.Empty
-	(*GetWalletHistoryRequest)(nil),  // 1: wallet.v1.GetWalletHistoryRequest
-	(*CurrentRateRequest)(nil),       // 2: wallet.v1.CurrentRateRequest
-	(*UserWalletResponse)(nil),       // 3: wallet.v1.UserWalletResponse
-	(*GetWalletHistoryResponse)(nil), // 4: wallet.v1.GetWalletHistoryResponse
-	(*CurrentRate)(nil),              // 5: wallet.v1.CurrentRate
+	(*emptypb.Empty)(nil),                 // 0: google.protobuf.Empty
+	(*GetWalletHistoryRequest)(nil),       // 1: wallet.v1.GetWalletHistoryRequest
+	(*GetMyICOPurchasesRequest)(nil),      // 2: wallet.v1.GetMyICOPurchasesRequest
+	(*GetMyReferralEarningsRequest)(nil),  // 3: wallet.v1.GetMyReferralEarningsRequest
+	(*CurrentRateRequest)(nil),            // 4: wallet.v1.CurrentRateRequest
+	(*UserWalletResponse)(nil),            // 5: wallet.v1.UserWalletResponse
+	(*GetWalletHistoryResponse)(nil),      // 6: wallet.v1.GetWalletHistoryResponse
+	(*GetMyICOPurchasesResponse)(nil),     // 7: wallet.v1.GetMyICOPurchasesResponse
+	(*GetMyReferralEarningsResponse)(nil), // 8: wallet.v1.GetMyReferralEarningsResponse
+	(*CurrentRate)(nil),                   // 9: wallet.v1.CurrentRate
 }
 var file_wallet_v1_user_wallet_service_proto_depIdxs = []int32{
 	0, // 0: wallet.v1.UserWalletService.GetWalletByUserId:input_type -> google.protobuf.Empty
 	1, // 1: wallet.v1.UserWalletService.GetWalletHistories:input_type -> wallet.v1.GetWalletHistoryRequest
-	2, // 2: wallet.v1.UserWalletService.GetCurrentRateBySymbol:input_type -> wallet.v1.CurrentRateRequest
-	3, // 3: wallet.v1.UserWalletService.GetWalletByUserId:output_type -> wallet.v1.UserWalletResponse
-	4, // 4: wallet.v1.UserWalletService.GetWalletHistories:output_type -> wallet.v1.GetWalletHistoryResponse
-	5, // 5: wallet.v1.UserWalletService.GetCurrentRateBySymbol:output_type -> wallet.v1.CurrentRate
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	2, // 2: wallet.v1.UserWalletService.GetMyICOPurchases:input_type -> wallet.v1.GetMyICOPurchasesRequest
+	3, // 3: wallet.v1.UserWalletService.GetMyReferralEarnings:input_type -> wallet.v1.GetMyReferralEarningsRequest
+	4, // 4: wallet.v1.UserWalletService.GetCurrentRateBySymbol:input_type -> wallet.v1.CurrentRateRequest
+	5, // 5: wallet.v1.UserWalletService.GetWalletByUserId:output_type -> wallet.v1.UserWalletResponse
+	6, // 6: wallet.v1.UserWalletService.GetWalletHistories:output_type -> wallet.v1.GetWalletHistoryResponse
+	7, // 7: wallet.v1.UserWalletService.GetMyICOPurchases:output_type -> wallet.v1.GetMyICOPurchasesResponse
+	8, // 8: wallet.v1.UserWalletService.GetMyReferralEarnings:output_type -> wallet.v1.GetMyReferralEarningsResponse
+	9, // 9: wallet.v1.UserWalletService.GetCurrentRateBySymbol:output_type -> wallet.v1.CurrentRate
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
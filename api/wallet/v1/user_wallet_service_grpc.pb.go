@@ -22,6 +22,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	UserWalletService_GetWalletByUserId_FullMethodName      = "/wallet.v1.UserWalletService/GetWalletByUserId"
 	UserWalletService_GetWalletHistories_FullMethodName     = "/wallet.v1.UserWalletService/GetWalletHistories"
+	UserWalletService_GetMyICOPurchases_FullMethodName      = "/wallet.v1.UserWalletService/GetMyICOPurchases"
+	UserWalletService_GetMyReferralEarnings_FullMethodName  = "/wallet.v1.UserWalletService/GetMyReferralEarnings"
 	UserWalletService_GetCurrentRateBySymbol_FullMethodName = "/wallet.v1.UserWalletService/GetCurrentRateBySymbol"
 )
 
@@ -31,6 +33,12 @@ const (
 type UserWalletServiceClient interface {
 	GetWalletByUserId(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserWalletResponse, error)
 	GetWalletHistories(ctx context.Context, in *GetWalletHistoryRequest, opts ...grpc.CallOption) (*GetWalletHistoryResponse, error)
+	// The ICO purchases of the user, one per sub-round a payment bought in,
+	// with a summary of all of them.
+	GetMyICOPurchases(ctx context.Context, in *GetMyICOPurchasesRequest, opts ...grpc.CallOption) (*GetMyICOPurchasesResponse, error)
+	// What the user earned from the coupons of their downline, per level, and
+	// the commissions one by one.
+	GetMyReferralEarnings(ctx context.Context, in *GetMyReferralEarningsRequest, opts ...grpc.CallOption) (*GetMyReferralEarningsResponse, error)
 	GetCurrentRateBySymbol(ctx context.Context, in *CurrentRateRequest, opts ...grpc.CallOption) (*CurrentRate, error)
 }
 
@@ -60,6 +68,24 @@ func (c *userWalletServiceClient) GetWalletHistories(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *userWalletServiceClient) GetMyICOPurchases(ctx context.Context, in *GetMyICOPurchasesRequest, opts ...grpc.CallOption) (*GetMyICOPurchasesResponse, error) {
+	out := new(GetMyICOPurchasesResponse)
+	err := c.cc.Invoke(ctx, UserWalletService_GetMyICOPurchases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userWalletServiceClient) GetMyReferralEarnings(ctx context.Context, in *GetMyReferralEarningsRequest, opts ...grpc.CallOption) (*GetMyReferralEarningsResponse, error) {
+	out := new(GetMyReferralEarningsResponse)
+	err := c.cc.Invoke(ctx, UserWalletService_GetMyReferralEarnings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userWalletServiceClient) GetCurrentRateBySymbol(ctx context.Context, in *CurrentRateRequest, opts ...grpc.CallOption) (*CurrentRate, error) {
 	out := new(CurrentRate)
 	err := c.cc.Invoke(ctx, UserWalletService_GetCurrentRateBySymbol_FullMethodName, in, out, opts...)
@@ -75,6 +101,12 @@ func (c *userWalletServiceClient) GetCurrentRateBySymbol(ctx context.Context, in
 type UserWalletServiceServer interface {
 	GetWalletByUserId(context.Context, *emptypb.Empty) (*UserWalletResponse, error)
 	GetWalletHistories(context.Context, *GetWalletHistoryRequest) (*GetWalletHistoryResponse, error)
+	// The ICO purchases of the user, one per sub-round a payment bought in,
+	// with a summary of all of them.
+	GetMyICOPurchases(context.Context, *GetMyICOPurchasesRequest) (*GetMyICOPurchasesResponse, error)
+	// What the user earned from the coupons of their downline, per level, and
+	// the commissions one by one.
+	GetMyReferralEarnings(context.Context, *GetMyReferralEarningsRequest) (*GetMyReferralEarningsResponse, error)
 	GetCurrentRateBySymbol(context.Context, *CurrentRateRequest) (*CurrentRate, error)
 	mustEmbedUnimplementedUserWalletServiceServer()
 }
@@ -89,6 +121,12 @@ func (UnimplementedUserWalletServiceServer) GetWalletByUserId(context.Context, *
 func (UnimplementedUserWalletServiceServer) GetWalletHistories(context.Context, *GetWalletHistoryRequest) (*GetWalletHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWalletHistories not implemented")
 }
+func (UnimplementedUserWalletServiceServer) GetMyICOPurchases(context.Context, *GetMyICOPurchasesRequest) (*GetMyICOPurchasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyICOPurchases not implemented")
+}
+func (UnimplementedUserWalletServiceServer) GetMyReferralEarnings(context.Context, *GetMyReferralEarningsRequest) (*GetMyReferralEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyReferralEarnings not implemented")
+}
 func (UnimplementedUserWalletServiceServer) GetCurrentRateBySymbol(context.Context, *CurrentRateRequest) (*CurrentRate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentRateBySymbol not implemented")
 }
@@ -141,6 +179,42 @@ func _UserWalletService_GetWalletHistories_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserWalletService_GetMyICOPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyICOPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserWalletServiceServer).GetMyICOPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserWalletService_GetMyICOPurchases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserWalletServiceServer).GetMyICOPurchases(ctx, req.(*GetMyICOPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserWalletService_GetMyReferralEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyReferralEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserWalletServiceServer).GetMyReferralEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserWalletService_GetMyReferralEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserWalletServiceServer).GetMyReferralEarnings(ctx, req.(*GetMyReferralEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserWalletService_GetCurrentRateBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CurrentRateRequest)
 	if err := dec(in); err != nil {
@@ -174,6 +248,14 @@ var UserWalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWalletHistories",
 			Handler:    _UserWalletService_GetWalletHistories_Handler,
 		},
+		{
+			MethodName: "GetMyICOPurchases",
+			Handler:    _UserWalletService_GetMyICOPurchases_Handler,
+		},
+		{
+			MethodName: "GetMyReferralEarnings",
+			Handler:    _UserWalletService_GetMyReferralEarnings_Handler,
+		},
 		{
 			MethodName: "GetCurrentRateBySymbol",
 			Handler:    _UserWalletService_GetCurrentRateBySymbol_Handler,
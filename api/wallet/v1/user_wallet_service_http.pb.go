@@ -20,10 +20,18 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserWalletServiceGetMyICOPurchases = "/wallet.v1.UserWalletService/GetMyICOPurchases"
+const OperationUserWalletServiceGetMyReferralEarnings = "/wallet.v1.UserWalletService/GetMyReferralEarnings"
 const OperationUserWalletServiceGetWalletByUserId = "/wallet.v1.UserWalletService/GetWalletByUserId"
 const OperationUserWalletServiceGetWalletHistories = "/wallet.v1.UserWalletService/GetWalletHistories"
 
 type UserWalletServiceHTTPServer interface {
+	// GetMyICOPurchases The ICO purchases of the user, one per sub-round a payment bought in,
+	// with a summary of all of them.
+	GetMyICOPurchases(context.Context, *GetMyICOPurchasesRequest) (*GetMyICOPurchasesResponse, error)
+	// GetMyReferralEarnings What the user earned from the coupons of their downline, per level, and
+	// the commissions one by one.
+	GetMyReferralEarnings(context.Context, *GetMyReferralEarningsRequest) (*GetMyReferralEarningsResponse, error)
 	GetWalletByUserId(context.Context, *emptypb.Empty) (*UserWalletResponse, error)
 	GetWalletHistories(context.Context, *GetWalletHistoryRequest) (*GetWalletHistoryResponse, error)
 }
@@ -32,6 +40,8 @@ func RegisterUserWalletServiceHTTPServer(s *http.Server, srv UserWalletServiceHT
 	r := s.Route("/")
 	r.GET("/api/wallet/v1/balance", _UserWalletService_GetWalletByUserId0_HTTP_Handler(srv))
 	r.GET("/api/wallet/v1/histories", _UserWalletService_GetWalletHistories0_HTTP_Handler(srv))
+	r.GET("/api/wallet/v1/ico/purchases", _UserWalletService_GetMyICOPurchases0_HTTP_Handler(srv))
+	r.GET("/api/wallet/v1/ico/referrals", _UserWalletService_GetMyReferralEarnings0_HTTP_Handler(srv))
 }
 
 func _UserWalletService_GetWalletByUserId0_HTTP_Handler(srv UserWalletServiceHTTPServer) func(ctx http.Context) error {
@@ -72,7 +82,47 @@ func _UserWalletService_GetWalletHistories0_HTTP_Handler(srv UserWalletServiceHT
 	}
 }
 
+func _UserWalletService_GetMyICOPurchases0_HTTP_Handler(srv UserWalletServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyICOPurchasesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWalletServiceGetMyICOPurchases)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyICOPurchases(ctx, req.(*GetMyICOPurchasesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMyICOPurchasesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserWalletService_GetMyReferralEarnings0_HTTP_Handler(srv UserWalletServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMyReferralEarningsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWalletServiceGetMyReferralEarnings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMyReferralEarnings(ctx, req.(*GetMyReferralEarningsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMyReferralEarningsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserWalletServiceHTTPClient interface {
+	GetMyICOPurchases(ctx context.Context, req *GetMyICOPurchasesRequest, opts ...http.CallOption) (rsp *GetMyICOPurchasesResponse, err error)
+	GetMyReferralEarnings(ctx context.Context, req *GetMyReferralEarningsRequest, opts ...http.CallOption) (rsp *GetMyReferralEarningsResponse, err error)
 	GetWalletByUserId(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserWalletResponse, err error)
 	GetWalletHistories(ctx context.Context, req *GetWalletHistoryRequest, opts ...http.CallOption) (rsp *GetWalletHistoryResponse, err error)
 }
@@ -85,6 +135,32 @@ func NewUserWalletServiceHTTPClient(client *http.Client) UserWalletServiceHTTPCl
 	return &UserWalletServiceHTTPClientImpl{client}
 }
 
+func (c *UserWalletServiceHTTPClientImpl) GetMyICOPurchases(ctx context.Context, in *GetMyICOPurchasesRequest, opts ...http.CallOption) (*GetMyICOPurchasesResponse, error) {
+	var out GetMyICOPurchasesResponse
+	pattern := "/api/wallet/v1/ico/purchases"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserWalletServiceGetMyICOPurchases))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserWalletServiceHTTPClientImpl) GetMyReferralEarnings(ctx context.Context, in *GetMyReferralEarningsRequest, opts ...http.CallOption) (*GetMyReferralEarningsResponse, error) {
+	var out GetMyReferralEarningsResponse
+	pattern := "/api/wallet/v1/ico/referrals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserWalletServiceGetMyReferralEarnings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserWalletServiceHTTPClientImpl) GetWalletByUserId(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*UserWalletResponse, error) {
 	var out UserWalletResponse
 	pattern := "/api/wallet/v1/balance"
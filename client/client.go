@@ -38,6 +38,7 @@ func InitEnvironment(walletUrl string) {
 func registerClaim(subject string) jwtlib.RegisteredClaims {
 	return jwtlib.RegisteredClaims{
 		Subject:   subject,
+		Issuer:    "SYSTEM",
 		ExpiresAt: jwtlib.NewNumericDate(time.Now().Add(TimeOut)),
 	}
 }
@@ -129,6 +130,7 @@ func (r *walletClient) BuyIco(userId, symbol, amount, coupon string) (*v1.BuyICO
 		Symbol: v1.SymbolType(v1.SymbolType_value[symbol]),
 		Amount: amount,
 		Coupon: coupon,
+		UserId: userId,
 	})
 }
 
@@ -146,11 +148,13 @@ func (r *walletClient) Deposit(userId, symbol, actionType, sourceId string, amou
 		Amount:   fmt.Sprintf("%f", amount),
 		SourceId: sourceId,
 		IcoType:  actionType,
+		UserId:   userId,
 	}
 	return r.transactionClient.Deposit(userIntoContext(userId), rq)
 }
 
 func (r *walletClient) ChargeFee(userId string, req *v1.ChargeFeeRequest) (*v1.ChargeFeeResponse, error) {
+	req.UserId = userId
 	return r.transactionClient.ChargeFee(userIntoContext(userId), req)
 }
 
@@ -159,10 +163,12 @@ func (r *walletClient) ChargeSubscription(userId, amount, symbol, sourceId strin
 		Symbol:   v1.SymbolType(v1.SymbolType_value[symbol]),
 		Amount:   amount,
 		SourceId: sourceId,
+		UserId:   userId,
 	}
 	return r.transactionClient.Subscription(userIntoContext(userId), &req)
 }
 
 func (r *walletClient) SubmitCommissionReward(userId string, input *v1.ReferralRewardRequest) (*v1.ReferralRewardResponse, error) {
+	input.UserId = userId
 	return r.transactionClient.ReferralReward(userIntoContext(userId), input)
 }
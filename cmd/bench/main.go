@@ -0,0 +1,170 @@
+// Command bench measures ICO purchase throughput against a real Postgres and
+// Redis. It buys tokens, so only point it at a disposable database:
+//
+//	go run ./cmd/bench -conf ../../configs -mode both -c 32 -n 2000
+//
+// Mode "global-lock" wraps every purchase in the ICO_LOCK the service
+// used before row-level locking, mode "row" runs them as the service does now.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+
+	"github.com/joho/godotenv"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// flagconf is the config flag.
+	flagconf    string
+	mode        string
+	concurrency int
+	total       int
+	amount      string
+	symbol      string
+)
+
+type benchJob struct {
+	WalletUc *biz.WalletTransactionUseCase
+	LockRepo biz.LockRepo
+}
+
+type result struct {
+	mode      string
+	elapsed   time.Duration
+	latencies []time.Duration
+	errors    map[string]int
+}
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&mode, "mode", "both", "global-lock, row or both")
+	flag.IntVar(&concurrency, "c", 16, "concurrent purchases")
+	flag.IntVar(&total, "n", 1000, "total purchases")
+	flag.StringVar(&amount, "amount", "1", "amount paid per purchase")
+	flag.StringVar(&symbol, "symbol", constant.TokenSymbolUSDT, "symbol paid with")
+	godotenv.Load()
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			env.NewSource("IND_"),
+			file.NewSource(flagconf),
+		),
+		config.WithResolver(CustomResolver),
+	)
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	// purchases log on every call, keep the output to the report
+	log.DefaultLogger = log.NewFilter(log.NewStdLogger(os.Stderr), log.FilterLevel(log.LevelError))
+	job, cleanup, err := initBench(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	var modes []string
+	switch mode {
+	case "both":
+		modes = []string{"global-lock", "row"}
+	case "global-lock", "row":
+		modes = []string{mode}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown mode %q\n", mode)
+		os.Exit(2)
+	}
+
+	for _, m := range modes {
+		report(run(job, m))
+	}
+}
+
+func run(job *benchJob, mode string) *result {
+	ctx := context.Background()
+	runId := time.Now().UnixNano()
+	purchases := make(chan int)
+	rs := &result{mode: mode, errors: map[string]int{}}
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	buy := func(i int) error {
+		userId := fmt.Sprintf("bench-%d-%d", runId, i%concurrency)
+		sourceId := fmt.Sprintf("bench-%d-%d", runId, i)
+		if mode == "global-lock" {
+			token, err := job.LockRepo.Lock(ctx, constant.ICO_LOCK)
+			if err != nil {
+				return err
+			}
+			defer job.LockRepo.UnLock(ctx, token, constant.ICO_LOCK)
+		}
+		return job.WalletUc.BuyICO(ctx, userId, amount, symbol, sourceId, "")
+	}
+
+	start := time.Now()
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range purchases {
+				begin := time.Now()
+				err := buy(i)
+				spent := time.Since(begin)
+
+				mu.Lock()
+				rs.latencies = append(rs.latencies, spent)
+				if err != nil {
+					rs.errors[err.Error()]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := 0; i < total; i++ {
+		purchases <- i
+	}
+	close(purchases)
+	wg.Wait()
+	rs.elapsed = time.Since(start)
+	return rs
+}
+
+func report(rs *result) {
+	sort.Slice(rs.latencies, func(i, j int) bool { return rs.latencies[i] < rs.latencies[j] })
+	percentile := func(p float64) time.Duration {
+		if len(rs.latencies) == 0 {
+			return 0
+		}
+		return rs.latencies[int(float64(len(rs.latencies)-1)*p)]
+	}
+
+	fmt.Printf("%-12s purchases=%d concurrency=%d elapsed=%s ops/s=%.1f p50=%s p95=%s p99=%s\n",
+		rs.mode, len(rs.latencies), concurrency, rs.elapsed.Round(time.Millisecond),
+		float64(len(rs.latencies))/rs.elapsed.Seconds(), percentile(0.50), percentile(0.95), percentile(0.99))
+	for msg, count := range rs.errors {
+		fmt.Printf("%-12s error %q x%d\n", rs.mode, msg, count)
+	}
+}
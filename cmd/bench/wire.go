@@ -0,0 +1,22 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"github.com/google/wire"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/client"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/data"
+	"github.com/indikay/wallet-service/internal/messaging"
+	"github.com/indikay/wallet-service/internal/queue"
+)
+
+// initBench wires the ICO purchase path against the configured stores.
+func initBench(*conf.Data) (*benchJob, func(), error) {
+	panic(wire.Build(data.ProviderSet, messaging.ProviderSet, queue.NewQueue, queue.NewWebhookQueue, queue.NewEventQueue, client.NewWebhookClient, client.NewUserTierClient, biz.ProviderSet, wire.Struct(new(benchJob), "*")))
+}
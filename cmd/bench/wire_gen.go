@@ -0,0 +1,74 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/client"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/data"
+	"github.com/indikay/wallet-service/internal/messaging"
+	"github.com/indikay/wallet-service/internal/queue"
+)
+
+// Injectors from wire.go:
+
+// initBench wires the ICO purchase path against the configured stores.
+func initBench(confData *conf.Data) (*benchJob, func(), error) {
+	dataData, cleanup, err := data.NewData(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	transactionRepo := data.NewTransactionRepo(dataData)
+	userWalletRepo := data.NewWalletRepo(dataData)
+	icoRepo := data.NewIcoRepo(dataData)
+	currencyRateRepo := data.NewCurrencyRepo(dataData)
+	icoCouponRepo := data.NewIcoCouponRepo(dataData)
+	userTierRepo, err := client.NewUserTierClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	leaderboardRepo := data.NewLeaderboardRepo(dataData)
+	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo, userTierRepo, leaderboardRepo)
+	lockRepo, cleanup2, err := data.NewLockRepo(confData, dataData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	eventQueue := queue.NewEventQueue(confData)
+	webhookRepo := data.NewWebhookRepo(dataData)
+	webhookQueue := queue.NewWebhookQueue(confData)
+	webhookSender := client.NewWebhookClient(confData)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, webhookQueue, webhookSender)
+	alertRuleRepo := data.NewAlertRuleRepo(dataData)
+	alertUsecase := biz.NewAlertUsecase(alertRuleRepo)
+	publisher := messaging.NewPublisher(confData, eventQueue, webhookUsecase, alertUsecase)
+	invariantRepo := data.NewInvariantRepo(dataData)
+	invariantUsecase := biz.NewInvariantUsecase(invariantRepo)
+	icoStatsRepo := data.NewICOStatsRepo(dataData)
+	icoStatsUsecase := biz.NewICOStatsUsecase(icoStatsRepo)
+	icoRefundRepo := data.NewICORefundRepo(confData, dataData)
+	referralRepo, err := data.NewReferralRepo(confData, dataData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	icoRefundUsecase := biz.NewICORefundUsecase(icoRefundRepo, icoRepo, userWalletRepo, transactionRepo, icoCouponRepo, referralRepo, icoUsecase, lockRepo, publisher)
+	queueJob := queue.NewQueue(confData, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo, publisher, webhookUsecase, publisher, invariantUsecase, icoStatsUsecase, icoRefundUsecase)
+	referralUsecase := biz.NewReferralUsecase(referralRepo, userWalletRepo, transactionRepo, publisher)
+	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, publisher, icoUsecase, referralUsecase, lockRepo)
+	mainBenchJob := &benchJob{
+		WalletUc: walletTransactionUseCase,
+		LockRepo: lockRepo,
+	}
+	return mainBenchJob, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
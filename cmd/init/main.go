@@ -3,9 +3,12 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 
+	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
 
 	"github.com/joho/godotenv"
 
@@ -30,6 +33,11 @@ var (
 	id, _ = os.Hostname()
 )
 
+type initJob struct {
+	WalletUc *biz.WalletTransactionUseCase
+	AuditUc  *biz.AuditUsecase
+}
+
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 	godotenv.Load()
@@ -63,11 +71,24 @@ func main() {
 		"trace_id", tracing.TraceID(),
 		"span_id", tracing.SpanID(),
 	)
-	uc, cleanup, err := initApp(bc.Data)
+	job, cleanup, err := initApp(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	// schema changes are audited by the migration itself
+	err = job.AuditUc.RecordJob(context.Background(), "cmd/init", constant.SERVICE_NAME, "init/InitData", func(ctx context.Context) (after string, err error) {
+		// InitData panics on failure, record it before failing the same way
+		defer func() {
+			if v := recover(); v != nil {
+				err = fmt.Errorf("%v", v)
+			}
+		}()
+		job.WalletUc.InitData(ctx)
+		return "", nil
+	})
 	if err != nil {
 		panic(err)
 	}
-	uc.InitData(context.Background())
 	// err = walletRepo.InitData(context.Background())
 	// log.Errorf("err %v", err)
 	cleanup()
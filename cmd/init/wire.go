@@ -9,6 +9,7 @@ import (
 	"github.com/google/wire"
 
 	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/client"
 	"github.com/indikay/wallet-service/internal/conf"
 	"github.com/indikay/wallet-service/internal/data"
 	"github.com/indikay/wallet-service/internal/messaging"
@@ -16,6 +17,6 @@ import (
 )
 
 // initApp init kratos application.
-func initApp(*conf.Data) (*biz.WalletTransactionUseCase, func(), error) {
-	panic(wire.Build(data.ProviderSet, messaging.ProviderSet, queue.NewQueue, biz.ProviderSet)) //data.NewIcoRepo,
+func initApp(*conf.Data) (*initJob, func(), error) {
+	panic(wire.Build(data.ProviderSet, messaging.ProviderSet, queue.NewQueue, queue.NewWebhookQueue, queue.NewEventQueue, client.NewWebhookClient, client.NewUserTierClient, biz.ProviderSet, wire.Struct(new(initJob), "*"))) //data.NewIcoRepo,
 }
@@ -8,6 +8,7 @@ package main
 
 import (
 	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/client"
 	"github.com/indikay/wallet-service/internal/conf"
 	"github.com/indikay/wallet-service/internal/data"
 	"github.com/indikay/wallet-service/internal/messaging"
@@ -17,7 +18,7 @@ import (
 // Injectors from wire.go:
 
 // initApp init kratos application.
-func initApp(confData *conf.Data) (*biz.WalletTransactionUseCase, func(), error) {
+func initApp(confData *conf.Data) (*initJob, func(), error) {
 	dataData, cleanup, err := data.NewData(confData)
 	if err != nil {
 		return nil, nil, err
@@ -27,12 +28,49 @@ func initApp(confData *conf.Data) (*biz.WalletTransactionUseCase, func(), error)
 	icoRepo := data.NewIcoRepo(dataData)
 	currencyRateRepo := data.NewCurrencyRepo(dataData)
 	icoCouponRepo := data.NewIcoCouponRepo(dataData)
-	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo)
-	lockRepo := data.NewLockRepo(dataData)
-	queueJob := queue.NewQueue(confData, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo)
-	transactionPublisher := messaging.NewPublisher(confData)
-	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, transactionPublisher, icoUsecase, lockRepo)
-	return walletTransactionUseCase, func() {
+	userTierRepo, err := client.NewUserTierClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	leaderboardRepo := data.NewLeaderboardRepo(dataData)
+	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo, userTierRepo, leaderboardRepo)
+	lockRepo, cleanup2, err := data.NewLockRepo(confData, dataData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	eventQueue := queue.NewEventQueue(confData)
+	webhookRepo := data.NewWebhookRepo(dataData)
+	webhookQueue := queue.NewWebhookQueue(confData)
+	webhookSender := client.NewWebhookClient(confData)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, webhookQueue, webhookSender)
+	alertRuleRepo := data.NewAlertRuleRepo(dataData)
+	alertUsecase := biz.NewAlertUsecase(alertRuleRepo)
+	publisher := messaging.NewPublisher(confData, eventQueue, webhookUsecase, alertUsecase)
+	invariantRepo := data.NewInvariantRepo(dataData)
+	invariantUsecase := biz.NewInvariantUsecase(invariantRepo)
+	icoStatsRepo := data.NewICOStatsRepo(dataData)
+	icoStatsUsecase := biz.NewICOStatsUsecase(icoStatsRepo)
+	icoRefundRepo := data.NewICORefundRepo(confData, dataData)
+	referralRepo, err := data.NewReferralRepo(confData, dataData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	icoRefundUsecase := biz.NewICORefundUsecase(icoRefundRepo, icoRepo, userWalletRepo, transactionRepo, icoCouponRepo, referralRepo, icoUsecase, lockRepo, publisher)
+	queueJob := queue.NewQueue(confData, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo, publisher, webhookUsecase, publisher, invariantUsecase, icoStatsUsecase, icoRefundUsecase)
+	referralUsecase := biz.NewReferralUsecase(referralRepo, userWalletRepo, transactionRepo, publisher)
+	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, publisher, icoUsecase, referralUsecase, lockRepo)
+	auditLogRepo := data.NewAuditLogRepo(dataData)
+	auditUsecase := biz.NewAuditUsecase(auditLogRepo, userWalletRepo, icoCouponRepo, icoRepo)
+	mainInitJob := &initJob{
+		WalletUc: walletTransactionUseCase,
+		AuditUc:  auditUsecase,
+	}
+	return mainInitJob, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -4,11 +4,14 @@ import (
 	"flag"
 	"os"
 
+	auditProto "github.com/indikay/wallet-service/api/audit/v1"
 	icoProto "github.com/indikay/wallet-service/api/ico/v1"
 	apiProto "github.com/indikay/wallet-service/api/wallet/v1"
+	webhookProto "github.com/indikay/wallet-service/api/webhook/v1"
 
 	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/middleware"
 
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -34,27 +37,74 @@ var (
 	Version string
 	// flagconf is the config flag.
 	flagconf string
+	// flagdev runs the service on in-memory storage.
+	flagdev bool
 
 	id, _ = os.Hostname()
 )
 
 func initService(logger log.Logger, hs *http.Server, gs *grpc.Server,
 	userToken *service.UserWalletService,
-	transaction *service.TransactionService, ico *service.ICOService, queue biz.QueueJob) *kratos.App {
+	transaction *service.TransactionService, ico *service.ICOService, icoAdmin *service.ICOAdminService, icoStats *service.ICOStatsService, webhook *service.WebhookService, alert *service.AlertService, audit *service.AuditService, auditUc *biz.AuditUsecase, queue biz.QueueJob) *kratos.App {
+	// audit first so rejected calls are recorded too
+	auditing := middleware.Audit(auditUc, authorizationPolicy)
+	authorization := middleware.Authorization(authorizationPolicy)
+	hs.Use("/*", auditing, authorization)
+	gs.Use("/*", auditing, authorization)
+
 	apiProto.RegisterUserWalletServiceServer(gs, userToken)
 	apiProto.RegisterUserWalletServiceHTTPServer(hs, userToken)
 
 	apiProto.RegisterTransactionServiceServer(gs, transaction)
 	apiProto.RegisterTransactionServiceHTTPServer(hs, transaction)
 
+	apiProto.RegisterAlertServiceServer(gs, alert)
+	apiProto.RegisterAlertServiceHTTPServer(hs, alert)
+
 	icoProto.RegisterICOServiceHTTPServer(hs, ico)
 	icoProto.RegisterICOServiceServer(gs, ico)
 
+	icoProto.RegisterICOAdminServiceHTTPServer(hs, icoAdmin)
+	icoProto.RegisterICOAdminServiceServer(gs, icoAdmin)
+
+	icoProto.RegisterICOStatsServiceHTTPServer(hs, icoStats)
+	icoProto.RegisterICOStatsServiceServer(gs, icoStats)
+
+	webhookProto.RegisterWebhookServiceHTTPServer(hs, webhook)
+	webhookProto.RegisterWebhookServiceServer(gs, webhook)
+
+	auditProto.RegisterAuditServiceHTTPServer(hs, audit)
+	auditProto.RegisterAuditServiceServer(gs, audit)
+
 	return kratos.New(kratos.ID(id), kratos.Name(Name), kratos.Version(Version), kratos.Server(hs, gs, queue))
 }
 
+// authorizationPolicy lists the roles allowed on internal and admin RPCs, every
+// other RPC only needs an authenticated user. Calls to these RPCs are audited.
+var authorizationPolicy = middleware.Policy{
+	apiProto.OperationTransactionServiceChargeFee:                      {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceDeposit:                        {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceBuyICO:                         {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceSubscription:                   {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceReferralReward:                 {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceSetReferrer:                    {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.OperationTransactionServiceRefundICOPurchase:              {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	apiProto.TransactionService_MarketingRewardInternal_FullMethodName: {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceAddICOCoupon:                           {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceUpdateICOCoupon:                        {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceDeleteICOCoupon:                        {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceRestoreICOCoupon:                       {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceListICOCoupons:                         {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	icoProto.OperationICOServiceGenerateICOCoupons:                     {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	"/ico.v1.ICOAdminService/":                                         {middleware.ROLE_ADMIN},
+	"/ico.v1.ICOStatsService/":                                         {middleware.ROLE_ADMIN},
+	"/webhook.v1.WebhookService/":                                      {middleware.ROLE_SERVICE, middleware.ROLE_ADMIN},
+	"/audit.v1.AuditService/":                                          {middleware.ROLE_ADMIN},
+}
+
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&flagdev, "dev", false, "run on in-memory storage, no Postgres, Redis or NATS needed")
 	godotenv.Load()
 }
 
@@ -85,7 +135,11 @@ func main() {
 		"trace_id", tracing.TraceID(),
 		"span_id", tracing.SpanID(),
 	)
-	app, cleanup, err := initApp(bc.Server, bc.Data, log.DefaultLogger)
+	newApp := initApp
+	if flagdev {
+		newApp = initDevApp
+	}
+	app, cleanup, err := newApp(bc.Server, bc.Data, log.DefaultLogger)
 	if err != nil {
 		panic(err)
 	}
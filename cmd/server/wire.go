@@ -16,6 +16,7 @@ import (
 	"github.com/indikay/wallet-service/internal/client"
 	"github.com/indikay/wallet-service/internal/conf"
 	data "github.com/indikay/wallet-service/internal/data"
+	"github.com/indikay/wallet-service/internal/memrepo"
 	"github.com/indikay/wallet-service/internal/messaging"
 	"github.com/indikay/wallet-service/internal/queue"
 	"github.com/indikay/wallet-service/internal/service"
@@ -23,5 +24,10 @@ import (
 
 // initApp init kratos application.
 func initApp(*coreConf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(data.ProviderSet, messaging.ProviderSet, queue.NewQueue, client.ProviderSet, biz.ProviderSet, service.ProviderSet, server.ProviderSet, initService))
+	panic(wire.Build(data.ProviderSet, messaging.ProviderSet, queue.NewQueue, queue.NewWebhookQueue, queue.NewEventQueue, client.ProviderSet, biz.ProviderSet, service.ProviderSet, server.ProviderSet, initService))
+}
+
+// initDevApp init kratos application on in-memory storage, without Postgres, Redis or NATS.
+func initDevApp(*coreConf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(memrepo.ProviderSet, client.ProviderSet, biz.ProviderSet, service.ProviderSet, server.ProviderSet, initService))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/indikay/wallet-service/internal/client"
 	conf2 "github.com/indikay/wallet-service/internal/conf"
 	"github.com/indikay/wallet-service/internal/data"
+	"github.com/indikay/wallet-service/internal/memrepo"
 	"github.com/indikay/wallet-service/internal/messaging"
 	"github.com/indikay/wallet-service/internal/queue"
 	"github.com/indikay/wallet-service/internal/service"
@@ -35,21 +36,122 @@ func initApp(confServer *conf.Server, confData *conf2.Data, logger log.Logger) (
 	icoRepo := data.NewIcoRepo(dataData)
 	currencyRateRepo := data.NewCurrencyRepo(dataData)
 	icoCouponRepo := data.NewIcoCouponRepo(dataData)
-	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo)
-	lockRepo := data.NewLockRepo(dataData)
-	queueJob := queue.NewQueue(confData, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo)
-	transactionPublisher := messaging.NewPublisher(confData)
-	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, transactionPublisher, icoUsecase, lockRepo)
-	userWalletService := service.NewUserWalletService(walletTransactionUseCase)
-	transactionService := service.NewTransactionService(walletTransactionUseCase)
+	userTierRepo, err := client.NewUserTierClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	leaderboardRepo := data.NewLeaderboardRepo(dataData)
+	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo, userTierRepo, leaderboardRepo)
+	lockRepo, cleanup2, err := data.NewLockRepo(confData, dataData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	eventQueue := queue.NewEventQueue(confData)
+	webhookRepo := data.NewWebhookRepo(dataData)
+	webhookQueue := queue.NewWebhookQueue(confData)
+	webhookSender := client.NewWebhookClient(confData)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, webhookQueue, webhookSender)
+	alertRuleRepo := data.NewAlertRuleRepo(dataData)
+	alertUsecase := biz.NewAlertUsecase(alertRuleRepo)
+	publisher := messaging.NewPublisher(confData, eventQueue, webhookUsecase, alertUsecase)
+	invariantRepo := data.NewInvariantRepo(dataData)
+	invariantUsecase := biz.NewInvariantUsecase(invariantRepo)
+	icoStatsRepo := data.NewICOStatsRepo(dataData)
+	icoStatsUsecase := biz.NewICOStatsUsecase(icoStatsRepo)
+	icoRefundRepo := data.NewICORefundRepo(confData, dataData)
+	referralRepo, err := data.NewReferralRepo(confData, dataData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	icoRefundUsecase := biz.NewICORefundUsecase(icoRefundRepo, icoRepo, userWalletRepo, transactionRepo, icoCouponRepo, referralRepo, icoUsecase, lockRepo, publisher)
+	queueJob := queue.NewQueue(confData, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo, publisher, webhookUsecase, publisher, invariantUsecase, icoStatsUsecase, icoRefundUsecase)
+	referralUsecase := biz.NewReferralUsecase(referralRepo, userWalletRepo, transactionRepo, publisher)
+	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, publisher, icoUsecase, referralUsecase, lockRepo)
+	userWalletService := service.NewUserWalletService(walletTransactionUseCase, referralUsecase)
+	transactionService := service.NewTransactionService(walletTransactionUseCase, referralUsecase, icoRefundUsecase)
 	profileClient, err := client.NewProfileClient(confData)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	icoService := service.NewICOService(icoUsecase, profileClient)
-	app := initService(logger, httpServer, grpcServer, userWalletService, transactionService, icoService, queueJob)
+	icoAdminUsecase := biz.NewICOAdminUsecase(icoRepo, userWalletRepo, queueJob)
+	icoAdminService := service.NewICOAdminService(icoAdminUsecase, icoUsecase, referralUsecase, icoRefundUsecase)
+	icoStatsService := service.NewICOStatsService(icoStatsUsecase)
+	webhookService := service.NewWebhookService(webhookUsecase)
+	alertService := service.NewAlertService(alertUsecase)
+	auditLogRepo := data.NewAuditLogRepo(dataData)
+	auditUsecase := biz.NewAuditUsecase(auditLogRepo, userWalletRepo, icoCouponRepo, icoRepo)
+	auditService := service.NewAuditService(auditUsecase)
+	app := initService(logger, httpServer, grpcServer, userWalletService, transactionService, icoService, icoAdminService, icoStatsService, webhookService, alertService, auditService, auditUsecase, queueJob)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
+
+// initDevApp init kratos application on in-memory storage, without Postgres, Redis or NATS.
+func initDevApp(confServer *conf.Server, confData *conf2.Data, logger log.Logger) (*kratos.App, func(), error) {
+	httpServer := server.NewHTTPServer(confServer, logger)
+	grpcServer := server.NewGRPCServer(confServer, logger)
+	store, err := memrepo.NewDevStore()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactionRepo := memrepo.NewTransactionRepo(store)
+	userWalletRepo := memrepo.NewWalletRepo(store)
+	icoRepo := memrepo.NewIcoRepo(store)
+	currencyRateRepo := memrepo.NewCurrencyRepo(store)
+	icoCouponRepo := memrepo.NewIcoCouponRepo(store)
+	broker := memrepo.NewBroker()
+	userTierRepo, err := client.NewUserTierClient(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	leaderboardRepo := memrepo.NewLeaderboardRepo(store)
+	icoUsecase := biz.NewICOUseCase(icoRepo, icoCouponRepo, currencyRateRepo, userTierRepo, leaderboardRepo)
+	lockRepo := memrepo.NewLockRepo(confData)
+	webhookRepo := memrepo.NewWebhookRepo(store)
+	webhookQueue := memrepo.NewWebhookQueue(confData, broker)
+	webhookSender := client.NewWebhookClient(confData)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, webhookQueue, webhookSender)
+	alertRuleRepo := memrepo.NewAlertRuleRepo(store)
+	alertUsecase := biz.NewAlertUsecase(alertRuleRepo)
+	transactionPublisher := memrepo.NewPublisher(webhookUsecase, alertUsecase)
+	invariantRepo := memrepo.NewInvariantRepo(store)
+	invariantUsecase := biz.NewInvariantUsecase(invariantRepo)
+	icoStatsRepo := memrepo.NewICOStatsRepo(store)
+	icoStatsUsecase := biz.NewICOStatsUsecase(icoStatsRepo)
+	icoRefundRepo := memrepo.NewICORefundRepo(confData, store)
+	referralRepo, err := memrepo.NewReferralRepo(confData, store)
+	if err != nil {
+		return nil, nil, err
+	}
+	icoRefundUsecase := biz.NewICORefundUsecase(icoRefundRepo, icoRepo, userWalletRepo, transactionRepo, icoCouponRepo, referralRepo, icoUsecase, lockRepo, transactionPublisher)
+	queueJob := memrepo.NewQueue(confData, broker, icoRepo, userWalletRepo, transactionRepo, icoUsecase, lockRepo, transactionPublisher, webhookUsecase, invariantUsecase, icoStatsUsecase, icoRefundUsecase)
+	referralUsecase := biz.NewReferralUsecase(referralRepo, userWalletRepo, transactionRepo, transactionPublisher)
+	walletTransactionUseCase := biz.NewWalletTransactionUseCase(transactionRepo, userWalletRepo, icoRepo, currencyRateRepo, icoCouponRepo, queueJob, transactionPublisher, icoUsecase, referralUsecase, lockRepo)
+	userWalletService := service.NewUserWalletService(walletTransactionUseCase, referralUsecase)
+	transactionService := service.NewTransactionService(walletTransactionUseCase, referralUsecase, icoRefundUsecase)
+	profileClient, err := client.NewProfileClient(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	icoService := service.NewICOService(icoUsecase, profileClient)
+	icoAdminUsecase := biz.NewICOAdminUsecase(icoRepo, userWalletRepo, queueJob)
+	icoAdminService := service.NewICOAdminService(icoAdminUsecase, icoUsecase, referralUsecase, icoRefundUsecase)
+	icoStatsService := service.NewICOStatsService(icoStatsUsecase)
+	webhookService := service.NewWebhookService(webhookUsecase)
+	alertService := service.NewAlertService(alertUsecase)
+	auditLogRepo := memrepo.NewAuditLogRepo(store)
+	auditUsecase := biz.NewAuditUsecase(auditLogRepo, userWalletRepo, icoCouponRepo, icoRepo)
+	auditService := service.NewAuditService(auditUsecase)
+	app := initService(logger, httpServer, grpcServer, userWalletService, transactionService, icoService, icoAdminService, icoStatsService, webhookService, alertService, auditService, auditUsecase, queueJob)
+	return app, func() {
+	}, nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/indikay/wallet-service/ent/migrate/migratedata"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+
+	"github.com/joho/godotenv"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+
+	logcore "github.com/indikay/go-core/log"
+)
+
+// go build -ldflags "-X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name string
+	// Version is the version of the compiled software.
+	Version string
+	// flagconf is the config flag.
+	flagconf string
+	// flagfile is the tokenomics definition, the one the migration applies when empty.
+	flagfile string
+
+	id, _ = os.Hostname()
+)
+
+type tokenomicsJob struct {
+	TokenomicsUc *biz.TokenomicsUsecase
+	AuditUc      *biz.AuditUsecase
+}
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&flagfile, "file", "", "tokenomics definition, eg: -file tokenomics.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] [-file tokenomics.yaml] validate|diff|apply\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	godotenv.Load()
+}
+
+func main() {
+	flag.Parse()
+	command := flag.Arg(0)
+	if command != "validate" && command != "diff" && command != "apply" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	source := migratedata.Tokenomics
+	if len(flagfile) > 0 {
+		var err error
+		if source, err = os.ReadFile(flagfile); err != nil {
+			panic(err)
+		}
+	}
+	def, err := biz.ParseTokenomics(source)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if command == "validate" {
+		fmt.Printf("tokenomics %s is valid, checksum %s\n", def.Version, def.Checksum())
+		return
+	}
+
+	c := config.New(
+		config.WithSource(
+			env.NewSource("IND_"),
+			file.NewSource(flagconf),
+		),
+		config.WithResolver(CustomResolver),
+	)
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	log.DefaultLogger = log.With(logcore.LogrusConfig(bc.Server.Log),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", Name,
+		"service.version", Version,
+		"trace_id", tracing.TraceID(),
+		"span_id", tracing.SpanID(),
+	)
+	job, cleanup, err := initApp(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	var plan *biz.TokenomicPlan
+	if command == "diff" {
+		plan, err = job.TokenomicsUc.Plan(context.Background(), def)
+	} else {
+		err = job.AuditUc.RecordJob(context.Background(), "cmd/tokenomics", constant.SERVICE_NAME, "tokenomics/Apply", func(ctx context.Context) (string, error) {
+			plan, err = job.TokenomicsUc.Apply(ctx, def)
+			if err != nil || plan.Applied {
+				return "", err
+			}
+			after, err := json.Marshal(map[string]interface{}{"version": plan.Version, "checksum": plan.Checksum, "changes": plan.Changes})
+			return string(after), err
+		})
+	}
+	if plan != nil {
+		printPlan(plan)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		cleanup()
+		os.Exit(1)
+	}
+}
+
+func printPlan(plan *biz.TokenomicPlan) {
+	if plan.Applied {
+		fmt.Printf("tokenomics %s is applied\n", plan.Version)
+		return
+	}
+	fmt.Printf("tokenomics %s, checksum %s: %d changes, %d conflicts\n", plan.Version, plan.Checksum, len(plan.Changes), len(plan.Conflicts))
+	for _, c := range plan.Changes {
+		switch {
+		case len(c.Before) > 0 && len(c.After) > 0:
+			fmt.Printf("  %-8s %s: %s -> %s\n", c.Action, c.Target, c.Before, c.After)
+		case len(c.After) > 0:
+			fmt.Printf("  %-8s %s: %s\n", c.Action, c.Target, c.After)
+		default:
+			fmt.Printf("  %-8s %s: %s\n", c.Action, c.Target, c.Before)
+		}
+	}
+	for _, c := range plan.Conflicts {
+		fmt.Printf("  CONFLICT %s\n", c)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"github.com/google/wire"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/data"
+)
+
+// initApp init kratos application.
+func initApp(*conf.Data) (*tokenomicsJob, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet, wire.Struct(new(tokenomicsJob), "*")))
+}
@@ -0,0 +1,38 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/data"
+)
+
+// Injectors from wire.go:
+
+// initApp init kratos application.
+func initApp(confData *conf.Data) (*tokenomicsJob, func(), error) {
+	dataData, cleanup, err := data.NewData(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenomicsRepo := data.NewTokenomicsRepo(dataData)
+	icoRepo := data.NewIcoRepo(dataData)
+	userWalletRepo := data.NewWalletRepo(dataData)
+	transactionRepo := data.NewTransactionRepo(dataData)
+	tokenomicsUsecase := biz.NewTokenomicsUsecase(tokenomicsRepo, icoRepo, userWalletRepo, transactionRepo)
+	auditLogRepo := data.NewAuditLogRepo(dataData)
+	icoCouponRepo := data.NewIcoCouponRepo(dataData)
+	auditUsecase := biz.NewAuditUsecase(auditLogRepo, userWalletRepo, icoCouponRepo, icoRepo)
+	mainTokenomicsJob := &tokenomicsJob{
+		TokenomicsUc: tokenomicsUsecase,
+		AuditUc:      auditUsecase,
+	}
+	return mainTokenomicsJob, func() {
+		cleanup()
+	}, nil
+}
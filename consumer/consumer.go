@@ -0,0 +1,133 @@
+// Package consumer decodes the events wallet-service publishes on NATS.
+//
+// Every message is an api/event/v1 Envelope encoded as protobuf. Check the
+// envelope type and version before unpacking the payload, and use Envelope.Id
+// to drop redeliveries: it is stable for a given transaction.
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	eventv1 "github.com/indikay/wallet-service/api/event/v1"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	EventSource     = "wallet-service"
+	SpecVersion     = "1.0"
+	DataContentType = "application/protobuf"
+
+	TransactionCreatedType    = "wallet.transaction.created"
+	TransactionCreatedVersion = "v1"
+
+	AlertTriggeredType    = "wallet.alert.triggered"
+	AlertTriggeredVersion = "v1"
+)
+
+var (
+	ErrUnknownType        = errors.New("unknown event type")
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+)
+
+// Decode parses a raw NATS payload into its envelope.
+func Decode(data []byte) (*eventv1.Envelope, error) {
+	envelope := &eventv1.Envelope{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+	if envelope.SpecVersion != SpecVersion || envelope.DataContentType != DataContentType {
+		return nil, fmt.Errorf("unexpected envelope %s/%s", envelope.SpecVersion, envelope.DataContentType)
+	}
+	return envelope, nil
+}
+
+// DecodeTransactionCreated parses a "wallet.transaction.created" event.
+func DecodeTransactionCreated(data []byte) (*eventv1.Envelope, *eventv1.TransactionCreated, error) {
+	envelope, err := Decode(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	event, err := TransactionCreated(envelope)
+	if err != nil {
+		return envelope, nil, err
+	}
+	return envelope, event, nil
+}
+
+// TransactionCreated unpacks the payload of an already decoded envelope.
+func TransactionCreated(envelope *eventv1.Envelope) (*eventv1.TransactionCreated, error) {
+	if envelope.Type != TransactionCreatedType {
+		return nil, ErrUnknownType
+	}
+	if envelope.Version != TransactionCreatedVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	event := &eventv1.TransactionCreated{}
+	if err := envelope.Data.UnmarshalTo(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// AlertTriggered unpacks the payload of an alert envelope received on the
+// notification topic.
+func AlertTriggered(envelope *eventv1.Envelope) (*eventv1.AlertTriggered, error) {
+	if envelope.Type != AlertTriggeredType {
+		return nil, ErrUnknownType
+	}
+	if envelope.Version != AlertTriggeredVersion {
+		return nil, ErrUnsupportedVersion
+	}
+
+	event := &eventv1.AlertTriggered{}
+	if err := envelope.Data.UnmarshalTo(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+type TransactionHandler func(ctx context.Context, envelope *eventv1.Envelope, event *eventv1.TransactionCreated) error
+
+// SubscribeTransactions calls handler for every transaction event on subject.
+// Messages of other types or versions are logged and skipped.
+func SubscribeTransactions(nc *nats.Conn, subject string, handler TransactionHandler) (*nats.Subscription, error) {
+	logger := log.NewHelper(log.DefaultLogger)
+	return nc.Subscribe(subject, func(msg *nats.Msg) {
+		envelope, event, err := DecodeTransactionCreated(msg.Data)
+		if err != nil {
+			logger.Warnf("SubscribeTransactions skip message: %v", err)
+			return
+		}
+		if err := handler(context.Background(), envelope, event); err != nil {
+			logger.Errorf("SubscribeTransactions handle %s: %v", envelope.Id, err)
+		}
+	})
+}
+
+type AlertHandler func(ctx context.Context, envelope *eventv1.Envelope, event *eventv1.AlertTriggered) error
+
+// SubscribeAlerts calls handler for every alert event on subject.
+// Messages of other types or versions are logged and skipped.
+func SubscribeAlerts(nc *nats.Conn, subject string, handler AlertHandler) (*nats.Subscription, error) {
+	logger := log.NewHelper(log.DefaultLogger)
+	return nc.Subscribe(subject, func(msg *nats.Msg) {
+		envelope, err := Decode(msg.Data)
+		if err != nil {
+			logger.Warnf("SubscribeAlerts skip message: %v", err)
+			return
+		}
+		event, err := AlertTriggered(envelope)
+		if err != nil {
+			logger.Warnf("SubscribeAlerts skip message %s: %v", envelope.Id, err)
+			return
+		}
+		if err := handler(context.Background(), envelope, event); err != nil {
+			logger.Errorf("SubscribeAlerts handle %s: %v", envelope.Id, err)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/rs/xid"
+)
+
+// AlertRule is the model entity for the AlertRule schema.
+type AlertRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold    string `json:"threshold,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldUserID, alertrule.FieldType, alertrule.FieldSymbol, alertrule.FieldThreshold:
+			values[i] = new(sql.NullString)
+		case alertrule.FieldCreatedAt, alertrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case alertrule.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertRule fields.
+func (ar *AlertRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertrule.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ar.ID = *value
+			}
+		case alertrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case alertrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ar.UpdatedAt = value.Time
+			}
+		case alertrule.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ar.UserID = value.String
+			}
+		case alertrule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ar.Type = value.String
+			}
+		case alertrule.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				ar.Symbol = value.String
+			}
+		case alertrule.FieldThreshold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				ar.Threshold = value.String
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AlertRule.
+// This includes values selected through modifiers, order, etc.
+func (ar *AlertRule) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AlertRule.
+// Note that you need to call AlertRule.Unwrap() before calling this method if this AlertRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AlertRule) Update() *AlertRuleUpdateOne {
+	return NewAlertRuleClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AlertRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AlertRule) Unwrap() *AlertRule {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertRule is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AlertRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ar.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ar.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(ar.Type)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(ar.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(ar.Threshold)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertRules is a parsable slice of AlertRule.
+type AlertRules []*AlertRule
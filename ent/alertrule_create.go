@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/rs/xid"
+)
+
+// AlertRuleCreate is the builder for creating a AlertRule entity.
+type AlertRuleCreate struct {
+	config
+	mutation *AlertRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AlertRuleCreate) SetCreatedAt(t time.Time) *AlertRuleCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableCreatedAt(t *time.Time) *AlertRuleCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (arc *AlertRuleCreate) SetUpdatedAt(t time.Time) *AlertRuleCreate {
+	arc.mutation.SetUpdatedAt(t)
+	return arc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableUpdatedAt(t *time.Time) *AlertRuleCreate {
+	if t != nil {
+		arc.SetUpdatedAt(*t)
+	}
+	return arc
+}
+
+// SetUserID sets the "user_id" field.
+func (arc *AlertRuleCreate) SetUserID(s string) *AlertRuleCreate {
+	arc.mutation.SetUserID(s)
+	return arc
+}
+
+// SetType sets the "type" field.
+func (arc *AlertRuleCreate) SetType(s string) *AlertRuleCreate {
+	arc.mutation.SetType(s)
+	return arc
+}
+
+// SetSymbol sets the "symbol" field.
+func (arc *AlertRuleCreate) SetSymbol(s string) *AlertRuleCreate {
+	arc.mutation.SetSymbol(s)
+	return arc
+}
+
+// SetThreshold sets the "threshold" field.
+func (arc *AlertRuleCreate) SetThreshold(s string) *AlertRuleCreate {
+	arc.mutation.SetThreshold(s)
+	return arc
+}
+
+// SetID sets the "id" field.
+func (arc *AlertRuleCreate) SetID(x xid.ID) *AlertRuleCreate {
+	arc.mutation.SetID(x)
+	return arc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableID(x *xid.ID) *AlertRuleCreate {
+	if x != nil {
+		arc.SetID(*x)
+	}
+	return arc
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (arc *AlertRuleCreate) Mutation() *AlertRuleMutation {
+	return arc.mutation
+}
+
+// Save creates the AlertRule in the database.
+func (arc *AlertRuleCreate) Save(ctx context.Context) (*AlertRule, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AlertRuleCreate) SaveX(ctx context.Context) *AlertRule {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AlertRuleCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AlertRuleCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AlertRuleCreate) defaults() {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := alertrule.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		v := alertrule.DefaultUpdatedAt()
+		arc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := arc.mutation.ID(); !ok {
+		v := alertrule.DefaultID()
+		arc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AlertRuleCreate) check() error {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertRule.created_at"`)}
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertRule.updated_at"`)}
+	}
+	if _, ok := arc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AlertRule.user_id"`)}
+	}
+	if _, ok := arc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AlertRule.type"`)}
+	}
+	if _, ok := arc.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "AlertRule.symbol"`)}
+	}
+	if _, ok := arc.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "AlertRule.threshold"`)}
+	}
+	return nil
+}
+
+func (arc *AlertRuleCreate) sqlSave(ctx context.Context) (*AlertRule, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AlertRuleCreate) createSpec() (*AlertRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertRule{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(alertrule.Table, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = arc.conflict
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(alertrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := arc.mutation.UserID(); ok {
+		_spec.SetField(alertrule.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := arc.mutation.GetType(); ok {
+		_spec.SetField(alertrule.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := arc.mutation.Symbol(); ok {
+		_spec.SetField(alertrule.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := arc.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeString, value)
+		_node.Threshold = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AlertRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *AlertRuleCreate) OnConflict(opts ...sql.ConflictOption) *AlertRuleUpsertOne {
+	arc.conflict = opts
+	return &AlertRuleUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *AlertRuleCreate) OnConflictColumns(columns ...string) *AlertRuleUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &AlertRuleUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// AlertRuleUpsertOne is the builder for "upsert"-ing
+	//  one AlertRule node.
+	AlertRuleUpsertOne struct {
+		create *AlertRuleCreate
+	}
+
+	// AlertRuleUpsert is the "OnConflict" setter.
+	AlertRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertRuleUpsert) SetUpdatedAt(v time.Time) *AlertRuleUpsert {
+	u.Set(alertrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertRuleUpsert) UpdateUpdatedAt() *AlertRuleUpsert {
+	u.SetExcluded(alertrule.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AlertRuleUpsert) SetUserID(v string) *AlertRuleUpsert {
+	u.Set(alertrule.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertRuleUpsert) UpdateUserID() *AlertRuleUpsert {
+	u.SetExcluded(alertrule.FieldUserID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *AlertRuleUpsert) SetType(v string) *AlertRuleUpsert {
+	u.Set(alertrule.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AlertRuleUpsert) UpdateType() *AlertRuleUpsert {
+	u.SetExcluded(alertrule.FieldType)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *AlertRuleUpsert) SetSymbol(v string) *AlertRuleUpsert {
+	u.Set(alertrule.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *AlertRuleUpsert) UpdateSymbol() *AlertRuleUpsert {
+	u.SetExcluded(alertrule.FieldSymbol)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AlertRuleUpsert) SetThreshold(v string) *AlertRuleUpsert {
+	u.Set(alertrule.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AlertRuleUpsert) UpdateThreshold() *AlertRuleUpsert {
+	u.SetExcluded(alertrule.FieldThreshold)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alertrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertRuleUpsertOne) UpdateNewValues() *AlertRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(alertrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(alertrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AlertRuleUpsertOne) Ignore() *AlertRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertRuleUpsertOne) DoNothing() *AlertRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertRuleCreate.OnConflict
+// documentation for more info.
+func (u *AlertRuleUpsertOne) Update(set func(*AlertRuleUpsert)) *AlertRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertRuleUpsertOne) SetUpdatedAt(v time.Time) *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertRuleUpsertOne) UpdateUpdatedAt() *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AlertRuleUpsertOne) SetUserID(v string) *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertRuleUpsertOne) UpdateUserID() *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *AlertRuleUpsertOne) SetType(v string) *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AlertRuleUpsertOne) UpdateType() *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *AlertRuleUpsertOne) SetSymbol(v string) *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *AlertRuleUpsertOne) UpdateSymbol() *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AlertRuleUpsertOne) SetThreshold(v string) *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AlertRuleUpsertOne) UpdateThreshold() *AlertRuleUpsertOne {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AlertRuleUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AlertRuleUpsertOne.ID is not supported by MySQL driver. Use AlertRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AlertRuleUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AlertRuleCreateBulk is the builder for creating many AlertRule entities in bulk.
+type AlertRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AlertRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AlertRule entities in the database.
+func (arcb *AlertRuleCreateBulk) Save(ctx context.Context) ([]*AlertRule, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AlertRule, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AlertRuleCreateBulk) SaveX(ctx context.Context) []*AlertRule {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AlertRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AlertRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AlertRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AlertRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *AlertRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *AlertRuleUpsertBulk {
+	arcb.conflict = opts
+	return &AlertRuleUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *AlertRuleCreateBulk) OnConflictColumns(columns ...string) *AlertRuleUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &AlertRuleUpsertBulk{
+		create: arcb,
+	}
+}
+
+// AlertRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of AlertRule nodes.
+type AlertRuleUpsertBulk struct {
+	create *AlertRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alertrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AlertRuleUpsertBulk) UpdateNewValues() *AlertRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(alertrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(alertrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AlertRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AlertRuleUpsertBulk) Ignore() *AlertRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AlertRuleUpsertBulk) DoNothing() *AlertRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AlertRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *AlertRuleUpsertBulk) Update(set func(*AlertRuleUpsert)) *AlertRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AlertRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AlertRuleUpsertBulk) SetUpdatedAt(v time.Time) *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AlertRuleUpsertBulk) UpdateUpdatedAt() *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AlertRuleUpsertBulk) SetUserID(v string) *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AlertRuleUpsertBulk) UpdateUserID() *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *AlertRuleUpsertBulk) SetType(v string) *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AlertRuleUpsertBulk) UpdateType() *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *AlertRuleUpsertBulk) SetSymbol(v string) *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *AlertRuleUpsertBulk) UpdateSymbol() *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *AlertRuleUpsertBulk) SetThreshold(v string) *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *AlertRuleUpsertBulk) UpdateThreshold() *AlertRuleUpsertBulk {
+	return u.Update(func(s *AlertRuleUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *AlertRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AlertRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AlertRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AlertRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// AlertRuleUpdate is the builder for updating AlertRule entities.
+type AlertRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AlertRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (aru *AlertRuleUpdate) Where(ps ...predicate.AlertRule) *AlertRuleUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aru *AlertRuleUpdate) SetUpdatedAt(t time.Time) *AlertRuleUpdate {
+	aru.mutation.SetUpdatedAt(t)
+	return aru
+}
+
+// SetUserID sets the "user_id" field.
+func (aru *AlertRuleUpdate) SetUserID(s string) *AlertRuleUpdate {
+	aru.mutation.SetUserID(s)
+	return aru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableUserID(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetUserID(*s)
+	}
+	return aru
+}
+
+// SetType sets the "type" field.
+func (aru *AlertRuleUpdate) SetType(s string) *AlertRuleUpdate {
+	aru.mutation.SetType(s)
+	return aru
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableType(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetType(*s)
+	}
+	return aru
+}
+
+// SetSymbol sets the "symbol" field.
+func (aru *AlertRuleUpdate) SetSymbol(s string) *AlertRuleUpdate {
+	aru.mutation.SetSymbol(s)
+	return aru
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableSymbol(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetSymbol(*s)
+	}
+	return aru
+}
+
+// SetThreshold sets the "threshold" field.
+func (aru *AlertRuleUpdate) SetThreshold(s string) *AlertRuleUpdate {
+	aru.mutation.SetThreshold(s)
+	return aru
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableThreshold(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetThreshold(*s)
+	}
+	return aru
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (aru *AlertRuleUpdate) Mutation() *AlertRuleMutation {
+	return aru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AlertRuleUpdate) Save(ctx context.Context) (int, error) {
+	aru.defaults()
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AlertRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AlertRuleUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AlertRuleUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aru *AlertRuleUpdate) defaults() {
+	if _, ok := aru.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		aru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aru *AlertRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertRuleUpdate {
+	aru.modifiers = append(aru.modifiers, modifiers...)
+	return aru
+}
+
+func (aru *AlertRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aru.mutation.UserID(); ok {
+		_spec.SetField(alertrule.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.GetType(); ok {
+		_spec.SetField(alertrule.FieldType, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.Symbol(); ok {
+		_spec.SetField(alertrule.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeString, value)
+	}
+	_spec.AddModifiers(aru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AlertRuleUpdateOne is the builder for updating a single AlertRule entity.
+type AlertRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AlertRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aruo *AlertRuleUpdateOne) SetUpdatedAt(t time.Time) *AlertRuleUpdateOne {
+	aruo.mutation.SetUpdatedAt(t)
+	return aruo
+}
+
+// SetUserID sets the "user_id" field.
+func (aruo *AlertRuleUpdateOne) SetUserID(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetUserID(s)
+	return aruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableUserID(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetUserID(*s)
+	}
+	return aruo
+}
+
+// SetType sets the "type" field.
+func (aruo *AlertRuleUpdateOne) SetType(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetType(s)
+	return aruo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableType(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetType(*s)
+	}
+	return aruo
+}
+
+// SetSymbol sets the "symbol" field.
+func (aruo *AlertRuleUpdateOne) SetSymbol(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetSymbol(s)
+	return aruo
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableSymbol(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetSymbol(*s)
+	}
+	return aruo
+}
+
+// SetThreshold sets the "threshold" field.
+func (aruo *AlertRuleUpdateOne) SetThreshold(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetThreshold(s)
+	return aruo
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableThreshold(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetThreshold(*s)
+	}
+	return aruo
+}
+
+// Mutation returns the AlertRuleMutation object of the builder.
+func (aruo *AlertRuleUpdateOne) Mutation() *AlertRuleMutation {
+	return aruo.mutation
+}
+
+// Where appends a list predicates to the AlertRuleUpdate builder.
+func (aruo *AlertRuleUpdateOne) Where(ps ...predicate.AlertRule) *AlertRuleUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AlertRuleUpdateOne) Select(field string, fields ...string) *AlertRuleUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AlertRule entity.
+func (aruo *AlertRuleUpdateOne) Save(ctx context.Context) (*AlertRule, error) {
+	aruo.defaults()
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AlertRuleUpdateOne) SaveX(ctx context.Context) *AlertRule {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AlertRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AlertRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aruo *AlertRuleUpdateOne) defaults() {
+	if _, ok := aruo.mutation.UpdatedAt(); !ok {
+		v := alertrule.UpdateDefaultUpdatedAt()
+		aruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aruo *AlertRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertRuleUpdateOne {
+	aruo.modifiers = append(aruo.modifiers, modifiers...)
+	return aruo
+}
+
+func (aruo *AlertRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(alertrule.Table, alertrule.Columns, sqlgraph.NewFieldSpec(alertrule.FieldID, field.TypeString))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertrule.FieldID)
+		for _, f := range fields {
+			if !alertrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aruo.mutation.UserID(); ok {
+		_spec.SetField(alertrule.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.GetType(); ok {
+		_spec.SetField(alertrule.FieldType, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.Symbol(); ok {
+		_spec.SetField(alertrule.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.Threshold(); ok {
+		_spec.SetField(alertrule.FieldThreshold, field.TypeString, value)
+	}
+	_spec.AddModifiers(aruo.modifiers...)
+	_node = &AlertRule{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
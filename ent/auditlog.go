@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/auditlog"
+	"github.com/rs/xid"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Service holds the value of the "service" field.
+	Service string `json:"service,omitempty"`
+	// Roles holds the value of the "roles" field.
+	Roles []string `json:"roles,omitempty"`
+	// RPC holds the value of the "rpc" field.
+	RPC string `json:"rpc,omitempty"`
+	// TargetUserID holds the value of the "target_user_id" field.
+	TargetUserID string `json:"target_user_id,omitempty"`
+	// PayloadHash holds the value of the "payload_hash" field.
+	PayloadHash string `json:"payload_hash,omitempty"`
+	// Before holds the value of the "before" field.
+	Before string `json:"before,omitempty"`
+	// After holds the value of the "after" field.
+	After string `json:"after,omitempty"`
+	// Outcome holds the value of the "outcome" field.
+	Outcome string `json:"outcome,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldRoles:
+			values[i] = new([]byte)
+		case auditlog.FieldActor, auditlog.FieldService, auditlog.FieldRPC, auditlog.FieldTargetUserID, auditlog.FieldPayloadHash, auditlog.FieldBefore, auditlog.FieldAfter, auditlog.FieldOutcome, auditlog.FieldError:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case auditlog.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				al.ID = *value
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		case auditlog.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				al.Actor = value.String
+			}
+		case auditlog.FieldService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service", values[i])
+			} else if value.Valid {
+				al.Service = value.String
+			}
+		case auditlog.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &al.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		case auditlog.FieldRPC:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rpc", values[i])
+			} else if value.Valid {
+				al.RPC = value.String
+			}
+		case auditlog.FieldTargetUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_id", values[i])
+			} else if value.Valid {
+				al.TargetUserID = value.String
+			}
+		case auditlog.FieldPayloadHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload_hash", values[i])
+			} else if value.Valid {
+				al.PayloadHash = value.String
+			}
+		case auditlog.FieldBefore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value.Valid {
+				al.Before = value.String
+			}
+		case auditlog.FieldAfter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value.Valid {
+				al.After = value.String
+			}
+		case auditlog.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				al.Outcome = value.String
+			}
+		case auditlog.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				al.Error = value.String
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(al.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("service=")
+	builder.WriteString(al.Service)
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", al.Roles))
+	builder.WriteString(", ")
+	builder.WriteString("rpc=")
+	builder.WriteString(al.RPC)
+	builder.WriteString(", ")
+	builder.WriteString("target_user_id=")
+	builder.WriteString(al.TargetUserID)
+	builder.WriteString(", ")
+	builder.WriteString("payload_hash=")
+	builder.WriteString(al.PayloadHash)
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(al.Before)
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(al.After)
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(al.Outcome)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(al.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
@@ -0,0 +1,1168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/auditlog"
+	"github.com/rs/xid"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetActor sets the "actor" field.
+func (alc *AuditLogCreate) SetActor(s string) *AuditLogCreate {
+	alc.mutation.SetActor(s)
+	return alc
+}
+
+// SetService sets the "service" field.
+func (alc *AuditLogCreate) SetService(s string) *AuditLogCreate {
+	alc.mutation.SetService(s)
+	return alc
+}
+
+// SetRoles sets the "roles" field.
+func (alc *AuditLogCreate) SetRoles(s []string) *AuditLogCreate {
+	alc.mutation.SetRoles(s)
+	return alc
+}
+
+// SetRPC sets the "rpc" field.
+func (alc *AuditLogCreate) SetRPC(s string) *AuditLogCreate {
+	alc.mutation.SetRPC(s)
+	return alc
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (alc *AuditLogCreate) SetTargetUserID(s string) *AuditLogCreate {
+	alc.mutation.SetTargetUserID(s)
+	return alc
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTargetUserID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTargetUserID(*s)
+	}
+	return alc
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (alc *AuditLogCreate) SetPayloadHash(s string) *AuditLogCreate {
+	alc.mutation.SetPayloadHash(s)
+	return alc
+}
+
+// SetNillablePayloadHash sets the "payload_hash" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePayloadHash(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPayloadHash(*s)
+	}
+	return alc
+}
+
+// SetBefore sets the "before" field.
+func (alc *AuditLogCreate) SetBefore(s string) *AuditLogCreate {
+	alc.mutation.SetBefore(s)
+	return alc
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableBefore(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetBefore(*s)
+	}
+	return alc
+}
+
+// SetAfter sets the "after" field.
+func (alc *AuditLogCreate) SetAfter(s string) *AuditLogCreate {
+	alc.mutation.SetAfter(s)
+	return alc
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableAfter(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetAfter(*s)
+	}
+	return alc
+}
+
+// SetOutcome sets the "outcome" field.
+func (alc *AuditLogCreate) SetOutcome(s string) *AuditLogCreate {
+	alc.mutation.SetOutcome(s)
+	return alc
+}
+
+// SetError sets the "error" field.
+func (alc *AuditLogCreate) SetError(s string) *AuditLogCreate {
+	alc.mutation.SetError(s)
+	return alc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableError(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetError(*s)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(x xid.ID) *AuditLogCreate {
+	alc.mutation.SetID(x)
+	return alc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableID(x *xid.ID) *AuditLogCreate {
+	if x != nil {
+		alc.SetID(*x)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		if auditlog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := alc.mutation.ID(); !ok {
+		if auditlog.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := auditlog.DefaultID()
+		alc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	if _, ok := alc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "AuditLog.actor"`)}
+	}
+	if _, ok := alc.mutation.Service(); !ok {
+		return &ValidationError{Name: "service", err: errors.New(`ent: missing required field "AuditLog.service"`)}
+	}
+	if _, ok := alc.mutation.RPC(); !ok {
+		return &ValidationError{Name: "rpc", err: errors.New(`ent: missing required field "AuditLog.rpc"`)}
+	}
+	if _, ok := alc.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "AuditLog.outcome"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := alc.mutation.Actor(); ok {
+		_spec.SetField(auditlog.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := alc.mutation.Service(); ok {
+		_spec.SetField(auditlog.FieldService, field.TypeString, value)
+		_node.Service = value
+	}
+	if value, ok := alc.mutation.Roles(); ok {
+		_spec.SetField(auditlog.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := alc.mutation.RPC(); ok {
+		_spec.SetField(auditlog.FieldRPC, field.TypeString, value)
+		_node.RPC = value
+	}
+	if value, ok := alc.mutation.TargetUserID(); ok {
+		_spec.SetField(auditlog.FieldTargetUserID, field.TypeString, value)
+		_node.TargetUserID = value
+	}
+	if value, ok := alc.mutation.PayloadHash(); ok {
+		_spec.SetField(auditlog.FieldPayloadHash, field.TypeString, value)
+		_node.PayloadHash = value
+	}
+	if value, ok := alc.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeString, value)
+		_node.Before = value
+	}
+	if value, ok := alc.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeString, value)
+		_node.After = value
+	}
+	if value, ok := alc.mutation.Outcome(); ok {
+		_spec.SetField(auditlog.FieldOutcome, field.TypeString, value)
+		_node.Outcome = value
+	}
+	if value, ok := alc.mutation.Error(); ok {
+		_spec.SetField(auditlog.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetActor sets the "actor" field.
+func (u *AuditLogUpsert) SetActor(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldActor, v)
+	return u
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateActor() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldActor)
+	return u
+}
+
+// SetService sets the "service" field.
+func (u *AuditLogUpsert) SetService(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldService, v)
+	return u
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateService() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldService)
+	return u
+}
+
+// SetRoles sets the "roles" field.
+func (u *AuditLogUpsert) SetRoles(v []string) *AuditLogUpsert {
+	u.Set(auditlog.FieldRoles, v)
+	return u
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRoles() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRoles)
+	return u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *AuditLogUpsert) ClearRoles() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldRoles)
+	return u
+}
+
+// SetRPC sets the "rpc" field.
+func (u *AuditLogUpsert) SetRPC(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldRPC, v)
+	return u
+}
+
+// UpdateRPC sets the "rpc" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRPC() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRPC)
+	return u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AuditLogUpsert) SetTargetUserID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldTargetUserID, v)
+	return u
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateTargetUserID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldTargetUserID)
+	return u
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AuditLogUpsert) ClearTargetUserID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldTargetUserID)
+	return u
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (u *AuditLogUpsert) SetPayloadHash(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPayloadHash, v)
+	return u
+}
+
+// UpdatePayloadHash sets the "payload_hash" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePayloadHash() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPayloadHash)
+	return u
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (u *AuditLogUpsert) ClearPayloadHash() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPayloadHash)
+	return u
+}
+
+// SetBefore sets the "before" field.
+func (u *AuditLogUpsert) SetBefore(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldBefore, v)
+	return u
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateBefore() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldBefore)
+	return u
+}
+
+// ClearBefore clears the value of the "before" field.
+func (u *AuditLogUpsert) ClearBefore() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldBefore)
+	return u
+}
+
+// SetAfter sets the "after" field.
+func (u *AuditLogUpsert) SetAfter(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldAfter, v)
+	return u
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateAfter() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldAfter)
+	return u
+}
+
+// ClearAfter clears the value of the "after" field.
+func (u *AuditLogUpsert) ClearAfter() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldAfter)
+	return u
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *AuditLogUpsert) SetOutcome(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldOutcome, v)
+	return u
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateOutcome() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldOutcome)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *AuditLogUpsert) SetError(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateError() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AuditLogUpsert) ClearError() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActor sets the "actor" field.
+func (u *AuditLogUpsertOne) SetActor(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateActor() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetService sets the "service" field.
+func (u *AuditLogUpsertOne) SetService(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetService(v)
+	})
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateService() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateService()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *AuditLogUpsertOne) SetRoles(v []string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRoles() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *AuditLogUpsertOne) ClearRoles() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// SetRPC sets the "rpc" field.
+func (u *AuditLogUpsertOne) SetRPC(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRPC(v)
+	})
+}
+
+// UpdateRPC sets the "rpc" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRPC() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRPC()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AuditLogUpsertOne) SetTargetUserID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateTargetUserID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AuditLogUpsertOne) ClearTargetUserID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (u *AuditLogUpsertOne) SetPayloadHash(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPayloadHash(v)
+	})
+}
+
+// UpdatePayloadHash sets the "payload_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePayloadHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePayloadHash()
+	})
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (u *AuditLogUpsertOne) ClearPayloadHash() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPayloadHash()
+	})
+}
+
+// SetBefore sets the "before" field.
+func (u *AuditLogUpsertOne) SetBefore(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetBefore(v)
+	})
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateBefore() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateBefore()
+	})
+}
+
+// ClearBefore clears the value of the "before" field.
+func (u *AuditLogUpsertOne) ClearBefore() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearBefore()
+	})
+}
+
+// SetAfter sets the "after" field.
+func (u *AuditLogUpsertOne) SetAfter(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAfter(v)
+	})
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateAfter() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAfter()
+	})
+}
+
+// ClearAfter clears the value of the "after" field.
+func (u *AuditLogUpsertOne) ClearAfter() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearAfter()
+	})
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *AuditLogUpsertOne) SetOutcome(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOutcome(v)
+	})
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateOutcome() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOutcome()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *AuditLogUpsertOne) SetError(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateError() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AuditLogUpsertOne) ClearError() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AuditLogUpsertOne.ID is not supported by MySQL driver. Use AuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActor sets the "actor" field.
+func (u *AuditLogUpsertBulk) SetActor(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateActor() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetService sets the "service" field.
+func (u *AuditLogUpsertBulk) SetService(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetService(v)
+	})
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateService() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateService()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *AuditLogUpsertBulk) SetRoles(v []string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRoles() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *AuditLogUpsertBulk) ClearRoles() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// SetRPC sets the "rpc" field.
+func (u *AuditLogUpsertBulk) SetRPC(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRPC(v)
+	})
+}
+
+// UpdateRPC sets the "rpc" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRPC() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRPC()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AuditLogUpsertBulk) SetTargetUserID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateTargetUserID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AuditLogUpsertBulk) ClearTargetUserID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (u *AuditLogUpsertBulk) SetPayloadHash(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPayloadHash(v)
+	})
+}
+
+// UpdatePayloadHash sets the "payload_hash" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePayloadHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePayloadHash()
+	})
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (u *AuditLogUpsertBulk) ClearPayloadHash() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPayloadHash()
+	})
+}
+
+// SetBefore sets the "before" field.
+func (u *AuditLogUpsertBulk) SetBefore(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetBefore(v)
+	})
+}
+
+// UpdateBefore sets the "before" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateBefore() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateBefore()
+	})
+}
+
+// ClearBefore clears the value of the "before" field.
+func (u *AuditLogUpsertBulk) ClearBefore() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearBefore()
+	})
+}
+
+// SetAfter sets the "after" field.
+func (u *AuditLogUpsertBulk) SetAfter(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetAfter(v)
+	})
+}
+
+// UpdateAfter sets the "after" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateAfter() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateAfter()
+	})
+}
+
+// ClearAfter clears the value of the "after" field.
+func (u *AuditLogUpsertBulk) ClearAfter() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearAfter()
+	})
+}
+
+// SetOutcome sets the "outcome" field.
+func (u *AuditLogUpsertBulk) SetOutcome(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetOutcome(v)
+	})
+}
+
+// UpdateOutcome sets the "outcome" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateOutcome() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateOutcome()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *AuditLogUpsertBulk) SetError(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateError() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *AuditLogUpsertBulk) ClearError() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/auditlog"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetActor sets the "actor" field.
+func (alu *AuditLogUpdate) SetActor(s string) *AuditLogUpdate {
+	alu.mutation.SetActor(s)
+	return alu
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActor(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetActor(*s)
+	}
+	return alu
+}
+
+// SetService sets the "service" field.
+func (alu *AuditLogUpdate) SetService(s string) *AuditLogUpdate {
+	alu.mutation.SetService(s)
+	return alu
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableService(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetService(*s)
+	}
+	return alu
+}
+
+// SetRoles sets the "roles" field.
+func (alu *AuditLogUpdate) SetRoles(s []string) *AuditLogUpdate {
+	alu.mutation.SetRoles(s)
+	return alu
+}
+
+// AppendRoles appends s to the "roles" field.
+func (alu *AuditLogUpdate) AppendRoles(s []string) *AuditLogUpdate {
+	alu.mutation.AppendRoles(s)
+	return alu
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (alu *AuditLogUpdate) ClearRoles() *AuditLogUpdate {
+	alu.mutation.ClearRoles()
+	return alu
+}
+
+// SetRPC sets the "rpc" field.
+func (alu *AuditLogUpdate) SetRPC(s string) *AuditLogUpdate {
+	alu.mutation.SetRPC(s)
+	return alu
+}
+
+// SetNillableRPC sets the "rpc" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRPC(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetRPC(*s)
+	}
+	return alu
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (alu *AuditLogUpdate) SetTargetUserID(s string) *AuditLogUpdate {
+	alu.mutation.SetTargetUserID(s)
+	return alu
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTargetUserID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTargetUserID(*s)
+	}
+	return alu
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (alu *AuditLogUpdate) ClearTargetUserID() *AuditLogUpdate {
+	alu.mutation.ClearTargetUserID()
+	return alu
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (alu *AuditLogUpdate) SetPayloadHash(s string) *AuditLogUpdate {
+	alu.mutation.SetPayloadHash(s)
+	return alu
+}
+
+// SetNillablePayloadHash sets the "payload_hash" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePayloadHash(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPayloadHash(*s)
+	}
+	return alu
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (alu *AuditLogUpdate) ClearPayloadHash() *AuditLogUpdate {
+	alu.mutation.ClearPayloadHash()
+	return alu
+}
+
+// SetBefore sets the "before" field.
+func (alu *AuditLogUpdate) SetBefore(s string) *AuditLogUpdate {
+	alu.mutation.SetBefore(s)
+	return alu
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableBefore(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetBefore(*s)
+	}
+	return alu
+}
+
+// ClearBefore clears the value of the "before" field.
+func (alu *AuditLogUpdate) ClearBefore() *AuditLogUpdate {
+	alu.mutation.ClearBefore()
+	return alu
+}
+
+// SetAfter sets the "after" field.
+func (alu *AuditLogUpdate) SetAfter(s string) *AuditLogUpdate {
+	alu.mutation.SetAfter(s)
+	return alu
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAfter(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAfter(*s)
+	}
+	return alu
+}
+
+// ClearAfter clears the value of the "after" field.
+func (alu *AuditLogUpdate) ClearAfter() *AuditLogUpdate {
+	alu.mutation.ClearAfter()
+	return alu
+}
+
+// SetOutcome sets the "outcome" field.
+func (alu *AuditLogUpdate) SetOutcome(s string) *AuditLogUpdate {
+	alu.mutation.SetOutcome(s)
+	return alu
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableOutcome(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetOutcome(*s)
+	}
+	return alu
+}
+
+// SetError sets the "error" field.
+func (alu *AuditLogUpdate) SetError(s string) *AuditLogUpdate {
+	alu.mutation.SetError(s)
+	return alu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableError(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetError(*s)
+	}
+	return alu
+}
+
+// ClearError clears the value of the "error" field.
+func (alu *AuditLogUpdate) ClearError() *AuditLogUpdate {
+	alu.mutation.ClearError()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (alu *AuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdate {
+	alu.modifiers = append(alu.modifiers, modifiers...)
+	return alu
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.Actor(); ok {
+		_spec.SetField(auditlog.FieldActor, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Service(); ok {
+		_spec.SetField(auditlog.FieldService, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Roles(); ok {
+		_spec.SetField(auditlog.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := alu.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldRoles, value)
+		})
+	}
+	if alu.mutation.RolesCleared() {
+		_spec.ClearField(auditlog.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := alu.mutation.RPC(); ok {
+		_spec.SetField(auditlog.FieldRPC, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.TargetUserID(); ok {
+		_spec.SetField(auditlog.FieldTargetUserID, field.TypeString, value)
+	}
+	if alu.mutation.TargetUserIDCleared() {
+		_spec.ClearField(auditlog.FieldTargetUserID, field.TypeString)
+	}
+	if value, ok := alu.mutation.PayloadHash(); ok {
+		_spec.SetField(auditlog.FieldPayloadHash, field.TypeString, value)
+	}
+	if alu.mutation.PayloadHashCleared() {
+		_spec.ClearField(auditlog.FieldPayloadHash, field.TypeString)
+	}
+	if value, ok := alu.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeString, value)
+	}
+	if alu.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeString)
+	}
+	if value, ok := alu.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeString, value)
+	}
+	if alu.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeString)
+	}
+	if value, ok := alu.mutation.Outcome(); ok {
+		_spec.SetField(auditlog.FieldOutcome, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Error(); ok {
+		_spec.SetField(auditlog.FieldError, field.TypeString, value)
+	}
+	if alu.mutation.ErrorCleared() {
+		_spec.ClearField(auditlog.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(alu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetActor sets the "actor" field.
+func (aluo *AuditLogUpdateOne) SetActor(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetActor(s)
+	return aluo
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActor(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetActor(*s)
+	}
+	return aluo
+}
+
+// SetService sets the "service" field.
+func (aluo *AuditLogUpdateOne) SetService(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetService(s)
+	return aluo
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableService(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetService(*s)
+	}
+	return aluo
+}
+
+// SetRoles sets the "roles" field.
+func (aluo *AuditLogUpdateOne) SetRoles(s []string) *AuditLogUpdateOne {
+	aluo.mutation.SetRoles(s)
+	return aluo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (aluo *AuditLogUpdateOne) AppendRoles(s []string) *AuditLogUpdateOne {
+	aluo.mutation.AppendRoles(s)
+	return aluo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (aluo *AuditLogUpdateOne) ClearRoles() *AuditLogUpdateOne {
+	aluo.mutation.ClearRoles()
+	return aluo
+}
+
+// SetRPC sets the "rpc" field.
+func (aluo *AuditLogUpdateOne) SetRPC(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetRPC(s)
+	return aluo
+}
+
+// SetNillableRPC sets the "rpc" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRPC(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetRPC(*s)
+	}
+	return aluo
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (aluo *AuditLogUpdateOne) SetTargetUserID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTargetUserID(s)
+	return aluo
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTargetUserID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTargetUserID(*s)
+	}
+	return aluo
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (aluo *AuditLogUpdateOne) ClearTargetUserID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTargetUserID()
+	return aluo
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (aluo *AuditLogUpdateOne) SetPayloadHash(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPayloadHash(s)
+	return aluo
+}
+
+// SetNillablePayloadHash sets the "payload_hash" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePayloadHash(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPayloadHash(*s)
+	}
+	return aluo
+}
+
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (aluo *AuditLogUpdateOne) ClearPayloadHash() *AuditLogUpdateOne {
+	aluo.mutation.ClearPayloadHash()
+	return aluo
+}
+
+// SetBefore sets the "before" field.
+func (aluo *AuditLogUpdateOne) SetBefore(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetBefore(s)
+	return aluo
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableBefore(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetBefore(*s)
+	}
+	return aluo
+}
+
+// ClearBefore clears the value of the "before" field.
+func (aluo *AuditLogUpdateOne) ClearBefore() *AuditLogUpdateOne {
+	aluo.mutation.ClearBefore()
+	return aluo
+}
+
+// SetAfter sets the "after" field.
+func (aluo *AuditLogUpdateOne) SetAfter(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAfter(s)
+	return aluo
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAfter(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAfter(*s)
+	}
+	return aluo
+}
+
+// ClearAfter clears the value of the "after" field.
+func (aluo *AuditLogUpdateOne) ClearAfter() *AuditLogUpdateOne {
+	aluo.mutation.ClearAfter()
+	return aluo
+}
+
+// SetOutcome sets the "outcome" field.
+func (aluo *AuditLogUpdateOne) SetOutcome(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetOutcome(s)
+	return aluo
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableOutcome(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetOutcome(*s)
+	}
+	return aluo
+}
+
+// SetError sets the "error" field.
+func (aluo *AuditLogUpdateOne) SetError(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetError(s)
+	return aluo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableError(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetError(*s)
+	}
+	return aluo
+}
+
+// ClearError clears the value of the "error" field.
+func (aluo *AuditLogUpdateOne) ClearError() *AuditLogUpdateOne {
+	aluo.mutation.ClearError()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aluo *AuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdateOne {
+	aluo.modifiers = append(aluo.modifiers, modifiers...)
+	return aluo
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.Actor(); ok {
+		_spec.SetField(auditlog.FieldActor, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Service(); ok {
+		_spec.SetField(auditlog.FieldService, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Roles(); ok {
+		_spec.SetField(auditlog.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := aluo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditlog.FieldRoles, value)
+		})
+	}
+	if aluo.mutation.RolesCleared() {
+		_spec.ClearField(auditlog.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := aluo.mutation.RPC(); ok {
+		_spec.SetField(auditlog.FieldRPC, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.TargetUserID(); ok {
+		_spec.SetField(auditlog.FieldTargetUserID, field.TypeString, value)
+	}
+	if aluo.mutation.TargetUserIDCleared() {
+		_spec.ClearField(auditlog.FieldTargetUserID, field.TypeString)
+	}
+	if value, ok := aluo.mutation.PayloadHash(); ok {
+		_spec.SetField(auditlog.FieldPayloadHash, field.TypeString, value)
+	}
+	if aluo.mutation.PayloadHashCleared() {
+		_spec.ClearField(auditlog.FieldPayloadHash, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Before(); ok {
+		_spec.SetField(auditlog.FieldBefore, field.TypeString, value)
+	}
+	if aluo.mutation.BeforeCleared() {
+		_spec.ClearField(auditlog.FieldBefore, field.TypeString)
+	}
+	if value, ok := aluo.mutation.After(); ok {
+		_spec.SetField(auditlog.FieldAfter, field.TypeString, value)
+	}
+	if aluo.mutation.AfterCleared() {
+		_spec.ClearField(auditlog.FieldAfter, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Outcome(); ok {
+		_spec.SetField(auditlog.FieldOutcome, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Error(); ok {
+		_spec.SetField(auditlog.FieldError, field.TypeString, value)
+	}
+	if aluo.mutation.ErrorCleared() {
+		_spec.ClearField(auditlog.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(aluo.modifiers...)
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
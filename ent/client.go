@@ -15,13 +15,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/ent/auditlog"
 	"github.com/indikay/wallet-service/ent/currencyrate"
 	"github.com/indikay/wallet-service/ent/ico"
 	"github.com/indikay/wallet-service/ent/icocoupon"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/icodailystat"
 	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
 	"github.com/indikay/wallet-service/ent/icoround"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/referral"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
 	"github.com/indikay/wallet-service/ent/transaction"
 	"github.com/indikay/wallet-service/ent/userwallet"
+	"github.com/indikay/wallet-service/ent/webhook"
+	"github.com/indikay/wallet-service/ent/webhookdelivery"
 
 	stdsql "database/sql"
 )
@@ -31,20 +42,42 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AlertRule is the client for interacting with the AlertRule builders.
+	AlertRule *AlertRuleClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// CurrencyRate is the client for interacting with the CurrencyRate builders.
 	CurrencyRate *CurrencyRateClient
 	// Ico is the client for interacting with the Ico builders.
 	Ico *IcoClient
 	// IcoCoupon is the client for interacting with the IcoCoupon builders.
 	IcoCoupon *IcoCouponClient
+	// IcoCouponRedemption is the client for interacting with the IcoCouponRedemption builders.
+	IcoCouponRedemption *IcoCouponRedemptionClient
+	// IcoDailyStat is the client for interacting with the IcoDailyStat builders.
+	IcoDailyStat *IcoDailyStatClient
 	// IcoHistory is the client for interacting with the IcoHistory builders.
 	IcoHistory *IcoHistoryClient
+	// IcoOutstandingRefund is the client for interacting with the IcoOutstandingRefund builders.
+	IcoOutstandingRefund *IcoOutstandingRefundClient
 	// IcoRound is the client for interacting with the IcoRound builders.
 	IcoRound *IcoRoundClient
+	// LeaderboardSnapshot is the client for interacting with the LeaderboardSnapshot builders.
+	LeaderboardSnapshot *LeaderboardSnapshotClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// ReferralCommission is the client for interacting with the ReferralCommission builders.
+	ReferralCommission *ReferralCommissionClient
+	// TokenomicVersion is the client for interacting with the TokenomicVersion builders.
+	TokenomicVersion *TokenomicVersionClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// UserWallet is the client for interacting with the UserWallet builders.
 	UserWallet *UserWalletClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -56,13 +89,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AlertRule = NewAlertRuleClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.CurrencyRate = NewCurrencyRateClient(c.config)
 	c.Ico = NewIcoClient(c.config)
 	c.IcoCoupon = NewIcoCouponClient(c.config)
+	c.IcoCouponRedemption = NewIcoCouponRedemptionClient(c.config)
+	c.IcoDailyStat = NewIcoDailyStatClient(c.config)
 	c.IcoHistory = NewIcoHistoryClient(c.config)
+	c.IcoOutstandingRefund = NewIcoOutstandingRefundClient(c.config)
 	c.IcoRound = NewIcoRoundClient(c.config)
+	c.LeaderboardSnapshot = NewLeaderboardSnapshotClient(c.config)
+	c.Referral = NewReferralClient(c.config)
+	c.ReferralCommission = NewReferralCommissionClient(c.config)
+	c.TokenomicVersion = NewTokenomicVersionClient(c.config)
 	c.Transaction = NewTransactionClient(c.config)
 	c.UserWallet = NewUserWalletClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -153,15 +197,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		CurrencyRate: NewCurrencyRateClient(cfg),
-		Ico:          NewIcoClient(cfg),
-		IcoCoupon:    NewIcoCouponClient(cfg),
-		IcoHistory:   NewIcoHistoryClient(cfg),
-		IcoRound:     NewIcoRoundClient(cfg),
-		Transaction:  NewTransactionClient(cfg),
-		UserWallet:   NewUserWalletClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AlertRule:            NewAlertRuleClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		CurrencyRate:         NewCurrencyRateClient(cfg),
+		Ico:                  NewIcoClient(cfg),
+		IcoCoupon:            NewIcoCouponClient(cfg),
+		IcoCouponRedemption:  NewIcoCouponRedemptionClient(cfg),
+		IcoDailyStat:         NewIcoDailyStatClient(cfg),
+		IcoHistory:           NewIcoHistoryClient(cfg),
+		IcoOutstandingRefund: NewIcoOutstandingRefundClient(cfg),
+		IcoRound:             NewIcoRoundClient(cfg),
+		LeaderboardSnapshot:  NewLeaderboardSnapshotClient(cfg),
+		Referral:             NewReferralClient(cfg),
+		ReferralCommission:   NewReferralCommissionClient(cfg),
+		TokenomicVersion:     NewTokenomicVersionClient(cfg),
+		Transaction:          NewTransactionClient(cfg),
+		UserWallet:           NewUserWalletClient(cfg),
+		Webhook:              NewWebhookClient(cfg),
+		WebhookDelivery:      NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -179,22 +234,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		CurrencyRate: NewCurrencyRateClient(cfg),
-		Ico:          NewIcoClient(cfg),
-		IcoCoupon:    NewIcoCouponClient(cfg),
-		IcoHistory:   NewIcoHistoryClient(cfg),
-		IcoRound:     NewIcoRoundClient(cfg),
-		Transaction:  NewTransactionClient(cfg),
-		UserWallet:   NewUserWalletClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AlertRule:            NewAlertRuleClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		CurrencyRate:         NewCurrencyRateClient(cfg),
+		Ico:                  NewIcoClient(cfg),
+		IcoCoupon:            NewIcoCouponClient(cfg),
+		IcoCouponRedemption:  NewIcoCouponRedemptionClient(cfg),
+		IcoDailyStat:         NewIcoDailyStatClient(cfg),
+		IcoHistory:           NewIcoHistoryClient(cfg),
+		IcoOutstandingRefund: NewIcoOutstandingRefundClient(cfg),
+		IcoRound:             NewIcoRoundClient(cfg),
+		LeaderboardSnapshot:  NewLeaderboardSnapshotClient(cfg),
+		Referral:             NewReferralClient(cfg),
+		ReferralCommission:   NewReferralCommissionClient(cfg),
+		TokenomicVersion:     NewTokenomicVersionClient(cfg),
+		Transaction:          NewTransactionClient(cfg),
+		UserWallet:           NewUserWalletClient(cfg),
+		Webhook:              NewWebhookClient(cfg),
+		WebhookDelivery:      NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		CurrencyRate.
+//		AlertRule.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -217,8 +283,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CurrencyRate, c.Ico, c.IcoCoupon, c.IcoHistory, c.IcoRound, c.Transaction,
-		c.UserWallet,
+		c.AlertRule, c.AuditLog, c.CurrencyRate, c.Ico, c.IcoCoupon,
+		c.IcoCouponRedemption, c.IcoDailyStat, c.IcoHistory, c.IcoOutstandingRefund,
+		c.IcoRound, c.LeaderboardSnapshot, c.Referral, c.ReferralCommission,
+		c.TokenomicVersion, c.Transaction, c.UserWallet, c.Webhook, c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -228,8 +296,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CurrencyRate, c.Ico, c.IcoCoupon, c.IcoHistory, c.IcoRound, c.Transaction,
-		c.UserWallet,
+		c.AlertRule, c.AuditLog, c.CurrencyRate, c.Ico, c.IcoCoupon,
+		c.IcoCouponRedemption, c.IcoDailyStat, c.IcoHistory, c.IcoOutstandingRefund,
+		c.IcoRound, c.LeaderboardSnapshot, c.Referral, c.ReferralCommission,
+		c.TokenomicVersion, c.Transaction, c.UserWallet, c.Webhook, c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -238,25 +308,314 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AlertRuleMutation:
+		return c.AlertRule.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *CurrencyRateMutation:
 		return c.CurrencyRate.mutate(ctx, m)
 	case *IcoMutation:
 		return c.Ico.mutate(ctx, m)
 	case *IcoCouponMutation:
 		return c.IcoCoupon.mutate(ctx, m)
+	case *IcoCouponRedemptionMutation:
+		return c.IcoCouponRedemption.mutate(ctx, m)
+	case *IcoDailyStatMutation:
+		return c.IcoDailyStat.mutate(ctx, m)
 	case *IcoHistoryMutation:
 		return c.IcoHistory.mutate(ctx, m)
+	case *IcoOutstandingRefundMutation:
+		return c.IcoOutstandingRefund.mutate(ctx, m)
 	case *IcoRoundMutation:
 		return c.IcoRound.mutate(ctx, m)
+	case *LeaderboardSnapshotMutation:
+		return c.LeaderboardSnapshot.mutate(ctx, m)
+	case *ReferralMutation:
+		return c.Referral.mutate(ctx, m)
+	case *ReferralCommissionMutation:
+		return c.ReferralCommission.mutate(ctx, m)
+	case *TokenomicVersionMutation:
+		return c.TokenomicVersion.mutate(ctx, m)
 	case *TransactionMutation:
 		return c.Transaction.mutate(ctx, m)
 	case *UserWalletMutation:
 		return c.UserWallet.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AlertRuleClient is a client for the AlertRule schema.
+type AlertRuleClient struct {
+	config
+}
+
+// NewAlertRuleClient returns a client for the AlertRule from the given config.
+func NewAlertRuleClient(c config) *AlertRuleClient {
+	return &AlertRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `alertrule.Hooks(f(g(h())))`.
+func (c *AlertRuleClient) Use(hooks ...Hook) {
+	c.hooks.AlertRule = append(c.hooks.AlertRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `alertrule.Intercept(f(g(h())))`.
+func (c *AlertRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AlertRule = append(c.inters.AlertRule, interceptors...)
+}
+
+// Create returns a builder for creating a AlertRule entity.
+func (c *AlertRuleClient) Create() *AlertRuleCreate {
+	mutation := newAlertRuleMutation(c.config, OpCreate)
+	return &AlertRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AlertRule entities.
+func (c *AlertRuleClient) CreateBulk(builders ...*AlertRuleCreate) *AlertRuleCreateBulk {
+	return &AlertRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AlertRuleClient) MapCreateBulk(slice any, setFunc func(*AlertRuleCreate, int)) *AlertRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AlertRuleCreateBulk{err: fmt.Errorf("calling to AlertRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AlertRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AlertRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AlertRule.
+func (c *AlertRuleClient) Update() *AlertRuleUpdate {
+	mutation := newAlertRuleMutation(c.config, OpUpdate)
+	return &AlertRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AlertRuleClient) UpdateOne(ar *AlertRule) *AlertRuleUpdateOne {
+	mutation := newAlertRuleMutation(c.config, OpUpdateOne, withAlertRule(ar))
+	return &AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AlertRuleClient) UpdateOneID(id xid.ID) *AlertRuleUpdateOne {
+	mutation := newAlertRuleMutation(c.config, OpUpdateOne, withAlertRuleID(id))
+	return &AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AlertRule.
+func (c *AlertRuleClient) Delete() *AlertRuleDelete {
+	mutation := newAlertRuleMutation(c.config, OpDelete)
+	return &AlertRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AlertRuleClient) DeleteOne(ar *AlertRule) *AlertRuleDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AlertRuleClient) DeleteOneID(id xid.ID) *AlertRuleDeleteOne {
+	builder := c.Delete().Where(alertrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AlertRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for AlertRule.
+func (c *AlertRuleClient) Query() *AlertRuleQuery {
+	return &AlertRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAlertRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AlertRule entity by its id.
+func (c *AlertRuleClient) Get(ctx context.Context, id xid.ID) (*AlertRule, error) {
+	return c.Query().Where(alertrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AlertRuleClient) GetX(ctx context.Context, id xid.ID) *AlertRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AlertRuleClient) Hooks() []Hook {
+	return c.hooks.AlertRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *AlertRuleClient) Interceptors() []Interceptor {
+	return c.inters.AlertRule
+}
+
+func (c *AlertRuleClient) mutate(ctx context.Context, m *AlertRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AlertRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AlertRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AlertRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AlertRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AlertRule mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id xid.ID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id xid.ID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id xid.ID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id xid.ID) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // CurrencyRateClient is a client for the CurrencyRate schema.
 type CurrencyRateClient struct {
 	config
@@ -656,107 +1015,107 @@ func (c *IcoCouponClient) mutate(ctx context.Context, m *IcoCouponMutation) (Val
 	}
 }
 
-// IcoHistoryClient is a client for the IcoHistory schema.
-type IcoHistoryClient struct {
+// IcoCouponRedemptionClient is a client for the IcoCouponRedemption schema.
+type IcoCouponRedemptionClient struct {
 	config
 }
 
-// NewIcoHistoryClient returns a client for the IcoHistory from the given config.
-func NewIcoHistoryClient(c config) *IcoHistoryClient {
-	return &IcoHistoryClient{config: c}
+// NewIcoCouponRedemptionClient returns a client for the IcoCouponRedemption from the given config.
+func NewIcoCouponRedemptionClient(c config) *IcoCouponRedemptionClient {
+	return &IcoCouponRedemptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `icohistory.Hooks(f(g(h())))`.
-func (c *IcoHistoryClient) Use(hooks ...Hook) {
-	c.hooks.IcoHistory = append(c.hooks.IcoHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `icocouponredemption.Hooks(f(g(h())))`.
+func (c *IcoCouponRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.IcoCouponRedemption = append(c.hooks.IcoCouponRedemption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `icohistory.Intercept(f(g(h())))`.
-func (c *IcoHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IcoHistory = append(c.inters.IcoHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `icocouponredemption.Intercept(f(g(h())))`.
+func (c *IcoCouponRedemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IcoCouponRedemption = append(c.inters.IcoCouponRedemption, interceptors...)
 }
 
-// Create returns a builder for creating a IcoHistory entity.
-func (c *IcoHistoryClient) Create() *IcoHistoryCreate {
-	mutation := newIcoHistoryMutation(c.config, OpCreate)
-	return &IcoHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IcoCouponRedemption entity.
+func (c *IcoCouponRedemptionClient) Create() *IcoCouponRedemptionCreate {
+	mutation := newIcoCouponRedemptionMutation(c.config, OpCreate)
+	return &IcoCouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IcoHistory entities.
-func (c *IcoHistoryClient) CreateBulk(builders ...*IcoHistoryCreate) *IcoHistoryCreateBulk {
-	return &IcoHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IcoCouponRedemption entities.
+func (c *IcoCouponRedemptionClient) CreateBulk(builders ...*IcoCouponRedemptionCreate) *IcoCouponRedemptionCreateBulk {
+	return &IcoCouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IcoHistoryClient) MapCreateBulk(slice any, setFunc func(*IcoHistoryCreate, int)) *IcoHistoryCreateBulk {
+func (c *IcoCouponRedemptionClient) MapCreateBulk(slice any, setFunc func(*IcoCouponRedemptionCreate, int)) *IcoCouponRedemptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IcoHistoryCreateBulk{err: fmt.Errorf("calling to IcoHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IcoCouponRedemptionCreateBulk{err: fmt.Errorf("calling to IcoCouponRedemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IcoHistoryCreate, rv.Len())
+	builders := make([]*IcoCouponRedemptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IcoHistoryCreateBulk{config: c.config, builders: builders}
+	return &IcoCouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IcoHistory.
-func (c *IcoHistoryClient) Update() *IcoHistoryUpdate {
-	mutation := newIcoHistoryMutation(c.config, OpUpdate)
-	return &IcoHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IcoCouponRedemption.
+func (c *IcoCouponRedemptionClient) Update() *IcoCouponRedemptionUpdate {
+	mutation := newIcoCouponRedemptionMutation(c.config, OpUpdate)
+	return &IcoCouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IcoHistoryClient) UpdateOne(ih *IcoHistory) *IcoHistoryUpdateOne {
-	mutation := newIcoHistoryMutation(c.config, OpUpdateOne, withIcoHistory(ih))
-	return &IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IcoCouponRedemptionClient) UpdateOne(icr *IcoCouponRedemption) *IcoCouponRedemptionUpdateOne {
+	mutation := newIcoCouponRedemptionMutation(c.config, OpUpdateOne, withIcoCouponRedemption(icr))
+	return &IcoCouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IcoHistoryClient) UpdateOneID(id xid.ID) *IcoHistoryUpdateOne {
-	mutation := newIcoHistoryMutation(c.config, OpUpdateOne, withIcoHistoryID(id))
-	return &IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IcoCouponRedemptionClient) UpdateOneID(id xid.ID) *IcoCouponRedemptionUpdateOne {
+	mutation := newIcoCouponRedemptionMutation(c.config, OpUpdateOne, withIcoCouponRedemptionID(id))
+	return &IcoCouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IcoHistory.
-func (c *IcoHistoryClient) Delete() *IcoHistoryDelete {
-	mutation := newIcoHistoryMutation(c.config, OpDelete)
-	return &IcoHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IcoCouponRedemption.
+func (c *IcoCouponRedemptionClient) Delete() *IcoCouponRedemptionDelete {
+	mutation := newIcoCouponRedemptionMutation(c.config, OpDelete)
+	return &IcoCouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IcoHistoryClient) DeleteOne(ih *IcoHistory) *IcoHistoryDeleteOne {
-	return c.DeleteOneID(ih.ID)
+func (c *IcoCouponRedemptionClient) DeleteOne(icr *IcoCouponRedemption) *IcoCouponRedemptionDeleteOne {
+	return c.DeleteOneID(icr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IcoHistoryClient) DeleteOneID(id xid.ID) *IcoHistoryDeleteOne {
-	builder := c.Delete().Where(icohistory.ID(id))
+func (c *IcoCouponRedemptionClient) DeleteOneID(id xid.ID) *IcoCouponRedemptionDeleteOne {
+	builder := c.Delete().Where(icocouponredemption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IcoHistoryDeleteOne{builder}
+	return &IcoCouponRedemptionDeleteOne{builder}
 }
 
-// Query returns a query builder for IcoHistory.
-func (c *IcoHistoryClient) Query() *IcoHistoryQuery {
-	return &IcoHistoryQuery{
+// Query returns a query builder for IcoCouponRedemption.
+func (c *IcoCouponRedemptionClient) Query() *IcoCouponRedemptionQuery {
+	return &IcoCouponRedemptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIcoHistory},
+		ctx:    &QueryContext{Type: TypeIcoCouponRedemption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IcoHistory entity by its id.
-func (c *IcoHistoryClient) Get(ctx context.Context, id xid.ID) (*IcoHistory, error) {
-	return c.Query().Where(icohistory.ID(id)).Only(ctx)
+// Get returns a IcoCouponRedemption entity by its id.
+func (c *IcoCouponRedemptionClient) Get(ctx context.Context, id xid.ID) (*IcoCouponRedemption, error) {
+	return c.Query().Where(icocouponredemption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IcoHistoryClient) GetX(ctx context.Context, id xid.ID) *IcoHistory {
+func (c *IcoCouponRedemptionClient) GetX(ctx context.Context, id xid.ID) *IcoCouponRedemption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -765,131 +1124,530 @@ func (c *IcoHistoryClient) GetX(ctx context.Context, id xid.ID) *IcoHistory {
 }
 
 // Hooks returns the client hooks.
-func (c *IcoHistoryClient) Hooks() []Hook {
-	return c.hooks.IcoHistory
+func (c *IcoCouponRedemptionClient) Hooks() []Hook {
+	return c.hooks.IcoCouponRedemption
 }
 
 // Interceptors returns the client interceptors.
-func (c *IcoHistoryClient) Interceptors() []Interceptor {
-	return c.inters.IcoHistory
+func (c *IcoCouponRedemptionClient) Interceptors() []Interceptor {
+	return c.inters.IcoCouponRedemption
 }
 
-func (c *IcoHistoryClient) mutate(ctx context.Context, m *IcoHistoryMutation) (Value, error) {
+func (c *IcoCouponRedemptionClient) mutate(ctx context.Context, m *IcoCouponRedemptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IcoHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IcoCouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IcoHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IcoCouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IcoCouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IcoHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IcoCouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IcoHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown IcoCouponRedemption mutation op: %q", m.Op())
 	}
 }
 
-// IcoRoundClient is a client for the IcoRound schema.
-type IcoRoundClient struct {
+// IcoDailyStatClient is a client for the IcoDailyStat schema.
+type IcoDailyStatClient struct {
 	config
 }
 
-// NewIcoRoundClient returns a client for the IcoRound from the given config.
-func NewIcoRoundClient(c config) *IcoRoundClient {
-	return &IcoRoundClient{config: c}
+// NewIcoDailyStatClient returns a client for the IcoDailyStat from the given config.
+func NewIcoDailyStatClient(c config) *IcoDailyStatClient {
+	return &IcoDailyStatClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `icoround.Hooks(f(g(h())))`.
-func (c *IcoRoundClient) Use(hooks ...Hook) {
-	c.hooks.IcoRound = append(c.hooks.IcoRound, hooks...)
+// A call to `Use(f, g, h)` equals to `icodailystat.Hooks(f(g(h())))`.
+func (c *IcoDailyStatClient) Use(hooks ...Hook) {
+	c.hooks.IcoDailyStat = append(c.hooks.IcoDailyStat, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `icoround.Intercept(f(g(h())))`.
-func (c *IcoRoundClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IcoRound = append(c.inters.IcoRound, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `icodailystat.Intercept(f(g(h())))`.
+func (c *IcoDailyStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IcoDailyStat = append(c.inters.IcoDailyStat, interceptors...)
 }
 
-// Create returns a builder for creating a IcoRound entity.
-func (c *IcoRoundClient) Create() *IcoRoundCreate {
-	mutation := newIcoRoundMutation(c.config, OpCreate)
-	return &IcoRoundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IcoDailyStat entity.
+func (c *IcoDailyStatClient) Create() *IcoDailyStatCreate {
+	mutation := newIcoDailyStatMutation(c.config, OpCreate)
+	return &IcoDailyStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IcoRound entities.
-func (c *IcoRoundClient) CreateBulk(builders ...*IcoRoundCreate) *IcoRoundCreateBulk {
-	return &IcoRoundCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IcoDailyStat entities.
+func (c *IcoDailyStatClient) CreateBulk(builders ...*IcoDailyStatCreate) *IcoDailyStatCreateBulk {
+	return &IcoDailyStatCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IcoRoundClient) MapCreateBulk(slice any, setFunc func(*IcoRoundCreate, int)) *IcoRoundCreateBulk {
+func (c *IcoDailyStatClient) MapCreateBulk(slice any, setFunc func(*IcoDailyStatCreate, int)) *IcoDailyStatCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IcoRoundCreateBulk{err: fmt.Errorf("calling to IcoRoundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IcoDailyStatCreateBulk{err: fmt.Errorf("calling to IcoDailyStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IcoRoundCreate, rv.Len())
+	builders := make([]*IcoDailyStatCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IcoRoundCreateBulk{config: c.config, builders: builders}
+	return &IcoDailyStatCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IcoRound.
-func (c *IcoRoundClient) Update() *IcoRoundUpdate {
-	mutation := newIcoRoundMutation(c.config, OpUpdate)
-	return &IcoRoundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IcoDailyStat.
+func (c *IcoDailyStatClient) Update() *IcoDailyStatUpdate {
+	mutation := newIcoDailyStatMutation(c.config, OpUpdate)
+	return &IcoDailyStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IcoRoundClient) UpdateOne(ir *IcoRound) *IcoRoundUpdateOne {
-	mutation := newIcoRoundMutation(c.config, OpUpdateOne, withIcoRound(ir))
-	return &IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IcoDailyStatClient) UpdateOne(ids *IcoDailyStat) *IcoDailyStatUpdateOne {
+	mutation := newIcoDailyStatMutation(c.config, OpUpdateOne, withIcoDailyStat(ids))
+	return &IcoDailyStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IcoRoundClient) UpdateOneID(id xid.ID) *IcoRoundUpdateOne {
-	mutation := newIcoRoundMutation(c.config, OpUpdateOne, withIcoRoundID(id))
-	return &IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IcoDailyStatClient) UpdateOneID(id xid.ID) *IcoDailyStatUpdateOne {
+	mutation := newIcoDailyStatMutation(c.config, OpUpdateOne, withIcoDailyStatID(id))
+	return &IcoDailyStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IcoRound.
-func (c *IcoRoundClient) Delete() *IcoRoundDelete {
-	mutation := newIcoRoundMutation(c.config, OpDelete)
-	return &IcoRoundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IcoDailyStat.
+func (c *IcoDailyStatClient) Delete() *IcoDailyStatDelete {
+	mutation := newIcoDailyStatMutation(c.config, OpDelete)
+	return &IcoDailyStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IcoRoundClient) DeleteOne(ir *IcoRound) *IcoRoundDeleteOne {
-	return c.DeleteOneID(ir.ID)
+func (c *IcoDailyStatClient) DeleteOne(ids *IcoDailyStat) *IcoDailyStatDeleteOne {
+	return c.DeleteOneID(ids.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IcoRoundClient) DeleteOneID(id xid.ID) *IcoRoundDeleteOne {
-	builder := c.Delete().Where(icoround.ID(id))
+func (c *IcoDailyStatClient) DeleteOneID(id xid.ID) *IcoDailyStatDeleteOne {
+	builder := c.Delete().Where(icodailystat.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IcoRoundDeleteOne{builder}
+	return &IcoDailyStatDeleteOne{builder}
 }
 
-// Query returns a query builder for IcoRound.
-func (c *IcoRoundClient) Query() *IcoRoundQuery {
-	return &IcoRoundQuery{
+// Query returns a query builder for IcoDailyStat.
+func (c *IcoDailyStatClient) Query() *IcoDailyStatQuery {
+	return &IcoDailyStatQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIcoRound},
+		ctx:    &QueryContext{Type: TypeIcoDailyStat},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IcoRound entity by its id.
-func (c *IcoRoundClient) Get(ctx context.Context, id xid.ID) (*IcoRound, error) {
-	return c.Query().Where(icoround.ID(id)).Only(ctx)
+// Get returns a IcoDailyStat entity by its id.
+func (c *IcoDailyStatClient) Get(ctx context.Context, id xid.ID) (*IcoDailyStat, error) {
+	return c.Query().Where(icodailystat.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IcoRoundClient) GetX(ctx context.Context, id xid.ID) *IcoRound {
+func (c *IcoDailyStatClient) GetX(ctx context.Context, id xid.ID) *IcoDailyStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IcoDailyStatClient) Hooks() []Hook {
+	return c.hooks.IcoDailyStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *IcoDailyStatClient) Interceptors() []Interceptor {
+	return c.inters.IcoDailyStat
+}
+
+func (c *IcoDailyStatClient) mutate(ctx context.Context, m *IcoDailyStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IcoDailyStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IcoDailyStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IcoDailyStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IcoDailyStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IcoDailyStat mutation op: %q", m.Op())
+	}
+}
+
+// IcoHistoryClient is a client for the IcoHistory schema.
+type IcoHistoryClient struct {
+	config
+}
+
+// NewIcoHistoryClient returns a client for the IcoHistory from the given config.
+func NewIcoHistoryClient(c config) *IcoHistoryClient {
+	return &IcoHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `icohistory.Hooks(f(g(h())))`.
+func (c *IcoHistoryClient) Use(hooks ...Hook) {
+	c.hooks.IcoHistory = append(c.hooks.IcoHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `icohistory.Intercept(f(g(h())))`.
+func (c *IcoHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IcoHistory = append(c.inters.IcoHistory, interceptors...)
+}
+
+// Create returns a builder for creating a IcoHistory entity.
+func (c *IcoHistoryClient) Create() *IcoHistoryCreate {
+	mutation := newIcoHistoryMutation(c.config, OpCreate)
+	return &IcoHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IcoHistory entities.
+func (c *IcoHistoryClient) CreateBulk(builders ...*IcoHistoryCreate) *IcoHistoryCreateBulk {
+	return &IcoHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IcoHistoryClient) MapCreateBulk(slice any, setFunc func(*IcoHistoryCreate, int)) *IcoHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IcoHistoryCreateBulk{err: fmt.Errorf("calling to IcoHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IcoHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IcoHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IcoHistory.
+func (c *IcoHistoryClient) Update() *IcoHistoryUpdate {
+	mutation := newIcoHistoryMutation(c.config, OpUpdate)
+	return &IcoHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IcoHistoryClient) UpdateOne(ih *IcoHistory) *IcoHistoryUpdateOne {
+	mutation := newIcoHistoryMutation(c.config, OpUpdateOne, withIcoHistory(ih))
+	return &IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IcoHistoryClient) UpdateOneID(id xid.ID) *IcoHistoryUpdateOne {
+	mutation := newIcoHistoryMutation(c.config, OpUpdateOne, withIcoHistoryID(id))
+	return &IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IcoHistory.
+func (c *IcoHistoryClient) Delete() *IcoHistoryDelete {
+	mutation := newIcoHistoryMutation(c.config, OpDelete)
+	return &IcoHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IcoHistoryClient) DeleteOne(ih *IcoHistory) *IcoHistoryDeleteOne {
+	return c.DeleteOneID(ih.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IcoHistoryClient) DeleteOneID(id xid.ID) *IcoHistoryDeleteOne {
+	builder := c.Delete().Where(icohistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IcoHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for IcoHistory.
+func (c *IcoHistoryClient) Query() *IcoHistoryQuery {
+	return &IcoHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIcoHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IcoHistory entity by its id.
+func (c *IcoHistoryClient) Get(ctx context.Context, id xid.ID) (*IcoHistory, error) {
+	return c.Query().Where(icohistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IcoHistoryClient) GetX(ctx context.Context, id xid.ID) *IcoHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IcoHistoryClient) Hooks() []Hook {
+	return c.hooks.IcoHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *IcoHistoryClient) Interceptors() []Interceptor {
+	return c.inters.IcoHistory
+}
+
+func (c *IcoHistoryClient) mutate(ctx context.Context, m *IcoHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IcoHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IcoHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IcoHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IcoHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IcoHistory mutation op: %q", m.Op())
+	}
+}
+
+// IcoOutstandingRefundClient is a client for the IcoOutstandingRefund schema.
+type IcoOutstandingRefundClient struct {
+	config
+}
+
+// NewIcoOutstandingRefundClient returns a client for the IcoOutstandingRefund from the given config.
+func NewIcoOutstandingRefundClient(c config) *IcoOutstandingRefundClient {
+	return &IcoOutstandingRefundClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `icooutstandingrefund.Hooks(f(g(h())))`.
+func (c *IcoOutstandingRefundClient) Use(hooks ...Hook) {
+	c.hooks.IcoOutstandingRefund = append(c.hooks.IcoOutstandingRefund, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `icooutstandingrefund.Intercept(f(g(h())))`.
+func (c *IcoOutstandingRefundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IcoOutstandingRefund = append(c.inters.IcoOutstandingRefund, interceptors...)
+}
+
+// Create returns a builder for creating a IcoOutstandingRefund entity.
+func (c *IcoOutstandingRefundClient) Create() *IcoOutstandingRefundCreate {
+	mutation := newIcoOutstandingRefundMutation(c.config, OpCreate)
+	return &IcoOutstandingRefundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IcoOutstandingRefund entities.
+func (c *IcoOutstandingRefundClient) CreateBulk(builders ...*IcoOutstandingRefundCreate) *IcoOutstandingRefundCreateBulk {
+	return &IcoOutstandingRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IcoOutstandingRefundClient) MapCreateBulk(slice any, setFunc func(*IcoOutstandingRefundCreate, int)) *IcoOutstandingRefundCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IcoOutstandingRefundCreateBulk{err: fmt.Errorf("calling to IcoOutstandingRefundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IcoOutstandingRefundCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IcoOutstandingRefundCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IcoOutstandingRefund.
+func (c *IcoOutstandingRefundClient) Update() *IcoOutstandingRefundUpdate {
+	mutation := newIcoOutstandingRefundMutation(c.config, OpUpdate)
+	return &IcoOutstandingRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IcoOutstandingRefundClient) UpdateOne(ior *IcoOutstandingRefund) *IcoOutstandingRefundUpdateOne {
+	mutation := newIcoOutstandingRefundMutation(c.config, OpUpdateOne, withIcoOutstandingRefund(ior))
+	return &IcoOutstandingRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IcoOutstandingRefundClient) UpdateOneID(id xid.ID) *IcoOutstandingRefundUpdateOne {
+	mutation := newIcoOutstandingRefundMutation(c.config, OpUpdateOne, withIcoOutstandingRefundID(id))
+	return &IcoOutstandingRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IcoOutstandingRefund.
+func (c *IcoOutstandingRefundClient) Delete() *IcoOutstandingRefundDelete {
+	mutation := newIcoOutstandingRefundMutation(c.config, OpDelete)
+	return &IcoOutstandingRefundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IcoOutstandingRefundClient) DeleteOne(ior *IcoOutstandingRefund) *IcoOutstandingRefundDeleteOne {
+	return c.DeleteOneID(ior.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IcoOutstandingRefundClient) DeleteOneID(id xid.ID) *IcoOutstandingRefundDeleteOne {
+	builder := c.Delete().Where(icooutstandingrefund.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IcoOutstandingRefundDeleteOne{builder}
+}
+
+// Query returns a query builder for IcoOutstandingRefund.
+func (c *IcoOutstandingRefundClient) Query() *IcoOutstandingRefundQuery {
+	return &IcoOutstandingRefundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIcoOutstandingRefund},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IcoOutstandingRefund entity by its id.
+func (c *IcoOutstandingRefundClient) Get(ctx context.Context, id xid.ID) (*IcoOutstandingRefund, error) {
+	return c.Query().Where(icooutstandingrefund.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IcoOutstandingRefundClient) GetX(ctx context.Context, id xid.ID) *IcoOutstandingRefund {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IcoOutstandingRefundClient) Hooks() []Hook {
+	return c.hooks.IcoOutstandingRefund
+}
+
+// Interceptors returns the client interceptors.
+func (c *IcoOutstandingRefundClient) Interceptors() []Interceptor {
+	return c.inters.IcoOutstandingRefund
+}
+
+func (c *IcoOutstandingRefundClient) mutate(ctx context.Context, m *IcoOutstandingRefundMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IcoOutstandingRefundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IcoOutstandingRefundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IcoOutstandingRefundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IcoOutstandingRefundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IcoOutstandingRefund mutation op: %q", m.Op())
+	}
+}
+
+// IcoRoundClient is a client for the IcoRound schema.
+type IcoRoundClient struct {
+	config
+}
+
+// NewIcoRoundClient returns a client for the IcoRound from the given config.
+func NewIcoRoundClient(c config) *IcoRoundClient {
+	return &IcoRoundClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `icoround.Hooks(f(g(h())))`.
+func (c *IcoRoundClient) Use(hooks ...Hook) {
+	c.hooks.IcoRound = append(c.hooks.IcoRound, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `icoround.Intercept(f(g(h())))`.
+func (c *IcoRoundClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IcoRound = append(c.inters.IcoRound, interceptors...)
+}
+
+// Create returns a builder for creating a IcoRound entity.
+func (c *IcoRoundClient) Create() *IcoRoundCreate {
+	mutation := newIcoRoundMutation(c.config, OpCreate)
+	return &IcoRoundCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IcoRound entities.
+func (c *IcoRoundClient) CreateBulk(builders ...*IcoRoundCreate) *IcoRoundCreateBulk {
+	return &IcoRoundCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IcoRoundClient) MapCreateBulk(slice any, setFunc func(*IcoRoundCreate, int)) *IcoRoundCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IcoRoundCreateBulk{err: fmt.Errorf("calling to IcoRoundClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IcoRoundCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IcoRoundCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IcoRound.
+func (c *IcoRoundClient) Update() *IcoRoundUpdate {
+	mutation := newIcoRoundMutation(c.config, OpUpdate)
+	return &IcoRoundUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IcoRoundClient) UpdateOne(ir *IcoRound) *IcoRoundUpdateOne {
+	mutation := newIcoRoundMutation(c.config, OpUpdateOne, withIcoRound(ir))
+	return &IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IcoRoundClient) UpdateOneID(id xid.ID) *IcoRoundUpdateOne {
+	mutation := newIcoRoundMutation(c.config, OpUpdateOne, withIcoRoundID(id))
+	return &IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IcoRound.
+func (c *IcoRoundClient) Delete() *IcoRoundDelete {
+	mutation := newIcoRoundMutation(c.config, OpDelete)
+	return &IcoRoundDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IcoRoundClient) DeleteOne(ir *IcoRound) *IcoRoundDeleteOne {
+	return c.DeleteOneID(ir.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IcoRoundClient) DeleteOneID(id xid.ID) *IcoRoundDeleteOne {
+	builder := c.Delete().Where(icoround.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IcoRoundDeleteOne{builder}
+}
+
+// Query returns a query builder for IcoRound.
+func (c *IcoRoundClient) Query() *IcoRoundQuery {
+	return &IcoRoundQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIcoRound},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IcoRound entity by its id.
+func (c *IcoRoundClient) Get(ctx context.Context, id xid.ID) (*IcoRound, error) {
+	return c.Query().Where(icoround.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IcoRoundClient) GetX(ctx context.Context, id xid.ID) *IcoRound {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -903,22 +1661,554 @@ func (c *IcoRoundClient) Hooks() []Hook {
 }
 
 // Interceptors returns the client interceptors.
-func (c *IcoRoundClient) Interceptors() []Interceptor {
-	return c.inters.IcoRound
+func (c *IcoRoundClient) Interceptors() []Interceptor {
+	return c.inters.IcoRound
+}
+
+func (c *IcoRoundClient) mutate(ctx context.Context, m *IcoRoundMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IcoRoundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IcoRoundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IcoRoundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IcoRound mutation op: %q", m.Op())
+	}
+}
+
+// LeaderboardSnapshotClient is a client for the LeaderboardSnapshot schema.
+type LeaderboardSnapshotClient struct {
+	config
+}
+
+// NewLeaderboardSnapshotClient returns a client for the LeaderboardSnapshot from the given config.
+func NewLeaderboardSnapshotClient(c config) *LeaderboardSnapshotClient {
+	return &LeaderboardSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaderboardsnapshot.Hooks(f(g(h())))`.
+func (c *LeaderboardSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.LeaderboardSnapshot = append(c.hooks.LeaderboardSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaderboardsnapshot.Intercept(f(g(h())))`.
+func (c *LeaderboardSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaderboardSnapshot = append(c.inters.LeaderboardSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a LeaderboardSnapshot entity.
+func (c *LeaderboardSnapshotClient) Create() *LeaderboardSnapshotCreate {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpCreate)
+	return &LeaderboardSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaderboardSnapshot entities.
+func (c *LeaderboardSnapshotClient) CreateBulk(builders ...*LeaderboardSnapshotCreate) *LeaderboardSnapshotCreateBulk {
+	return &LeaderboardSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaderboardSnapshotClient) MapCreateBulk(slice any, setFunc func(*LeaderboardSnapshotCreate, int)) *LeaderboardSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaderboardSnapshotCreateBulk{err: fmt.Errorf("calling to LeaderboardSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaderboardSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaderboardSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Update() *LeaderboardSnapshotUpdate {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdate)
+	return &LeaderboardSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaderboardSnapshotClient) UpdateOne(ls *LeaderboardSnapshot) *LeaderboardSnapshotUpdateOne {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdateOne, withLeaderboardSnapshot(ls))
+	return &LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaderboardSnapshotClient) UpdateOneID(id xid.ID) *LeaderboardSnapshotUpdateOne {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdateOne, withLeaderboardSnapshotID(id))
+	return &LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Delete() *LeaderboardSnapshotDelete {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpDelete)
+	return &LeaderboardSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaderboardSnapshotClient) DeleteOne(ls *LeaderboardSnapshot) *LeaderboardSnapshotDeleteOne {
+	return c.DeleteOneID(ls.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaderboardSnapshotClient) DeleteOneID(id xid.ID) *LeaderboardSnapshotDeleteOne {
+	builder := c.Delete().Where(leaderboardsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaderboardSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Query() *LeaderboardSnapshotQuery {
+	return &LeaderboardSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaderboardSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaderboardSnapshot entity by its id.
+func (c *LeaderboardSnapshotClient) Get(ctx context.Context, id xid.ID) (*LeaderboardSnapshot, error) {
+	return c.Query().Where(leaderboardsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaderboardSnapshotClient) GetX(ctx context.Context, id xid.ID) *LeaderboardSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaderboardSnapshotClient) Hooks() []Hook {
+	return c.hooks.LeaderboardSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaderboardSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.LeaderboardSnapshot
+}
+
+func (c *LeaderboardSnapshotClient) mutate(ctx context.Context, m *LeaderboardSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaderboardSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaderboardSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaderboardSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaderboardSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// ReferralClient is a client for the Referral schema.
+type ReferralClient struct {
+	config
+}
+
+// NewReferralClient returns a client for the Referral from the given config.
+func NewReferralClient(c config) *ReferralClient {
+	return &ReferralClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `referral.Hooks(f(g(h())))`.
+func (c *ReferralClient) Use(hooks ...Hook) {
+	c.hooks.Referral = append(c.hooks.Referral, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `referral.Intercept(f(g(h())))`.
+func (c *ReferralClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Referral = append(c.inters.Referral, interceptors...)
+}
+
+// Create returns a builder for creating a Referral entity.
+func (c *ReferralClient) Create() *ReferralCreate {
+	mutation := newReferralMutation(c.config, OpCreate)
+	return &ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Referral entities.
+func (c *ReferralClient) CreateBulk(builders ...*ReferralCreate) *ReferralCreateBulk {
+	return &ReferralCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReferralClient) MapCreateBulk(slice any, setFunc func(*ReferralCreate, int)) *ReferralCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReferralCreateBulk{err: fmt.Errorf("calling to ReferralClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReferralCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReferralCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Referral.
+func (c *ReferralClient) Update() *ReferralUpdate {
+	mutation := newReferralMutation(c.config, OpUpdate)
+	return &ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReferralClient) UpdateOne(r *Referral) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferral(r))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReferralClient) UpdateOneID(id xid.ID) *ReferralUpdateOne {
+	mutation := newReferralMutation(c.config, OpUpdateOne, withReferralID(id))
+	return &ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Referral.
+func (c *ReferralClient) Delete() *ReferralDelete {
+	mutation := newReferralMutation(c.config, OpDelete)
+	return &ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReferralClient) DeleteOne(r *Referral) *ReferralDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReferralClient) DeleteOneID(id xid.ID) *ReferralDeleteOne {
+	builder := c.Delete().Where(referral.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReferralDeleteOne{builder}
+}
+
+// Query returns a query builder for Referral.
+func (c *ReferralClient) Query() *ReferralQuery {
+	return &ReferralQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReferral},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Referral entity by its id.
+func (c *ReferralClient) Get(ctx context.Context, id xid.ID) (*Referral, error) {
+	return c.Query().Where(referral.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReferralClient) GetX(ctx context.Context, id xid.ID) *Referral {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReferralClient) Hooks() []Hook {
+	return c.hooks.Referral
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReferralClient) Interceptors() []Interceptor {
+	return c.inters.Referral
+}
+
+func (c *ReferralClient) mutate(ctx context.Context, m *ReferralMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReferralCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReferralUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReferralUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReferralDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Referral mutation op: %q", m.Op())
+	}
+}
+
+// ReferralCommissionClient is a client for the ReferralCommission schema.
+type ReferralCommissionClient struct {
+	config
+}
+
+// NewReferralCommissionClient returns a client for the ReferralCommission from the given config.
+func NewReferralCommissionClient(c config) *ReferralCommissionClient {
+	return &ReferralCommissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `referralcommission.Hooks(f(g(h())))`.
+func (c *ReferralCommissionClient) Use(hooks ...Hook) {
+	c.hooks.ReferralCommission = append(c.hooks.ReferralCommission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `referralcommission.Intercept(f(g(h())))`.
+func (c *ReferralCommissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReferralCommission = append(c.inters.ReferralCommission, interceptors...)
+}
+
+// Create returns a builder for creating a ReferralCommission entity.
+func (c *ReferralCommissionClient) Create() *ReferralCommissionCreate {
+	mutation := newReferralCommissionMutation(c.config, OpCreate)
+	return &ReferralCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReferralCommission entities.
+func (c *ReferralCommissionClient) CreateBulk(builders ...*ReferralCommissionCreate) *ReferralCommissionCreateBulk {
+	return &ReferralCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReferralCommissionClient) MapCreateBulk(slice any, setFunc func(*ReferralCommissionCreate, int)) *ReferralCommissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReferralCommissionCreateBulk{err: fmt.Errorf("calling to ReferralCommissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReferralCommissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReferralCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReferralCommission.
+func (c *ReferralCommissionClient) Update() *ReferralCommissionUpdate {
+	mutation := newReferralCommissionMutation(c.config, OpUpdate)
+	return &ReferralCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReferralCommissionClient) UpdateOne(rc *ReferralCommission) *ReferralCommissionUpdateOne {
+	mutation := newReferralCommissionMutation(c.config, OpUpdateOne, withReferralCommission(rc))
+	return &ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReferralCommissionClient) UpdateOneID(id xid.ID) *ReferralCommissionUpdateOne {
+	mutation := newReferralCommissionMutation(c.config, OpUpdateOne, withReferralCommissionID(id))
+	return &ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReferralCommission.
+func (c *ReferralCommissionClient) Delete() *ReferralCommissionDelete {
+	mutation := newReferralCommissionMutation(c.config, OpDelete)
+	return &ReferralCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReferralCommissionClient) DeleteOne(rc *ReferralCommission) *ReferralCommissionDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReferralCommissionClient) DeleteOneID(id xid.ID) *ReferralCommissionDeleteOne {
+	builder := c.Delete().Where(referralcommission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReferralCommissionDeleteOne{builder}
+}
+
+// Query returns a query builder for ReferralCommission.
+func (c *ReferralCommissionClient) Query() *ReferralCommissionQuery {
+	return &ReferralCommissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReferralCommission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReferralCommission entity by its id.
+func (c *ReferralCommissionClient) Get(ctx context.Context, id xid.ID) (*ReferralCommission, error) {
+	return c.Query().Where(referralcommission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReferralCommissionClient) GetX(ctx context.Context, id xid.ID) *ReferralCommission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReferralCommissionClient) Hooks() []Hook {
+	return c.hooks.ReferralCommission
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReferralCommissionClient) Interceptors() []Interceptor {
+	return c.inters.ReferralCommission
 }
 
-func (c *IcoRoundClient) mutate(ctx context.Context, m *IcoRoundMutation) (Value, error) {
+func (c *ReferralCommissionClient) mutate(ctx context.Context, m *ReferralCommissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IcoRoundCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IcoRoundUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IcoRoundUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IcoRoundDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReferralCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IcoRound mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReferralCommission mutation op: %q", m.Op())
+	}
+}
+
+// TokenomicVersionClient is a client for the TokenomicVersion schema.
+type TokenomicVersionClient struct {
+	config
+}
+
+// NewTokenomicVersionClient returns a client for the TokenomicVersion from the given config.
+func NewTokenomicVersionClient(c config) *TokenomicVersionClient {
+	return &TokenomicVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenomicversion.Hooks(f(g(h())))`.
+func (c *TokenomicVersionClient) Use(hooks ...Hook) {
+	c.hooks.TokenomicVersion = append(c.hooks.TokenomicVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenomicversion.Intercept(f(g(h())))`.
+func (c *TokenomicVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenomicVersion = append(c.inters.TokenomicVersion, interceptors...)
+}
+
+// Create returns a builder for creating a TokenomicVersion entity.
+func (c *TokenomicVersionClient) Create() *TokenomicVersionCreate {
+	mutation := newTokenomicVersionMutation(c.config, OpCreate)
+	return &TokenomicVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenomicVersion entities.
+func (c *TokenomicVersionClient) CreateBulk(builders ...*TokenomicVersionCreate) *TokenomicVersionCreateBulk {
+	return &TokenomicVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenomicVersionClient) MapCreateBulk(slice any, setFunc func(*TokenomicVersionCreate, int)) *TokenomicVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenomicVersionCreateBulk{err: fmt.Errorf("calling to TokenomicVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenomicVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenomicVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenomicVersion.
+func (c *TokenomicVersionClient) Update() *TokenomicVersionUpdate {
+	mutation := newTokenomicVersionMutation(c.config, OpUpdate)
+	return &TokenomicVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenomicVersionClient) UpdateOne(tv *TokenomicVersion) *TokenomicVersionUpdateOne {
+	mutation := newTokenomicVersionMutation(c.config, OpUpdateOne, withTokenomicVersion(tv))
+	return &TokenomicVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenomicVersionClient) UpdateOneID(id xid.ID) *TokenomicVersionUpdateOne {
+	mutation := newTokenomicVersionMutation(c.config, OpUpdateOne, withTokenomicVersionID(id))
+	return &TokenomicVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenomicVersion.
+func (c *TokenomicVersionClient) Delete() *TokenomicVersionDelete {
+	mutation := newTokenomicVersionMutation(c.config, OpDelete)
+	return &TokenomicVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenomicVersionClient) DeleteOne(tv *TokenomicVersion) *TokenomicVersionDeleteOne {
+	return c.DeleteOneID(tv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenomicVersionClient) DeleteOneID(id xid.ID) *TokenomicVersionDeleteOne {
+	builder := c.Delete().Where(tokenomicversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenomicVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenomicVersion.
+func (c *TokenomicVersionClient) Query() *TokenomicVersionQuery {
+	return &TokenomicVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenomicVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenomicVersion entity by its id.
+func (c *TokenomicVersionClient) Get(ctx context.Context, id xid.ID) (*TokenomicVersion, error) {
+	return c.Query().Where(tokenomicversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenomicVersionClient) GetX(ctx context.Context, id xid.ID) *TokenomicVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TokenomicVersionClient) Hooks() []Hook {
+	return c.hooks.TokenomicVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenomicVersionClient) Interceptors() []Interceptor {
+	return c.inters.TokenomicVersion
+}
+
+func (c *TokenomicVersionClient) mutate(ctx context.Context, m *TokenomicVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenomicVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenomicVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenomicVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenomicVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenomicVersion mutation op: %q", m.Op())
 	}
 }
 
@@ -1188,15 +2478,285 @@ func (c *UserWalletClient) mutate(ctx context.Context, m *UserWalletMutation) (V
 	}
 }
 
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id xid.ID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id xid.ID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id xid.ID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id xid.ID) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id xid.ID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id xid.ID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id xid.ID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id xid.ID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CurrencyRate, Ico, IcoCoupon, IcoHistory, IcoRound, Transaction,
-		UserWallet []ent.Hook
+		AlertRule, AuditLog, CurrencyRate, Ico, IcoCoupon, IcoCouponRedemption,
+		IcoDailyStat, IcoHistory, IcoOutstandingRefund, IcoRound, LeaderboardSnapshot,
+		Referral, ReferralCommission, TokenomicVersion, Transaction, UserWallet,
+		Webhook, WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		CurrencyRate, Ico, IcoCoupon, IcoHistory, IcoRound, Transaction,
-		UserWallet []ent.Interceptor
+		AlertRule, AuditLog, CurrencyRate, Ico, IcoCoupon, IcoCouponRedemption,
+		IcoDailyStat, IcoHistory, IcoOutstandingRefund, IcoRound, LeaderboardSnapshot,
+		Referral, ReferralCommission, TokenomicVersion, Transaction, UserWallet,
+		Webhook, WebhookDelivery []ent.Interceptor
 	}
 )
 
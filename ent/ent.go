@@ -12,13 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/ent/auditlog"
 	"github.com/indikay/wallet-service/ent/currencyrate"
 	"github.com/indikay/wallet-service/ent/ico"
 	"github.com/indikay/wallet-service/ent/icocoupon"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/icodailystat"
 	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
 	"github.com/indikay/wallet-service/ent/icoround"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/referral"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
 	"github.com/indikay/wallet-service/ent/transaction"
 	"github.com/indikay/wallet-service/ent/userwallet"
+	"github.com/indikay/wallet-service/ent/webhook"
+	"github.com/indikay/wallet-service/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -79,13 +90,24 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			currencyrate.Table: currencyrate.ValidColumn,
-			ico.Table:          ico.ValidColumn,
-			icocoupon.Table:    icocoupon.ValidColumn,
-			icohistory.Table:   icohistory.ValidColumn,
-			icoround.Table:     icoround.ValidColumn,
-			transaction.Table:  transaction.ValidColumn,
-			userwallet.Table:   userwallet.ValidColumn,
+			alertrule.Table:            alertrule.ValidColumn,
+			auditlog.Table:             auditlog.ValidColumn,
+			currencyrate.Table:         currencyrate.ValidColumn,
+			ico.Table:                  ico.ValidColumn,
+			icocoupon.Table:            icocoupon.ValidColumn,
+			icocouponredemption.Table:  icocouponredemption.ValidColumn,
+			icodailystat.Table:         icodailystat.ValidColumn,
+			icohistory.Table:           icohistory.ValidColumn,
+			icooutstandingrefund.Table: icooutstandingrefund.ValidColumn,
+			icoround.Table:             icoround.ValidColumn,
+			leaderboardsnapshot.Table:  leaderboardsnapshot.ValidColumn,
+			referral.Table:             referral.ValidColumn,
+			referralcommission.Table:   referralcommission.ValidColumn,
+			tokenomicversion.Table:     tokenomicversion.ValidColumn,
+			transaction.Table:          transaction.ValidColumn,
+			userwallet.Table:           userwallet.ValidColumn,
+			webhook.Table:              webhook.ValidColumn,
+			webhookdelivery.Table:      webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
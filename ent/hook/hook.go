@@ -9,6 +9,30 @@ import (
 	"github.com/indikay/wallet-service/ent"
 )
 
+// The AlertRuleFunc type is an adapter to allow the use of ordinary
+// function as AlertRule mutator.
+type AlertRuleFunc func(context.Context, *ent.AlertRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlertRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlertRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertRuleMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
 // The CurrencyRateFunc type is an adapter to allow the use of ordinary
 // function as CurrencyRate mutator.
 type CurrencyRateFunc func(context.Context, *ent.CurrencyRateMutation) (ent.Value, error)
@@ -45,6 +69,30 @@ func (f IcoCouponFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoCouponMutation", m)
 }
 
+// The IcoCouponRedemptionFunc type is an adapter to allow the use of ordinary
+// function as IcoCouponRedemption mutator.
+type IcoCouponRedemptionFunc func(context.Context, *ent.IcoCouponRedemptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IcoCouponRedemptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IcoCouponRedemptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoCouponRedemptionMutation", m)
+}
+
+// The IcoDailyStatFunc type is an adapter to allow the use of ordinary
+// function as IcoDailyStat mutator.
+type IcoDailyStatFunc func(context.Context, *ent.IcoDailyStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IcoDailyStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IcoDailyStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoDailyStatMutation", m)
+}
+
 // The IcoHistoryFunc type is an adapter to allow the use of ordinary
 // function as IcoHistory mutator.
 type IcoHistoryFunc func(context.Context, *ent.IcoHistoryMutation) (ent.Value, error)
@@ -57,6 +105,18 @@ func (f IcoHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoHistoryMutation", m)
 }
 
+// The IcoOutstandingRefundFunc type is an adapter to allow the use of ordinary
+// function as IcoOutstandingRefund mutator.
+type IcoOutstandingRefundFunc func(context.Context, *ent.IcoOutstandingRefundMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IcoOutstandingRefundFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IcoOutstandingRefundMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoOutstandingRefundMutation", m)
+}
+
 // The IcoRoundFunc type is an adapter to allow the use of ordinary
 // function as IcoRound mutator.
 type IcoRoundFunc func(context.Context, *ent.IcoRoundMutation) (ent.Value, error)
@@ -69,6 +129,54 @@ func (f IcoRoundFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IcoRoundMutation", m)
 }
 
+// The LeaderboardSnapshotFunc type is an adapter to allow the use of ordinary
+// function as LeaderboardSnapshot mutator.
+type LeaderboardSnapshotFunc func(context.Context, *ent.LeaderboardSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaderboardSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaderboardSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaderboardSnapshotMutation", m)
+}
+
+// The ReferralFunc type is an adapter to allow the use of ordinary
+// function as Referral mutator.
+type ReferralFunc func(context.Context, *ent.ReferralMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReferralFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReferralMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReferralMutation", m)
+}
+
+// The ReferralCommissionFunc type is an adapter to allow the use of ordinary
+// function as ReferralCommission mutator.
+type ReferralCommissionFunc func(context.Context, *ent.ReferralCommissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReferralCommissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReferralCommissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReferralCommissionMutation", m)
+}
+
+// The TokenomicVersionFunc type is an adapter to allow the use of ordinary
+// function as TokenomicVersion mutator.
+type TokenomicVersionFunc func(context.Context, *ent.TokenomicVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenomicVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenomicVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenomicVersionMutation", m)
+}
+
 // The TransactionFunc type is an adapter to allow the use of ordinary
 // function as Transaction mutator.
 type TransactionFunc func(context.Context, *ent.TransactionMutation) (ent.Value, error)
@@ -93,6 +201,30 @@ func (f UserWalletFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserWalletMutation", m)
 }
 
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
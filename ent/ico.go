@@ -34,8 +34,22 @@ type Ico struct {
 	NumSub int32 `json:"num_sub,omitempty"`
 	// PriceGap holds the value of the "price_gap" field.
 	PriceGap string `json:"price_gap,omitempty"`
+	// Lifetime holds the value of the "lifetime" field.
+	Lifetime int32 `json:"lifetime,omitempty"`
 	// EndedAt holds the value of the "ended_at" field.
-	EndedAt      *time.Time `json:"ended_at,omitempty"`
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Limits holds the value of the "limits" field.
+	Limits string `json:"limits,omitempty"`
+	// Pricing holds the value of the "pricing" field.
+	Pricing string `json:"pricing,omitempty"`
+	// Unsold holds the value of the "unsold" field.
+	Unsold string `json:"unsold,omitempty"`
+	// SoftCap holds the value of the "soft_cap" field.
+	SoftCap string `json:"soft_cap,omitempty"`
+	// HardCap holds the value of the "hard_cap" field.
+	HardCap string `json:"hard_cap,omitempty"`
+	// Status holds the value of the "status" field.
+	Status       string `json:"status,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -44,9 +58,9 @@ func (*Ico) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case ico.FieldRoundID, ico.FieldNumSub:
+		case ico.FieldRoundID, ico.FieldNumSub, ico.FieldLifetime:
 			values[i] = new(sql.NullInt64)
-		case ico.FieldRoundName, ico.FieldPrice, ico.FieldNumToken, ico.FieldPriceGap:
+		case ico.FieldRoundName, ico.FieldPrice, ico.FieldNumToken, ico.FieldPriceGap, ico.FieldLimits, ico.FieldPricing, ico.FieldUnsold, ico.FieldSoftCap, ico.FieldHardCap, ico.FieldStatus:
 			values[i] = new(sql.NullString)
 		case ico.FieldCreatedAt, ico.FieldUpdatedAt, ico.FieldEndedAt:
 			values[i] = new(sql.NullTime)
@@ -121,6 +135,12 @@ func (i *Ico) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.PriceGap = value.String
 			}
+		case ico.FieldLifetime:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lifetime", values[j])
+			} else if value.Valid {
+				i.Lifetime = int32(value.Int64)
+			}
 		case ico.FieldEndedAt:
 			if value, ok := values[j].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field ended_at", values[j])
@@ -128,6 +148,42 @@ func (i *Ico) assignValues(columns []string, values []any) error {
 				i.EndedAt = new(time.Time)
 				*i.EndedAt = value.Time
 			}
+		case ico.FieldLimits:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field limits", values[j])
+			} else if value.Valid {
+				i.Limits = value.String
+			}
+		case ico.FieldPricing:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pricing", values[j])
+			} else if value.Valid {
+				i.Pricing = value.String
+			}
+		case ico.FieldUnsold:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unsold", values[j])
+			} else if value.Valid {
+				i.Unsold = value.String
+			}
+		case ico.FieldSoftCap:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field soft_cap", values[j])
+			} else if value.Valid {
+				i.SoftCap = value.String
+			}
+		case ico.FieldHardCap:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hard_cap", values[j])
+			} else if value.Valid {
+				i.HardCap = value.String
+			}
+		case ico.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = value.String
+			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
 		}
@@ -188,10 +244,31 @@ func (i *Ico) String() string {
 	builder.WriteString("price_gap=")
 	builder.WriteString(i.PriceGap)
 	builder.WriteString(", ")
+	builder.WriteString("lifetime=")
+	builder.WriteString(fmt.Sprintf("%v", i.Lifetime))
+	builder.WriteString(", ")
 	if v := i.EndedAt; v != nil {
 		builder.WriteString("ended_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("limits=")
+	builder.WriteString(i.Limits)
+	builder.WriteString(", ")
+	builder.WriteString("pricing=")
+	builder.WriteString(i.Pricing)
+	builder.WriteString(", ")
+	builder.WriteString("unsold=")
+	builder.WriteString(i.Unsold)
+	builder.WriteString(", ")
+	builder.WriteString("soft_cap=")
+	builder.WriteString(i.SoftCap)
+	builder.WriteString(", ")
+	builder.WriteString("hard_cap=")
+	builder.WriteString(i.HardCap)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(i.Status)
 	builder.WriteByte(')')
 	return builder.String()
 }
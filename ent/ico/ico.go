@@ -30,8 +30,22 @@ const (
 	FieldNumSub = "num_sub"
 	// FieldPriceGap holds the string denoting the price_gap field in the database.
 	FieldPriceGap = "price_gap"
+	// FieldLifetime holds the string denoting the lifetime field in the database.
+	FieldLifetime = "lifetime"
 	// FieldEndedAt holds the string denoting the ended_at field in the database.
 	FieldEndedAt = "ended_at"
+	// FieldLimits holds the string denoting the limits field in the database.
+	FieldLimits = "limits"
+	// FieldPricing holds the string denoting the pricing field in the database.
+	FieldPricing = "pricing"
+	// FieldUnsold holds the string denoting the unsold field in the database.
+	FieldUnsold = "unsold"
+	// FieldSoftCap holds the string denoting the soft_cap field in the database.
+	FieldSoftCap = "soft_cap"
+	// FieldHardCap holds the string denoting the hard_cap field in the database.
+	FieldHardCap = "hard_cap"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// Table holds the table name of the ico in the database.
 	Table = "icos"
 )
@@ -47,7 +61,14 @@ var Columns = []string{
 	FieldNumToken,
 	FieldNumSub,
 	FieldPriceGap,
+	FieldLifetime,
 	FieldEndedAt,
+	FieldLimits,
+	FieldPricing,
+	FieldUnsold,
+	FieldSoftCap,
+	FieldHardCap,
+	FieldStatus,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +88,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultLifetime holds the default value on creation for the "lifetime" field.
+	DefaultLifetime int32
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 )
@@ -119,7 +142,42 @@ func ByPriceGap(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriceGap, opts...).ToFunc()
 }
 
+// ByLifetime orders the results by the lifetime field.
+func ByLifetime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLifetime, opts...).ToFunc()
+}
+
 // ByEndedAt orders the results by the ended_at field.
 func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
 }
+
+// ByLimits orders the results by the limits field.
+func ByLimits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLimits, opts...).ToFunc()
+}
+
+// ByPricing orders the results by the pricing field.
+func ByPricing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPricing, opts...).ToFunc()
+}
+
+// ByUnsold orders the results by the unsold field.
+func ByUnsold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnsold, opts...).ToFunc()
+}
+
+// BySoftCap orders the results by the soft_cap field.
+func BySoftCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftCap, opts...).ToFunc()
+}
+
+// ByHardCap orders the results by the hard_cap field.
+func ByHardCap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardCap, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
@@ -95,11 +95,46 @@ func PriceGap(v string) predicate.Ico {
 	return predicate.Ico(sql.FieldEQ(FieldPriceGap, v))
 }
 
+// Lifetime applies equality check predicate on the "lifetime" field. It's identical to LifetimeEQ.
+func Lifetime(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldLifetime, v))
+}
+
 // EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
 func EndedAt(v time.Time) predicate.Ico {
 	return predicate.Ico(sql.FieldEQ(FieldEndedAt, v))
 }
 
+// Limits applies equality check predicate on the "limits" field. It's identical to LimitsEQ.
+func Limits(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldLimits, v))
+}
+
+// Pricing applies equality check predicate on the "pricing" field. It's identical to PricingEQ.
+func Pricing(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldPricing, v))
+}
+
+// Unsold applies equality check predicate on the "unsold" field. It's identical to UnsoldEQ.
+func Unsold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldUnsold, v))
+}
+
+// SoftCap applies equality check predicate on the "soft_cap" field. It's identical to SoftCapEQ.
+func SoftCap(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldSoftCap, v))
+}
+
+// HardCap applies equality check predicate on the "hard_cap" field. It's identical to HardCapEQ.
+func HardCap(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldHardCap, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldStatus, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Ico {
 	return predicate.Ico(sql.FieldEQ(FieldCreatedAt, v))
@@ -520,6 +555,46 @@ func PriceGapContainsFold(v string) predicate.Ico {
 	return predicate.Ico(sql.FieldContainsFold(FieldPriceGap, v))
 }
 
+// LifetimeEQ applies the EQ predicate on the "lifetime" field.
+func LifetimeEQ(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldLifetime, v))
+}
+
+// LifetimeNEQ applies the NEQ predicate on the "lifetime" field.
+func LifetimeNEQ(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldLifetime, v))
+}
+
+// LifetimeIn applies the In predicate on the "lifetime" field.
+func LifetimeIn(vs ...int32) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldLifetime, vs...))
+}
+
+// LifetimeNotIn applies the NotIn predicate on the "lifetime" field.
+func LifetimeNotIn(vs ...int32) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldLifetime, vs...))
+}
+
+// LifetimeGT applies the GT predicate on the "lifetime" field.
+func LifetimeGT(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldLifetime, v))
+}
+
+// LifetimeGTE applies the GTE predicate on the "lifetime" field.
+func LifetimeGTE(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldLifetime, v))
+}
+
+// LifetimeLT applies the LT predicate on the "lifetime" field.
+func LifetimeLT(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldLifetime, v))
+}
+
+// LifetimeLTE applies the LTE predicate on the "lifetime" field.
+func LifetimeLTE(v int32) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldLifetime, v))
+}
+
 // EndedAtEQ applies the EQ predicate on the "ended_at" field.
 func EndedAtEQ(v time.Time) predicate.Ico {
 	return predicate.Ico(sql.FieldEQ(FieldEndedAt, v))
@@ -570,6 +645,456 @@ func EndedAtNotNil() predicate.Ico {
 	return predicate.Ico(sql.FieldNotNull(FieldEndedAt))
 }
 
+// LimitsEQ applies the EQ predicate on the "limits" field.
+func LimitsEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldLimits, v))
+}
+
+// LimitsNEQ applies the NEQ predicate on the "limits" field.
+func LimitsNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldLimits, v))
+}
+
+// LimitsIn applies the In predicate on the "limits" field.
+func LimitsIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldLimits, vs...))
+}
+
+// LimitsNotIn applies the NotIn predicate on the "limits" field.
+func LimitsNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldLimits, vs...))
+}
+
+// LimitsGT applies the GT predicate on the "limits" field.
+func LimitsGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldLimits, v))
+}
+
+// LimitsGTE applies the GTE predicate on the "limits" field.
+func LimitsGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldLimits, v))
+}
+
+// LimitsLT applies the LT predicate on the "limits" field.
+func LimitsLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldLimits, v))
+}
+
+// LimitsLTE applies the LTE predicate on the "limits" field.
+func LimitsLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldLimits, v))
+}
+
+// LimitsContains applies the Contains predicate on the "limits" field.
+func LimitsContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldLimits, v))
+}
+
+// LimitsHasPrefix applies the HasPrefix predicate on the "limits" field.
+func LimitsHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldLimits, v))
+}
+
+// LimitsHasSuffix applies the HasSuffix predicate on the "limits" field.
+func LimitsHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldLimits, v))
+}
+
+// LimitsIsNil applies the IsNil predicate on the "limits" field.
+func LimitsIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldLimits))
+}
+
+// LimitsNotNil applies the NotNil predicate on the "limits" field.
+func LimitsNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldLimits))
+}
+
+// LimitsEqualFold applies the EqualFold predicate on the "limits" field.
+func LimitsEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldLimits, v))
+}
+
+// LimitsContainsFold applies the ContainsFold predicate on the "limits" field.
+func LimitsContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldLimits, v))
+}
+
+// PricingEQ applies the EQ predicate on the "pricing" field.
+func PricingEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldPricing, v))
+}
+
+// PricingNEQ applies the NEQ predicate on the "pricing" field.
+func PricingNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldPricing, v))
+}
+
+// PricingIn applies the In predicate on the "pricing" field.
+func PricingIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldPricing, vs...))
+}
+
+// PricingNotIn applies the NotIn predicate on the "pricing" field.
+func PricingNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldPricing, vs...))
+}
+
+// PricingGT applies the GT predicate on the "pricing" field.
+func PricingGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldPricing, v))
+}
+
+// PricingGTE applies the GTE predicate on the "pricing" field.
+func PricingGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldPricing, v))
+}
+
+// PricingLT applies the LT predicate on the "pricing" field.
+func PricingLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldPricing, v))
+}
+
+// PricingLTE applies the LTE predicate on the "pricing" field.
+func PricingLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldPricing, v))
+}
+
+// PricingContains applies the Contains predicate on the "pricing" field.
+func PricingContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldPricing, v))
+}
+
+// PricingHasPrefix applies the HasPrefix predicate on the "pricing" field.
+func PricingHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldPricing, v))
+}
+
+// PricingHasSuffix applies the HasSuffix predicate on the "pricing" field.
+func PricingHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldPricing, v))
+}
+
+// PricingIsNil applies the IsNil predicate on the "pricing" field.
+func PricingIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldPricing))
+}
+
+// PricingNotNil applies the NotNil predicate on the "pricing" field.
+func PricingNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldPricing))
+}
+
+// PricingEqualFold applies the EqualFold predicate on the "pricing" field.
+func PricingEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldPricing, v))
+}
+
+// PricingContainsFold applies the ContainsFold predicate on the "pricing" field.
+func PricingContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldPricing, v))
+}
+
+// UnsoldEQ applies the EQ predicate on the "unsold" field.
+func UnsoldEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldUnsold, v))
+}
+
+// UnsoldNEQ applies the NEQ predicate on the "unsold" field.
+func UnsoldNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldUnsold, v))
+}
+
+// UnsoldIn applies the In predicate on the "unsold" field.
+func UnsoldIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldUnsold, vs...))
+}
+
+// UnsoldNotIn applies the NotIn predicate on the "unsold" field.
+func UnsoldNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldUnsold, vs...))
+}
+
+// UnsoldGT applies the GT predicate on the "unsold" field.
+func UnsoldGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldUnsold, v))
+}
+
+// UnsoldGTE applies the GTE predicate on the "unsold" field.
+func UnsoldGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldUnsold, v))
+}
+
+// UnsoldLT applies the LT predicate on the "unsold" field.
+func UnsoldLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldUnsold, v))
+}
+
+// UnsoldLTE applies the LTE predicate on the "unsold" field.
+func UnsoldLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldUnsold, v))
+}
+
+// UnsoldContains applies the Contains predicate on the "unsold" field.
+func UnsoldContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldUnsold, v))
+}
+
+// UnsoldHasPrefix applies the HasPrefix predicate on the "unsold" field.
+func UnsoldHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldUnsold, v))
+}
+
+// UnsoldHasSuffix applies the HasSuffix predicate on the "unsold" field.
+func UnsoldHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldUnsold, v))
+}
+
+// UnsoldIsNil applies the IsNil predicate on the "unsold" field.
+func UnsoldIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldUnsold))
+}
+
+// UnsoldNotNil applies the NotNil predicate on the "unsold" field.
+func UnsoldNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldUnsold))
+}
+
+// UnsoldEqualFold applies the EqualFold predicate on the "unsold" field.
+func UnsoldEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldUnsold, v))
+}
+
+// UnsoldContainsFold applies the ContainsFold predicate on the "unsold" field.
+func UnsoldContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldUnsold, v))
+}
+
+// SoftCapEQ applies the EQ predicate on the "soft_cap" field.
+func SoftCapEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldSoftCap, v))
+}
+
+// SoftCapNEQ applies the NEQ predicate on the "soft_cap" field.
+func SoftCapNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldSoftCap, v))
+}
+
+// SoftCapIn applies the In predicate on the "soft_cap" field.
+func SoftCapIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldSoftCap, vs...))
+}
+
+// SoftCapNotIn applies the NotIn predicate on the "soft_cap" field.
+func SoftCapNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldSoftCap, vs...))
+}
+
+// SoftCapGT applies the GT predicate on the "soft_cap" field.
+func SoftCapGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldSoftCap, v))
+}
+
+// SoftCapGTE applies the GTE predicate on the "soft_cap" field.
+func SoftCapGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldSoftCap, v))
+}
+
+// SoftCapLT applies the LT predicate on the "soft_cap" field.
+func SoftCapLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldSoftCap, v))
+}
+
+// SoftCapLTE applies the LTE predicate on the "soft_cap" field.
+func SoftCapLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldSoftCap, v))
+}
+
+// SoftCapContains applies the Contains predicate on the "soft_cap" field.
+func SoftCapContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldSoftCap, v))
+}
+
+// SoftCapHasPrefix applies the HasPrefix predicate on the "soft_cap" field.
+func SoftCapHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldSoftCap, v))
+}
+
+// SoftCapHasSuffix applies the HasSuffix predicate on the "soft_cap" field.
+func SoftCapHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldSoftCap, v))
+}
+
+// SoftCapIsNil applies the IsNil predicate on the "soft_cap" field.
+func SoftCapIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldSoftCap))
+}
+
+// SoftCapNotNil applies the NotNil predicate on the "soft_cap" field.
+func SoftCapNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldSoftCap))
+}
+
+// SoftCapEqualFold applies the EqualFold predicate on the "soft_cap" field.
+func SoftCapEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldSoftCap, v))
+}
+
+// SoftCapContainsFold applies the ContainsFold predicate on the "soft_cap" field.
+func SoftCapContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldSoftCap, v))
+}
+
+// HardCapEQ applies the EQ predicate on the "hard_cap" field.
+func HardCapEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldHardCap, v))
+}
+
+// HardCapNEQ applies the NEQ predicate on the "hard_cap" field.
+func HardCapNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldHardCap, v))
+}
+
+// HardCapIn applies the In predicate on the "hard_cap" field.
+func HardCapIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldHardCap, vs...))
+}
+
+// HardCapNotIn applies the NotIn predicate on the "hard_cap" field.
+func HardCapNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldHardCap, vs...))
+}
+
+// HardCapGT applies the GT predicate on the "hard_cap" field.
+func HardCapGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldHardCap, v))
+}
+
+// HardCapGTE applies the GTE predicate on the "hard_cap" field.
+func HardCapGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldHardCap, v))
+}
+
+// HardCapLT applies the LT predicate on the "hard_cap" field.
+func HardCapLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldHardCap, v))
+}
+
+// HardCapLTE applies the LTE predicate on the "hard_cap" field.
+func HardCapLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldHardCap, v))
+}
+
+// HardCapContains applies the Contains predicate on the "hard_cap" field.
+func HardCapContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldHardCap, v))
+}
+
+// HardCapHasPrefix applies the HasPrefix predicate on the "hard_cap" field.
+func HardCapHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldHardCap, v))
+}
+
+// HardCapHasSuffix applies the HasSuffix predicate on the "hard_cap" field.
+func HardCapHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldHardCap, v))
+}
+
+// HardCapIsNil applies the IsNil predicate on the "hard_cap" field.
+func HardCapIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldHardCap))
+}
+
+// HardCapNotNil applies the NotNil predicate on the "hard_cap" field.
+func HardCapNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldHardCap))
+}
+
+// HardCapEqualFold applies the EqualFold predicate on the "hard_cap" field.
+func HardCapEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldHardCap, v))
+}
+
+// HardCapContainsFold applies the ContainsFold predicate on the "hard_cap" field.
+func HardCapContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldHardCap, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Ico {
+	return predicate.Ico(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.Ico {
+	return predicate.Ico(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.Ico {
+	return predicate.Ico(sql.FieldNotNull(FieldStatus))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Ico {
+	return predicate.Ico(sql.FieldContainsFold(FieldStatus, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Ico) predicate.Ico {
 	return predicate.Ico(sql.AndPredicates(predicates...))
@@ -88,6 +88,20 @@ func (ic *IcoCreate) SetPriceGap(s string) *IcoCreate {
 	return ic
 }
 
+// SetLifetime sets the "lifetime" field.
+func (ic *IcoCreate) SetLifetime(i int32) *IcoCreate {
+	ic.mutation.SetLifetime(i)
+	return ic
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableLifetime(i *int32) *IcoCreate {
+	if i != nil {
+		ic.SetLifetime(*i)
+	}
+	return ic
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (ic *IcoCreate) SetEndedAt(t time.Time) *IcoCreate {
 	ic.mutation.SetEndedAt(t)
@@ -102,6 +116,90 @@ func (ic *IcoCreate) SetNillableEndedAt(t *time.Time) *IcoCreate {
 	return ic
 }
 
+// SetLimits sets the "limits" field.
+func (ic *IcoCreate) SetLimits(s string) *IcoCreate {
+	ic.mutation.SetLimits(s)
+	return ic
+}
+
+// SetNillableLimits sets the "limits" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableLimits(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetLimits(*s)
+	}
+	return ic
+}
+
+// SetPricing sets the "pricing" field.
+func (ic *IcoCreate) SetPricing(s string) *IcoCreate {
+	ic.mutation.SetPricing(s)
+	return ic
+}
+
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (ic *IcoCreate) SetNillablePricing(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetPricing(*s)
+	}
+	return ic
+}
+
+// SetUnsold sets the "unsold" field.
+func (ic *IcoCreate) SetUnsold(s string) *IcoCreate {
+	ic.mutation.SetUnsold(s)
+	return ic
+}
+
+// SetNillableUnsold sets the "unsold" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableUnsold(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetUnsold(*s)
+	}
+	return ic
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (ic *IcoCreate) SetSoftCap(s string) *IcoCreate {
+	ic.mutation.SetSoftCap(s)
+	return ic
+}
+
+// SetNillableSoftCap sets the "soft_cap" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableSoftCap(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetSoftCap(*s)
+	}
+	return ic
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (ic *IcoCreate) SetHardCap(s string) *IcoCreate {
+	ic.mutation.SetHardCap(s)
+	return ic
+}
+
+// SetNillableHardCap sets the "hard_cap" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableHardCap(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetHardCap(*s)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *IcoCreate) SetStatus(s string) *IcoCreate {
+	ic.mutation.SetStatus(s)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *IcoCreate) SetNillableStatus(s *string) *IcoCreate {
+	if s != nil {
+		ic.SetStatus(*s)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *IcoCreate) SetID(x xid.ID) *IcoCreate {
 	ic.mutation.SetID(x)
@@ -159,6 +257,10 @@ func (ic *IcoCreate) defaults() {
 		v := ico.DefaultUpdatedAt()
 		ic.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ic.mutation.Lifetime(); !ok {
+		v := ico.DefaultLifetime
+		ic.mutation.SetLifetime(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := ico.DefaultID()
 		ic.mutation.SetID(v)
@@ -191,6 +293,9 @@ func (ic *IcoCreate) check() error {
 	if _, ok := ic.mutation.PriceGap(); !ok {
 		return &ValidationError{Name: "price_gap", err: errors.New(`ent: missing required field "Ico.price_gap"`)}
 	}
+	if _, ok := ic.mutation.Lifetime(); !ok {
+		return &ValidationError{Name: "lifetime", err: errors.New(`ent: missing required field "Ico.lifetime"`)}
+	}
 	return nil
 }
 
@@ -259,10 +364,38 @@ func (ic *IcoCreate) createSpec() (*Ico, *sqlgraph.CreateSpec) {
 		_spec.SetField(ico.FieldPriceGap, field.TypeString, value)
 		_node.PriceGap = value
 	}
+	if value, ok := ic.mutation.Lifetime(); ok {
+		_spec.SetField(ico.FieldLifetime, field.TypeInt32, value)
+		_node.Lifetime = value
+	}
 	if value, ok := ic.mutation.EndedAt(); ok {
 		_spec.SetField(ico.FieldEndedAt, field.TypeTime, value)
 		_node.EndedAt = &value
 	}
+	if value, ok := ic.mutation.Limits(); ok {
+		_spec.SetField(ico.FieldLimits, field.TypeString, value)
+		_node.Limits = value
+	}
+	if value, ok := ic.mutation.Pricing(); ok {
+		_spec.SetField(ico.FieldPricing, field.TypeString, value)
+		_node.Pricing = value
+	}
+	if value, ok := ic.mutation.Unsold(); ok {
+		_spec.SetField(ico.FieldUnsold, field.TypeString, value)
+		_node.Unsold = value
+	}
+	if value, ok := ic.mutation.SoftCap(); ok {
+		_spec.SetField(ico.FieldSoftCap, field.TypeString, value)
+		_node.SoftCap = value
+	}
+	if value, ok := ic.mutation.HardCap(); ok {
+		_spec.SetField(ico.FieldHardCap, field.TypeString, value)
+		_node.HardCap = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(ico.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
 	return _node, _spec
 }
 
@@ -411,6 +544,24 @@ func (u *IcoUpsert) UpdatePriceGap() *IcoUpsert {
 	return u
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoUpsert) SetLifetime(v int32) *IcoUpsert {
+	u.Set(ico.FieldLifetime, v)
+	return u
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateLifetime() *IcoUpsert {
+	u.SetExcluded(ico.FieldLifetime)
+	return u
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoUpsert) AddLifetime(v int32) *IcoUpsert {
+	u.Add(ico.FieldLifetime, v)
+	return u
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (u *IcoUpsert) SetEndedAt(v time.Time) *IcoUpsert {
 	u.Set(ico.FieldEndedAt, v)
@@ -429,6 +580,114 @@ func (u *IcoUpsert) ClearEndedAt() *IcoUpsert {
 	return u
 }
 
+// SetLimits sets the "limits" field.
+func (u *IcoUpsert) SetLimits(v string) *IcoUpsert {
+	u.Set(ico.FieldLimits, v)
+	return u
+}
+
+// UpdateLimits sets the "limits" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateLimits() *IcoUpsert {
+	u.SetExcluded(ico.FieldLimits)
+	return u
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (u *IcoUpsert) ClearLimits() *IcoUpsert {
+	u.SetNull(ico.FieldLimits)
+	return u
+}
+
+// SetPricing sets the "pricing" field.
+func (u *IcoUpsert) SetPricing(v string) *IcoUpsert {
+	u.Set(ico.FieldPricing, v)
+	return u
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *IcoUpsert) UpdatePricing() *IcoUpsert {
+	u.SetExcluded(ico.FieldPricing)
+	return u
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *IcoUpsert) ClearPricing() *IcoUpsert {
+	u.SetNull(ico.FieldPricing)
+	return u
+}
+
+// SetUnsold sets the "unsold" field.
+func (u *IcoUpsert) SetUnsold(v string) *IcoUpsert {
+	u.Set(ico.FieldUnsold, v)
+	return u
+}
+
+// UpdateUnsold sets the "unsold" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateUnsold() *IcoUpsert {
+	u.SetExcluded(ico.FieldUnsold)
+	return u
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (u *IcoUpsert) ClearUnsold() *IcoUpsert {
+	u.SetNull(ico.FieldUnsold)
+	return u
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (u *IcoUpsert) SetSoftCap(v string) *IcoUpsert {
+	u.Set(ico.FieldSoftCap, v)
+	return u
+}
+
+// UpdateSoftCap sets the "soft_cap" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateSoftCap() *IcoUpsert {
+	u.SetExcluded(ico.FieldSoftCap)
+	return u
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (u *IcoUpsert) ClearSoftCap() *IcoUpsert {
+	u.SetNull(ico.FieldSoftCap)
+	return u
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (u *IcoUpsert) SetHardCap(v string) *IcoUpsert {
+	u.Set(ico.FieldHardCap, v)
+	return u
+}
+
+// UpdateHardCap sets the "hard_cap" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateHardCap() *IcoUpsert {
+	u.SetExcluded(ico.FieldHardCap)
+	return u
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (u *IcoUpsert) ClearHardCap() *IcoUpsert {
+	u.SetNull(ico.FieldHardCap)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *IcoUpsert) SetStatus(v string) *IcoUpsert {
+	u.Set(ico.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IcoUpsert) UpdateStatus() *IcoUpsert {
+	u.SetExcluded(ico.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *IcoUpsert) ClearStatus() *IcoUpsert {
+	u.SetNull(ico.FieldStatus)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -592,6 +851,27 @@ func (u *IcoUpsertOne) UpdatePriceGap() *IcoUpsertOne {
 	})
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoUpsertOne) SetLifetime(v int32) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetLifetime(v)
+	})
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoUpsertOne) AddLifetime(v int32) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.AddLifetime(v)
+	})
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateLifetime() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateLifetime()
+	})
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (u *IcoUpsertOne) SetEndedAt(v time.Time) *IcoUpsertOne {
 	return u.Update(func(s *IcoUpsert) {
@@ -613,6 +893,132 @@ func (u *IcoUpsertOne) ClearEndedAt() *IcoUpsertOne {
 	})
 }
 
+// SetLimits sets the "limits" field.
+func (u *IcoUpsertOne) SetLimits(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetLimits(v)
+	})
+}
+
+// UpdateLimits sets the "limits" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateLimits() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateLimits()
+	})
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (u *IcoUpsertOne) ClearLimits() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearLimits()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *IcoUpsertOne) SetPricing(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdatePricing() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *IcoUpsertOne) ClearPricing() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearPricing()
+	})
+}
+
+// SetUnsold sets the "unsold" field.
+func (u *IcoUpsertOne) SetUnsold(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetUnsold(v)
+	})
+}
+
+// UpdateUnsold sets the "unsold" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateUnsold() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateUnsold()
+	})
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (u *IcoUpsertOne) ClearUnsold() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearUnsold()
+	})
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (u *IcoUpsertOne) SetSoftCap(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetSoftCap(v)
+	})
+}
+
+// UpdateSoftCap sets the "soft_cap" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateSoftCap() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateSoftCap()
+	})
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (u *IcoUpsertOne) ClearSoftCap() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearSoftCap()
+	})
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (u *IcoUpsertOne) SetHardCap(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetHardCap(v)
+	})
+}
+
+// UpdateHardCap sets the "hard_cap" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateHardCap() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateHardCap()
+	})
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (u *IcoUpsertOne) ClearHardCap() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearHardCap()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *IcoUpsertOne) SetStatus(v string) *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IcoUpsertOne) UpdateStatus() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *IcoUpsertOne) ClearStatus() *IcoUpsertOne {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -943,6 +1349,27 @@ func (u *IcoUpsertBulk) UpdatePriceGap() *IcoUpsertBulk {
 	})
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoUpsertBulk) SetLifetime(v int32) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetLifetime(v)
+	})
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoUpsertBulk) AddLifetime(v int32) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.AddLifetime(v)
+	})
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateLifetime() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateLifetime()
+	})
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (u *IcoUpsertBulk) SetEndedAt(v time.Time) *IcoUpsertBulk {
 	return u.Update(func(s *IcoUpsert) {
@@ -964,6 +1391,132 @@ func (u *IcoUpsertBulk) ClearEndedAt() *IcoUpsertBulk {
 	})
 }
 
+// SetLimits sets the "limits" field.
+func (u *IcoUpsertBulk) SetLimits(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetLimits(v)
+	})
+}
+
+// UpdateLimits sets the "limits" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateLimits() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateLimits()
+	})
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (u *IcoUpsertBulk) ClearLimits() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearLimits()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *IcoUpsertBulk) SetPricing(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdatePricing() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *IcoUpsertBulk) ClearPricing() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearPricing()
+	})
+}
+
+// SetUnsold sets the "unsold" field.
+func (u *IcoUpsertBulk) SetUnsold(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetUnsold(v)
+	})
+}
+
+// UpdateUnsold sets the "unsold" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateUnsold() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateUnsold()
+	})
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (u *IcoUpsertBulk) ClearUnsold() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearUnsold()
+	})
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (u *IcoUpsertBulk) SetSoftCap(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetSoftCap(v)
+	})
+}
+
+// UpdateSoftCap sets the "soft_cap" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateSoftCap() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateSoftCap()
+	})
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (u *IcoUpsertBulk) ClearSoftCap() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearSoftCap()
+	})
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (u *IcoUpsertBulk) SetHardCap(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetHardCap(v)
+	})
+}
+
+// UpdateHardCap sets the "hard_cap" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateHardCap() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateHardCap()
+	})
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (u *IcoUpsertBulk) ClearHardCap() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearHardCap()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *IcoUpsertBulk) SetStatus(v string) *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IcoUpsertBulk) UpdateStatus() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *IcoUpsertBulk) ClearStatus() *IcoUpsertBulk {
+	return u.Update(func(s *IcoUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
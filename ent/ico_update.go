@@ -133,6 +133,27 @@ func (iu *IcoUpdate) SetNillablePriceGap(s *string) *IcoUpdate {
 	return iu
 }
 
+// SetLifetime sets the "lifetime" field.
+func (iu *IcoUpdate) SetLifetime(i int32) *IcoUpdate {
+	iu.mutation.ResetLifetime()
+	iu.mutation.SetLifetime(i)
+	return iu
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableLifetime(i *int32) *IcoUpdate {
+	if i != nil {
+		iu.SetLifetime(*i)
+	}
+	return iu
+}
+
+// AddLifetime adds i to the "lifetime" field.
+func (iu *IcoUpdate) AddLifetime(i int32) *IcoUpdate {
+	iu.mutation.AddLifetime(i)
+	return iu
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (iu *IcoUpdate) SetEndedAt(t time.Time) *IcoUpdate {
 	iu.mutation.SetEndedAt(t)
@@ -153,6 +174,126 @@ func (iu *IcoUpdate) ClearEndedAt() *IcoUpdate {
 	return iu
 }
 
+// SetLimits sets the "limits" field.
+func (iu *IcoUpdate) SetLimits(s string) *IcoUpdate {
+	iu.mutation.SetLimits(s)
+	return iu
+}
+
+// SetNillableLimits sets the "limits" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableLimits(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetLimits(*s)
+	}
+	return iu
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (iu *IcoUpdate) ClearLimits() *IcoUpdate {
+	iu.mutation.ClearLimits()
+	return iu
+}
+
+// SetPricing sets the "pricing" field.
+func (iu *IcoUpdate) SetPricing(s string) *IcoUpdate {
+	iu.mutation.SetPricing(s)
+	return iu
+}
+
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillablePricing(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetPricing(*s)
+	}
+	return iu
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (iu *IcoUpdate) ClearPricing() *IcoUpdate {
+	iu.mutation.ClearPricing()
+	return iu
+}
+
+// SetUnsold sets the "unsold" field.
+func (iu *IcoUpdate) SetUnsold(s string) *IcoUpdate {
+	iu.mutation.SetUnsold(s)
+	return iu
+}
+
+// SetNillableUnsold sets the "unsold" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableUnsold(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetUnsold(*s)
+	}
+	return iu
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (iu *IcoUpdate) ClearUnsold() *IcoUpdate {
+	iu.mutation.ClearUnsold()
+	return iu
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (iu *IcoUpdate) SetSoftCap(s string) *IcoUpdate {
+	iu.mutation.SetSoftCap(s)
+	return iu
+}
+
+// SetNillableSoftCap sets the "soft_cap" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableSoftCap(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetSoftCap(*s)
+	}
+	return iu
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (iu *IcoUpdate) ClearSoftCap() *IcoUpdate {
+	iu.mutation.ClearSoftCap()
+	return iu
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (iu *IcoUpdate) SetHardCap(s string) *IcoUpdate {
+	iu.mutation.SetHardCap(s)
+	return iu
+}
+
+// SetNillableHardCap sets the "hard_cap" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableHardCap(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetHardCap(*s)
+	}
+	return iu
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (iu *IcoUpdate) ClearHardCap() *IcoUpdate {
+	iu.mutation.ClearHardCap()
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *IcoUpdate) SetStatus(s string) *IcoUpdate {
+	iu.mutation.SetStatus(s)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *IcoUpdate) SetNillableStatus(s *string) *IcoUpdate {
+	if s != nil {
+		iu.SetStatus(*s)
+	}
+	return iu
+}
+
+// ClearStatus clears the value of the "status" field.
+func (iu *IcoUpdate) ClearStatus() *IcoUpdate {
+	iu.mutation.ClearStatus()
+	return iu
+}
+
 // Mutation returns the IcoMutation object of the builder.
 func (iu *IcoUpdate) Mutation() *IcoMutation {
 	return iu.mutation
@@ -236,12 +377,54 @@ func (iu *IcoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.PriceGap(); ok {
 		_spec.SetField(ico.FieldPriceGap, field.TypeString, value)
 	}
+	if value, ok := iu.mutation.Lifetime(); ok {
+		_spec.SetField(ico.FieldLifetime, field.TypeInt32, value)
+	}
+	if value, ok := iu.mutation.AddedLifetime(); ok {
+		_spec.AddField(ico.FieldLifetime, field.TypeInt32, value)
+	}
 	if value, ok := iu.mutation.EndedAt(); ok {
 		_spec.SetField(ico.FieldEndedAt, field.TypeTime, value)
 	}
 	if iu.mutation.EndedAtCleared() {
 		_spec.ClearField(ico.FieldEndedAt, field.TypeTime)
 	}
+	if value, ok := iu.mutation.Limits(); ok {
+		_spec.SetField(ico.FieldLimits, field.TypeString, value)
+	}
+	if iu.mutation.LimitsCleared() {
+		_spec.ClearField(ico.FieldLimits, field.TypeString)
+	}
+	if value, ok := iu.mutation.Pricing(); ok {
+		_spec.SetField(ico.FieldPricing, field.TypeString, value)
+	}
+	if iu.mutation.PricingCleared() {
+		_spec.ClearField(ico.FieldPricing, field.TypeString)
+	}
+	if value, ok := iu.mutation.Unsold(); ok {
+		_spec.SetField(ico.FieldUnsold, field.TypeString, value)
+	}
+	if iu.mutation.UnsoldCleared() {
+		_spec.ClearField(ico.FieldUnsold, field.TypeString)
+	}
+	if value, ok := iu.mutation.SoftCap(); ok {
+		_spec.SetField(ico.FieldSoftCap, field.TypeString, value)
+	}
+	if iu.mutation.SoftCapCleared() {
+		_spec.ClearField(ico.FieldSoftCap, field.TypeString)
+	}
+	if value, ok := iu.mutation.HardCap(); ok {
+		_spec.SetField(ico.FieldHardCap, field.TypeString, value)
+	}
+	if iu.mutation.HardCapCleared() {
+		_spec.ClearField(ico.FieldHardCap, field.TypeString)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(ico.FieldStatus, field.TypeString, value)
+	}
+	if iu.mutation.StatusCleared() {
+		_spec.ClearField(ico.FieldStatus, field.TypeString)
+	}
 	_spec.AddModifiers(iu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -368,6 +551,27 @@ func (iuo *IcoUpdateOne) SetNillablePriceGap(s *string) *IcoUpdateOne {
 	return iuo
 }
 
+// SetLifetime sets the "lifetime" field.
+func (iuo *IcoUpdateOne) SetLifetime(i int32) *IcoUpdateOne {
+	iuo.mutation.ResetLifetime()
+	iuo.mutation.SetLifetime(i)
+	return iuo
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableLifetime(i *int32) *IcoUpdateOne {
+	if i != nil {
+		iuo.SetLifetime(*i)
+	}
+	return iuo
+}
+
+// AddLifetime adds i to the "lifetime" field.
+func (iuo *IcoUpdateOne) AddLifetime(i int32) *IcoUpdateOne {
+	iuo.mutation.AddLifetime(i)
+	return iuo
+}
+
 // SetEndedAt sets the "ended_at" field.
 func (iuo *IcoUpdateOne) SetEndedAt(t time.Time) *IcoUpdateOne {
 	iuo.mutation.SetEndedAt(t)
@@ -388,6 +592,126 @@ func (iuo *IcoUpdateOne) ClearEndedAt() *IcoUpdateOne {
 	return iuo
 }
 
+// SetLimits sets the "limits" field.
+func (iuo *IcoUpdateOne) SetLimits(s string) *IcoUpdateOne {
+	iuo.mutation.SetLimits(s)
+	return iuo
+}
+
+// SetNillableLimits sets the "limits" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableLimits(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetLimits(*s)
+	}
+	return iuo
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (iuo *IcoUpdateOne) ClearLimits() *IcoUpdateOne {
+	iuo.mutation.ClearLimits()
+	return iuo
+}
+
+// SetPricing sets the "pricing" field.
+func (iuo *IcoUpdateOne) SetPricing(s string) *IcoUpdateOne {
+	iuo.mutation.SetPricing(s)
+	return iuo
+}
+
+// SetNillablePricing sets the "pricing" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillablePricing(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetPricing(*s)
+	}
+	return iuo
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (iuo *IcoUpdateOne) ClearPricing() *IcoUpdateOne {
+	iuo.mutation.ClearPricing()
+	return iuo
+}
+
+// SetUnsold sets the "unsold" field.
+func (iuo *IcoUpdateOne) SetUnsold(s string) *IcoUpdateOne {
+	iuo.mutation.SetUnsold(s)
+	return iuo
+}
+
+// SetNillableUnsold sets the "unsold" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableUnsold(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetUnsold(*s)
+	}
+	return iuo
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (iuo *IcoUpdateOne) ClearUnsold() *IcoUpdateOne {
+	iuo.mutation.ClearUnsold()
+	return iuo
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (iuo *IcoUpdateOne) SetSoftCap(s string) *IcoUpdateOne {
+	iuo.mutation.SetSoftCap(s)
+	return iuo
+}
+
+// SetNillableSoftCap sets the "soft_cap" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableSoftCap(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetSoftCap(*s)
+	}
+	return iuo
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (iuo *IcoUpdateOne) ClearSoftCap() *IcoUpdateOne {
+	iuo.mutation.ClearSoftCap()
+	return iuo
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (iuo *IcoUpdateOne) SetHardCap(s string) *IcoUpdateOne {
+	iuo.mutation.SetHardCap(s)
+	return iuo
+}
+
+// SetNillableHardCap sets the "hard_cap" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableHardCap(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetHardCap(*s)
+	}
+	return iuo
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (iuo *IcoUpdateOne) ClearHardCap() *IcoUpdateOne {
+	iuo.mutation.ClearHardCap()
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *IcoUpdateOne) SetStatus(s string) *IcoUpdateOne {
+	iuo.mutation.SetStatus(s)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *IcoUpdateOne) SetNillableStatus(s *string) *IcoUpdateOne {
+	if s != nil {
+		iuo.SetStatus(*s)
+	}
+	return iuo
+}
+
+// ClearStatus clears the value of the "status" field.
+func (iuo *IcoUpdateOne) ClearStatus() *IcoUpdateOne {
+	iuo.mutation.ClearStatus()
+	return iuo
+}
+
 // Mutation returns the IcoMutation object of the builder.
 func (iuo *IcoUpdateOne) Mutation() *IcoMutation {
 	return iuo.mutation
@@ -501,12 +825,54 @@ func (iuo *IcoUpdateOne) sqlSave(ctx context.Context) (_node *Ico, err error) {
 	if value, ok := iuo.mutation.PriceGap(); ok {
 		_spec.SetField(ico.FieldPriceGap, field.TypeString, value)
 	}
+	if value, ok := iuo.mutation.Lifetime(); ok {
+		_spec.SetField(ico.FieldLifetime, field.TypeInt32, value)
+	}
+	if value, ok := iuo.mutation.AddedLifetime(); ok {
+		_spec.AddField(ico.FieldLifetime, field.TypeInt32, value)
+	}
 	if value, ok := iuo.mutation.EndedAt(); ok {
 		_spec.SetField(ico.FieldEndedAt, field.TypeTime, value)
 	}
 	if iuo.mutation.EndedAtCleared() {
 		_spec.ClearField(ico.FieldEndedAt, field.TypeTime)
 	}
+	if value, ok := iuo.mutation.Limits(); ok {
+		_spec.SetField(ico.FieldLimits, field.TypeString, value)
+	}
+	if iuo.mutation.LimitsCleared() {
+		_spec.ClearField(ico.FieldLimits, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Pricing(); ok {
+		_spec.SetField(ico.FieldPricing, field.TypeString, value)
+	}
+	if iuo.mutation.PricingCleared() {
+		_spec.ClearField(ico.FieldPricing, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Unsold(); ok {
+		_spec.SetField(ico.FieldUnsold, field.TypeString, value)
+	}
+	if iuo.mutation.UnsoldCleared() {
+		_spec.ClearField(ico.FieldUnsold, field.TypeString)
+	}
+	if value, ok := iuo.mutation.SoftCap(); ok {
+		_spec.SetField(ico.FieldSoftCap, field.TypeString, value)
+	}
+	if iuo.mutation.SoftCapCleared() {
+		_spec.ClearField(ico.FieldSoftCap, field.TypeString)
+	}
+	if value, ok := iuo.mutation.HardCap(); ok {
+		_spec.SetField(ico.FieldHardCap, field.TypeString, value)
+	}
+	if iuo.mutation.HardCapCleared() {
+		_spec.ClearField(ico.FieldHardCap, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(ico.FieldStatus, field.TypeString, value)
+	}
+	if iuo.mutation.StatusCleared() {
+		_spec.ClearField(ico.FieldStatus, field.TypeString)
+	}
 	_spec.AddModifiers(iuo.modifiers...)
 	_node = &Ico{config: iuo.config}
 	_spec.Assign = _node.assignValues
@@ -30,6 +30,18 @@ type IcoCoupon struct {
 	Reward string `json:"reward,omitempty"`
 	// Cashback holds the value of the "cashback" field.
 	Cashback string `json:"cashback,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses int `json:"max_uses,omitempty"`
+	// Used holds the value of the "used" field.
+	Used int `json:"used,omitempty"`
+	// SingleUse holds the value of the "single_use" field.
+	SingleUse bool `json:"single_use,omitempty"`
+	// MinPurchase holds the value of the "min_purchase" field.
+	MinPurchase string `json:"min_purchase,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
 	selectValues sql.SelectValues
@@ -40,9 +52,13 @@ func (*IcoCoupon) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case icocoupon.FieldUserID, icocoupon.FieldCoupon, icocoupon.FieldReward, icocoupon.FieldCashback:
+		case icocoupon.FieldSingleUse:
+			values[i] = new(sql.NullBool)
+		case icocoupon.FieldMaxUses, icocoupon.FieldUsed:
+			values[i] = new(sql.NullInt64)
+		case icocoupon.FieldUserID, icocoupon.FieldCoupon, icocoupon.FieldReward, icocoupon.FieldCashback, icocoupon.FieldMinPurchase:
 			values[i] = new(sql.NullString)
-		case icocoupon.FieldCreatedAt, icocoupon.FieldUpdatedAt, icocoupon.FieldDeletedAt:
+		case icocoupon.FieldCreatedAt, icocoupon.FieldUpdatedAt, icocoupon.FieldStartsAt, icocoupon.FieldEndsAt, icocoupon.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case icocoupon.FieldID:
 			values[i] = new(xid.ID)
@@ -103,6 +119,44 @@ func (ic *IcoCoupon) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ic.Cashback = value.String
 			}
+		case icocoupon.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				ic.StartsAt = new(time.Time)
+				*ic.StartsAt = value.Time
+			}
+		case icocoupon.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				ic.EndsAt = new(time.Time)
+				*ic.EndsAt = value.Time
+			}
+		case icocoupon.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				ic.MaxUses = int(value.Int64)
+			}
+		case icocoupon.FieldUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used", values[i])
+			} else if value.Valid {
+				ic.Used = int(value.Int64)
+			}
+		case icocoupon.FieldSingleUse:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field single_use", values[i])
+			} else if value.Valid {
+				ic.SingleUse = value.Bool
+			}
+		case icocoupon.FieldMinPurchase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min_purchase", values[i])
+			} else if value.Valid {
+				ic.MinPurchase = value.String
+			}
 		case icocoupon.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -164,6 +218,28 @@ func (ic *IcoCoupon) String() string {
 	builder.WriteString("cashback=")
 	builder.WriteString(ic.Cashback)
 	builder.WriteString(", ")
+	if v := ic.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ic.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", ic.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("used=")
+	builder.WriteString(fmt.Sprintf("%v", ic.Used))
+	builder.WriteString(", ")
+	builder.WriteString("single_use=")
+	builder.WriteString(fmt.Sprintf("%v", ic.SingleUse))
+	builder.WriteString(", ")
+	builder.WriteString("min_purchase=")
+	builder.WriteString(ic.MinPurchase)
+	builder.WriteString(", ")
 	if v := ic.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -26,6 +26,18 @@ const (
 	FieldReward = "reward"
 	// FieldCashback holds the string denoting the cashback field in the database.
 	FieldCashback = "cashback"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldMaxUses holds the string denoting the max_uses field in the database.
+	FieldMaxUses = "max_uses"
+	// FieldUsed holds the string denoting the used field in the database.
+	FieldUsed = "used"
+	// FieldSingleUse holds the string denoting the single_use field in the database.
+	FieldSingleUse = "single_use"
+	// FieldMinPurchase holds the string denoting the min_purchase field in the database.
+	FieldMinPurchase = "min_purchase"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
 	// Table holds the table name of the icocoupon in the database.
@@ -41,6 +53,12 @@ var Columns = []string{
 	FieldCoupon,
 	FieldReward,
 	FieldCashback,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldMaxUses,
+	FieldUsed,
+	FieldSingleUse,
+	FieldMinPurchase,
 	FieldDeletedAt,
 }
 
@@ -61,6 +79,12 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultMaxUses holds the default value on creation for the "max_uses" field.
+	DefaultMaxUses int
+	// DefaultUsed holds the default value on creation for the "used" field.
+	DefaultUsed int
+	// DefaultSingleUse holds the default value on creation for the "single_use" field.
+	DefaultSingleUse bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() xid.ID
 )
@@ -103,6 +127,36 @@ func ByCashback(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCashback, opts...).ToFunc()
 }
 
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByMaxUses orders the results by the max_uses field.
+func ByMaxUses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUses, opts...).ToFunc()
+}
+
+// ByUsed orders the results by the used field.
+func ByUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsed, opts...).ToFunc()
+}
+
+// BySingleUse orders the results by the single_use field.
+func BySingleUse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSingleUse, opts...).ToFunc()
+}
+
+// ByMinPurchase orders the results by the min_purchase field.
+func ByMinPurchase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinPurchase, opts...).ToFunc()
+}
+
 // ByDeletedAt orders the results by the deleted_at field.
 func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
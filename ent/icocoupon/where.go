@@ -85,6 +85,36 @@ func Cashback(v string) predicate.IcoCoupon {
 	return predicate.IcoCoupon(sql.FieldEQ(FieldCashback, v))
 }
 
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// Used applies equality check predicate on the "used" field. It's identical to UsedEQ.
+func Used(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldUsed, v))
+}
+
+// SingleUse applies equality check predicate on the "single_use" field. It's identical to SingleUseEQ.
+func SingleUse(v bool) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldSingleUse, v))
+}
+
+// MinPurchase applies equality check predicate on the "min_purchase" field. It's identical to MinPurchaseEQ.
+func MinPurchase(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldMinPurchase, v))
+}
+
 // DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
 func DeletedAt(v time.Time) predicate.IcoCoupon {
 	return predicate.IcoCoupon(sql.FieldEQ(FieldDeletedAt, v))
@@ -430,6 +460,271 @@ func CashbackContainsFold(v string) predicate.IcoCoupon {
 	return predicate.IcoCoupon(sql.FieldContainsFold(FieldCashback, v))
 }
 
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotNull(FieldStartsAt))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotNull(FieldEndsAt))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// UsedEQ applies the EQ predicate on the "used" field.
+func UsedEQ(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldUsed, v))
+}
+
+// UsedNEQ applies the NEQ predicate on the "used" field.
+func UsedNEQ(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldUsed, v))
+}
+
+// UsedIn applies the In predicate on the "used" field.
+func UsedIn(vs ...int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIn(FieldUsed, vs...))
+}
+
+// UsedNotIn applies the NotIn predicate on the "used" field.
+func UsedNotIn(vs ...int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotIn(FieldUsed, vs...))
+}
+
+// UsedGT applies the GT predicate on the "used" field.
+func UsedGT(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGT(FieldUsed, v))
+}
+
+// UsedGTE applies the GTE predicate on the "used" field.
+func UsedGTE(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGTE(FieldUsed, v))
+}
+
+// UsedLT applies the LT predicate on the "used" field.
+func UsedLT(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLT(FieldUsed, v))
+}
+
+// UsedLTE applies the LTE predicate on the "used" field.
+func UsedLTE(v int) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLTE(FieldUsed, v))
+}
+
+// SingleUseEQ applies the EQ predicate on the "single_use" field.
+func SingleUseEQ(v bool) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldSingleUse, v))
+}
+
+// SingleUseNEQ applies the NEQ predicate on the "single_use" field.
+func SingleUseNEQ(v bool) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldSingleUse, v))
+}
+
+// MinPurchaseEQ applies the EQ predicate on the "min_purchase" field.
+func MinPurchaseEQ(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEQ(FieldMinPurchase, v))
+}
+
+// MinPurchaseNEQ applies the NEQ predicate on the "min_purchase" field.
+func MinPurchaseNEQ(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNEQ(FieldMinPurchase, v))
+}
+
+// MinPurchaseIn applies the In predicate on the "min_purchase" field.
+func MinPurchaseIn(vs ...string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIn(FieldMinPurchase, vs...))
+}
+
+// MinPurchaseNotIn applies the NotIn predicate on the "min_purchase" field.
+func MinPurchaseNotIn(vs ...string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotIn(FieldMinPurchase, vs...))
+}
+
+// MinPurchaseGT applies the GT predicate on the "min_purchase" field.
+func MinPurchaseGT(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGT(FieldMinPurchase, v))
+}
+
+// MinPurchaseGTE applies the GTE predicate on the "min_purchase" field.
+func MinPurchaseGTE(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldGTE(FieldMinPurchase, v))
+}
+
+// MinPurchaseLT applies the LT predicate on the "min_purchase" field.
+func MinPurchaseLT(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLT(FieldMinPurchase, v))
+}
+
+// MinPurchaseLTE applies the LTE predicate on the "min_purchase" field.
+func MinPurchaseLTE(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldLTE(FieldMinPurchase, v))
+}
+
+// MinPurchaseContains applies the Contains predicate on the "min_purchase" field.
+func MinPurchaseContains(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldContains(FieldMinPurchase, v))
+}
+
+// MinPurchaseHasPrefix applies the HasPrefix predicate on the "min_purchase" field.
+func MinPurchaseHasPrefix(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldHasPrefix(FieldMinPurchase, v))
+}
+
+// MinPurchaseHasSuffix applies the HasSuffix predicate on the "min_purchase" field.
+func MinPurchaseHasSuffix(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldHasSuffix(FieldMinPurchase, v))
+}
+
+// MinPurchaseIsNil applies the IsNil predicate on the "min_purchase" field.
+func MinPurchaseIsNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldIsNull(FieldMinPurchase))
+}
+
+// MinPurchaseNotNil applies the NotNil predicate on the "min_purchase" field.
+func MinPurchaseNotNil() predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldNotNull(FieldMinPurchase))
+}
+
+// MinPurchaseEqualFold applies the EqualFold predicate on the "min_purchase" field.
+func MinPurchaseEqualFold(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldEqualFold(FieldMinPurchase, v))
+}
+
+// MinPurchaseContainsFold applies the ContainsFold predicate on the "min_purchase" field.
+func MinPurchaseContainsFold(v string) predicate.IcoCoupon {
+	return predicate.IcoCoupon(sql.FieldContainsFold(FieldMinPurchase, v))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.IcoCoupon {
 	return predicate.IcoCoupon(sql.FieldEQ(FieldDeletedAt, v))
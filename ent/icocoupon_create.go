@@ -76,6 +76,90 @@ func (icc *IcoCouponCreate) SetCashback(s string) *IcoCouponCreate {
 	return icc
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (icc *IcoCouponCreate) SetStartsAt(t time.Time) *IcoCouponCreate {
+	icc.mutation.SetStartsAt(t)
+	return icc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableStartsAt(t *time.Time) *IcoCouponCreate {
+	if t != nil {
+		icc.SetStartsAt(*t)
+	}
+	return icc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (icc *IcoCouponCreate) SetEndsAt(t time.Time) *IcoCouponCreate {
+	icc.mutation.SetEndsAt(t)
+	return icc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableEndsAt(t *time.Time) *IcoCouponCreate {
+	if t != nil {
+		icc.SetEndsAt(*t)
+	}
+	return icc
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icc *IcoCouponCreate) SetMaxUses(i int) *IcoCouponCreate {
+	icc.mutation.SetMaxUses(i)
+	return icc
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableMaxUses(i *int) *IcoCouponCreate {
+	if i != nil {
+		icc.SetMaxUses(*i)
+	}
+	return icc
+}
+
+// SetUsed sets the "used" field.
+func (icc *IcoCouponCreate) SetUsed(i int) *IcoCouponCreate {
+	icc.mutation.SetUsed(i)
+	return icc
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableUsed(i *int) *IcoCouponCreate {
+	if i != nil {
+		icc.SetUsed(*i)
+	}
+	return icc
+}
+
+// SetSingleUse sets the "single_use" field.
+func (icc *IcoCouponCreate) SetSingleUse(b bool) *IcoCouponCreate {
+	icc.mutation.SetSingleUse(b)
+	return icc
+}
+
+// SetNillableSingleUse sets the "single_use" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableSingleUse(b *bool) *IcoCouponCreate {
+	if b != nil {
+		icc.SetSingleUse(*b)
+	}
+	return icc
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (icc *IcoCouponCreate) SetMinPurchase(s string) *IcoCouponCreate {
+	icc.mutation.SetMinPurchase(s)
+	return icc
+}
+
+// SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
+func (icc *IcoCouponCreate) SetNillableMinPurchase(s *string) *IcoCouponCreate {
+	if s != nil {
+		icc.SetMinPurchase(*s)
+	}
+	return icc
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (icc *IcoCouponCreate) SetDeletedAt(t time.Time) *IcoCouponCreate {
 	icc.mutation.SetDeletedAt(t)
@@ -147,6 +231,18 @@ func (icc *IcoCouponCreate) defaults() {
 		v := icocoupon.DefaultUpdatedAt()
 		icc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := icc.mutation.MaxUses(); !ok {
+		v := icocoupon.DefaultMaxUses
+		icc.mutation.SetMaxUses(v)
+	}
+	if _, ok := icc.mutation.Used(); !ok {
+		v := icocoupon.DefaultUsed
+		icc.mutation.SetUsed(v)
+	}
+	if _, ok := icc.mutation.SingleUse(); !ok {
+		v := icocoupon.DefaultSingleUse
+		icc.mutation.SetSingleUse(v)
+	}
 	if _, ok := icc.mutation.ID(); !ok {
 		v := icocoupon.DefaultID()
 		icc.mutation.SetID(v)
@@ -173,6 +269,15 @@ func (icc *IcoCouponCreate) check() error {
 	if _, ok := icc.mutation.Cashback(); !ok {
 		return &ValidationError{Name: "cashback", err: errors.New(`ent: missing required field "IcoCoupon.cashback"`)}
 	}
+	if _, ok := icc.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "IcoCoupon.max_uses"`)}
+	}
+	if _, ok := icc.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "IcoCoupon.used"`)}
+	}
+	if _, ok := icc.mutation.SingleUse(); !ok {
+		return &ValidationError{Name: "single_use", err: errors.New(`ent: missing required field "IcoCoupon.single_use"`)}
+	}
 	return nil
 }
 
@@ -233,6 +338,30 @@ func (icc *IcoCouponCreate) createSpec() (*IcoCoupon, *sqlgraph.CreateSpec) {
 		_spec.SetField(icocoupon.FieldCashback, field.TypeString, value)
 		_node.Cashback = value
 	}
+	if value, ok := icc.mutation.StartsAt(); ok {
+		_spec.SetField(icocoupon.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := icc.mutation.EndsAt(); ok {
+		_spec.SetField(icocoupon.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := icc.mutation.MaxUses(); ok {
+		_spec.SetField(icocoupon.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := icc.mutation.Used(); ok {
+		_spec.SetField(icocoupon.FieldUsed, field.TypeInt, value)
+		_node.Used = value
+	}
+	if value, ok := icc.mutation.SingleUse(); ok {
+		_spec.SetField(icocoupon.FieldSingleUse, field.TypeBool, value)
+		_node.SingleUse = value
+	}
+	if value, ok := icc.mutation.MinPurchase(); ok {
+		_spec.SetField(icocoupon.FieldMinPurchase, field.TypeString, value)
+		_node.MinPurchase = value
+	}
 	if value, ok := icc.mutation.DeletedAt(); ok {
 		_spec.SetField(icocoupon.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -349,6 +478,108 @@ func (u *IcoCouponUpsert) UpdateCashback() *IcoCouponUpsert {
 	return u
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (u *IcoCouponUpsert) SetStartsAt(v time.Time) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateStartsAt() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldStartsAt)
+	return u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *IcoCouponUpsert) ClearStartsAt() *IcoCouponUpsert {
+	u.SetNull(icocoupon.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *IcoCouponUpsert) SetEndsAt(v time.Time) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateEndsAt() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldEndsAt)
+	return u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *IcoCouponUpsert) ClearEndsAt() *IcoCouponUpsert {
+	u.SetNull(icocoupon.FieldEndsAt)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *IcoCouponUpsert) SetMaxUses(v int) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateMaxUses() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *IcoCouponUpsert) AddMaxUses(v int) *IcoCouponUpsert {
+	u.Add(icocoupon.FieldMaxUses, v)
+	return u
+}
+
+// SetUsed sets the "used" field.
+func (u *IcoCouponUpsert) SetUsed(v int) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldUsed, v)
+	return u
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateUsed() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldUsed)
+	return u
+}
+
+// AddUsed adds v to the "used" field.
+func (u *IcoCouponUpsert) AddUsed(v int) *IcoCouponUpsert {
+	u.Add(icocoupon.FieldUsed, v)
+	return u
+}
+
+// SetSingleUse sets the "single_use" field.
+func (u *IcoCouponUpsert) SetSingleUse(v bool) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldSingleUse, v)
+	return u
+}
+
+// UpdateSingleUse sets the "single_use" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateSingleUse() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldSingleUse)
+	return u
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (u *IcoCouponUpsert) SetMinPurchase(v string) *IcoCouponUpsert {
+	u.Set(icocoupon.FieldMinPurchase, v)
+	return u
+}
+
+// UpdateMinPurchase sets the "min_purchase" field to the value that was provided on create.
+func (u *IcoCouponUpsert) UpdateMinPurchase() *IcoCouponUpsert {
+	u.SetExcluded(icocoupon.FieldMinPurchase)
+	return u
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (u *IcoCouponUpsert) ClearMinPurchase() *IcoCouponUpsert {
+	u.SetNull(icocoupon.FieldMinPurchase)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *IcoCouponUpsert) SetDeletedAt(v time.Time) *IcoCouponUpsert {
 	u.Set(icocoupon.FieldDeletedAt, v)
@@ -488,6 +719,125 @@ func (u *IcoCouponUpsertOne) UpdateCashback() *IcoCouponUpsertOne {
 	})
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (u *IcoCouponUpsertOne) SetStartsAt(v time.Time) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateStartsAt() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *IcoCouponUpsertOne) ClearStartsAt() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *IcoCouponUpsertOne) SetEndsAt(v time.Time) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateEndsAt() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *IcoCouponUpsertOne) ClearEndsAt() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *IcoCouponUpsertOne) SetMaxUses(v int) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *IcoCouponUpsertOne) AddMaxUses(v int) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateMaxUses() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsed sets the "used" field.
+func (u *IcoCouponUpsertOne) SetUsed(v int) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *IcoCouponUpsertOne) AddUsed(v int) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateUsed() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetSingleUse sets the "single_use" field.
+func (u *IcoCouponUpsertOne) SetSingleUse(v bool) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetSingleUse(v)
+	})
+}
+
+// UpdateSingleUse sets the "single_use" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateSingleUse() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateSingleUse()
+	})
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (u *IcoCouponUpsertOne) SetMinPurchase(v string) *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetMinPurchase(v)
+	})
+}
+
+// UpdateMinPurchase sets the "min_purchase" field to the value that was provided on create.
+func (u *IcoCouponUpsertOne) UpdateMinPurchase() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateMinPurchase()
+	})
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (u *IcoCouponUpsertOne) ClearMinPurchase() *IcoCouponUpsertOne {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearMinPurchase()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *IcoCouponUpsertOne) SetDeletedAt(v time.Time) *IcoCouponUpsertOne {
 	return u.Update(func(s *IcoCouponUpsert) {
@@ -797,6 +1147,125 @@ func (u *IcoCouponUpsertBulk) UpdateCashback() *IcoCouponUpsertBulk {
 	})
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (u *IcoCouponUpsertBulk) SetStartsAt(v time.Time) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateStartsAt() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *IcoCouponUpsertBulk) ClearStartsAt() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *IcoCouponUpsertBulk) SetEndsAt(v time.Time) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateEndsAt() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *IcoCouponUpsertBulk) ClearEndsAt() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *IcoCouponUpsertBulk) SetMaxUses(v int) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *IcoCouponUpsertBulk) AddMaxUses(v int) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateMaxUses() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsed sets the "used" field.
+func (u *IcoCouponUpsertBulk) SetUsed(v int) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *IcoCouponUpsertBulk) AddUsed(v int) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateUsed() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetSingleUse sets the "single_use" field.
+func (u *IcoCouponUpsertBulk) SetSingleUse(v bool) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetSingleUse(v)
+	})
+}
+
+// UpdateSingleUse sets the "single_use" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateSingleUse() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateSingleUse()
+	})
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (u *IcoCouponUpsertBulk) SetMinPurchase(v string) *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.SetMinPurchase(v)
+	})
+}
+
+// UpdateMinPurchase sets the "min_purchase" field to the value that was provided on create.
+func (u *IcoCouponUpsertBulk) UpdateMinPurchase() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.UpdateMinPurchase()
+	})
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (u *IcoCouponUpsertBulk) ClearMinPurchase() *IcoCouponUpsertBulk {
+	return u.Update(func(s *IcoCouponUpsert) {
+		s.ClearMinPurchase()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *IcoCouponUpsertBulk) SetDeletedAt(v time.Time) *IcoCouponUpsertBulk {
 	return u.Update(func(s *IcoCouponUpsert) {
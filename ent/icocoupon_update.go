@@ -91,6 +91,122 @@ func (icu *IcoCouponUpdate) SetNillableCashback(s *string) *IcoCouponUpdate {
 	return icu
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (icu *IcoCouponUpdate) SetStartsAt(t time.Time) *IcoCouponUpdate {
+	icu.mutation.SetStartsAt(t)
+	return icu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableStartsAt(t *time.Time) *IcoCouponUpdate {
+	if t != nil {
+		icu.SetStartsAt(*t)
+	}
+	return icu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (icu *IcoCouponUpdate) ClearStartsAt() *IcoCouponUpdate {
+	icu.mutation.ClearStartsAt()
+	return icu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (icu *IcoCouponUpdate) SetEndsAt(t time.Time) *IcoCouponUpdate {
+	icu.mutation.SetEndsAt(t)
+	return icu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableEndsAt(t *time.Time) *IcoCouponUpdate {
+	if t != nil {
+		icu.SetEndsAt(*t)
+	}
+	return icu
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (icu *IcoCouponUpdate) ClearEndsAt() *IcoCouponUpdate {
+	icu.mutation.ClearEndsAt()
+	return icu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icu *IcoCouponUpdate) SetMaxUses(i int) *IcoCouponUpdate {
+	icu.mutation.ResetMaxUses()
+	icu.mutation.SetMaxUses(i)
+	return icu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableMaxUses(i *int) *IcoCouponUpdate {
+	if i != nil {
+		icu.SetMaxUses(*i)
+	}
+	return icu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (icu *IcoCouponUpdate) AddMaxUses(i int) *IcoCouponUpdate {
+	icu.mutation.AddMaxUses(i)
+	return icu
+}
+
+// SetUsed sets the "used" field.
+func (icu *IcoCouponUpdate) SetUsed(i int) *IcoCouponUpdate {
+	icu.mutation.ResetUsed()
+	icu.mutation.SetUsed(i)
+	return icu
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableUsed(i *int) *IcoCouponUpdate {
+	if i != nil {
+		icu.SetUsed(*i)
+	}
+	return icu
+}
+
+// AddUsed adds i to the "used" field.
+func (icu *IcoCouponUpdate) AddUsed(i int) *IcoCouponUpdate {
+	icu.mutation.AddUsed(i)
+	return icu
+}
+
+// SetSingleUse sets the "single_use" field.
+func (icu *IcoCouponUpdate) SetSingleUse(b bool) *IcoCouponUpdate {
+	icu.mutation.SetSingleUse(b)
+	return icu
+}
+
+// SetNillableSingleUse sets the "single_use" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableSingleUse(b *bool) *IcoCouponUpdate {
+	if b != nil {
+		icu.SetSingleUse(*b)
+	}
+	return icu
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (icu *IcoCouponUpdate) SetMinPurchase(s string) *IcoCouponUpdate {
+	icu.mutation.SetMinPurchase(s)
+	return icu
+}
+
+// SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
+func (icu *IcoCouponUpdate) SetNillableMinPurchase(s *string) *IcoCouponUpdate {
+	if s != nil {
+		icu.SetMinPurchase(*s)
+	}
+	return icu
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (icu *IcoCouponUpdate) ClearMinPurchase() *IcoCouponUpdate {
+	icu.mutation.ClearMinPurchase()
+	return icu
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (icu *IcoCouponUpdate) SetDeletedAt(t time.Time) *IcoCouponUpdate {
 	icu.mutation.SetDeletedAt(t)
@@ -182,6 +298,39 @@ func (icu *IcoCouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := icu.mutation.Cashback(); ok {
 		_spec.SetField(icocoupon.FieldCashback, field.TypeString, value)
 	}
+	if value, ok := icu.mutation.StartsAt(); ok {
+		_spec.SetField(icocoupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if icu.mutation.StartsAtCleared() {
+		_spec.ClearField(icocoupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := icu.mutation.EndsAt(); ok {
+		_spec.SetField(icocoupon.FieldEndsAt, field.TypeTime, value)
+	}
+	if icu.mutation.EndsAtCleared() {
+		_spec.ClearField(icocoupon.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := icu.mutation.MaxUses(); ok {
+		_spec.SetField(icocoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(icocoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.Used(); ok {
+		_spec.SetField(icocoupon.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedUsed(); ok {
+		_spec.AddField(icocoupon.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.SingleUse(); ok {
+		_spec.SetField(icocoupon.FieldSingleUse, field.TypeBool, value)
+	}
+	if value, ok := icu.mutation.MinPurchase(); ok {
+		_spec.SetField(icocoupon.FieldMinPurchase, field.TypeString, value)
+	}
+	if icu.mutation.MinPurchaseCleared() {
+		_spec.ClearField(icocoupon.FieldMinPurchase, field.TypeString)
+	}
 	if value, ok := icu.mutation.DeletedAt(); ok {
 		_spec.SetField(icocoupon.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -272,6 +421,122 @@ func (icuo *IcoCouponUpdateOne) SetNillableCashback(s *string) *IcoCouponUpdateO
 	return icuo
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (icuo *IcoCouponUpdateOne) SetStartsAt(t time.Time) *IcoCouponUpdateOne {
+	icuo.mutation.SetStartsAt(t)
+	return icuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableStartsAt(t *time.Time) *IcoCouponUpdateOne {
+	if t != nil {
+		icuo.SetStartsAt(*t)
+	}
+	return icuo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (icuo *IcoCouponUpdateOne) ClearStartsAt() *IcoCouponUpdateOne {
+	icuo.mutation.ClearStartsAt()
+	return icuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (icuo *IcoCouponUpdateOne) SetEndsAt(t time.Time) *IcoCouponUpdateOne {
+	icuo.mutation.SetEndsAt(t)
+	return icuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableEndsAt(t *time.Time) *IcoCouponUpdateOne {
+	if t != nil {
+		icuo.SetEndsAt(*t)
+	}
+	return icuo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (icuo *IcoCouponUpdateOne) ClearEndsAt() *IcoCouponUpdateOne {
+	icuo.mutation.ClearEndsAt()
+	return icuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icuo *IcoCouponUpdateOne) SetMaxUses(i int) *IcoCouponUpdateOne {
+	icuo.mutation.ResetMaxUses()
+	icuo.mutation.SetMaxUses(i)
+	return icuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableMaxUses(i *int) *IcoCouponUpdateOne {
+	if i != nil {
+		icuo.SetMaxUses(*i)
+	}
+	return icuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (icuo *IcoCouponUpdateOne) AddMaxUses(i int) *IcoCouponUpdateOne {
+	icuo.mutation.AddMaxUses(i)
+	return icuo
+}
+
+// SetUsed sets the "used" field.
+func (icuo *IcoCouponUpdateOne) SetUsed(i int) *IcoCouponUpdateOne {
+	icuo.mutation.ResetUsed()
+	icuo.mutation.SetUsed(i)
+	return icuo
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableUsed(i *int) *IcoCouponUpdateOne {
+	if i != nil {
+		icuo.SetUsed(*i)
+	}
+	return icuo
+}
+
+// AddUsed adds i to the "used" field.
+func (icuo *IcoCouponUpdateOne) AddUsed(i int) *IcoCouponUpdateOne {
+	icuo.mutation.AddUsed(i)
+	return icuo
+}
+
+// SetSingleUse sets the "single_use" field.
+func (icuo *IcoCouponUpdateOne) SetSingleUse(b bool) *IcoCouponUpdateOne {
+	icuo.mutation.SetSingleUse(b)
+	return icuo
+}
+
+// SetNillableSingleUse sets the "single_use" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableSingleUse(b *bool) *IcoCouponUpdateOne {
+	if b != nil {
+		icuo.SetSingleUse(*b)
+	}
+	return icuo
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (icuo *IcoCouponUpdateOne) SetMinPurchase(s string) *IcoCouponUpdateOne {
+	icuo.mutation.SetMinPurchase(s)
+	return icuo
+}
+
+// SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
+func (icuo *IcoCouponUpdateOne) SetNillableMinPurchase(s *string) *IcoCouponUpdateOne {
+	if s != nil {
+		icuo.SetMinPurchase(*s)
+	}
+	return icuo
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (icuo *IcoCouponUpdateOne) ClearMinPurchase() *IcoCouponUpdateOne {
+	icuo.mutation.ClearMinPurchase()
+	return icuo
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (icuo *IcoCouponUpdateOne) SetDeletedAt(t time.Time) *IcoCouponUpdateOne {
 	icuo.mutation.SetDeletedAt(t)
@@ -393,6 +658,39 @@ func (icuo *IcoCouponUpdateOne) sqlSave(ctx context.Context) (_node *IcoCoupon,
 	if value, ok := icuo.mutation.Cashback(); ok {
 		_spec.SetField(icocoupon.FieldCashback, field.TypeString, value)
 	}
+	if value, ok := icuo.mutation.StartsAt(); ok {
+		_spec.SetField(icocoupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if icuo.mutation.StartsAtCleared() {
+		_spec.ClearField(icocoupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := icuo.mutation.EndsAt(); ok {
+		_spec.SetField(icocoupon.FieldEndsAt, field.TypeTime, value)
+	}
+	if icuo.mutation.EndsAtCleared() {
+		_spec.ClearField(icocoupon.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := icuo.mutation.MaxUses(); ok {
+		_spec.SetField(icocoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(icocoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.Used(); ok {
+		_spec.SetField(icocoupon.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedUsed(); ok {
+		_spec.AddField(icocoupon.FieldUsed, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.SingleUse(); ok {
+		_spec.SetField(icocoupon.FieldSingleUse, field.TypeBool, value)
+	}
+	if value, ok := icuo.mutation.MinPurchase(); ok {
+		_spec.SetField(icocoupon.FieldMinPurchase, field.TypeString, value)
+	}
+	if icuo.mutation.MinPurchaseCleared() {
+		_spec.ClearField(icocoupon.FieldMinPurchase, field.TypeString)
+	}
 	if value, ok := icuo.mutation.DeletedAt(); ok {
 		_spec.SetField(icocoupon.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/rs/xid"
+)
+
+// IcoCouponRedemption is the model entity for the IcoCouponRedemption schema.
+type IcoCouponRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CouponID holds the value of the "coupon_id" field.
+	CouponID xid.ID `json:"coupon_id,omitempty"`
+	// Coupon holds the value of the "coupon" field.
+	Coupon string `json:"coupon,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID string `json:"source_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Reward holds the value of the "reward" field.
+	Reward string `json:"reward,omitempty"`
+	// Cashback holds the value of the "cashback" field.
+	Cashback     string `json:"cashback,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IcoCouponRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case icocouponredemption.FieldCoupon, icocouponredemption.FieldUserID, icocouponredemption.FieldSourceID, icocouponredemption.FieldAmount, icocouponredemption.FieldSymbol, icocouponredemption.FieldReward, icocouponredemption.FieldCashback:
+			values[i] = new(sql.NullString)
+		case icocouponredemption.FieldCreatedAt, icocouponredemption.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case icocouponredemption.FieldID, icocouponredemption.FieldCouponID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IcoCouponRedemption fields.
+func (icr *IcoCouponRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case icocouponredemption.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				icr.ID = *value
+			}
+		case icocouponredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				icr.CreatedAt = value.Time
+			}
+		case icocouponredemption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				icr.UpdatedAt = value.Time
+			}
+		case icocouponredemption.FieldCouponID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value != nil {
+				icr.CouponID = *value
+			}
+		case icocouponredemption.FieldCoupon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon", values[i])
+			} else if value.Valid {
+				icr.Coupon = value.String
+			}
+		case icocouponredemption.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				icr.UserID = value.String
+			}
+		case icocouponredemption.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				icr.SourceID = value.String
+			}
+		case icocouponredemption.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				icr.Amount = value.String
+			}
+		case icocouponredemption.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				icr.Symbol = value.String
+			}
+		case icocouponredemption.FieldReward:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reward", values[i])
+			} else if value.Valid {
+				icr.Reward = value.String
+			}
+		case icocouponredemption.FieldCashback:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cashback", values[i])
+			} else if value.Valid {
+				icr.Cashback = value.String
+			}
+		default:
+			icr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IcoCouponRedemption.
+// This includes values selected through modifiers, order, etc.
+func (icr *IcoCouponRedemption) Value(name string) (ent.Value, error) {
+	return icr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IcoCouponRedemption.
+// Note that you need to call IcoCouponRedemption.Unwrap() before calling this method if this IcoCouponRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (icr *IcoCouponRedemption) Update() *IcoCouponRedemptionUpdateOne {
+	return NewIcoCouponRedemptionClient(icr.config).UpdateOne(icr)
+}
+
+// Unwrap unwraps the IcoCouponRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (icr *IcoCouponRedemption) Unwrap() *IcoCouponRedemption {
+	_tx, ok := icr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IcoCouponRedemption is not a transactional entity")
+	}
+	icr.config.driver = _tx.drv
+	return icr
+}
+
+// String implements the fmt.Stringer.
+func (icr *IcoCouponRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("IcoCouponRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", icr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(icr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(icr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_id=")
+	builder.WriteString(fmt.Sprintf("%v", icr.CouponID))
+	builder.WriteString(", ")
+	builder.WriteString("coupon=")
+	builder.WriteString(icr.Coupon)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(icr.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(icr.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(icr.Amount)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(icr.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("reward=")
+	builder.WriteString(icr.Reward)
+	builder.WriteString(", ")
+	builder.WriteString("cashback=")
+	builder.WriteString(icr.Cashback)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IcoCouponRedemptions is a parsable slice of IcoCouponRedemption.
+type IcoCouponRedemptions []*IcoCouponRedemption
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package icocouponredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// Coupon applies equality check predicate on the "coupon" field. It's identical to CouponEQ.
+func Coupon(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCoupon, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldSourceID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldAmount, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Reward applies equality check predicate on the "reward" field. It's identical to RewardEQ.
+func Reward(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldReward, v))
+}
+
+// Cashback applies equality check predicate on the "cashback" field. It's identical to CashbackEQ.
+func Cashback(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCashback, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v xid.ID) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldCouponID, v))
+}
+
+// CouponIDContains applies the Contains predicate on the "coupon_id" field.
+func CouponIDContains(v xid.ID) predicate.IcoCouponRedemption {
+	vc := v.String()
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldCouponID, vc))
+}
+
+// CouponIDHasPrefix applies the HasPrefix predicate on the "coupon_id" field.
+func CouponIDHasPrefix(v xid.ID) predicate.IcoCouponRedemption {
+	vc := v.String()
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldCouponID, vc))
+}
+
+// CouponIDHasSuffix applies the HasSuffix predicate on the "coupon_id" field.
+func CouponIDHasSuffix(v xid.ID) predicate.IcoCouponRedemption {
+	vc := v.String()
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldCouponID, vc))
+}
+
+// CouponIDEqualFold applies the EqualFold predicate on the "coupon_id" field.
+func CouponIDEqualFold(v xid.ID) predicate.IcoCouponRedemption {
+	vc := v.String()
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldCouponID, vc))
+}
+
+// CouponIDContainsFold applies the ContainsFold predicate on the "coupon_id" field.
+func CouponIDContainsFold(v xid.ID) predicate.IcoCouponRedemption {
+	vc := v.String()
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldCouponID, vc))
+}
+
+// CouponEQ applies the EQ predicate on the "coupon" field.
+func CouponEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCoupon, v))
+}
+
+// CouponNEQ applies the NEQ predicate on the "coupon" field.
+func CouponNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldCoupon, v))
+}
+
+// CouponIn applies the In predicate on the "coupon" field.
+func CouponIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldCoupon, vs...))
+}
+
+// CouponNotIn applies the NotIn predicate on the "coupon" field.
+func CouponNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldCoupon, vs...))
+}
+
+// CouponGT applies the GT predicate on the "coupon" field.
+func CouponGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldCoupon, v))
+}
+
+// CouponGTE applies the GTE predicate on the "coupon" field.
+func CouponGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldCoupon, v))
+}
+
+// CouponLT applies the LT predicate on the "coupon" field.
+func CouponLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldCoupon, v))
+}
+
+// CouponLTE applies the LTE predicate on the "coupon" field.
+func CouponLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldCoupon, v))
+}
+
+// CouponContains applies the Contains predicate on the "coupon" field.
+func CouponContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldCoupon, v))
+}
+
+// CouponHasPrefix applies the HasPrefix predicate on the "coupon" field.
+func CouponHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldCoupon, v))
+}
+
+// CouponHasSuffix applies the HasSuffix predicate on the "coupon" field.
+func CouponHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldCoupon, v))
+}
+
+// CouponEqualFold applies the EqualFold predicate on the "coupon" field.
+func CouponEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldCoupon, v))
+}
+
+// CouponContainsFold applies the ContainsFold predicate on the "coupon" field.
+func CouponContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldCoupon, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldAmount, v))
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldAmount, v))
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldAmount, v))
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldAmount, v))
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldAmount, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// RewardEQ applies the EQ predicate on the "reward" field.
+func RewardEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldReward, v))
+}
+
+// RewardNEQ applies the NEQ predicate on the "reward" field.
+func RewardNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldReward, v))
+}
+
+// RewardIn applies the In predicate on the "reward" field.
+func RewardIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldReward, vs...))
+}
+
+// RewardNotIn applies the NotIn predicate on the "reward" field.
+func RewardNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldReward, vs...))
+}
+
+// RewardGT applies the GT predicate on the "reward" field.
+func RewardGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldReward, v))
+}
+
+// RewardGTE applies the GTE predicate on the "reward" field.
+func RewardGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldReward, v))
+}
+
+// RewardLT applies the LT predicate on the "reward" field.
+func RewardLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldReward, v))
+}
+
+// RewardLTE applies the LTE predicate on the "reward" field.
+func RewardLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldReward, v))
+}
+
+// RewardContains applies the Contains predicate on the "reward" field.
+func RewardContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldReward, v))
+}
+
+// RewardHasPrefix applies the HasPrefix predicate on the "reward" field.
+func RewardHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldReward, v))
+}
+
+// RewardHasSuffix applies the HasSuffix predicate on the "reward" field.
+func RewardHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldReward, v))
+}
+
+// RewardEqualFold applies the EqualFold predicate on the "reward" field.
+func RewardEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldReward, v))
+}
+
+// RewardContainsFold applies the ContainsFold predicate on the "reward" field.
+func RewardContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldReward, v))
+}
+
+// CashbackEQ applies the EQ predicate on the "cashback" field.
+func CashbackEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEQ(FieldCashback, v))
+}
+
+// CashbackNEQ applies the NEQ predicate on the "cashback" field.
+func CashbackNEQ(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNEQ(FieldCashback, v))
+}
+
+// CashbackIn applies the In predicate on the "cashback" field.
+func CashbackIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldIn(FieldCashback, vs...))
+}
+
+// CashbackNotIn applies the NotIn predicate on the "cashback" field.
+func CashbackNotIn(vs ...string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldNotIn(FieldCashback, vs...))
+}
+
+// CashbackGT applies the GT predicate on the "cashback" field.
+func CashbackGT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGT(FieldCashback, v))
+}
+
+// CashbackGTE applies the GTE predicate on the "cashback" field.
+func CashbackGTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldGTE(FieldCashback, v))
+}
+
+// CashbackLT applies the LT predicate on the "cashback" field.
+func CashbackLT(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLT(FieldCashback, v))
+}
+
+// CashbackLTE applies the LTE predicate on the "cashback" field.
+func CashbackLTE(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldLTE(FieldCashback, v))
+}
+
+// CashbackContains applies the Contains predicate on the "cashback" field.
+func CashbackContains(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContains(FieldCashback, v))
+}
+
+// CashbackHasPrefix applies the HasPrefix predicate on the "cashback" field.
+func CashbackHasPrefix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasPrefix(FieldCashback, v))
+}
+
+// CashbackHasSuffix applies the HasSuffix predicate on the "cashback" field.
+func CashbackHasSuffix(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldHasSuffix(FieldCashback, v))
+}
+
+// CashbackEqualFold applies the EqualFold predicate on the "cashback" field.
+func CashbackEqualFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldEqualFold(FieldCashback, v))
+}
+
+// CashbackContainsFold applies the ContainsFold predicate on the "cashback" field.
+func CashbackContainsFold(v string) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.FieldContainsFold(FieldCashback, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IcoCouponRedemption) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IcoCouponRedemption) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IcoCouponRedemption) predicate.IcoCouponRedemption {
+	return predicate.IcoCouponRedemption(sql.NotPredicates(p))
+}
@@ -0,0 +1,976 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/rs/xid"
+)
+
+// IcoCouponRedemptionCreate is the builder for creating a IcoCouponRedemption entity.
+type IcoCouponRedemptionCreate struct {
+	config
+	mutation *IcoCouponRedemptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icrc *IcoCouponRedemptionCreate) SetCreatedAt(t time.Time) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetCreatedAt(t)
+	return icrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icrc *IcoCouponRedemptionCreate) SetNillableCreatedAt(t *time.Time) *IcoCouponRedemptionCreate {
+	if t != nil {
+		icrc.SetCreatedAt(*t)
+	}
+	return icrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icrc *IcoCouponRedemptionCreate) SetUpdatedAt(t time.Time) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetUpdatedAt(t)
+	return icrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (icrc *IcoCouponRedemptionCreate) SetNillableUpdatedAt(t *time.Time) *IcoCouponRedemptionCreate {
+	if t != nil {
+		icrc.SetUpdatedAt(*t)
+	}
+	return icrc
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (icrc *IcoCouponRedemptionCreate) SetCouponID(x xid.ID) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetCouponID(x)
+	return icrc
+}
+
+// SetCoupon sets the "coupon" field.
+func (icrc *IcoCouponRedemptionCreate) SetCoupon(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetCoupon(s)
+	return icrc
+}
+
+// SetUserID sets the "user_id" field.
+func (icrc *IcoCouponRedemptionCreate) SetUserID(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetUserID(s)
+	return icrc
+}
+
+// SetSourceID sets the "source_id" field.
+func (icrc *IcoCouponRedemptionCreate) SetSourceID(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetSourceID(s)
+	return icrc
+}
+
+// SetAmount sets the "amount" field.
+func (icrc *IcoCouponRedemptionCreate) SetAmount(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetAmount(s)
+	return icrc
+}
+
+// SetSymbol sets the "symbol" field.
+func (icrc *IcoCouponRedemptionCreate) SetSymbol(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetSymbol(s)
+	return icrc
+}
+
+// SetReward sets the "reward" field.
+func (icrc *IcoCouponRedemptionCreate) SetReward(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetReward(s)
+	return icrc
+}
+
+// SetCashback sets the "cashback" field.
+func (icrc *IcoCouponRedemptionCreate) SetCashback(s string) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetCashback(s)
+	return icrc
+}
+
+// SetID sets the "id" field.
+func (icrc *IcoCouponRedemptionCreate) SetID(x xid.ID) *IcoCouponRedemptionCreate {
+	icrc.mutation.SetID(x)
+	return icrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (icrc *IcoCouponRedemptionCreate) SetNillableID(x *xid.ID) *IcoCouponRedemptionCreate {
+	if x != nil {
+		icrc.SetID(*x)
+	}
+	return icrc
+}
+
+// Mutation returns the IcoCouponRedemptionMutation object of the builder.
+func (icrc *IcoCouponRedemptionCreate) Mutation() *IcoCouponRedemptionMutation {
+	return icrc.mutation
+}
+
+// Save creates the IcoCouponRedemption in the database.
+func (icrc *IcoCouponRedemptionCreate) Save(ctx context.Context) (*IcoCouponRedemption, error) {
+	icrc.defaults()
+	return withHooks(ctx, icrc.sqlSave, icrc.mutation, icrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icrc *IcoCouponRedemptionCreate) SaveX(ctx context.Context) *IcoCouponRedemption {
+	v, err := icrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icrc *IcoCouponRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := icrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icrc *IcoCouponRedemptionCreate) ExecX(ctx context.Context) {
+	if err := icrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icrc *IcoCouponRedemptionCreate) defaults() {
+	if _, ok := icrc.mutation.CreatedAt(); !ok {
+		v := icocouponredemption.DefaultCreatedAt()
+		icrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := icrc.mutation.UpdatedAt(); !ok {
+		v := icocouponredemption.DefaultUpdatedAt()
+		icrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := icrc.mutation.ID(); !ok {
+		v := icocouponredemption.DefaultID()
+		icrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icrc *IcoCouponRedemptionCreate) check() error {
+	if _, ok := icrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IcoCouponRedemption.created_at"`)}
+	}
+	if _, ok := icrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IcoCouponRedemption.updated_at"`)}
+	}
+	if _, ok := icrc.mutation.CouponID(); !ok {
+		return &ValidationError{Name: "coupon_id", err: errors.New(`ent: missing required field "IcoCouponRedemption.coupon_id"`)}
+	}
+	if _, ok := icrc.mutation.Coupon(); !ok {
+		return &ValidationError{Name: "coupon", err: errors.New(`ent: missing required field "IcoCouponRedemption.coupon"`)}
+	}
+	if _, ok := icrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "IcoCouponRedemption.user_id"`)}
+	}
+	if _, ok := icrc.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "IcoCouponRedemption.source_id"`)}
+	}
+	if _, ok := icrc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "IcoCouponRedemption.amount"`)}
+	}
+	if _, ok := icrc.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "IcoCouponRedemption.symbol"`)}
+	}
+	if _, ok := icrc.mutation.Reward(); !ok {
+		return &ValidationError{Name: "reward", err: errors.New(`ent: missing required field "IcoCouponRedemption.reward"`)}
+	}
+	if _, ok := icrc.mutation.Cashback(); !ok {
+		return &ValidationError{Name: "cashback", err: errors.New(`ent: missing required field "IcoCouponRedemption.cashback"`)}
+	}
+	return nil
+}
+
+func (icrc *IcoCouponRedemptionCreate) sqlSave(ctx context.Context) (*IcoCouponRedemption, error) {
+	if err := icrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	icrc.mutation.id = &_node.ID
+	icrc.mutation.done = true
+	return _node, nil
+}
+
+func (icrc *IcoCouponRedemptionCreate) createSpec() (*IcoCouponRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IcoCouponRedemption{config: icrc.config}
+		_spec = sqlgraph.NewCreateSpec(icocouponredemption.Table, sqlgraph.NewFieldSpec(icocouponredemption.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = icrc.conflict
+	if id, ok := icrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := icrc.mutation.CreatedAt(); ok {
+		_spec.SetField(icocouponredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := icrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(icocouponredemption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := icrc.mutation.CouponID(); ok {
+		_spec.SetField(icocouponredemption.FieldCouponID, field.TypeString, value)
+		_node.CouponID = value
+	}
+	if value, ok := icrc.mutation.Coupon(); ok {
+		_spec.SetField(icocouponredemption.FieldCoupon, field.TypeString, value)
+		_node.Coupon = value
+	}
+	if value, ok := icrc.mutation.UserID(); ok {
+		_spec.SetField(icocouponredemption.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := icrc.mutation.SourceID(); ok {
+		_spec.SetField(icocouponredemption.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := icrc.mutation.Amount(); ok {
+		_spec.SetField(icocouponredemption.FieldAmount, field.TypeString, value)
+		_node.Amount = value
+	}
+	if value, ok := icrc.mutation.Symbol(); ok {
+		_spec.SetField(icocouponredemption.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := icrc.mutation.Reward(); ok {
+		_spec.SetField(icocouponredemption.FieldReward, field.TypeString, value)
+		_node.Reward = value
+	}
+	if value, ok := icrc.mutation.Cashback(); ok {
+		_spec.SetField(icocouponredemption.FieldCashback, field.TypeString, value)
+		_node.Cashback = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoCouponRedemption.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoCouponRedemptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icrc *IcoCouponRedemptionCreate) OnConflict(opts ...sql.ConflictOption) *IcoCouponRedemptionUpsertOne {
+	icrc.conflict = opts
+	return &IcoCouponRedemptionUpsertOne{
+		create: icrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icrc *IcoCouponRedemptionCreate) OnConflictColumns(columns ...string) *IcoCouponRedemptionUpsertOne {
+	icrc.conflict = append(icrc.conflict, sql.ConflictColumns(columns...))
+	return &IcoCouponRedemptionUpsertOne{
+		create: icrc,
+	}
+}
+
+type (
+	// IcoCouponRedemptionUpsertOne is the builder for "upsert"-ing
+	//  one IcoCouponRedemption node.
+	IcoCouponRedemptionUpsertOne struct {
+		create *IcoCouponRedemptionCreate
+	}
+
+	// IcoCouponRedemptionUpsert is the "OnConflict" setter.
+	IcoCouponRedemptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoCouponRedemptionUpsert) SetUpdatedAt(v time.Time) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateUpdatedAt() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldUpdatedAt)
+	return u
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (u *IcoCouponRedemptionUpsert) SetCouponID(v xid.ID) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldCouponID, v)
+	return u
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateCouponID() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldCouponID)
+	return u
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *IcoCouponRedemptionUpsert) SetCoupon(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldCoupon, v)
+	return u
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateCoupon() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldCoupon)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoCouponRedemptionUpsert) SetUserID(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateUserID() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldUserID)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoCouponRedemptionUpsert) SetSourceID(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateSourceID() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldSourceID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoCouponRedemptionUpsert) SetAmount(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateAmount() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldAmount)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoCouponRedemptionUpsert) SetSymbol(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateSymbol() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldSymbol)
+	return u
+}
+
+// SetReward sets the "reward" field.
+func (u *IcoCouponRedemptionUpsert) SetReward(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldReward, v)
+	return u
+}
+
+// UpdateReward sets the "reward" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateReward() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldReward)
+	return u
+}
+
+// SetCashback sets the "cashback" field.
+func (u *IcoCouponRedemptionUpsert) SetCashback(v string) *IcoCouponRedemptionUpsert {
+	u.Set(icocouponredemption.FieldCashback, v)
+	return u
+}
+
+// UpdateCashback sets the "cashback" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsert) UpdateCashback() *IcoCouponRedemptionUpsert {
+	u.SetExcluded(icocouponredemption.FieldCashback)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icocouponredemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoCouponRedemptionUpsertOne) UpdateNewValues() *IcoCouponRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(icocouponredemption.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(icocouponredemption.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IcoCouponRedemptionUpsertOne) Ignore() *IcoCouponRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoCouponRedemptionUpsertOne) DoNothing() *IcoCouponRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoCouponRedemptionCreate.OnConflict
+// documentation for more info.
+func (u *IcoCouponRedemptionUpsertOne) Update(set func(*IcoCouponRedemptionUpsert)) *IcoCouponRedemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoCouponRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoCouponRedemptionUpsertOne) SetUpdatedAt(v time.Time) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateUpdatedAt() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (u *IcoCouponRedemptionUpsertOne) SetCouponID(v xid.ID) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCouponID(v)
+	})
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateCouponID() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCouponID()
+	})
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *IcoCouponRedemptionUpsertOne) SetCoupon(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCoupon(v)
+	})
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateCoupon() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCoupon()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoCouponRedemptionUpsertOne) SetUserID(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateUserID() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoCouponRedemptionUpsertOne) SetSourceID(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateSourceID() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoCouponRedemptionUpsertOne) SetAmount(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateAmount() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoCouponRedemptionUpsertOne) SetSymbol(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateSymbol() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetReward sets the "reward" field.
+func (u *IcoCouponRedemptionUpsertOne) SetReward(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetReward(v)
+	})
+}
+
+// UpdateReward sets the "reward" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateReward() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateReward()
+	})
+}
+
+// SetCashback sets the "cashback" field.
+func (u *IcoCouponRedemptionUpsertOne) SetCashback(v string) *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCashback(v)
+	})
+}
+
+// UpdateCashback sets the "cashback" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertOne) UpdateCashback() *IcoCouponRedemptionUpsertOne {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCashback()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoCouponRedemptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoCouponRedemptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoCouponRedemptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IcoCouponRedemptionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: IcoCouponRedemptionUpsertOne.ID is not supported by MySQL driver. Use IcoCouponRedemptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IcoCouponRedemptionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IcoCouponRedemptionCreateBulk is the builder for creating many IcoCouponRedemption entities in bulk.
+type IcoCouponRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*IcoCouponRedemptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IcoCouponRedemption entities in the database.
+func (icrcb *IcoCouponRedemptionCreateBulk) Save(ctx context.Context) ([]*IcoCouponRedemption, error) {
+	if icrcb.err != nil {
+		return nil, icrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icrcb.builders))
+	nodes := make([]*IcoCouponRedemption, len(icrcb.builders))
+	mutators := make([]Mutator, len(icrcb.builders))
+	for i := range icrcb.builders {
+		func(i int, root context.Context) {
+			builder := icrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IcoCouponRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icrcb *IcoCouponRedemptionCreateBulk) SaveX(ctx context.Context) []*IcoCouponRedemption {
+	v, err := icrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icrcb *IcoCouponRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := icrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icrcb *IcoCouponRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := icrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoCouponRedemption.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoCouponRedemptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icrcb *IcoCouponRedemptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *IcoCouponRedemptionUpsertBulk {
+	icrcb.conflict = opts
+	return &IcoCouponRedemptionUpsertBulk{
+		create: icrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icrcb *IcoCouponRedemptionCreateBulk) OnConflictColumns(columns ...string) *IcoCouponRedemptionUpsertBulk {
+	icrcb.conflict = append(icrcb.conflict, sql.ConflictColumns(columns...))
+	return &IcoCouponRedemptionUpsertBulk{
+		create: icrcb,
+	}
+}
+
+// IcoCouponRedemptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of IcoCouponRedemption nodes.
+type IcoCouponRedemptionUpsertBulk struct {
+	create *IcoCouponRedemptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icocouponredemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoCouponRedemptionUpsertBulk) UpdateNewValues() *IcoCouponRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(icocouponredemption.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(icocouponredemption.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoCouponRedemption.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IcoCouponRedemptionUpsertBulk) Ignore() *IcoCouponRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoCouponRedemptionUpsertBulk) DoNothing() *IcoCouponRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoCouponRedemptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *IcoCouponRedemptionUpsertBulk) Update(set func(*IcoCouponRedemptionUpsert)) *IcoCouponRedemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoCouponRedemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetUpdatedAt(v time.Time) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateUpdatedAt() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetCouponID(v xid.ID) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCouponID(v)
+	})
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateCouponID() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCouponID()
+	})
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetCoupon(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCoupon(v)
+	})
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateCoupon() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCoupon()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetUserID(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateUserID() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetSourceID(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateSourceID() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetAmount(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateAmount() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetSymbol(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateSymbol() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetReward sets the "reward" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetReward(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetReward(v)
+	})
+}
+
+// UpdateReward sets the "reward" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateReward() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateReward()
+	})
+}
+
+// SetCashback sets the "cashback" field.
+func (u *IcoCouponRedemptionUpsertBulk) SetCashback(v string) *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.SetCashback(v)
+	})
+}
+
+// UpdateCashback sets the "cashback" field to the value that was provided on create.
+func (u *IcoCouponRedemptionUpsertBulk) UpdateCashback() *IcoCouponRedemptionUpsertBulk {
+	return u.Update(func(s *IcoCouponRedemptionUpsert) {
+		s.UpdateCashback()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoCouponRedemptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IcoCouponRedemptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoCouponRedemptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoCouponRedemptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// IcoCouponRedemptionDelete is the builder for deleting a IcoCouponRedemption entity.
+type IcoCouponRedemptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *IcoCouponRedemptionMutation
+}
+
+// Where appends a list predicates to the IcoCouponRedemptionDelete builder.
+func (icrd *IcoCouponRedemptionDelete) Where(ps ...predicate.IcoCouponRedemption) *IcoCouponRedemptionDelete {
+	icrd.mutation.Where(ps...)
+	return icrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icrd *IcoCouponRedemptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icrd.sqlExec, icrd.mutation, icrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icrd *IcoCouponRedemptionDelete) ExecX(ctx context.Context) int {
+	n, err := icrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icrd *IcoCouponRedemptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(icocouponredemption.Table, sqlgraph.NewFieldSpec(icocouponredemption.FieldID, field.TypeString))
+	if ps := icrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icrd.mutation.done = true
+	return affected, err
+}
+
+// IcoCouponRedemptionDeleteOne is the builder for deleting a single IcoCouponRedemption entity.
+type IcoCouponRedemptionDeleteOne struct {
+	icrd *IcoCouponRedemptionDelete
+}
+
+// Where appends a list predicates to the IcoCouponRedemptionDelete builder.
+func (icrdo *IcoCouponRedemptionDeleteOne) Where(ps ...predicate.IcoCouponRedemption) *IcoCouponRedemptionDeleteOne {
+	icrdo.icrd.mutation.Where(ps...)
+	return icrdo
+}
+
+// Exec executes the deletion query.
+func (icrdo *IcoCouponRedemptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := icrdo.icrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{icocouponredemption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icrdo *IcoCouponRedemptionDeleteOne) ExecX(ctx context.Context) {
+	if err := icrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// IcoCouponRedemptionQuery is the builder for querying IcoCouponRedemption entities.
+type IcoCouponRedemptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []icocouponredemption.OrderOption
+	inters     []Interceptor
+	predicates []predicate.IcoCouponRedemption
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IcoCouponRedemptionQuery builder.
+func (icrq *IcoCouponRedemptionQuery) Where(ps ...predicate.IcoCouponRedemption) *IcoCouponRedemptionQuery {
+	icrq.predicates = append(icrq.predicates, ps...)
+	return icrq
+}
+
+// Limit the number of records to be returned by this query.
+func (icrq *IcoCouponRedemptionQuery) Limit(limit int) *IcoCouponRedemptionQuery {
+	icrq.ctx.Limit = &limit
+	return icrq
+}
+
+// Offset to start from.
+func (icrq *IcoCouponRedemptionQuery) Offset(offset int) *IcoCouponRedemptionQuery {
+	icrq.ctx.Offset = &offset
+	return icrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (icrq *IcoCouponRedemptionQuery) Unique(unique bool) *IcoCouponRedemptionQuery {
+	icrq.ctx.Unique = &unique
+	return icrq
+}
+
+// Order specifies how the records should be ordered.
+func (icrq *IcoCouponRedemptionQuery) Order(o ...icocouponredemption.OrderOption) *IcoCouponRedemptionQuery {
+	icrq.order = append(icrq.order, o...)
+	return icrq
+}
+
+// First returns the first IcoCouponRedemption entity from the query.
+// Returns a *NotFoundError when no IcoCouponRedemption was found.
+func (icrq *IcoCouponRedemptionQuery) First(ctx context.Context) (*IcoCouponRedemption, error) {
+	nodes, err := icrq.Limit(1).All(setContextOp(ctx, icrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{icocouponredemption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) FirstX(ctx context.Context) *IcoCouponRedemption {
+	node, err := icrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IcoCouponRedemption ID from the query.
+// Returns a *NotFoundError when no IcoCouponRedemption ID was found.
+func (icrq *IcoCouponRedemptionQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = icrq.Limit(1).IDs(setContextOp(ctx, icrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{icocouponredemption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := icrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IcoCouponRedemption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IcoCouponRedemption entity is found.
+// Returns a *NotFoundError when no IcoCouponRedemption entities are found.
+func (icrq *IcoCouponRedemptionQuery) Only(ctx context.Context) (*IcoCouponRedemption, error) {
+	nodes, err := icrq.Limit(2).All(setContextOp(ctx, icrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{icocouponredemption.Label}
+	default:
+		return nil, &NotSingularError{icocouponredemption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) OnlyX(ctx context.Context) *IcoCouponRedemption {
+	node, err := icrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IcoCouponRedemption ID in the query.
+// Returns a *NotSingularError when more than one IcoCouponRedemption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (icrq *IcoCouponRedemptionQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = icrq.Limit(2).IDs(setContextOp(ctx, icrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{icocouponredemption.Label}
+	default:
+		err = &NotSingularError{icocouponredemption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := icrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IcoCouponRedemptions.
+func (icrq *IcoCouponRedemptionQuery) All(ctx context.Context) ([]*IcoCouponRedemption, error) {
+	ctx = setContextOp(ctx, icrq.ctx, "All")
+	if err := icrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IcoCouponRedemption, *IcoCouponRedemptionQuery]()
+	return withInterceptors[[]*IcoCouponRedemption](ctx, icrq, qr, icrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) AllX(ctx context.Context) []*IcoCouponRedemption {
+	nodes, err := icrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IcoCouponRedemption IDs.
+func (icrq *IcoCouponRedemptionQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if icrq.ctx.Unique == nil && icrq.path != nil {
+		icrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, icrq.ctx, "IDs")
+	if err = icrq.Select(icocouponredemption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := icrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (icrq *IcoCouponRedemptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, icrq.ctx, "Count")
+	if err := icrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, icrq, querierCount[*IcoCouponRedemptionQuery](), icrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) CountX(ctx context.Context) int {
+	count, err := icrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (icrq *IcoCouponRedemptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, icrq.ctx, "Exist")
+	switch _, err := icrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (icrq *IcoCouponRedemptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := icrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IcoCouponRedemptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (icrq *IcoCouponRedemptionQuery) Clone() *IcoCouponRedemptionQuery {
+	if icrq == nil {
+		return nil
+	}
+	return &IcoCouponRedemptionQuery{
+		config:     icrq.config,
+		ctx:        icrq.ctx.Clone(),
+		order:      append([]icocouponredemption.OrderOption{}, icrq.order...),
+		inters:     append([]Interceptor{}, icrq.inters...),
+		predicates: append([]predicate.IcoCouponRedemption{}, icrq.predicates...),
+		// clone intermediate query.
+		sql:  icrq.sql.Clone(),
+		path: icrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IcoCouponRedemption.Query().
+//		GroupBy(icocouponredemption.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (icrq *IcoCouponRedemptionQuery) GroupBy(field string, fields ...string) *IcoCouponRedemptionGroupBy {
+	icrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IcoCouponRedemptionGroupBy{build: icrq}
+	grbuild.flds = &icrq.ctx.Fields
+	grbuild.label = icocouponredemption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.IcoCouponRedemption.Query().
+//		Select(icocouponredemption.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (icrq *IcoCouponRedemptionQuery) Select(fields ...string) *IcoCouponRedemptionSelect {
+	icrq.ctx.Fields = append(icrq.ctx.Fields, fields...)
+	sbuild := &IcoCouponRedemptionSelect{IcoCouponRedemptionQuery: icrq}
+	sbuild.label = icocouponredemption.Label
+	sbuild.flds, sbuild.scan = &icrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IcoCouponRedemptionSelect configured with the given aggregations.
+func (icrq *IcoCouponRedemptionQuery) Aggregate(fns ...AggregateFunc) *IcoCouponRedemptionSelect {
+	return icrq.Select().Aggregate(fns...)
+}
+
+func (icrq *IcoCouponRedemptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range icrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, icrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range icrq.ctx.Fields {
+		if !icocouponredemption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if icrq.path != nil {
+		prev, err := icrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		icrq.sql = prev
+	}
+	return nil
+}
+
+func (icrq *IcoCouponRedemptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IcoCouponRedemption, error) {
+	var (
+		nodes = []*IcoCouponRedemption{}
+		_spec = icrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IcoCouponRedemption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IcoCouponRedemption{config: icrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(icrq.modifiers) > 0 {
+		_spec.Modifiers = icrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, icrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (icrq *IcoCouponRedemptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := icrq.querySpec()
+	if len(icrq.modifiers) > 0 {
+		_spec.Modifiers = icrq.modifiers
+	}
+	_spec.Node.Columns = icrq.ctx.Fields
+	if len(icrq.ctx.Fields) > 0 {
+		_spec.Unique = icrq.ctx.Unique != nil && *icrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, icrq.driver, _spec)
+}
+
+func (icrq *IcoCouponRedemptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(icocouponredemption.Table, icocouponredemption.Columns, sqlgraph.NewFieldSpec(icocouponredemption.FieldID, field.TypeString))
+	_spec.From = icrq.sql
+	if unique := icrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if icrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := icrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icocouponredemption.FieldID)
+		for i := range fields {
+			if fields[i] != icocouponredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := icrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := icrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := icrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := icrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (icrq *IcoCouponRedemptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(icrq.driver.Dialect())
+	t1 := builder.Table(icocouponredemption.Table)
+	columns := icrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = icocouponredemption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if icrq.sql != nil {
+		selector = icrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if icrq.ctx.Unique != nil && *icrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range icrq.modifiers {
+		m(selector)
+	}
+	for _, p := range icrq.predicates {
+		p(selector)
+	}
+	for _, p := range icrq.order {
+		p(selector)
+	}
+	if offset := icrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := icrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (icrq *IcoCouponRedemptionQuery) Modify(modifiers ...func(s *sql.Selector)) *IcoCouponRedemptionSelect {
+	icrq.modifiers = append(icrq.modifiers, modifiers...)
+	return icrq.Select()
+}
+
+// IcoCouponRedemptionGroupBy is the group-by builder for IcoCouponRedemption entities.
+type IcoCouponRedemptionGroupBy struct {
+	selector
+	build *IcoCouponRedemptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icrgb *IcoCouponRedemptionGroupBy) Aggregate(fns ...AggregateFunc) *IcoCouponRedemptionGroupBy {
+	icrgb.fns = append(icrgb.fns, fns...)
+	return icrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icrgb *IcoCouponRedemptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, icrgb.build.ctx, "GroupBy")
+	if err := icrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoCouponRedemptionQuery, *IcoCouponRedemptionGroupBy](ctx, icrgb.build, icrgb, icrgb.build.inters, v)
+}
+
+func (icrgb *IcoCouponRedemptionGroupBy) sqlScan(ctx context.Context, root *IcoCouponRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(icrgb.fns))
+	for _, fn := range icrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*icrgb.flds)+len(icrgb.fns))
+		for _, f := range *icrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*icrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IcoCouponRedemptionSelect is the builder for selecting fields of IcoCouponRedemption entities.
+type IcoCouponRedemptionSelect struct {
+	*IcoCouponRedemptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (icrs *IcoCouponRedemptionSelect) Aggregate(fns ...AggregateFunc) *IcoCouponRedemptionSelect {
+	icrs.fns = append(icrs.fns, fns...)
+	return icrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icrs *IcoCouponRedemptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, icrs.ctx, "Select")
+	if err := icrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoCouponRedemptionQuery, *IcoCouponRedemptionSelect](ctx, icrs.IcoCouponRedemptionQuery, icrs, icrs.inters, v)
+}
+
+func (icrs *IcoCouponRedemptionSelect) sqlScan(ctx context.Context, root *IcoCouponRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(icrs.fns))
+	for _, fn := range icrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*icrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (icrs *IcoCouponRedemptionSelect) Modify(modifiers ...func(s *sql.Selector)) *IcoCouponRedemptionSelect {
+	icrs.modifiers = append(icrs.modifiers, modifiers...)
+	return icrs
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// IcoCouponRedemptionUpdate is the builder for updating IcoCouponRedemption entities.
+type IcoCouponRedemptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IcoCouponRedemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IcoCouponRedemptionUpdate builder.
+func (icru *IcoCouponRedemptionUpdate) Where(ps ...predicate.IcoCouponRedemption) *IcoCouponRedemptionUpdate {
+	icru.mutation.Where(ps...)
+	return icru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icru *IcoCouponRedemptionUpdate) SetUpdatedAt(t time.Time) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetUpdatedAt(t)
+	return icru
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (icru *IcoCouponRedemptionUpdate) SetCouponID(x xid.ID) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetCouponID(x)
+	return icru
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableCouponID(x *xid.ID) *IcoCouponRedemptionUpdate {
+	if x != nil {
+		icru.SetCouponID(*x)
+	}
+	return icru
+}
+
+// SetCoupon sets the "coupon" field.
+func (icru *IcoCouponRedemptionUpdate) SetCoupon(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetCoupon(s)
+	return icru
+}
+
+// SetNillableCoupon sets the "coupon" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableCoupon(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetCoupon(*s)
+	}
+	return icru
+}
+
+// SetUserID sets the "user_id" field.
+func (icru *IcoCouponRedemptionUpdate) SetUserID(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetUserID(s)
+	return icru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableUserID(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetUserID(*s)
+	}
+	return icru
+}
+
+// SetSourceID sets the "source_id" field.
+func (icru *IcoCouponRedemptionUpdate) SetSourceID(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetSourceID(s)
+	return icru
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableSourceID(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetSourceID(*s)
+	}
+	return icru
+}
+
+// SetAmount sets the "amount" field.
+func (icru *IcoCouponRedemptionUpdate) SetAmount(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetAmount(s)
+	return icru
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableAmount(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetAmount(*s)
+	}
+	return icru
+}
+
+// SetSymbol sets the "symbol" field.
+func (icru *IcoCouponRedemptionUpdate) SetSymbol(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetSymbol(s)
+	return icru
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableSymbol(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetSymbol(*s)
+	}
+	return icru
+}
+
+// SetReward sets the "reward" field.
+func (icru *IcoCouponRedemptionUpdate) SetReward(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetReward(s)
+	return icru
+}
+
+// SetNillableReward sets the "reward" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableReward(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetReward(*s)
+	}
+	return icru
+}
+
+// SetCashback sets the "cashback" field.
+func (icru *IcoCouponRedemptionUpdate) SetCashback(s string) *IcoCouponRedemptionUpdate {
+	icru.mutation.SetCashback(s)
+	return icru
+}
+
+// SetNillableCashback sets the "cashback" field if the given value is not nil.
+func (icru *IcoCouponRedemptionUpdate) SetNillableCashback(s *string) *IcoCouponRedemptionUpdate {
+	if s != nil {
+		icru.SetCashback(*s)
+	}
+	return icru
+}
+
+// Mutation returns the IcoCouponRedemptionMutation object of the builder.
+func (icru *IcoCouponRedemptionUpdate) Mutation() *IcoCouponRedemptionMutation {
+	return icru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icru *IcoCouponRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	icru.defaults()
+	return withHooks(ctx, icru.sqlSave, icru.mutation, icru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icru *IcoCouponRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := icru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icru *IcoCouponRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := icru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icru *IcoCouponRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := icru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icru *IcoCouponRedemptionUpdate) defaults() {
+	if _, ok := icru.mutation.UpdatedAt(); !ok {
+		v := icocouponredemption.UpdateDefaultUpdatedAt()
+		icru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icru *IcoCouponRedemptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoCouponRedemptionUpdate {
+	icru.modifiers = append(icru.modifiers, modifiers...)
+	return icru
+}
+
+func (icru *IcoCouponRedemptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icocouponredemption.Table, icocouponredemption.Columns, sqlgraph.NewFieldSpec(icocouponredemption.FieldID, field.TypeString))
+	if ps := icru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icru.mutation.UpdatedAt(); ok {
+		_spec.SetField(icocouponredemption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icru.mutation.CouponID(); ok {
+		_spec.SetField(icocouponredemption.FieldCouponID, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.Coupon(); ok {
+		_spec.SetField(icocouponredemption.FieldCoupon, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.UserID(); ok {
+		_spec.SetField(icocouponredemption.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.SourceID(); ok {
+		_spec.SetField(icocouponredemption.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.Amount(); ok {
+		_spec.SetField(icocouponredemption.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.Symbol(); ok {
+		_spec.SetField(icocouponredemption.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.Reward(); ok {
+		_spec.SetField(icocouponredemption.FieldReward, field.TypeString, value)
+	}
+	if value, ok := icru.mutation.Cashback(); ok {
+		_spec.SetField(icocouponredemption.FieldCashback, field.TypeString, value)
+	}
+	_spec.AddModifiers(icru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, icru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icocouponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icru.mutation.done = true
+	return n, nil
+}
+
+// IcoCouponRedemptionUpdateOne is the builder for updating a single IcoCouponRedemption entity.
+type IcoCouponRedemptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IcoCouponRedemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetUpdatedAt(t time.Time) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetUpdatedAt(t)
+	return icruo
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetCouponID(x xid.ID) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetCouponID(x)
+	return icruo
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableCouponID(x *xid.ID) *IcoCouponRedemptionUpdateOne {
+	if x != nil {
+		icruo.SetCouponID(*x)
+	}
+	return icruo
+}
+
+// SetCoupon sets the "coupon" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetCoupon(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetCoupon(s)
+	return icruo
+}
+
+// SetNillableCoupon sets the "coupon" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableCoupon(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetCoupon(*s)
+	}
+	return icruo
+}
+
+// SetUserID sets the "user_id" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetUserID(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetUserID(s)
+	return icruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableUserID(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetUserID(*s)
+	}
+	return icruo
+}
+
+// SetSourceID sets the "source_id" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetSourceID(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetSourceID(s)
+	return icruo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableSourceID(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetSourceID(*s)
+	}
+	return icruo
+}
+
+// SetAmount sets the "amount" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetAmount(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetAmount(s)
+	return icruo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableAmount(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetAmount(*s)
+	}
+	return icruo
+}
+
+// SetSymbol sets the "symbol" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetSymbol(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetSymbol(s)
+	return icruo
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableSymbol(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetSymbol(*s)
+	}
+	return icruo
+}
+
+// SetReward sets the "reward" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetReward(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetReward(s)
+	return icruo
+}
+
+// SetNillableReward sets the "reward" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableReward(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetReward(*s)
+	}
+	return icruo
+}
+
+// SetCashback sets the "cashback" field.
+func (icruo *IcoCouponRedemptionUpdateOne) SetCashback(s string) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.SetCashback(s)
+	return icruo
+}
+
+// SetNillableCashback sets the "cashback" field if the given value is not nil.
+func (icruo *IcoCouponRedemptionUpdateOne) SetNillableCashback(s *string) *IcoCouponRedemptionUpdateOne {
+	if s != nil {
+		icruo.SetCashback(*s)
+	}
+	return icruo
+}
+
+// Mutation returns the IcoCouponRedemptionMutation object of the builder.
+func (icruo *IcoCouponRedemptionUpdateOne) Mutation() *IcoCouponRedemptionMutation {
+	return icruo.mutation
+}
+
+// Where appends a list predicates to the IcoCouponRedemptionUpdate builder.
+func (icruo *IcoCouponRedemptionUpdateOne) Where(ps ...predicate.IcoCouponRedemption) *IcoCouponRedemptionUpdateOne {
+	icruo.mutation.Where(ps...)
+	return icruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icruo *IcoCouponRedemptionUpdateOne) Select(field string, fields ...string) *IcoCouponRedemptionUpdateOne {
+	icruo.fields = append([]string{field}, fields...)
+	return icruo
+}
+
+// Save executes the query and returns the updated IcoCouponRedemption entity.
+func (icruo *IcoCouponRedemptionUpdateOne) Save(ctx context.Context) (*IcoCouponRedemption, error) {
+	icruo.defaults()
+	return withHooks(ctx, icruo.sqlSave, icruo.mutation, icruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icruo *IcoCouponRedemptionUpdateOne) SaveX(ctx context.Context) *IcoCouponRedemption {
+	node, err := icruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icruo *IcoCouponRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := icruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icruo *IcoCouponRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := icruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icruo *IcoCouponRedemptionUpdateOne) defaults() {
+	if _, ok := icruo.mutation.UpdatedAt(); !ok {
+		v := icocouponredemption.UpdateDefaultUpdatedAt()
+		icruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icruo *IcoCouponRedemptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoCouponRedemptionUpdateOne {
+	icruo.modifiers = append(icruo.modifiers, modifiers...)
+	return icruo
+}
+
+func (icruo *IcoCouponRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *IcoCouponRedemption, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icocouponredemption.Table, icocouponredemption.Columns, sqlgraph.NewFieldSpec(icocouponredemption.FieldID, field.TypeString))
+	id, ok := icruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IcoCouponRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icocouponredemption.FieldID)
+		for _, f := range fields {
+			if !icocouponredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != icocouponredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(icocouponredemption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icruo.mutation.CouponID(); ok {
+		_spec.SetField(icocouponredemption.FieldCouponID, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.Coupon(); ok {
+		_spec.SetField(icocouponredemption.FieldCoupon, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.UserID(); ok {
+		_spec.SetField(icocouponredemption.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.SourceID(); ok {
+		_spec.SetField(icocouponredemption.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.Amount(); ok {
+		_spec.SetField(icocouponredemption.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.Symbol(); ok {
+		_spec.SetField(icocouponredemption.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.Reward(); ok {
+		_spec.SetField(icocouponredemption.FieldReward, field.TypeString, value)
+	}
+	if value, ok := icruo.mutation.Cashback(); ok {
+		_spec.SetField(icocouponredemption.FieldCashback, field.TypeString, value)
+	}
+	_spec.AddModifiers(icruo.modifiers...)
+	_node = &IcoCouponRedemption{config: icruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icocouponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icruo.mutation.done = true
+	return _node, nil
+}
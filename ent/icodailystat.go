@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/rs/xid"
+)
+
+// IcoDailyStat is the model entity for the IcoDailyStat schema.
+type IcoDailyStat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// Purchases holds the value of the "purchases" field.
+	Purchases int `json:"purchases,omitempty"`
+	// Buyers holds the value of the "buyers" field.
+	Buyers int `json:"buyers,omitempty"`
+	// NumToken holds the value of the "num_token" field.
+	NumToken string `json:"num_token,omitempty"`
+	// Raised holds the value of the "raised" field.
+	Raised string `json:"raised,omitempty"`
+	// CouponPurchases holds the value of the "coupon_purchases" field.
+	CouponPurchases int `json:"coupon_purchases,omitempty"`
+	// CouponBuyers holds the value of the "coupon_buyers" field.
+	CouponBuyers int `json:"coupon_buyers,omitempty"`
+	// CouponNumToken holds the value of the "coupon_num_token" field.
+	CouponNumToken string `json:"coupon_num_token,omitempty"`
+	// CouponRaised holds the value of the "coupon_raised" field.
+	CouponRaised string `json:"coupon_raised,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IcoDailyStat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case icodailystat.FieldPurchases, icodailystat.FieldBuyers, icodailystat.FieldCouponPurchases, icodailystat.FieldCouponBuyers:
+			values[i] = new(sql.NullInt64)
+		case icodailystat.FieldNumToken, icodailystat.FieldRaised, icodailystat.FieldCouponNumToken, icodailystat.FieldCouponRaised:
+			values[i] = new(sql.NullString)
+		case icodailystat.FieldCreatedAt, icodailystat.FieldUpdatedAt, icodailystat.FieldDay:
+			values[i] = new(sql.NullTime)
+		case icodailystat.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IcoDailyStat fields.
+func (ids *IcoDailyStat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case icodailystat.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ids.ID = *value
+			}
+		case icodailystat.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ids.CreatedAt = value.Time
+			}
+		case icodailystat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ids.UpdatedAt = value.Time
+			}
+		case icodailystat.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				ids.Day = value.Time
+			}
+		case icodailystat.FieldPurchases:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purchases", values[i])
+			} else if value.Valid {
+				ids.Purchases = int(value.Int64)
+			}
+		case icodailystat.FieldBuyers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyers", values[i])
+			} else if value.Valid {
+				ids.Buyers = int(value.Int64)
+			}
+		case icodailystat.FieldNumToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field num_token", values[i])
+			} else if value.Valid {
+				ids.NumToken = value.String
+			}
+		case icodailystat.FieldRaised:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raised", values[i])
+			} else if value.Valid {
+				ids.Raised = value.String
+			}
+		case icodailystat.FieldCouponPurchases:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_purchases", values[i])
+			} else if value.Valid {
+				ids.CouponPurchases = int(value.Int64)
+			}
+		case icodailystat.FieldCouponBuyers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_buyers", values[i])
+			} else if value.Valid {
+				ids.CouponBuyers = int(value.Int64)
+			}
+		case icodailystat.FieldCouponNumToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_num_token", values[i])
+			} else if value.Valid {
+				ids.CouponNumToken = value.String
+			}
+		case icodailystat.FieldCouponRaised:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_raised", values[i])
+			} else if value.Valid {
+				ids.CouponRaised = value.String
+			}
+		default:
+			ids.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IcoDailyStat.
+// This includes values selected through modifiers, order, etc.
+func (ids *IcoDailyStat) Value(name string) (ent.Value, error) {
+	return ids.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IcoDailyStat.
+// Note that you need to call IcoDailyStat.Unwrap() before calling this method if this IcoDailyStat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ids *IcoDailyStat) Update() *IcoDailyStatUpdateOne {
+	return NewIcoDailyStatClient(ids.config).UpdateOne(ids)
+}
+
+// Unwrap unwraps the IcoDailyStat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ids *IcoDailyStat) Unwrap() *IcoDailyStat {
+	_tx, ok := ids.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IcoDailyStat is not a transactional entity")
+	}
+	ids.config.driver = _tx.drv
+	return ids
+}
+
+// String implements the fmt.Stringer.
+func (ids *IcoDailyStat) String() string {
+	var builder strings.Builder
+	builder.WriteString("IcoDailyStat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ids.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ids.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ids.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(ids.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("purchases=")
+	builder.WriteString(fmt.Sprintf("%v", ids.Purchases))
+	builder.WriteString(", ")
+	builder.WriteString("buyers=")
+	builder.WriteString(fmt.Sprintf("%v", ids.Buyers))
+	builder.WriteString(", ")
+	builder.WriteString("num_token=")
+	builder.WriteString(ids.NumToken)
+	builder.WriteString(", ")
+	builder.WriteString("raised=")
+	builder.WriteString(ids.Raised)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_purchases=")
+	builder.WriteString(fmt.Sprintf("%v", ids.CouponPurchases))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_buyers=")
+	builder.WriteString(fmt.Sprintf("%v", ids.CouponBuyers))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_num_token=")
+	builder.WriteString(ids.CouponNumToken)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_raised=")
+	builder.WriteString(ids.CouponRaised)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IcoDailyStats is a parsable slice of IcoDailyStat.
+type IcoDailyStats []*IcoDailyStat
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package icodailystat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/rs/xid"
+)
+
+const (
+	// Label holds the string label denoting the icodailystat type in the database.
+	Label = "ico_daily_stat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldPurchases holds the string denoting the purchases field in the database.
+	FieldPurchases = "purchases"
+	// FieldBuyers holds the string denoting the buyers field in the database.
+	FieldBuyers = "buyers"
+	// FieldNumToken holds the string denoting the num_token field in the database.
+	FieldNumToken = "num_token"
+	// FieldRaised holds the string denoting the raised field in the database.
+	FieldRaised = "raised"
+	// FieldCouponPurchases holds the string denoting the coupon_purchases field in the database.
+	FieldCouponPurchases = "coupon_purchases"
+	// FieldCouponBuyers holds the string denoting the coupon_buyers field in the database.
+	FieldCouponBuyers = "coupon_buyers"
+	// FieldCouponNumToken holds the string denoting the coupon_num_token field in the database.
+	FieldCouponNumToken = "coupon_num_token"
+	// FieldCouponRaised holds the string denoting the coupon_raised field in the database.
+	FieldCouponRaised = "coupon_raised"
+	// Table holds the table name of the icodailystat in the database.
+	Table = "ico_daily_stats"
+)
+
+// Columns holds all SQL columns for icodailystat fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDay,
+	FieldPurchases,
+	FieldBuyers,
+	FieldNumToken,
+	FieldRaised,
+	FieldCouponPurchases,
+	FieldCouponBuyers,
+	FieldCouponNumToken,
+	FieldCouponRaised,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() xid.ID
+)
+
+// OrderOption defines the ordering options for the IcoDailyStat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByPurchases orders the results by the purchases field.
+func ByPurchases(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchases, opts...).ToFunc()
+}
+
+// ByBuyers orders the results by the buyers field.
+func ByBuyers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyers, opts...).ToFunc()
+}
+
+// ByNumToken orders the results by the num_token field.
+func ByNumToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumToken, opts...).ToFunc()
+}
+
+// ByRaised orders the results by the raised field.
+func ByRaised(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRaised, opts...).ToFunc()
+}
+
+// ByCouponPurchases orders the results by the coupon_purchases field.
+func ByCouponPurchases(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponPurchases, opts...).ToFunc()
+}
+
+// ByCouponBuyers orders the results by the coupon_buyers field.
+func ByCouponBuyers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponBuyers, opts...).ToFunc()
+}
+
+// ByCouponNumToken orders the results by the coupon_num_token field.
+func ByCouponNumToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponNumToken, opts...).ToFunc()
+}
+
+// ByCouponRaised orders the results by the coupon_raised field.
+func ByCouponRaised(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponRaised, opts...).ToFunc()
+}
@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package icodailystat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldDay, v))
+}
+
+// Purchases applies equality check predicate on the "purchases" field. It's identical to PurchasesEQ.
+func Purchases(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldPurchases, v))
+}
+
+// Buyers applies equality check predicate on the "buyers" field. It's identical to BuyersEQ.
+func Buyers(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldBuyers, v))
+}
+
+// NumToken applies equality check predicate on the "num_token" field. It's identical to NumTokenEQ.
+func NumToken(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldNumToken, v))
+}
+
+// Raised applies equality check predicate on the "raised" field. It's identical to RaisedEQ.
+func Raised(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldRaised, v))
+}
+
+// CouponPurchases applies equality check predicate on the "coupon_purchases" field. It's identical to CouponPurchasesEQ.
+func CouponPurchases(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponPurchases, v))
+}
+
+// CouponBuyers applies equality check predicate on the "coupon_buyers" field. It's identical to CouponBuyersEQ.
+func CouponBuyers(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponBuyers, v))
+}
+
+// CouponNumToken applies equality check predicate on the "coupon_num_token" field. It's identical to CouponNumTokenEQ.
+func CouponNumToken(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponNumToken, v))
+}
+
+// CouponRaised applies equality check predicate on the "coupon_raised" field. It's identical to CouponRaisedEQ.
+func CouponRaised(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponRaised, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldDay, v))
+}
+
+// PurchasesEQ applies the EQ predicate on the "purchases" field.
+func PurchasesEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldPurchases, v))
+}
+
+// PurchasesNEQ applies the NEQ predicate on the "purchases" field.
+func PurchasesNEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldPurchases, v))
+}
+
+// PurchasesIn applies the In predicate on the "purchases" field.
+func PurchasesIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldPurchases, vs...))
+}
+
+// PurchasesNotIn applies the NotIn predicate on the "purchases" field.
+func PurchasesNotIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldPurchases, vs...))
+}
+
+// PurchasesGT applies the GT predicate on the "purchases" field.
+func PurchasesGT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldPurchases, v))
+}
+
+// PurchasesGTE applies the GTE predicate on the "purchases" field.
+func PurchasesGTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldPurchases, v))
+}
+
+// PurchasesLT applies the LT predicate on the "purchases" field.
+func PurchasesLT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldPurchases, v))
+}
+
+// PurchasesLTE applies the LTE predicate on the "purchases" field.
+func PurchasesLTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldPurchases, v))
+}
+
+// BuyersEQ applies the EQ predicate on the "buyers" field.
+func BuyersEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldBuyers, v))
+}
+
+// BuyersNEQ applies the NEQ predicate on the "buyers" field.
+func BuyersNEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldBuyers, v))
+}
+
+// BuyersIn applies the In predicate on the "buyers" field.
+func BuyersIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldBuyers, vs...))
+}
+
+// BuyersNotIn applies the NotIn predicate on the "buyers" field.
+func BuyersNotIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldBuyers, vs...))
+}
+
+// BuyersGT applies the GT predicate on the "buyers" field.
+func BuyersGT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldBuyers, v))
+}
+
+// BuyersGTE applies the GTE predicate on the "buyers" field.
+func BuyersGTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldBuyers, v))
+}
+
+// BuyersLT applies the LT predicate on the "buyers" field.
+func BuyersLT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldBuyers, v))
+}
+
+// BuyersLTE applies the LTE predicate on the "buyers" field.
+func BuyersLTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldBuyers, v))
+}
+
+// NumTokenEQ applies the EQ predicate on the "num_token" field.
+func NumTokenEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldNumToken, v))
+}
+
+// NumTokenNEQ applies the NEQ predicate on the "num_token" field.
+func NumTokenNEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldNumToken, v))
+}
+
+// NumTokenIn applies the In predicate on the "num_token" field.
+func NumTokenIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldNumToken, vs...))
+}
+
+// NumTokenNotIn applies the NotIn predicate on the "num_token" field.
+func NumTokenNotIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldNumToken, vs...))
+}
+
+// NumTokenGT applies the GT predicate on the "num_token" field.
+func NumTokenGT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldNumToken, v))
+}
+
+// NumTokenGTE applies the GTE predicate on the "num_token" field.
+func NumTokenGTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldNumToken, v))
+}
+
+// NumTokenLT applies the LT predicate on the "num_token" field.
+func NumTokenLT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldNumToken, v))
+}
+
+// NumTokenLTE applies the LTE predicate on the "num_token" field.
+func NumTokenLTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldNumToken, v))
+}
+
+// NumTokenContains applies the Contains predicate on the "num_token" field.
+func NumTokenContains(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContains(FieldNumToken, v))
+}
+
+// NumTokenHasPrefix applies the HasPrefix predicate on the "num_token" field.
+func NumTokenHasPrefix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasPrefix(FieldNumToken, v))
+}
+
+// NumTokenHasSuffix applies the HasSuffix predicate on the "num_token" field.
+func NumTokenHasSuffix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasSuffix(FieldNumToken, v))
+}
+
+// NumTokenEqualFold applies the EqualFold predicate on the "num_token" field.
+func NumTokenEqualFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEqualFold(FieldNumToken, v))
+}
+
+// NumTokenContainsFold applies the ContainsFold predicate on the "num_token" field.
+func NumTokenContainsFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContainsFold(FieldNumToken, v))
+}
+
+// RaisedEQ applies the EQ predicate on the "raised" field.
+func RaisedEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldRaised, v))
+}
+
+// RaisedNEQ applies the NEQ predicate on the "raised" field.
+func RaisedNEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldRaised, v))
+}
+
+// RaisedIn applies the In predicate on the "raised" field.
+func RaisedIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldRaised, vs...))
+}
+
+// RaisedNotIn applies the NotIn predicate on the "raised" field.
+func RaisedNotIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldRaised, vs...))
+}
+
+// RaisedGT applies the GT predicate on the "raised" field.
+func RaisedGT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldRaised, v))
+}
+
+// RaisedGTE applies the GTE predicate on the "raised" field.
+func RaisedGTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldRaised, v))
+}
+
+// RaisedLT applies the LT predicate on the "raised" field.
+func RaisedLT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldRaised, v))
+}
+
+// RaisedLTE applies the LTE predicate on the "raised" field.
+func RaisedLTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldRaised, v))
+}
+
+// RaisedContains applies the Contains predicate on the "raised" field.
+func RaisedContains(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContains(FieldRaised, v))
+}
+
+// RaisedHasPrefix applies the HasPrefix predicate on the "raised" field.
+func RaisedHasPrefix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasPrefix(FieldRaised, v))
+}
+
+// RaisedHasSuffix applies the HasSuffix predicate on the "raised" field.
+func RaisedHasSuffix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasSuffix(FieldRaised, v))
+}
+
+// RaisedEqualFold applies the EqualFold predicate on the "raised" field.
+func RaisedEqualFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEqualFold(FieldRaised, v))
+}
+
+// RaisedContainsFold applies the ContainsFold predicate on the "raised" field.
+func RaisedContainsFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContainsFold(FieldRaised, v))
+}
+
+// CouponPurchasesEQ applies the EQ predicate on the "coupon_purchases" field.
+func CouponPurchasesEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponPurchases, v))
+}
+
+// CouponPurchasesNEQ applies the NEQ predicate on the "coupon_purchases" field.
+func CouponPurchasesNEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldCouponPurchases, v))
+}
+
+// CouponPurchasesIn applies the In predicate on the "coupon_purchases" field.
+func CouponPurchasesIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldCouponPurchases, vs...))
+}
+
+// CouponPurchasesNotIn applies the NotIn predicate on the "coupon_purchases" field.
+func CouponPurchasesNotIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldCouponPurchases, vs...))
+}
+
+// CouponPurchasesGT applies the GT predicate on the "coupon_purchases" field.
+func CouponPurchasesGT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldCouponPurchases, v))
+}
+
+// CouponPurchasesGTE applies the GTE predicate on the "coupon_purchases" field.
+func CouponPurchasesGTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldCouponPurchases, v))
+}
+
+// CouponPurchasesLT applies the LT predicate on the "coupon_purchases" field.
+func CouponPurchasesLT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldCouponPurchases, v))
+}
+
+// CouponPurchasesLTE applies the LTE predicate on the "coupon_purchases" field.
+func CouponPurchasesLTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldCouponPurchases, v))
+}
+
+// CouponBuyersEQ applies the EQ predicate on the "coupon_buyers" field.
+func CouponBuyersEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponBuyers, v))
+}
+
+// CouponBuyersNEQ applies the NEQ predicate on the "coupon_buyers" field.
+func CouponBuyersNEQ(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldCouponBuyers, v))
+}
+
+// CouponBuyersIn applies the In predicate on the "coupon_buyers" field.
+func CouponBuyersIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldCouponBuyers, vs...))
+}
+
+// CouponBuyersNotIn applies the NotIn predicate on the "coupon_buyers" field.
+func CouponBuyersNotIn(vs ...int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldCouponBuyers, vs...))
+}
+
+// CouponBuyersGT applies the GT predicate on the "coupon_buyers" field.
+func CouponBuyersGT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldCouponBuyers, v))
+}
+
+// CouponBuyersGTE applies the GTE predicate on the "coupon_buyers" field.
+func CouponBuyersGTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldCouponBuyers, v))
+}
+
+// CouponBuyersLT applies the LT predicate on the "coupon_buyers" field.
+func CouponBuyersLT(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldCouponBuyers, v))
+}
+
+// CouponBuyersLTE applies the LTE predicate on the "coupon_buyers" field.
+func CouponBuyersLTE(v int) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldCouponBuyers, v))
+}
+
+// CouponNumTokenEQ applies the EQ predicate on the "coupon_num_token" field.
+func CouponNumTokenEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenNEQ applies the NEQ predicate on the "coupon_num_token" field.
+func CouponNumTokenNEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenIn applies the In predicate on the "coupon_num_token" field.
+func CouponNumTokenIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldCouponNumToken, vs...))
+}
+
+// CouponNumTokenNotIn applies the NotIn predicate on the "coupon_num_token" field.
+func CouponNumTokenNotIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldCouponNumToken, vs...))
+}
+
+// CouponNumTokenGT applies the GT predicate on the "coupon_num_token" field.
+func CouponNumTokenGT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenGTE applies the GTE predicate on the "coupon_num_token" field.
+func CouponNumTokenGTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenLT applies the LT predicate on the "coupon_num_token" field.
+func CouponNumTokenLT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenLTE applies the LTE predicate on the "coupon_num_token" field.
+func CouponNumTokenLTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenContains applies the Contains predicate on the "coupon_num_token" field.
+func CouponNumTokenContains(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContains(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenHasPrefix applies the HasPrefix predicate on the "coupon_num_token" field.
+func CouponNumTokenHasPrefix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasPrefix(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenHasSuffix applies the HasSuffix predicate on the "coupon_num_token" field.
+func CouponNumTokenHasSuffix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasSuffix(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenEqualFold applies the EqualFold predicate on the "coupon_num_token" field.
+func CouponNumTokenEqualFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEqualFold(FieldCouponNumToken, v))
+}
+
+// CouponNumTokenContainsFold applies the ContainsFold predicate on the "coupon_num_token" field.
+func CouponNumTokenContainsFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContainsFold(FieldCouponNumToken, v))
+}
+
+// CouponRaisedEQ applies the EQ predicate on the "coupon_raised" field.
+func CouponRaisedEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEQ(FieldCouponRaised, v))
+}
+
+// CouponRaisedNEQ applies the NEQ predicate on the "coupon_raised" field.
+func CouponRaisedNEQ(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNEQ(FieldCouponRaised, v))
+}
+
+// CouponRaisedIn applies the In predicate on the "coupon_raised" field.
+func CouponRaisedIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldIn(FieldCouponRaised, vs...))
+}
+
+// CouponRaisedNotIn applies the NotIn predicate on the "coupon_raised" field.
+func CouponRaisedNotIn(vs ...string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldNotIn(FieldCouponRaised, vs...))
+}
+
+// CouponRaisedGT applies the GT predicate on the "coupon_raised" field.
+func CouponRaisedGT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGT(FieldCouponRaised, v))
+}
+
+// CouponRaisedGTE applies the GTE predicate on the "coupon_raised" field.
+func CouponRaisedGTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldGTE(FieldCouponRaised, v))
+}
+
+// CouponRaisedLT applies the LT predicate on the "coupon_raised" field.
+func CouponRaisedLT(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLT(FieldCouponRaised, v))
+}
+
+// CouponRaisedLTE applies the LTE predicate on the "coupon_raised" field.
+func CouponRaisedLTE(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldLTE(FieldCouponRaised, v))
+}
+
+// CouponRaisedContains applies the Contains predicate on the "coupon_raised" field.
+func CouponRaisedContains(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContains(FieldCouponRaised, v))
+}
+
+// CouponRaisedHasPrefix applies the HasPrefix predicate on the "coupon_raised" field.
+func CouponRaisedHasPrefix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasPrefix(FieldCouponRaised, v))
+}
+
+// CouponRaisedHasSuffix applies the HasSuffix predicate on the "coupon_raised" field.
+func CouponRaisedHasSuffix(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldHasSuffix(FieldCouponRaised, v))
+}
+
+// CouponRaisedEqualFold applies the EqualFold predicate on the "coupon_raised" field.
+func CouponRaisedEqualFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldEqualFold(FieldCouponRaised, v))
+}
+
+// CouponRaisedContainsFold applies the ContainsFold predicate on the "coupon_raised" field.
+func CouponRaisedContainsFold(v string) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.FieldContainsFold(FieldCouponRaised, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IcoDailyStat) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IcoDailyStat) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IcoDailyStat) predicate.IcoDailyStat {
+	return predicate.IcoDailyStat(sql.NotPredicates(p))
+}
@@ -0,0 +1,1109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/rs/xid"
+)
+
+// IcoDailyStatCreate is the builder for creating a IcoDailyStat entity.
+type IcoDailyStatCreate struct {
+	config
+	mutation *IcoDailyStatMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (idsc *IcoDailyStatCreate) SetCreatedAt(t time.Time) *IcoDailyStatCreate {
+	idsc.mutation.SetCreatedAt(t)
+	return idsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (idsc *IcoDailyStatCreate) SetNillableCreatedAt(t *time.Time) *IcoDailyStatCreate {
+	if t != nil {
+		idsc.SetCreatedAt(*t)
+	}
+	return idsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (idsc *IcoDailyStatCreate) SetUpdatedAt(t time.Time) *IcoDailyStatCreate {
+	idsc.mutation.SetUpdatedAt(t)
+	return idsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (idsc *IcoDailyStatCreate) SetNillableUpdatedAt(t *time.Time) *IcoDailyStatCreate {
+	if t != nil {
+		idsc.SetUpdatedAt(*t)
+	}
+	return idsc
+}
+
+// SetDay sets the "day" field.
+func (idsc *IcoDailyStatCreate) SetDay(t time.Time) *IcoDailyStatCreate {
+	idsc.mutation.SetDay(t)
+	return idsc
+}
+
+// SetPurchases sets the "purchases" field.
+func (idsc *IcoDailyStatCreate) SetPurchases(i int) *IcoDailyStatCreate {
+	idsc.mutation.SetPurchases(i)
+	return idsc
+}
+
+// SetBuyers sets the "buyers" field.
+func (idsc *IcoDailyStatCreate) SetBuyers(i int) *IcoDailyStatCreate {
+	idsc.mutation.SetBuyers(i)
+	return idsc
+}
+
+// SetNumToken sets the "num_token" field.
+func (idsc *IcoDailyStatCreate) SetNumToken(s string) *IcoDailyStatCreate {
+	idsc.mutation.SetNumToken(s)
+	return idsc
+}
+
+// SetRaised sets the "raised" field.
+func (idsc *IcoDailyStatCreate) SetRaised(s string) *IcoDailyStatCreate {
+	idsc.mutation.SetRaised(s)
+	return idsc
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (idsc *IcoDailyStatCreate) SetCouponPurchases(i int) *IcoDailyStatCreate {
+	idsc.mutation.SetCouponPurchases(i)
+	return idsc
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (idsc *IcoDailyStatCreate) SetCouponBuyers(i int) *IcoDailyStatCreate {
+	idsc.mutation.SetCouponBuyers(i)
+	return idsc
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (idsc *IcoDailyStatCreate) SetCouponNumToken(s string) *IcoDailyStatCreate {
+	idsc.mutation.SetCouponNumToken(s)
+	return idsc
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (idsc *IcoDailyStatCreate) SetCouponRaised(s string) *IcoDailyStatCreate {
+	idsc.mutation.SetCouponRaised(s)
+	return idsc
+}
+
+// SetID sets the "id" field.
+func (idsc *IcoDailyStatCreate) SetID(x xid.ID) *IcoDailyStatCreate {
+	idsc.mutation.SetID(x)
+	return idsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (idsc *IcoDailyStatCreate) SetNillableID(x *xid.ID) *IcoDailyStatCreate {
+	if x != nil {
+		idsc.SetID(*x)
+	}
+	return idsc
+}
+
+// Mutation returns the IcoDailyStatMutation object of the builder.
+func (idsc *IcoDailyStatCreate) Mutation() *IcoDailyStatMutation {
+	return idsc.mutation
+}
+
+// Save creates the IcoDailyStat in the database.
+func (idsc *IcoDailyStatCreate) Save(ctx context.Context) (*IcoDailyStat, error) {
+	idsc.defaults()
+	return withHooks(ctx, idsc.sqlSave, idsc.mutation, idsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (idsc *IcoDailyStatCreate) SaveX(ctx context.Context) *IcoDailyStat {
+	v, err := idsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (idsc *IcoDailyStatCreate) Exec(ctx context.Context) error {
+	_, err := idsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idsc *IcoDailyStatCreate) ExecX(ctx context.Context) {
+	if err := idsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (idsc *IcoDailyStatCreate) defaults() {
+	if _, ok := idsc.mutation.CreatedAt(); !ok {
+		v := icodailystat.DefaultCreatedAt()
+		idsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := idsc.mutation.UpdatedAt(); !ok {
+		v := icodailystat.DefaultUpdatedAt()
+		idsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := idsc.mutation.ID(); !ok {
+		v := icodailystat.DefaultID()
+		idsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (idsc *IcoDailyStatCreate) check() error {
+	if _, ok := idsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IcoDailyStat.created_at"`)}
+	}
+	if _, ok := idsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IcoDailyStat.updated_at"`)}
+	}
+	if _, ok := idsc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "IcoDailyStat.day"`)}
+	}
+	if _, ok := idsc.mutation.Purchases(); !ok {
+		return &ValidationError{Name: "purchases", err: errors.New(`ent: missing required field "IcoDailyStat.purchases"`)}
+	}
+	if _, ok := idsc.mutation.Buyers(); !ok {
+		return &ValidationError{Name: "buyers", err: errors.New(`ent: missing required field "IcoDailyStat.buyers"`)}
+	}
+	if _, ok := idsc.mutation.NumToken(); !ok {
+		return &ValidationError{Name: "num_token", err: errors.New(`ent: missing required field "IcoDailyStat.num_token"`)}
+	}
+	if _, ok := idsc.mutation.Raised(); !ok {
+		return &ValidationError{Name: "raised", err: errors.New(`ent: missing required field "IcoDailyStat.raised"`)}
+	}
+	if _, ok := idsc.mutation.CouponPurchases(); !ok {
+		return &ValidationError{Name: "coupon_purchases", err: errors.New(`ent: missing required field "IcoDailyStat.coupon_purchases"`)}
+	}
+	if _, ok := idsc.mutation.CouponBuyers(); !ok {
+		return &ValidationError{Name: "coupon_buyers", err: errors.New(`ent: missing required field "IcoDailyStat.coupon_buyers"`)}
+	}
+	if _, ok := idsc.mutation.CouponNumToken(); !ok {
+		return &ValidationError{Name: "coupon_num_token", err: errors.New(`ent: missing required field "IcoDailyStat.coupon_num_token"`)}
+	}
+	if _, ok := idsc.mutation.CouponRaised(); !ok {
+		return &ValidationError{Name: "coupon_raised", err: errors.New(`ent: missing required field "IcoDailyStat.coupon_raised"`)}
+	}
+	return nil
+}
+
+func (idsc *IcoDailyStatCreate) sqlSave(ctx context.Context) (*IcoDailyStat, error) {
+	if err := idsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := idsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, idsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	idsc.mutation.id = &_node.ID
+	idsc.mutation.done = true
+	return _node, nil
+}
+
+func (idsc *IcoDailyStatCreate) createSpec() (*IcoDailyStat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IcoDailyStat{config: idsc.config}
+		_spec = sqlgraph.NewCreateSpec(icodailystat.Table, sqlgraph.NewFieldSpec(icodailystat.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = idsc.conflict
+	if id, ok := idsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := idsc.mutation.CreatedAt(); ok {
+		_spec.SetField(icodailystat.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := idsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(icodailystat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := idsc.mutation.Day(); ok {
+		_spec.SetField(icodailystat.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := idsc.mutation.Purchases(); ok {
+		_spec.SetField(icodailystat.FieldPurchases, field.TypeInt, value)
+		_node.Purchases = value
+	}
+	if value, ok := idsc.mutation.Buyers(); ok {
+		_spec.SetField(icodailystat.FieldBuyers, field.TypeInt, value)
+		_node.Buyers = value
+	}
+	if value, ok := idsc.mutation.NumToken(); ok {
+		_spec.SetField(icodailystat.FieldNumToken, field.TypeString, value)
+		_node.NumToken = value
+	}
+	if value, ok := idsc.mutation.Raised(); ok {
+		_spec.SetField(icodailystat.FieldRaised, field.TypeString, value)
+		_node.Raised = value
+	}
+	if value, ok := idsc.mutation.CouponPurchases(); ok {
+		_spec.SetField(icodailystat.FieldCouponPurchases, field.TypeInt, value)
+		_node.CouponPurchases = value
+	}
+	if value, ok := idsc.mutation.CouponBuyers(); ok {
+		_spec.SetField(icodailystat.FieldCouponBuyers, field.TypeInt, value)
+		_node.CouponBuyers = value
+	}
+	if value, ok := idsc.mutation.CouponNumToken(); ok {
+		_spec.SetField(icodailystat.FieldCouponNumToken, field.TypeString, value)
+		_node.CouponNumToken = value
+	}
+	if value, ok := idsc.mutation.CouponRaised(); ok {
+		_spec.SetField(icodailystat.FieldCouponRaised, field.TypeString, value)
+		_node.CouponRaised = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoDailyStat.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoDailyStatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (idsc *IcoDailyStatCreate) OnConflict(opts ...sql.ConflictOption) *IcoDailyStatUpsertOne {
+	idsc.conflict = opts
+	return &IcoDailyStatUpsertOne{
+		create: idsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (idsc *IcoDailyStatCreate) OnConflictColumns(columns ...string) *IcoDailyStatUpsertOne {
+	idsc.conflict = append(idsc.conflict, sql.ConflictColumns(columns...))
+	return &IcoDailyStatUpsertOne{
+		create: idsc,
+	}
+}
+
+type (
+	// IcoDailyStatUpsertOne is the builder for "upsert"-ing
+	//  one IcoDailyStat node.
+	IcoDailyStatUpsertOne struct {
+		create *IcoDailyStatCreate
+	}
+
+	// IcoDailyStatUpsert is the "OnConflict" setter.
+	IcoDailyStatUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoDailyStatUpsert) SetUpdatedAt(v time.Time) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateUpdatedAt() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldUpdatedAt)
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *IcoDailyStatUpsert) SetDay(v time.Time) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldDay, v)
+	return u
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateDay() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldDay)
+	return u
+}
+
+// SetPurchases sets the "purchases" field.
+func (u *IcoDailyStatUpsert) SetPurchases(v int) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldPurchases, v)
+	return u
+}
+
+// UpdatePurchases sets the "purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdatePurchases() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldPurchases)
+	return u
+}
+
+// AddPurchases adds v to the "purchases" field.
+func (u *IcoDailyStatUpsert) AddPurchases(v int) *IcoDailyStatUpsert {
+	u.Add(icodailystat.FieldPurchases, v)
+	return u
+}
+
+// SetBuyers sets the "buyers" field.
+func (u *IcoDailyStatUpsert) SetBuyers(v int) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldBuyers, v)
+	return u
+}
+
+// UpdateBuyers sets the "buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateBuyers() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldBuyers)
+	return u
+}
+
+// AddBuyers adds v to the "buyers" field.
+func (u *IcoDailyStatUpsert) AddBuyers(v int) *IcoDailyStatUpsert {
+	u.Add(icodailystat.FieldBuyers, v)
+	return u
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoDailyStatUpsert) SetNumToken(v string) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldNumToken, v)
+	return u
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateNumToken() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldNumToken)
+	return u
+}
+
+// SetRaised sets the "raised" field.
+func (u *IcoDailyStatUpsert) SetRaised(v string) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldRaised, v)
+	return u
+}
+
+// UpdateRaised sets the "raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateRaised() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldRaised)
+	return u
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (u *IcoDailyStatUpsert) SetCouponPurchases(v int) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldCouponPurchases, v)
+	return u
+}
+
+// UpdateCouponPurchases sets the "coupon_purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateCouponPurchases() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldCouponPurchases)
+	return u
+}
+
+// AddCouponPurchases adds v to the "coupon_purchases" field.
+func (u *IcoDailyStatUpsert) AddCouponPurchases(v int) *IcoDailyStatUpsert {
+	u.Add(icodailystat.FieldCouponPurchases, v)
+	return u
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (u *IcoDailyStatUpsert) SetCouponBuyers(v int) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldCouponBuyers, v)
+	return u
+}
+
+// UpdateCouponBuyers sets the "coupon_buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateCouponBuyers() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldCouponBuyers)
+	return u
+}
+
+// AddCouponBuyers adds v to the "coupon_buyers" field.
+func (u *IcoDailyStatUpsert) AddCouponBuyers(v int) *IcoDailyStatUpsert {
+	u.Add(icodailystat.FieldCouponBuyers, v)
+	return u
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (u *IcoDailyStatUpsert) SetCouponNumToken(v string) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldCouponNumToken, v)
+	return u
+}
+
+// UpdateCouponNumToken sets the "coupon_num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateCouponNumToken() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldCouponNumToken)
+	return u
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (u *IcoDailyStatUpsert) SetCouponRaised(v string) *IcoDailyStatUpsert {
+	u.Set(icodailystat.FieldCouponRaised, v)
+	return u
+}
+
+// UpdateCouponRaised sets the "coupon_raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsert) UpdateCouponRaised() *IcoDailyStatUpsert {
+	u.SetExcluded(icodailystat.FieldCouponRaised)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icodailystat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoDailyStatUpsertOne) UpdateNewValues() *IcoDailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(icodailystat.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(icodailystat.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IcoDailyStatUpsertOne) Ignore() *IcoDailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoDailyStatUpsertOne) DoNothing() *IcoDailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoDailyStatCreate.OnConflict
+// documentation for more info.
+func (u *IcoDailyStatUpsertOne) Update(set func(*IcoDailyStatUpsert)) *IcoDailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoDailyStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoDailyStatUpsertOne) SetUpdatedAt(v time.Time) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateUpdatedAt() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDay sets the "day" field.
+func (u *IcoDailyStatUpsertOne) SetDay(v time.Time) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateDay() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetPurchases sets the "purchases" field.
+func (u *IcoDailyStatUpsertOne) SetPurchases(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetPurchases(v)
+	})
+}
+
+// AddPurchases adds v to the "purchases" field.
+func (u *IcoDailyStatUpsertOne) AddPurchases(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddPurchases(v)
+	})
+}
+
+// UpdatePurchases sets the "purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdatePurchases() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdatePurchases()
+	})
+}
+
+// SetBuyers sets the "buyers" field.
+func (u *IcoDailyStatUpsertOne) SetBuyers(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetBuyers(v)
+	})
+}
+
+// AddBuyers adds v to the "buyers" field.
+func (u *IcoDailyStatUpsertOne) AddBuyers(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddBuyers(v)
+	})
+}
+
+// UpdateBuyers sets the "buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateBuyers() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateBuyers()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoDailyStatUpsertOne) SetNumToken(v string) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateNumToken() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// SetRaised sets the "raised" field.
+func (u *IcoDailyStatUpsertOne) SetRaised(v string) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetRaised(v)
+	})
+}
+
+// UpdateRaised sets the "raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateRaised() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateRaised()
+	})
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (u *IcoDailyStatUpsertOne) SetCouponPurchases(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponPurchases(v)
+	})
+}
+
+// AddCouponPurchases adds v to the "coupon_purchases" field.
+func (u *IcoDailyStatUpsertOne) AddCouponPurchases(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddCouponPurchases(v)
+	})
+}
+
+// UpdateCouponPurchases sets the "coupon_purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateCouponPurchases() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponPurchases()
+	})
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (u *IcoDailyStatUpsertOne) SetCouponBuyers(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponBuyers(v)
+	})
+}
+
+// AddCouponBuyers adds v to the "coupon_buyers" field.
+func (u *IcoDailyStatUpsertOne) AddCouponBuyers(v int) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddCouponBuyers(v)
+	})
+}
+
+// UpdateCouponBuyers sets the "coupon_buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateCouponBuyers() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponBuyers()
+	})
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (u *IcoDailyStatUpsertOne) SetCouponNumToken(v string) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponNumToken(v)
+	})
+}
+
+// UpdateCouponNumToken sets the "coupon_num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateCouponNumToken() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponNumToken()
+	})
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (u *IcoDailyStatUpsertOne) SetCouponRaised(v string) *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponRaised(v)
+	})
+}
+
+// UpdateCouponRaised sets the "coupon_raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertOne) UpdateCouponRaised() *IcoDailyStatUpsertOne {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponRaised()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoDailyStatUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoDailyStatCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoDailyStatUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IcoDailyStatUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: IcoDailyStatUpsertOne.ID is not supported by MySQL driver. Use IcoDailyStatUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IcoDailyStatUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IcoDailyStatCreateBulk is the builder for creating many IcoDailyStat entities in bulk.
+type IcoDailyStatCreateBulk struct {
+	config
+	err      error
+	builders []*IcoDailyStatCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IcoDailyStat entities in the database.
+func (idscb *IcoDailyStatCreateBulk) Save(ctx context.Context) ([]*IcoDailyStat, error) {
+	if idscb.err != nil {
+		return nil, idscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(idscb.builders))
+	nodes := make([]*IcoDailyStat, len(idscb.builders))
+	mutators := make([]Mutator, len(idscb.builders))
+	for i := range idscb.builders {
+		func(i int, root context.Context) {
+			builder := idscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IcoDailyStatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, idscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = idscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, idscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, idscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idscb *IcoDailyStatCreateBulk) SaveX(ctx context.Context) []*IcoDailyStat {
+	v, err := idscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (idscb *IcoDailyStatCreateBulk) Exec(ctx context.Context) error {
+	_, err := idscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idscb *IcoDailyStatCreateBulk) ExecX(ctx context.Context) {
+	if err := idscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoDailyStat.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoDailyStatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (idscb *IcoDailyStatCreateBulk) OnConflict(opts ...sql.ConflictOption) *IcoDailyStatUpsertBulk {
+	idscb.conflict = opts
+	return &IcoDailyStatUpsertBulk{
+		create: idscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (idscb *IcoDailyStatCreateBulk) OnConflictColumns(columns ...string) *IcoDailyStatUpsertBulk {
+	idscb.conflict = append(idscb.conflict, sql.ConflictColumns(columns...))
+	return &IcoDailyStatUpsertBulk{
+		create: idscb,
+	}
+}
+
+// IcoDailyStatUpsertBulk is the builder for "upsert"-ing
+// a bulk of IcoDailyStat nodes.
+type IcoDailyStatUpsertBulk struct {
+	create *IcoDailyStatCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icodailystat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoDailyStatUpsertBulk) UpdateNewValues() *IcoDailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(icodailystat.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(icodailystat.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoDailyStat.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IcoDailyStatUpsertBulk) Ignore() *IcoDailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoDailyStatUpsertBulk) DoNothing() *IcoDailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoDailyStatCreateBulk.OnConflict
+// documentation for more info.
+func (u *IcoDailyStatUpsertBulk) Update(set func(*IcoDailyStatUpsert)) *IcoDailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoDailyStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoDailyStatUpsertBulk) SetUpdatedAt(v time.Time) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateUpdatedAt() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDay sets the "day" field.
+func (u *IcoDailyStatUpsertBulk) SetDay(v time.Time) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateDay() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetPurchases sets the "purchases" field.
+func (u *IcoDailyStatUpsertBulk) SetPurchases(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetPurchases(v)
+	})
+}
+
+// AddPurchases adds v to the "purchases" field.
+func (u *IcoDailyStatUpsertBulk) AddPurchases(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddPurchases(v)
+	})
+}
+
+// UpdatePurchases sets the "purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdatePurchases() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdatePurchases()
+	})
+}
+
+// SetBuyers sets the "buyers" field.
+func (u *IcoDailyStatUpsertBulk) SetBuyers(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetBuyers(v)
+	})
+}
+
+// AddBuyers adds v to the "buyers" field.
+func (u *IcoDailyStatUpsertBulk) AddBuyers(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddBuyers(v)
+	})
+}
+
+// UpdateBuyers sets the "buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateBuyers() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateBuyers()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoDailyStatUpsertBulk) SetNumToken(v string) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateNumToken() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// SetRaised sets the "raised" field.
+func (u *IcoDailyStatUpsertBulk) SetRaised(v string) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetRaised(v)
+	})
+}
+
+// UpdateRaised sets the "raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateRaised() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateRaised()
+	})
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (u *IcoDailyStatUpsertBulk) SetCouponPurchases(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponPurchases(v)
+	})
+}
+
+// AddCouponPurchases adds v to the "coupon_purchases" field.
+func (u *IcoDailyStatUpsertBulk) AddCouponPurchases(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddCouponPurchases(v)
+	})
+}
+
+// UpdateCouponPurchases sets the "coupon_purchases" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateCouponPurchases() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponPurchases()
+	})
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (u *IcoDailyStatUpsertBulk) SetCouponBuyers(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponBuyers(v)
+	})
+}
+
+// AddCouponBuyers adds v to the "coupon_buyers" field.
+func (u *IcoDailyStatUpsertBulk) AddCouponBuyers(v int) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.AddCouponBuyers(v)
+	})
+}
+
+// UpdateCouponBuyers sets the "coupon_buyers" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateCouponBuyers() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponBuyers()
+	})
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (u *IcoDailyStatUpsertBulk) SetCouponNumToken(v string) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponNumToken(v)
+	})
+}
+
+// UpdateCouponNumToken sets the "coupon_num_token" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateCouponNumToken() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponNumToken()
+	})
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (u *IcoDailyStatUpsertBulk) SetCouponRaised(v string) *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.SetCouponRaised(v)
+	})
+}
+
+// UpdateCouponRaised sets the "coupon_raised" field to the value that was provided on create.
+func (u *IcoDailyStatUpsertBulk) UpdateCouponRaised() *IcoDailyStatUpsertBulk {
+	return u.Update(func(s *IcoDailyStatUpsert) {
+		s.UpdateCouponRaised()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoDailyStatUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IcoDailyStatCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoDailyStatCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoDailyStatUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// IcoDailyStatDelete is the builder for deleting a IcoDailyStat entity.
+type IcoDailyStatDelete struct {
+	config
+	hooks    []Hook
+	mutation *IcoDailyStatMutation
+}
+
+// Where appends a list predicates to the IcoDailyStatDelete builder.
+func (idsd *IcoDailyStatDelete) Where(ps ...predicate.IcoDailyStat) *IcoDailyStatDelete {
+	idsd.mutation.Where(ps...)
+	return idsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (idsd *IcoDailyStatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, idsd.sqlExec, idsd.mutation, idsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idsd *IcoDailyStatDelete) ExecX(ctx context.Context) int {
+	n, err := idsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (idsd *IcoDailyStatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(icodailystat.Table, sqlgraph.NewFieldSpec(icodailystat.FieldID, field.TypeString))
+	if ps := idsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, idsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	idsd.mutation.done = true
+	return affected, err
+}
+
+// IcoDailyStatDeleteOne is the builder for deleting a single IcoDailyStat entity.
+type IcoDailyStatDeleteOne struct {
+	idsd *IcoDailyStatDelete
+}
+
+// Where appends a list predicates to the IcoDailyStatDelete builder.
+func (idsdo *IcoDailyStatDeleteOne) Where(ps ...predicate.IcoDailyStat) *IcoDailyStatDeleteOne {
+	idsdo.idsd.mutation.Where(ps...)
+	return idsdo
+}
+
+// Exec executes the deletion query.
+func (idsdo *IcoDailyStatDeleteOne) Exec(ctx context.Context) error {
+	n, err := idsdo.idsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{icodailystat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idsdo *IcoDailyStatDeleteOne) ExecX(ctx context.Context) {
+	if err := idsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
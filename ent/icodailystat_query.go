@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// IcoDailyStatQuery is the builder for querying IcoDailyStat entities.
+type IcoDailyStatQuery struct {
+	config
+	ctx        *QueryContext
+	order      []icodailystat.OrderOption
+	inters     []Interceptor
+	predicates []predicate.IcoDailyStat
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IcoDailyStatQuery builder.
+func (idsq *IcoDailyStatQuery) Where(ps ...predicate.IcoDailyStat) *IcoDailyStatQuery {
+	idsq.predicates = append(idsq.predicates, ps...)
+	return idsq
+}
+
+// Limit the number of records to be returned by this query.
+func (idsq *IcoDailyStatQuery) Limit(limit int) *IcoDailyStatQuery {
+	idsq.ctx.Limit = &limit
+	return idsq
+}
+
+// Offset to start from.
+func (idsq *IcoDailyStatQuery) Offset(offset int) *IcoDailyStatQuery {
+	idsq.ctx.Offset = &offset
+	return idsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (idsq *IcoDailyStatQuery) Unique(unique bool) *IcoDailyStatQuery {
+	idsq.ctx.Unique = &unique
+	return idsq
+}
+
+// Order specifies how the records should be ordered.
+func (idsq *IcoDailyStatQuery) Order(o ...icodailystat.OrderOption) *IcoDailyStatQuery {
+	idsq.order = append(idsq.order, o...)
+	return idsq
+}
+
+// First returns the first IcoDailyStat entity from the query.
+// Returns a *NotFoundError when no IcoDailyStat was found.
+func (idsq *IcoDailyStatQuery) First(ctx context.Context) (*IcoDailyStat, error) {
+	nodes, err := idsq.Limit(1).All(setContextOp(ctx, idsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{icodailystat.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) FirstX(ctx context.Context) *IcoDailyStat {
+	node, err := idsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IcoDailyStat ID from the query.
+// Returns a *NotFoundError when no IcoDailyStat ID was found.
+func (idsq *IcoDailyStatQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = idsq.Limit(1).IDs(setContextOp(ctx, idsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{icodailystat.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := idsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IcoDailyStat entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IcoDailyStat entity is found.
+// Returns a *NotFoundError when no IcoDailyStat entities are found.
+func (idsq *IcoDailyStatQuery) Only(ctx context.Context) (*IcoDailyStat, error) {
+	nodes, err := idsq.Limit(2).All(setContextOp(ctx, idsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{icodailystat.Label}
+	default:
+		return nil, &NotSingularError{icodailystat.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) OnlyX(ctx context.Context) *IcoDailyStat {
+	node, err := idsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IcoDailyStat ID in the query.
+// Returns a *NotSingularError when more than one IcoDailyStat ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (idsq *IcoDailyStatQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = idsq.Limit(2).IDs(setContextOp(ctx, idsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{icodailystat.Label}
+	default:
+		err = &NotSingularError{icodailystat.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := idsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IcoDailyStats.
+func (idsq *IcoDailyStatQuery) All(ctx context.Context) ([]*IcoDailyStat, error) {
+	ctx = setContextOp(ctx, idsq.ctx, "All")
+	if err := idsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IcoDailyStat, *IcoDailyStatQuery]()
+	return withInterceptors[[]*IcoDailyStat](ctx, idsq, qr, idsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) AllX(ctx context.Context) []*IcoDailyStat {
+	nodes, err := idsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IcoDailyStat IDs.
+func (idsq *IcoDailyStatQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if idsq.ctx.Unique == nil && idsq.path != nil {
+		idsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, idsq.ctx, "IDs")
+	if err = idsq.Select(icodailystat.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := idsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (idsq *IcoDailyStatQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, idsq.ctx, "Count")
+	if err := idsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, idsq, querierCount[*IcoDailyStatQuery](), idsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) CountX(ctx context.Context) int {
+	count, err := idsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (idsq *IcoDailyStatQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, idsq.ctx, "Exist")
+	switch _, err := idsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (idsq *IcoDailyStatQuery) ExistX(ctx context.Context) bool {
+	exist, err := idsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IcoDailyStatQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (idsq *IcoDailyStatQuery) Clone() *IcoDailyStatQuery {
+	if idsq == nil {
+		return nil
+	}
+	return &IcoDailyStatQuery{
+		config:     idsq.config,
+		ctx:        idsq.ctx.Clone(),
+		order:      append([]icodailystat.OrderOption{}, idsq.order...),
+		inters:     append([]Interceptor{}, idsq.inters...),
+		predicates: append([]predicate.IcoDailyStat{}, idsq.predicates...),
+		// clone intermediate query.
+		sql:  idsq.sql.Clone(),
+		path: idsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IcoDailyStat.Query().
+//		GroupBy(icodailystat.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (idsq *IcoDailyStatQuery) GroupBy(field string, fields ...string) *IcoDailyStatGroupBy {
+	idsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IcoDailyStatGroupBy{build: idsq}
+	grbuild.flds = &idsq.ctx.Fields
+	grbuild.label = icodailystat.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.IcoDailyStat.Query().
+//		Select(icodailystat.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (idsq *IcoDailyStatQuery) Select(fields ...string) *IcoDailyStatSelect {
+	idsq.ctx.Fields = append(idsq.ctx.Fields, fields...)
+	sbuild := &IcoDailyStatSelect{IcoDailyStatQuery: idsq}
+	sbuild.label = icodailystat.Label
+	sbuild.flds, sbuild.scan = &idsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IcoDailyStatSelect configured with the given aggregations.
+func (idsq *IcoDailyStatQuery) Aggregate(fns ...AggregateFunc) *IcoDailyStatSelect {
+	return idsq.Select().Aggregate(fns...)
+}
+
+func (idsq *IcoDailyStatQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range idsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, idsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range idsq.ctx.Fields {
+		if !icodailystat.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if idsq.path != nil {
+		prev, err := idsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		idsq.sql = prev
+	}
+	return nil
+}
+
+func (idsq *IcoDailyStatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IcoDailyStat, error) {
+	var (
+		nodes = []*IcoDailyStat{}
+		_spec = idsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IcoDailyStat).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IcoDailyStat{config: idsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(idsq.modifiers) > 0 {
+		_spec.Modifiers = idsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, idsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (idsq *IcoDailyStatQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := idsq.querySpec()
+	if len(idsq.modifiers) > 0 {
+		_spec.Modifiers = idsq.modifiers
+	}
+	_spec.Node.Columns = idsq.ctx.Fields
+	if len(idsq.ctx.Fields) > 0 {
+		_spec.Unique = idsq.ctx.Unique != nil && *idsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, idsq.driver, _spec)
+}
+
+func (idsq *IcoDailyStatQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(icodailystat.Table, icodailystat.Columns, sqlgraph.NewFieldSpec(icodailystat.FieldID, field.TypeString))
+	_spec.From = idsq.sql
+	if unique := idsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if idsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := idsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icodailystat.FieldID)
+		for i := range fields {
+			if fields[i] != icodailystat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := idsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := idsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := idsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := idsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (idsq *IcoDailyStatQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(idsq.driver.Dialect())
+	t1 := builder.Table(icodailystat.Table)
+	columns := idsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = icodailystat.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if idsq.sql != nil {
+		selector = idsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if idsq.ctx.Unique != nil && *idsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range idsq.modifiers {
+		m(selector)
+	}
+	for _, p := range idsq.predicates {
+		p(selector)
+	}
+	for _, p := range idsq.order {
+		p(selector)
+	}
+	if offset := idsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := idsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (idsq *IcoDailyStatQuery) Modify(modifiers ...func(s *sql.Selector)) *IcoDailyStatSelect {
+	idsq.modifiers = append(idsq.modifiers, modifiers...)
+	return idsq.Select()
+}
+
+// IcoDailyStatGroupBy is the group-by builder for IcoDailyStat entities.
+type IcoDailyStatGroupBy struct {
+	selector
+	build *IcoDailyStatQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (idsgb *IcoDailyStatGroupBy) Aggregate(fns ...AggregateFunc) *IcoDailyStatGroupBy {
+	idsgb.fns = append(idsgb.fns, fns...)
+	return idsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (idsgb *IcoDailyStatGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, idsgb.build.ctx, "GroupBy")
+	if err := idsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoDailyStatQuery, *IcoDailyStatGroupBy](ctx, idsgb.build, idsgb, idsgb.build.inters, v)
+}
+
+func (idsgb *IcoDailyStatGroupBy) sqlScan(ctx context.Context, root *IcoDailyStatQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(idsgb.fns))
+	for _, fn := range idsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*idsgb.flds)+len(idsgb.fns))
+		for _, f := range *idsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*idsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := idsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IcoDailyStatSelect is the builder for selecting fields of IcoDailyStat entities.
+type IcoDailyStatSelect struct {
+	*IcoDailyStatQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (idss *IcoDailyStatSelect) Aggregate(fns ...AggregateFunc) *IcoDailyStatSelect {
+	idss.fns = append(idss.fns, fns...)
+	return idss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (idss *IcoDailyStatSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, idss.ctx, "Select")
+	if err := idss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoDailyStatQuery, *IcoDailyStatSelect](ctx, idss.IcoDailyStatQuery, idss, idss.inters, v)
+}
+
+func (idss *IcoDailyStatSelect) sqlScan(ctx context.Context, root *IcoDailyStatQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(idss.fns))
+	for _, fn := range idss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*idss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := idss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (idss *IcoDailyStatSelect) Modify(modifiers ...func(s *sql.Selector)) *IcoDailyStatSelect {
+	idss.modifiers = append(idss.modifiers, modifiers...)
+	return idss
+}
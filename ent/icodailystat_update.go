@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// IcoDailyStatUpdate is the builder for updating IcoDailyStat entities.
+type IcoDailyStatUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IcoDailyStatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IcoDailyStatUpdate builder.
+func (idsu *IcoDailyStatUpdate) Where(ps ...predicate.IcoDailyStat) *IcoDailyStatUpdate {
+	idsu.mutation.Where(ps...)
+	return idsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (idsu *IcoDailyStatUpdate) SetUpdatedAt(t time.Time) *IcoDailyStatUpdate {
+	idsu.mutation.SetUpdatedAt(t)
+	return idsu
+}
+
+// SetDay sets the "day" field.
+func (idsu *IcoDailyStatUpdate) SetDay(t time.Time) *IcoDailyStatUpdate {
+	idsu.mutation.SetDay(t)
+	return idsu
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableDay(t *time.Time) *IcoDailyStatUpdate {
+	if t != nil {
+		idsu.SetDay(*t)
+	}
+	return idsu
+}
+
+// SetPurchases sets the "purchases" field.
+func (idsu *IcoDailyStatUpdate) SetPurchases(i int) *IcoDailyStatUpdate {
+	idsu.mutation.ResetPurchases()
+	idsu.mutation.SetPurchases(i)
+	return idsu
+}
+
+// SetNillablePurchases sets the "purchases" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillablePurchases(i *int) *IcoDailyStatUpdate {
+	if i != nil {
+		idsu.SetPurchases(*i)
+	}
+	return idsu
+}
+
+// AddPurchases adds i to the "purchases" field.
+func (idsu *IcoDailyStatUpdate) AddPurchases(i int) *IcoDailyStatUpdate {
+	idsu.mutation.AddPurchases(i)
+	return idsu
+}
+
+// SetBuyers sets the "buyers" field.
+func (idsu *IcoDailyStatUpdate) SetBuyers(i int) *IcoDailyStatUpdate {
+	idsu.mutation.ResetBuyers()
+	idsu.mutation.SetBuyers(i)
+	return idsu
+}
+
+// SetNillableBuyers sets the "buyers" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableBuyers(i *int) *IcoDailyStatUpdate {
+	if i != nil {
+		idsu.SetBuyers(*i)
+	}
+	return idsu
+}
+
+// AddBuyers adds i to the "buyers" field.
+func (idsu *IcoDailyStatUpdate) AddBuyers(i int) *IcoDailyStatUpdate {
+	idsu.mutation.AddBuyers(i)
+	return idsu
+}
+
+// SetNumToken sets the "num_token" field.
+func (idsu *IcoDailyStatUpdate) SetNumToken(s string) *IcoDailyStatUpdate {
+	idsu.mutation.SetNumToken(s)
+	return idsu
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableNumToken(s *string) *IcoDailyStatUpdate {
+	if s != nil {
+		idsu.SetNumToken(*s)
+	}
+	return idsu
+}
+
+// SetRaised sets the "raised" field.
+func (idsu *IcoDailyStatUpdate) SetRaised(s string) *IcoDailyStatUpdate {
+	idsu.mutation.SetRaised(s)
+	return idsu
+}
+
+// SetNillableRaised sets the "raised" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableRaised(s *string) *IcoDailyStatUpdate {
+	if s != nil {
+		idsu.SetRaised(*s)
+	}
+	return idsu
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (idsu *IcoDailyStatUpdate) SetCouponPurchases(i int) *IcoDailyStatUpdate {
+	idsu.mutation.ResetCouponPurchases()
+	idsu.mutation.SetCouponPurchases(i)
+	return idsu
+}
+
+// SetNillableCouponPurchases sets the "coupon_purchases" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableCouponPurchases(i *int) *IcoDailyStatUpdate {
+	if i != nil {
+		idsu.SetCouponPurchases(*i)
+	}
+	return idsu
+}
+
+// AddCouponPurchases adds i to the "coupon_purchases" field.
+func (idsu *IcoDailyStatUpdate) AddCouponPurchases(i int) *IcoDailyStatUpdate {
+	idsu.mutation.AddCouponPurchases(i)
+	return idsu
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (idsu *IcoDailyStatUpdate) SetCouponBuyers(i int) *IcoDailyStatUpdate {
+	idsu.mutation.ResetCouponBuyers()
+	idsu.mutation.SetCouponBuyers(i)
+	return idsu
+}
+
+// SetNillableCouponBuyers sets the "coupon_buyers" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableCouponBuyers(i *int) *IcoDailyStatUpdate {
+	if i != nil {
+		idsu.SetCouponBuyers(*i)
+	}
+	return idsu
+}
+
+// AddCouponBuyers adds i to the "coupon_buyers" field.
+func (idsu *IcoDailyStatUpdate) AddCouponBuyers(i int) *IcoDailyStatUpdate {
+	idsu.mutation.AddCouponBuyers(i)
+	return idsu
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (idsu *IcoDailyStatUpdate) SetCouponNumToken(s string) *IcoDailyStatUpdate {
+	idsu.mutation.SetCouponNumToken(s)
+	return idsu
+}
+
+// SetNillableCouponNumToken sets the "coupon_num_token" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableCouponNumToken(s *string) *IcoDailyStatUpdate {
+	if s != nil {
+		idsu.SetCouponNumToken(*s)
+	}
+	return idsu
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (idsu *IcoDailyStatUpdate) SetCouponRaised(s string) *IcoDailyStatUpdate {
+	idsu.mutation.SetCouponRaised(s)
+	return idsu
+}
+
+// SetNillableCouponRaised sets the "coupon_raised" field if the given value is not nil.
+func (idsu *IcoDailyStatUpdate) SetNillableCouponRaised(s *string) *IcoDailyStatUpdate {
+	if s != nil {
+		idsu.SetCouponRaised(*s)
+	}
+	return idsu
+}
+
+// Mutation returns the IcoDailyStatMutation object of the builder.
+func (idsu *IcoDailyStatUpdate) Mutation() *IcoDailyStatMutation {
+	return idsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (idsu *IcoDailyStatUpdate) Save(ctx context.Context) (int, error) {
+	idsu.defaults()
+	return withHooks(ctx, idsu.sqlSave, idsu.mutation, idsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idsu *IcoDailyStatUpdate) SaveX(ctx context.Context) int {
+	affected, err := idsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (idsu *IcoDailyStatUpdate) Exec(ctx context.Context) error {
+	_, err := idsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idsu *IcoDailyStatUpdate) ExecX(ctx context.Context) {
+	if err := idsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (idsu *IcoDailyStatUpdate) defaults() {
+	if _, ok := idsu.mutation.UpdatedAt(); !ok {
+		v := icodailystat.UpdateDefaultUpdatedAt()
+		idsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (idsu *IcoDailyStatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoDailyStatUpdate {
+	idsu.modifiers = append(idsu.modifiers, modifiers...)
+	return idsu
+}
+
+func (idsu *IcoDailyStatUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icodailystat.Table, icodailystat.Columns, sqlgraph.NewFieldSpec(icodailystat.FieldID, field.TypeString))
+	if ps := idsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := idsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(icodailystat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := idsu.mutation.Day(); ok {
+		_spec.SetField(icodailystat.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := idsu.mutation.Purchases(); ok {
+		_spec.SetField(icodailystat.FieldPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.AddedPurchases(); ok {
+		_spec.AddField(icodailystat.FieldPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.Buyers(); ok {
+		_spec.SetField(icodailystat.FieldBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.AddedBuyers(); ok {
+		_spec.AddField(icodailystat.FieldBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.NumToken(); ok {
+		_spec.SetField(icodailystat.FieldNumToken, field.TypeString, value)
+	}
+	if value, ok := idsu.mutation.Raised(); ok {
+		_spec.SetField(icodailystat.FieldRaised, field.TypeString, value)
+	}
+	if value, ok := idsu.mutation.CouponPurchases(); ok {
+		_spec.SetField(icodailystat.FieldCouponPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.AddedCouponPurchases(); ok {
+		_spec.AddField(icodailystat.FieldCouponPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.CouponBuyers(); ok {
+		_spec.SetField(icodailystat.FieldCouponBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.AddedCouponBuyers(); ok {
+		_spec.AddField(icodailystat.FieldCouponBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsu.mutation.CouponNumToken(); ok {
+		_spec.SetField(icodailystat.FieldCouponNumToken, field.TypeString, value)
+	}
+	if value, ok := idsu.mutation.CouponRaised(); ok {
+		_spec.SetField(icodailystat.FieldCouponRaised, field.TypeString, value)
+	}
+	_spec.AddModifiers(idsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, idsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icodailystat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	idsu.mutation.done = true
+	return n, nil
+}
+
+// IcoDailyStatUpdateOne is the builder for updating a single IcoDailyStat entity.
+type IcoDailyStatUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IcoDailyStatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (idsuo *IcoDailyStatUpdateOne) SetUpdatedAt(t time.Time) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetUpdatedAt(t)
+	return idsuo
+}
+
+// SetDay sets the "day" field.
+func (idsuo *IcoDailyStatUpdateOne) SetDay(t time.Time) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetDay(t)
+	return idsuo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableDay(t *time.Time) *IcoDailyStatUpdateOne {
+	if t != nil {
+		idsuo.SetDay(*t)
+	}
+	return idsuo
+}
+
+// SetPurchases sets the "purchases" field.
+func (idsuo *IcoDailyStatUpdateOne) SetPurchases(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.ResetPurchases()
+	idsuo.mutation.SetPurchases(i)
+	return idsuo
+}
+
+// SetNillablePurchases sets the "purchases" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillablePurchases(i *int) *IcoDailyStatUpdateOne {
+	if i != nil {
+		idsuo.SetPurchases(*i)
+	}
+	return idsuo
+}
+
+// AddPurchases adds i to the "purchases" field.
+func (idsuo *IcoDailyStatUpdateOne) AddPurchases(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.AddPurchases(i)
+	return idsuo
+}
+
+// SetBuyers sets the "buyers" field.
+func (idsuo *IcoDailyStatUpdateOne) SetBuyers(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.ResetBuyers()
+	idsuo.mutation.SetBuyers(i)
+	return idsuo
+}
+
+// SetNillableBuyers sets the "buyers" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableBuyers(i *int) *IcoDailyStatUpdateOne {
+	if i != nil {
+		idsuo.SetBuyers(*i)
+	}
+	return idsuo
+}
+
+// AddBuyers adds i to the "buyers" field.
+func (idsuo *IcoDailyStatUpdateOne) AddBuyers(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.AddBuyers(i)
+	return idsuo
+}
+
+// SetNumToken sets the "num_token" field.
+func (idsuo *IcoDailyStatUpdateOne) SetNumToken(s string) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetNumToken(s)
+	return idsuo
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableNumToken(s *string) *IcoDailyStatUpdateOne {
+	if s != nil {
+		idsuo.SetNumToken(*s)
+	}
+	return idsuo
+}
+
+// SetRaised sets the "raised" field.
+func (idsuo *IcoDailyStatUpdateOne) SetRaised(s string) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetRaised(s)
+	return idsuo
+}
+
+// SetNillableRaised sets the "raised" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableRaised(s *string) *IcoDailyStatUpdateOne {
+	if s != nil {
+		idsuo.SetRaised(*s)
+	}
+	return idsuo
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (idsuo *IcoDailyStatUpdateOne) SetCouponPurchases(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.ResetCouponPurchases()
+	idsuo.mutation.SetCouponPurchases(i)
+	return idsuo
+}
+
+// SetNillableCouponPurchases sets the "coupon_purchases" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableCouponPurchases(i *int) *IcoDailyStatUpdateOne {
+	if i != nil {
+		idsuo.SetCouponPurchases(*i)
+	}
+	return idsuo
+}
+
+// AddCouponPurchases adds i to the "coupon_purchases" field.
+func (idsuo *IcoDailyStatUpdateOne) AddCouponPurchases(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.AddCouponPurchases(i)
+	return idsuo
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (idsuo *IcoDailyStatUpdateOne) SetCouponBuyers(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.ResetCouponBuyers()
+	idsuo.mutation.SetCouponBuyers(i)
+	return idsuo
+}
+
+// SetNillableCouponBuyers sets the "coupon_buyers" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableCouponBuyers(i *int) *IcoDailyStatUpdateOne {
+	if i != nil {
+		idsuo.SetCouponBuyers(*i)
+	}
+	return idsuo
+}
+
+// AddCouponBuyers adds i to the "coupon_buyers" field.
+func (idsuo *IcoDailyStatUpdateOne) AddCouponBuyers(i int) *IcoDailyStatUpdateOne {
+	idsuo.mutation.AddCouponBuyers(i)
+	return idsuo
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (idsuo *IcoDailyStatUpdateOne) SetCouponNumToken(s string) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetCouponNumToken(s)
+	return idsuo
+}
+
+// SetNillableCouponNumToken sets the "coupon_num_token" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableCouponNumToken(s *string) *IcoDailyStatUpdateOne {
+	if s != nil {
+		idsuo.SetCouponNumToken(*s)
+	}
+	return idsuo
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (idsuo *IcoDailyStatUpdateOne) SetCouponRaised(s string) *IcoDailyStatUpdateOne {
+	idsuo.mutation.SetCouponRaised(s)
+	return idsuo
+}
+
+// SetNillableCouponRaised sets the "coupon_raised" field if the given value is not nil.
+func (idsuo *IcoDailyStatUpdateOne) SetNillableCouponRaised(s *string) *IcoDailyStatUpdateOne {
+	if s != nil {
+		idsuo.SetCouponRaised(*s)
+	}
+	return idsuo
+}
+
+// Mutation returns the IcoDailyStatMutation object of the builder.
+func (idsuo *IcoDailyStatUpdateOne) Mutation() *IcoDailyStatMutation {
+	return idsuo.mutation
+}
+
+// Where appends a list predicates to the IcoDailyStatUpdate builder.
+func (idsuo *IcoDailyStatUpdateOne) Where(ps ...predicate.IcoDailyStat) *IcoDailyStatUpdateOne {
+	idsuo.mutation.Where(ps...)
+	return idsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (idsuo *IcoDailyStatUpdateOne) Select(field string, fields ...string) *IcoDailyStatUpdateOne {
+	idsuo.fields = append([]string{field}, fields...)
+	return idsuo
+}
+
+// Save executes the query and returns the updated IcoDailyStat entity.
+func (idsuo *IcoDailyStatUpdateOne) Save(ctx context.Context) (*IcoDailyStat, error) {
+	idsuo.defaults()
+	return withHooks(ctx, idsuo.sqlSave, idsuo.mutation, idsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (idsuo *IcoDailyStatUpdateOne) SaveX(ctx context.Context) *IcoDailyStat {
+	node, err := idsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (idsuo *IcoDailyStatUpdateOne) Exec(ctx context.Context) error {
+	_, err := idsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (idsuo *IcoDailyStatUpdateOne) ExecX(ctx context.Context) {
+	if err := idsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (idsuo *IcoDailyStatUpdateOne) defaults() {
+	if _, ok := idsuo.mutation.UpdatedAt(); !ok {
+		v := icodailystat.UpdateDefaultUpdatedAt()
+		idsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (idsuo *IcoDailyStatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoDailyStatUpdateOne {
+	idsuo.modifiers = append(idsuo.modifiers, modifiers...)
+	return idsuo
+}
+
+func (idsuo *IcoDailyStatUpdateOne) sqlSave(ctx context.Context) (_node *IcoDailyStat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icodailystat.Table, icodailystat.Columns, sqlgraph.NewFieldSpec(icodailystat.FieldID, field.TypeString))
+	id, ok := idsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IcoDailyStat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := idsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icodailystat.FieldID)
+		for _, f := range fields {
+			if !icodailystat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != icodailystat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := idsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := idsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(icodailystat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := idsuo.mutation.Day(); ok {
+		_spec.SetField(icodailystat.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := idsuo.mutation.Purchases(); ok {
+		_spec.SetField(icodailystat.FieldPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.AddedPurchases(); ok {
+		_spec.AddField(icodailystat.FieldPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.Buyers(); ok {
+		_spec.SetField(icodailystat.FieldBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.AddedBuyers(); ok {
+		_spec.AddField(icodailystat.FieldBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.NumToken(); ok {
+		_spec.SetField(icodailystat.FieldNumToken, field.TypeString, value)
+	}
+	if value, ok := idsuo.mutation.Raised(); ok {
+		_spec.SetField(icodailystat.FieldRaised, field.TypeString, value)
+	}
+	if value, ok := idsuo.mutation.CouponPurchases(); ok {
+		_spec.SetField(icodailystat.FieldCouponPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.AddedCouponPurchases(); ok {
+		_spec.AddField(icodailystat.FieldCouponPurchases, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.CouponBuyers(); ok {
+		_spec.SetField(icodailystat.FieldCouponBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.AddedCouponBuyers(); ok {
+		_spec.AddField(icodailystat.FieldCouponBuyers, field.TypeInt, value)
+	}
+	if value, ok := idsuo.mutation.CouponNumToken(); ok {
+		_spec.SetField(icodailystat.FieldCouponNumToken, field.TypeString, value)
+	}
+	if value, ok := idsuo.mutation.CouponRaised(); ok {
+		_spec.SetField(icodailystat.FieldCouponRaised, field.TypeString, value)
+	}
+	_spec.AddModifiers(idsuo.modifiers...)
+	_node = &IcoDailyStat{config: idsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, idsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icodailystat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	idsuo.mutation.done = true
+	return _node, nil
+}
@@ -33,7 +33,13 @@ type IcoHistory struct {
 	// SubRound holds the value of the "sub_round" field.
 	SubRound int32 `json:"sub_round,omitempty"`
 	// Type holds the value of the "type" field.
-	Type         string `json:"type,omitempty"`
+	Type string `json:"type,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID string `json:"source_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount       string `json:"amount,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -44,7 +50,7 @@ func (*IcoHistory) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case icohistory.FieldRoundID, icohistory.FieldSubRound:
 			values[i] = new(sql.NullInt64)
-		case icohistory.FieldUserID, icohistory.FieldPrice, icohistory.FieldNumToken, icohistory.FieldType:
+		case icohistory.FieldUserID, icohistory.FieldPrice, icohistory.FieldNumToken, icohistory.FieldType, icohistory.FieldSourceID, icohistory.FieldSymbol, icohistory.FieldAmount:
 			values[i] = new(sql.NullString)
 		case icohistory.FieldCreatedAt, icohistory.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -119,6 +125,24 @@ func (ih *IcoHistory) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ih.Type = value.String
 			}
+		case icohistory.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				ih.SourceID = value.String
+			}
+		case icohistory.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				ih.Symbol = value.String
+			}
+		case icohistory.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ih.Amount = value.String
+			}
 		default:
 			ih.selectValues.Set(columns[i], values[i])
 		}
@@ -178,6 +202,15 @@ func (ih *IcoHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(ih.Type)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(ih.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(ih.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(ih.Amount)
 	builder.WriteByte(')')
 	return builder.String()
 }
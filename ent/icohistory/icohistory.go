@@ -30,6 +30,12 @@ const (
 	FieldSubRound = "sub_round"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
 	// Table holds the table name of the icohistory in the database.
 	Table = "ico_histories"
 )
@@ -45,6 +51,9 @@ var Columns = []string{
 	FieldNumToken,
 	FieldSubRound,
 	FieldType,
+	FieldSourceID,
+	FieldSymbol,
+	FieldAmount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -115,3 +124,18 @@ func BySubRound(opts ...sql.OrderTermOption) OrderOption {
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
@@ -95,6 +95,21 @@ func Type(v string) predicate.IcoHistory {
 	return predicate.IcoHistory(sql.FieldEQ(FieldType, v))
 }
 
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldSourceID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldAmount, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.IcoHistory {
 	return predicate.IcoHistory(sql.FieldEQ(FieldCreatedAt, v))
@@ -525,6 +540,231 @@ func TypeContainsFold(v string) predicate.IcoHistory {
 	return predicate.IcoHistory(sql.FieldContainsFold(FieldType, v))
 }
 
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDIsNil applies the IsNil predicate on the "source_id" field.
+func SourceIDIsNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIsNull(FieldSourceID))
+}
+
+// SourceIDNotNil applies the NotNil predicate on the "source_id" field.
+func SourceIDNotNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotNull(FieldSourceID))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolIsNil applies the IsNil predicate on the "symbol" field.
+func SymbolIsNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIsNull(FieldSymbol))
+}
+
+// SymbolNotNil applies the NotNil predicate on the "symbol" field.
+func SymbolNotNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotNull(FieldSymbol))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContains(FieldAmount, v))
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasPrefix(FieldAmount, v))
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldHasSuffix(FieldAmount, v))
+}
+
+// AmountIsNil applies the IsNil predicate on the "amount" field.
+func AmountIsNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldIsNull(FieldAmount))
+}
+
+// AmountNotNil applies the NotNil predicate on the "amount" field.
+func AmountNotNil() predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldNotNull(FieldAmount))
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldEqualFold(FieldAmount, v))
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.IcoHistory {
+	return predicate.IcoHistory(sql.FieldContainsFold(FieldAmount, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.IcoHistory) predicate.IcoHistory {
 	return predicate.IcoHistory(sql.AndPredicates(predicates...))
@@ -96,6 +96,48 @@ func (ihc *IcoHistoryCreate) SetNillableType(s *string) *IcoHistoryCreate {
 	return ihc
 }
 
+// SetSourceID sets the "source_id" field.
+func (ihc *IcoHistoryCreate) SetSourceID(s string) *IcoHistoryCreate {
+	ihc.mutation.SetSourceID(s)
+	return ihc
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (ihc *IcoHistoryCreate) SetNillableSourceID(s *string) *IcoHistoryCreate {
+	if s != nil {
+		ihc.SetSourceID(*s)
+	}
+	return ihc
+}
+
+// SetSymbol sets the "symbol" field.
+func (ihc *IcoHistoryCreate) SetSymbol(s string) *IcoHistoryCreate {
+	ihc.mutation.SetSymbol(s)
+	return ihc
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (ihc *IcoHistoryCreate) SetNillableSymbol(s *string) *IcoHistoryCreate {
+	if s != nil {
+		ihc.SetSymbol(*s)
+	}
+	return ihc
+}
+
+// SetAmount sets the "amount" field.
+func (ihc *IcoHistoryCreate) SetAmount(s string) *IcoHistoryCreate {
+	ihc.mutation.SetAmount(s)
+	return ihc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ihc *IcoHistoryCreate) SetNillableAmount(s *string) *IcoHistoryCreate {
+	if s != nil {
+		ihc.SetAmount(*s)
+	}
+	return ihc
+}
+
 // SetID sets the "id" field.
 func (ihc *IcoHistoryCreate) SetID(x xid.ID) *IcoHistoryCreate {
 	ihc.mutation.SetID(x)
@@ -250,6 +292,18 @@ func (ihc *IcoHistoryCreate) createSpec() (*IcoHistory, *sqlgraph.CreateSpec) {
 		_spec.SetField(icohistory.FieldType, field.TypeString, value)
 		_node.Type = value
 	}
+	if value, ok := ihc.mutation.SourceID(); ok {
+		_spec.SetField(icohistory.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := ihc.mutation.Symbol(); ok {
+		_spec.SetField(icohistory.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := ihc.mutation.Amount(); ok {
+		_spec.SetField(icohistory.FieldAmount, field.TypeString, value)
+		_node.Amount = value
+	}
 	return _node, _spec
 }
 
@@ -404,6 +458,60 @@ func (u *IcoHistoryUpsert) ClearType() *IcoHistoryUpsert {
 	return u
 }
 
+// SetSourceID sets the "source_id" field.
+func (u *IcoHistoryUpsert) SetSourceID(v string) *IcoHistoryUpsert {
+	u.Set(icohistory.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoHistoryUpsert) UpdateSourceID() *IcoHistoryUpsert {
+	u.SetExcluded(icohistory.FieldSourceID)
+	return u
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *IcoHistoryUpsert) ClearSourceID() *IcoHistoryUpsert {
+	u.SetNull(icohistory.FieldSourceID)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoHistoryUpsert) SetSymbol(v string) *IcoHistoryUpsert {
+	u.Set(icohistory.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoHistoryUpsert) UpdateSymbol() *IcoHistoryUpsert {
+	u.SetExcluded(icohistory.FieldSymbol)
+	return u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *IcoHistoryUpsert) ClearSymbol() *IcoHistoryUpsert {
+	u.SetNull(icohistory.FieldSymbol)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoHistoryUpsert) SetAmount(v string) *IcoHistoryUpsert {
+	u.Set(icohistory.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoHistoryUpsert) UpdateAmount() *IcoHistoryUpsert {
+	u.SetExcluded(icohistory.FieldAmount)
+	return u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *IcoHistoryUpsert) ClearAmount() *IcoHistoryUpsert {
+	u.SetNull(icohistory.FieldAmount)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -574,6 +682,69 @@ func (u *IcoHistoryUpsertOne) ClearType() *IcoHistoryUpsertOne {
 	})
 }
 
+// SetSourceID sets the "source_id" field.
+func (u *IcoHistoryUpsertOne) SetSourceID(v string) *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoHistoryUpsertOne) UpdateSourceID() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *IcoHistoryUpsertOne) ClearSourceID() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoHistoryUpsertOne) SetSymbol(v string) *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoHistoryUpsertOne) UpdateSymbol() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *IcoHistoryUpsertOne) ClearSymbol() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearSymbol()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoHistoryUpsertOne) SetAmount(v string) *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoHistoryUpsertOne) UpdateAmount() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *IcoHistoryUpsertOne) ClearAmount() *IcoHistoryUpsertOne {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearAmount()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoHistoryUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -911,6 +1082,69 @@ func (u *IcoHistoryUpsertBulk) ClearType() *IcoHistoryUpsertBulk {
 	})
 }
 
+// SetSourceID sets the "source_id" field.
+func (u *IcoHistoryUpsertBulk) SetSourceID(v string) *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoHistoryUpsertBulk) UpdateSourceID() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (u *IcoHistoryUpsertBulk) ClearSourceID() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearSourceID()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *IcoHistoryUpsertBulk) SetSymbol(v string) *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *IcoHistoryUpsertBulk) UpdateSymbol() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (u *IcoHistoryUpsertBulk) ClearSymbol() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearSymbol()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *IcoHistoryUpsertBulk) SetAmount(v string) *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *IcoHistoryUpsertBulk) UpdateAmount() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *IcoHistoryUpsertBulk) ClearAmount() *IcoHistoryUpsertBulk {
+	return u.Update(func(s *IcoHistoryUpsert) {
+		s.ClearAmount()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoHistoryUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
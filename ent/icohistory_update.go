@@ -139,6 +139,66 @@ func (ihu *IcoHistoryUpdate) ClearType() *IcoHistoryUpdate {
 	return ihu
 }
 
+// SetSourceID sets the "source_id" field.
+func (ihu *IcoHistoryUpdate) SetSourceID(s string) *IcoHistoryUpdate {
+	ihu.mutation.SetSourceID(s)
+	return ihu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (ihu *IcoHistoryUpdate) SetNillableSourceID(s *string) *IcoHistoryUpdate {
+	if s != nil {
+		ihu.SetSourceID(*s)
+	}
+	return ihu
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (ihu *IcoHistoryUpdate) ClearSourceID() *IcoHistoryUpdate {
+	ihu.mutation.ClearSourceID()
+	return ihu
+}
+
+// SetSymbol sets the "symbol" field.
+func (ihu *IcoHistoryUpdate) SetSymbol(s string) *IcoHistoryUpdate {
+	ihu.mutation.SetSymbol(s)
+	return ihu
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (ihu *IcoHistoryUpdate) SetNillableSymbol(s *string) *IcoHistoryUpdate {
+	if s != nil {
+		ihu.SetSymbol(*s)
+	}
+	return ihu
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (ihu *IcoHistoryUpdate) ClearSymbol() *IcoHistoryUpdate {
+	ihu.mutation.ClearSymbol()
+	return ihu
+}
+
+// SetAmount sets the "amount" field.
+func (ihu *IcoHistoryUpdate) SetAmount(s string) *IcoHistoryUpdate {
+	ihu.mutation.SetAmount(s)
+	return ihu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ihu *IcoHistoryUpdate) SetNillableAmount(s *string) *IcoHistoryUpdate {
+	if s != nil {
+		ihu.SetAmount(*s)
+	}
+	return ihu
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ihu *IcoHistoryUpdate) ClearAmount() *IcoHistoryUpdate {
+	ihu.mutation.ClearAmount()
+	return ihu
+}
+
 // Mutation returns the IcoHistoryMutation object of the builder.
 func (ihu *IcoHistoryUpdate) Mutation() *IcoHistoryMutation {
 	return ihu.mutation
@@ -225,6 +285,24 @@ func (ihu *IcoHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ihu.mutation.TypeCleared() {
 		_spec.ClearField(icohistory.FieldType, field.TypeString)
 	}
+	if value, ok := ihu.mutation.SourceID(); ok {
+		_spec.SetField(icohistory.FieldSourceID, field.TypeString, value)
+	}
+	if ihu.mutation.SourceIDCleared() {
+		_spec.ClearField(icohistory.FieldSourceID, field.TypeString)
+	}
+	if value, ok := ihu.mutation.Symbol(); ok {
+		_spec.SetField(icohistory.FieldSymbol, field.TypeString, value)
+	}
+	if ihu.mutation.SymbolCleared() {
+		_spec.ClearField(icohistory.FieldSymbol, field.TypeString)
+	}
+	if value, ok := ihu.mutation.Amount(); ok {
+		_spec.SetField(icohistory.FieldAmount, field.TypeString, value)
+	}
+	if ihu.mutation.AmountCleared() {
+		_spec.ClearField(icohistory.FieldAmount, field.TypeString)
+	}
 	_spec.AddModifiers(ihu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ihu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -357,6 +435,66 @@ func (ihuo *IcoHistoryUpdateOne) ClearType() *IcoHistoryUpdateOne {
 	return ihuo
 }
 
+// SetSourceID sets the "source_id" field.
+func (ihuo *IcoHistoryUpdateOne) SetSourceID(s string) *IcoHistoryUpdateOne {
+	ihuo.mutation.SetSourceID(s)
+	return ihuo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (ihuo *IcoHistoryUpdateOne) SetNillableSourceID(s *string) *IcoHistoryUpdateOne {
+	if s != nil {
+		ihuo.SetSourceID(*s)
+	}
+	return ihuo
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (ihuo *IcoHistoryUpdateOne) ClearSourceID() *IcoHistoryUpdateOne {
+	ihuo.mutation.ClearSourceID()
+	return ihuo
+}
+
+// SetSymbol sets the "symbol" field.
+func (ihuo *IcoHistoryUpdateOne) SetSymbol(s string) *IcoHistoryUpdateOne {
+	ihuo.mutation.SetSymbol(s)
+	return ihuo
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (ihuo *IcoHistoryUpdateOne) SetNillableSymbol(s *string) *IcoHistoryUpdateOne {
+	if s != nil {
+		ihuo.SetSymbol(*s)
+	}
+	return ihuo
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (ihuo *IcoHistoryUpdateOne) ClearSymbol() *IcoHistoryUpdateOne {
+	ihuo.mutation.ClearSymbol()
+	return ihuo
+}
+
+// SetAmount sets the "amount" field.
+func (ihuo *IcoHistoryUpdateOne) SetAmount(s string) *IcoHistoryUpdateOne {
+	ihuo.mutation.SetAmount(s)
+	return ihuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ihuo *IcoHistoryUpdateOne) SetNillableAmount(s *string) *IcoHistoryUpdateOne {
+	if s != nil {
+		ihuo.SetAmount(*s)
+	}
+	return ihuo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ihuo *IcoHistoryUpdateOne) ClearAmount() *IcoHistoryUpdateOne {
+	ihuo.mutation.ClearAmount()
+	return ihuo
+}
+
 // Mutation returns the IcoHistoryMutation object of the builder.
 func (ihuo *IcoHistoryUpdateOne) Mutation() *IcoHistoryMutation {
 	return ihuo.mutation
@@ -473,6 +611,24 @@ func (ihuo *IcoHistoryUpdateOne) sqlSave(ctx context.Context) (_node *IcoHistory
 	if ihuo.mutation.TypeCleared() {
 		_spec.ClearField(icohistory.FieldType, field.TypeString)
 	}
+	if value, ok := ihuo.mutation.SourceID(); ok {
+		_spec.SetField(icohistory.FieldSourceID, field.TypeString, value)
+	}
+	if ihuo.mutation.SourceIDCleared() {
+		_spec.ClearField(icohistory.FieldSourceID, field.TypeString)
+	}
+	if value, ok := ihuo.mutation.Symbol(); ok {
+		_spec.SetField(icohistory.FieldSymbol, field.TypeString, value)
+	}
+	if ihuo.mutation.SymbolCleared() {
+		_spec.ClearField(icohistory.FieldSymbol, field.TypeString)
+	}
+	if value, ok := ihuo.mutation.Amount(); ok {
+		_spec.SetField(icohistory.FieldAmount, field.TypeString, value)
+	}
+	if ihuo.mutation.AmountCleared() {
+		_spec.ClearField(icohistory.FieldAmount, field.TypeString)
+	}
 	_spec.AddModifiers(ihuo.modifiers...)
 	_node = &IcoHistory{config: ihuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/rs/xid"
+)
+
+// IcoOutstandingRefund is the model entity for the IcoOutstandingRefund schema.
+type IcoOutstandingRefund struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// RoundID holds the value of the "round_id" field.
+	RoundID int32 `json:"round_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID string `json:"source_id,omitempty"`
+	// NumToken holds the value of the "num_token" field.
+	NumToken     string `json:"num_token,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IcoOutstandingRefund) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case icooutstandingrefund.FieldRoundID:
+			values[i] = new(sql.NullInt64)
+		case icooutstandingrefund.FieldUserID, icooutstandingrefund.FieldSourceID, icooutstandingrefund.FieldNumToken:
+			values[i] = new(sql.NullString)
+		case icooutstandingrefund.FieldCreatedAt, icooutstandingrefund.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case icooutstandingrefund.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IcoOutstandingRefund fields.
+func (ior *IcoOutstandingRefund) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case icooutstandingrefund.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ior.ID = *value
+			}
+		case icooutstandingrefund.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ior.CreatedAt = value.Time
+			}
+		case icooutstandingrefund.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ior.UpdatedAt = value.Time
+			}
+		case icooutstandingrefund.FieldRoundID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round_id", values[i])
+			} else if value.Valid {
+				ior.RoundID = int32(value.Int64)
+			}
+		case icooutstandingrefund.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ior.UserID = value.String
+			}
+		case icooutstandingrefund.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				ior.SourceID = value.String
+			}
+		case icooutstandingrefund.FieldNumToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field num_token", values[i])
+			} else if value.Valid {
+				ior.NumToken = value.String
+			}
+		default:
+			ior.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IcoOutstandingRefund.
+// This includes values selected through modifiers, order, etc.
+func (ior *IcoOutstandingRefund) Value(name string) (ent.Value, error) {
+	return ior.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IcoOutstandingRefund.
+// Note that you need to call IcoOutstandingRefund.Unwrap() before calling this method if this IcoOutstandingRefund
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ior *IcoOutstandingRefund) Update() *IcoOutstandingRefundUpdateOne {
+	return NewIcoOutstandingRefundClient(ior.config).UpdateOne(ior)
+}
+
+// Unwrap unwraps the IcoOutstandingRefund entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ior *IcoOutstandingRefund) Unwrap() *IcoOutstandingRefund {
+	_tx, ok := ior.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IcoOutstandingRefund is not a transactional entity")
+	}
+	ior.config.driver = _tx.drv
+	return ior
+}
+
+// String implements the fmt.Stringer.
+func (ior *IcoOutstandingRefund) String() string {
+	var builder strings.Builder
+	builder.WriteString("IcoOutstandingRefund(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ior.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ior.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ior.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("round_id=")
+	builder.WriteString(fmt.Sprintf("%v", ior.RoundID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ior.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(ior.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("num_token=")
+	builder.WriteString(ior.NumToken)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IcoOutstandingRefunds is a parsable slice of IcoOutstandingRefund.
+type IcoOutstandingRefunds []*IcoOutstandingRefund
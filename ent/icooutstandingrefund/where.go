@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package icooutstandingrefund
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RoundID applies equality check predicate on the "round_id" field. It's identical to RoundIDEQ.
+func RoundID(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldRoundID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldUserID, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldSourceID, v))
+}
+
+// NumToken applies equality check predicate on the "num_token" field. It's identical to NumTokenEQ.
+func NumToken(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldNumToken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RoundIDEQ applies the EQ predicate on the "round_id" field.
+func RoundIDEQ(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldRoundID, v))
+}
+
+// RoundIDNEQ applies the NEQ predicate on the "round_id" field.
+func RoundIDNEQ(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldRoundID, v))
+}
+
+// RoundIDIn applies the In predicate on the "round_id" field.
+func RoundIDIn(vs ...int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldRoundID, vs...))
+}
+
+// RoundIDNotIn applies the NotIn predicate on the "round_id" field.
+func RoundIDNotIn(vs ...int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldRoundID, vs...))
+}
+
+// RoundIDGT applies the GT predicate on the "round_id" field.
+func RoundIDGT(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldRoundID, v))
+}
+
+// RoundIDGTE applies the GTE predicate on the "round_id" field.
+func RoundIDGTE(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldRoundID, v))
+}
+
+// RoundIDLT applies the LT predicate on the "round_id" field.
+func RoundIDLT(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldRoundID, v))
+}
+
+// RoundIDLTE applies the LTE predicate on the "round_id" field.
+func RoundIDLTE(v int32) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldRoundID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// NumTokenEQ applies the EQ predicate on the "num_token" field.
+func NumTokenEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEQ(FieldNumToken, v))
+}
+
+// NumTokenNEQ applies the NEQ predicate on the "num_token" field.
+func NumTokenNEQ(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNEQ(FieldNumToken, v))
+}
+
+// NumTokenIn applies the In predicate on the "num_token" field.
+func NumTokenIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldIn(FieldNumToken, vs...))
+}
+
+// NumTokenNotIn applies the NotIn predicate on the "num_token" field.
+func NumTokenNotIn(vs ...string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldNotIn(FieldNumToken, vs...))
+}
+
+// NumTokenGT applies the GT predicate on the "num_token" field.
+func NumTokenGT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGT(FieldNumToken, v))
+}
+
+// NumTokenGTE applies the GTE predicate on the "num_token" field.
+func NumTokenGTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldGTE(FieldNumToken, v))
+}
+
+// NumTokenLT applies the LT predicate on the "num_token" field.
+func NumTokenLT(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLT(FieldNumToken, v))
+}
+
+// NumTokenLTE applies the LTE predicate on the "num_token" field.
+func NumTokenLTE(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldLTE(FieldNumToken, v))
+}
+
+// NumTokenContains applies the Contains predicate on the "num_token" field.
+func NumTokenContains(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContains(FieldNumToken, v))
+}
+
+// NumTokenHasPrefix applies the HasPrefix predicate on the "num_token" field.
+func NumTokenHasPrefix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasPrefix(FieldNumToken, v))
+}
+
+// NumTokenHasSuffix applies the HasSuffix predicate on the "num_token" field.
+func NumTokenHasSuffix(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldHasSuffix(FieldNumToken, v))
+}
+
+// NumTokenEqualFold applies the EqualFold predicate on the "num_token" field.
+func NumTokenEqualFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldEqualFold(FieldNumToken, v))
+}
+
+// NumTokenContainsFold applies the ContainsFold predicate on the "num_token" field.
+func NumTokenContainsFold(v string) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.FieldContainsFold(FieldNumToken, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IcoOutstandingRefund) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IcoOutstandingRefund) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IcoOutstandingRefund) predicate.IcoOutstandingRefund {
+	return predicate.IcoOutstandingRefund(sql.NotPredicates(p))
+}
@@ -0,0 +1,784 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/rs/xid"
+)
+
+// IcoOutstandingRefundCreate is the builder for creating a IcoOutstandingRefund entity.
+type IcoOutstandingRefundCreate struct {
+	config
+	mutation *IcoOutstandingRefundMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iorc *IcoOutstandingRefundCreate) SetCreatedAt(t time.Time) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetCreatedAt(t)
+	return iorc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iorc *IcoOutstandingRefundCreate) SetNillableCreatedAt(t *time.Time) *IcoOutstandingRefundCreate {
+	if t != nil {
+		iorc.SetCreatedAt(*t)
+	}
+	return iorc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iorc *IcoOutstandingRefundCreate) SetUpdatedAt(t time.Time) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetUpdatedAt(t)
+	return iorc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iorc *IcoOutstandingRefundCreate) SetNillableUpdatedAt(t *time.Time) *IcoOutstandingRefundCreate {
+	if t != nil {
+		iorc.SetUpdatedAt(*t)
+	}
+	return iorc
+}
+
+// SetRoundID sets the "round_id" field.
+func (iorc *IcoOutstandingRefundCreate) SetRoundID(i int32) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetRoundID(i)
+	return iorc
+}
+
+// SetUserID sets the "user_id" field.
+func (iorc *IcoOutstandingRefundCreate) SetUserID(s string) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetUserID(s)
+	return iorc
+}
+
+// SetSourceID sets the "source_id" field.
+func (iorc *IcoOutstandingRefundCreate) SetSourceID(s string) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetSourceID(s)
+	return iorc
+}
+
+// SetNumToken sets the "num_token" field.
+func (iorc *IcoOutstandingRefundCreate) SetNumToken(s string) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetNumToken(s)
+	return iorc
+}
+
+// SetID sets the "id" field.
+func (iorc *IcoOutstandingRefundCreate) SetID(x xid.ID) *IcoOutstandingRefundCreate {
+	iorc.mutation.SetID(x)
+	return iorc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (iorc *IcoOutstandingRefundCreate) SetNillableID(x *xid.ID) *IcoOutstandingRefundCreate {
+	if x != nil {
+		iorc.SetID(*x)
+	}
+	return iorc
+}
+
+// Mutation returns the IcoOutstandingRefundMutation object of the builder.
+func (iorc *IcoOutstandingRefundCreate) Mutation() *IcoOutstandingRefundMutation {
+	return iorc.mutation
+}
+
+// Save creates the IcoOutstandingRefund in the database.
+func (iorc *IcoOutstandingRefundCreate) Save(ctx context.Context) (*IcoOutstandingRefund, error) {
+	iorc.defaults()
+	return withHooks(ctx, iorc.sqlSave, iorc.mutation, iorc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iorc *IcoOutstandingRefundCreate) SaveX(ctx context.Context) *IcoOutstandingRefund {
+	v, err := iorc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iorc *IcoOutstandingRefundCreate) Exec(ctx context.Context) error {
+	_, err := iorc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iorc *IcoOutstandingRefundCreate) ExecX(ctx context.Context) {
+	if err := iorc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iorc *IcoOutstandingRefundCreate) defaults() {
+	if _, ok := iorc.mutation.CreatedAt(); !ok {
+		v := icooutstandingrefund.DefaultCreatedAt()
+		iorc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iorc.mutation.UpdatedAt(); !ok {
+		v := icooutstandingrefund.DefaultUpdatedAt()
+		iorc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := iorc.mutation.ID(); !ok {
+		v := icooutstandingrefund.DefaultID()
+		iorc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iorc *IcoOutstandingRefundCreate) check() error {
+	if _, ok := iorc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IcoOutstandingRefund.created_at"`)}
+	}
+	if _, ok := iorc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IcoOutstandingRefund.updated_at"`)}
+	}
+	if _, ok := iorc.mutation.RoundID(); !ok {
+		return &ValidationError{Name: "round_id", err: errors.New(`ent: missing required field "IcoOutstandingRefund.round_id"`)}
+	}
+	if _, ok := iorc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "IcoOutstandingRefund.user_id"`)}
+	}
+	if _, ok := iorc.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "IcoOutstandingRefund.source_id"`)}
+	}
+	if _, ok := iorc.mutation.NumToken(); !ok {
+		return &ValidationError{Name: "num_token", err: errors.New(`ent: missing required field "IcoOutstandingRefund.num_token"`)}
+	}
+	return nil
+}
+
+func (iorc *IcoOutstandingRefundCreate) sqlSave(ctx context.Context) (*IcoOutstandingRefund, error) {
+	if err := iorc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iorc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iorc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	iorc.mutation.id = &_node.ID
+	iorc.mutation.done = true
+	return _node, nil
+}
+
+func (iorc *IcoOutstandingRefundCreate) createSpec() (*IcoOutstandingRefund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IcoOutstandingRefund{config: iorc.config}
+		_spec = sqlgraph.NewCreateSpec(icooutstandingrefund.Table, sqlgraph.NewFieldSpec(icooutstandingrefund.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = iorc.conflict
+	if id, ok := iorc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := iorc.mutation.CreatedAt(); ok {
+		_spec.SetField(icooutstandingrefund.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iorc.mutation.UpdatedAt(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := iorc.mutation.RoundID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldRoundID, field.TypeInt32, value)
+		_node.RoundID = value
+	}
+	if value, ok := iorc.mutation.UserID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := iorc.mutation.SourceID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := iorc.mutation.NumToken(); ok {
+		_spec.SetField(icooutstandingrefund.FieldNumToken, field.TypeString, value)
+		_node.NumToken = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoOutstandingRefund.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoOutstandingRefundUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (iorc *IcoOutstandingRefundCreate) OnConflict(opts ...sql.ConflictOption) *IcoOutstandingRefundUpsertOne {
+	iorc.conflict = opts
+	return &IcoOutstandingRefundUpsertOne{
+		create: iorc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iorc *IcoOutstandingRefundCreate) OnConflictColumns(columns ...string) *IcoOutstandingRefundUpsertOne {
+	iorc.conflict = append(iorc.conflict, sql.ConflictColumns(columns...))
+	return &IcoOutstandingRefundUpsertOne{
+		create: iorc,
+	}
+}
+
+type (
+	// IcoOutstandingRefundUpsertOne is the builder for "upsert"-ing
+	//  one IcoOutstandingRefund node.
+	IcoOutstandingRefundUpsertOne struct {
+		create *IcoOutstandingRefundCreate
+	}
+
+	// IcoOutstandingRefundUpsert is the "OnConflict" setter.
+	IcoOutstandingRefundUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoOutstandingRefundUpsert) SetUpdatedAt(v time.Time) *IcoOutstandingRefundUpsert {
+	u.Set(icooutstandingrefund.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsert) UpdateUpdatedAt() *IcoOutstandingRefundUpsert {
+	u.SetExcluded(icooutstandingrefund.FieldUpdatedAt)
+	return u
+}
+
+// SetRoundID sets the "round_id" field.
+func (u *IcoOutstandingRefundUpsert) SetRoundID(v int32) *IcoOutstandingRefundUpsert {
+	u.Set(icooutstandingrefund.FieldRoundID, v)
+	return u
+}
+
+// UpdateRoundID sets the "round_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsert) UpdateRoundID() *IcoOutstandingRefundUpsert {
+	u.SetExcluded(icooutstandingrefund.FieldRoundID)
+	return u
+}
+
+// AddRoundID adds v to the "round_id" field.
+func (u *IcoOutstandingRefundUpsert) AddRoundID(v int32) *IcoOutstandingRefundUpsert {
+	u.Add(icooutstandingrefund.FieldRoundID, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoOutstandingRefundUpsert) SetUserID(v string) *IcoOutstandingRefundUpsert {
+	u.Set(icooutstandingrefund.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsert) UpdateUserID() *IcoOutstandingRefundUpsert {
+	u.SetExcluded(icooutstandingrefund.FieldUserID)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoOutstandingRefundUpsert) SetSourceID(v string) *IcoOutstandingRefundUpsert {
+	u.Set(icooutstandingrefund.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsert) UpdateSourceID() *IcoOutstandingRefundUpsert {
+	u.SetExcluded(icooutstandingrefund.FieldSourceID)
+	return u
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoOutstandingRefundUpsert) SetNumToken(v string) *IcoOutstandingRefundUpsert {
+	u.Set(icooutstandingrefund.FieldNumToken, v)
+	return u
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsert) UpdateNumToken() *IcoOutstandingRefundUpsert {
+	u.SetExcluded(icooutstandingrefund.FieldNumToken)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icooutstandingrefund.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoOutstandingRefundUpsertOne) UpdateNewValues() *IcoOutstandingRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(icooutstandingrefund.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(icooutstandingrefund.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IcoOutstandingRefundUpsertOne) Ignore() *IcoOutstandingRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoOutstandingRefundUpsertOne) DoNothing() *IcoOutstandingRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoOutstandingRefundCreate.OnConflict
+// documentation for more info.
+func (u *IcoOutstandingRefundUpsertOne) Update(set func(*IcoOutstandingRefundUpsert)) *IcoOutstandingRefundUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoOutstandingRefundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoOutstandingRefundUpsertOne) SetUpdatedAt(v time.Time) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertOne) UpdateUpdatedAt() *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRoundID sets the "round_id" field.
+func (u *IcoOutstandingRefundUpsertOne) SetRoundID(v int32) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetRoundID(v)
+	})
+}
+
+// AddRoundID adds v to the "round_id" field.
+func (u *IcoOutstandingRefundUpsertOne) AddRoundID(v int32) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.AddRoundID(v)
+	})
+}
+
+// UpdateRoundID sets the "round_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertOne) UpdateRoundID() *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateRoundID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoOutstandingRefundUpsertOne) SetUserID(v string) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertOne) UpdateUserID() *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoOutstandingRefundUpsertOne) SetSourceID(v string) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertOne) UpdateSourceID() *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoOutstandingRefundUpsertOne) SetNumToken(v string) *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertOne) UpdateNumToken() *IcoOutstandingRefundUpsertOne {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoOutstandingRefundUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoOutstandingRefundCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoOutstandingRefundUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IcoOutstandingRefundUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: IcoOutstandingRefundUpsertOne.ID is not supported by MySQL driver. Use IcoOutstandingRefundUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IcoOutstandingRefundUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IcoOutstandingRefundCreateBulk is the builder for creating many IcoOutstandingRefund entities in bulk.
+type IcoOutstandingRefundCreateBulk struct {
+	config
+	err      error
+	builders []*IcoOutstandingRefundCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IcoOutstandingRefund entities in the database.
+func (iorcb *IcoOutstandingRefundCreateBulk) Save(ctx context.Context) ([]*IcoOutstandingRefund, error) {
+	if iorcb.err != nil {
+		return nil, iorcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iorcb.builders))
+	nodes := make([]*IcoOutstandingRefund, len(iorcb.builders))
+	mutators := make([]Mutator, len(iorcb.builders))
+	for i := range iorcb.builders {
+		func(i int, root context.Context) {
+			builder := iorcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IcoOutstandingRefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iorcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iorcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iorcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iorcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iorcb *IcoOutstandingRefundCreateBulk) SaveX(ctx context.Context) []*IcoOutstandingRefund {
+	v, err := iorcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iorcb *IcoOutstandingRefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := iorcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iorcb *IcoOutstandingRefundCreateBulk) ExecX(ctx context.Context) {
+	if err := iorcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IcoOutstandingRefund.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IcoOutstandingRefundUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (iorcb *IcoOutstandingRefundCreateBulk) OnConflict(opts ...sql.ConflictOption) *IcoOutstandingRefundUpsertBulk {
+	iorcb.conflict = opts
+	return &IcoOutstandingRefundUpsertBulk{
+		create: iorcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iorcb *IcoOutstandingRefundCreateBulk) OnConflictColumns(columns ...string) *IcoOutstandingRefundUpsertBulk {
+	iorcb.conflict = append(iorcb.conflict, sql.ConflictColumns(columns...))
+	return &IcoOutstandingRefundUpsertBulk{
+		create: iorcb,
+	}
+}
+
+// IcoOutstandingRefundUpsertBulk is the builder for "upsert"-ing
+// a bulk of IcoOutstandingRefund nodes.
+type IcoOutstandingRefundUpsertBulk struct {
+	create *IcoOutstandingRefundCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(icooutstandingrefund.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IcoOutstandingRefundUpsertBulk) UpdateNewValues() *IcoOutstandingRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(icooutstandingrefund.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(icooutstandingrefund.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IcoOutstandingRefund.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IcoOutstandingRefundUpsertBulk) Ignore() *IcoOutstandingRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IcoOutstandingRefundUpsertBulk) DoNothing() *IcoOutstandingRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IcoOutstandingRefundCreateBulk.OnConflict
+// documentation for more info.
+func (u *IcoOutstandingRefundUpsertBulk) Update(set func(*IcoOutstandingRefundUpsert)) *IcoOutstandingRefundUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IcoOutstandingRefundUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IcoOutstandingRefundUpsertBulk) SetUpdatedAt(v time.Time) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertBulk) UpdateUpdatedAt() *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRoundID sets the "round_id" field.
+func (u *IcoOutstandingRefundUpsertBulk) SetRoundID(v int32) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetRoundID(v)
+	})
+}
+
+// AddRoundID adds v to the "round_id" field.
+func (u *IcoOutstandingRefundUpsertBulk) AddRoundID(v int32) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.AddRoundID(v)
+	})
+}
+
+// UpdateRoundID sets the "round_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertBulk) UpdateRoundID() *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateRoundID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *IcoOutstandingRefundUpsertBulk) SetUserID(v string) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertBulk) UpdateUserID() *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *IcoOutstandingRefundUpsertBulk) SetSourceID(v string) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertBulk) UpdateSourceID() *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *IcoOutstandingRefundUpsertBulk) SetNumToken(v string) *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *IcoOutstandingRefundUpsertBulk) UpdateNumToken() *IcoOutstandingRefundUpsertBulk {
+	return u.Update(func(s *IcoOutstandingRefundUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// Exec executes the query.
+func (u *IcoOutstandingRefundUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IcoOutstandingRefundCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IcoOutstandingRefundCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IcoOutstandingRefundUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// IcoOutstandingRefundDelete is the builder for deleting a IcoOutstandingRefund entity.
+type IcoOutstandingRefundDelete struct {
+	config
+	hooks    []Hook
+	mutation *IcoOutstandingRefundMutation
+}
+
+// Where appends a list predicates to the IcoOutstandingRefundDelete builder.
+func (iord *IcoOutstandingRefundDelete) Where(ps ...predicate.IcoOutstandingRefund) *IcoOutstandingRefundDelete {
+	iord.mutation.Where(ps...)
+	return iord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iord *IcoOutstandingRefundDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iord.sqlExec, iord.mutation, iord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iord *IcoOutstandingRefundDelete) ExecX(ctx context.Context) int {
+	n, err := iord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iord *IcoOutstandingRefundDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(icooutstandingrefund.Table, sqlgraph.NewFieldSpec(icooutstandingrefund.FieldID, field.TypeString))
+	if ps := iord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iord.mutation.done = true
+	return affected, err
+}
+
+// IcoOutstandingRefundDeleteOne is the builder for deleting a single IcoOutstandingRefund entity.
+type IcoOutstandingRefundDeleteOne struct {
+	iord *IcoOutstandingRefundDelete
+}
+
+// Where appends a list predicates to the IcoOutstandingRefundDelete builder.
+func (iordo *IcoOutstandingRefundDeleteOne) Where(ps ...predicate.IcoOutstandingRefund) *IcoOutstandingRefundDeleteOne {
+	iordo.iord.mutation.Where(ps...)
+	return iordo
+}
+
+// Exec executes the deletion query.
+func (iordo *IcoOutstandingRefundDeleteOne) Exec(ctx context.Context) error {
+	n, err := iordo.iord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{icooutstandingrefund.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iordo *IcoOutstandingRefundDeleteOne) ExecX(ctx context.Context) {
+	if err := iordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
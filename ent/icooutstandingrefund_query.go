@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// IcoOutstandingRefundQuery is the builder for querying IcoOutstandingRefund entities.
+type IcoOutstandingRefundQuery struct {
+	config
+	ctx        *QueryContext
+	order      []icooutstandingrefund.OrderOption
+	inters     []Interceptor
+	predicates []predicate.IcoOutstandingRefund
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IcoOutstandingRefundQuery builder.
+func (iorq *IcoOutstandingRefundQuery) Where(ps ...predicate.IcoOutstandingRefund) *IcoOutstandingRefundQuery {
+	iorq.predicates = append(iorq.predicates, ps...)
+	return iorq
+}
+
+// Limit the number of records to be returned by this query.
+func (iorq *IcoOutstandingRefundQuery) Limit(limit int) *IcoOutstandingRefundQuery {
+	iorq.ctx.Limit = &limit
+	return iorq
+}
+
+// Offset to start from.
+func (iorq *IcoOutstandingRefundQuery) Offset(offset int) *IcoOutstandingRefundQuery {
+	iorq.ctx.Offset = &offset
+	return iorq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iorq *IcoOutstandingRefundQuery) Unique(unique bool) *IcoOutstandingRefundQuery {
+	iorq.ctx.Unique = &unique
+	return iorq
+}
+
+// Order specifies how the records should be ordered.
+func (iorq *IcoOutstandingRefundQuery) Order(o ...icooutstandingrefund.OrderOption) *IcoOutstandingRefundQuery {
+	iorq.order = append(iorq.order, o...)
+	return iorq
+}
+
+// First returns the first IcoOutstandingRefund entity from the query.
+// Returns a *NotFoundError when no IcoOutstandingRefund was found.
+func (iorq *IcoOutstandingRefundQuery) First(ctx context.Context) (*IcoOutstandingRefund, error) {
+	nodes, err := iorq.Limit(1).All(setContextOp(ctx, iorq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{icooutstandingrefund.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) FirstX(ctx context.Context) *IcoOutstandingRefund {
+	node, err := iorq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IcoOutstandingRefund ID from the query.
+// Returns a *NotFoundError when no IcoOutstandingRefund ID was found.
+func (iorq *IcoOutstandingRefundQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = iorq.Limit(1).IDs(setContextOp(ctx, iorq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{icooutstandingrefund.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := iorq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IcoOutstandingRefund entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IcoOutstandingRefund entity is found.
+// Returns a *NotFoundError when no IcoOutstandingRefund entities are found.
+func (iorq *IcoOutstandingRefundQuery) Only(ctx context.Context) (*IcoOutstandingRefund, error) {
+	nodes, err := iorq.Limit(2).All(setContextOp(ctx, iorq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{icooutstandingrefund.Label}
+	default:
+		return nil, &NotSingularError{icooutstandingrefund.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) OnlyX(ctx context.Context) *IcoOutstandingRefund {
+	node, err := iorq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IcoOutstandingRefund ID in the query.
+// Returns a *NotSingularError when more than one IcoOutstandingRefund ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iorq *IcoOutstandingRefundQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = iorq.Limit(2).IDs(setContextOp(ctx, iorq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{icooutstandingrefund.Label}
+	default:
+		err = &NotSingularError{icooutstandingrefund.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := iorq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IcoOutstandingRefunds.
+func (iorq *IcoOutstandingRefundQuery) All(ctx context.Context) ([]*IcoOutstandingRefund, error) {
+	ctx = setContextOp(ctx, iorq.ctx, "All")
+	if err := iorq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IcoOutstandingRefund, *IcoOutstandingRefundQuery]()
+	return withInterceptors[[]*IcoOutstandingRefund](ctx, iorq, qr, iorq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) AllX(ctx context.Context) []*IcoOutstandingRefund {
+	nodes, err := iorq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IcoOutstandingRefund IDs.
+func (iorq *IcoOutstandingRefundQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if iorq.ctx.Unique == nil && iorq.path != nil {
+		iorq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iorq.ctx, "IDs")
+	if err = iorq.Select(icooutstandingrefund.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := iorq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iorq *IcoOutstandingRefundQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iorq.ctx, "Count")
+	if err := iorq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iorq, querierCount[*IcoOutstandingRefundQuery](), iorq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) CountX(ctx context.Context) int {
+	count, err := iorq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iorq *IcoOutstandingRefundQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iorq.ctx, "Exist")
+	switch _, err := iorq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iorq *IcoOutstandingRefundQuery) ExistX(ctx context.Context) bool {
+	exist, err := iorq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IcoOutstandingRefundQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iorq *IcoOutstandingRefundQuery) Clone() *IcoOutstandingRefundQuery {
+	if iorq == nil {
+		return nil
+	}
+	return &IcoOutstandingRefundQuery{
+		config:     iorq.config,
+		ctx:        iorq.ctx.Clone(),
+		order:      append([]icooutstandingrefund.OrderOption{}, iorq.order...),
+		inters:     append([]Interceptor{}, iorq.inters...),
+		predicates: append([]predicate.IcoOutstandingRefund{}, iorq.predicates...),
+		// clone intermediate query.
+		sql:  iorq.sql.Clone(),
+		path: iorq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IcoOutstandingRefund.Query().
+//		GroupBy(icooutstandingrefund.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iorq *IcoOutstandingRefundQuery) GroupBy(field string, fields ...string) *IcoOutstandingRefundGroupBy {
+	iorq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IcoOutstandingRefundGroupBy{build: iorq}
+	grbuild.flds = &iorq.ctx.Fields
+	grbuild.label = icooutstandingrefund.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.IcoOutstandingRefund.Query().
+//		Select(icooutstandingrefund.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (iorq *IcoOutstandingRefundQuery) Select(fields ...string) *IcoOutstandingRefundSelect {
+	iorq.ctx.Fields = append(iorq.ctx.Fields, fields...)
+	sbuild := &IcoOutstandingRefundSelect{IcoOutstandingRefundQuery: iorq}
+	sbuild.label = icooutstandingrefund.Label
+	sbuild.flds, sbuild.scan = &iorq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IcoOutstandingRefundSelect configured with the given aggregations.
+func (iorq *IcoOutstandingRefundQuery) Aggregate(fns ...AggregateFunc) *IcoOutstandingRefundSelect {
+	return iorq.Select().Aggregate(fns...)
+}
+
+func (iorq *IcoOutstandingRefundQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iorq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iorq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iorq.ctx.Fields {
+		if !icooutstandingrefund.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iorq.path != nil {
+		prev, err := iorq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iorq.sql = prev
+	}
+	return nil
+}
+
+func (iorq *IcoOutstandingRefundQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IcoOutstandingRefund, error) {
+	var (
+		nodes = []*IcoOutstandingRefund{}
+		_spec = iorq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IcoOutstandingRefund).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IcoOutstandingRefund{config: iorq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iorq.modifiers) > 0 {
+		_spec.Modifiers = iorq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iorq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iorq *IcoOutstandingRefundQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iorq.querySpec()
+	if len(iorq.modifiers) > 0 {
+		_spec.Modifiers = iorq.modifiers
+	}
+	_spec.Node.Columns = iorq.ctx.Fields
+	if len(iorq.ctx.Fields) > 0 {
+		_spec.Unique = iorq.ctx.Unique != nil && *iorq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iorq.driver, _spec)
+}
+
+func (iorq *IcoOutstandingRefundQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(icooutstandingrefund.Table, icooutstandingrefund.Columns, sqlgraph.NewFieldSpec(icooutstandingrefund.FieldID, field.TypeString))
+	_spec.From = iorq.sql
+	if unique := iorq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iorq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iorq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icooutstandingrefund.FieldID)
+		for i := range fields {
+			if fields[i] != icooutstandingrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iorq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iorq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iorq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iorq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iorq *IcoOutstandingRefundQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iorq.driver.Dialect())
+	t1 := builder.Table(icooutstandingrefund.Table)
+	columns := iorq.ctx.Fields
+	if len(columns) == 0 {
+		columns = icooutstandingrefund.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iorq.sql != nil {
+		selector = iorq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iorq.ctx.Unique != nil && *iorq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iorq.modifiers {
+		m(selector)
+	}
+	for _, p := range iorq.predicates {
+		p(selector)
+	}
+	for _, p := range iorq.order {
+		p(selector)
+	}
+	if offset := iorq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iorq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iorq *IcoOutstandingRefundQuery) Modify(modifiers ...func(s *sql.Selector)) *IcoOutstandingRefundSelect {
+	iorq.modifiers = append(iorq.modifiers, modifiers...)
+	return iorq.Select()
+}
+
+// IcoOutstandingRefundGroupBy is the group-by builder for IcoOutstandingRefund entities.
+type IcoOutstandingRefundGroupBy struct {
+	selector
+	build *IcoOutstandingRefundQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iorgb *IcoOutstandingRefundGroupBy) Aggregate(fns ...AggregateFunc) *IcoOutstandingRefundGroupBy {
+	iorgb.fns = append(iorgb.fns, fns...)
+	return iorgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iorgb *IcoOutstandingRefundGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iorgb.build.ctx, "GroupBy")
+	if err := iorgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoOutstandingRefundQuery, *IcoOutstandingRefundGroupBy](ctx, iorgb.build, iorgb, iorgb.build.inters, v)
+}
+
+func (iorgb *IcoOutstandingRefundGroupBy) sqlScan(ctx context.Context, root *IcoOutstandingRefundQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iorgb.fns))
+	for _, fn := range iorgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iorgb.flds)+len(iorgb.fns))
+		for _, f := range *iorgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iorgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iorgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IcoOutstandingRefundSelect is the builder for selecting fields of IcoOutstandingRefund entities.
+type IcoOutstandingRefundSelect struct {
+	*IcoOutstandingRefundQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iors *IcoOutstandingRefundSelect) Aggregate(fns ...AggregateFunc) *IcoOutstandingRefundSelect {
+	iors.fns = append(iors.fns, fns...)
+	return iors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iors *IcoOutstandingRefundSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iors.ctx, "Select")
+	if err := iors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IcoOutstandingRefundQuery, *IcoOutstandingRefundSelect](ctx, iors.IcoOutstandingRefundQuery, iors, iors.inters, v)
+}
+
+func (iors *IcoOutstandingRefundSelect) sqlScan(ctx context.Context, root *IcoOutstandingRefundQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iors.fns))
+	for _, fn := range iors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iors *IcoOutstandingRefundSelect) Modify(modifiers ...func(s *sql.Selector)) *IcoOutstandingRefundSelect {
+	iors.modifiers = append(iors.modifiers, modifiers...)
+	return iors
+}
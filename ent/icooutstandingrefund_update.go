@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// IcoOutstandingRefundUpdate is the builder for updating IcoOutstandingRefund entities.
+type IcoOutstandingRefundUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IcoOutstandingRefundMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IcoOutstandingRefundUpdate builder.
+func (ioru *IcoOutstandingRefundUpdate) Where(ps ...predicate.IcoOutstandingRefund) *IcoOutstandingRefundUpdate {
+	ioru.mutation.Where(ps...)
+	return ioru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ioru *IcoOutstandingRefundUpdate) SetUpdatedAt(t time.Time) *IcoOutstandingRefundUpdate {
+	ioru.mutation.SetUpdatedAt(t)
+	return ioru
+}
+
+// SetRoundID sets the "round_id" field.
+func (ioru *IcoOutstandingRefundUpdate) SetRoundID(i int32) *IcoOutstandingRefundUpdate {
+	ioru.mutation.ResetRoundID()
+	ioru.mutation.SetRoundID(i)
+	return ioru
+}
+
+// SetNillableRoundID sets the "round_id" field if the given value is not nil.
+func (ioru *IcoOutstandingRefundUpdate) SetNillableRoundID(i *int32) *IcoOutstandingRefundUpdate {
+	if i != nil {
+		ioru.SetRoundID(*i)
+	}
+	return ioru
+}
+
+// AddRoundID adds i to the "round_id" field.
+func (ioru *IcoOutstandingRefundUpdate) AddRoundID(i int32) *IcoOutstandingRefundUpdate {
+	ioru.mutation.AddRoundID(i)
+	return ioru
+}
+
+// SetUserID sets the "user_id" field.
+func (ioru *IcoOutstandingRefundUpdate) SetUserID(s string) *IcoOutstandingRefundUpdate {
+	ioru.mutation.SetUserID(s)
+	return ioru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ioru *IcoOutstandingRefundUpdate) SetNillableUserID(s *string) *IcoOutstandingRefundUpdate {
+	if s != nil {
+		ioru.SetUserID(*s)
+	}
+	return ioru
+}
+
+// SetSourceID sets the "source_id" field.
+func (ioru *IcoOutstandingRefundUpdate) SetSourceID(s string) *IcoOutstandingRefundUpdate {
+	ioru.mutation.SetSourceID(s)
+	return ioru
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (ioru *IcoOutstandingRefundUpdate) SetNillableSourceID(s *string) *IcoOutstandingRefundUpdate {
+	if s != nil {
+		ioru.SetSourceID(*s)
+	}
+	return ioru
+}
+
+// SetNumToken sets the "num_token" field.
+func (ioru *IcoOutstandingRefundUpdate) SetNumToken(s string) *IcoOutstandingRefundUpdate {
+	ioru.mutation.SetNumToken(s)
+	return ioru
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (ioru *IcoOutstandingRefundUpdate) SetNillableNumToken(s *string) *IcoOutstandingRefundUpdate {
+	if s != nil {
+		ioru.SetNumToken(*s)
+	}
+	return ioru
+}
+
+// Mutation returns the IcoOutstandingRefundMutation object of the builder.
+func (ioru *IcoOutstandingRefundUpdate) Mutation() *IcoOutstandingRefundMutation {
+	return ioru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ioru *IcoOutstandingRefundUpdate) Save(ctx context.Context) (int, error) {
+	ioru.defaults()
+	return withHooks(ctx, ioru.sqlSave, ioru.mutation, ioru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ioru *IcoOutstandingRefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := ioru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ioru *IcoOutstandingRefundUpdate) Exec(ctx context.Context) error {
+	_, err := ioru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ioru *IcoOutstandingRefundUpdate) ExecX(ctx context.Context) {
+	if err := ioru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ioru *IcoOutstandingRefundUpdate) defaults() {
+	if _, ok := ioru.mutation.UpdatedAt(); !ok {
+		v := icooutstandingrefund.UpdateDefaultUpdatedAt()
+		ioru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ioru *IcoOutstandingRefundUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoOutstandingRefundUpdate {
+	ioru.modifiers = append(ioru.modifiers, modifiers...)
+	return ioru
+}
+
+func (ioru *IcoOutstandingRefundUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icooutstandingrefund.Table, icooutstandingrefund.Columns, sqlgraph.NewFieldSpec(icooutstandingrefund.FieldID, field.TypeString))
+	if ps := ioru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ioru.mutation.UpdatedAt(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ioru.mutation.RoundID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldRoundID, field.TypeInt32, value)
+	}
+	if value, ok := ioru.mutation.AddedRoundID(); ok {
+		_spec.AddField(icooutstandingrefund.FieldRoundID, field.TypeInt32, value)
+	}
+	if value, ok := ioru.mutation.UserID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ioru.mutation.SourceID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := ioru.mutation.NumToken(); ok {
+		_spec.SetField(icooutstandingrefund.FieldNumToken, field.TypeString, value)
+	}
+	_spec.AddModifiers(ioru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ioru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icooutstandingrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ioru.mutation.done = true
+	return n, nil
+}
+
+// IcoOutstandingRefundUpdateOne is the builder for updating a single IcoOutstandingRefund entity.
+type IcoOutstandingRefundUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IcoOutstandingRefundMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetUpdatedAt(t time.Time) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.SetUpdatedAt(t)
+	return ioruo
+}
+
+// SetRoundID sets the "round_id" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetRoundID(i int32) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.ResetRoundID()
+	ioruo.mutation.SetRoundID(i)
+	return ioruo
+}
+
+// SetNillableRoundID sets the "round_id" field if the given value is not nil.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetNillableRoundID(i *int32) *IcoOutstandingRefundUpdateOne {
+	if i != nil {
+		ioruo.SetRoundID(*i)
+	}
+	return ioruo
+}
+
+// AddRoundID adds i to the "round_id" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) AddRoundID(i int32) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.AddRoundID(i)
+	return ioruo
+}
+
+// SetUserID sets the "user_id" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetUserID(s string) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.SetUserID(s)
+	return ioruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetNillableUserID(s *string) *IcoOutstandingRefundUpdateOne {
+	if s != nil {
+		ioruo.SetUserID(*s)
+	}
+	return ioruo
+}
+
+// SetSourceID sets the "source_id" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetSourceID(s string) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.SetSourceID(s)
+	return ioruo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetNillableSourceID(s *string) *IcoOutstandingRefundUpdateOne {
+	if s != nil {
+		ioruo.SetSourceID(*s)
+	}
+	return ioruo
+}
+
+// SetNumToken sets the "num_token" field.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetNumToken(s string) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.SetNumToken(s)
+	return ioruo
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (ioruo *IcoOutstandingRefundUpdateOne) SetNillableNumToken(s *string) *IcoOutstandingRefundUpdateOne {
+	if s != nil {
+		ioruo.SetNumToken(*s)
+	}
+	return ioruo
+}
+
+// Mutation returns the IcoOutstandingRefundMutation object of the builder.
+func (ioruo *IcoOutstandingRefundUpdateOne) Mutation() *IcoOutstandingRefundMutation {
+	return ioruo.mutation
+}
+
+// Where appends a list predicates to the IcoOutstandingRefundUpdate builder.
+func (ioruo *IcoOutstandingRefundUpdateOne) Where(ps ...predicate.IcoOutstandingRefund) *IcoOutstandingRefundUpdateOne {
+	ioruo.mutation.Where(ps...)
+	return ioruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ioruo *IcoOutstandingRefundUpdateOne) Select(field string, fields ...string) *IcoOutstandingRefundUpdateOne {
+	ioruo.fields = append([]string{field}, fields...)
+	return ioruo
+}
+
+// Save executes the query and returns the updated IcoOutstandingRefund entity.
+func (ioruo *IcoOutstandingRefundUpdateOne) Save(ctx context.Context) (*IcoOutstandingRefund, error) {
+	ioruo.defaults()
+	return withHooks(ctx, ioruo.sqlSave, ioruo.mutation, ioruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ioruo *IcoOutstandingRefundUpdateOne) SaveX(ctx context.Context) *IcoOutstandingRefund {
+	node, err := ioruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ioruo *IcoOutstandingRefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := ioruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ioruo *IcoOutstandingRefundUpdateOne) ExecX(ctx context.Context) {
+	if err := ioruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ioruo *IcoOutstandingRefundUpdateOne) defaults() {
+	if _, ok := ioruo.mutation.UpdatedAt(); !ok {
+		v := icooutstandingrefund.UpdateDefaultUpdatedAt()
+		ioruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ioruo *IcoOutstandingRefundUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IcoOutstandingRefundUpdateOne {
+	ioruo.modifiers = append(ioruo.modifiers, modifiers...)
+	return ioruo
+}
+
+func (ioruo *IcoOutstandingRefundUpdateOne) sqlSave(ctx context.Context) (_node *IcoOutstandingRefund, err error) {
+	_spec := sqlgraph.NewUpdateSpec(icooutstandingrefund.Table, icooutstandingrefund.Columns, sqlgraph.NewFieldSpec(icooutstandingrefund.FieldID, field.TypeString))
+	id, ok := ioruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IcoOutstandingRefund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ioruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, icooutstandingrefund.FieldID)
+		for _, f := range fields {
+			if !icooutstandingrefund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != icooutstandingrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ioruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ioruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ioruo.mutation.RoundID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldRoundID, field.TypeInt32, value)
+	}
+	if value, ok := ioruo.mutation.AddedRoundID(); ok {
+		_spec.AddField(icooutstandingrefund.FieldRoundID, field.TypeInt32, value)
+	}
+	if value, ok := ioruo.mutation.UserID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ioruo.mutation.SourceID(); ok {
+		_spec.SetField(icooutstandingrefund.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := ioruo.mutation.NumToken(); ok {
+		_spec.SetField(icooutstandingrefund.FieldNumToken, field.TypeString, value)
+	}
+	_spec.AddModifiers(ioruo.modifiers...)
+	_node = &IcoOutstandingRefund{config: ioruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ioruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{icooutstandingrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ioruo.mutation.done = true
+	return _node, nil
+}
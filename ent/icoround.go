@@ -36,8 +36,12 @@ type IcoRound struct {
 	StartAt *time.Time `json:"start_at,omitempty"`
 	// EndAt holds the value of the "end_at" field.
 	EndAt *time.Time `json:"end_at,omitempty"`
+	// Lifetime holds the value of the "lifetime" field.
+	Lifetime int32 `json:"lifetime,omitempty"`
 	// IsClose holds the value of the "is_close" field.
-	IsClose      bool `json:"is_close,omitempty"`
+	IsClose bool `json:"is_close,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt     *time.Time `json:"paused_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -48,11 +52,11 @@ func (*IcoRound) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case icoround.FieldIsClose:
 			values[i] = new(sql.NullBool)
-		case icoround.FieldRoundID, icoround.FieldSubRound:
+		case icoround.FieldRoundID, icoround.FieldSubRound, icoround.FieldLifetime:
 			values[i] = new(sql.NullInt64)
 		case icoround.FieldPrice, icoround.FieldNumToken, icoround.FieldBoughtToken:
 			values[i] = new(sql.NullString)
-		case icoround.FieldCreatedAt, icoround.FieldUpdatedAt, icoround.FieldStartAt, icoround.FieldEndAt:
+		case icoround.FieldCreatedAt, icoround.FieldUpdatedAt, icoround.FieldStartAt, icoround.FieldEndAt, icoround.FieldPausedAt:
 			values[i] = new(sql.NullTime)
 		case icoround.FieldID:
 			values[i] = new(xid.ID)
@@ -133,12 +137,25 @@ func (ir *IcoRound) assignValues(columns []string, values []any) error {
 				ir.EndAt = new(time.Time)
 				*ir.EndAt = value.Time
 			}
+		case icoround.FieldLifetime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lifetime", values[i])
+			} else if value.Valid {
+				ir.Lifetime = int32(value.Int64)
+			}
 		case icoround.FieldIsClose:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_close", values[i])
 			} else if value.Valid {
 				ir.IsClose = value.Bool
 			}
+		case icoround.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				ir.PausedAt = new(time.Time)
+				*ir.PausedAt = value.Time
+			}
 		default:
 			ir.selectValues.Set(columns[i], values[i])
 		}
@@ -206,8 +223,16 @@ func (ir *IcoRound) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("lifetime=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Lifetime))
+	builder.WriteString(", ")
 	builder.WriteString("is_close=")
 	builder.WriteString(fmt.Sprintf("%v", ir.IsClose))
+	builder.WriteString(", ")
+	if v := ir.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,8 +32,12 @@ const (
 	FieldStartAt = "start_at"
 	// FieldEndAt holds the string denoting the end_at field in the database.
 	FieldEndAt = "end_at"
+	// FieldLifetime holds the string denoting the lifetime field in the database.
+	FieldLifetime = "lifetime"
 	// FieldIsClose holds the string denoting the is_close field in the database.
 	FieldIsClose = "is_close"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
 	// Table holds the table name of the icoround in the database.
 	Table = "ico_rounds"
 )
@@ -50,7 +54,9 @@ var Columns = []string{
 	FieldBoughtToken,
 	FieldStartAt,
 	FieldEndAt,
+	FieldLifetime,
 	FieldIsClose,
+	FieldPausedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -70,6 +76,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultLifetime holds the default value on creation for the "lifetime" field.
+	DefaultLifetime int32
 	// DefaultIsClose holds the default value on creation for the "is_close" field.
 	DefaultIsClose bool
 	// DefaultID holds the default value on creation for the "id" field.
@@ -129,7 +137,17 @@ func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
 }
 
+// ByLifetime orders the results by the lifetime field.
+func ByLifetime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLifetime, opts...).ToFunc()
+}
+
 // ByIsClose orders the results by the is_close field.
 func ByIsClose(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsClose, opts...).ToFunc()
 }
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
@@ -100,11 +100,21 @@ func EndAt(v time.Time) predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldEQ(FieldEndAt, v))
 }
 
+// Lifetime applies equality check predicate on the "lifetime" field. It's identical to LifetimeEQ.
+func Lifetime(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldEQ(FieldLifetime, v))
+}
+
 // IsClose applies equality check predicate on the "is_close" field. It's identical to IsCloseEQ.
 func IsClose(v bool) predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldEQ(FieldIsClose, v))
 }
 
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldEQ(FieldPausedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldEQ(FieldCreatedAt, v))
@@ -560,6 +570,46 @@ func EndAtNotNil() predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldNotNull(FieldEndAt))
 }
 
+// LifetimeEQ applies the EQ predicate on the "lifetime" field.
+func LifetimeEQ(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldEQ(FieldLifetime, v))
+}
+
+// LifetimeNEQ applies the NEQ predicate on the "lifetime" field.
+func LifetimeNEQ(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldNEQ(FieldLifetime, v))
+}
+
+// LifetimeIn applies the In predicate on the "lifetime" field.
+func LifetimeIn(vs ...int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldIn(FieldLifetime, vs...))
+}
+
+// LifetimeNotIn applies the NotIn predicate on the "lifetime" field.
+func LifetimeNotIn(vs ...int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldNotIn(FieldLifetime, vs...))
+}
+
+// LifetimeGT applies the GT predicate on the "lifetime" field.
+func LifetimeGT(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldGT(FieldLifetime, v))
+}
+
+// LifetimeGTE applies the GTE predicate on the "lifetime" field.
+func LifetimeGTE(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldGTE(FieldLifetime, v))
+}
+
+// LifetimeLT applies the LT predicate on the "lifetime" field.
+func LifetimeLT(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldLT(FieldLifetime, v))
+}
+
+// LifetimeLTE applies the LTE predicate on the "lifetime" field.
+func LifetimeLTE(v int32) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldLTE(FieldLifetime, v))
+}
+
 // IsCloseEQ applies the EQ predicate on the "is_close" field.
 func IsCloseEQ(v bool) predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldEQ(FieldIsClose, v))
@@ -570,6 +620,56 @@ func IsCloseNEQ(v bool) predicate.IcoRound {
 	return predicate.IcoRound(sql.FieldNEQ(FieldIsClose, v))
 }
 
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.IcoRound {
+	return predicate.IcoRound(sql.FieldNotNull(FieldPausedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.IcoRound) predicate.IcoRound {
 	return predicate.IcoRound(sql.AndPredicates(predicates...))
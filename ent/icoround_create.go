@@ -110,6 +110,20 @@ func (irc *IcoRoundCreate) SetNillableEndAt(t *time.Time) *IcoRoundCreate {
 	return irc
 }
 
+// SetLifetime sets the "lifetime" field.
+func (irc *IcoRoundCreate) SetLifetime(i int32) *IcoRoundCreate {
+	irc.mutation.SetLifetime(i)
+	return irc
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (irc *IcoRoundCreate) SetNillableLifetime(i *int32) *IcoRoundCreate {
+	if i != nil {
+		irc.SetLifetime(*i)
+	}
+	return irc
+}
+
 // SetIsClose sets the "is_close" field.
 func (irc *IcoRoundCreate) SetIsClose(b bool) *IcoRoundCreate {
 	irc.mutation.SetIsClose(b)
@@ -124,6 +138,20 @@ func (irc *IcoRoundCreate) SetNillableIsClose(b *bool) *IcoRoundCreate {
 	return irc
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (irc *IcoRoundCreate) SetPausedAt(t time.Time) *IcoRoundCreate {
+	irc.mutation.SetPausedAt(t)
+	return irc
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (irc *IcoRoundCreate) SetNillablePausedAt(t *time.Time) *IcoRoundCreate {
+	if t != nil {
+		irc.SetPausedAt(*t)
+	}
+	return irc
+}
+
 // SetID sets the "id" field.
 func (irc *IcoRoundCreate) SetID(x xid.ID) *IcoRoundCreate {
 	irc.mutation.SetID(x)
@@ -181,6 +209,10 @@ func (irc *IcoRoundCreate) defaults() {
 		v := icoround.DefaultUpdatedAt()
 		irc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := irc.mutation.Lifetime(); !ok {
+		v := icoround.DefaultLifetime
+		irc.mutation.SetLifetime(v)
+	}
 	if _, ok := irc.mutation.IsClose(); !ok {
 		v := icoround.DefaultIsClose
 		irc.mutation.SetIsClose(v)
@@ -214,6 +246,9 @@ func (irc *IcoRoundCreate) check() error {
 	if _, ok := irc.mutation.BoughtToken(); !ok {
 		return &ValidationError{Name: "bought_token", err: errors.New(`ent: missing required field "IcoRound.bought_token"`)}
 	}
+	if _, ok := irc.mutation.Lifetime(); !ok {
+		return &ValidationError{Name: "lifetime", err: errors.New(`ent: missing required field "IcoRound.lifetime"`)}
+	}
 	if _, ok := irc.mutation.IsClose(); !ok {
 		return &ValidationError{Name: "is_close", err: errors.New(`ent: missing required field "IcoRound.is_close"`)}
 	}
@@ -289,10 +324,18 @@ func (irc *IcoRoundCreate) createSpec() (*IcoRound, *sqlgraph.CreateSpec) {
 		_spec.SetField(icoround.FieldEndAt, field.TypeTime, value)
 		_node.EndAt = &value
 	}
+	if value, ok := irc.mutation.Lifetime(); ok {
+		_spec.SetField(icoround.FieldLifetime, field.TypeInt32, value)
+		_node.Lifetime = value
+	}
 	if value, ok := irc.mutation.IsClose(); ok {
 		_spec.SetField(icoround.FieldIsClose, field.TypeBool, value)
 		_node.IsClose = value
 	}
+	if value, ok := irc.mutation.PausedAt(); ok {
+		_spec.SetField(icoround.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -465,6 +508,24 @@ func (u *IcoRoundUpsert) ClearEndAt() *IcoRoundUpsert {
 	return u
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoRoundUpsert) SetLifetime(v int32) *IcoRoundUpsert {
+	u.Set(icoround.FieldLifetime, v)
+	return u
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoRoundUpsert) UpdateLifetime() *IcoRoundUpsert {
+	u.SetExcluded(icoround.FieldLifetime)
+	return u
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoRoundUpsert) AddLifetime(v int32) *IcoRoundUpsert {
+	u.Add(icoround.FieldLifetime, v)
+	return u
+}
+
 // SetIsClose sets the "is_close" field.
 func (u *IcoRoundUpsert) SetIsClose(v bool) *IcoRoundUpsert {
 	u.Set(icoround.FieldIsClose, v)
@@ -477,6 +538,24 @@ func (u *IcoRoundUpsert) UpdateIsClose() *IcoRoundUpsert {
 	return u
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (u *IcoRoundUpsert) SetPausedAt(v time.Time) *IcoRoundUpsert {
+	u.Set(icoround.FieldPausedAt, v)
+	return u
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *IcoRoundUpsert) UpdatePausedAt() *IcoRoundUpsert {
+	u.SetExcluded(icoround.FieldPausedAt)
+	return u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *IcoRoundUpsert) ClearPausedAt() *IcoRoundUpsert {
+	u.SetNull(icoround.FieldPausedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -668,6 +747,27 @@ func (u *IcoRoundUpsertOne) ClearEndAt() *IcoRoundUpsertOne {
 	})
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoRoundUpsertOne) SetLifetime(v int32) *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.SetLifetime(v)
+	})
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoRoundUpsertOne) AddLifetime(v int32) *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.AddLifetime(v)
+	})
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoRoundUpsertOne) UpdateLifetime() *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.UpdateLifetime()
+	})
+}
+
 // SetIsClose sets the "is_close" field.
 func (u *IcoRoundUpsertOne) SetIsClose(v bool) *IcoRoundUpsertOne {
 	return u.Update(func(s *IcoRoundUpsert) {
@@ -682,6 +782,27 @@ func (u *IcoRoundUpsertOne) UpdateIsClose() *IcoRoundUpsertOne {
 	})
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (u *IcoRoundUpsertOne) SetPausedAt(v time.Time) *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *IcoRoundUpsertOne) UpdatePausedAt() *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *IcoRoundUpsertOne) ClearPausedAt() *IcoRoundUpsertOne {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoRoundUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1040,6 +1161,27 @@ func (u *IcoRoundUpsertBulk) ClearEndAt() *IcoRoundUpsertBulk {
 	})
 }
 
+// SetLifetime sets the "lifetime" field.
+func (u *IcoRoundUpsertBulk) SetLifetime(v int32) *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.SetLifetime(v)
+	})
+}
+
+// AddLifetime adds v to the "lifetime" field.
+func (u *IcoRoundUpsertBulk) AddLifetime(v int32) *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.AddLifetime(v)
+	})
+}
+
+// UpdateLifetime sets the "lifetime" field to the value that was provided on create.
+func (u *IcoRoundUpsertBulk) UpdateLifetime() *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.UpdateLifetime()
+	})
+}
+
 // SetIsClose sets the "is_close" field.
 func (u *IcoRoundUpsertBulk) SetIsClose(v bool) *IcoRoundUpsertBulk {
 	return u.Update(func(s *IcoRoundUpsert) {
@@ -1054,6 +1196,27 @@ func (u *IcoRoundUpsertBulk) UpdateIsClose() *IcoRoundUpsertBulk {
 	})
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (u *IcoRoundUpsertBulk) SetPausedAt(v time.Time) *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *IcoRoundUpsertBulk) UpdatePausedAt() *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *IcoRoundUpsertBulk) ClearPausedAt() *IcoRoundUpsertBulk {
+	return u.Update(func(s *IcoRoundUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *IcoRoundUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
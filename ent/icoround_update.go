@@ -159,6 +159,27 @@ func (iru *IcoRoundUpdate) ClearEndAt() *IcoRoundUpdate {
 	return iru
 }
 
+// SetLifetime sets the "lifetime" field.
+func (iru *IcoRoundUpdate) SetLifetime(i int32) *IcoRoundUpdate {
+	iru.mutation.ResetLifetime()
+	iru.mutation.SetLifetime(i)
+	return iru
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (iru *IcoRoundUpdate) SetNillableLifetime(i *int32) *IcoRoundUpdate {
+	if i != nil {
+		iru.SetLifetime(*i)
+	}
+	return iru
+}
+
+// AddLifetime adds i to the "lifetime" field.
+func (iru *IcoRoundUpdate) AddLifetime(i int32) *IcoRoundUpdate {
+	iru.mutation.AddLifetime(i)
+	return iru
+}
+
 // SetIsClose sets the "is_close" field.
 func (iru *IcoRoundUpdate) SetIsClose(b bool) *IcoRoundUpdate {
 	iru.mutation.SetIsClose(b)
@@ -173,6 +194,26 @@ func (iru *IcoRoundUpdate) SetNillableIsClose(b *bool) *IcoRoundUpdate {
 	return iru
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (iru *IcoRoundUpdate) SetPausedAt(t time.Time) *IcoRoundUpdate {
+	iru.mutation.SetPausedAt(t)
+	return iru
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (iru *IcoRoundUpdate) SetNillablePausedAt(t *time.Time) *IcoRoundUpdate {
+	if t != nil {
+		iru.SetPausedAt(*t)
+	}
+	return iru
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (iru *IcoRoundUpdate) ClearPausedAt() *IcoRoundUpdate {
+	iru.mutation.ClearPausedAt()
+	return iru
+}
+
 // Mutation returns the IcoRoundMutation object of the builder.
 func (iru *IcoRoundUpdate) Mutation() *IcoRoundMutation {
 	return iru.mutation
@@ -265,9 +306,21 @@ func (iru *IcoRoundUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iru.mutation.EndAtCleared() {
 		_spec.ClearField(icoround.FieldEndAt, field.TypeTime)
 	}
+	if value, ok := iru.mutation.Lifetime(); ok {
+		_spec.SetField(icoround.FieldLifetime, field.TypeInt32, value)
+	}
+	if value, ok := iru.mutation.AddedLifetime(); ok {
+		_spec.AddField(icoround.FieldLifetime, field.TypeInt32, value)
+	}
 	if value, ok := iru.mutation.IsClose(); ok {
 		_spec.SetField(icoround.FieldIsClose, field.TypeBool, value)
 	}
+	if value, ok := iru.mutation.PausedAt(); ok {
+		_spec.SetField(icoround.FieldPausedAt, field.TypeTime, value)
+	}
+	if iru.mutation.PausedAtCleared() {
+		_spec.ClearField(icoround.FieldPausedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(iru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -420,6 +473,27 @@ func (iruo *IcoRoundUpdateOne) ClearEndAt() *IcoRoundUpdateOne {
 	return iruo
 }
 
+// SetLifetime sets the "lifetime" field.
+func (iruo *IcoRoundUpdateOne) SetLifetime(i int32) *IcoRoundUpdateOne {
+	iruo.mutation.ResetLifetime()
+	iruo.mutation.SetLifetime(i)
+	return iruo
+}
+
+// SetNillableLifetime sets the "lifetime" field if the given value is not nil.
+func (iruo *IcoRoundUpdateOne) SetNillableLifetime(i *int32) *IcoRoundUpdateOne {
+	if i != nil {
+		iruo.SetLifetime(*i)
+	}
+	return iruo
+}
+
+// AddLifetime adds i to the "lifetime" field.
+func (iruo *IcoRoundUpdateOne) AddLifetime(i int32) *IcoRoundUpdateOne {
+	iruo.mutation.AddLifetime(i)
+	return iruo
+}
+
 // SetIsClose sets the "is_close" field.
 func (iruo *IcoRoundUpdateOne) SetIsClose(b bool) *IcoRoundUpdateOne {
 	iruo.mutation.SetIsClose(b)
@@ -434,6 +508,26 @@ func (iruo *IcoRoundUpdateOne) SetNillableIsClose(b *bool) *IcoRoundUpdateOne {
 	return iruo
 }
 
+// SetPausedAt sets the "paused_at" field.
+func (iruo *IcoRoundUpdateOne) SetPausedAt(t time.Time) *IcoRoundUpdateOne {
+	iruo.mutation.SetPausedAt(t)
+	return iruo
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (iruo *IcoRoundUpdateOne) SetNillablePausedAt(t *time.Time) *IcoRoundUpdateOne {
+	if t != nil {
+		iruo.SetPausedAt(*t)
+	}
+	return iruo
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (iruo *IcoRoundUpdateOne) ClearPausedAt() *IcoRoundUpdateOne {
+	iruo.mutation.ClearPausedAt()
+	return iruo
+}
+
 // Mutation returns the IcoRoundMutation object of the builder.
 func (iruo *IcoRoundUpdateOne) Mutation() *IcoRoundMutation {
 	return iruo.mutation
@@ -556,9 +650,21 @@ func (iruo *IcoRoundUpdateOne) sqlSave(ctx context.Context) (_node *IcoRound, er
 	if iruo.mutation.EndAtCleared() {
 		_spec.ClearField(icoround.FieldEndAt, field.TypeTime)
 	}
+	if value, ok := iruo.mutation.Lifetime(); ok {
+		_spec.SetField(icoround.FieldLifetime, field.TypeInt32, value)
+	}
+	if value, ok := iruo.mutation.AddedLifetime(); ok {
+		_spec.AddField(icoround.FieldLifetime, field.TypeInt32, value)
+	}
 	if value, ok := iruo.mutation.IsClose(); ok {
 		_spec.SetField(icoround.FieldIsClose, field.TypeBool, value)
 	}
+	if value, ok := iruo.mutation.PausedAt(); ok {
+		_spec.SetField(icoround.FieldPausedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.PausedAtCleared() {
+		_spec.ClearField(icoround.FieldPausedAt, field.TypeTime)
+	}
 	_spec.AddModifiers(iruo.modifiers...)
 	_node = &IcoRound{config: iruo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/rs/xid"
+)
+
+// LeaderboardSnapshot is the model entity for the LeaderboardSnapshot schema.
+type LeaderboardSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TakenAt holds the value of the "taken_at" field.
+	TakenAt time.Time `json:"taken_at,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank int `json:"rank,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// NumToken holds the value of the "num_token" field.
+	NumToken     string `json:"num_token,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaderboardSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaderboardsnapshot.FieldRank:
+			values[i] = new(sql.NullInt64)
+		case leaderboardsnapshot.FieldUserID, leaderboardsnapshot.FieldNumToken:
+			values[i] = new(sql.NullString)
+		case leaderboardsnapshot.FieldCreatedAt, leaderboardsnapshot.FieldUpdatedAt, leaderboardsnapshot.FieldTakenAt:
+			values[i] = new(sql.NullTime)
+		case leaderboardsnapshot.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaderboardSnapshot fields.
+func (ls *LeaderboardSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaderboardsnapshot.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ls.ID = *value
+			}
+		case leaderboardsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ls.CreatedAt = value.Time
+			}
+		case leaderboardsnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ls.UpdatedAt = value.Time
+			}
+		case leaderboardsnapshot.FieldTakenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field taken_at", values[i])
+			} else if value.Valid {
+				ls.TakenAt = value.Time
+			}
+		case leaderboardsnapshot.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				ls.Rank = int(value.Int64)
+			}
+		case leaderboardsnapshot.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ls.UserID = value.String
+			}
+		case leaderboardsnapshot.FieldNumToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field num_token", values[i])
+			} else if value.Valid {
+				ls.NumToken = value.String
+			}
+		default:
+			ls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaderboardSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (ls *LeaderboardSnapshot) Value(name string) (ent.Value, error) {
+	return ls.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LeaderboardSnapshot.
+// Note that you need to call LeaderboardSnapshot.Unwrap() before calling this method if this LeaderboardSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LeaderboardSnapshot) Update() *LeaderboardSnapshotUpdateOne {
+	return NewLeaderboardSnapshotClient(ls.config).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LeaderboardSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LeaderboardSnapshot) Unwrap() *LeaderboardSnapshot {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaderboardSnapshot is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LeaderboardSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaderboardSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ls.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ls.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("taken_at=")
+	builder.WriteString(ls.TakenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(fmt.Sprintf("%v", ls.Rank))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ls.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("num_token=")
+	builder.WriteString(ls.NumToken)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaderboardSnapshots is a parsable slice of LeaderboardSnapshot.
+type LeaderboardSnapshots []*LeaderboardSnapshot
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaderboardsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TakenAt applies equality check predicate on the "taken_at" field. It's identical to TakenAtEQ.
+func TakenAt(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldTakenAt, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldRank, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// NumToken applies equality check predicate on the "num_token" field. It's identical to NumTokenEQ.
+func NumToken(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldNumToken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TakenAtEQ applies the EQ predicate on the "taken_at" field.
+func TakenAtEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldTakenAt, v))
+}
+
+// TakenAtNEQ applies the NEQ predicate on the "taken_at" field.
+func TakenAtNEQ(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldTakenAt, v))
+}
+
+// TakenAtIn applies the In predicate on the "taken_at" field.
+func TakenAtIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldTakenAt, vs...))
+}
+
+// TakenAtNotIn applies the NotIn predicate on the "taken_at" field.
+func TakenAtNotIn(vs ...time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldTakenAt, vs...))
+}
+
+// TakenAtGT applies the GT predicate on the "taken_at" field.
+func TakenAtGT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldTakenAt, v))
+}
+
+// TakenAtGTE applies the GTE predicate on the "taken_at" field.
+func TakenAtGTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldTakenAt, v))
+}
+
+// TakenAtLT applies the LT predicate on the "taken_at" field.
+func TakenAtLT(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldTakenAt, v))
+}
+
+// TakenAtLTE applies the LTE predicate on the "taken_at" field.
+func TakenAtLTE(v time.Time) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldTakenAt, v))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldRank, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// NumTokenEQ applies the EQ predicate on the "num_token" field.
+func NumTokenEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldNumToken, v))
+}
+
+// NumTokenNEQ applies the NEQ predicate on the "num_token" field.
+func NumTokenNEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldNumToken, v))
+}
+
+// NumTokenIn applies the In predicate on the "num_token" field.
+func NumTokenIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldNumToken, vs...))
+}
+
+// NumTokenNotIn applies the NotIn predicate on the "num_token" field.
+func NumTokenNotIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldNumToken, vs...))
+}
+
+// NumTokenGT applies the GT predicate on the "num_token" field.
+func NumTokenGT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldNumToken, v))
+}
+
+// NumTokenGTE applies the GTE predicate on the "num_token" field.
+func NumTokenGTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldNumToken, v))
+}
+
+// NumTokenLT applies the LT predicate on the "num_token" field.
+func NumTokenLT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldNumToken, v))
+}
+
+// NumTokenLTE applies the LTE predicate on the "num_token" field.
+func NumTokenLTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldNumToken, v))
+}
+
+// NumTokenContains applies the Contains predicate on the "num_token" field.
+func NumTokenContains(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContains(FieldNumToken, v))
+}
+
+// NumTokenHasPrefix applies the HasPrefix predicate on the "num_token" field.
+func NumTokenHasPrefix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasPrefix(FieldNumToken, v))
+}
+
+// NumTokenHasSuffix applies the HasSuffix predicate on the "num_token" field.
+func NumTokenHasSuffix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasSuffix(FieldNumToken, v))
+}
+
+// NumTokenEqualFold applies the EqualFold predicate on the "num_token" field.
+func NumTokenEqualFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEqualFold(FieldNumToken, v))
+}
+
+// NumTokenContainsFold applies the ContainsFold predicate on the "num_token" field.
+func NumTokenContainsFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContainsFold(FieldNumToken, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.NotPredicates(p))
+}
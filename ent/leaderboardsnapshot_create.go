@@ -0,0 +1,784 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/rs/xid"
+)
+
+// LeaderboardSnapshotCreate is the builder for creating a LeaderboardSnapshot entity.
+type LeaderboardSnapshotCreate struct {
+	config
+	mutation *LeaderboardSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lsc *LeaderboardSnapshotCreate) SetCreatedAt(t time.Time) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetCreatedAt(t)
+	return lsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lsc *LeaderboardSnapshotCreate) SetNillableCreatedAt(t *time.Time) *LeaderboardSnapshotCreate {
+	if t != nil {
+		lsc.SetCreatedAt(*t)
+	}
+	return lsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsc *LeaderboardSnapshotCreate) SetUpdatedAt(t time.Time) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetUpdatedAt(t)
+	return lsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lsc *LeaderboardSnapshotCreate) SetNillableUpdatedAt(t *time.Time) *LeaderboardSnapshotCreate {
+	if t != nil {
+		lsc.SetUpdatedAt(*t)
+	}
+	return lsc
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (lsc *LeaderboardSnapshotCreate) SetTakenAt(t time.Time) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetTakenAt(t)
+	return lsc
+}
+
+// SetRank sets the "rank" field.
+func (lsc *LeaderboardSnapshotCreate) SetRank(i int) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetRank(i)
+	return lsc
+}
+
+// SetUserID sets the "user_id" field.
+func (lsc *LeaderboardSnapshotCreate) SetUserID(s string) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetUserID(s)
+	return lsc
+}
+
+// SetNumToken sets the "num_token" field.
+func (lsc *LeaderboardSnapshotCreate) SetNumToken(s string) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetNumToken(s)
+	return lsc
+}
+
+// SetID sets the "id" field.
+func (lsc *LeaderboardSnapshotCreate) SetID(x xid.ID) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetID(x)
+	return lsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lsc *LeaderboardSnapshotCreate) SetNillableID(x *xid.ID) *LeaderboardSnapshotCreate {
+	if x != nil {
+		lsc.SetID(*x)
+	}
+	return lsc
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsc *LeaderboardSnapshotCreate) Mutation() *LeaderboardSnapshotMutation {
+	return lsc.mutation
+}
+
+// Save creates the LeaderboardSnapshot in the database.
+func (lsc *LeaderboardSnapshotCreate) Save(ctx context.Context) (*LeaderboardSnapshot, error) {
+	lsc.defaults()
+	return withHooks(ctx, lsc.sqlSave, lsc.mutation, lsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lsc *LeaderboardSnapshotCreate) SaveX(ctx context.Context) *LeaderboardSnapshot {
+	v, err := lsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lsc *LeaderboardSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := lsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsc *LeaderboardSnapshotCreate) ExecX(ctx context.Context) {
+	if err := lsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsc *LeaderboardSnapshotCreate) defaults() {
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		v := leaderboardsnapshot.DefaultCreatedAt()
+		lsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lsc.mutation.UpdatedAt(); !ok {
+		v := leaderboardsnapshot.DefaultUpdatedAt()
+		lsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lsc.mutation.ID(); !ok {
+		v := leaderboardsnapshot.DefaultID()
+		lsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsc *LeaderboardSnapshotCreate) check() error {
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaderboardSnapshot.created_at"`)}
+	}
+	if _, ok := lsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LeaderboardSnapshot.updated_at"`)}
+	}
+	if _, ok := lsc.mutation.TakenAt(); !ok {
+		return &ValidationError{Name: "taken_at", err: errors.New(`ent: missing required field "LeaderboardSnapshot.taken_at"`)}
+	}
+	if _, ok := lsc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "LeaderboardSnapshot.rank"`)}
+	}
+	if _, ok := lsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeaderboardSnapshot.user_id"`)}
+	}
+	if _, ok := lsc.mutation.NumToken(); !ok {
+		return &ValidationError{Name: "num_token", err: errors.New(`ent: missing required field "LeaderboardSnapshot.num_token"`)}
+	}
+	return nil
+}
+
+func (lsc *LeaderboardSnapshotCreate) sqlSave(ctx context.Context) (*LeaderboardSnapshot, error) {
+	if err := lsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lsc.mutation.id = &_node.ID
+	lsc.mutation.done = true
+	return _node, nil
+}
+
+func (lsc *LeaderboardSnapshotCreate) createSpec() (*LeaderboardSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaderboardSnapshot{config: lsc.config}
+		_spec = sqlgraph.NewCreateSpec(leaderboardsnapshot.Table, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = lsc.conflict
+	if id, ok := lsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lsc.mutation.CreatedAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lsc.mutation.TakenAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldTakenAt, field.TypeTime, value)
+		_node.TakenAt = value
+	}
+	if value, ok := lsc.mutation.Rank(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldRank, field.TypeInt, value)
+		_node.Rank = value
+	}
+	if value, ok := lsc.mutation.UserID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := lsc.mutation.NumToken(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldNumToken, field.TypeString, value)
+		_node.NumToken = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaderboardSnapshot.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (lsc *LeaderboardSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *LeaderboardSnapshotUpsertOne {
+	lsc.conflict = opts
+	return &LeaderboardSnapshotUpsertOne{
+		create: lsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lsc *LeaderboardSnapshotCreate) OnConflictColumns(columns ...string) *LeaderboardSnapshotUpsertOne {
+	lsc.conflict = append(lsc.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardSnapshotUpsertOne{
+		create: lsc,
+	}
+}
+
+type (
+	// LeaderboardSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one LeaderboardSnapshot node.
+	LeaderboardSnapshotUpsertOne struct {
+		create *LeaderboardSnapshotCreate
+	}
+
+	// LeaderboardSnapshotUpsert is the "OnConflict" setter.
+	LeaderboardSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardSnapshotUpsert) SetUpdatedAt(v time.Time) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateUpdatedAt() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldUpdatedAt)
+	return u
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *LeaderboardSnapshotUpsert) SetTakenAt(v time.Time) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldTakenAt, v)
+	return u
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateTakenAt() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldTakenAt)
+	return u
+}
+
+// SetRank sets the "rank" field.
+func (u *LeaderboardSnapshotUpsert) SetRank(v int) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldRank, v)
+	return u
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateRank() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldRank)
+	return u
+}
+
+// AddRank adds v to the "rank" field.
+func (u *LeaderboardSnapshotUpsert) AddRank(v int) *LeaderboardSnapshotUpsert {
+	u.Add(leaderboardsnapshot.FieldRank, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaderboardSnapshotUpsert) SetUserID(v string) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateUserID() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldUserID)
+	return u
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *LeaderboardSnapshotUpsert) SetNumToken(v string) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldNumToken, v)
+	return u
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateNumToken() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldNumToken)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboardsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertOne) UpdateNewValues() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaderboardsnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(leaderboardsnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaderboardSnapshotUpsertOne) Ignore() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardSnapshotUpsertOne) DoNothing() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *LeaderboardSnapshotUpsertOne) Update(set func(*LeaderboardSnapshotUpsert)) *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardSnapshotUpsertOne) SetUpdatedAt(v time.Time) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateUpdatedAt() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *LeaderboardSnapshotUpsertOne) SetTakenAt(v time.Time) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetTakenAt(v)
+	})
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateTakenAt() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateTakenAt()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *LeaderboardSnapshotUpsertOne) SetRank(v int) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// AddRank adds v to the "rank" field.
+func (u *LeaderboardSnapshotUpsertOne) AddRank(v int) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateRank() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaderboardSnapshotUpsertOne) SetUserID(v string) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateUserID() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *LeaderboardSnapshotUpsertOne) SetNumToken(v string) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateNumToken() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaderboardSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaderboardSnapshotUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeaderboardSnapshotUpsertOne.ID is not supported by MySQL driver. Use LeaderboardSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaderboardSnapshotCreateBulk is the builder for creating many LeaderboardSnapshot entities in bulk.
+type LeaderboardSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*LeaderboardSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeaderboardSnapshot entities in the database.
+func (lscb *LeaderboardSnapshotCreateBulk) Save(ctx context.Context) ([]*LeaderboardSnapshot, error) {
+	if lscb.err != nil {
+		return nil, lscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lscb.builders))
+	nodes := make([]*LeaderboardSnapshot, len(lscb.builders))
+	mutators := make([]Mutator, len(lscb.builders))
+	for i := range lscb.builders {
+		func(i int, root context.Context) {
+			builder := lscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaderboardSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lscb *LeaderboardSnapshotCreateBulk) SaveX(ctx context.Context) []*LeaderboardSnapshot {
+	v, err := lscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lscb *LeaderboardSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := lscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lscb *LeaderboardSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := lscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaderboardSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (lscb *LeaderboardSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaderboardSnapshotUpsertBulk {
+	lscb.conflict = opts
+	return &LeaderboardSnapshotUpsertBulk{
+		create: lscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lscb *LeaderboardSnapshotCreateBulk) OnConflictColumns(columns ...string) *LeaderboardSnapshotUpsertBulk {
+	lscb.conflict = append(lscb.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardSnapshotUpsertBulk{
+		create: lscb,
+	}
+}
+
+// LeaderboardSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeaderboardSnapshot nodes.
+type LeaderboardSnapshotUpsertBulk struct {
+	create *LeaderboardSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboardsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertBulk) UpdateNewValues() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leaderboardsnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(leaderboardsnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertBulk) Ignore() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardSnapshotUpsertBulk) DoNothing() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaderboardSnapshotUpsertBulk) Update(set func(*LeaderboardSnapshotUpsert)) *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetUpdatedAt(v time.Time) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateUpdatedAt() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetTakenAt(v time.Time) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetTakenAt(v)
+	})
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateTakenAt() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateTakenAt()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetRank(v int) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// AddRank adds v to the "rank" field.
+func (u *LeaderboardSnapshotUpsertBulk) AddRank(v int) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateRank() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetUserID(v string) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateUserID() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetNumToken sets the "num_token" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetNumToken(v string) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetNumToken(v)
+	})
+}
+
+// UpdateNumToken sets the "num_token" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateNumToken() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateNumToken()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeaderboardSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaderboardSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// LeaderboardSnapshotDelete is the builder for deleting a LeaderboardSnapshot entity.
+type LeaderboardSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *LeaderboardSnapshotMutation
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotDelete builder.
+func (lsd *LeaderboardSnapshotDelete) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotDelete {
+	lsd.mutation.Where(ps...)
+	return lsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lsd *LeaderboardSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lsd.sqlExec, lsd.mutation, lsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsd *LeaderboardSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := lsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lsd *LeaderboardSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(leaderboardsnapshot.Table, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeString))
+	if ps := lsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lsd.mutation.done = true
+	return affected, err
+}
+
+// LeaderboardSnapshotDeleteOne is the builder for deleting a single LeaderboardSnapshot entity.
+type LeaderboardSnapshotDeleteOne struct {
+	lsd *LeaderboardSnapshotDelete
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotDelete builder.
+func (lsdo *LeaderboardSnapshotDeleteOne) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotDeleteOne {
+	lsdo.lsd.mutation.Where(ps...)
+	return lsdo
+}
+
+// Exec executes the deletion query.
+func (lsdo *LeaderboardSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := lsdo.lsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{leaderboardsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsdo *LeaderboardSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := lsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
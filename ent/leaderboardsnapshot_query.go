@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// LeaderboardSnapshotQuery is the builder for querying LeaderboardSnapshot entities.
+type LeaderboardSnapshotQuery struct {
+	config
+	ctx        *QueryContext
+	order      []leaderboardsnapshot.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LeaderboardSnapshot
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaderboardSnapshotQuery builder.
+func (lsq *LeaderboardSnapshotQuery) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotQuery {
+	lsq.predicates = append(lsq.predicates, ps...)
+	return lsq
+}
+
+// Limit the number of records to be returned by this query.
+func (lsq *LeaderboardSnapshotQuery) Limit(limit int) *LeaderboardSnapshotQuery {
+	lsq.ctx.Limit = &limit
+	return lsq
+}
+
+// Offset to start from.
+func (lsq *LeaderboardSnapshotQuery) Offset(offset int) *LeaderboardSnapshotQuery {
+	lsq.ctx.Offset = &offset
+	return lsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lsq *LeaderboardSnapshotQuery) Unique(unique bool) *LeaderboardSnapshotQuery {
+	lsq.ctx.Unique = &unique
+	return lsq
+}
+
+// Order specifies how the records should be ordered.
+func (lsq *LeaderboardSnapshotQuery) Order(o ...leaderboardsnapshot.OrderOption) *LeaderboardSnapshotQuery {
+	lsq.order = append(lsq.order, o...)
+	return lsq
+}
+
+// First returns the first LeaderboardSnapshot entity from the query.
+// Returns a *NotFoundError when no LeaderboardSnapshot was found.
+func (lsq *LeaderboardSnapshotQuery) First(ctx context.Context) (*LeaderboardSnapshot, error) {
+	nodes, err := lsq.Limit(1).All(setContextOp(ctx, lsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{leaderboardsnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) FirstX(ctx context.Context) *LeaderboardSnapshot {
+	node, err := lsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LeaderboardSnapshot ID from the query.
+// Returns a *NotFoundError when no LeaderboardSnapshot ID was found.
+func (lsq *LeaderboardSnapshotQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = lsq.Limit(1).IDs(setContextOp(ctx, lsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{leaderboardsnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := lsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LeaderboardSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LeaderboardSnapshot entity is found.
+// Returns a *NotFoundError when no LeaderboardSnapshot entities are found.
+func (lsq *LeaderboardSnapshotQuery) Only(ctx context.Context) (*LeaderboardSnapshot, error) {
+	nodes, err := lsq.Limit(2).All(setContextOp(ctx, lsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{leaderboardsnapshot.Label}
+	default:
+		return nil, &NotSingularError{leaderboardsnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) OnlyX(ctx context.Context) *LeaderboardSnapshot {
+	node, err := lsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LeaderboardSnapshot ID in the query.
+// Returns a *NotSingularError when more than one LeaderboardSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lsq *LeaderboardSnapshotQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = lsq.Limit(2).IDs(setContextOp(ctx, lsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{leaderboardsnapshot.Label}
+	default:
+		err = &NotSingularError{leaderboardsnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := lsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LeaderboardSnapshots.
+func (lsq *LeaderboardSnapshotQuery) All(ctx context.Context) ([]*LeaderboardSnapshot, error) {
+	ctx = setContextOp(ctx, lsq.ctx, "All")
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LeaderboardSnapshot, *LeaderboardSnapshotQuery]()
+	return withInterceptors[[]*LeaderboardSnapshot](ctx, lsq, qr, lsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) AllX(ctx context.Context) []*LeaderboardSnapshot {
+	nodes, err := lsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LeaderboardSnapshot IDs.
+func (lsq *LeaderboardSnapshotQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if lsq.ctx.Unique == nil && lsq.path != nil {
+		lsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lsq.ctx, "IDs")
+	if err = lsq.Select(leaderboardsnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := lsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lsq *LeaderboardSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lsq.ctx, "Count")
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lsq, querierCount[*LeaderboardSnapshotQuery](), lsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := lsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lsq *LeaderboardSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lsq.ctx, "Exist")
+	switch _, err := lsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lsq *LeaderboardSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := lsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaderboardSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lsq *LeaderboardSnapshotQuery) Clone() *LeaderboardSnapshotQuery {
+	if lsq == nil {
+		return nil
+	}
+	return &LeaderboardSnapshotQuery{
+		config:     lsq.config,
+		ctx:        lsq.ctx.Clone(),
+		order:      append([]leaderboardsnapshot.OrderOption{}, lsq.order...),
+		inters:     append([]Interceptor{}, lsq.inters...),
+		predicates: append([]predicate.LeaderboardSnapshot{}, lsq.predicates...),
+		// clone intermediate query.
+		sql:  lsq.sql.Clone(),
+		path: lsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LeaderboardSnapshot.Query().
+//		GroupBy(leaderboardsnapshot.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lsq *LeaderboardSnapshotQuery) GroupBy(field string, fields ...string) *LeaderboardSnapshotGroupBy {
+	lsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LeaderboardSnapshotGroupBy{build: lsq}
+	grbuild.flds = &lsq.ctx.Fields
+	grbuild.label = leaderboardsnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LeaderboardSnapshot.Query().
+//		Select(leaderboardsnapshot.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (lsq *LeaderboardSnapshotQuery) Select(fields ...string) *LeaderboardSnapshotSelect {
+	lsq.ctx.Fields = append(lsq.ctx.Fields, fields...)
+	sbuild := &LeaderboardSnapshotSelect{LeaderboardSnapshotQuery: lsq}
+	sbuild.label = leaderboardsnapshot.Label
+	sbuild.flds, sbuild.scan = &lsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LeaderboardSnapshotSelect configured with the given aggregations.
+func (lsq *LeaderboardSnapshotQuery) Aggregate(fns ...AggregateFunc) *LeaderboardSnapshotSelect {
+	return lsq.Select().Aggregate(fns...)
+}
+
+func (lsq *LeaderboardSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lsq.ctx.Fields {
+		if !leaderboardsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lsq.path != nil {
+		prev, err := lsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lsq.sql = prev
+	}
+	return nil
+}
+
+func (lsq *LeaderboardSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LeaderboardSnapshot, error) {
+	var (
+		nodes = []*LeaderboardSnapshot{}
+		_spec = lsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LeaderboardSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LeaderboardSnapshot{config: lsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(lsq.modifiers) > 0 {
+		_spec.Modifiers = lsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lsq *LeaderboardSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lsq.querySpec()
+	if len(lsq.modifiers) > 0 {
+		_spec.Modifiers = lsq.modifiers
+	}
+	_spec.Node.Columns = lsq.ctx.Fields
+	if len(lsq.ctx.Fields) > 0 {
+		_spec.Unique = lsq.ctx.Unique != nil && *lsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lsq.driver, _spec)
+}
+
+func (lsq *LeaderboardSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(leaderboardsnapshot.Table, leaderboardsnapshot.Columns, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeString))
+	_spec.From = lsq.sql
+	if unique := lsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaderboardsnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != leaderboardsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lsq *LeaderboardSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lsq.driver.Dialect())
+	t1 := builder.Table(leaderboardsnapshot.Table)
+	columns := lsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = leaderboardsnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lsq.sql != nil {
+		selector = lsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lsq.ctx.Unique != nil && *lsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range lsq.modifiers {
+		m(selector)
+	}
+	for _, p := range lsq.predicates {
+		p(selector)
+	}
+	for _, p := range lsq.order {
+		p(selector)
+	}
+	if offset := lsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lsq *LeaderboardSnapshotQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaderboardSnapshotSelect {
+	lsq.modifiers = append(lsq.modifiers, modifiers...)
+	return lsq.Select()
+}
+
+// LeaderboardSnapshotGroupBy is the group-by builder for LeaderboardSnapshot entities.
+type LeaderboardSnapshotGroupBy struct {
+	selector
+	build *LeaderboardSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lsgb *LeaderboardSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *LeaderboardSnapshotGroupBy {
+	lsgb.fns = append(lsgb.fns, fns...)
+	return lsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lsgb *LeaderboardSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lsgb.build.ctx, "GroupBy")
+	if err := lsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaderboardSnapshotQuery, *LeaderboardSnapshotGroupBy](ctx, lsgb.build, lsgb, lsgb.build.inters, v)
+}
+
+func (lsgb *LeaderboardSnapshotGroupBy) sqlScan(ctx context.Context, root *LeaderboardSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lsgb.fns))
+	for _, fn := range lsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lsgb.flds)+len(lsgb.fns))
+		for _, f := range *lsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LeaderboardSnapshotSelect is the builder for selecting fields of LeaderboardSnapshot entities.
+type LeaderboardSnapshotSelect struct {
+	*LeaderboardSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lss *LeaderboardSnapshotSelect) Aggregate(fns ...AggregateFunc) *LeaderboardSnapshotSelect {
+	lss.fns = append(lss.fns, fns...)
+	return lss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lss *LeaderboardSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lss.ctx, "Select")
+	if err := lss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LeaderboardSnapshotQuery, *LeaderboardSnapshotSelect](ctx, lss.LeaderboardSnapshotQuery, lss, lss.inters, v)
+}
+
+func (lss *LeaderboardSnapshotSelect) sqlScan(ctx context.Context, root *LeaderboardSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lss.fns))
+	for _, fn := range lss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lss *LeaderboardSnapshotSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaderboardSnapshotSelect {
+	lss.modifiers = append(lss.modifiers, modifiers...)
+	return lss
+}
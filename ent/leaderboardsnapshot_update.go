@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/predicate"
+)
+
+// LeaderboardSnapshotUpdate is the builder for updating LeaderboardSnapshot entities.
+type LeaderboardSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaderboardSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotUpdate builder.
+func (lsu *LeaderboardSnapshotUpdate) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotUpdate {
+	lsu.mutation.Where(ps...)
+	return lsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsu *LeaderboardSnapshotUpdate) SetUpdatedAt(t time.Time) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetUpdatedAt(t)
+	return lsu
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (lsu *LeaderboardSnapshotUpdate) SetTakenAt(t time.Time) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetTakenAt(t)
+	return lsu
+}
+
+// SetNillableTakenAt sets the "taken_at" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillableTakenAt(t *time.Time) *LeaderboardSnapshotUpdate {
+	if t != nil {
+		lsu.SetTakenAt(*t)
+	}
+	return lsu
+}
+
+// SetRank sets the "rank" field.
+func (lsu *LeaderboardSnapshotUpdate) SetRank(i int) *LeaderboardSnapshotUpdate {
+	lsu.mutation.ResetRank()
+	lsu.mutation.SetRank(i)
+	return lsu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillableRank(i *int) *LeaderboardSnapshotUpdate {
+	if i != nil {
+		lsu.SetRank(*i)
+	}
+	return lsu
+}
+
+// AddRank adds i to the "rank" field.
+func (lsu *LeaderboardSnapshotUpdate) AddRank(i int) *LeaderboardSnapshotUpdate {
+	lsu.mutation.AddRank(i)
+	return lsu
+}
+
+// SetUserID sets the "user_id" field.
+func (lsu *LeaderboardSnapshotUpdate) SetUserID(s string) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetUserID(s)
+	return lsu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillableUserID(s *string) *LeaderboardSnapshotUpdate {
+	if s != nil {
+		lsu.SetUserID(*s)
+	}
+	return lsu
+}
+
+// SetNumToken sets the "num_token" field.
+func (lsu *LeaderboardSnapshotUpdate) SetNumToken(s string) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetNumToken(s)
+	return lsu
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillableNumToken(s *string) *LeaderboardSnapshotUpdate {
+	if s != nil {
+		lsu.SetNumToken(*s)
+	}
+	return lsu
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsu *LeaderboardSnapshotUpdate) Mutation() *LeaderboardSnapshotMutation {
+	return lsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lsu *LeaderboardSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	lsu.defaults()
+	return withHooks(ctx, lsu.sqlSave, lsu.mutation, lsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsu *LeaderboardSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := lsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lsu *LeaderboardSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := lsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsu *LeaderboardSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := lsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsu *LeaderboardSnapshotUpdate) defaults() {
+	if _, ok := lsu.mutation.UpdatedAt(); !ok {
+		v := leaderboardsnapshot.UpdateDefaultUpdatedAt()
+		lsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lsu *LeaderboardSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardSnapshotUpdate {
+	lsu.modifiers = append(lsu.modifiers, modifiers...)
+	return lsu
+}
+
+func (lsu *LeaderboardSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboardsnapshot.Table, leaderboardsnapshot.Columns, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeString))
+	if ps := lsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lsu.mutation.TakenAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldTakenAt, field.TypeTime, value)
+	}
+	if value, ok := lsu.mutation.Rank(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := lsu.mutation.AddedRank(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := lsu.mutation.UserID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.NumToken(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldNumToken, field.TypeString, value)
+	}
+	_spec.AddModifiers(lsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboardsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lsu.mutation.done = true
+	return n, nil
+}
+
+// LeaderboardSnapshotUpdateOne is the builder for updating a single LeaderboardSnapshot entity.
+type LeaderboardSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaderboardSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetUpdatedAt(t time.Time) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetUpdatedAt(t)
+	return lsuo
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetTakenAt(t time.Time) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetTakenAt(t)
+	return lsuo
+}
+
+// SetNillableTakenAt sets the "taken_at" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillableTakenAt(t *time.Time) *LeaderboardSnapshotUpdateOne {
+	if t != nil {
+		lsuo.SetTakenAt(*t)
+	}
+	return lsuo
+}
+
+// SetRank sets the "rank" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetRank(i int) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.ResetRank()
+	lsuo.mutation.SetRank(i)
+	return lsuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillableRank(i *int) *LeaderboardSnapshotUpdateOne {
+	if i != nil {
+		lsuo.SetRank(*i)
+	}
+	return lsuo
+}
+
+// AddRank adds i to the "rank" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) AddRank(i int) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.AddRank(i)
+	return lsuo
+}
+
+// SetUserID sets the "user_id" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetUserID(s string) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetUserID(s)
+	return lsuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillableUserID(s *string) *LeaderboardSnapshotUpdateOne {
+	if s != nil {
+		lsuo.SetUserID(*s)
+	}
+	return lsuo
+}
+
+// SetNumToken sets the "num_token" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNumToken(s string) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetNumToken(s)
+	return lsuo
+}
+
+// SetNillableNumToken sets the "num_token" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillableNumToken(s *string) *LeaderboardSnapshotUpdateOne {
+	if s != nil {
+		lsuo.SetNumToken(*s)
+	}
+	return lsuo
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsuo *LeaderboardSnapshotUpdateOne) Mutation() *LeaderboardSnapshotMutation {
+	return lsuo.mutation
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotUpdate builder.
+func (lsuo *LeaderboardSnapshotUpdateOne) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.Where(ps...)
+	return lsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lsuo *LeaderboardSnapshotUpdateOne) Select(field string, fields ...string) *LeaderboardSnapshotUpdateOne {
+	lsuo.fields = append([]string{field}, fields...)
+	return lsuo
+}
+
+// Save executes the query and returns the updated LeaderboardSnapshot entity.
+func (lsuo *LeaderboardSnapshotUpdateOne) Save(ctx context.Context) (*LeaderboardSnapshot, error) {
+	lsuo.defaults()
+	return withHooks(ctx, lsuo.sqlSave, lsuo.mutation, lsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsuo *LeaderboardSnapshotUpdateOne) SaveX(ctx context.Context) *LeaderboardSnapshot {
+	node, err := lsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lsuo *LeaderboardSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := lsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsuo *LeaderboardSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := lsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsuo *LeaderboardSnapshotUpdateOne) defaults() {
+	if _, ok := lsuo.mutation.UpdatedAt(); !ok {
+		v := leaderboardsnapshot.UpdateDefaultUpdatedAt()
+		lsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lsuo *LeaderboardSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardSnapshotUpdateOne {
+	lsuo.modifiers = append(lsuo.modifiers, modifiers...)
+	return lsuo
+}
+
+func (lsuo *LeaderboardSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *LeaderboardSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboardsnapshot.Table, leaderboardsnapshot.Columns, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeString))
+	id, ok := lsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaderboardSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaderboardsnapshot.FieldID)
+		for _, f := range fields {
+			if !leaderboardsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leaderboardsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lsuo.mutation.TakenAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldTakenAt, field.TypeTime, value)
+	}
+	if value, ok := lsuo.mutation.Rank(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := lsuo.mutation.AddedRank(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := lsuo.mutation.UserID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.NumToken(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldNumToken, field.TypeString, value)
+	}
+	_spec.AddModifiers(lsuo.modifiers...)
+	_node = &LeaderboardSnapshot{config: lsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboardsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,8 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "owner" character varying NOT NULL, "url" character varying NOT NULL, "secret" character varying NOT NULL, "trans_types" jsonb NULL, "user_ids" jsonb NULL, "deleted_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "webhook_owner" to table: "webhooks"
+CREATE INDEX "webhook_owner" ON "webhooks" ("owner");
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "webhook_id" character varying NOT NULL, "event_id" character varying NOT NULL, "event_type" character varying NOT NULL, "payload" text NOT NULL, "status" character varying NOT NULL, "attempts" integer NOT NULL DEFAULT 0, "response_code" integer NOT NULL DEFAULT 0, "last_error" text NULL, "delivered_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "webhookdelivery_webhook_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_webhook_id_event_id" ON "webhook_deliveries" ("webhook_id", "event_id");
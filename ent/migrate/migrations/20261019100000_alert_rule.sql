@@ -0,0 +1,4 @@
+-- Create "alert_rules" table
+CREATE TABLE "alert_rules" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" character varying NOT NULL, "type" character varying NOT NULL, "symbol" character varying NOT NULL, "threshold" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "alertrule_user_id_type_symbol" to table: "alert_rules"
+CREATE UNIQUE INDEX "alertrule_user_id_type_symbol" ON "alert_rules" ("user_id", "type", "symbol");
@@ -0,0 +1,10 @@
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "actor" character varying NOT NULL, "service" character varying NOT NULL, "roles" jsonb NULL, "rpc" character varying NOT NULL, "target_user_id" character varying NULL, "payload_hash" character varying NULL, "before" text NULL, "after" text NULL, "outcome" character varying NOT NULL, "error" text NULL, PRIMARY KEY ("id"));
+-- Create index "auditlog_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_created_at" ON "audit_logs" ("created_at");
+-- Create index "auditlog_actor_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_actor_created_at" ON "audit_logs" ("actor", "created_at");
+-- Create index "auditlog_rpc_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_rpc_created_at" ON "audit_logs" ("rpc", "created_at");
+-- Create index "auditlog_target_user_id_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_target_user_id_created_at" ON "audit_logs" ("target_user_id", "created_at");
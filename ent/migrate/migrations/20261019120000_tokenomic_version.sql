@@ -0,0 +1,4 @@
+-- Create "tokenomic_versions" table
+CREATE TABLE "tokenomic_versions" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "version" character varying NOT NULL, "checksum" character varying NOT NULL, "definition" text NOT NULL, "changes" text NULL, PRIMARY KEY ("id"));
+-- Create index "tokenomic_versions_version_key" to table: "tokenomic_versions"
+CREATE UNIQUE INDEX "tokenomic_versions_version_key" ON "tokenomic_versions" ("version");
@@ -0,0 +1,4 @@
+-- Modify "icos" table
+ALTER TABLE "icos" ADD COLUMN "lifetime" integer NOT NULL DEFAULT 0;
+-- Modify "ico_rounds" table
+ALTER TABLE "ico_rounds" ADD COLUMN "lifetime" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "ico_rounds" table
+ALTER TABLE "ico_rounds" ADD COLUMN "paused_at" timestamptz NULL;
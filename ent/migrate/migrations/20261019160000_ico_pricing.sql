@@ -0,0 +1,2 @@
+-- Modify "icos" table
+ALTER TABLE "icos" ADD COLUMN "pricing" text NULL;
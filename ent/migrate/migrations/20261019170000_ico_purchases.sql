@@ -0,0 +1,6 @@
+-- Modify "ico_histories" table
+ALTER TABLE "ico_histories" ADD COLUMN "source_id" character varying NULL, ADD COLUMN "symbol" character varying NULL, ADD COLUMN "amount" character varying NULL;
+-- Create index "icohistory_user_id_created_at" to table: "ico_histories"
+CREATE INDEX "icohistory_user_id_created_at" ON "ico_histories" ("user_id", "created_at");
+-- Create index "transaction_source_id" to table: "transactions"
+CREATE INDEX "transaction_source_id" ON "transactions" ("source_id");
@@ -0,0 +1,4 @@
+-- Create "leaderboard_snapshots" table
+CREATE TABLE "leaderboard_snapshots" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "taken_at" timestamptz NOT NULL, "rank" bigint NOT NULL, "user_id" character varying NOT NULL, "num_token" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "leaderboardsnapshot_taken_at_rank" to table: "leaderboard_snapshots"
+CREATE UNIQUE INDEX "leaderboardsnapshot_taken_at_rank" ON "leaderboard_snapshots" ("taken_at", "rank");
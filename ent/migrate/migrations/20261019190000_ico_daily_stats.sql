@@ -0,0 +1,4 @@
+-- Create "ico_daily_stats" table
+CREATE TABLE "ico_daily_stats" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "day" timestamptz NOT NULL, "purchases" bigint NOT NULL, "buyers" bigint NOT NULL, "num_token" character varying NOT NULL, "raised" character varying NOT NULL, "coupon_purchases" bigint NOT NULL, "coupon_buyers" bigint NOT NULL, "coupon_num_token" character varying NOT NULL, "coupon_raised" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "icodailystat_day" to table: "ico_daily_stats"
+CREATE UNIQUE INDEX "icodailystat_day" ON "ico_daily_stats" ("day");
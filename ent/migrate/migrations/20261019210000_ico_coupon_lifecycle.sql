@@ -0,0 +1,6 @@
+-- Modify "ico_coupons" table
+ALTER TABLE "ico_coupons" ADD COLUMN "starts_at" timestamptz NULL, ADD COLUMN "ends_at" timestamptz NULL, ADD COLUMN "max_uses" bigint NOT NULL DEFAULT 0, ADD COLUMN "used" bigint NOT NULL DEFAULT 0, ADD COLUMN "single_use" boolean NOT NULL DEFAULT false, ADD COLUMN "min_purchase" character varying NULL;
+-- Create "ico_coupon_redemptions" table
+CREATE TABLE "ico_coupon_redemptions" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "coupon_id" character varying NOT NULL, "coupon" character varying NOT NULL, "user_id" character varying NOT NULL, "source_id" character varying NOT NULL, "amount" character varying NOT NULL, "symbol" character varying NOT NULL, "reward" character varying NOT NULL, "cashback" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "icocouponredemption_coupon_id_user_id" to table: "ico_coupon_redemptions"
+CREATE INDEX "icocouponredemption_coupon_id_user_id" ON "ico_coupon_redemptions" ("coupon_id", "user_id");
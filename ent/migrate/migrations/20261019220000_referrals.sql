@@ -0,0 +1,10 @@
+-- Create "referrals" table
+CREATE TABLE "referrals" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" character varying NOT NULL, "referrer_id" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "referral_user_id" to table: "referrals"
+CREATE UNIQUE INDEX "referral_user_id" ON "referrals" ("user_id");
+-- Create index "referral_referrer_id" to table: "referrals"
+CREATE INDEX "referral_referrer_id" ON "referrals" ("referrer_id");
+-- Create "referral_commissions" table
+CREATE TABLE "referral_commissions" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" character varying NOT NULL, "level" bigint NOT NULL, "buyer_id" character varying NOT NULL, "coupon" character varying NOT NULL, "source_id" character varying NOT NULL, "amount" character varying NOT NULL, "symbol" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "referralcommission_user_id" to table: "referral_commissions"
+CREATE INDEX "referralcommission_user_id" ON "referral_commissions" ("user_id");
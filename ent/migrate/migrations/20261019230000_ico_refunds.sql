@@ -0,0 +1,6 @@
+-- Create index "icohistory_source_id" to table: "ico_histories"
+CREATE INDEX "icohistory_source_id" ON "ico_histories" ("source_id");
+-- Create index "icocouponredemption_source_id" to table: "ico_coupon_redemptions"
+CREATE INDEX "icocouponredemption_source_id" ON "ico_coupon_redemptions" ("source_id");
+-- Create index "referralcommission_source_id" to table: "referral_commissions"
+CREATE INDEX "referralcommission_source_id" ON "referral_commissions" ("source_id");
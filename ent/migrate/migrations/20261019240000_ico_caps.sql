@@ -0,0 +1,4 @@
+-- Modify "icos" table
+ALTER TABLE "icos" ADD COLUMN "soft_cap" character varying NULL, ADD COLUMN "hard_cap" character varying NULL, ADD COLUMN "status" character varying NULL;
+-- Create index "icohistory_round_id" to table: "ico_histories"
+CREATE INDEX "icohistory_round_id" ON "ico_histories" ("round_id");
@@ -0,0 +1,9 @@
+-- Create the "SYS_ICO_REWARD" and "SYS_ICO_PROCEEDS" wallets of the purchase symbols
+INSERT INTO "user_wallets" ("id", "created_at", "updated_at", "user_id", "type", "symbol", "is_active", "balance") VALUES
+  ('dbb70bvh7ojp184dpchg', now(), now(), 'SYS_ICO_REWARD', 'SYSTEM', 'VND', true, 0),
+  ('dbb70bvh7ojp184dpci0', now(), now(), 'SYS_ICO_REWARD', 'SYSTEM', 'USD', true, 0),
+  ('dbb70bvh7ojp184dpcig', now(), now(), 'SYS_ICO_REWARD', 'SYSTEM', 'USDT', true, 0),
+  ('dbb70bvh7ojp184dpcj0', now(), now(), 'SYS_ICO_PROCEEDS', 'SYSTEM', 'VND', true, 0),
+  ('dbb70bvh7ojp184dpcjg', now(), now(), 'SYS_ICO_PROCEEDS', 'SYSTEM', 'USD', true, 0),
+  ('dbb70bvh7ojp184dpck0', now(), now(), 'SYS_ICO_PROCEEDS', 'SYSTEM', 'USDT', true, 0)
+ON CONFLICT ("user_id", "symbol", "type") DO NOTHING;
@@ -0,0 +1,6 @@
+-- Create "ico_outstanding_refunds" table
+CREATE TABLE "ico_outstanding_refunds" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "round_id" integer NOT NULL, "user_id" character varying NOT NULL, "source_id" character varying NOT NULL, "num_token" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "ico_outstanding_refunds_source_id_key" to table: "ico_outstanding_refunds"
+CREATE UNIQUE INDEX "ico_outstanding_refunds_source_id_key" ON "ico_outstanding_refunds" ("source_id");
+-- Create index "icooutstandingrefund_round_id" to table: "ico_outstanding_refunds"
+CREATE INDEX "icooutstandingrefund_round_id" ON "ico_outstanding_refunds" ("round_id");
@@ -8,6 +8,72 @@ import (
 )
 
 var (
+	// AlertRulesColumns holds the columns for the "alert_rules" table.
+	AlertRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "symbol", Type: field.TypeString},
+		{Name: "threshold", Type: field.TypeString},
+	}
+	// AlertRulesTable holds the schema information for the "alert_rules" table.
+	AlertRulesTable = &schema.Table{
+		Name:       "alert_rules",
+		Columns:    AlertRulesColumns,
+		PrimaryKey: []*schema.Column{AlertRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alertrule_user_id_type_symbol",
+				Unique:  true,
+				Columns: []*schema.Column{AlertRulesColumns[3], AlertRulesColumns[4], AlertRulesColumns[5]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "service", Type: field.TypeString},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "rpc", Type: field.TypeString},
+		{Name: "target_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "payload_hash", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "after", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "outcome", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_rpc_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_target_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6], AuditLogsColumns[1]},
+			},
+		},
+	}
 	// CurrencyRatesColumns holds the columns for the "currency_rates" table.
 	CurrencyRatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -41,7 +107,14 @@ var (
 		{Name: "num_token", Type: field.TypeString},
 		{Name: "num_sub", Type: field.TypeInt32},
 		{Name: "price_gap", Type: field.TypeString},
+		{Name: "lifetime", Type: field.TypeInt32, Default: 0},
 		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "limits", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "pricing", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "unsold", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "soft_cap", Type: field.TypeString, Nullable: true},
+		{Name: "hard_cap", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
 	}
 	// IcosTable holds the schema information for the "icos" table.
 	IcosTable = &schema.Table{
@@ -58,6 +131,12 @@ var (
 		{Name: "coupon", Type: field.TypeString},
 		{Name: "reward", Type: field.TypeString},
 		{Name: "cashback", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "used", Type: field.TypeInt, Default: 0},
+		{Name: "single_use", Type: field.TypeBool, Default: false},
+		{Name: "min_purchase", Type: field.TypeString, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// IcoCouponsTable holds the schema information for the "ico_coupons" table.
@@ -73,6 +152,66 @@ var (
 			},
 		},
 	}
+	// IcoCouponRedemptionsColumns holds the columns for the "ico_coupon_redemptions" table.
+	IcoCouponRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "coupon_id", Type: field.TypeString},
+		{Name: "coupon", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "symbol", Type: field.TypeString},
+		{Name: "reward", Type: field.TypeString},
+		{Name: "cashback", Type: field.TypeString},
+	}
+	// IcoCouponRedemptionsTable holds the schema information for the "ico_coupon_redemptions" table.
+	IcoCouponRedemptionsTable = &schema.Table{
+		Name:       "ico_coupon_redemptions",
+		Columns:    IcoCouponRedemptionsColumns,
+		PrimaryKey: []*schema.Column{IcoCouponRedemptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "icocouponredemption_coupon_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{IcoCouponRedemptionsColumns[3], IcoCouponRedemptionsColumns[5]},
+			},
+			{
+				Name:    "icocouponredemption_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{IcoCouponRedemptionsColumns[6]},
+			},
+		},
+	}
+	// IcoDailyStatsColumns holds the columns for the "ico_daily_stats" table.
+	IcoDailyStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "purchases", Type: field.TypeInt},
+		{Name: "buyers", Type: field.TypeInt},
+		{Name: "num_token", Type: field.TypeString},
+		{Name: "raised", Type: field.TypeString},
+		{Name: "coupon_purchases", Type: field.TypeInt},
+		{Name: "coupon_buyers", Type: field.TypeInt},
+		{Name: "coupon_num_token", Type: field.TypeString},
+		{Name: "coupon_raised", Type: field.TypeString},
+	}
+	// IcoDailyStatsTable holds the schema information for the "ico_daily_stats" table.
+	IcoDailyStatsTable = &schema.Table{
+		Name:       "ico_daily_stats",
+		Columns:    IcoDailyStatsColumns,
+		PrimaryKey: []*schema.Column{IcoDailyStatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "icodailystat_day",
+				Unique:  true,
+				Columns: []*schema.Column{IcoDailyStatsColumns[3]},
+			},
+		},
+	}
 	// IcoHistoriesColumns holds the columns for the "ico_histories" table.
 	IcoHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -84,12 +223,55 @@ var (
 		{Name: "num_token", Type: field.TypeString},
 		{Name: "sub_round", Type: field.TypeInt32},
 		{Name: "type", Type: field.TypeString, Nullable: true},
+		{Name: "source_id", Type: field.TypeString, Nullable: true},
+		{Name: "symbol", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeString, Nullable: true},
 	}
 	// IcoHistoriesTable holds the schema information for the "ico_histories" table.
 	IcoHistoriesTable = &schema.Table{
 		Name:       "ico_histories",
 		Columns:    IcoHistoriesColumns,
 		PrimaryKey: []*schema.Column{IcoHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "icohistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IcoHistoriesColumns[4], IcoHistoriesColumns[1]},
+			},
+			{
+				Name:    "icohistory_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{IcoHistoriesColumns[9]},
+			},
+			{
+				Name:    "icohistory_round_id",
+				Unique:  false,
+				Columns: []*schema.Column{IcoHistoriesColumns[3]},
+			},
+		},
+	}
+	// IcoOutstandingRefundsColumns holds the columns for the "ico_outstanding_refunds" table.
+	IcoOutstandingRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "round_id", Type: field.TypeInt32},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString, Unique: true},
+		{Name: "num_token", Type: field.TypeString},
+	}
+	// IcoOutstandingRefundsTable holds the schema information for the "ico_outstanding_refunds" table.
+	IcoOutstandingRefundsTable = &schema.Table{
+		Name:       "ico_outstanding_refunds",
+		Columns:    IcoOutstandingRefundsColumns,
+		PrimaryKey: []*schema.Column{IcoOutstandingRefundsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "icooutstandingrefund_round_id",
+				Unique:  false,
+				Columns: []*schema.Column{IcoOutstandingRefundsColumns[3]},
+			},
+		},
 	}
 	// IcoRoundsColumns holds the columns for the "ico_rounds" table.
 	IcoRoundsColumns = []*schema.Column{
@@ -103,7 +285,9 @@ var (
 		{Name: "bought_token", Type: field.TypeString},
 		{Name: "start_at", Type: field.TypeTime, Nullable: true},
 		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lifetime", Type: field.TypeInt32, Default: 0},
 		{Name: "is_close", Type: field.TypeBool, Default: false},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
 	}
 	// IcoRoundsTable holds the schema information for the "ico_rounds" table.
 	IcoRoundsTable = &schema.Table{
@@ -111,6 +295,101 @@ var (
 		Columns:    IcoRoundsColumns,
 		PrimaryKey: []*schema.Column{IcoRoundsColumns[0]},
 	}
+	// LeaderboardSnapshotsColumns holds the columns for the "leaderboard_snapshots" table.
+	LeaderboardSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "taken_at", Type: field.TypeTime},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "num_token", Type: field.TypeString},
+	}
+	// LeaderboardSnapshotsTable holds the schema information for the "leaderboard_snapshots" table.
+	LeaderboardSnapshotsTable = &schema.Table{
+		Name:       "leaderboard_snapshots",
+		Columns:    LeaderboardSnapshotsColumns,
+		PrimaryKey: []*schema.Column{LeaderboardSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leaderboardsnapshot_taken_at_rank",
+				Unique:  true,
+				Columns: []*schema.Column{LeaderboardSnapshotsColumns[3], LeaderboardSnapshotsColumns[4]},
+			},
+		},
+	}
+	// ReferralsColumns holds the columns for the "referrals" table.
+	ReferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "referrer_id", Type: field.TypeString},
+	}
+	// ReferralsTable holds the schema information for the "referrals" table.
+	ReferralsTable = &schema.Table{
+		Name:       "referrals",
+		Columns:    ReferralsColumns,
+		PrimaryKey: []*schema.Column{ReferralsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referral_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReferralsColumns[3]},
+			},
+			{
+				Name:    "referral_referrer_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[4]},
+			},
+		},
+	}
+	// ReferralCommissionsColumns holds the columns for the "referral_commissions" table.
+	ReferralCommissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "buyer_id", Type: field.TypeString},
+		{Name: "coupon", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "symbol", Type: field.TypeString},
+	}
+	// ReferralCommissionsTable holds the schema information for the "referral_commissions" table.
+	ReferralCommissionsTable = &schema.Table{
+		Name:       "referral_commissions",
+		Columns:    ReferralCommissionsColumns,
+		PrimaryKey: []*schema.Column{ReferralCommissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referralcommission_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralCommissionsColumns[3]},
+			},
+			{
+				Name:    "referralcommission_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralCommissionsColumns[7]},
+			},
+		},
+	}
+	// TokenomicVersionsColumns holds the columns for the "tokenomic_versions" table.
+	TokenomicVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeString, Unique: true},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "definition", Type: field.TypeString, Size: 2147483647},
+		{Name: "changes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// TokenomicVersionsTable holds the schema information for the "tokenomic_versions" table.
+	TokenomicVersionsTable = &schema.Table{
+		Name:       "tokenomic_versions",
+		Columns:    TokenomicVersionsColumns,
+		PrimaryKey: []*schema.Column{TokenomicVersionsColumns[0]},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -133,6 +412,13 @@ var (
 		Name:       "transactions",
 		Columns:    TransactionsColumns,
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transaction_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[12]},
+			},
+		},
 	}
 	// UserWalletsColumns holds the columns for the "user_wallets" table.
 	UserWalletsColumns = []*schema.Column{
@@ -158,15 +444,79 @@ var (
 			},
 		},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "trans_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_owner",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[3]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt32, Default: 0},
+		{Name: "response_code", Type: field.TypeInt32, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AlertRulesTable,
+		AuditLogsTable,
 		CurrencyRatesTable,
 		IcosTable,
 		IcoCouponsTable,
+		IcoCouponRedemptionsTable,
+		IcoDailyStatsTable,
 		IcoHistoriesTable,
+		IcoOutstandingRefundsTable,
 		IcoRoundsTable,
+		LeaderboardSnapshotsTable,
+		ReferralsTable,
+		ReferralCommissionsTable,
+		TokenomicVersionsTable,
 		TransactionsTable,
 		UserWalletsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
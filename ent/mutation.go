@@ -11,14 +11,25 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/ent/auditlog"
 	"github.com/indikay/wallet-service/ent/currencyrate"
 	"github.com/indikay/wallet-service/ent/ico"
 	"github.com/indikay/wallet-service/ent/icocoupon"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/icodailystat"
 	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
 	"github.com/indikay/wallet-service/ent/icoround"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
 	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/referral"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
 	"github.com/indikay/wallet-service/ent/transaction"
 	"github.com/indikay/wallet-service/ent/userwallet"
+	"github.com/indikay/wallet-service/ent/webhook"
+	"github.com/indikay/wallet-service/ent/webhookdelivery"
 	"github.com/rs/xid"
 )
 
@@ -31,43 +42,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCurrencyRate = "CurrencyRate"
-	TypeIco          = "Ico"
-	TypeIcoCoupon    = "IcoCoupon"
-	TypeIcoHistory   = "IcoHistory"
-	TypeIcoRound     = "IcoRound"
-	TypeTransaction  = "Transaction"
-	TypeUserWallet   = "UserWallet"
+	TypeAlertRule            = "AlertRule"
+	TypeAuditLog             = "AuditLog"
+	TypeCurrencyRate         = "CurrencyRate"
+	TypeIco                  = "Ico"
+	TypeIcoCoupon            = "IcoCoupon"
+	TypeIcoCouponRedemption  = "IcoCouponRedemption"
+	TypeIcoDailyStat         = "IcoDailyStat"
+	TypeIcoHistory           = "IcoHistory"
+	TypeIcoOutstandingRefund = "IcoOutstandingRefund"
+	TypeIcoRound             = "IcoRound"
+	TypeLeaderboardSnapshot  = "LeaderboardSnapshot"
+	TypeReferral             = "Referral"
+	TypeReferralCommission   = "ReferralCommission"
+	TypeTokenomicVersion     = "TokenomicVersion"
+	TypeTransaction          = "Transaction"
+	TypeUserWallet           = "UserWallet"
+	TypeWebhook              = "Webhook"
+	TypeWebhookDelivery      = "WebhookDelivery"
 )
 
-// CurrencyRateMutation represents an operation that mutates the CurrencyRate nodes in the graph.
-type CurrencyRateMutation struct {
+// AlertRuleMutation represents an operation that mutates the AlertRule nodes in the graph.
+type AlertRuleMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
+	id            *xid.ID
 	created_at    *time.Time
 	updated_at    *time.Time
+	user_id       *string
+	_type         *string
 	symbol        *string
-	rate          *string
-	expired_at    *time.Time
+	threshold     *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*CurrencyRate, error)
-	predicates    []predicate.CurrencyRate
+	oldValue      func(context.Context) (*AlertRule, error)
+	predicates    []predicate.AlertRule
 }
 
-var _ ent.Mutation = (*CurrencyRateMutation)(nil)
+var _ ent.Mutation = (*AlertRuleMutation)(nil)
 
-// currencyrateOption allows management of the mutation configuration using functional options.
-type currencyrateOption func(*CurrencyRateMutation)
+// alertruleOption allows management of the mutation configuration using functional options.
+type alertruleOption func(*AlertRuleMutation)
 
-// newCurrencyRateMutation creates new mutation for the CurrencyRate entity.
-func newCurrencyRateMutation(c config, op Op, opts ...currencyrateOption) *CurrencyRateMutation {
-	m := &CurrencyRateMutation{
+// newAlertRuleMutation creates new mutation for the AlertRule entity.
+func newAlertRuleMutation(c config, op Op, opts ...alertruleOption) *AlertRuleMutation {
+	m := &AlertRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCurrencyRate,
+		typ:           TypeAlertRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -76,20 +99,20 @@ func newCurrencyRateMutation(c config, op Op, opts ...currencyrateOption) *Curre
 	return m
 }
 
-// withCurrencyRateID sets the ID field of the mutation.
-func withCurrencyRateID(id int) currencyrateOption {
-	return func(m *CurrencyRateMutation) {
+// withAlertRuleID sets the ID field of the mutation.
+func withAlertRuleID(id xid.ID) alertruleOption {
+	return func(m *AlertRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CurrencyRate
+			value *AlertRule
 		)
-		m.oldValue = func(ctx context.Context) (*CurrencyRate, error) {
+		m.oldValue = func(ctx context.Context) (*AlertRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CurrencyRate.Get(ctx, id)
+					value, err = m.Client().AlertRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -98,10 +121,10 @@ func withCurrencyRateID(id int) currencyrateOption {
 	}
 }
 
-// withCurrencyRate sets the old CurrencyRate of the mutation.
-func withCurrencyRate(node *CurrencyRate) currencyrateOption {
-	return func(m *CurrencyRateMutation) {
-		m.oldValue = func(context.Context) (*CurrencyRate, error) {
+// withAlertRule sets the old AlertRule of the mutation.
+func withAlertRule(node *AlertRule) alertruleOption {
+	return func(m *AlertRuleMutation) {
+		m.oldValue = func(context.Context) (*AlertRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -110,7 +133,7 @@ func withCurrencyRate(node *CurrencyRate) currencyrateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CurrencyRateMutation) Client() *Client {
+func (m AlertRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -118,7 +141,7 @@ func (m CurrencyRateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CurrencyRateMutation) Tx() (*Tx, error) {
+func (m AlertRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -127,9 +150,15 @@ func (m CurrencyRateMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AlertRule entities.
+func (m *AlertRuleMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CurrencyRateMutation) ID() (id int, exists bool) {
+func (m *AlertRuleMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -140,28 +169,28 @@ func (m *CurrencyRateMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CurrencyRateMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AlertRuleMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []xid.ID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CurrencyRate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AlertRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CurrencyRateMutation) SetCreatedAt(t time.Time) {
+func (m *AlertRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CurrencyRateMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AlertRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -169,10 +198,10 @@ func (m *CurrencyRateMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CurrencyRate entity.
-// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CurrencyRateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AlertRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -187,17 +216,17 @@ func (m *CurrencyRateMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CurrencyRateMutation) ResetCreatedAt() {
+func (m *AlertRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CurrencyRateMutation) SetUpdatedAt(t time.Time) {
+func (m *AlertRuleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CurrencyRateMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AlertRuleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -205,10 +234,10 @@ func (m *CurrencyRateMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CurrencyRate entity.
-// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CurrencyRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AlertRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -223,140 +252,163 @@ func (m *CurrencyRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CurrencyRateMutation) ResetUpdatedAt() {
+func (m *AlertRuleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *CurrencyRateMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetUserID sets the "user_id" field.
+func (m *AlertRuleMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *CurrencyRateMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AlertRuleMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the CurrencyRate entity.
-// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CurrencyRateMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *AlertRuleMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.UserID, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *CurrencyRateMutation) ResetSymbol() {
-	m.symbol = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AlertRuleMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetRate sets the "rate" field.
-func (m *CurrencyRateMutation) SetRate(s string) {
-	m.rate = &s
+// SetType sets the "type" field.
+func (m *AlertRuleMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Rate returns the value of the "rate" field in the mutation.
-func (m *CurrencyRateMutation) Rate() (r string, exists bool) {
-	v := m.rate
+// GetType returns the value of the "type" field in the mutation.
+func (m *AlertRuleMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRate returns the old "rate" field's value of the CurrencyRate entity.
-// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CurrencyRateMutation) OldRate(ctx context.Context) (v string, err error) {
+func (m *AlertRuleMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRate requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Rate, nil
+	return oldValue.Type, nil
 }
 
-// ResetRate resets all changes to the "rate" field.
-func (m *CurrencyRateMutation) ResetRate() {
-	m.rate = nil
+// ResetType resets all changes to the "type" field.
+func (m *AlertRuleMutation) ResetType() {
+	m._type = nil
 }
 
-// SetExpiredAt sets the "expired_at" field.
-func (m *CurrencyRateMutation) SetExpiredAt(t time.Time) {
-	m.expired_at = &t
+// SetSymbol sets the "symbol" field.
+func (m *AlertRuleMutation) SetSymbol(s string) {
+	m.symbol = &s
 }
 
-// ExpiredAt returns the value of the "expired_at" field in the mutation.
-func (m *CurrencyRateMutation) ExpiredAt() (r time.Time, exists bool) {
-	v := m.expired_at
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *AlertRuleMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiredAt returns the old "expired_at" field's value of the CurrencyRate entity.
-// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CurrencyRateMutation) OldExpiredAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AlertRuleMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
 	}
-	return oldValue.ExpiredAt, nil
+	return oldValue.Symbol, nil
 }
 
-// ClearExpiredAt clears the value of the "expired_at" field.
-func (m *CurrencyRateMutation) ClearExpiredAt() {
-	m.expired_at = nil
-	m.clearedFields[currencyrate.FieldExpiredAt] = struct{}{}
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *AlertRuleMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// ExpiredAtCleared returns if the "expired_at" field was cleared in this mutation.
-func (m *CurrencyRateMutation) ExpiredAtCleared() bool {
-	_, ok := m.clearedFields[currencyrate.FieldExpiredAt]
-	return ok
+// SetThreshold sets the "threshold" field.
+func (m *AlertRuleMutation) SetThreshold(s string) {
+	m.threshold = &s
 }
 
-// ResetExpiredAt resets all changes to the "expired_at" field.
-func (m *CurrencyRateMutation) ResetExpiredAt() {
-	m.expired_at = nil
-	delete(m.clearedFields, currencyrate.FieldExpiredAt)
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *AlertRuleMutation) Threshold() (r string, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the CurrencyRateMutation builder.
-func (m *CurrencyRateMutation) Where(ps ...predicate.CurrencyRate) {
+// OldThreshold returns the old "threshold" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldThreshold(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *AlertRuleMutation) ResetThreshold() {
+	m.threshold = nil
+}
+
+// Where appends a list predicates to the AlertRuleMutation builder.
+func (m *AlertRuleMutation) Where(ps ...predicate.AlertRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CurrencyRateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AlertRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CurrencyRateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CurrencyRate, len(ps))
+func (m *AlertRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AlertRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -364,39 +416,42 @@ func (m *CurrencyRateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CurrencyRateMutation) Op() Op {
+func (m *AlertRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CurrencyRateMutation) SetOp(op Op) {
+func (m *AlertRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CurrencyRate).
-func (m *CurrencyRateMutation) Type() string {
+// Type returns the node type of this mutation (AlertRule).
+func (m *AlertRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CurrencyRateMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *AlertRuleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, currencyrate.FieldCreatedAt)
+		fields = append(fields, alertrule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, currencyrate.FieldUpdatedAt)
+		fields = append(fields, alertrule.FieldUpdatedAt)
 	}
-	if m.symbol != nil {
-		fields = append(fields, currencyrate.FieldSymbol)
+	if m.user_id != nil {
+		fields = append(fields, alertrule.FieldUserID)
 	}
-	if m.rate != nil {
-		fields = append(fields, currencyrate.FieldRate)
+	if m._type != nil {
+		fields = append(fields, alertrule.FieldType)
 	}
-	if m.expired_at != nil {
-		fields = append(fields, currencyrate.FieldExpiredAt)
+	if m.symbol != nil {
+		fields = append(fields, alertrule.FieldSymbol)
+	}
+	if m.threshold != nil {
+		fields = append(fields, alertrule.FieldThreshold)
 	}
 	return fields
 }
@@ -404,18 +459,20 @@ func (m *CurrencyRateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CurrencyRateMutation) Field(name string) (ent.Value, bool) {
+func (m *AlertRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case currencyrate.FieldCreatedAt:
+	case alertrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case currencyrate.FieldUpdatedAt:
+	case alertrule.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case currencyrate.FieldSymbol:
+	case alertrule.FieldUserID:
+		return m.UserID()
+	case alertrule.FieldType:
+		return m.GetType()
+	case alertrule.FieldSymbol:
 		return m.Symbol()
-	case currencyrate.FieldRate:
-		return m.Rate()
-	case currencyrate.FieldExpiredAt:
-		return m.ExpiredAt()
+	case alertrule.FieldThreshold:
+		return m.Threshold()
 	}
 	return nil, false
 }
@@ -423,221 +480,225 @@ func (m *CurrencyRateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CurrencyRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AlertRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case currencyrate.FieldCreatedAt:
+	case alertrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case currencyrate.FieldUpdatedAt:
+	case alertrule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case currencyrate.FieldSymbol:
+	case alertrule.FieldUserID:
+		return m.OldUserID(ctx)
+	case alertrule.FieldType:
+		return m.OldType(ctx)
+	case alertrule.FieldSymbol:
 		return m.OldSymbol(ctx)
-	case currencyrate.FieldRate:
-		return m.OldRate(ctx)
-	case currencyrate.FieldExpiredAt:
-		return m.OldExpiredAt(ctx)
+	case alertrule.FieldThreshold:
+		return m.OldThreshold(ctx)
 	}
-	return nil, fmt.Errorf("unknown CurrencyRate field %s", name)
+	return nil, fmt.Errorf("unknown AlertRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CurrencyRateMutation) SetField(name string, value ent.Value) error {
+func (m *AlertRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case currencyrate.FieldCreatedAt:
+	case alertrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case currencyrate.FieldUpdatedAt:
+	case alertrule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case currencyrate.FieldSymbol:
+	case alertrule.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
+		m.SetUserID(v)
 		return nil
-	case currencyrate.FieldRate:
+	case alertrule.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRate(v)
+		m.SetType(v)
 		return nil
-	case currencyrate.FieldExpiredAt:
-		v, ok := value.(time.Time)
+	case alertrule.FieldSymbol:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiredAt(v)
+		m.SetSymbol(v)
+		return nil
+	case alertrule.FieldThreshold:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CurrencyRate field %s", name)
+	return fmt.Errorf("unknown AlertRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CurrencyRateMutation) AddedFields() []string {
+func (m *AlertRuleMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CurrencyRateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AlertRuleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CurrencyRateMutation) AddField(name string, value ent.Value) error {
+func (m *AlertRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CurrencyRate numeric field %s", name)
+	return fmt.Errorf("unknown AlertRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CurrencyRateMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(currencyrate.FieldExpiredAt) {
-		fields = append(fields, currencyrate.FieldExpiredAt)
-	}
-	return fields
+func (m *AlertRuleMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CurrencyRateMutation) FieldCleared(name string) bool {
+func (m *AlertRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CurrencyRateMutation) ClearField(name string) error {
-	switch name {
-	case currencyrate.FieldExpiredAt:
-		m.ClearExpiredAt()
-		return nil
-	}
-	return fmt.Errorf("unknown CurrencyRate nullable field %s", name)
+func (m *AlertRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AlertRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CurrencyRateMutation) ResetField(name string) error {
+func (m *AlertRuleMutation) ResetField(name string) error {
 	switch name {
-	case currencyrate.FieldCreatedAt:
+	case alertrule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case currencyrate.FieldUpdatedAt:
+	case alertrule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case currencyrate.FieldSymbol:
-		m.ResetSymbol()
+	case alertrule.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case currencyrate.FieldRate:
-		m.ResetRate()
+	case alertrule.FieldType:
+		m.ResetType()
 		return nil
-	case currencyrate.FieldExpiredAt:
-		m.ResetExpiredAt()
+	case alertrule.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case alertrule.FieldThreshold:
+		m.ResetThreshold()
 		return nil
 	}
-	return fmt.Errorf("unknown CurrencyRate field %s", name)
+	return fmt.Errorf("unknown AlertRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CurrencyRateMutation) AddedEdges() []string {
+func (m *AlertRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CurrencyRateMutation) AddedIDs(name string) []ent.Value {
+func (m *AlertRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CurrencyRateMutation) RemovedEdges() []string {
+func (m *AlertRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CurrencyRateMutation) RemovedIDs(name string) []ent.Value {
+func (m *AlertRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CurrencyRateMutation) ClearedEdges() []string {
+func (m *AlertRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CurrencyRateMutation) EdgeCleared(name string) bool {
+func (m *AlertRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CurrencyRateMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CurrencyRate unique edge %s", name)
+func (m *AlertRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AlertRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CurrencyRateMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CurrencyRate edge %s", name)
+func (m *AlertRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AlertRule edge %s", name)
 }
 
-// IcoMutation represents an operation that mutates the Ico nodes in the graph.
-type IcoMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *xid.ID
-	created_at    *time.Time
-	updated_at    *time.Time
-	round_id      *int32
-	addround_id   *int32
-	round_name    *string
-	price         *string
-	num_token     *string
-	num_sub       *int32
-	addnum_sub    *int32
-	price_gap     *string
-	ended_at      *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Ico, error)
-	predicates    []predicate.Ico
+	op             Op
+	typ            string
+	id             *xid.ID
+	created_at     *time.Time
+	actor          *string
+	service        *string
+	roles          *[]string
+	appendroles    []string
+	rpc            *string
+	target_user_id *string
+	payload_hash   *string
+	before         *string
+	after          *string
+	outcome        *string
+	error          *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*AuditLog, error)
+	predicates     []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*IcoMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// icoOption allows management of the mutation configuration using functional options.
-type icoOption func(*IcoMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newIcoMutation creates new mutation for the Ico entity.
-func newIcoMutation(c config, op Op, opts ...icoOption) *IcoMutation {
-	m := &IcoMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIco,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -646,20 +707,20 @@ func newIcoMutation(c config, op Op, opts ...icoOption) *IcoMutation {
 	return m
 }
 
-// withIcoID sets the ID field of the mutation.
-func withIcoID(id xid.ID) icoOption {
-	return func(m *IcoMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id xid.ID) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Ico
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Ico, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Ico.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -668,10 +729,10 @@ func withIcoID(id xid.ID) icoOption {
 	}
 }
 
-// withIco sets the old Ico of the mutation.
-func withIco(node *Ico) icoOption {
-	return func(m *IcoMutation) {
-		m.oldValue = func(context.Context) (*Ico, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -680,7 +741,7 @@ func withIco(node *Ico) icoOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IcoMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -688,7 +749,7 @@ func (m IcoMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IcoMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -698,14 +759,14 @@ func (m IcoMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Ico entities.
-func (m *IcoMutation) SetID(id xid.ID) {
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id xid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IcoMutation) ID() (id xid.ID, exists bool) {
+func (m *AuditLogMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -716,7 +777,7 @@ func (m *IcoMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IcoMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -725,19 +786,19 @@ func (m *IcoMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Ico.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *IcoMutation) SetCreatedAt(t time.Time) {
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IcoMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -745,10 +806,10 @@ func (m *IcoMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -763,360 +824,473 @@ func (m *IcoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IcoMutation) ResetCreatedAt() {
+func (m *AuditLogMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *IcoMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IcoMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Actor, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IcoMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetRoundID sets the "round_id" field.
-func (m *IcoMutation) SetRoundID(i int32) {
-	m.round_id = &i
-	m.addround_id = nil
+// SetService sets the "service" field.
+func (m *AuditLogMutation) SetService(s string) {
+	m.service = &s
 }
 
-// RoundID returns the value of the "round_id" field in the mutation.
-func (m *IcoMutation) RoundID() (r int32, exists bool) {
-	v := m.round_id
+// Service returns the value of the "service" field in the mutation.
+func (m *AuditLogMutation) Service() (r string, exists bool) {
+	v := m.service
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoundID returns the old "round_id" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldService returns the old "service" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldRoundID(ctx context.Context) (v int32, err error) {
+func (m *AuditLogMutation) OldService(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
+		return v, errors.New("OldService is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoundID requires an ID field in the mutation")
+		return v, errors.New("OldService requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoundID: %w", err)
+		return v, fmt.Errorf("querying old value for OldService: %w", err)
 	}
-	return oldValue.RoundID, nil
+	return oldValue.Service, nil
 }
 
-// AddRoundID adds i to the "round_id" field.
-func (m *IcoMutation) AddRoundID(i int32) {
-	if m.addround_id != nil {
-		*m.addround_id += i
-	} else {
-		m.addround_id = &i
-	}
+// ResetService resets all changes to the "service" field.
+func (m *AuditLogMutation) ResetService() {
+	m.service = nil
 }
 
-// AddedRoundID returns the value that was added to the "round_id" field in this mutation.
-func (m *IcoMutation) AddedRoundID() (r int32, exists bool) {
-	v := m.addround_id
+// SetRoles sets the "roles" field.
+func (m *AuditLogMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *AuditLogMutation) Roles() (r []string, exists bool) {
+	v := m.roles
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRoundID resets all changes to the "round_id" field.
-func (m *IcoMutation) ResetRoundID() {
-	m.round_id = nil
-	m.addround_id = nil
+// OldRoles returns the old "roles" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldRoles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
 }
 
-// SetRoundName sets the "round_name" field.
-func (m *IcoMutation) SetRoundName(s string) {
-	m.round_name = &s
+// AppendRoles adds s to the "roles" field.
+func (m *AuditLogMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
 }
 
-// RoundName returns the value of the "round_name" field in the mutation.
-func (m *IcoMutation) RoundName() (r string, exists bool) {
-	v := m.round_name
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *AuditLogMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (m *AuditLogMutation) ClearRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	m.clearedFields[auditlog.FieldRoles] = struct{}{}
+}
+
+// RolesCleared returns if the "roles" field was cleared in this mutation.
+func (m *AuditLogMutation) RolesCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRoles]
+	return ok
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *AuditLogMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	delete(m.clearedFields, auditlog.FieldRoles)
+}
+
+// SetRPC sets the "rpc" field.
+func (m *AuditLogMutation) SetRPC(s string) {
+	m.rpc = &s
+}
+
+// RPC returns the value of the "rpc" field in the mutation.
+func (m *AuditLogMutation) RPC() (r string, exists bool) {
+	v := m.rpc
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoundName returns the old "round_name" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldRPC returns the old "rpc" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldRoundName(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldRPC(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoundName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRPC is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoundName requires an ID field in the mutation")
+		return v, errors.New("OldRPC requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoundName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRPC: %w", err)
 	}
-	return oldValue.RoundName, nil
+	return oldValue.RPC, nil
 }
 
-// ResetRoundName resets all changes to the "round_name" field.
-func (m *IcoMutation) ResetRoundName() {
-	m.round_name = nil
+// ResetRPC resets all changes to the "rpc" field.
+func (m *AuditLogMutation) ResetRPC() {
+	m.rpc = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *IcoMutation) SetPrice(s string) {
-	m.price = &s
+// SetTargetUserID sets the "target_user_id" field.
+func (m *AuditLogMutation) SetTargetUserID(s string) {
+	m.target_user_id = &s
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *IcoMutation) Price() (r string, exists bool) {
-	v := m.price
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *AuditLogMutation) TargetUserID() (r string, exists bool) {
+	v := m.target_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetUserID returns the old "target_user_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldPrice(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldTargetUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.TargetUserID, nil
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *IcoMutation) ResetPrice() {
-	m.price = nil
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (m *AuditLogMutation) ClearTargetUserID() {
+	m.target_user_id = nil
+	m.clearedFields[auditlog.FieldTargetUserID] = struct{}{}
 }
 
-// SetNumToken sets the "num_token" field.
-func (m *IcoMutation) SetNumToken(s string) {
-	m.num_token = &s
+// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TargetUserIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTargetUserID]
+	return ok
 }
 
-// NumToken returns the value of the "num_token" field in the mutation.
-func (m *IcoMutation) NumToken() (r string, exists bool) {
-	v := m.num_token
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *AuditLogMutation) ResetTargetUserID() {
+	m.target_user_id = nil
+	delete(m.clearedFields, auditlog.FieldTargetUserID)
+}
+
+// SetPayloadHash sets the "payload_hash" field.
+func (m *AuditLogMutation) SetPayloadHash(s string) {
+	m.payload_hash = &s
+}
+
+// PayloadHash returns the value of the "payload_hash" field in the mutation.
+func (m *AuditLogMutation) PayloadHash() (r string, exists bool) {
+	v := m.payload_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumToken returns the old "num_token" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldPayloadHash returns the old "payload_hash" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldNumToken(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldPayloadHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayloadHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumToken requires an ID field in the mutation")
+		return v, errors.New("OldPayloadHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayloadHash: %w", err)
 	}
-	return oldValue.NumToken, nil
+	return oldValue.PayloadHash, nil
 }
 
-// ResetNumToken resets all changes to the "num_token" field.
-func (m *IcoMutation) ResetNumToken() {
-	m.num_token = nil
+// ClearPayloadHash clears the value of the "payload_hash" field.
+func (m *AuditLogMutation) ClearPayloadHash() {
+	m.payload_hash = nil
+	m.clearedFields[auditlog.FieldPayloadHash] = struct{}{}
 }
 
-// SetNumSub sets the "num_sub" field.
-func (m *IcoMutation) SetNumSub(i int32) {
-	m.num_sub = &i
-	m.addnum_sub = nil
+// PayloadHashCleared returns if the "payload_hash" field was cleared in this mutation.
+func (m *AuditLogMutation) PayloadHashCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldPayloadHash]
+	return ok
 }
 
-// NumSub returns the value of the "num_sub" field in the mutation.
-func (m *IcoMutation) NumSub() (r int32, exists bool) {
-	v := m.num_sub
+// ResetPayloadHash resets all changes to the "payload_hash" field.
+func (m *AuditLogMutation) ResetPayloadHash() {
+	m.payload_hash = nil
+	delete(m.clearedFields, auditlog.FieldPayloadHash)
+}
+
+// SetBefore sets the "before" field.
+func (m *AuditLogMutation) SetBefore(s string) {
+	m.before = &s
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *AuditLogMutation) Before() (r string, exists bool) {
+	v := m.before
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumSub returns the old "num_sub" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldBefore returns the old "before" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldNumSub(ctx context.Context) (v int32, err error) {
+func (m *AuditLogMutation) OldBefore(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumSub is only allowed on UpdateOne operations")
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumSub requires an ID field in the mutation")
+		return v, errors.New("OldBefore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumSub: %w", err)
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
 	}
-	return oldValue.NumSub, nil
+	return oldValue.Before, nil
 }
 
-// AddNumSub adds i to the "num_sub" field.
-func (m *IcoMutation) AddNumSub(i int32) {
-	if m.addnum_sub != nil {
-		*m.addnum_sub += i
-	} else {
-		m.addnum_sub = &i
-	}
+// ClearBefore clears the value of the "before" field.
+func (m *AuditLogMutation) ClearBefore() {
+	m.before = nil
+	m.clearedFields[auditlog.FieldBefore] = struct{}{}
 }
 
-// AddedNumSub returns the value that was added to the "num_sub" field in this mutation.
-func (m *IcoMutation) AddedNumSub() (r int32, exists bool) {
-	v := m.addnum_sub
-	if v == nil {
-		return
-	}
-	return *v, true
+// BeforeCleared returns if the "before" field was cleared in this mutation.
+func (m *AuditLogMutation) BeforeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldBefore]
+	return ok
 }
 
-// ResetNumSub resets all changes to the "num_sub" field.
-func (m *IcoMutation) ResetNumSub() {
-	m.num_sub = nil
-	m.addnum_sub = nil
+// ResetBefore resets all changes to the "before" field.
+func (m *AuditLogMutation) ResetBefore() {
+	m.before = nil
+	delete(m.clearedFields, auditlog.FieldBefore)
 }
 
-// SetPriceGap sets the "price_gap" field.
-func (m *IcoMutation) SetPriceGap(s string) {
-	m.price_gap = &s
+// SetAfter sets the "after" field.
+func (m *AuditLogMutation) SetAfter(s string) {
+	m.after = &s
 }
 
-// PriceGap returns the value of the "price_gap" field in the mutation.
-func (m *IcoMutation) PriceGap() (r string, exists bool) {
-	v := m.price_gap
+// After returns the value of the "after" field in the mutation.
+func (m *AuditLogMutation) After() (r string, exists bool) {
+	v := m.after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceGap returns the old "price_gap" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldAfter returns the old "after" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldPriceGap(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldAfter(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceGap is only allowed on UpdateOne operations")
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceGap requires an ID field in the mutation")
+		return v, errors.New("OldAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceGap: %w", err)
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
 	}
-	return oldValue.PriceGap, nil
+	return oldValue.After, nil
 }
 
-// ResetPriceGap resets all changes to the "price_gap" field.
-func (m *IcoMutation) ResetPriceGap() {
-	m.price_gap = nil
+// ClearAfter clears the value of the "after" field.
+func (m *AuditLogMutation) ClearAfter() {
+	m.after = nil
+	m.clearedFields[auditlog.FieldAfter] = struct{}{}
 }
 
-// SetEndedAt sets the "ended_at" field.
-func (m *IcoMutation) SetEndedAt(t time.Time) {
-	m.ended_at = &t
+// AfterCleared returns if the "after" field was cleared in this mutation.
+func (m *AuditLogMutation) AfterCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldAfter]
+	return ok
 }
 
-// EndedAt returns the value of the "ended_at" field in the mutation.
-func (m *IcoMutation) EndedAt() (r time.Time, exists bool) {
-	v := m.ended_at
+// ResetAfter resets all changes to the "after" field.
+func (m *AuditLogMutation) ResetAfter() {
+	m.after = nil
+	delete(m.clearedFields, auditlog.FieldAfter)
+}
+
+// SetOutcome sets the "outcome" field.
+func (m *AuditLogMutation) SetOutcome(s string) {
+	m.outcome = &s
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *AuditLogMutation) Outcome() (r string, exists bool) {
+	v := m.outcome
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndedAt returns the old "ended_at" field's value of the Ico entity.
-// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// OldOutcome returns the old "outcome" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AuditLogMutation) OldOutcome(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
 	}
-	return oldValue.EndedAt, nil
+	return oldValue.Outcome, nil
 }
 
-// ClearEndedAt clears the value of the "ended_at" field.
-func (m *IcoMutation) ClearEndedAt() {
-	m.ended_at = nil
-	m.clearedFields[ico.FieldEndedAt] = struct{}{}
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *AuditLogMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
-func (m *IcoMutation) EndedAtCleared() bool {
-	_, ok := m.clearedFields[ico.FieldEndedAt]
+// SetError sets the "error" field.
+func (m *AuditLogMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditLogMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditLogMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditlog.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditLogMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldError]
 	return ok
 }
 
-// ResetEndedAt resets all changes to the "ended_at" field.
-func (m *IcoMutation) ResetEndedAt() {
-	m.ended_at = nil
-	delete(m.clearedFields, ico.FieldEndedAt)
+// ResetError resets all changes to the "error" field.
+func (m *AuditLogMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditlog.FieldError)
 }
 
-// Where appends a list predicates to the IcoMutation builder.
-func (m *IcoMutation) Where(ps ...predicate.Ico) {
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IcoMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IcoMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Ico, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1124,51 +1298,57 @@ func (m *IcoMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IcoMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IcoMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Ico).
-func (m *IcoMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IcoMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, ico.FieldCreatedAt)
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, ico.FieldUpdatedAt)
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
 	}
-	if m.round_id != nil {
-		fields = append(fields, ico.FieldRoundID)
+	if m.service != nil {
+		fields = append(fields, auditlog.FieldService)
 	}
-	if m.round_name != nil {
-		fields = append(fields, ico.FieldRoundName)
+	if m.roles != nil {
+		fields = append(fields, auditlog.FieldRoles)
 	}
-	if m.price != nil {
-		fields = append(fields, ico.FieldPrice)
+	if m.rpc != nil {
+		fields = append(fields, auditlog.FieldRPC)
 	}
-	if m.num_token != nil {
-		fields = append(fields, ico.FieldNumToken)
+	if m.target_user_id != nil {
+		fields = append(fields, auditlog.FieldTargetUserID)
 	}
-	if m.num_sub != nil {
-		fields = append(fields, ico.FieldNumSub)
+	if m.payload_hash != nil {
+		fields = append(fields, auditlog.FieldPayloadHash)
 	}
-	if m.price_gap != nil {
-		fields = append(fields, ico.FieldPriceGap)
+	if m.before != nil {
+		fields = append(fields, auditlog.FieldBefore)
 	}
-	if m.ended_at != nil {
-		fields = append(fields, ico.FieldEndedAt)
+	if m.after != nil {
+		fields = append(fields, auditlog.FieldAfter)
+	}
+	if m.outcome != nil {
+		fields = append(fields, auditlog.FieldOutcome)
+	}
+	if m.error != nil {
+		fields = append(fields, auditlog.FieldError)
 	}
 	return fields
 }
@@ -1176,26 +1356,30 @@ func (m *IcoMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IcoMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ico.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		return m.CreatedAt()
-	case ico.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case ico.FieldRoundID:
-		return m.RoundID()
-	case ico.FieldRoundName:
-		return m.RoundName()
-	case ico.FieldPrice:
-		return m.Price()
-	case ico.FieldNumToken:
-		return m.NumToken()
-	case ico.FieldNumSub:
-		return m.NumSub()
-	case ico.FieldPriceGap:
-		return m.PriceGap()
-	case ico.FieldEndedAt:
-		return m.EndedAt()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldService:
+		return m.Service()
+	case auditlog.FieldRoles:
+		return m.Roles()
+	case auditlog.FieldRPC:
+		return m.RPC()
+	case auditlog.FieldTargetUserID:
+		return m.TargetUserID()
+	case auditlog.FieldPayloadHash:
+		return m.PayloadHash()
+	case auditlog.FieldBefore:
+		return m.Before()
+	case auditlog.FieldAfter:
+		return m.After()
+	case auditlog.FieldOutcome:
+		return m.Outcome()
+	case auditlog.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -1203,292 +1387,317 @@ func (m *IcoMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IcoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ico.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case ico.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case ico.FieldRoundID:
-		return m.OldRoundID(ctx)
-	case ico.FieldRoundName:
-		return m.OldRoundName(ctx)
-	case ico.FieldPrice:
-		return m.OldPrice(ctx)
-	case ico.FieldNumToken:
-		return m.OldNumToken(ctx)
-	case ico.FieldNumSub:
-		return m.OldNumSub(ctx)
-	case ico.FieldPriceGap:
-		return m.OldPriceGap(ctx)
-	case ico.FieldEndedAt:
-		return m.OldEndedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Ico field %s", name)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldService:
+		return m.OldService(ctx)
+	case auditlog.FieldRoles:
+		return m.OldRoles(ctx)
+	case auditlog.FieldRPC:
+		return m.OldRPC(ctx)
+	case auditlog.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case auditlog.FieldPayloadHash:
+		return m.OldPayloadHash(ctx)
+	case auditlog.FieldBefore:
+		return m.OldBefore(ctx)
+	case auditlog.FieldAfter:
+		return m.OldAfter(ctx)
+	case auditlog.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case auditlog.FieldError:
+		return m.OldError(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ico.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case ico.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetActor(v)
 		return nil
-	case ico.FieldRoundID:
-		v, ok := value.(int32)
+	case auditlog.FieldService:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoundID(v)
+		m.SetService(v)
 		return nil
-	case ico.FieldRoundName:
+	case auditlog.FieldRoles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
+	case auditlog.FieldRPC:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoundName(v)
+		m.SetRPC(v)
 		return nil
-	case ico.FieldPrice:
+	case auditlog.FieldTargetUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetTargetUserID(v)
 		return nil
-	case ico.FieldNumToken:
+	case auditlog.FieldPayloadHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumToken(v)
+		m.SetPayloadHash(v)
 		return nil
-	case ico.FieldNumSub:
-		v, ok := value.(int32)
+	case auditlog.FieldBefore:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumSub(v)
+		m.SetBefore(v)
 		return nil
-	case ico.FieldPriceGap:
+	case auditlog.FieldAfter:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriceGap(v)
+		m.SetAfter(v)
 		return nil
-	case ico.FieldEndedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldOutcome:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndedAt(v)
+		m.SetOutcome(v)
+		return nil
+	case auditlog.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ico field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IcoMutation) AddedFields() []string {
-	var fields []string
-	if m.addround_id != nil {
-		fields = append(fields, ico.FieldRoundID)
-	}
-	if m.addnum_sub != nil {
-		fields = append(fields, ico.FieldNumSub)
-	}
-	return fields
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IcoMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case ico.FieldRoundID:
-		return m.AddedRoundID()
-	case ico.FieldNumSub:
-		return m.AddedNumSub()
-	}
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case ico.FieldRoundID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRoundID(v)
-		return nil
-	case ico.FieldNumSub:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNumSub(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Ico numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IcoMutation) ClearedFields() []string {
+func (m *AuditLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(ico.FieldEndedAt) {
-		fields = append(fields, ico.FieldEndedAt)
+	if m.FieldCleared(auditlog.FieldRoles) {
+		fields = append(fields, auditlog.FieldRoles)
+	}
+	if m.FieldCleared(auditlog.FieldTargetUserID) {
+		fields = append(fields, auditlog.FieldTargetUserID)
+	}
+	if m.FieldCleared(auditlog.FieldPayloadHash) {
+		fields = append(fields, auditlog.FieldPayloadHash)
+	}
+	if m.FieldCleared(auditlog.FieldBefore) {
+		fields = append(fields, auditlog.FieldBefore)
+	}
+	if m.FieldCleared(auditlog.FieldAfter) {
+		fields = append(fields, auditlog.FieldAfter)
+	}
+	if m.FieldCleared(auditlog.FieldError) {
+		fields = append(fields, auditlog.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IcoMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IcoMutation) ClearField(name string) error {
+func (m *AuditLogMutation) ClearField(name string) error {
 	switch name {
-	case ico.FieldEndedAt:
-		m.ClearEndedAt()
+	case auditlog.FieldRoles:
+		m.ClearRoles()
+		return nil
+	case auditlog.FieldTargetUserID:
+		m.ClearTargetUserID()
+		return nil
+	case auditlog.FieldPayloadHash:
+		m.ClearPayloadHash()
+		return nil
+	case auditlog.FieldBefore:
+		m.ClearBefore()
+		return nil
+	case auditlog.FieldAfter:
+		m.ClearAfter()
+		return nil
+	case auditlog.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Ico nullable field %s", name)
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IcoMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case ico.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case ico.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditlog.FieldActor:
+		m.ResetActor()
 		return nil
-	case ico.FieldRoundID:
-		m.ResetRoundID()
+	case auditlog.FieldService:
+		m.ResetService()
 		return nil
-	case ico.FieldRoundName:
-		m.ResetRoundName()
+	case auditlog.FieldRoles:
+		m.ResetRoles()
 		return nil
-	case ico.FieldPrice:
-		m.ResetPrice()
+	case auditlog.FieldRPC:
+		m.ResetRPC()
 		return nil
-	case ico.FieldNumToken:
-		m.ResetNumToken()
+	case auditlog.FieldTargetUserID:
+		m.ResetTargetUserID()
 		return nil
-	case ico.FieldNumSub:
-		m.ResetNumSub()
+	case auditlog.FieldPayloadHash:
+		m.ResetPayloadHash()
 		return nil
-	case ico.FieldPriceGap:
-		m.ResetPriceGap()
+	case auditlog.FieldBefore:
+		m.ResetBefore()
 		return nil
-	case ico.FieldEndedAt:
-		m.ResetEndedAt()
+	case auditlog.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case auditlog.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case auditlog.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown Ico field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IcoMutation) AddedEdges() []string {
+func (m *AuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IcoMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IcoMutation) RemovedEdges() []string {
+func (m *AuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IcoMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IcoMutation) ClearedEdges() []string {
+func (m *AuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IcoMutation) EdgeCleared(name string) bool {
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IcoMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Ico unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IcoMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Ico edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// IcoCouponMutation represents an operation that mutates the IcoCoupon nodes in the graph.
-type IcoCouponMutation struct {
+// CurrencyRateMutation represents an operation that mutates the CurrencyRate nodes in the graph.
+type CurrencyRateMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *xid.ID
+	id            *int
 	created_at    *time.Time
 	updated_at    *time.Time
-	user_id       *string
-	coupon        *string
-	reward        *string
-	cashback      *string
-	deleted_at    *time.Time
+	symbol        *string
+	rate          *string
+	expired_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*IcoCoupon, error)
-	predicates    []predicate.IcoCoupon
+	oldValue      func(context.Context) (*CurrencyRate, error)
+	predicates    []predicate.CurrencyRate
 }
 
-var _ ent.Mutation = (*IcoCouponMutation)(nil)
+var _ ent.Mutation = (*CurrencyRateMutation)(nil)
 
-// icocouponOption allows management of the mutation configuration using functional options.
-type icocouponOption func(*IcoCouponMutation)
+// currencyrateOption allows management of the mutation configuration using functional options.
+type currencyrateOption func(*CurrencyRateMutation)
 
-// newIcoCouponMutation creates new mutation for the IcoCoupon entity.
-func newIcoCouponMutation(c config, op Op, opts ...icocouponOption) *IcoCouponMutation {
-	m := &IcoCouponMutation{
+// newCurrencyRateMutation creates new mutation for the CurrencyRate entity.
+func newCurrencyRateMutation(c config, op Op, opts ...currencyrateOption) *CurrencyRateMutation {
+	m := &CurrencyRateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIcoCoupon,
+		typ:           TypeCurrencyRate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1497,20 +1706,20 @@ func newIcoCouponMutation(c config, op Op, opts ...icocouponOption) *IcoCouponMu
 	return m
 }
 
-// withIcoCouponID sets the ID field of the mutation.
-func withIcoCouponID(id xid.ID) icocouponOption {
-	return func(m *IcoCouponMutation) {
+// withCurrencyRateID sets the ID field of the mutation.
+func withCurrencyRateID(id int) currencyrateOption {
+	return func(m *CurrencyRateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *IcoCoupon
+			value *CurrencyRate
 		)
-		m.oldValue = func(ctx context.Context) (*IcoCoupon, error) {
+		m.oldValue = func(ctx context.Context) (*CurrencyRate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().IcoCoupon.Get(ctx, id)
+					value, err = m.Client().CurrencyRate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1519,10 +1728,10 @@ func withIcoCouponID(id xid.ID) icocouponOption {
 	}
 }
 
-// withIcoCoupon sets the old IcoCoupon of the mutation.
-func withIcoCoupon(node *IcoCoupon) icocouponOption {
-	return func(m *IcoCouponMutation) {
-		m.oldValue = func(context.Context) (*IcoCoupon, error) {
+// withCurrencyRate sets the old CurrencyRate of the mutation.
+func withCurrencyRate(node *CurrencyRate) currencyrateOption {
+	return func(m *CurrencyRateMutation) {
+		m.oldValue = func(context.Context) (*CurrencyRate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1531,7 +1740,7 @@ func withIcoCoupon(node *IcoCoupon) icocouponOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IcoCouponMutation) Client() *Client {
+func (m CurrencyRateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1539,7 +1748,7 @@ func (m IcoCouponMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IcoCouponMutation) Tx() (*Tx, error) {
+func (m CurrencyRateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1548,15 +1757,9 @@ func (m IcoCouponMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of IcoCoupon entities.
-func (m *IcoCouponMutation) SetID(id xid.ID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IcoCouponMutation) ID() (id xid.ID, exists bool) {
+func (m *CurrencyRateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1567,28 +1770,28 @@ func (m *IcoCouponMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IcoCouponMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *CurrencyRateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []xid.ID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IcoCoupon.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CurrencyRate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *IcoCouponMutation) SetCreatedAt(t time.Time) {
+func (m *CurrencyRateMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IcoCouponMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CurrencyRateMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1596,10 +1799,10 @@ func (m *IcoCouponMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CurrencyRate entity.
+// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CurrencyRateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1614,17 +1817,17 @@ func (m *IcoCouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IcoCouponMutation) ResetCreatedAt() {
+func (m *CurrencyRateMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *IcoCouponMutation) SetUpdatedAt(t time.Time) {
+func (m *CurrencyRateMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IcoCouponMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CurrencyRateMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1632,10 +1835,10 @@ func (m *IcoCouponMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CurrencyRate entity.
+// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CurrencyRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1650,212 +1853,140 @@ func (m *IcoCouponMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IcoCouponMutation) ResetUpdatedAt() {
+func (m *CurrencyRateMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *IcoCouponMutation) SetUserID(s string) {
-	m.user_id = &s
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *IcoCouponMutation) UserID() (r string, exists bool) {
-	v := m.user_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *IcoCouponMutation) ResetUserID() {
-	m.user_id = nil
-}
-
-// SetCoupon sets the "coupon" field.
-func (m *IcoCouponMutation) SetCoupon(s string) {
-	m.coupon = &s
-}
-
-// Coupon returns the value of the "coupon" field in the mutation.
-func (m *IcoCouponMutation) Coupon() (r string, exists bool) {
-	v := m.coupon
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCoupon returns the old "coupon" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldCoupon(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoupon is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoupon requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoupon: %w", err)
-	}
-	return oldValue.Coupon, nil
-}
-
-// ResetCoupon resets all changes to the "coupon" field.
-func (m *IcoCouponMutation) ResetCoupon() {
-	m.coupon = nil
-}
-
-// SetReward sets the "reward" field.
-func (m *IcoCouponMutation) SetReward(s string) {
-	m.reward = &s
+// SetSymbol sets the "symbol" field.
+func (m *CurrencyRateMutation) SetSymbol(s string) {
+	m.symbol = &s
 }
 
-// Reward returns the value of the "reward" field in the mutation.
-func (m *IcoCouponMutation) Reward() (r string, exists bool) {
-	v := m.reward
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *CurrencyRateMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReward returns the old "reward" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the CurrencyRate entity.
+// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldReward(ctx context.Context) (v string, err error) {
+func (m *CurrencyRateMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReward is only allowed on UpdateOne operations")
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReward requires an ID field in the mutation")
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReward: %w", err)
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
 	}
-	return oldValue.Reward, nil
+	return oldValue.Symbol, nil
 }
 
-// ResetReward resets all changes to the "reward" field.
-func (m *IcoCouponMutation) ResetReward() {
-	m.reward = nil
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *CurrencyRateMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// SetCashback sets the "cashback" field.
-func (m *IcoCouponMutation) SetCashback(s string) {
-	m.cashback = &s
+// SetRate sets the "rate" field.
+func (m *CurrencyRateMutation) SetRate(s string) {
+	m.rate = &s
 }
 
-// Cashback returns the value of the "cashback" field in the mutation.
-func (m *IcoCouponMutation) Cashback() (r string, exists bool) {
-	v := m.cashback
+// Rate returns the value of the "rate" field in the mutation.
+func (m *CurrencyRateMutation) Rate() (r string, exists bool) {
+	v := m.rate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCashback returns the old "cashback" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// OldRate returns the old "rate" field's value of the CurrencyRate entity.
+// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldCashback(ctx context.Context) (v string, err error) {
+func (m *CurrencyRateMutation) OldRate(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCashback is only allowed on UpdateOne operations")
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCashback requires an ID field in the mutation")
+		return v, errors.New("OldRate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCashback: %w", err)
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
 	}
-	return oldValue.Cashback, nil
+	return oldValue.Rate, nil
 }
 
-// ResetCashback resets all changes to the "cashback" field.
-func (m *IcoCouponMutation) ResetCashback() {
-	m.cashback = nil
+// ResetRate resets all changes to the "rate" field.
+func (m *CurrencyRateMutation) ResetRate() {
+	m.rate = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *IcoCouponMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetExpiredAt sets the "expired_at" field.
+func (m *CurrencyRateMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *IcoCouponMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *CurrencyRateMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the IcoCoupon entity.
-// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiredAt returns the old "expired_at" field's value of the CurrencyRate entity.
+// If the CurrencyRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoCouponMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CurrencyRateMutation) OldExpiredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.ExpiredAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *IcoCouponMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[icocoupon.FieldDeletedAt] = struct{}{}
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (m *CurrencyRateMutation) ClearExpiredAt() {
+	m.expired_at = nil
+	m.clearedFields[currencyrate.FieldExpiredAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *IcoCouponMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[icocoupon.FieldDeletedAt]
+// ExpiredAtCleared returns if the "expired_at" field was cleared in this mutation.
+func (m *CurrencyRateMutation) ExpiredAtCleared() bool {
+	_, ok := m.clearedFields[currencyrate.FieldExpiredAt]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *IcoCouponMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, icocoupon.FieldDeletedAt)
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *CurrencyRateMutation) ResetExpiredAt() {
+	m.expired_at = nil
+	delete(m.clearedFields, currencyrate.FieldExpiredAt)
 }
 
-// Where appends a list predicates to the IcoCouponMutation builder.
-func (m *IcoCouponMutation) Where(ps ...predicate.IcoCoupon) {
+// Where appends a list predicates to the CurrencyRateMutation builder.
+func (m *CurrencyRateMutation) Where(ps ...predicate.CurrencyRate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IcoCouponMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CurrencyRateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IcoCouponMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IcoCoupon, len(ps))
+func (m *CurrencyRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CurrencyRate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1863,45 +1994,39 @@ func (m *IcoCouponMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IcoCouponMutation) Op() Op {
+func (m *CurrencyRateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IcoCouponMutation) SetOp(op Op) {
+func (m *CurrencyRateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (IcoCoupon).
-func (m *IcoCouponMutation) Type() string {
+// Type returns the node type of this mutation (CurrencyRate).
+func (m *CurrencyRateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IcoCouponMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *CurrencyRateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, icocoupon.FieldCreatedAt)
+		fields = append(fields, currencyrate.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, icocoupon.FieldUpdatedAt)
-	}
-	if m.user_id != nil {
-		fields = append(fields, icocoupon.FieldUserID)
-	}
-	if m.coupon != nil {
-		fields = append(fields, icocoupon.FieldCoupon)
+		fields = append(fields, currencyrate.FieldUpdatedAt)
 	}
-	if m.reward != nil {
-		fields = append(fields, icocoupon.FieldReward)
+	if m.symbol != nil {
+		fields = append(fields, currencyrate.FieldSymbol)
 	}
-	if m.cashback != nil {
-		fields = append(fields, icocoupon.FieldCashback)
+	if m.rate != nil {
+		fields = append(fields, currencyrate.FieldRate)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, icocoupon.FieldDeletedAt)
+	if m.expired_at != nil {
+		fields = append(fields, currencyrate.FieldExpiredAt)
 	}
 	return fields
 }
@@ -1909,22 +2034,18 @@ func (m *IcoCouponMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IcoCouponMutation) Field(name string) (ent.Value, bool) {
+func (m *CurrencyRateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case icocoupon.FieldCreatedAt:
+	case currencyrate.FieldCreatedAt:
 		return m.CreatedAt()
-	case icocoupon.FieldUpdatedAt:
+	case currencyrate.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case icocoupon.FieldUserID:
-		return m.UserID()
-	case icocoupon.FieldCoupon:
-		return m.Coupon()
-	case icocoupon.FieldReward:
-		return m.Reward()
-	case icocoupon.FieldCashback:
-		return m.Cashback()
-	case icocoupon.FieldDeletedAt:
-		return m.DeletedAt()
+	case currencyrate.FieldSymbol:
+		return m.Symbol()
+	case currencyrate.FieldRate:
+		return m.Rate()
+	case currencyrate.FieldExpiredAt:
+		return m.ExpiredAt()
 	}
 	return nil, false
 }
@@ -1932,213 +2053,189 @@ func (m *IcoCouponMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IcoCouponMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CurrencyRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case icocoupon.FieldCreatedAt:
+	case currencyrate.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case icocoupon.FieldUpdatedAt:
+	case currencyrate.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case icocoupon.FieldUserID:
-		return m.OldUserID(ctx)
-	case icocoupon.FieldCoupon:
-		return m.OldCoupon(ctx)
-	case icocoupon.FieldReward:
-		return m.OldReward(ctx)
-	case icocoupon.FieldCashback:
-		return m.OldCashback(ctx)
-	case icocoupon.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case currencyrate.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case currencyrate.FieldRate:
+		return m.OldRate(ctx)
+	case currencyrate.FieldExpiredAt:
+		return m.OldExpiredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown IcoCoupon field %s", name)
+	return nil, fmt.Errorf("unknown CurrencyRate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoCouponMutation) SetField(name string, value ent.Value) error {
+func (m *CurrencyRateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case icocoupon.FieldCreatedAt:
+	case currencyrate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case icocoupon.FieldUpdatedAt:
+	case currencyrate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case icocoupon.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case icocoupon.FieldCoupon:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCoupon(v)
-		return nil
-	case icocoupon.FieldReward:
+	case currencyrate.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReward(v)
+		m.SetSymbol(v)
 		return nil
-	case icocoupon.FieldCashback:
+	case currencyrate.FieldRate:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCashback(v)
+		m.SetRate(v)
 		return nil
-	case icocoupon.FieldDeletedAt:
+	case currencyrate.FieldExpiredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetExpiredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IcoCoupon field %s", name)
+	return fmt.Errorf("unknown CurrencyRate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IcoCouponMutation) AddedFields() []string {
+func (m *CurrencyRateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IcoCouponMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CurrencyRateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoCouponMutation) AddField(name string, value ent.Value) error {
+func (m *CurrencyRateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown IcoCoupon numeric field %s", name)
+	return fmt.Errorf("unknown CurrencyRate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IcoCouponMutation) ClearedFields() []string {
+func (m *CurrencyRateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(icocoupon.FieldDeletedAt) {
-		fields = append(fields, icocoupon.FieldDeletedAt)
+	if m.FieldCleared(currencyrate.FieldExpiredAt) {
+		fields = append(fields, currencyrate.FieldExpiredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IcoCouponMutation) FieldCleared(name string) bool {
+func (m *CurrencyRateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IcoCouponMutation) ClearField(name string) error {
+func (m *CurrencyRateMutation) ClearField(name string) error {
 	switch name {
-	case icocoupon.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case currencyrate.FieldExpiredAt:
+		m.ClearExpiredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown IcoCoupon nullable field %s", name)
+	return fmt.Errorf("unknown CurrencyRate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IcoCouponMutation) ResetField(name string) error {
+func (m *CurrencyRateMutation) ResetField(name string) error {
 	switch name {
-	case icocoupon.FieldCreatedAt:
+	case currencyrate.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case icocoupon.FieldUpdatedAt:
+	case currencyrate.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case icocoupon.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case icocoupon.FieldCoupon:
-		m.ResetCoupon()
-		return nil
-	case icocoupon.FieldReward:
-		m.ResetReward()
+	case currencyrate.FieldSymbol:
+		m.ResetSymbol()
 		return nil
-	case icocoupon.FieldCashback:
-		m.ResetCashback()
+	case currencyrate.FieldRate:
+		m.ResetRate()
 		return nil
-	case icocoupon.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case currencyrate.FieldExpiredAt:
+		m.ResetExpiredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown IcoCoupon field %s", name)
+	return fmt.Errorf("unknown CurrencyRate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IcoCouponMutation) AddedEdges() []string {
+func (m *CurrencyRateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IcoCouponMutation) AddedIDs(name string) []ent.Value {
+func (m *CurrencyRateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IcoCouponMutation) RemovedEdges() []string {
+func (m *CurrencyRateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IcoCouponMutation) RemovedIDs(name string) []ent.Value {
+func (m *CurrencyRateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IcoCouponMutation) ClearedEdges() []string {
+func (m *CurrencyRateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IcoCouponMutation) EdgeCleared(name string) bool {
+func (m *CurrencyRateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IcoCouponMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown IcoCoupon unique edge %s", name)
+func (m *CurrencyRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CurrencyRate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IcoCouponMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown IcoCoupon edge %s", name)
+func (m *CurrencyRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CurrencyRate edge %s", name)
 }
 
-// IcoHistoryMutation represents an operation that mutates the IcoHistory nodes in the graph.
-type IcoHistoryMutation struct {
+// IcoMutation represents an operation that mutates the Ico nodes in the graph.
+type IcoMutation struct {
 	config
 	op            Op
 	typ           string
@@ -2147,29 +2244,38 @@ type IcoHistoryMutation struct {
 	updated_at    *time.Time
 	round_id      *int32
 	addround_id   *int32
-	user_id       *string
+	round_name    *string
 	price         *string
 	num_token     *string
-	sub_round     *int32
-	addsub_round  *int32
-	_type         *string
+	num_sub       *int32
+	addnum_sub    *int32
+	price_gap     *string
+	lifetime      *int32
+	addlifetime   *int32
+	ended_at      *time.Time
+	limits        *string
+	pricing       *string
+	unsold        *string
+	soft_cap      *string
+	hard_cap      *string
+	status        *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*IcoHistory, error)
-	predicates    []predicate.IcoHistory
+	oldValue      func(context.Context) (*Ico, error)
+	predicates    []predicate.Ico
 }
 
-var _ ent.Mutation = (*IcoHistoryMutation)(nil)
+var _ ent.Mutation = (*IcoMutation)(nil)
 
-// icohistoryOption allows management of the mutation configuration using functional options.
-type icohistoryOption func(*IcoHistoryMutation)
+// icoOption allows management of the mutation configuration using functional options.
+type icoOption func(*IcoMutation)
 
-// newIcoHistoryMutation creates new mutation for the IcoHistory entity.
-func newIcoHistoryMutation(c config, op Op, opts ...icohistoryOption) *IcoHistoryMutation {
-	m := &IcoHistoryMutation{
+// newIcoMutation creates new mutation for the Ico entity.
+func newIcoMutation(c config, op Op, opts ...icoOption) *IcoMutation {
+	m := &IcoMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIcoHistory,
+		typ:           TypeIco,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2178,20 +2284,20 @@ func newIcoHistoryMutation(c config, op Op, opts ...icohistoryOption) *IcoHistor
 	return m
 }
 
-// withIcoHistoryID sets the ID field of the mutation.
-func withIcoHistoryID(id xid.ID) icohistoryOption {
-	return func(m *IcoHistoryMutation) {
+// withIcoID sets the ID field of the mutation.
+func withIcoID(id xid.ID) icoOption {
+	return func(m *IcoMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *IcoHistory
+			value *Ico
 		)
-		m.oldValue = func(ctx context.Context) (*IcoHistory, error) {
+		m.oldValue = func(ctx context.Context) (*Ico, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().IcoHistory.Get(ctx, id)
+					value, err = m.Client().Ico.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2200,10 +2306,10 @@ func withIcoHistoryID(id xid.ID) icohistoryOption {
 	}
 }
 
-// withIcoHistory sets the old IcoHistory of the mutation.
-func withIcoHistory(node *IcoHistory) icohistoryOption {
-	return func(m *IcoHistoryMutation) {
-		m.oldValue = func(context.Context) (*IcoHistory, error) {
+// withIco sets the old Ico of the mutation.
+func withIco(node *Ico) icoOption {
+	return func(m *IcoMutation) {
+		m.oldValue = func(context.Context) (*Ico, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2212,7 +2318,7 @@ func withIcoHistory(node *IcoHistory) icohistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IcoHistoryMutation) Client() *Client {
+func (m IcoMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2220,7 +2326,7 @@ func (m IcoHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IcoHistoryMutation) Tx() (*Tx, error) {
+func (m IcoMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2230,14 +2336,14 @@ func (m IcoHistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of IcoHistory entities.
-func (m *IcoHistoryMutation) SetID(id xid.ID) {
+// operation is only accepted on creation of Ico entities.
+func (m *IcoMutation) SetID(id xid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IcoHistoryMutation) ID() (id xid.ID, exists bool) {
+func (m *IcoMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2248,7 +2354,7 @@ func (m *IcoHistoryMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IcoHistoryMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *IcoMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2257,19 +2363,19 @@ func (m *IcoHistoryMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IcoHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Ico.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *IcoHistoryMutation) SetCreatedAt(t time.Time) {
+func (m *IcoMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IcoHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *IcoMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2277,10 +2383,10 @@ func (m *IcoHistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IcoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2295,17 +2401,17 @@ func (m *IcoHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IcoHistoryMutation) ResetCreatedAt() {
+func (m *IcoMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *IcoHistoryMutation) SetUpdatedAt(t time.Time) {
+func (m *IcoMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IcoHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *IcoMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2313,10 +2419,10 @@ func (m *IcoHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+// OldUpdatedAt returns the old "updated_at" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2331,18 +2437,18 @@ func (m *IcoHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IcoHistoryMutation) ResetUpdatedAt() {
+func (m *IcoMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetRoundID sets the "round_id" field.
-func (m *IcoHistoryMutation) SetRoundID(i int32) {
+func (m *IcoMutation) SetRoundID(i int32) {
 	m.round_id = &i
 	m.addround_id = nil
 }
 
 // RoundID returns the value of the "round_id" field in the mutation.
-func (m *IcoHistoryMutation) RoundID() (r int32, exists bool) {
+func (m *IcoMutation) RoundID() (r int32, exists bool) {
 	v := m.round_id
 	if v == nil {
 		return
@@ -2350,10 +2456,10 @@ func (m *IcoHistoryMutation) RoundID() (r int32, exists bool) {
 	return *v, true
 }
 
-// OldRoundID returns the old "round_id" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldRoundID returns the old "round_id" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldRoundID(ctx context.Context) (v int32, err error) {
+func (m *IcoMutation) OldRoundID(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
 	}
@@ -2368,7 +2474,7 @@ func (m *IcoHistoryMutation) OldRoundID(ctx context.Context) (v int32, err error
 }
 
 // AddRoundID adds i to the "round_id" field.
-func (m *IcoHistoryMutation) AddRoundID(i int32) {
+func (m *IcoMutation) AddRoundID(i int32) {
 	if m.addround_id != nil {
 		*m.addround_id += i
 	} else {
@@ -2377,7 +2483,7 @@ func (m *IcoHistoryMutation) AddRoundID(i int32) {
 }
 
 // AddedRoundID returns the value that was added to the "round_id" field in this mutation.
-func (m *IcoHistoryMutation) AddedRoundID() (r int32, exists bool) {
+func (m *IcoMutation) AddedRoundID() (r int32, exists bool) {
 	v := m.addround_id
 	if v == nil {
 		return
@@ -2386,54 +2492,54 @@ func (m *IcoHistoryMutation) AddedRoundID() (r int32, exists bool) {
 }
 
 // ResetRoundID resets all changes to the "round_id" field.
-func (m *IcoHistoryMutation) ResetRoundID() {
+func (m *IcoMutation) ResetRoundID() {
 	m.round_id = nil
 	m.addround_id = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *IcoHistoryMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetRoundName sets the "round_name" field.
+func (m *IcoMutation) SetRoundName(s string) {
+	m.round_name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *IcoHistoryMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// RoundName returns the value of the "round_name" field in the mutation.
+func (m *IcoMutation) RoundName() (r string, exists bool) {
+	v := m.round_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldRoundName returns the old "round_name" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *IcoMutation) OldRoundName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoundName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldRoundName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoundName: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.RoundName, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *IcoHistoryMutation) ResetUserID() {
-	m.user_id = nil
+// ResetRoundName resets all changes to the "round_name" field.
+func (m *IcoMutation) ResetRoundName() {
+	m.round_name = nil
 }
 
 // SetPrice sets the "price" field.
-func (m *IcoHistoryMutation) SetPrice(s string) {
+func (m *IcoMutation) SetPrice(s string) {
 	m.price = &s
 }
 
 // Price returns the value of the "price" field in the mutation.
-func (m *IcoHistoryMutation) Price() (r string, exists bool) {
+func (m *IcoMutation) Price() (r string, exists bool) {
 	v := m.price
 	if v == nil {
 		return
@@ -2441,10 +2547,10 @@ func (m *IcoHistoryMutation) Price() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldPrice returns the old "price" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldPrice(ctx context.Context) (v string, err error) {
+func (m *IcoMutation) OldPrice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
 	}
@@ -2459,17 +2565,17 @@ func (m *IcoHistoryMutation) OldPrice(ctx context.Context) (v string, err error)
 }
 
 // ResetPrice resets all changes to the "price" field.
-func (m *IcoHistoryMutation) ResetPrice() {
+func (m *IcoMutation) ResetPrice() {
 	m.price = nil
 }
 
 // SetNumToken sets the "num_token" field.
-func (m *IcoHistoryMutation) SetNumToken(s string) {
+func (m *IcoMutation) SetNumToken(s string) {
 	m.num_token = &s
 }
 
 // NumToken returns the value of the "num_token" field in the mutation.
-func (m *IcoHistoryMutation) NumToken() (r string, exists bool) {
+func (m *IcoMutation) NumToken() (r string, exists bool) {
 	v := m.num_token
 	if v == nil {
 		return
@@ -2477,10 +2583,10 @@ func (m *IcoHistoryMutation) NumToken() (r string, exists bool) {
 	return *v, true
 }
 
-// OldNumToken returns the old "num_token" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldNumToken returns the old "num_token" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldNumToken(ctx context.Context) (v string, err error) {
+func (m *IcoMutation) OldNumToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
 	}
@@ -2495,173 +2601,9890 @@ func (m *IcoHistoryMutation) OldNumToken(ctx context.Context) (v string, err err
 }
 
 // ResetNumToken resets all changes to the "num_token" field.
-func (m *IcoHistoryMutation) ResetNumToken() {
+func (m *IcoMutation) ResetNumToken() {
 	m.num_token = nil
 }
 
-// SetSubRound sets the "sub_round" field.
-func (m *IcoHistoryMutation) SetSubRound(i int32) {
-	m.sub_round = &i
-	m.addsub_round = nil
+// SetNumSub sets the "num_sub" field.
+func (m *IcoMutation) SetNumSub(i int32) {
+	m.num_sub = &i
+	m.addnum_sub = nil
 }
 
-// SubRound returns the value of the "sub_round" field in the mutation.
-func (m *IcoHistoryMutation) SubRound() (r int32, exists bool) {
-	v := m.sub_round
+// NumSub returns the value of the "num_sub" field in the mutation.
+func (m *IcoMutation) NumSub() (r int32, exists bool) {
+	v := m.num_sub
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubRound returns the old "sub_round" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldNumSub returns the old "num_sub" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldSubRound(ctx context.Context) (v int32, err error) {
+func (m *IcoMutation) OldNumSub(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubRound is only allowed on UpdateOne operations")
+		return v, errors.New("OldNumSub is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubRound requires an ID field in the mutation")
+		return v, errors.New("OldNumSub requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubRound: %w", err)
+		return v, fmt.Errorf("querying old value for OldNumSub: %w", err)
 	}
-	return oldValue.SubRound, nil
+	return oldValue.NumSub, nil
 }
 
-// AddSubRound adds i to the "sub_round" field.
-func (m *IcoHistoryMutation) AddSubRound(i int32) {
-	if m.addsub_round != nil {
-		*m.addsub_round += i
+// AddNumSub adds i to the "num_sub" field.
+func (m *IcoMutation) AddNumSub(i int32) {
+	if m.addnum_sub != nil {
+		*m.addnum_sub += i
 	} else {
-		m.addsub_round = &i
+		m.addnum_sub = &i
 	}
 }
 
-// AddedSubRound returns the value that was added to the "sub_round" field in this mutation.
-func (m *IcoHistoryMutation) AddedSubRound() (r int32, exists bool) {
-	v := m.addsub_round
+// AddedNumSub returns the value that was added to the "num_sub" field in this mutation.
+func (m *IcoMutation) AddedNumSub() (r int32, exists bool) {
+	v := m.addnum_sub
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSubRound resets all changes to the "sub_round" field.
-func (m *IcoHistoryMutation) ResetSubRound() {
-	m.sub_round = nil
-	m.addsub_round = nil
+// ResetNumSub resets all changes to the "num_sub" field.
+func (m *IcoMutation) ResetNumSub() {
+	m.num_sub = nil
+	m.addnum_sub = nil
 }
 
-// SetType sets the "type" field.
-func (m *IcoHistoryMutation) SetType(s string) {
-	m._type = &s
+// SetPriceGap sets the "price_gap" field.
+func (m *IcoMutation) SetPriceGap(s string) {
+	m.price_gap = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *IcoHistoryMutation) GetType() (r string, exists bool) {
-	v := m._type
+// PriceGap returns the value of the "price_gap" field in the mutation.
+func (m *IcoMutation) PriceGap() (r string, exists bool) {
+	v := m.price_gap
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the IcoHistory entity.
-// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldPriceGap returns the old "price_gap" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoHistoryMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *IcoMutation) OldPriceGap(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriceGap is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldPriceGap requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriceGap: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.PriceGap, nil
 }
 
-// ClearType clears the value of the "type" field.
-func (m *IcoHistoryMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[icohistory.FieldType] = struct{}{}
+// ResetPriceGap resets all changes to the "price_gap" field.
+func (m *IcoMutation) ResetPriceGap() {
+	m.price_gap = nil
 }
 
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *IcoHistoryMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[icohistory.FieldType]
-	return ok
+// SetLifetime sets the "lifetime" field.
+func (m *IcoMutation) SetLifetime(i int32) {
+	m.lifetime = &i
+	m.addlifetime = nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *IcoHistoryMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, icohistory.FieldType)
+// Lifetime returns the value of the "lifetime" field in the mutation.
+func (m *IcoMutation) Lifetime() (r int32, exists bool) {
+	v := m.lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the IcoHistoryMutation builder.
-func (m *IcoHistoryMutation) Where(ps ...predicate.IcoHistory) {
-	m.predicates = append(m.predicates, ps...)
+// OldLifetime returns the old "lifetime" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldLifetime(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLifetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLifetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLifetime: %w", err)
+	}
+	return oldValue.Lifetime, nil
 }
 
-// WhereP appends storage-level predicates to the IcoHistoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IcoHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IcoHistory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddLifetime adds i to the "lifetime" field.
+func (m *IcoMutation) AddLifetime(i int32) {
+	if m.addlifetime != nil {
+		*m.addlifetime += i
+	} else {
+		m.addlifetime = &i
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *IcoHistoryMutation) Op() Op {
-	return m.op
+// AddedLifetime returns the value that was added to the "lifetime" field in this mutation.
+func (m *IcoMutation) AddedLifetime() (r int32, exists bool) {
+	v := m.addlifetime
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *IcoHistoryMutation) SetOp(op Op) {
-	m.op = op
+// ResetLifetime resets all changes to the "lifetime" field.
+func (m *IcoMutation) ResetLifetime() {
+	m.lifetime = nil
+	m.addlifetime = nil
 }
 
-// Type returns the node type of this mutation (IcoHistory).
-func (m *IcoHistoryMutation) Type() string {
-	return m.typ
+// SetEndedAt sets the "ended_at" field.
+func (m *IcoMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *IcoHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, icohistory.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, icohistory.FieldUpdatedAt)
-	}
-	if m.round_id != nil {
-		fields = append(fields, icohistory.FieldRoundID)
-	}
-	if m.user_id != nil {
-		fields = append(fields, icohistory.FieldUserID)
-	}
-	if m.price != nil {
-		fields = append(fields, icohistory.FieldPrice)
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *IcoMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
 	}
-	if m.num_token != nil {
-		fields = append(fields, icohistory.FieldNumToken)
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
 	}
-	if m.sub_round != nil {
-		fields = append(fields, icohistory.FieldSubRound)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
 	}
-	if m._type != nil {
-		fields = append(fields, icohistory.FieldType)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *IcoMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[ico.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *IcoMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[ico.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *IcoMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, ico.FieldEndedAt)
+}
+
+// SetLimits sets the "limits" field.
+func (m *IcoMutation) SetLimits(s string) {
+	m.limits = &s
+}
+
+// Limits returns the value of the "limits" field in the mutation.
+func (m *IcoMutation) Limits() (r string, exists bool) {
+	v := m.limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLimits returns the old "limits" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldLimits(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLimits: %w", err)
+	}
+	return oldValue.Limits, nil
+}
+
+// ClearLimits clears the value of the "limits" field.
+func (m *IcoMutation) ClearLimits() {
+	m.limits = nil
+	m.clearedFields[ico.FieldLimits] = struct{}{}
+}
+
+// LimitsCleared returns if the "limits" field was cleared in this mutation.
+func (m *IcoMutation) LimitsCleared() bool {
+	_, ok := m.clearedFields[ico.FieldLimits]
+	return ok
+}
+
+// ResetLimits resets all changes to the "limits" field.
+func (m *IcoMutation) ResetLimits() {
+	m.limits = nil
+	delete(m.clearedFields, ico.FieldLimits)
+}
+
+// SetPricing sets the "pricing" field.
+func (m *IcoMutation) SetPricing(s string) {
+	m.pricing = &s
+}
+
+// Pricing returns the value of the "pricing" field in the mutation.
+func (m *IcoMutation) Pricing() (r string, exists bool) {
+	v := m.pricing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPricing returns the old "pricing" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldPricing(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPricing is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPricing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPricing: %w", err)
+	}
+	return oldValue.Pricing, nil
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (m *IcoMutation) ClearPricing() {
+	m.pricing = nil
+	m.clearedFields[ico.FieldPricing] = struct{}{}
+}
+
+// PricingCleared returns if the "pricing" field was cleared in this mutation.
+func (m *IcoMutation) PricingCleared() bool {
+	_, ok := m.clearedFields[ico.FieldPricing]
+	return ok
+}
+
+// ResetPricing resets all changes to the "pricing" field.
+func (m *IcoMutation) ResetPricing() {
+	m.pricing = nil
+	delete(m.clearedFields, ico.FieldPricing)
+}
+
+// SetUnsold sets the "unsold" field.
+func (m *IcoMutation) SetUnsold(s string) {
+	m.unsold = &s
+}
+
+// Unsold returns the value of the "unsold" field in the mutation.
+func (m *IcoMutation) Unsold() (r string, exists bool) {
+	v := m.unsold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnsold returns the old "unsold" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldUnsold(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnsold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnsold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnsold: %w", err)
+	}
+	return oldValue.Unsold, nil
+}
+
+// ClearUnsold clears the value of the "unsold" field.
+func (m *IcoMutation) ClearUnsold() {
+	m.unsold = nil
+	m.clearedFields[ico.FieldUnsold] = struct{}{}
+}
+
+// UnsoldCleared returns if the "unsold" field was cleared in this mutation.
+func (m *IcoMutation) UnsoldCleared() bool {
+	_, ok := m.clearedFields[ico.FieldUnsold]
+	return ok
+}
+
+// ResetUnsold resets all changes to the "unsold" field.
+func (m *IcoMutation) ResetUnsold() {
+	m.unsold = nil
+	delete(m.clearedFields, ico.FieldUnsold)
+}
+
+// SetSoftCap sets the "soft_cap" field.
+func (m *IcoMutation) SetSoftCap(s string) {
+	m.soft_cap = &s
+}
+
+// SoftCap returns the value of the "soft_cap" field in the mutation.
+func (m *IcoMutation) SoftCap() (r string, exists bool) {
+	v := m.soft_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftCap returns the old "soft_cap" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldSoftCap(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftCap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftCap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftCap: %w", err)
+	}
+	return oldValue.SoftCap, nil
+}
+
+// ClearSoftCap clears the value of the "soft_cap" field.
+func (m *IcoMutation) ClearSoftCap() {
+	m.soft_cap = nil
+	m.clearedFields[ico.FieldSoftCap] = struct{}{}
+}
+
+// SoftCapCleared returns if the "soft_cap" field was cleared in this mutation.
+func (m *IcoMutation) SoftCapCleared() bool {
+	_, ok := m.clearedFields[ico.FieldSoftCap]
+	return ok
+}
+
+// ResetSoftCap resets all changes to the "soft_cap" field.
+func (m *IcoMutation) ResetSoftCap() {
+	m.soft_cap = nil
+	delete(m.clearedFields, ico.FieldSoftCap)
+}
+
+// SetHardCap sets the "hard_cap" field.
+func (m *IcoMutation) SetHardCap(s string) {
+	m.hard_cap = &s
+}
+
+// HardCap returns the value of the "hard_cap" field in the mutation.
+func (m *IcoMutation) HardCap() (r string, exists bool) {
+	v := m.hard_cap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardCap returns the old "hard_cap" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldHardCap(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardCap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardCap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardCap: %w", err)
+	}
+	return oldValue.HardCap, nil
+}
+
+// ClearHardCap clears the value of the "hard_cap" field.
+func (m *IcoMutation) ClearHardCap() {
+	m.hard_cap = nil
+	m.clearedFields[ico.FieldHardCap] = struct{}{}
+}
+
+// HardCapCleared returns if the "hard_cap" field was cleared in this mutation.
+func (m *IcoMutation) HardCapCleared() bool {
+	_, ok := m.clearedFields[ico.FieldHardCap]
+	return ok
+}
+
+// ResetHardCap resets all changes to the "hard_cap" field.
+func (m *IcoMutation) ResetHardCap() {
+	m.hard_cap = nil
+	delete(m.clearedFields, ico.FieldHardCap)
+}
+
+// SetStatus sets the "status" field.
+func (m *IcoMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IcoMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Ico entity.
+// If the Ico object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *IcoMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[ico.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *IcoMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[ico.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IcoMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, ico.FieldStatus)
+}
+
+// Where appends a list predicates to the IcoMutation builder.
+func (m *IcoMutation) Where(ps ...predicate.Ico) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ico, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Ico).
+func (m *IcoMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.created_at != nil {
+		fields = append(fields, ico.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ico.FieldUpdatedAt)
+	}
+	if m.round_id != nil {
+		fields = append(fields, ico.FieldRoundID)
+	}
+	if m.round_name != nil {
+		fields = append(fields, ico.FieldRoundName)
+	}
+	if m.price != nil {
+		fields = append(fields, ico.FieldPrice)
+	}
+	if m.num_token != nil {
+		fields = append(fields, ico.FieldNumToken)
+	}
+	if m.num_sub != nil {
+		fields = append(fields, ico.FieldNumSub)
+	}
+	if m.price_gap != nil {
+		fields = append(fields, ico.FieldPriceGap)
+	}
+	if m.lifetime != nil {
+		fields = append(fields, ico.FieldLifetime)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, ico.FieldEndedAt)
+	}
+	if m.limits != nil {
+		fields = append(fields, ico.FieldLimits)
+	}
+	if m.pricing != nil {
+		fields = append(fields, ico.FieldPricing)
+	}
+	if m.unsold != nil {
+		fields = append(fields, ico.FieldUnsold)
+	}
+	if m.soft_cap != nil {
+		fields = append(fields, ico.FieldSoftCap)
+	}
+	if m.hard_cap != nil {
+		fields = append(fields, ico.FieldHardCap)
+	}
+	if m.status != nil {
+		fields = append(fields, ico.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ico.FieldCreatedAt:
+		return m.CreatedAt()
+	case ico.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case ico.FieldRoundID:
+		return m.RoundID()
+	case ico.FieldRoundName:
+		return m.RoundName()
+	case ico.FieldPrice:
+		return m.Price()
+	case ico.FieldNumToken:
+		return m.NumToken()
+	case ico.FieldNumSub:
+		return m.NumSub()
+	case ico.FieldPriceGap:
+		return m.PriceGap()
+	case ico.FieldLifetime:
+		return m.Lifetime()
+	case ico.FieldEndedAt:
+		return m.EndedAt()
+	case ico.FieldLimits:
+		return m.Limits()
+	case ico.FieldPricing:
+		return m.Pricing()
+	case ico.FieldUnsold:
+		return m.Unsold()
+	case ico.FieldSoftCap:
+		return m.SoftCap()
+	case ico.FieldHardCap:
+		return m.HardCap()
+	case ico.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ico.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ico.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case ico.FieldRoundID:
+		return m.OldRoundID(ctx)
+	case ico.FieldRoundName:
+		return m.OldRoundName(ctx)
+	case ico.FieldPrice:
+		return m.OldPrice(ctx)
+	case ico.FieldNumToken:
+		return m.OldNumToken(ctx)
+	case ico.FieldNumSub:
+		return m.OldNumSub(ctx)
+	case ico.FieldPriceGap:
+		return m.OldPriceGap(ctx)
+	case ico.FieldLifetime:
+		return m.OldLifetime(ctx)
+	case ico.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case ico.FieldLimits:
+		return m.OldLimits(ctx)
+	case ico.FieldPricing:
+		return m.OldPricing(ctx)
+	case ico.FieldUnsold:
+		return m.OldUnsold(ctx)
+	case ico.FieldSoftCap:
+		return m.OldSoftCap(ctx)
+	case ico.FieldHardCap:
+		return m.OldHardCap(ctx)
+	case ico.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ico field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ico.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ico.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case ico.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoundID(v)
+		return nil
+	case ico.FieldRoundName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoundName(v)
+		return nil
+	case ico.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case ico.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	case ico.FieldNumSub:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumSub(v)
+		return nil
+	case ico.FieldPriceGap:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceGap(v)
+		return nil
+	case ico.FieldLifetime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLifetime(v)
+		return nil
+	case ico.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case ico.FieldLimits:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLimits(v)
+		return nil
+	case ico.FieldPricing:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPricing(v)
+		return nil
+	case ico.FieldUnsold:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnsold(v)
+		return nil
+	case ico.FieldSoftCap:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftCap(v)
+		return nil
+	case ico.FieldHardCap:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardCap(v)
+		return nil
+	case ico.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ico field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoMutation) AddedFields() []string {
+	var fields []string
+	if m.addround_id != nil {
+		fields = append(fields, ico.FieldRoundID)
+	}
+	if m.addnum_sub != nil {
+		fields = append(fields, ico.FieldNumSub)
+	}
+	if m.addlifetime != nil {
+		fields = append(fields, ico.FieldLifetime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ico.FieldRoundID:
+		return m.AddedRoundID()
+	case ico.FieldNumSub:
+		return m.AddedNumSub()
+	case ico.FieldLifetime:
+		return m.AddedLifetime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ico.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoundID(v)
+		return nil
+	case ico.FieldNumSub:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumSub(v)
+		return nil
+	case ico.FieldLifetime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLifetime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ico numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ico.FieldEndedAt) {
+		fields = append(fields, ico.FieldEndedAt)
+	}
+	if m.FieldCleared(ico.FieldLimits) {
+		fields = append(fields, ico.FieldLimits)
+	}
+	if m.FieldCleared(ico.FieldPricing) {
+		fields = append(fields, ico.FieldPricing)
+	}
+	if m.FieldCleared(ico.FieldUnsold) {
+		fields = append(fields, ico.FieldUnsold)
+	}
+	if m.FieldCleared(ico.FieldSoftCap) {
+		fields = append(fields, ico.FieldSoftCap)
+	}
+	if m.FieldCleared(ico.FieldHardCap) {
+		fields = append(fields, ico.FieldHardCap)
+	}
+	if m.FieldCleared(ico.FieldStatus) {
+		fields = append(fields, ico.FieldStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoMutation) ClearField(name string) error {
+	switch name {
+	case ico.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	case ico.FieldLimits:
+		m.ClearLimits()
+		return nil
+	case ico.FieldPricing:
+		m.ClearPricing()
+		return nil
+	case ico.FieldUnsold:
+		m.ClearUnsold()
+		return nil
+	case ico.FieldSoftCap:
+		m.ClearSoftCap()
+		return nil
+	case ico.FieldHardCap:
+		m.ClearHardCap()
+		return nil
+	case ico.FieldStatus:
+		m.ClearStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Ico nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoMutation) ResetField(name string) error {
+	switch name {
+	case ico.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ico.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case ico.FieldRoundID:
+		m.ResetRoundID()
+		return nil
+	case ico.FieldRoundName:
+		m.ResetRoundName()
+		return nil
+	case ico.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case ico.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	case ico.FieldNumSub:
+		m.ResetNumSub()
+		return nil
+	case ico.FieldPriceGap:
+		m.ResetPriceGap()
+		return nil
+	case ico.FieldLifetime:
+		m.ResetLifetime()
+		return nil
+	case ico.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case ico.FieldLimits:
+		m.ResetLimits()
+		return nil
+	case ico.FieldPricing:
+		m.ResetPricing()
+		return nil
+	case ico.FieldUnsold:
+		m.ResetUnsold()
+		return nil
+	case ico.FieldSoftCap:
+		m.ResetSoftCap()
+		return nil
+	case ico.FieldHardCap:
+		m.ResetHardCap()
+		return nil
+	case ico.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Ico field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Ico unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Ico edge %s", name)
+}
+
+// IcoCouponMutation represents an operation that mutates the IcoCoupon nodes in the graph.
+type IcoCouponMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *string
+	coupon        *string
+	reward        *string
+	cashback      *string
+	starts_at     *time.Time
+	ends_at       *time.Time
+	max_uses      *int
+	addmax_uses   *int
+	used          *int
+	addused       *int
+	single_use    *bool
+	min_purchase  *string
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IcoCoupon, error)
+	predicates    []predicate.IcoCoupon
+}
+
+var _ ent.Mutation = (*IcoCouponMutation)(nil)
+
+// icocouponOption allows management of the mutation configuration using functional options.
+type icocouponOption func(*IcoCouponMutation)
+
+// newIcoCouponMutation creates new mutation for the IcoCoupon entity.
+func newIcoCouponMutation(c config, op Op, opts ...icocouponOption) *IcoCouponMutation {
+	m := &IcoCouponMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoCoupon,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoCouponID sets the ID field of the mutation.
+func withIcoCouponID(id xid.ID) icocouponOption {
+	return func(m *IcoCouponMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoCoupon
+		)
+		m.oldValue = func(ctx context.Context) (*IcoCoupon, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoCoupon.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoCoupon sets the old IcoCoupon of the mutation.
+func withIcoCoupon(node *IcoCoupon) icocouponOption {
+	return func(m *IcoCouponMutation) {
+		m.oldValue = func(context.Context) (*IcoCoupon, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoCouponMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoCouponMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoCoupon entities.
+func (m *IcoCouponMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoCouponMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoCouponMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoCoupon.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoCouponMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoCouponMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoCouponMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoCouponMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoCouponMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoCouponMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IcoCouponMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IcoCouponMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IcoCouponMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetCoupon sets the "coupon" field.
+func (m *IcoCouponMutation) SetCoupon(s string) {
+	m.coupon = &s
+}
+
+// Coupon returns the value of the "coupon" field in the mutation.
+func (m *IcoCouponMutation) Coupon() (r string, exists bool) {
+	v := m.coupon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoupon returns the old "coupon" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldCoupon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoupon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoupon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoupon: %w", err)
+	}
+	return oldValue.Coupon, nil
+}
+
+// ResetCoupon resets all changes to the "coupon" field.
+func (m *IcoCouponMutation) ResetCoupon() {
+	m.coupon = nil
+}
+
+// SetReward sets the "reward" field.
+func (m *IcoCouponMutation) SetReward(s string) {
+	m.reward = &s
+}
+
+// Reward returns the value of the "reward" field in the mutation.
+func (m *IcoCouponMutation) Reward() (r string, exists bool) {
+	v := m.reward
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReward returns the old "reward" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldReward(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReward is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReward requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReward: %w", err)
+	}
+	return oldValue.Reward, nil
+}
+
+// ResetReward resets all changes to the "reward" field.
+func (m *IcoCouponMutation) ResetReward() {
+	m.reward = nil
+}
+
+// SetCashback sets the "cashback" field.
+func (m *IcoCouponMutation) SetCashback(s string) {
+	m.cashback = &s
+}
+
+// Cashback returns the value of the "cashback" field in the mutation.
+func (m *IcoCouponMutation) Cashback() (r string, exists bool) {
+	v := m.cashback
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCashback returns the old "cashback" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldCashback(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCashback is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCashback requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCashback: %w", err)
+	}
+	return oldValue.Cashback, nil
+}
+
+// ResetCashback resets all changes to the "cashback" field.
+func (m *IcoCouponMutation) ResetCashback() {
+	m.cashback = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *IcoCouponMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *IcoCouponMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldStartsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (m *IcoCouponMutation) ClearStartsAt() {
+	m.starts_at = nil
+	m.clearedFields[icocoupon.FieldStartsAt] = struct{}{}
+}
+
+// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
+func (m *IcoCouponMutation) StartsAtCleared() bool {
+	_, ok := m.clearedFields[icocoupon.FieldStartsAt]
+	return ok
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *IcoCouponMutation) ResetStartsAt() {
+	m.starts_at = nil
+	delete(m.clearedFields, icocoupon.FieldStartsAt)
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *IcoCouponMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *IcoCouponMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldEndsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *IcoCouponMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[icocoupon.FieldEndsAt] = struct{}{}
+}
+
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *IcoCouponMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[icocoupon.FieldEndsAt]
+	return ok
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *IcoCouponMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, icocoupon.FieldEndsAt)
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *IcoCouponMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *IcoCouponMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *IcoCouponMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *IcoCouponMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *IcoCouponMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+}
+
+// SetUsed sets the "used" field.
+func (m *IcoCouponMutation) SetUsed(i int) {
+	m.used = &i
+	m.addused = nil
+}
+
+// Used returns the value of the "used" field in the mutation.
+func (m *IcoCouponMutation) Used() (r int, exists bool) {
+	v := m.used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsed returns the old "used" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldUsed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
+	}
+	return oldValue.Used, nil
+}
+
+// AddUsed adds i to the "used" field.
+func (m *IcoCouponMutation) AddUsed(i int) {
+	if m.addused != nil {
+		*m.addused += i
+	} else {
+		m.addused = &i
+	}
+}
+
+// AddedUsed returns the value that was added to the "used" field in this mutation.
+func (m *IcoCouponMutation) AddedUsed() (r int, exists bool) {
+	v := m.addused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUsed resets all changes to the "used" field.
+func (m *IcoCouponMutation) ResetUsed() {
+	m.used = nil
+	m.addused = nil
+}
+
+// SetSingleUse sets the "single_use" field.
+func (m *IcoCouponMutation) SetSingleUse(b bool) {
+	m.single_use = &b
+}
+
+// SingleUse returns the value of the "single_use" field in the mutation.
+func (m *IcoCouponMutation) SingleUse() (r bool, exists bool) {
+	v := m.single_use
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSingleUse returns the old "single_use" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldSingleUse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSingleUse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSingleUse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSingleUse: %w", err)
+	}
+	return oldValue.SingleUse, nil
+}
+
+// ResetSingleUse resets all changes to the "single_use" field.
+func (m *IcoCouponMutation) ResetSingleUse() {
+	m.single_use = nil
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (m *IcoCouponMutation) SetMinPurchase(s string) {
+	m.min_purchase = &s
+}
+
+// MinPurchase returns the value of the "min_purchase" field in the mutation.
+func (m *IcoCouponMutation) MinPurchase() (r string, exists bool) {
+	v := m.min_purchase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinPurchase returns the old "min_purchase" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldMinPurchase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinPurchase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinPurchase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinPurchase: %w", err)
+	}
+	return oldValue.MinPurchase, nil
+}
+
+// ClearMinPurchase clears the value of the "min_purchase" field.
+func (m *IcoCouponMutation) ClearMinPurchase() {
+	m.min_purchase = nil
+	m.clearedFields[icocoupon.FieldMinPurchase] = struct{}{}
+}
+
+// MinPurchaseCleared returns if the "min_purchase" field was cleared in this mutation.
+func (m *IcoCouponMutation) MinPurchaseCleared() bool {
+	_, ok := m.clearedFields[icocoupon.FieldMinPurchase]
+	return ok
+}
+
+// ResetMinPurchase resets all changes to the "min_purchase" field.
+func (m *IcoCouponMutation) ResetMinPurchase() {
+	m.min_purchase = nil
+	delete(m.clearedFields, icocoupon.FieldMinPurchase)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *IcoCouponMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *IcoCouponMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the IcoCoupon entity.
+// If the IcoCoupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *IcoCouponMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[icocoupon.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *IcoCouponMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[icocoupon.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *IcoCouponMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, icocoupon.FieldDeletedAt)
+}
+
+// Where appends a list predicates to the IcoCouponMutation builder.
+func (m *IcoCouponMutation) Where(ps ...predicate.IcoCoupon) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoCouponMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoCouponMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoCoupon, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoCouponMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoCouponMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoCoupon).
+func (m *IcoCouponMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoCouponMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, icocoupon.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icocoupon.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, icocoupon.FieldUserID)
+	}
+	if m.coupon != nil {
+		fields = append(fields, icocoupon.FieldCoupon)
+	}
+	if m.reward != nil {
+		fields = append(fields, icocoupon.FieldReward)
+	}
+	if m.cashback != nil {
+		fields = append(fields, icocoupon.FieldCashback)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, icocoupon.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, icocoupon.FieldEndsAt)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, icocoupon.FieldMaxUses)
+	}
+	if m.used != nil {
+		fields = append(fields, icocoupon.FieldUsed)
+	}
+	if m.single_use != nil {
+		fields = append(fields, icocoupon.FieldSingleUse)
+	}
+	if m.min_purchase != nil {
+		fields = append(fields, icocoupon.FieldMinPurchase)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, icocoupon.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoCouponMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icocoupon.FieldCreatedAt:
+		return m.CreatedAt()
+	case icocoupon.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icocoupon.FieldUserID:
+		return m.UserID()
+	case icocoupon.FieldCoupon:
+		return m.Coupon()
+	case icocoupon.FieldReward:
+		return m.Reward()
+	case icocoupon.FieldCashback:
+		return m.Cashback()
+	case icocoupon.FieldStartsAt:
+		return m.StartsAt()
+	case icocoupon.FieldEndsAt:
+		return m.EndsAt()
+	case icocoupon.FieldMaxUses:
+		return m.MaxUses()
+	case icocoupon.FieldUsed:
+		return m.Used()
+	case icocoupon.FieldSingleUse:
+		return m.SingleUse()
+	case icocoupon.FieldMinPurchase:
+		return m.MinPurchase()
+	case icocoupon.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoCouponMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icocoupon.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icocoupon.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icocoupon.FieldUserID:
+		return m.OldUserID(ctx)
+	case icocoupon.FieldCoupon:
+		return m.OldCoupon(ctx)
+	case icocoupon.FieldReward:
+		return m.OldReward(ctx)
+	case icocoupon.FieldCashback:
+		return m.OldCashback(ctx)
+	case icocoupon.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case icocoupon.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case icocoupon.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case icocoupon.FieldUsed:
+		return m.OldUsed(ctx)
+	case icocoupon.FieldSingleUse:
+		return m.OldSingleUse(ctx)
+	case icocoupon.FieldMinPurchase:
+		return m.OldMinPurchase(ctx)
+	case icocoupon.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoCoupon field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoCouponMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icocoupon.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icocoupon.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icocoupon.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case icocoupon.FieldCoupon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoupon(v)
+		return nil
+	case icocoupon.FieldReward:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReward(v)
+		return nil
+	case icocoupon.FieldCashback:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCashback(v)
+		return nil
+	case icocoupon.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case icocoupon.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case icocoupon.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case icocoupon.FieldUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsed(v)
+		return nil
+	case icocoupon.FieldSingleUse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSingleUse(v)
+		return nil
+	case icocoupon.FieldMinPurchase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinPurchase(v)
+		return nil
+	case icocoupon.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCoupon field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoCouponMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, icocoupon.FieldMaxUses)
+	}
+	if m.addused != nil {
+		fields = append(fields, icocoupon.FieldUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoCouponMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case icocoupon.FieldMaxUses:
+		return m.AddedMaxUses()
+	case icocoupon.FieldUsed:
+		return m.AddedUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoCouponMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case icocoupon.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case icocoupon.FieldUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCoupon numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoCouponMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(icocoupon.FieldStartsAt) {
+		fields = append(fields, icocoupon.FieldStartsAt)
+	}
+	if m.FieldCleared(icocoupon.FieldEndsAt) {
+		fields = append(fields, icocoupon.FieldEndsAt)
+	}
+	if m.FieldCleared(icocoupon.FieldMinPurchase) {
+		fields = append(fields, icocoupon.FieldMinPurchase)
+	}
+	if m.FieldCleared(icocoupon.FieldDeletedAt) {
+		fields = append(fields, icocoupon.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoCouponMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoCouponMutation) ClearField(name string) error {
+	switch name {
+	case icocoupon.FieldStartsAt:
+		m.ClearStartsAt()
+		return nil
+	case icocoupon.FieldEndsAt:
+		m.ClearEndsAt()
+		return nil
+	case icocoupon.FieldMinPurchase:
+		m.ClearMinPurchase()
+		return nil
+	case icocoupon.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCoupon nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoCouponMutation) ResetField(name string) error {
+	switch name {
+	case icocoupon.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icocoupon.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icocoupon.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case icocoupon.FieldCoupon:
+		m.ResetCoupon()
+		return nil
+	case icocoupon.FieldReward:
+		m.ResetReward()
+		return nil
+	case icocoupon.FieldCashback:
+		m.ResetCashback()
+		return nil
+	case icocoupon.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case icocoupon.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case icocoupon.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case icocoupon.FieldUsed:
+		m.ResetUsed()
+		return nil
+	case icocoupon.FieldSingleUse:
+		m.ResetSingleUse()
+		return nil
+	case icocoupon.FieldMinPurchase:
+		m.ResetMinPurchase()
+		return nil
+	case icocoupon.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCoupon field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoCouponMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoCouponMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoCouponMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoCouponMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoCouponMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoCouponMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoCouponMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoCoupon unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoCouponMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoCoupon edge %s", name)
+}
+
+// IcoCouponRedemptionMutation represents an operation that mutates the IcoCouponRedemption nodes in the graph.
+type IcoCouponRedemptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	coupon_id     *xid.ID
+	coupon        *string
+	user_id       *string
+	source_id     *string
+	amount        *string
+	symbol        *string
+	reward        *string
+	cashback      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IcoCouponRedemption, error)
+	predicates    []predicate.IcoCouponRedemption
+}
+
+var _ ent.Mutation = (*IcoCouponRedemptionMutation)(nil)
+
+// icocouponredemptionOption allows management of the mutation configuration using functional options.
+type icocouponredemptionOption func(*IcoCouponRedemptionMutation)
+
+// newIcoCouponRedemptionMutation creates new mutation for the IcoCouponRedemption entity.
+func newIcoCouponRedemptionMutation(c config, op Op, opts ...icocouponredemptionOption) *IcoCouponRedemptionMutation {
+	m := &IcoCouponRedemptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoCouponRedemption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoCouponRedemptionID sets the ID field of the mutation.
+func withIcoCouponRedemptionID(id xid.ID) icocouponredemptionOption {
+	return func(m *IcoCouponRedemptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoCouponRedemption
+		)
+		m.oldValue = func(ctx context.Context) (*IcoCouponRedemption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoCouponRedemption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoCouponRedemption sets the old IcoCouponRedemption of the mutation.
+func withIcoCouponRedemption(node *IcoCouponRedemption) icocouponredemptionOption {
+	return func(m *IcoCouponRedemptionMutation) {
+		m.oldValue = func(context.Context) (*IcoCouponRedemption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoCouponRedemptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoCouponRedemptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoCouponRedemption entities.
+func (m *IcoCouponRedemptionMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoCouponRedemptionMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoCouponRedemptionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoCouponRedemption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoCouponRedemptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoCouponRedemptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoCouponRedemptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoCouponRedemptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoCouponRedemptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoCouponRedemptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (m *IcoCouponRedemptionMutation) SetCouponID(x xid.ID) {
+	m.coupon_id = &x
+}
+
+// CouponID returns the value of the "coupon_id" field in the mutation.
+func (m *IcoCouponRedemptionMutation) CouponID() (r xid.ID, exists bool) {
+	v := m.coupon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponID returns the old "coupon_id" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldCouponID(ctx context.Context) (v xid.ID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponID: %w", err)
+	}
+	return oldValue.CouponID, nil
+}
+
+// ResetCouponID resets all changes to the "coupon_id" field.
+func (m *IcoCouponRedemptionMutation) ResetCouponID() {
+	m.coupon_id = nil
+}
+
+// SetCoupon sets the "coupon" field.
+func (m *IcoCouponRedemptionMutation) SetCoupon(s string) {
+	m.coupon = &s
+}
+
+// Coupon returns the value of the "coupon" field in the mutation.
+func (m *IcoCouponRedemptionMutation) Coupon() (r string, exists bool) {
+	v := m.coupon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoupon returns the old "coupon" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldCoupon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoupon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoupon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoupon: %w", err)
+	}
+	return oldValue.Coupon, nil
+}
+
+// ResetCoupon resets all changes to the "coupon" field.
+func (m *IcoCouponRedemptionMutation) ResetCoupon() {
+	m.coupon = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IcoCouponRedemptionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IcoCouponRedemptionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IcoCouponRedemptionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *IcoCouponRedemptionMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *IcoCouponRedemptionMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *IcoCouponRedemptionMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *IcoCouponRedemptionMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *IcoCouponRedemptionMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *IcoCouponRedemptionMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *IcoCouponRedemptionMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *IcoCouponRedemptionMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *IcoCouponRedemptionMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetReward sets the "reward" field.
+func (m *IcoCouponRedemptionMutation) SetReward(s string) {
+	m.reward = &s
+}
+
+// Reward returns the value of the "reward" field in the mutation.
+func (m *IcoCouponRedemptionMutation) Reward() (r string, exists bool) {
+	v := m.reward
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReward returns the old "reward" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldReward(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReward is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReward requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReward: %w", err)
+	}
+	return oldValue.Reward, nil
+}
+
+// ResetReward resets all changes to the "reward" field.
+func (m *IcoCouponRedemptionMutation) ResetReward() {
+	m.reward = nil
+}
+
+// SetCashback sets the "cashback" field.
+func (m *IcoCouponRedemptionMutation) SetCashback(s string) {
+	m.cashback = &s
+}
+
+// Cashback returns the value of the "cashback" field in the mutation.
+func (m *IcoCouponRedemptionMutation) Cashback() (r string, exists bool) {
+	v := m.cashback
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCashback returns the old "cashback" field's value of the IcoCouponRedemption entity.
+// If the IcoCouponRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoCouponRedemptionMutation) OldCashback(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCashback is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCashback requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCashback: %w", err)
+	}
+	return oldValue.Cashback, nil
+}
+
+// ResetCashback resets all changes to the "cashback" field.
+func (m *IcoCouponRedemptionMutation) ResetCashback() {
+	m.cashback = nil
+}
+
+// Where appends a list predicates to the IcoCouponRedemptionMutation builder.
+func (m *IcoCouponRedemptionMutation) Where(ps ...predicate.IcoCouponRedemption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoCouponRedemptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoCouponRedemptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoCouponRedemption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoCouponRedemptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoCouponRedemptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoCouponRedemption).
+func (m *IcoCouponRedemptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoCouponRedemptionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, icocouponredemption.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icocouponredemption.FieldUpdatedAt)
+	}
+	if m.coupon_id != nil {
+		fields = append(fields, icocouponredemption.FieldCouponID)
+	}
+	if m.coupon != nil {
+		fields = append(fields, icocouponredemption.FieldCoupon)
+	}
+	if m.user_id != nil {
+		fields = append(fields, icocouponredemption.FieldUserID)
+	}
+	if m.source_id != nil {
+		fields = append(fields, icocouponredemption.FieldSourceID)
+	}
+	if m.amount != nil {
+		fields = append(fields, icocouponredemption.FieldAmount)
+	}
+	if m.symbol != nil {
+		fields = append(fields, icocouponredemption.FieldSymbol)
+	}
+	if m.reward != nil {
+		fields = append(fields, icocouponredemption.FieldReward)
+	}
+	if m.cashback != nil {
+		fields = append(fields, icocouponredemption.FieldCashback)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoCouponRedemptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icocouponredemption.FieldCreatedAt:
+		return m.CreatedAt()
+	case icocouponredemption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icocouponredemption.FieldCouponID:
+		return m.CouponID()
+	case icocouponredemption.FieldCoupon:
+		return m.Coupon()
+	case icocouponredemption.FieldUserID:
+		return m.UserID()
+	case icocouponredemption.FieldSourceID:
+		return m.SourceID()
+	case icocouponredemption.FieldAmount:
+		return m.Amount()
+	case icocouponredemption.FieldSymbol:
+		return m.Symbol()
+	case icocouponredemption.FieldReward:
+		return m.Reward()
+	case icocouponredemption.FieldCashback:
+		return m.Cashback()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoCouponRedemptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icocouponredemption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icocouponredemption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icocouponredemption.FieldCouponID:
+		return m.OldCouponID(ctx)
+	case icocouponredemption.FieldCoupon:
+		return m.OldCoupon(ctx)
+	case icocouponredemption.FieldUserID:
+		return m.OldUserID(ctx)
+	case icocouponredemption.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case icocouponredemption.FieldAmount:
+		return m.OldAmount(ctx)
+	case icocouponredemption.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case icocouponredemption.FieldReward:
+		return m.OldReward(ctx)
+	case icocouponredemption.FieldCashback:
+		return m.OldCashback(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoCouponRedemption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoCouponRedemptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icocouponredemption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icocouponredemption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icocouponredemption.FieldCouponID:
+		v, ok := value.(xid.ID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponID(v)
+		return nil
+	case icocouponredemption.FieldCoupon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoupon(v)
+		return nil
+	case icocouponredemption.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case icocouponredemption.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case icocouponredemption.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case icocouponredemption.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case icocouponredemption.FieldReward:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReward(v)
+		return nil
+	case icocouponredemption.FieldCashback:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCashback(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCouponRedemption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoCouponRedemptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoCouponRedemptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoCouponRedemptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IcoCouponRedemption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoCouponRedemptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoCouponRedemptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoCouponRedemptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IcoCouponRedemption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoCouponRedemptionMutation) ResetField(name string) error {
+	switch name {
+	case icocouponredemption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icocouponredemption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icocouponredemption.FieldCouponID:
+		m.ResetCouponID()
+		return nil
+	case icocouponredemption.FieldCoupon:
+		m.ResetCoupon()
+		return nil
+	case icocouponredemption.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case icocouponredemption.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case icocouponredemption.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case icocouponredemption.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case icocouponredemption.FieldReward:
+		m.ResetReward()
+		return nil
+	case icocouponredemption.FieldCashback:
+		m.ResetCashback()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoCouponRedemption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoCouponRedemptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoCouponRedemptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoCouponRedemptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoCouponRedemptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoCouponRedemptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoCouponRedemptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoCouponRedemptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoCouponRedemption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoCouponRedemptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoCouponRedemption edge %s", name)
+}
+
+// IcoDailyStatMutation represents an operation that mutates the IcoDailyStat nodes in the graph.
+type IcoDailyStatMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *xid.ID
+	created_at          *time.Time
+	updated_at          *time.Time
+	day                 *time.Time
+	purchases           *int
+	addpurchases        *int
+	buyers              *int
+	addbuyers           *int
+	num_token           *string
+	raised              *string
+	coupon_purchases    *int
+	addcoupon_purchases *int
+	coupon_buyers       *int
+	addcoupon_buyers    *int
+	coupon_num_token    *string
+	coupon_raised       *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*IcoDailyStat, error)
+	predicates          []predicate.IcoDailyStat
+}
+
+var _ ent.Mutation = (*IcoDailyStatMutation)(nil)
+
+// icodailystatOption allows management of the mutation configuration using functional options.
+type icodailystatOption func(*IcoDailyStatMutation)
+
+// newIcoDailyStatMutation creates new mutation for the IcoDailyStat entity.
+func newIcoDailyStatMutation(c config, op Op, opts ...icodailystatOption) *IcoDailyStatMutation {
+	m := &IcoDailyStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoDailyStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoDailyStatID sets the ID field of the mutation.
+func withIcoDailyStatID(id xid.ID) icodailystatOption {
+	return func(m *IcoDailyStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoDailyStat
+		)
+		m.oldValue = func(ctx context.Context) (*IcoDailyStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoDailyStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoDailyStat sets the old IcoDailyStat of the mutation.
+func withIcoDailyStat(node *IcoDailyStat) icodailystatOption {
+	return func(m *IcoDailyStatMutation) {
+		m.oldValue = func(context.Context) (*IcoDailyStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoDailyStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoDailyStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoDailyStat entities.
+func (m *IcoDailyStatMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoDailyStatMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoDailyStatMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoDailyStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoDailyStatMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoDailyStatMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoDailyStatMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoDailyStatMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoDailyStatMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoDailyStatMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDay sets the "day" field.
+func (m *IcoDailyStatMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *IcoDailyStatMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *IcoDailyStatMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetPurchases sets the "purchases" field.
+func (m *IcoDailyStatMutation) SetPurchases(i int) {
+	m.purchases = &i
+	m.addpurchases = nil
+}
+
+// Purchases returns the value of the "purchases" field in the mutation.
+func (m *IcoDailyStatMutation) Purchases() (r int, exists bool) {
+	v := m.purchases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchases returns the old "purchases" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldPurchases(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchases: %w", err)
+	}
+	return oldValue.Purchases, nil
+}
+
+// AddPurchases adds i to the "purchases" field.
+func (m *IcoDailyStatMutation) AddPurchases(i int) {
+	if m.addpurchases != nil {
+		*m.addpurchases += i
+	} else {
+		m.addpurchases = &i
+	}
+}
+
+// AddedPurchases returns the value that was added to the "purchases" field in this mutation.
+func (m *IcoDailyStatMutation) AddedPurchases() (r int, exists bool) {
+	v := m.addpurchases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPurchases resets all changes to the "purchases" field.
+func (m *IcoDailyStatMutation) ResetPurchases() {
+	m.purchases = nil
+	m.addpurchases = nil
+}
+
+// SetBuyers sets the "buyers" field.
+func (m *IcoDailyStatMutation) SetBuyers(i int) {
+	m.buyers = &i
+	m.addbuyers = nil
+}
+
+// Buyers returns the value of the "buyers" field in the mutation.
+func (m *IcoDailyStatMutation) Buyers() (r int, exists bool) {
+	v := m.buyers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyers returns the old "buyers" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldBuyers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyers: %w", err)
+	}
+	return oldValue.Buyers, nil
+}
+
+// AddBuyers adds i to the "buyers" field.
+func (m *IcoDailyStatMutation) AddBuyers(i int) {
+	if m.addbuyers != nil {
+		*m.addbuyers += i
+	} else {
+		m.addbuyers = &i
+	}
+}
+
+// AddedBuyers returns the value that was added to the "buyers" field in this mutation.
+func (m *IcoDailyStatMutation) AddedBuyers() (r int, exists bool) {
+	v := m.addbuyers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBuyers resets all changes to the "buyers" field.
+func (m *IcoDailyStatMutation) ResetBuyers() {
+	m.buyers = nil
+	m.addbuyers = nil
+}
+
+// SetNumToken sets the "num_token" field.
+func (m *IcoDailyStatMutation) SetNumToken(s string) {
+	m.num_token = &s
+}
+
+// NumToken returns the value of the "num_token" field in the mutation.
+func (m *IcoDailyStatMutation) NumToken() (r string, exists bool) {
+	v := m.num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumToken returns the old "num_token" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+	}
+	return oldValue.NumToken, nil
+}
+
+// ResetNumToken resets all changes to the "num_token" field.
+func (m *IcoDailyStatMutation) ResetNumToken() {
+	m.num_token = nil
+}
+
+// SetRaised sets the "raised" field.
+func (m *IcoDailyStatMutation) SetRaised(s string) {
+	m.raised = &s
+}
+
+// Raised returns the value of the "raised" field in the mutation.
+func (m *IcoDailyStatMutation) Raised() (r string, exists bool) {
+	v := m.raised
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRaised returns the old "raised" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldRaised(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRaised is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRaised requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRaised: %w", err)
+	}
+	return oldValue.Raised, nil
+}
+
+// ResetRaised resets all changes to the "raised" field.
+func (m *IcoDailyStatMutation) ResetRaised() {
+	m.raised = nil
+}
+
+// SetCouponPurchases sets the "coupon_purchases" field.
+func (m *IcoDailyStatMutation) SetCouponPurchases(i int) {
+	m.coupon_purchases = &i
+	m.addcoupon_purchases = nil
+}
+
+// CouponPurchases returns the value of the "coupon_purchases" field in the mutation.
+func (m *IcoDailyStatMutation) CouponPurchases() (r int, exists bool) {
+	v := m.coupon_purchases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponPurchases returns the old "coupon_purchases" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldCouponPurchases(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponPurchases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponPurchases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponPurchases: %w", err)
+	}
+	return oldValue.CouponPurchases, nil
+}
+
+// AddCouponPurchases adds i to the "coupon_purchases" field.
+func (m *IcoDailyStatMutation) AddCouponPurchases(i int) {
+	if m.addcoupon_purchases != nil {
+		*m.addcoupon_purchases += i
+	} else {
+		m.addcoupon_purchases = &i
+	}
+}
+
+// AddedCouponPurchases returns the value that was added to the "coupon_purchases" field in this mutation.
+func (m *IcoDailyStatMutation) AddedCouponPurchases() (r int, exists bool) {
+	v := m.addcoupon_purchases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCouponPurchases resets all changes to the "coupon_purchases" field.
+func (m *IcoDailyStatMutation) ResetCouponPurchases() {
+	m.coupon_purchases = nil
+	m.addcoupon_purchases = nil
+}
+
+// SetCouponBuyers sets the "coupon_buyers" field.
+func (m *IcoDailyStatMutation) SetCouponBuyers(i int) {
+	m.coupon_buyers = &i
+	m.addcoupon_buyers = nil
+}
+
+// CouponBuyers returns the value of the "coupon_buyers" field in the mutation.
+func (m *IcoDailyStatMutation) CouponBuyers() (r int, exists bool) {
+	v := m.coupon_buyers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponBuyers returns the old "coupon_buyers" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldCouponBuyers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponBuyers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponBuyers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponBuyers: %w", err)
+	}
+	return oldValue.CouponBuyers, nil
+}
+
+// AddCouponBuyers adds i to the "coupon_buyers" field.
+func (m *IcoDailyStatMutation) AddCouponBuyers(i int) {
+	if m.addcoupon_buyers != nil {
+		*m.addcoupon_buyers += i
+	} else {
+		m.addcoupon_buyers = &i
+	}
+}
+
+// AddedCouponBuyers returns the value that was added to the "coupon_buyers" field in this mutation.
+func (m *IcoDailyStatMutation) AddedCouponBuyers() (r int, exists bool) {
+	v := m.addcoupon_buyers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCouponBuyers resets all changes to the "coupon_buyers" field.
+func (m *IcoDailyStatMutation) ResetCouponBuyers() {
+	m.coupon_buyers = nil
+	m.addcoupon_buyers = nil
+}
+
+// SetCouponNumToken sets the "coupon_num_token" field.
+func (m *IcoDailyStatMutation) SetCouponNumToken(s string) {
+	m.coupon_num_token = &s
+}
+
+// CouponNumToken returns the value of the "coupon_num_token" field in the mutation.
+func (m *IcoDailyStatMutation) CouponNumToken() (r string, exists bool) {
+	v := m.coupon_num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponNumToken returns the old "coupon_num_token" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldCouponNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponNumToken: %w", err)
+	}
+	return oldValue.CouponNumToken, nil
+}
+
+// ResetCouponNumToken resets all changes to the "coupon_num_token" field.
+func (m *IcoDailyStatMutation) ResetCouponNumToken() {
+	m.coupon_num_token = nil
+}
+
+// SetCouponRaised sets the "coupon_raised" field.
+func (m *IcoDailyStatMutation) SetCouponRaised(s string) {
+	m.coupon_raised = &s
+}
+
+// CouponRaised returns the value of the "coupon_raised" field in the mutation.
+func (m *IcoDailyStatMutation) CouponRaised() (r string, exists bool) {
+	v := m.coupon_raised
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponRaised returns the old "coupon_raised" field's value of the IcoDailyStat entity.
+// If the IcoDailyStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoDailyStatMutation) OldCouponRaised(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponRaised is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponRaised requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponRaised: %w", err)
+	}
+	return oldValue.CouponRaised, nil
+}
+
+// ResetCouponRaised resets all changes to the "coupon_raised" field.
+func (m *IcoDailyStatMutation) ResetCouponRaised() {
+	m.coupon_raised = nil
+}
+
+// Where appends a list predicates to the IcoDailyStatMutation builder.
+func (m *IcoDailyStatMutation) Where(ps ...predicate.IcoDailyStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoDailyStatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoDailyStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoDailyStat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoDailyStatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoDailyStatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoDailyStat).
+func (m *IcoDailyStatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoDailyStatMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, icodailystat.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icodailystat.FieldUpdatedAt)
+	}
+	if m.day != nil {
+		fields = append(fields, icodailystat.FieldDay)
+	}
+	if m.purchases != nil {
+		fields = append(fields, icodailystat.FieldPurchases)
+	}
+	if m.buyers != nil {
+		fields = append(fields, icodailystat.FieldBuyers)
+	}
+	if m.num_token != nil {
+		fields = append(fields, icodailystat.FieldNumToken)
+	}
+	if m.raised != nil {
+		fields = append(fields, icodailystat.FieldRaised)
+	}
+	if m.coupon_purchases != nil {
+		fields = append(fields, icodailystat.FieldCouponPurchases)
+	}
+	if m.coupon_buyers != nil {
+		fields = append(fields, icodailystat.FieldCouponBuyers)
+	}
+	if m.coupon_num_token != nil {
+		fields = append(fields, icodailystat.FieldCouponNumToken)
+	}
+	if m.coupon_raised != nil {
+		fields = append(fields, icodailystat.FieldCouponRaised)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoDailyStatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icodailystat.FieldCreatedAt:
+		return m.CreatedAt()
+	case icodailystat.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icodailystat.FieldDay:
+		return m.Day()
+	case icodailystat.FieldPurchases:
+		return m.Purchases()
+	case icodailystat.FieldBuyers:
+		return m.Buyers()
+	case icodailystat.FieldNumToken:
+		return m.NumToken()
+	case icodailystat.FieldRaised:
+		return m.Raised()
+	case icodailystat.FieldCouponPurchases:
+		return m.CouponPurchases()
+	case icodailystat.FieldCouponBuyers:
+		return m.CouponBuyers()
+	case icodailystat.FieldCouponNumToken:
+		return m.CouponNumToken()
+	case icodailystat.FieldCouponRaised:
+		return m.CouponRaised()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoDailyStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icodailystat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icodailystat.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icodailystat.FieldDay:
+		return m.OldDay(ctx)
+	case icodailystat.FieldPurchases:
+		return m.OldPurchases(ctx)
+	case icodailystat.FieldBuyers:
+		return m.OldBuyers(ctx)
+	case icodailystat.FieldNumToken:
+		return m.OldNumToken(ctx)
+	case icodailystat.FieldRaised:
+		return m.OldRaised(ctx)
+	case icodailystat.FieldCouponPurchases:
+		return m.OldCouponPurchases(ctx)
+	case icodailystat.FieldCouponBuyers:
+		return m.OldCouponBuyers(ctx)
+	case icodailystat.FieldCouponNumToken:
+		return m.OldCouponNumToken(ctx)
+	case icodailystat.FieldCouponRaised:
+		return m.OldCouponRaised(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoDailyStat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoDailyStatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icodailystat.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icodailystat.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icodailystat.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case icodailystat.FieldPurchases:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchases(v)
+		return nil
+	case icodailystat.FieldBuyers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyers(v)
+		return nil
+	case icodailystat.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	case icodailystat.FieldRaised:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRaised(v)
+		return nil
+	case icodailystat.FieldCouponPurchases:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponPurchases(v)
+		return nil
+	case icodailystat.FieldCouponBuyers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponBuyers(v)
+		return nil
+	case icodailystat.FieldCouponNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponNumToken(v)
+		return nil
+	case icodailystat.FieldCouponRaised:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponRaised(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoDailyStat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoDailyStatMutation) AddedFields() []string {
+	var fields []string
+	if m.addpurchases != nil {
+		fields = append(fields, icodailystat.FieldPurchases)
+	}
+	if m.addbuyers != nil {
+		fields = append(fields, icodailystat.FieldBuyers)
+	}
+	if m.addcoupon_purchases != nil {
+		fields = append(fields, icodailystat.FieldCouponPurchases)
+	}
+	if m.addcoupon_buyers != nil {
+		fields = append(fields, icodailystat.FieldCouponBuyers)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoDailyStatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case icodailystat.FieldPurchases:
+		return m.AddedPurchases()
+	case icodailystat.FieldBuyers:
+		return m.AddedBuyers()
+	case icodailystat.FieldCouponPurchases:
+		return m.AddedCouponPurchases()
+	case icodailystat.FieldCouponBuyers:
+		return m.AddedCouponBuyers()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoDailyStatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case icodailystat.FieldPurchases:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPurchases(v)
+		return nil
+	case icodailystat.FieldBuyers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyers(v)
+		return nil
+	case icodailystat.FieldCouponPurchases:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCouponPurchases(v)
+		return nil
+	case icodailystat.FieldCouponBuyers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCouponBuyers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoDailyStat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoDailyStatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoDailyStatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoDailyStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IcoDailyStat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoDailyStatMutation) ResetField(name string) error {
+	switch name {
+	case icodailystat.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icodailystat.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icodailystat.FieldDay:
+		m.ResetDay()
+		return nil
+	case icodailystat.FieldPurchases:
+		m.ResetPurchases()
+		return nil
+	case icodailystat.FieldBuyers:
+		m.ResetBuyers()
+		return nil
+	case icodailystat.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	case icodailystat.FieldRaised:
+		m.ResetRaised()
+		return nil
+	case icodailystat.FieldCouponPurchases:
+		m.ResetCouponPurchases()
+		return nil
+	case icodailystat.FieldCouponBuyers:
+		m.ResetCouponBuyers()
+		return nil
+	case icodailystat.FieldCouponNumToken:
+		m.ResetCouponNumToken()
+		return nil
+	case icodailystat.FieldCouponRaised:
+		m.ResetCouponRaised()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoDailyStat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoDailyStatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoDailyStatMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoDailyStatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoDailyStatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoDailyStatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoDailyStatMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoDailyStatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoDailyStat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoDailyStatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoDailyStat edge %s", name)
+}
+
+// IcoHistoryMutation represents an operation that mutates the IcoHistory nodes in the graph.
+type IcoHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	round_id      *int32
+	addround_id   *int32
+	user_id       *string
+	price         *string
+	num_token     *string
+	sub_round     *int32
+	addsub_round  *int32
+	_type         *string
+	source_id     *string
+	symbol        *string
+	amount        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IcoHistory, error)
+	predicates    []predicate.IcoHistory
+}
+
+var _ ent.Mutation = (*IcoHistoryMutation)(nil)
+
+// icohistoryOption allows management of the mutation configuration using functional options.
+type icohistoryOption func(*IcoHistoryMutation)
+
+// newIcoHistoryMutation creates new mutation for the IcoHistory entity.
+func newIcoHistoryMutation(c config, op Op, opts ...icohistoryOption) *IcoHistoryMutation {
+	m := &IcoHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoHistoryID sets the ID field of the mutation.
+func withIcoHistoryID(id xid.ID) icohistoryOption {
+	return func(m *IcoHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoHistory
+		)
+		m.oldValue = func(ctx context.Context) (*IcoHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoHistory sets the old IcoHistory of the mutation.
+func withIcoHistory(node *IcoHistory) icohistoryOption {
+	return func(m *IcoHistoryMutation) {
+		m.oldValue = func(context.Context) (*IcoHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoHistory entities.
+func (m *IcoHistoryMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoHistoryMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoHistoryMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoHistoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoHistoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRoundID sets the "round_id" field.
+func (m *IcoHistoryMutation) SetRoundID(i int32) {
+	m.round_id = &i
+	m.addround_id = nil
+}
+
+// RoundID returns the value of the "round_id" field in the mutation.
+func (m *IcoHistoryMutation) RoundID() (r int32, exists bool) {
+	v := m.round_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoundID returns the old "round_id" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldRoundID(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoundID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoundID: %w", err)
+	}
+	return oldValue.RoundID, nil
+}
+
+// AddRoundID adds i to the "round_id" field.
+func (m *IcoHistoryMutation) AddRoundID(i int32) {
+	if m.addround_id != nil {
+		*m.addround_id += i
+	} else {
+		m.addround_id = &i
+	}
+}
+
+// AddedRoundID returns the value that was added to the "round_id" field in this mutation.
+func (m *IcoHistoryMutation) AddedRoundID() (r int32, exists bool) {
+	v := m.addround_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoundID resets all changes to the "round_id" field.
+func (m *IcoHistoryMutation) ResetRoundID() {
+	m.round_id = nil
+	m.addround_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IcoHistoryMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IcoHistoryMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IcoHistoryMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *IcoHistoryMutation) SetPrice(s string) {
+	m.price = &s
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *IcoHistoryMutation) Price() (r string, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *IcoHistoryMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetNumToken sets the "num_token" field.
+func (m *IcoHistoryMutation) SetNumToken(s string) {
+	m.num_token = &s
+}
+
+// NumToken returns the value of the "num_token" field in the mutation.
+func (m *IcoHistoryMutation) NumToken() (r string, exists bool) {
+	v := m.num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumToken returns the old "num_token" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+	}
+	return oldValue.NumToken, nil
+}
+
+// ResetNumToken resets all changes to the "num_token" field.
+func (m *IcoHistoryMutation) ResetNumToken() {
+	m.num_token = nil
+}
+
+// SetSubRound sets the "sub_round" field.
+func (m *IcoHistoryMutation) SetSubRound(i int32) {
+	m.sub_round = &i
+	m.addsub_round = nil
+}
+
+// SubRound returns the value of the "sub_round" field in the mutation.
+func (m *IcoHistoryMutation) SubRound() (r int32, exists bool) {
+	v := m.sub_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubRound returns the old "sub_round" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldSubRound(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubRound: %w", err)
+	}
+	return oldValue.SubRound, nil
+}
+
+// AddSubRound adds i to the "sub_round" field.
+func (m *IcoHistoryMutation) AddSubRound(i int32) {
+	if m.addsub_round != nil {
+		*m.addsub_round += i
+	} else {
+		m.addsub_round = &i
+	}
+}
+
+// AddedSubRound returns the value that was added to the "sub_round" field in this mutation.
+func (m *IcoHistoryMutation) AddedSubRound() (r int32, exists bool) {
+	v := m.addsub_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubRound resets all changes to the "sub_round" field.
+func (m *IcoHistoryMutation) ResetSubRound() {
+	m.sub_round = nil
+	m.addsub_round = nil
+}
+
+// SetType sets the "type" field.
+func (m *IcoHistoryMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *IcoHistoryMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *IcoHistoryMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[icohistory.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *IcoHistoryMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[icohistory.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *IcoHistoryMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, icohistory.FieldType)
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *IcoHistoryMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *IcoHistoryMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *IcoHistoryMutation) ClearSourceID() {
+	m.source_id = nil
+	m.clearedFields[icohistory.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *IcoHistoryMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[icohistory.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *IcoHistoryMutation) ResetSourceID() {
+	m.source_id = nil
+	delete(m.clearedFields, icohistory.FieldSourceID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *IcoHistoryMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *IcoHistoryMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (m *IcoHistoryMutation) ClearSymbol() {
+	m.symbol = nil
+	m.clearedFields[icohistory.FieldSymbol] = struct{}{}
+}
+
+// SymbolCleared returns if the "symbol" field was cleared in this mutation.
+func (m *IcoHistoryMutation) SymbolCleared() bool {
+	_, ok := m.clearedFields[icohistory.FieldSymbol]
+	return ok
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *IcoHistoryMutation) ResetSymbol() {
+	m.symbol = nil
+	delete(m.clearedFields, icohistory.FieldSymbol)
+}
+
+// SetAmount sets the "amount" field.
+func (m *IcoHistoryMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *IcoHistoryMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the IcoHistory entity.
+// If the IcoHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoHistoryMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *IcoHistoryMutation) ClearAmount() {
+	m.amount = nil
+	m.clearedFields[icohistory.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *IcoHistoryMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[icohistory.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *IcoHistoryMutation) ResetAmount() {
+	m.amount = nil
+	delete(m.clearedFields, icohistory.FieldAmount)
+}
+
+// Where appends a list predicates to the IcoHistoryMutation builder.
+func (m *IcoHistoryMutation) Where(ps ...predicate.IcoHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoHistory).
+func (m *IcoHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, icohistory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icohistory.FieldUpdatedAt)
+	}
+	if m.round_id != nil {
+		fields = append(fields, icohistory.FieldRoundID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, icohistory.FieldUserID)
+	}
+	if m.price != nil {
+		fields = append(fields, icohistory.FieldPrice)
+	}
+	if m.num_token != nil {
+		fields = append(fields, icohistory.FieldNumToken)
+	}
+	if m.sub_round != nil {
+		fields = append(fields, icohistory.FieldSubRound)
+	}
+	if m._type != nil {
+		fields = append(fields, icohistory.FieldType)
+	}
+	if m.source_id != nil {
+		fields = append(fields, icohistory.FieldSourceID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, icohistory.FieldSymbol)
+	}
+	if m.amount != nil {
+		fields = append(fields, icohistory.FieldAmount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icohistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case icohistory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icohistory.FieldRoundID:
+		return m.RoundID()
+	case icohistory.FieldUserID:
+		return m.UserID()
+	case icohistory.FieldPrice:
+		return m.Price()
+	case icohistory.FieldNumToken:
+		return m.NumToken()
+	case icohistory.FieldSubRound:
+		return m.SubRound()
+	case icohistory.FieldType:
+		return m.GetType()
+	case icohistory.FieldSourceID:
+		return m.SourceID()
+	case icohistory.FieldSymbol:
+		return m.Symbol()
+	case icohistory.FieldAmount:
+		return m.Amount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icohistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icohistory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icohistory.FieldRoundID:
+		return m.OldRoundID(ctx)
+	case icohistory.FieldUserID:
+		return m.OldUserID(ctx)
+	case icohistory.FieldPrice:
+		return m.OldPrice(ctx)
+	case icohistory.FieldNumToken:
+		return m.OldNumToken(ctx)
+	case icohistory.FieldSubRound:
+		return m.OldSubRound(ctx)
+	case icohistory.FieldType:
+		return m.OldType(ctx)
+	case icohistory.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case icohistory.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case icohistory.FieldAmount:
+		return m.OldAmount(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icohistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icohistory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icohistory.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoundID(v)
+		return nil
+	case icohistory.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case icohistory.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case icohistory.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	case icohistory.FieldSubRound:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubRound(v)
+		return nil
+	case icohistory.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case icohistory.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case icohistory.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case icohistory.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addround_id != nil {
+		fields = append(fields, icohistory.FieldRoundID)
+	}
+	if m.addsub_round != nil {
+		fields = append(fields, icohistory.FieldSubRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case icohistory.FieldRoundID:
+		return m.AddedRoundID()
+	case icohistory.FieldSubRound:
+		return m.AddedSubRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case icohistory.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoundID(v)
+		return nil
+	case icohistory.FieldSubRound:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(icohistory.FieldType) {
+		fields = append(fields, icohistory.FieldType)
+	}
+	if m.FieldCleared(icohistory.FieldSourceID) {
+		fields = append(fields, icohistory.FieldSourceID)
+	}
+	if m.FieldCleared(icohistory.FieldSymbol) {
+		fields = append(fields, icohistory.FieldSymbol)
+	}
+	if m.FieldCleared(icohistory.FieldAmount) {
+		fields = append(fields, icohistory.FieldAmount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoHistoryMutation) ClearField(name string) error {
+	switch name {
+	case icohistory.FieldType:
+		m.ClearType()
+		return nil
+	case icohistory.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case icohistory.FieldSymbol:
+		m.ClearSymbol()
+		return nil
+	case icohistory.FieldAmount:
+		m.ClearAmount()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoHistoryMutation) ResetField(name string) error {
+	switch name {
+	case icohistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icohistory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icohistory.FieldRoundID:
+		m.ResetRoundID()
+		return nil
+	case icohistory.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case icohistory.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case icohistory.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	case icohistory.FieldSubRound:
+		m.ResetSubRound()
+		return nil
+	case icohistory.FieldType:
+		m.ResetType()
+		return nil
+	case icohistory.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case icohistory.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case icohistory.FieldAmount:
+		m.ResetAmount()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoHistory edge %s", name)
+}
+
+// IcoOutstandingRefundMutation represents an operation that mutates the IcoOutstandingRefund nodes in the graph.
+type IcoOutstandingRefundMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	round_id      *int32
+	addround_id   *int32
+	user_id       *string
+	source_id     *string
+	num_token     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IcoOutstandingRefund, error)
+	predicates    []predicate.IcoOutstandingRefund
+}
+
+var _ ent.Mutation = (*IcoOutstandingRefundMutation)(nil)
+
+// icooutstandingrefundOption allows management of the mutation configuration using functional options.
+type icooutstandingrefundOption func(*IcoOutstandingRefundMutation)
+
+// newIcoOutstandingRefundMutation creates new mutation for the IcoOutstandingRefund entity.
+func newIcoOutstandingRefundMutation(c config, op Op, opts ...icooutstandingrefundOption) *IcoOutstandingRefundMutation {
+	m := &IcoOutstandingRefundMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoOutstandingRefund,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoOutstandingRefundID sets the ID field of the mutation.
+func withIcoOutstandingRefundID(id xid.ID) icooutstandingrefundOption {
+	return func(m *IcoOutstandingRefundMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoOutstandingRefund
+		)
+		m.oldValue = func(ctx context.Context) (*IcoOutstandingRefund, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoOutstandingRefund.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoOutstandingRefund sets the old IcoOutstandingRefund of the mutation.
+func withIcoOutstandingRefund(node *IcoOutstandingRefund) icooutstandingrefundOption {
+	return func(m *IcoOutstandingRefundMutation) {
+		m.oldValue = func(context.Context) (*IcoOutstandingRefund, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoOutstandingRefundMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoOutstandingRefundMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoOutstandingRefund entities.
+func (m *IcoOutstandingRefundMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoOutstandingRefundMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoOutstandingRefundMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoOutstandingRefund.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoOutstandingRefundMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoOutstandingRefundMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoOutstandingRefundMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoOutstandingRefundMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoOutstandingRefundMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoOutstandingRefundMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRoundID sets the "round_id" field.
+func (m *IcoOutstandingRefundMutation) SetRoundID(i int32) {
+	m.round_id = &i
+	m.addround_id = nil
+}
+
+// RoundID returns the value of the "round_id" field in the mutation.
+func (m *IcoOutstandingRefundMutation) RoundID() (r int32, exists bool) {
+	v := m.round_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoundID returns the old "round_id" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldRoundID(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoundID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoundID: %w", err)
+	}
+	return oldValue.RoundID, nil
+}
+
+// AddRoundID adds i to the "round_id" field.
+func (m *IcoOutstandingRefundMutation) AddRoundID(i int32) {
+	if m.addround_id != nil {
+		*m.addround_id += i
+	} else {
+		m.addround_id = &i
+	}
+}
+
+// AddedRoundID returns the value that was added to the "round_id" field in this mutation.
+func (m *IcoOutstandingRefundMutation) AddedRoundID() (r int32, exists bool) {
+	v := m.addround_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoundID resets all changes to the "round_id" field.
+func (m *IcoOutstandingRefundMutation) ResetRoundID() {
+	m.round_id = nil
+	m.addround_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *IcoOutstandingRefundMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *IcoOutstandingRefundMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *IcoOutstandingRefundMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *IcoOutstandingRefundMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *IcoOutstandingRefundMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *IcoOutstandingRefundMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetNumToken sets the "num_token" field.
+func (m *IcoOutstandingRefundMutation) SetNumToken(s string) {
+	m.num_token = &s
+}
+
+// NumToken returns the value of the "num_token" field in the mutation.
+func (m *IcoOutstandingRefundMutation) NumToken() (r string, exists bool) {
+	v := m.num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumToken returns the old "num_token" field's value of the IcoOutstandingRefund entity.
+// If the IcoOutstandingRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoOutstandingRefundMutation) OldNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+	}
+	return oldValue.NumToken, nil
+}
+
+// ResetNumToken resets all changes to the "num_token" field.
+func (m *IcoOutstandingRefundMutation) ResetNumToken() {
+	m.num_token = nil
+}
+
+// Where appends a list predicates to the IcoOutstandingRefundMutation builder.
+func (m *IcoOutstandingRefundMutation) Where(ps ...predicate.IcoOutstandingRefund) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoOutstandingRefundMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoOutstandingRefundMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoOutstandingRefund, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoOutstandingRefundMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoOutstandingRefundMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoOutstandingRefund).
+func (m *IcoOutstandingRefundMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoOutstandingRefundMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, icooutstandingrefund.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icooutstandingrefund.FieldUpdatedAt)
+	}
+	if m.round_id != nil {
+		fields = append(fields, icooutstandingrefund.FieldRoundID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, icooutstandingrefund.FieldUserID)
+	}
+	if m.source_id != nil {
+		fields = append(fields, icooutstandingrefund.FieldSourceID)
+	}
+	if m.num_token != nil {
+		fields = append(fields, icooutstandingrefund.FieldNumToken)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoOutstandingRefundMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icooutstandingrefund.FieldCreatedAt:
+		return m.CreatedAt()
+	case icooutstandingrefund.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icooutstandingrefund.FieldRoundID:
+		return m.RoundID()
+	case icooutstandingrefund.FieldUserID:
+		return m.UserID()
+	case icooutstandingrefund.FieldSourceID:
+		return m.SourceID()
+	case icooutstandingrefund.FieldNumToken:
+		return m.NumToken()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoOutstandingRefundMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icooutstandingrefund.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icooutstandingrefund.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icooutstandingrefund.FieldRoundID:
+		return m.OldRoundID(ctx)
+	case icooutstandingrefund.FieldUserID:
+		return m.OldUserID(ctx)
+	case icooutstandingrefund.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case icooutstandingrefund.FieldNumToken:
+		return m.OldNumToken(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoOutstandingRefund field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoOutstandingRefundMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icooutstandingrefund.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icooutstandingrefund.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icooutstandingrefund.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoundID(v)
+		return nil
+	case icooutstandingrefund.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case icooutstandingrefund.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case icooutstandingrefund.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoOutstandingRefund field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoOutstandingRefundMutation) AddedFields() []string {
+	var fields []string
+	if m.addround_id != nil {
+		fields = append(fields, icooutstandingrefund.FieldRoundID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoOutstandingRefundMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case icooutstandingrefund.FieldRoundID:
+		return m.AddedRoundID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoOutstandingRefundMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case icooutstandingrefund.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoundID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoOutstandingRefund numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoOutstandingRefundMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoOutstandingRefundMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoOutstandingRefundMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IcoOutstandingRefund nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoOutstandingRefundMutation) ResetField(name string) error {
+	switch name {
+	case icooutstandingrefund.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icooutstandingrefund.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icooutstandingrefund.FieldRoundID:
+		m.ResetRoundID()
+		return nil
+	case icooutstandingrefund.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case icooutstandingrefund.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case icooutstandingrefund.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoOutstandingRefund field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoOutstandingRefundMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoOutstandingRefundMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoOutstandingRefundMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoOutstandingRefundMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoOutstandingRefundMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoOutstandingRefundMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoOutstandingRefundMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoOutstandingRefund unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoOutstandingRefundMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoOutstandingRefund edge %s", name)
+}
+
+// IcoRoundMutation represents an operation that mutates the IcoRound nodes in the graph.
+type IcoRoundMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	round_id      *int32
+	addround_id   *int32
+	sub_round     *int32
+	addsub_round  *int32
+	price         *string
+	num_token     *string
+	bought_token  *string
+	start_at      *time.Time
+	end_at        *time.Time
+	lifetime      *int32
+	addlifetime   *int32
+	is_close      *bool
+	paused_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IcoRound, error)
+	predicates    []predicate.IcoRound
+}
+
+var _ ent.Mutation = (*IcoRoundMutation)(nil)
+
+// icoroundOption allows management of the mutation configuration using functional options.
+type icoroundOption func(*IcoRoundMutation)
+
+// newIcoRoundMutation creates new mutation for the IcoRound entity.
+func newIcoRoundMutation(c config, op Op, opts ...icoroundOption) *IcoRoundMutation {
+	m := &IcoRoundMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIcoRound,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIcoRoundID sets the ID field of the mutation.
+func withIcoRoundID(id xid.ID) icoroundOption {
+	return func(m *IcoRoundMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IcoRound
+		)
+		m.oldValue = func(ctx context.Context) (*IcoRound, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IcoRound.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIcoRound sets the old IcoRound of the mutation.
+func withIcoRound(node *IcoRound) icoroundOption {
+	return func(m *IcoRoundMutation) {
+		m.oldValue = func(context.Context) (*IcoRound, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IcoRoundMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IcoRoundMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IcoRound entities.
+func (m *IcoRoundMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IcoRoundMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IcoRoundMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IcoRound.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IcoRoundMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IcoRoundMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IcoRoundMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IcoRoundMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IcoRoundMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IcoRoundMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRoundID sets the "round_id" field.
+func (m *IcoRoundMutation) SetRoundID(i int32) {
+	m.round_id = &i
+	m.addround_id = nil
+}
+
+// RoundID returns the value of the "round_id" field in the mutation.
+func (m *IcoRoundMutation) RoundID() (r int32, exists bool) {
+	v := m.round_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoundID returns the old "round_id" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldRoundID(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoundID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoundID: %w", err)
+	}
+	return oldValue.RoundID, nil
+}
+
+// AddRoundID adds i to the "round_id" field.
+func (m *IcoRoundMutation) AddRoundID(i int32) {
+	if m.addround_id != nil {
+		*m.addround_id += i
+	} else {
+		m.addround_id = &i
+	}
+}
+
+// AddedRoundID returns the value that was added to the "round_id" field in this mutation.
+func (m *IcoRoundMutation) AddedRoundID() (r int32, exists bool) {
+	v := m.addround_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoundID resets all changes to the "round_id" field.
+func (m *IcoRoundMutation) ResetRoundID() {
+	m.round_id = nil
+	m.addround_id = nil
+}
+
+// SetSubRound sets the "sub_round" field.
+func (m *IcoRoundMutation) SetSubRound(i int32) {
+	m.sub_round = &i
+	m.addsub_round = nil
+}
+
+// SubRound returns the value of the "sub_round" field in the mutation.
+func (m *IcoRoundMutation) SubRound() (r int32, exists bool) {
+	v := m.sub_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubRound returns the old "sub_round" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldSubRound(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubRound: %w", err)
+	}
+	return oldValue.SubRound, nil
+}
+
+// AddSubRound adds i to the "sub_round" field.
+func (m *IcoRoundMutation) AddSubRound(i int32) {
+	if m.addsub_round != nil {
+		*m.addsub_round += i
+	} else {
+		m.addsub_round = &i
+	}
+}
+
+// AddedSubRound returns the value that was added to the "sub_round" field in this mutation.
+func (m *IcoRoundMutation) AddedSubRound() (r int32, exists bool) {
+	v := m.addsub_round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubRound resets all changes to the "sub_round" field.
+func (m *IcoRoundMutation) ResetSubRound() {
+	m.sub_round = nil
+	m.addsub_round = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *IcoRoundMutation) SetPrice(s string) {
+	m.price = &s
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *IcoRoundMutation) Price() (r string, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldPrice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *IcoRoundMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetNumToken sets the "num_token" field.
+func (m *IcoRoundMutation) SetNumToken(s string) {
+	m.num_token = &s
+}
+
+// NumToken returns the value of the "num_token" field in the mutation.
+func (m *IcoRoundMutation) NumToken() (r string, exists bool) {
+	v := m.num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumToken returns the old "num_token" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+	}
+	return oldValue.NumToken, nil
+}
+
+// ResetNumToken resets all changes to the "num_token" field.
+func (m *IcoRoundMutation) ResetNumToken() {
+	m.num_token = nil
+}
+
+// SetBoughtToken sets the "bought_token" field.
+func (m *IcoRoundMutation) SetBoughtToken(s string) {
+	m.bought_token = &s
+}
+
+// BoughtToken returns the value of the "bought_token" field in the mutation.
+func (m *IcoRoundMutation) BoughtToken() (r string, exists bool) {
+	v := m.bought_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoughtToken returns the old "bought_token" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldBoughtToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoughtToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoughtToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoughtToken: %w", err)
+	}
+	return oldValue.BoughtToken, nil
+}
+
+// ResetBoughtToken resets all changes to the "bought_token" field.
+func (m *IcoRoundMutation) ResetBoughtToken() {
+	m.bought_token = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *IcoRoundMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *IcoRoundMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldStartAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (m *IcoRoundMutation) ClearStartAt() {
+	m.start_at = nil
+	m.clearedFields[icoround.FieldStartAt] = struct{}{}
+}
+
+// StartAtCleared returns if the "start_at" field was cleared in this mutation.
+func (m *IcoRoundMutation) StartAtCleared() bool {
+	_, ok := m.clearedFields[icoround.FieldStartAt]
+	return ok
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *IcoRoundMutation) ResetStartAt() {
+	m.start_at = nil
+	delete(m.clearedFields, icoround.FieldStartAt)
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *IcoRoundMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *IcoRoundMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldEndAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (m *IcoRoundMutation) ClearEndAt() {
+	m.end_at = nil
+	m.clearedFields[icoround.FieldEndAt] = struct{}{}
+}
+
+// EndAtCleared returns if the "end_at" field was cleared in this mutation.
+func (m *IcoRoundMutation) EndAtCleared() bool {
+	_, ok := m.clearedFields[icoround.FieldEndAt]
+	return ok
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *IcoRoundMutation) ResetEndAt() {
+	m.end_at = nil
+	delete(m.clearedFields, icoround.FieldEndAt)
+}
+
+// SetLifetime sets the "lifetime" field.
+func (m *IcoRoundMutation) SetLifetime(i int32) {
+	m.lifetime = &i
+	m.addlifetime = nil
+}
+
+// Lifetime returns the value of the "lifetime" field in the mutation.
+func (m *IcoRoundMutation) Lifetime() (r int32, exists bool) {
+	v := m.lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLifetime returns the old "lifetime" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldLifetime(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLifetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLifetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLifetime: %w", err)
+	}
+	return oldValue.Lifetime, nil
+}
+
+// AddLifetime adds i to the "lifetime" field.
+func (m *IcoRoundMutation) AddLifetime(i int32) {
+	if m.addlifetime != nil {
+		*m.addlifetime += i
+	} else {
+		m.addlifetime = &i
+	}
+}
+
+// AddedLifetime returns the value that was added to the "lifetime" field in this mutation.
+func (m *IcoRoundMutation) AddedLifetime() (r int32, exists bool) {
+	v := m.addlifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLifetime resets all changes to the "lifetime" field.
+func (m *IcoRoundMutation) ResetLifetime() {
+	m.lifetime = nil
+	m.addlifetime = nil
+}
+
+// SetIsClose sets the "is_close" field.
+func (m *IcoRoundMutation) SetIsClose(b bool) {
+	m.is_close = &b
+}
+
+// IsClose returns the value of the "is_close" field in the mutation.
+func (m *IcoRoundMutation) IsClose() (r bool, exists bool) {
+	v := m.is_close
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsClose returns the old "is_close" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldIsClose(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsClose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsClose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsClose: %w", err)
+	}
+	return oldValue.IsClose, nil
+}
+
+// ResetIsClose resets all changes to the "is_close" field.
+func (m *IcoRoundMutation) ResetIsClose() {
+	m.is_close = nil
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (m *IcoRoundMutation) SetPausedAt(t time.Time) {
+	m.paused_at = &t
+}
+
+// PausedAt returns the value of the "paused_at" field in the mutation.
+func (m *IcoRoundMutation) PausedAt() (r time.Time, exists bool) {
+	v := m.paused_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPausedAt returns the old "paused_at" field's value of the IcoRound entity.
+// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IcoRoundMutation) OldPausedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedAt: %w", err)
+	}
+	return oldValue.PausedAt, nil
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (m *IcoRoundMutation) ClearPausedAt() {
+	m.paused_at = nil
+	m.clearedFields[icoround.FieldPausedAt] = struct{}{}
+}
+
+// PausedAtCleared returns if the "paused_at" field was cleared in this mutation.
+func (m *IcoRoundMutation) PausedAtCleared() bool {
+	_, ok := m.clearedFields[icoround.FieldPausedAt]
+	return ok
+}
+
+// ResetPausedAt resets all changes to the "paused_at" field.
+func (m *IcoRoundMutation) ResetPausedAt() {
+	m.paused_at = nil
+	delete(m.clearedFields, icoround.FieldPausedAt)
+}
+
+// Where appends a list predicates to the IcoRoundMutation builder.
+func (m *IcoRoundMutation) Where(ps ...predicate.IcoRound) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IcoRoundMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IcoRoundMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IcoRound, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IcoRoundMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IcoRoundMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IcoRound).
+func (m *IcoRoundMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IcoRoundMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, icoround.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, icoround.FieldUpdatedAt)
+	}
+	if m.round_id != nil {
+		fields = append(fields, icoround.FieldRoundID)
+	}
+	if m.sub_round != nil {
+		fields = append(fields, icoround.FieldSubRound)
+	}
+	if m.price != nil {
+		fields = append(fields, icoround.FieldPrice)
+	}
+	if m.num_token != nil {
+		fields = append(fields, icoround.FieldNumToken)
+	}
+	if m.bought_token != nil {
+		fields = append(fields, icoround.FieldBoughtToken)
+	}
+	if m.start_at != nil {
+		fields = append(fields, icoround.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, icoround.FieldEndAt)
+	}
+	if m.lifetime != nil {
+		fields = append(fields, icoround.FieldLifetime)
+	}
+	if m.is_close != nil {
+		fields = append(fields, icoround.FieldIsClose)
+	}
+	if m.paused_at != nil {
+		fields = append(fields, icoround.FieldPausedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IcoRoundMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case icoround.FieldCreatedAt:
+		return m.CreatedAt()
+	case icoround.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case icoround.FieldRoundID:
+		return m.RoundID()
+	case icoround.FieldSubRound:
+		return m.SubRound()
+	case icoround.FieldPrice:
+		return m.Price()
+	case icoround.FieldNumToken:
+		return m.NumToken()
+	case icoround.FieldBoughtToken:
+		return m.BoughtToken()
+	case icoround.FieldStartAt:
+		return m.StartAt()
+	case icoround.FieldEndAt:
+		return m.EndAt()
+	case icoround.FieldLifetime:
+		return m.Lifetime()
+	case icoround.FieldIsClose:
+		return m.IsClose()
+	case icoround.FieldPausedAt:
+		return m.PausedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IcoRoundMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case icoround.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case icoround.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case icoround.FieldRoundID:
+		return m.OldRoundID(ctx)
+	case icoround.FieldSubRound:
+		return m.OldSubRound(ctx)
+	case icoround.FieldPrice:
+		return m.OldPrice(ctx)
+	case icoround.FieldNumToken:
+		return m.OldNumToken(ctx)
+	case icoround.FieldBoughtToken:
+		return m.OldBoughtToken(ctx)
+	case icoround.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case icoround.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case icoround.FieldLifetime:
+		return m.OldLifetime(ctx)
+	case icoround.FieldIsClose:
+		return m.OldIsClose(ctx)
+	case icoround.FieldPausedAt:
+		return m.OldPausedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IcoRound field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoRoundMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case icoround.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case icoround.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case icoround.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoundID(v)
+		return nil
+	case icoround.FieldSubRound:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubRound(v)
+		return nil
+	case icoround.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case icoround.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	case icoround.FieldBoughtToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoughtToken(v)
+		return nil
+	case icoround.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case icoround.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case icoround.FieldLifetime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLifetime(v)
+		return nil
+	case icoround.FieldIsClose:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsClose(v)
+		return nil
+	case icoround.FieldPausedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoRound field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IcoRoundMutation) AddedFields() []string {
+	var fields []string
+	if m.addround_id != nil {
+		fields = append(fields, icoround.FieldRoundID)
+	}
+	if m.addsub_round != nil {
+		fields = append(fields, icoround.FieldSubRound)
+	}
+	if m.addlifetime != nil {
+		fields = append(fields, icoround.FieldLifetime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IcoRoundMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case icoround.FieldRoundID:
+		return m.AddedRoundID()
+	case icoround.FieldSubRound:
+		return m.AddedSubRound()
+	case icoround.FieldLifetime:
+		return m.AddedLifetime()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IcoRoundMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case icoround.FieldRoundID:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoundID(v)
+		return nil
+	case icoround.FieldSubRound:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubRound(v)
+		return nil
+	case icoround.FieldLifetime:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLifetime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IcoRound numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IcoRoundMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(icoround.FieldStartAt) {
+		fields = append(fields, icoround.FieldStartAt)
+	}
+	if m.FieldCleared(icoround.FieldEndAt) {
+		fields = append(fields, icoround.FieldEndAt)
+	}
+	if m.FieldCleared(icoround.FieldPausedAt) {
+		fields = append(fields, icoround.FieldPausedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IcoRoundMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IcoRoundMutation) ClearField(name string) error {
+	switch name {
+	case icoround.FieldStartAt:
+		m.ClearStartAt()
+		return nil
+	case icoround.FieldEndAt:
+		m.ClearEndAt()
+		return nil
+	case icoround.FieldPausedAt:
+		m.ClearPausedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoRound nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IcoRoundMutation) ResetField(name string) error {
+	switch name {
+	case icoround.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case icoround.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case icoround.FieldRoundID:
+		m.ResetRoundID()
+		return nil
+	case icoround.FieldSubRound:
+		m.ResetSubRound()
+		return nil
+	case icoround.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case icoround.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	case icoround.FieldBoughtToken:
+		m.ResetBoughtToken()
+		return nil
+	case icoround.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case icoround.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case icoround.FieldLifetime:
+		m.ResetLifetime()
+		return nil
+	case icoround.FieldIsClose:
+		m.ResetIsClose()
+		return nil
+	case icoround.FieldPausedAt:
+		m.ResetPausedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IcoRound field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IcoRoundMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IcoRoundMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IcoRoundMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IcoRoundMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IcoRoundMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IcoRoundMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IcoRoundMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IcoRound unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IcoRoundMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IcoRound edge %s", name)
+}
+
+// LeaderboardSnapshotMutation represents an operation that mutates the LeaderboardSnapshot nodes in the graph.
+type LeaderboardSnapshotMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	taken_at      *time.Time
+	rank          *int
+	addrank       *int
+	user_id       *string
+	num_token     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LeaderboardSnapshot, error)
+	predicates    []predicate.LeaderboardSnapshot
+}
+
+var _ ent.Mutation = (*LeaderboardSnapshotMutation)(nil)
+
+// leaderboardsnapshotOption allows management of the mutation configuration using functional options.
+type leaderboardsnapshotOption func(*LeaderboardSnapshotMutation)
+
+// newLeaderboardSnapshotMutation creates new mutation for the LeaderboardSnapshot entity.
+func newLeaderboardSnapshotMutation(c config, op Op, opts ...leaderboardsnapshotOption) *LeaderboardSnapshotMutation {
+	m := &LeaderboardSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLeaderboardSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaderboardSnapshotID sets the ID field of the mutation.
+func withLeaderboardSnapshotID(id xid.ID) leaderboardsnapshotOption {
+	return func(m *LeaderboardSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LeaderboardSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*LeaderboardSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LeaderboardSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLeaderboardSnapshot sets the old LeaderboardSnapshot of the mutation.
+func withLeaderboardSnapshot(node *LeaderboardSnapshot) leaderboardsnapshotOption {
+	return func(m *LeaderboardSnapshotMutation) {
+		m.oldValue = func(context.Context) (*LeaderboardSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaderboardSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaderboardSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LeaderboardSnapshot entities.
+func (m *LeaderboardSnapshotMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaderboardSnapshotMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaderboardSnapshotMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LeaderboardSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LeaderboardSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LeaderboardSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LeaderboardSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LeaderboardSnapshotMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LeaderboardSnapshotMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LeaderboardSnapshotMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (m *LeaderboardSnapshotMutation) SetTakenAt(t time.Time) {
+	m.taken_at = &t
+}
+
+// TakenAt returns the value of the "taken_at" field in the mutation.
+func (m *LeaderboardSnapshotMutation) TakenAt() (r time.Time, exists bool) {
+	v := m.taken_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakenAt returns the old "taken_at" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldTakenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakenAt: %w", err)
+	}
+	return oldValue.TakenAt, nil
+}
+
+// ResetTakenAt resets all changes to the "taken_at" field.
+func (m *LeaderboardSnapshotMutation) ResetTakenAt() {
+	m.taken_at = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *LeaderboardSnapshotMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *LeaderboardSnapshotMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *LeaderboardSnapshotMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *LeaderboardSnapshotMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *LeaderboardSnapshotMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LeaderboardSnapshotMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LeaderboardSnapshotMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LeaderboardSnapshotMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetNumToken sets the "num_token" field.
+func (m *LeaderboardSnapshotMutation) SetNumToken(s string) {
+	m.num_token = &s
+}
+
+// NumToken returns the value of the "num_token" field in the mutation.
+func (m *LeaderboardSnapshotMutation) NumToken() (r string, exists bool) {
+	v := m.num_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumToken returns the old "num_token" field's value of the LeaderboardSnapshot entity.
+// If the LeaderboardSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaderboardSnapshotMutation) OldNumToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+	}
+	return oldValue.NumToken, nil
+}
+
+// ResetNumToken resets all changes to the "num_token" field.
+func (m *LeaderboardSnapshotMutation) ResetNumToken() {
+	m.num_token = nil
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotMutation builder.
+func (m *LeaderboardSnapshotMutation) Where(ps ...predicate.LeaderboardSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LeaderboardSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LeaderboardSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LeaderboardSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LeaderboardSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LeaderboardSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LeaderboardSnapshot).
+func (m *LeaderboardSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaderboardSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, leaderboardsnapshot.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, leaderboardsnapshot.FieldUpdatedAt)
+	}
+	if m.taken_at != nil {
+		fields = append(fields, leaderboardsnapshot.FieldTakenAt)
+	}
+	if m.rank != nil {
+		fields = append(fields, leaderboardsnapshot.FieldRank)
+	}
+	if m.user_id != nil {
+		fields = append(fields, leaderboardsnapshot.FieldUserID)
+	}
+	if m.num_token != nil {
+		fields = append(fields, leaderboardsnapshot.FieldNumToken)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaderboardSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case leaderboardsnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case leaderboardsnapshot.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case leaderboardsnapshot.FieldTakenAt:
+		return m.TakenAt()
+	case leaderboardsnapshot.FieldRank:
+		return m.Rank()
+	case leaderboardsnapshot.FieldUserID:
+		return m.UserID()
+	case leaderboardsnapshot.FieldNumToken:
+		return m.NumToken()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaderboardSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case leaderboardsnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case leaderboardsnapshot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case leaderboardsnapshot.FieldTakenAt:
+		return m.OldTakenAt(ctx)
+	case leaderboardsnapshot.FieldRank:
+		return m.OldRank(ctx)
+	case leaderboardsnapshot.FieldUserID:
+		return m.OldUserID(ctx)
+	case leaderboardsnapshot.FieldNumToken:
+		return m.OldNumToken(ctx)
+	}
+	return nil, fmt.Errorf("unknown LeaderboardSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaderboardSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case leaderboardsnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case leaderboardsnapshot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case leaderboardsnapshot.FieldTakenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakenAt(v)
+		return nil
+	case leaderboardsnapshot.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case leaderboardsnapshot.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case leaderboardsnapshot.FieldNumToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumToken(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LeaderboardSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaderboardSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, leaderboardsnapshot.FieldRank)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaderboardSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case leaderboardsnapshot.FieldRank:
+		return m.AddedRank()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaderboardSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case leaderboardsnapshot.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LeaderboardSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaderboardSnapshotMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaderboardSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaderboardSnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LeaderboardSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaderboardSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case leaderboardsnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case leaderboardsnapshot.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case leaderboardsnapshot.FieldTakenAt:
+		m.ResetTakenAt()
+		return nil
+	case leaderboardsnapshot.FieldRank:
+		m.ResetRank()
+		return nil
+	case leaderboardsnapshot.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case leaderboardsnapshot.FieldNumToken:
+		m.ResetNumToken()
+		return nil
+	}
+	return fmt.Errorf("unknown LeaderboardSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaderboardSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaderboardSnapshotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaderboardSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaderboardSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaderboardSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaderboardSnapshotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaderboardSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LeaderboardSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaderboardSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LeaderboardSnapshot edge %s", name)
+}
+
+// ReferralMutation represents an operation that mutates the Referral nodes in the graph.
+type ReferralMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *string
+	referrer_id   *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Referral, error)
+	predicates    []predicate.Referral
+}
+
+var _ ent.Mutation = (*ReferralMutation)(nil)
+
+// referralOption allows management of the mutation configuration using functional options.
+type referralOption func(*ReferralMutation)
+
+// newReferralMutation creates new mutation for the Referral entity.
+func newReferralMutation(c config, op Op, opts ...referralOption) *ReferralMutation {
+	m := &ReferralMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReferral,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReferralID sets the ID field of the mutation.
+func withReferralID(id xid.ID) referralOption {
+	return func(m *ReferralMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Referral
+		)
+		m.oldValue = func(ctx context.Context) (*Referral, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Referral.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReferral sets the old Referral of the mutation.
+func withReferral(node *Referral) referralOption {
+	return func(m *ReferralMutation) {
+		m.oldValue = func(context.Context) (*Referral, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReferralMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReferralMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Referral entities.
+func (m *ReferralMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReferralMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReferralMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Referral.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReferralMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReferralMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Referral entity.
+// If the Referral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReferralMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReferralMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReferralMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Referral entity.
+// If the Referral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReferralMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReferralMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReferralMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Referral entity.
+// If the Referral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReferralMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (m *ReferralMutation) SetReferrerID(s string) {
+	m.referrer_id = &s
+}
+
+// ReferrerID returns the value of the "referrer_id" field in the mutation.
+func (m *ReferralMutation) ReferrerID() (r string, exists bool) {
+	v := m.referrer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferrerID returns the old "referrer_id" field's value of the Referral entity.
+// If the Referral object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralMutation) OldReferrerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferrerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferrerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferrerID: %w", err)
+	}
+	return oldValue.ReferrerID, nil
+}
+
+// ResetReferrerID resets all changes to the "referrer_id" field.
+func (m *ReferralMutation) ResetReferrerID() {
+	m.referrer_id = nil
+}
+
+// Where appends a list predicates to the ReferralMutation builder.
+func (m *ReferralMutation) Where(ps ...predicate.Referral) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReferralMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReferralMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Referral, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReferralMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReferralMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Referral).
+func (m *ReferralMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReferralMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, referral.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, referral.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, referral.FieldUserID)
+	}
+	if m.referrer_id != nil {
+		fields = append(fields, referral.FieldReferrerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReferralMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case referral.FieldCreatedAt:
+		return m.CreatedAt()
+	case referral.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case referral.FieldUserID:
+		return m.UserID()
+	case referral.FieldReferrerID:
+		return m.ReferrerID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReferralMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case referral.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case referral.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case referral.FieldUserID:
+		return m.OldUserID(ctx)
+	case referral.FieldReferrerID:
+		return m.OldReferrerID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Referral field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReferralMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case referral.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case referral.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case referral.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case referral.FieldReferrerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferrerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Referral field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReferralMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReferralMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReferralMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Referral numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReferralMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReferralMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReferralMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Referral nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReferralMutation) ResetField(name string) error {
+	switch name {
+	case referral.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case referral.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case referral.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case referral.FieldReferrerID:
+		m.ResetReferrerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Referral field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReferralMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReferralMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReferralMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReferralMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReferralMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReferralMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReferralMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Referral unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReferralMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Referral edge %s", name)
+}
+
+// ReferralCommissionMutation represents an operation that mutates the ReferralCommission nodes in the graph.
+type ReferralCommissionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *string
+	level         *int
+	addlevel      *int
+	buyer_id      *string
+	coupon        *string
+	source_id     *string
+	amount        *string
+	symbol        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ReferralCommission, error)
+	predicates    []predicate.ReferralCommission
+}
+
+var _ ent.Mutation = (*ReferralCommissionMutation)(nil)
+
+// referralcommissionOption allows management of the mutation configuration using functional options.
+type referralcommissionOption func(*ReferralCommissionMutation)
+
+// newReferralCommissionMutation creates new mutation for the ReferralCommission entity.
+func newReferralCommissionMutation(c config, op Op, opts ...referralcommissionOption) *ReferralCommissionMutation {
+	m := &ReferralCommissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReferralCommission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReferralCommissionID sets the ID field of the mutation.
+func withReferralCommissionID(id xid.ID) referralcommissionOption {
+	return func(m *ReferralCommissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReferralCommission
+		)
+		m.oldValue = func(ctx context.Context) (*ReferralCommission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReferralCommission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReferralCommission sets the old ReferralCommission of the mutation.
+func withReferralCommission(node *ReferralCommission) referralcommissionOption {
+	return func(m *ReferralCommissionMutation) {
+		m.oldValue = func(context.Context) (*ReferralCommission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReferralCommissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReferralCommissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReferralCommission entities.
+func (m *ReferralCommissionMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReferralCommissionMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReferralCommissionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReferralCommission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReferralCommissionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReferralCommissionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReferralCommissionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReferralCommissionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReferralCommissionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReferralCommissionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReferralCommissionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReferralCommissionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReferralCommissionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *ReferralCommissionMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *ReferralCommissionMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *ReferralCommissionMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *ReferralCommissionMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *ReferralCommissionMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (m *ReferralCommissionMutation) SetBuyerID(s string) {
+	m.buyer_id = &s
+}
+
+// BuyerID returns the value of the "buyer_id" field in the mutation.
+func (m *ReferralCommissionMutation) BuyerID() (r string, exists bool) {
+	v := m.buyer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerID returns the old "buyer_id" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldBuyerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerID: %w", err)
+	}
+	return oldValue.BuyerID, nil
+}
+
+// ResetBuyerID resets all changes to the "buyer_id" field.
+func (m *ReferralCommissionMutation) ResetBuyerID() {
+	m.buyer_id = nil
+}
+
+// SetCoupon sets the "coupon" field.
+func (m *ReferralCommissionMutation) SetCoupon(s string) {
+	m.coupon = &s
+}
+
+// Coupon returns the value of the "coupon" field in the mutation.
+func (m *ReferralCommissionMutation) Coupon() (r string, exists bool) {
+	v := m.coupon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoupon returns the old "coupon" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldCoupon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoupon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoupon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoupon: %w", err)
+	}
+	return oldValue.Coupon, nil
+}
+
+// ResetCoupon resets all changes to the "coupon" field.
+func (m *ReferralCommissionMutation) ResetCoupon() {
+	m.coupon = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *ReferralCommissionMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *ReferralCommissionMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *ReferralCommissionMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *ReferralCommissionMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *ReferralCommissionMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *ReferralCommissionMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *ReferralCommissionMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *ReferralCommissionMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the ReferralCommission entity.
+// If the ReferralCommission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReferralCommissionMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *ReferralCommissionMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// Where appends a list predicates to the ReferralCommissionMutation builder.
+func (m *ReferralCommissionMutation) Where(ps ...predicate.ReferralCommission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReferralCommissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReferralCommissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReferralCommission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReferralCommissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReferralCommissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReferralCommission).
+func (m *ReferralCommissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReferralCommissionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, referralcommission.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, referralcommission.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, referralcommission.FieldUserID)
+	}
+	if m.level != nil {
+		fields = append(fields, referralcommission.FieldLevel)
+	}
+	if m.buyer_id != nil {
+		fields = append(fields, referralcommission.FieldBuyerID)
+	}
+	if m.coupon != nil {
+		fields = append(fields, referralcommission.FieldCoupon)
+	}
+	if m.source_id != nil {
+		fields = append(fields, referralcommission.FieldSourceID)
+	}
+	if m.amount != nil {
+		fields = append(fields, referralcommission.FieldAmount)
+	}
+	if m.symbol != nil {
+		fields = append(fields, referralcommission.FieldSymbol)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReferralCommissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case referralcommission.FieldCreatedAt:
+		return m.CreatedAt()
+	case referralcommission.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case referralcommission.FieldUserID:
+		return m.UserID()
+	case referralcommission.FieldLevel:
+		return m.Level()
+	case referralcommission.FieldBuyerID:
+		return m.BuyerID()
+	case referralcommission.FieldCoupon:
+		return m.Coupon()
+	case referralcommission.FieldSourceID:
+		return m.SourceID()
+	case referralcommission.FieldAmount:
+		return m.Amount()
+	case referralcommission.FieldSymbol:
+		return m.Symbol()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReferralCommissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case referralcommission.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case referralcommission.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case referralcommission.FieldUserID:
+		return m.OldUserID(ctx)
+	case referralcommission.FieldLevel:
+		return m.OldLevel(ctx)
+	case referralcommission.FieldBuyerID:
+		return m.OldBuyerID(ctx)
+	case referralcommission.FieldCoupon:
+		return m.OldCoupon(ctx)
+	case referralcommission.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case referralcommission.FieldAmount:
+		return m.OldAmount(ctx)
+	case referralcommission.FieldSymbol:
+		return m.OldSymbol(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReferralCommission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReferralCommissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case referralcommission.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case referralcommission.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case referralcommission.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case referralcommission.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case referralcommission.FieldBuyerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerID(v)
+		return nil
+	case referralcommission.FieldCoupon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoupon(v)
+		return nil
+	case referralcommission.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case referralcommission.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case referralcommission.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReferralCommission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReferralCommissionMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, referralcommission.FieldLevel)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReferralCommissionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case referralcommission.FieldLevel:
+		return m.AddedLevel()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReferralCommissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case referralcommission.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReferralCommission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReferralCommissionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReferralCommissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReferralCommissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReferralCommission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReferralCommissionMutation) ResetField(name string) error {
+	switch name {
+	case referralcommission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case referralcommission.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case referralcommission.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case referralcommission.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case referralcommission.FieldBuyerID:
+		m.ResetBuyerID()
+		return nil
+	case referralcommission.FieldCoupon:
+		m.ResetCoupon()
+		return nil
+	case referralcommission.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case referralcommission.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case referralcommission.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	}
+	return fmt.Errorf("unknown ReferralCommission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReferralCommissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReferralCommissionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReferralCommissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReferralCommissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReferralCommissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReferralCommissionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReferralCommissionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReferralCommission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReferralCommissionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReferralCommission edge %s", name)
+}
+
+// TokenomicVersionMutation represents an operation that mutates the TokenomicVersion nodes in the graph.
+type TokenomicVersionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *xid.ID
+	created_at    *time.Time
+	version       *string
+	checksum      *string
+	definition    *string
+	changes       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TokenomicVersion, error)
+	predicates    []predicate.TokenomicVersion
+}
+
+var _ ent.Mutation = (*TokenomicVersionMutation)(nil)
+
+// tokenomicversionOption allows management of the mutation configuration using functional options.
+type tokenomicversionOption func(*TokenomicVersionMutation)
+
+// newTokenomicVersionMutation creates new mutation for the TokenomicVersion entity.
+func newTokenomicVersionMutation(c config, op Op, opts ...tokenomicversionOption) *TokenomicVersionMutation {
+	m := &TokenomicVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenomicVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenomicVersionID sets the ID field of the mutation.
+func withTokenomicVersionID(id xid.ID) tokenomicversionOption {
+	return func(m *TokenomicVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenomicVersion
+		)
+		m.oldValue = func(ctx context.Context) (*TokenomicVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenomicVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTokenomicVersion sets the old TokenomicVersion of the mutation.
+func withTokenomicVersion(node *TokenomicVersion) tokenomicversionOption {
+	return func(m *TokenomicVersionMutation) {
+		m.oldValue = func(context.Context) (*TokenomicVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenomicVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenomicVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenomicVersion entities.
+func (m *TokenomicVersionMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenomicVersionMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenomicVersionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenomicVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenomicVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenomicVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TokenomicVersion entity.
+// If the TokenomicVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenomicVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenomicVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *TokenomicVersionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TokenomicVersionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the TokenomicVersion entity.
+// If the TokenomicVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenomicVersionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TokenomicVersionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *TokenomicVersionMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *TokenomicVersionMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the TokenomicVersion entity.
+// If the TokenomicVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenomicVersionMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *TokenomicVersionMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetDefinition sets the "definition" field.
+func (m *TokenomicVersionMutation) SetDefinition(s string) {
+	m.definition = &s
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *TokenomicVersionMutation) Definition() (r string, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the TokenomicVersion entity.
+// If the TokenomicVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenomicVersionMutation) OldDefinition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *TokenomicVersionMutation) ResetDefinition() {
+	m.definition = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *TokenomicVersionMutation) SetChanges(s string) {
+	m.changes = &s
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *TokenomicVersionMutation) Changes() (r string, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the TokenomicVersion entity.
+// If the TokenomicVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenomicVersionMutation) OldChanges(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *TokenomicVersionMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[tokenomicversion.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *TokenomicVersionMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[tokenomicversion.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *TokenomicVersionMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, tokenomicversion.FieldChanges)
+}
+
+// Where appends a list predicates to the TokenomicVersionMutation builder.
+func (m *TokenomicVersionMutation) Where(ps ...predicate.TokenomicVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenomicVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenomicVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenomicVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenomicVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenomicVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TokenomicVersion).
+func (m *TokenomicVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenomicVersionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, tokenomicversion.FieldCreatedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, tokenomicversion.FieldVersion)
+	}
+	if m.checksum != nil {
+		fields = append(fields, tokenomicversion.FieldChecksum)
+	}
+	if m.definition != nil {
+		fields = append(fields, tokenomicversion.FieldDefinition)
+	}
+	if m.changes != nil {
+		fields = append(fields, tokenomicversion.FieldChanges)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenomicVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenomicversion.FieldCreatedAt:
+		return m.CreatedAt()
+	case tokenomicversion.FieldVersion:
+		return m.Version()
+	case tokenomicversion.FieldChecksum:
+		return m.Checksum()
+	case tokenomicversion.FieldDefinition:
+		return m.Definition()
+	case tokenomicversion.FieldChanges:
+		return m.Changes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenomicVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenomicversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tokenomicversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case tokenomicversion.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case tokenomicversion.FieldDefinition:
+		return m.OldDefinition(ctx)
+	case tokenomicversion.FieldChanges:
+		return m.OldChanges(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenomicVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenomicVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenomicversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tokenomicversion.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case tokenomicversion.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case tokenomicversion.FieldDefinition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinition(v)
+		return nil
+	case tokenomicversion.FieldChanges:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenomicVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenomicVersionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenomicVersionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenomicVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TokenomicVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenomicVersionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tokenomicversion.FieldChanges) {
+		fields = append(fields, tokenomicversion.FieldChanges)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenomicVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenomicVersionMutation) ClearField(name string) error {
+	switch name {
+	case tokenomicversion.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenomicVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenomicVersionMutation) ResetField(name string) error {
+	switch name {
+	case tokenomicversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tokenomicversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case tokenomicversion.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case tokenomicversion.FieldDefinition:
+		m.ResetDefinition()
+		return nil
+	case tokenomicversion.FieldChanges:
+		m.ResetChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenomicVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenomicVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenomicVersionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenomicVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenomicVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenomicVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenomicVersionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenomicVersionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TokenomicVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenomicVersionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TokenomicVersion edge %s", name)
+}
+
+// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
+type TransactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *xid.ID
+	created_at     *time.Time
+	updated_at     *time.Time
+	trans_type     *string
+	source         *string
+	src_symbol     *string
+	src_amount     *string
+	destination    *string
+	dest_symbol    *string
+	dest_amount    *string
+	rate           *string
+	source_service *string
+	source_id      *string
+	status         *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Transaction, error)
+	predicates     []predicate.Transaction
+}
+
+var _ ent.Mutation = (*TransactionMutation)(nil)
+
+// transactionOption allows management of the mutation configuration using functional options.
+type transactionOption func(*TransactionMutation)
+
+// newTransactionMutation creates new mutation for the Transaction entity.
+func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
+	m := &TransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionID sets the ID field of the mutation.
+func withTransactionID(id xid.ID) transactionOption {
+	return func(m *TransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transaction
+		)
+		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransaction sets the old Transaction of the mutation.
+func withTransaction(node *Transaction) transactionOption {
+	return func(m *TransactionMutation) {
+		m.oldValue = func(context.Context) (*Transaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Transaction entities.
+func (m *TransactionMutation) SetID(id xid.ID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionMutation) ID() (id xid.ID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []xid.ID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TransactionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TransactionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTransType sets the "trans_type" field.
+func (m *TransactionMutation) SetTransType(s string) {
+	m.trans_type = &s
+}
+
+// TransType returns the value of the "trans_type" field in the mutation.
+func (m *TransactionMutation) TransType() (r string, exists bool) {
+	v := m.trans_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransType returns the old "trans_type" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldTransType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransType: %w", err)
+	}
+	return oldValue.TransType, nil
+}
+
+// ResetTransType resets all changes to the "trans_type" field.
+func (m *TransactionMutation) ResetTransType() {
+	m.trans_type = nil
+}
+
+// SetSource sets the "source" field.
+func (m *TransactionMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *TransactionMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *TransactionMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetSrcSymbol sets the "src_symbol" field.
+func (m *TransactionMutation) SetSrcSymbol(s string) {
+	m.src_symbol = &s
+}
+
+// SrcSymbol returns the value of the "src_symbol" field in the mutation.
+func (m *TransactionMutation) SrcSymbol() (r string, exists bool) {
+	v := m.src_symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSrcSymbol returns the old "src_symbol" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSrcSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSrcSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSrcSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSrcSymbol: %w", err)
+	}
+	return oldValue.SrcSymbol, nil
+}
+
+// ResetSrcSymbol resets all changes to the "src_symbol" field.
+func (m *TransactionMutation) ResetSrcSymbol() {
+	m.src_symbol = nil
+}
+
+// SetSrcAmount sets the "src_amount" field.
+func (m *TransactionMutation) SetSrcAmount(s string) {
+	m.src_amount = &s
+}
+
+// SrcAmount returns the value of the "src_amount" field in the mutation.
+func (m *TransactionMutation) SrcAmount() (r string, exists bool) {
+	v := m.src_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSrcAmount returns the old "src_amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSrcAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSrcAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSrcAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSrcAmount: %w", err)
+	}
+	return oldValue.SrcAmount, nil
+}
+
+// ResetSrcAmount resets all changes to the "src_amount" field.
+func (m *TransactionMutation) ResetSrcAmount() {
+	m.src_amount = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *TransactionMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *TransactionMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *TransactionMutation) ResetDestination() {
+	m.destination = nil
+}
+
+// SetDestSymbol sets the "dest_symbol" field.
+func (m *TransactionMutation) SetDestSymbol(s string) {
+	m.dest_symbol = &s
+}
+
+// DestSymbol returns the value of the "dest_symbol" field in the mutation.
+func (m *TransactionMutation) DestSymbol() (r string, exists bool) {
+	v := m.dest_symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestSymbol returns the old "dest_symbol" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDestSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestSymbol: %w", err)
+	}
+	return oldValue.DestSymbol, nil
+}
+
+// ResetDestSymbol resets all changes to the "dest_symbol" field.
+func (m *TransactionMutation) ResetDestSymbol() {
+	m.dest_symbol = nil
+}
+
+// SetDestAmount sets the "dest_amount" field.
+func (m *TransactionMutation) SetDestAmount(s string) {
+	m.dest_amount = &s
+}
+
+// DestAmount returns the value of the "dest_amount" field in the mutation.
+func (m *TransactionMutation) DestAmount() (r string, exists bool) {
+	v := m.dest_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestAmount returns the old "dest_amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDestAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestAmount: %w", err)
+	}
+	return oldValue.DestAmount, nil
+}
+
+// ResetDestAmount resets all changes to the "dest_amount" field.
+func (m *TransactionMutation) ResetDestAmount() {
+	m.dest_amount = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *TransactionMutation) SetRate(s string) {
+	m.rate = &s
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *TransactionMutation) Rate() (r string, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldRate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *TransactionMutation) ResetRate() {
+	m.rate = nil
+}
+
+// SetSourceService sets the "source_service" field.
+func (m *TransactionMutation) SetSourceService(s string) {
+	m.source_service = &s
+}
+
+// SourceService returns the value of the "source_service" field in the mutation.
+func (m *TransactionMutation) SourceService() (r string, exists bool) {
+	v := m.source_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceService returns the old "source_service" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSourceService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceService: %w", err)
+	}
+	return oldValue.SourceService, nil
+}
+
+// ResetSourceService resets all changes to the "source_service" field.
+func (m *TransactionMutation) ResetSourceService() {
+	m.source_service = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *TransactionMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *TransactionMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *TransactionMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TransactionMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TransactionMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TransactionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// Where appends a list predicates to the TransactionMutation builder.
+func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transaction).
+func (m *TransactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransactionMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, transaction.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, transaction.FieldUpdatedAt)
+	}
+	if m.trans_type != nil {
+		fields = append(fields, transaction.FieldTransType)
+	}
+	if m.source != nil {
+		fields = append(fields, transaction.FieldSource)
+	}
+	if m.src_symbol != nil {
+		fields = append(fields, transaction.FieldSrcSymbol)
+	}
+	if m.src_amount != nil {
+		fields = append(fields, transaction.FieldSrcAmount)
+	}
+	if m.destination != nil {
+		fields = append(fields, transaction.FieldDestination)
+	}
+	if m.dest_symbol != nil {
+		fields = append(fields, transaction.FieldDestSymbol)
+	}
+	if m.dest_amount != nil {
+		fields = append(fields, transaction.FieldDestAmount)
+	}
+	if m.rate != nil {
+		fields = append(fields, transaction.FieldRate)
+	}
+	if m.source_service != nil {
+		fields = append(fields, transaction.FieldSourceService)
+	}
+	if m.source_id != nil {
+		fields = append(fields, transaction.FieldSourceID)
+	}
+	if m.status != nil {
+		fields = append(fields, transaction.FieldStatus)
 	}
 	return fields
 }
@@ -2669,24 +12492,34 @@ func (m *IcoHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IcoHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case icohistory.FieldCreatedAt:
+	case transaction.FieldCreatedAt:
 		return m.CreatedAt()
-	case icohistory.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case icohistory.FieldRoundID:
-		return m.RoundID()
-	case icohistory.FieldUserID:
-		return m.UserID()
-	case icohistory.FieldPrice:
-		return m.Price()
-	case icohistory.FieldNumToken:
-		return m.NumToken()
-	case icohistory.FieldSubRound:
-		return m.SubRound()
-	case icohistory.FieldType:
-		return m.GetType()
+	case transaction.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case transaction.FieldTransType:
+		return m.TransType()
+	case transaction.FieldSource:
+		return m.Source()
+	case transaction.FieldSrcSymbol:
+		return m.SrcSymbol()
+	case transaction.FieldSrcAmount:
+		return m.SrcAmount()
+	case transaction.FieldDestination:
+		return m.Destination()
+	case transaction.FieldDestSymbol:
+		return m.DestSymbol()
+	case transaction.FieldDestAmount:
+		return m.DestAmount()
+	case transaction.FieldRate:
+		return m.Rate()
+	case transaction.FieldSourceService:
+		return m.SourceService()
+	case transaction.FieldSourceID:
+		return m.SourceID()
+	case transaction.FieldStatus:
+		return m.Status()
 	}
 	return nil, false
 }
@@ -2694,285 +12527,304 @@ func (m *IcoHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IcoHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case icohistory.FieldCreatedAt:
+	case transaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case icohistory.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case icohistory.FieldRoundID:
-		return m.OldRoundID(ctx)
-	case icohistory.FieldUserID:
-		return m.OldUserID(ctx)
-	case icohistory.FieldPrice:
-		return m.OldPrice(ctx)
-	case icohistory.FieldNumToken:
-		return m.OldNumToken(ctx)
-	case icohistory.FieldSubRound:
-		return m.OldSubRound(ctx)
-	case icohistory.FieldType:
-		return m.OldType(ctx)
+	case transaction.FieldTransType:
+		return m.OldTransType(ctx)
+	case transaction.FieldSource:
+		return m.OldSource(ctx)
+	case transaction.FieldSrcSymbol:
+		return m.OldSrcSymbol(ctx)
+	case transaction.FieldSrcAmount:
+		return m.OldSrcAmount(ctx)
+	case transaction.FieldDestination:
+		return m.OldDestination(ctx)
+	case transaction.FieldDestSymbol:
+		return m.OldDestSymbol(ctx)
+	case transaction.FieldDestAmount:
+		return m.OldDestAmount(ctx)
+	case transaction.FieldRate:
+		return m.OldRate(ctx)
+	case transaction.FieldSourceService:
+		return m.OldSourceService(ctx)
+	case transaction.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case transaction.FieldStatus:
+		return m.OldStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown IcoHistory field %s", name)
+	return nil, fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case icohistory.FieldCreatedAt:
+	case transaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case icohistory.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case icohistory.FieldRoundID:
-		v, ok := value.(int32)
+	case transaction.FieldTransType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoundID(v)
+		m.SetTransType(v)
 		return nil
-	case icohistory.FieldUserID:
+	case transaction.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetSource(v)
 		return nil
-	case icohistory.FieldPrice:
+	case transaction.FieldSrcSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetSrcSymbol(v)
 		return nil
-	case icohistory.FieldNumToken:
+	case transaction.FieldSrcAmount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumToken(v)
+		m.SetSrcAmount(v)
 		return nil
-	case icohistory.FieldSubRound:
-		v, ok := value.(int32)
+	case transaction.FieldDestination:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubRound(v)
+		m.SetDestination(v)
 		return nil
-	case icohistory.FieldType:
+	case transaction.FieldDestSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetDestSymbol(v)
+		return nil
+	case transaction.FieldDestAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestAmount(v)
+		return nil
+	case transaction.FieldRate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case transaction.FieldSourceService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceService(v)
+		return nil
+	case transaction.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case transaction.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IcoHistory field %s", name)
+	return fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IcoHistoryMutation) AddedFields() []string {
-	var fields []string
-	if m.addround_id != nil {
-		fields = append(fields, icohistory.FieldRoundID)
-	}
-	if m.addsub_round != nil {
-		fields = append(fields, icohistory.FieldSubRound)
-	}
-	return fields
+func (m *TransactionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IcoHistoryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case icohistory.FieldRoundID:
-		return m.AddedRoundID()
-	case icohistory.FieldSubRound:
-		return m.AddedSubRound()
-	}
+func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *TransactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case icohistory.FieldRoundID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRoundID(v)
-		return nil
-	case icohistory.FieldSubRound:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubRound(v)
-		return nil
 	}
-	return fmt.Errorf("unknown IcoHistory numeric field %s", name)
+	return fmt.Errorf("unknown Transaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IcoHistoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(icohistory.FieldType) {
-		fields = append(fields, icohistory.FieldType)
-	}
-	return fields
+func (m *TransactionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IcoHistoryMutation) FieldCleared(name string) bool {
+func (m *TransactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IcoHistoryMutation) ClearField(name string) error {
-	switch name {
-	case icohistory.FieldType:
-		m.ClearType()
-		return nil
-	}
-	return fmt.Errorf("unknown IcoHistory nullable field %s", name)
+func (m *TransactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Transaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IcoHistoryMutation) ResetField(name string) error {
+func (m *TransactionMutation) ResetField(name string) error {
 	switch name {
-	case icohistory.FieldCreatedAt:
+	case transaction.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case icohistory.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case icohistory.FieldRoundID:
-		m.ResetRoundID()
+	case transaction.FieldTransType:
+		m.ResetTransType()
 		return nil
-	case icohistory.FieldUserID:
-		m.ResetUserID()
+	case transaction.FieldSource:
+		m.ResetSource()
 		return nil
-	case icohistory.FieldPrice:
-		m.ResetPrice()
+	case transaction.FieldSrcSymbol:
+		m.ResetSrcSymbol()
 		return nil
-	case icohistory.FieldNumToken:
-		m.ResetNumToken()
+	case transaction.FieldSrcAmount:
+		m.ResetSrcAmount()
 		return nil
-	case icohistory.FieldSubRound:
-		m.ResetSubRound()
+	case transaction.FieldDestination:
+		m.ResetDestination()
 		return nil
-	case icohistory.FieldType:
-		m.ResetType()
+	case transaction.FieldDestSymbol:
+		m.ResetDestSymbol()
+		return nil
+	case transaction.FieldDestAmount:
+		m.ResetDestAmount()
+		return nil
+	case transaction.FieldRate:
+		m.ResetRate()
+		return nil
+	case transaction.FieldSourceService:
+		m.ResetSourceService()
+		return nil
+	case transaction.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case transaction.FieldStatus:
+		m.ResetStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown IcoHistory field %s", name)
+	return fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IcoHistoryMutation) AddedEdges() []string {
+func (m *TransactionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IcoHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IcoHistoryMutation) RemovedEdges() []string {
+func (m *TransactionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IcoHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IcoHistoryMutation) ClearedEdges() []string {
+func (m *TransactionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IcoHistoryMutation) EdgeCleared(name string) bool {
+func (m *TransactionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IcoHistoryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown IcoHistory unique edge %s", name)
+func (m *TransactionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Transaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IcoHistoryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown IcoHistory edge %s", name)
+func (m *TransactionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Transaction edge %s", name)
 }
 
-// IcoRoundMutation represents an operation that mutates the IcoRound nodes in the graph.
-type IcoRoundMutation struct {
+// UserWalletMutation represents an operation that mutates the UserWallet nodes in the graph.
+type UserWalletMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *xid.ID
 	created_at    *time.Time
 	updated_at    *time.Time
-	round_id      *int32
-	addround_id   *int32
-	sub_round     *int32
-	addsub_round  *int32
-	price         *string
-	num_token     *string
-	bought_token  *string
-	start_at      *time.Time
-	end_at        *time.Time
-	is_close      *bool
+	user_id       *string
+	_type         *string
+	symbol        *string
+	is_active     *bool
+	balance       *string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*IcoRound, error)
-	predicates    []predicate.IcoRound
+	oldValue      func(context.Context) (*UserWallet, error)
+	predicates    []predicate.UserWallet
 }
 
-var _ ent.Mutation = (*IcoRoundMutation)(nil)
+var _ ent.Mutation = (*UserWalletMutation)(nil)
 
-// icoroundOption allows management of the mutation configuration using functional options.
-type icoroundOption func(*IcoRoundMutation)
+// userwalletOption allows management of the mutation configuration using functional options.
+type userwalletOption func(*UserWalletMutation)
 
-// newIcoRoundMutation creates new mutation for the IcoRound entity.
-func newIcoRoundMutation(c config, op Op, opts ...icoroundOption) *IcoRoundMutation {
-	m := &IcoRoundMutation{
+// newUserWalletMutation creates new mutation for the UserWallet entity.
+func newUserWalletMutation(c config, op Op, opts ...userwalletOption) *UserWalletMutation {
+	m := &UserWalletMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIcoRound,
+		typ:           TypeUserWallet,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2981,20 +12833,20 @@ func newIcoRoundMutation(c config, op Op, opts ...icoroundOption) *IcoRoundMutat
 	return m
 }
 
-// withIcoRoundID sets the ID field of the mutation.
-func withIcoRoundID(id xid.ID) icoroundOption {
-	return func(m *IcoRoundMutation) {
+// withUserWalletID sets the ID field of the mutation.
+func withUserWalletID(id xid.ID) userwalletOption {
+	return func(m *UserWalletMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *IcoRound
+			value *UserWallet
 		)
-		m.oldValue = func(ctx context.Context) (*IcoRound, error) {
+		m.oldValue = func(ctx context.Context) (*UserWallet, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().IcoRound.Get(ctx, id)
+					value, err = m.Client().UserWallet.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3003,10 +12855,10 @@ func withIcoRoundID(id xid.ID) icoroundOption {
 	}
 }
 
-// withIcoRound sets the old IcoRound of the mutation.
-func withIcoRound(node *IcoRound) icoroundOption {
-	return func(m *IcoRoundMutation) {
-		m.oldValue = func(context.Context) (*IcoRound, error) {
+// withUserWallet sets the old UserWallet of the mutation.
+func withUserWallet(node *UserWallet) userwalletOption {
+	return func(m *UserWalletMutation) {
+		m.oldValue = func(context.Context) (*UserWallet, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3015,7 +12867,7 @@ func withIcoRound(node *IcoRound) icoroundOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IcoRoundMutation) Client() *Client {
+func (m UserWalletMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3023,7 +12875,7 @@ func (m IcoRoundMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IcoRoundMutation) Tx() (*Tx, error) {
+func (m UserWalletMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3033,14 +12885,14 @@ func (m IcoRoundMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of IcoRound entities.
-func (m *IcoRoundMutation) SetID(id xid.ID) {
+// operation is only accepted on creation of UserWallet entities.
+func (m *UserWalletMutation) SetID(id xid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IcoRoundMutation) ID() (id xid.ID, exists bool) {
+func (m *UserWalletMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3051,7 +12903,7 @@ func (m *IcoRoundMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IcoRoundMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *UserWalletMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3060,19 +12912,19 @@ func (m *IcoRoundMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IcoRound.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserWallet.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *IcoRoundMutation) SetCreatedAt(t time.Time) {
+func (m *UserWalletMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IcoRoundMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserWalletMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3080,10 +12932,10 @@ func (m *IcoRoundMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserWalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3098,17 +12950,17 @@ func (m *IcoRoundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IcoRoundMutation) ResetCreatedAt() {
+func (m *UserWalletMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *IcoRoundMutation) SetUpdatedAt(t time.Time) {
+func (m *UserWalletMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IcoRoundMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserWalletMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3116,10 +12968,10 @@ func (m *IcoRoundMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserWalletMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3134,373 +12986,199 @@ func (m *IcoRoundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IcoRoundMutation) ResetUpdatedAt() {
+func (m *UserWalletMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetRoundID sets the "round_id" field.
-func (m *IcoRoundMutation) SetRoundID(i int32) {
-	m.round_id = &i
-	m.addround_id = nil
-}
-
-// RoundID returns the value of the "round_id" field in the mutation.
-func (m *IcoRoundMutation) RoundID() (r int32, exists bool) {
-	v := m.round_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRoundID returns the old "round_id" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldRoundID(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoundID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoundID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoundID: %w", err)
-	}
-	return oldValue.RoundID, nil
-}
-
-// AddRoundID adds i to the "round_id" field.
-func (m *IcoRoundMutation) AddRoundID(i int32) {
-	if m.addround_id != nil {
-		*m.addround_id += i
-	} else {
-		m.addround_id = &i
-	}
-}
-
-// AddedRoundID returns the value that was added to the "round_id" field in this mutation.
-func (m *IcoRoundMutation) AddedRoundID() (r int32, exists bool) {
-	v := m.addround_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetRoundID resets all changes to the "round_id" field.
-func (m *IcoRoundMutation) ResetRoundID() {
-	m.round_id = nil
-	m.addround_id = nil
-}
-
-// SetSubRound sets the "sub_round" field.
-func (m *IcoRoundMutation) SetSubRound(i int32) {
-	m.sub_round = &i
-	m.addsub_round = nil
-}
-
-// SubRound returns the value of the "sub_round" field in the mutation.
-func (m *IcoRoundMutation) SubRound() (r int32, exists bool) {
-	v := m.sub_round
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSubRound returns the old "sub_round" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldSubRound(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubRound is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubRound requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubRound: %w", err)
-	}
-	return oldValue.SubRound, nil
-}
-
-// AddSubRound adds i to the "sub_round" field.
-func (m *IcoRoundMutation) AddSubRound(i int32) {
-	if m.addsub_round != nil {
-		*m.addsub_round += i
-	} else {
-		m.addsub_round = &i
-	}
-}
-
-// AddedSubRound returns the value that was added to the "sub_round" field in this mutation.
-func (m *IcoRoundMutation) AddedSubRound() (r int32, exists bool) {
-	v := m.addsub_round
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSubRound resets all changes to the "sub_round" field.
-func (m *IcoRoundMutation) ResetSubRound() {
-	m.sub_round = nil
-	m.addsub_round = nil
-}
-
-// SetPrice sets the "price" field.
-func (m *IcoRoundMutation) SetPrice(s string) {
-	m.price = &s
-}
-
-// Price returns the value of the "price" field in the mutation.
-func (m *IcoRoundMutation) Price() (r string, exists bool) {
-	v := m.price
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPrice returns the old "price" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldPrice(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
-	}
-	return oldValue.Price, nil
-}
-
-// ResetPrice resets all changes to the "price" field.
-func (m *IcoRoundMutation) ResetPrice() {
-	m.price = nil
-}
-
-// SetNumToken sets the "num_token" field.
-func (m *IcoRoundMutation) SetNumToken(s string) {
-	m.num_token = &s
+// SetUserID sets the "user_id" field.
+func (m *UserWalletMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// NumToken returns the value of the "num_token" field in the mutation.
-func (m *IcoRoundMutation) NumToken() (r string, exists bool) {
-	v := m.num_token
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserWalletMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumToken returns the old "num_token" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldNumToken(ctx context.Context) (v string, err error) {
+func (m *UserWalletMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumToken requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.NumToken, nil
+	return oldValue.UserID, nil
 }
 
-// ResetNumToken resets all changes to the "num_token" field.
-func (m *IcoRoundMutation) ResetNumToken() {
-	m.num_token = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserWalletMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetBoughtToken sets the "bought_token" field.
-func (m *IcoRoundMutation) SetBoughtToken(s string) {
-	m.bought_token = &s
+// SetType sets the "type" field.
+func (m *UserWalletMutation) SetType(s string) {
+	m._type = &s
 }
 
-// BoughtToken returns the value of the "bought_token" field in the mutation.
-func (m *IcoRoundMutation) BoughtToken() (r string, exists bool) {
-	v := m.bought_token
+// GetType returns the value of the "type" field in the mutation.
+func (m *UserWalletMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBoughtToken returns the old "bought_token" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldBoughtToken(ctx context.Context) (v string, err error) {
+func (m *UserWalletMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBoughtToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBoughtToken requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBoughtToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.BoughtToken, nil
+	return oldValue.Type, nil
 }
 
-// ResetBoughtToken resets all changes to the "bought_token" field.
-func (m *IcoRoundMutation) ResetBoughtToken() {
-	m.bought_token = nil
+// ResetType resets all changes to the "type" field.
+func (m *UserWalletMutation) ResetType() {
+	m._type = nil
 }
 
-// SetStartAt sets the "start_at" field.
-func (m *IcoRoundMutation) SetStartAt(t time.Time) {
-	m.start_at = &t
+// SetSymbol sets the "symbol" field.
+func (m *UserWalletMutation) SetSymbol(s string) {
+	m.symbol = &s
 }
 
-// StartAt returns the value of the "start_at" field in the mutation.
-func (m *IcoRoundMutation) StartAt() (r time.Time, exists bool) {
-	v := m.start_at
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *UserWalletMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartAt returns the old "start_at" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldStartAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UserWalletMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartAt requires an ID field in the mutation")
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
 	}
-	return oldValue.StartAt, nil
-}
-
-// ClearStartAt clears the value of the "start_at" field.
-func (m *IcoRoundMutation) ClearStartAt() {
-	m.start_at = nil
-	m.clearedFields[icoround.FieldStartAt] = struct{}{}
-}
-
-// StartAtCleared returns if the "start_at" field was cleared in this mutation.
-func (m *IcoRoundMutation) StartAtCleared() bool {
-	_, ok := m.clearedFields[icoround.FieldStartAt]
-	return ok
+	return oldValue.Symbol, nil
 }
 
-// ResetStartAt resets all changes to the "start_at" field.
-func (m *IcoRoundMutation) ResetStartAt() {
-	m.start_at = nil
-	delete(m.clearedFields, icoround.FieldStartAt)
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *UserWalletMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// SetEndAt sets the "end_at" field.
-func (m *IcoRoundMutation) SetEndAt(t time.Time) {
-	m.end_at = &t
+// SetIsActive sets the "is_active" field.
+func (m *UserWalletMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// EndAt returns the value of the "end_at" field in the mutation.
-func (m *IcoRoundMutation) EndAt() (r time.Time, exists bool) {
-	v := m.end_at
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *UserWalletMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndAt returns the old "end_at" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldEndAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UserWalletMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndAt requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.EndAt, nil
-}
-
-// ClearEndAt clears the value of the "end_at" field.
-func (m *IcoRoundMutation) ClearEndAt() {
-	m.end_at = nil
-	m.clearedFields[icoround.FieldEndAt] = struct{}{}
-}
-
-// EndAtCleared returns if the "end_at" field was cleared in this mutation.
-func (m *IcoRoundMutation) EndAtCleared() bool {
-	_, ok := m.clearedFields[icoround.FieldEndAt]
-	return ok
+	return oldValue.IsActive, nil
 }
 
-// ResetEndAt resets all changes to the "end_at" field.
-func (m *IcoRoundMutation) ResetEndAt() {
-	m.end_at = nil
-	delete(m.clearedFields, icoround.FieldEndAt)
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *UserWalletMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetIsClose sets the "is_close" field.
-func (m *IcoRoundMutation) SetIsClose(b bool) {
-	m.is_close = &b
+// SetBalance sets the "balance" field.
+func (m *UserWalletMutation) SetBalance(s string) {
+	m.balance = &s
 }
 
-// IsClose returns the value of the "is_close" field in the mutation.
-func (m *IcoRoundMutation) IsClose() (r bool, exists bool) {
-	v := m.is_close
+// Balance returns the value of the "balance" field in the mutation.
+func (m *UserWalletMutation) Balance() (r string, exists bool) {
+	v := m.balance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsClose returns the old "is_close" field's value of the IcoRound entity.
-// If the IcoRound object wasn't provided to the builder, the object is fetched from the database.
+// OldBalance returns the old "balance" field's value of the UserWallet entity.
+// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IcoRoundMutation) OldIsClose(ctx context.Context) (v bool, err error) {
+func (m *UserWalletMutation) OldBalance(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsClose is only allowed on UpdateOne operations")
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsClose requires an ID field in the mutation")
+		return v, errors.New("OldBalance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsClose: %w", err)
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
 	}
-	return oldValue.IsClose, nil
+	return oldValue.Balance, nil
 }
 
-// ResetIsClose resets all changes to the "is_close" field.
-func (m *IcoRoundMutation) ResetIsClose() {
-	m.is_close = nil
+// ResetBalance resets all changes to the "balance" field.
+func (m *UserWalletMutation) ResetBalance() {
+	m.balance = nil
 }
 
-// Where appends a list predicates to the IcoRoundMutation builder.
-func (m *IcoRoundMutation) Where(ps ...predicate.IcoRound) {
+// Where appends a list predicates to the UserWalletMutation builder.
+func (m *UserWalletMutation) Where(ps ...predicate.UserWallet) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IcoRoundMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserWalletMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IcoRoundMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IcoRound, len(ps))
+func (m *UserWalletMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserWallet, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3508,83 +13186,68 @@ func (m *IcoRoundMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IcoRoundMutation) Op() Op {
+func (m *UserWalletMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IcoRoundMutation) SetOp(op Op) {
+func (m *UserWalletMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (IcoRound).
-func (m *IcoRoundMutation) Type() string {
+// Type returns the node type of this mutation (UserWallet).
+func (m *UserWalletMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IcoRoundMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *UserWalletMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, icoround.FieldCreatedAt)
+		fields = append(fields, userwallet.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, icoround.FieldUpdatedAt)
-	}
-	if m.round_id != nil {
-		fields = append(fields, icoround.FieldRoundID)
-	}
-	if m.sub_round != nil {
-		fields = append(fields, icoround.FieldSubRound)
-	}
-	if m.price != nil {
-		fields = append(fields, icoround.FieldPrice)
+		fields = append(fields, userwallet.FieldUpdatedAt)
 	}
-	if m.num_token != nil {
-		fields = append(fields, icoround.FieldNumToken)
+	if m.user_id != nil {
+		fields = append(fields, userwallet.FieldUserID)
 	}
-	if m.bought_token != nil {
-		fields = append(fields, icoround.FieldBoughtToken)
+	if m._type != nil {
+		fields = append(fields, userwallet.FieldType)
 	}
-	if m.start_at != nil {
-		fields = append(fields, icoround.FieldStartAt)
+	if m.symbol != nil {
+		fields = append(fields, userwallet.FieldSymbol)
 	}
-	if m.end_at != nil {
-		fields = append(fields, icoround.FieldEndAt)
+	if m.is_active != nil {
+		fields = append(fields, userwallet.FieldIsActive)
 	}
-	if m.is_close != nil {
-		fields = append(fields, icoround.FieldIsClose)
+	if m.balance != nil {
+		fields = append(fields, userwallet.FieldBalance)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *IcoRoundMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case icoround.FieldCreatedAt:
-		return m.CreatedAt()
-	case icoround.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case icoround.FieldRoundID:
-		return m.RoundID()
-	case icoround.FieldSubRound:
-		return m.SubRound()
-	case icoround.FieldPrice:
-		return m.Price()
-	case icoround.FieldNumToken:
-		return m.NumToken()
-	case icoround.FieldBoughtToken:
-		return m.BoughtToken()
-	case icoround.FieldStartAt:
-		return m.StartAt()
-	case icoround.FieldEndAt:
-		return m.EndAt()
-	case icoround.FieldIsClose:
-		return m.IsClose()
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserWalletMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userwallet.FieldCreatedAt:
+		return m.CreatedAt()
+	case userwallet.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case userwallet.FieldUserID:
+		return m.UserID()
+	case userwallet.FieldType:
+		return m.GetType()
+	case userwallet.FieldSymbol:
+		return m.Symbol()
+	case userwallet.FieldIsActive:
+		return m.IsActive()
+	case userwallet.FieldBalance:
+		return m.Balance()
 	}
 	return nil, false
 }
@@ -3592,316 +13255,235 @@ func (m *IcoRoundMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IcoRoundMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserWalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case icoround.FieldCreatedAt:
+	case userwallet.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case icoround.FieldUpdatedAt:
+	case userwallet.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case icoround.FieldRoundID:
-		return m.OldRoundID(ctx)
-	case icoround.FieldSubRound:
-		return m.OldSubRound(ctx)
-	case icoround.FieldPrice:
-		return m.OldPrice(ctx)
-	case icoround.FieldNumToken:
-		return m.OldNumToken(ctx)
-	case icoround.FieldBoughtToken:
-		return m.OldBoughtToken(ctx)
-	case icoround.FieldStartAt:
-		return m.OldStartAt(ctx)
-	case icoround.FieldEndAt:
-		return m.OldEndAt(ctx)
-	case icoround.FieldIsClose:
-		return m.OldIsClose(ctx)
+	case userwallet.FieldUserID:
+		return m.OldUserID(ctx)
+	case userwallet.FieldType:
+		return m.OldType(ctx)
+	case userwallet.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case userwallet.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case userwallet.FieldBalance:
+		return m.OldBalance(ctx)
 	}
-	return nil, fmt.Errorf("unknown IcoRound field %s", name)
+	return nil, fmt.Errorf("unknown UserWallet field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoRoundMutation) SetField(name string, value ent.Value) error {
+func (m *UserWalletMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case icoround.FieldCreatedAt:
+	case userwallet.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case icoround.FieldUpdatedAt:
+	case userwallet.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case icoround.FieldRoundID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoundID(v)
-		return nil
-	case icoround.FieldSubRound:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubRound(v)
-		return nil
-	case icoround.FieldPrice:
+	case userwallet.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetUserID(v)
 		return nil
-	case icoround.FieldNumToken:
+	case userwallet.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumToken(v)
+		m.SetType(v)
 		return nil
-	case icoround.FieldBoughtToken:
+	case userwallet.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBoughtToken(v)
-		return nil
-	case icoround.FieldStartAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartAt(v)
+		m.SetSymbol(v)
 		return nil
-	case icoround.FieldEndAt:
-		v, ok := value.(time.Time)
+	case userwallet.FieldIsActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndAt(v)
+		m.SetIsActive(v)
 		return nil
-	case icoround.FieldIsClose:
-		v, ok := value.(bool)
+	case userwallet.FieldBalance:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsClose(v)
+		m.SetBalance(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IcoRound field %s", name)
+	return fmt.Errorf("unknown UserWallet field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IcoRoundMutation) AddedFields() []string {
-	var fields []string
-	if m.addround_id != nil {
-		fields = append(fields, icoround.FieldRoundID)
-	}
-	if m.addsub_round != nil {
-		fields = append(fields, icoround.FieldSubRound)
-	}
-	return fields
+func (m *UserWalletMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IcoRoundMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case icoround.FieldRoundID:
-		return m.AddedRoundID()
-	case icoround.FieldSubRound:
-		return m.AddedSubRound()
-	}
+func (m *UserWalletMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IcoRoundMutation) AddField(name string, value ent.Value) error {
+func (m *UserWalletMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case icoround.FieldRoundID:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRoundID(v)
-		return nil
-	case icoround.FieldSubRound:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubRound(v)
-		return nil
 	}
-	return fmt.Errorf("unknown IcoRound numeric field %s", name)
+	return fmt.Errorf("unknown UserWallet numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IcoRoundMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(icoround.FieldStartAt) {
-		fields = append(fields, icoround.FieldStartAt)
-	}
-	if m.FieldCleared(icoround.FieldEndAt) {
-		fields = append(fields, icoround.FieldEndAt)
-	}
-	return fields
+func (m *UserWalletMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IcoRoundMutation) FieldCleared(name string) bool {
+func (m *UserWalletMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IcoRoundMutation) ClearField(name string) error {
-	switch name {
-	case icoround.FieldStartAt:
-		m.ClearStartAt()
-		return nil
-	case icoround.FieldEndAt:
-		m.ClearEndAt()
-		return nil
-	}
-	return fmt.Errorf("unknown IcoRound nullable field %s", name)
+func (m *UserWalletMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserWallet nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IcoRoundMutation) ResetField(name string) error {
+func (m *UserWalletMutation) ResetField(name string) error {
 	switch name {
-	case icoround.FieldCreatedAt:
+	case userwallet.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case icoround.FieldUpdatedAt:
+	case userwallet.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case icoround.FieldRoundID:
-		m.ResetRoundID()
-		return nil
-	case icoround.FieldSubRound:
-		m.ResetSubRound()
-		return nil
-	case icoround.FieldPrice:
-		m.ResetPrice()
-		return nil
-	case icoround.FieldNumToken:
-		m.ResetNumToken()
+	case userwallet.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case icoround.FieldBoughtToken:
-		m.ResetBoughtToken()
+	case userwallet.FieldType:
+		m.ResetType()
 		return nil
-	case icoround.FieldStartAt:
-		m.ResetStartAt()
+	case userwallet.FieldSymbol:
+		m.ResetSymbol()
 		return nil
-	case icoround.FieldEndAt:
-		m.ResetEndAt()
+	case userwallet.FieldIsActive:
+		m.ResetIsActive()
 		return nil
-	case icoround.FieldIsClose:
-		m.ResetIsClose()
+	case userwallet.FieldBalance:
+		m.ResetBalance()
 		return nil
 	}
-	return fmt.Errorf("unknown IcoRound field %s", name)
+	return fmt.Errorf("unknown UserWallet field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IcoRoundMutation) AddedEdges() []string {
+func (m *UserWalletMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IcoRoundMutation) AddedIDs(name string) []ent.Value {
+func (m *UserWalletMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IcoRoundMutation) RemovedEdges() []string {
+func (m *UserWalletMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IcoRoundMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserWalletMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IcoRoundMutation) ClearedEdges() []string {
+func (m *UserWalletMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *IcoRoundMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *IcoRoundMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown IcoRound unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *IcoRoundMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown IcoRound edge %s", name)
-}
-
-// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
-type TransactionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *xid.ID
-	created_at     *time.Time
-	updated_at     *time.Time
-	trans_type     *string
-	source         *string
-	src_symbol     *string
-	src_amount     *string
-	destination    *string
-	dest_symbol    *string
-	dest_amount    *string
-	rate           *string
-	source_service *string
-	source_id      *string
-	status         *string
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Transaction, error)
-	predicates     []predicate.Transaction
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserWalletMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-var _ ent.Mutation = (*TransactionMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserWalletMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserWallet unique edge %s", name)
+}
 
-// transactionOption allows management of the mutation configuration using functional options.
-type transactionOption func(*TransactionMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserWalletMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserWallet edge %s", name)
+}
 
-// newTransactionMutation creates new mutation for the Transaction entity.
-func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
-	m := &TransactionMutation{
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *xid.ID
+	created_at        *time.Time
+	updated_at        *time.Time
+	owner             *string
+	url               *string
+	secret            *string
+	trans_types       *[]string
+	appendtrans_types []string
+	user_ids          *[]string
+	appenduser_ids    []string
+	deleted_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTransaction,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3910,20 +13492,20 @@ func newTransactionMutation(c config, op Op, opts ...transactionOption) *Transac
 	return m
 }
 
-// withTransactionID sets the ID field of the mutation.
-func withTransactionID(id xid.ID) transactionOption {
-	return func(m *TransactionMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id xid.ID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Transaction
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Transaction.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3932,10 +13514,10 @@ func withTransactionID(id xid.ID) transactionOption {
 	}
 }
 
-// withTransaction sets the old Transaction of the mutation.
-func withTransaction(node *Transaction) transactionOption {
-	return func(m *TransactionMutation) {
-		m.oldValue = func(context.Context) (*Transaction, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3944,7 +13526,7 @@ func withTransaction(node *Transaction) transactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransactionMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3952,7 +13534,7 @@ func (m TransactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TransactionMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3962,14 +13544,14 @@ func (m TransactionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Transaction entities.
-func (m *TransactionMutation) SetID(id xid.ID) {
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id xid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TransactionMutation) ID() (id xid.ID, exists bool) {
+func (m *WebhookMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3980,7 +13562,7 @@ func (m *TransactionMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TransactionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3989,19 +13571,19 @@ func (m *TransactionMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4009,10 +13591,10 @@ func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4027,17 +13609,17 @@ func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TransactionMutation) ResetCreatedAt() {
+func (m *WebhookMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TransactionMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4045,10 +13627,10 @@ func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4063,415 +13645,306 @@ func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TransactionMutation) ResetUpdatedAt() {
+func (m *WebhookMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetTransType sets the "trans_type" field.
-func (m *TransactionMutation) SetTransType(s string) {
-	m.trans_type = &s
-}
-
-// TransType returns the value of the "trans_type" field in the mutation.
-func (m *TransactionMutation) TransType() (r string, exists bool) {
-	v := m.trans_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTransType returns the old "trans_type" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldTransType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTransType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTransType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTransType: %w", err)
-	}
-	return oldValue.TransType, nil
-}
-
-// ResetTransType resets all changes to the "trans_type" field.
-func (m *TransactionMutation) ResetTransType() {
-	m.trans_type = nil
-}
-
-// SetSource sets the "source" field.
-func (m *TransactionMutation) SetSource(s string) {
-	m.source = &s
-}
-
-// Source returns the value of the "source" field in the mutation.
-func (m *TransactionMutation) Source() (r string, exists bool) {
-	v := m.source
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSource returns the old "source" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSource(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
-	}
-	return oldValue.Source, nil
-}
-
-// ResetSource resets all changes to the "source" field.
-func (m *TransactionMutation) ResetSource() {
-	m.source = nil
-}
-
-// SetSrcSymbol sets the "src_symbol" field.
-func (m *TransactionMutation) SetSrcSymbol(s string) {
-	m.src_symbol = &s
-}
-
-// SrcSymbol returns the value of the "src_symbol" field in the mutation.
-func (m *TransactionMutation) SrcSymbol() (r string, exists bool) {
-	v := m.src_symbol
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSrcSymbol returns the old "src_symbol" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSrcSymbol(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSrcSymbol is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSrcSymbol requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSrcSymbol: %w", err)
-	}
-	return oldValue.SrcSymbol, nil
-}
-
-// ResetSrcSymbol resets all changes to the "src_symbol" field.
-func (m *TransactionMutation) ResetSrcSymbol() {
-	m.src_symbol = nil
-}
-
-// SetSrcAmount sets the "src_amount" field.
-func (m *TransactionMutation) SetSrcAmount(s string) {
-	m.src_amount = &s
-}
-
-// SrcAmount returns the value of the "src_amount" field in the mutation.
-func (m *TransactionMutation) SrcAmount() (r string, exists bool) {
-	v := m.src_amount
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSrcAmount returns the old "src_amount" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSrcAmount(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSrcAmount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSrcAmount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSrcAmount: %w", err)
-	}
-	return oldValue.SrcAmount, nil
-}
-
-// ResetSrcAmount resets all changes to the "src_amount" field.
-func (m *TransactionMutation) ResetSrcAmount() {
-	m.src_amount = nil
-}
-
-// SetDestination sets the "destination" field.
-func (m *TransactionMutation) SetDestination(s string) {
-	m.destination = &s
-}
-
-// Destination returns the value of the "destination" field in the mutation.
-func (m *TransactionMutation) Destination() (r string, exists bool) {
-	v := m.destination
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDestination returns the old "destination" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDestination(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDestination requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
-	}
-	return oldValue.Destination, nil
-}
-
-// ResetDestination resets all changes to the "destination" field.
-func (m *TransactionMutation) ResetDestination() {
-	m.destination = nil
-}
-
-// SetDestSymbol sets the "dest_symbol" field.
-func (m *TransactionMutation) SetDestSymbol(s string) {
-	m.dest_symbol = &s
+// SetOwner sets the "owner" field.
+func (m *WebhookMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// DestSymbol returns the value of the "dest_symbol" field in the mutation.
-func (m *TransactionMutation) DestSymbol() (r string, exists bool) {
-	v := m.dest_symbol
+// Owner returns the value of the "owner" field in the mutation.
+func (m *WebhookMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDestSymbol returns the old "dest_symbol" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDestSymbol(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDestSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDestSymbol requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDestSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.DestSymbol, nil
-}
-
-// ResetDestSymbol resets all changes to the "dest_symbol" field.
-func (m *TransactionMutation) ResetDestSymbol() {
-	m.dest_symbol = nil
+	return oldValue.Owner, nil
 }
 
-// SetDestAmount sets the "dest_amount" field.
-func (m *TransactionMutation) SetDestAmount(s string) {
-	m.dest_amount = &s
+// ResetOwner resets all changes to the "owner" field.
+func (m *WebhookMutation) ResetOwner() {
+	m.owner = nil
 }
 
-// DestAmount returns the value of the "dest_amount" field in the mutation.
-func (m *TransactionMutation) DestAmount() (r string, exists bool) {
-	v := m.dest_amount
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDestAmount returns the old "dest_amount" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDestAmount(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDestAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDestAmount requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDestAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.DestAmount, nil
+	return oldValue.URL, nil
 }
 
-// ResetDestAmount resets all changes to the "dest_amount" field.
-func (m *TransactionMutation) ResetDestAmount() {
-	m.dest_amount = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetRate sets the "rate" field.
-func (m *TransactionMutation) SetRate(s string) {
-	m.rate = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Rate returns the value of the "rate" field in the mutation.
-func (m *TransactionMutation) Rate() (r string, exists bool) {
-	v := m.rate
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRate returns the old "rate" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldRate(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRate requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Rate, nil
+	return oldValue.Secret, nil
 }
 
-// ResetRate resets all changes to the "rate" field.
-func (m *TransactionMutation) ResetRate() {
-	m.rate = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetSourceService sets the "source_service" field.
-func (m *TransactionMutation) SetSourceService(s string) {
-	m.source_service = &s
+// SetTransTypes sets the "trans_types" field.
+func (m *WebhookMutation) SetTransTypes(s []string) {
+	m.trans_types = &s
+	m.appendtrans_types = nil
 }
 
-// SourceService returns the value of the "source_service" field in the mutation.
-func (m *TransactionMutation) SourceService() (r string, exists bool) {
-	v := m.source_service
+// TransTypes returns the value of the "trans_types" field in the mutation.
+func (m *WebhookMutation) TransTypes() (r []string, exists bool) {
+	v := m.trans_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceService returns the old "source_service" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldTransTypes returns the old "trans_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSourceService(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldTransTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceService is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceService requires an ID field in the mutation")
+		return v, errors.New("OldTransTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceService: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransTypes: %w", err)
 	}
-	return oldValue.SourceService, nil
+	return oldValue.TransTypes, nil
 }
 
-// ResetSourceService resets all changes to the "source_service" field.
-func (m *TransactionMutation) ResetSourceService() {
-	m.source_service = nil
+// AppendTransTypes adds s to the "trans_types" field.
+func (m *WebhookMutation) AppendTransTypes(s []string) {
+	m.appendtrans_types = append(m.appendtrans_types, s...)
 }
 
-// SetSourceID sets the "source_id" field.
-func (m *TransactionMutation) SetSourceID(s string) {
-	m.source_id = &s
+// AppendedTransTypes returns the list of values that were appended to the "trans_types" field in this mutation.
+func (m *WebhookMutation) AppendedTransTypes() ([]string, bool) {
+	if len(m.appendtrans_types) == 0 {
+		return nil, false
+	}
+	return m.appendtrans_types, true
 }
 
-// SourceID returns the value of the "source_id" field in the mutation.
-func (m *TransactionMutation) SourceID() (r string, exists bool) {
-	v := m.source_id
+// ClearTransTypes clears the value of the "trans_types" field.
+func (m *WebhookMutation) ClearTransTypes() {
+	m.trans_types = nil
+	m.appendtrans_types = nil
+	m.clearedFields[webhook.FieldTransTypes] = struct{}{}
+}
+
+// TransTypesCleared returns if the "trans_types" field was cleared in this mutation.
+func (m *WebhookMutation) TransTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTransTypes]
+	return ok
+}
+
+// ResetTransTypes resets all changes to the "trans_types" field.
+func (m *WebhookMutation) ResetTransTypes() {
+	m.trans_types = nil
+	m.appendtrans_types = nil
+	delete(m.clearedFields, webhook.FieldTransTypes)
+}
+
+// SetUserIds sets the "user_ids" field.
+func (m *WebhookMutation) SetUserIds(s []string) {
+	m.user_ids = &s
+	m.appenduser_ids = nil
+}
+
+// UserIds returns the value of the "user_ids" field in the mutation.
+func (m *WebhookMutation) UserIds() (r []string, exists bool) {
+	v := m.user_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceID returns the old "source_id" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldUserIds returns the old "user_ids" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldSourceID(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldUserIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceID requires an ID field in the mutation")
+		return v, errors.New("OldUserIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserIds: %w", err)
 	}
-	return oldValue.SourceID, nil
+	return oldValue.UserIds, nil
 }
 
-// ResetSourceID resets all changes to the "source_id" field.
-func (m *TransactionMutation) ResetSourceID() {
-	m.source_id = nil
+// AppendUserIds adds s to the "user_ids" field.
+func (m *WebhookMutation) AppendUserIds(s []string) {
+	m.appenduser_ids = append(m.appenduser_ids, s...)
 }
 
-// SetStatus sets the "status" field.
-func (m *TransactionMutation) SetStatus(s string) {
-	m.status = &s
+// AppendedUserIds returns the list of values that were appended to the "user_ids" field in this mutation.
+func (m *WebhookMutation) AppendedUserIds() ([]string, bool) {
+	if len(m.appenduser_ids) == 0 {
+		return nil, false
+	}
+	return m.appenduser_ids, true
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TransactionMutation) Status() (r string, exists bool) {
-	v := m.status
+// ClearUserIds clears the value of the "user_ids" field.
+func (m *WebhookMutation) ClearUserIds() {
+	m.user_ids = nil
+	m.appenduser_ids = nil
+	m.clearedFields[webhook.FieldUserIds] = struct{}{}
+}
+
+// UserIdsCleared returns if the "user_ids" field was cleared in this mutation.
+func (m *WebhookMutation) UserIdsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldUserIds]
+	return ok
+}
+
+// ResetUserIds resets all changes to the "user_ids" field.
+func (m *WebhookMutation) ResetUserIds() {
+	m.user_ids = nil
+	m.appenduser_ids = nil
+	delete(m.clearedFields, webhook.FieldUserIds)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *WebhookMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *WebhookMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TransactionMutation) ResetStatus() {
-	m.status = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *WebhookMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[webhook.FieldDeletedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the TransactionMutation builder.
-func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *WebhookMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *WebhookMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, webhook.FieldDeletedAt)
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transaction, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4479,63 +13952,48 @@ func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransactionMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransactionMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Transaction).
-func (m *TransactionMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransactionMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, transaction.FieldCreatedAt)
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, transaction.FieldUpdatedAt)
+		fields = append(fields, webhook.FieldUpdatedAt)
 	}
-	if m.trans_type != nil {
-		fields = append(fields, transaction.FieldTransType)
-	}
-	if m.source != nil {
-		fields = append(fields, transaction.FieldSource)
-	}
-	if m.src_symbol != nil {
-		fields = append(fields, transaction.FieldSrcSymbol)
-	}
-	if m.src_amount != nil {
-		fields = append(fields, transaction.FieldSrcAmount)
-	}
-	if m.destination != nil {
-		fields = append(fields, transaction.FieldDestination)
-	}
-	if m.dest_symbol != nil {
-		fields = append(fields, transaction.FieldDestSymbol)
+	if m.owner != nil {
+		fields = append(fields, webhook.FieldOwner)
 	}
-	if m.dest_amount != nil {
-		fields = append(fields, transaction.FieldDestAmount)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.rate != nil {
-		fields = append(fields, transaction.FieldRate)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.source_service != nil {
-		fields = append(fields, transaction.FieldSourceService)
+	if m.trans_types != nil {
+		fields = append(fields, webhook.FieldTransTypes)
 	}
-	if m.source_id != nil {
-		fields = append(fields, transaction.FieldSourceID)
+	if m.user_ids != nil {
+		fields = append(fields, webhook.FieldUserIds)
 	}
-	if m.status != nil {
-		fields = append(fields, transaction.FieldStatus)
+	if m.deleted_at != nil {
+		fields = append(fields, webhook.FieldDeletedAt)
 	}
 	return fields
 }
@@ -4543,34 +14001,24 @@ func (m *TransactionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transaction.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		return m.CreatedAt()
-	case transaction.FieldUpdatedAt:
+	case webhook.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case transaction.FieldTransType:
-		return m.TransType()
-	case transaction.FieldSource:
-		return m.Source()
-	case transaction.FieldSrcSymbol:
-		return m.SrcSymbol()
-	case transaction.FieldSrcAmount:
-		return m.SrcAmount()
-	case transaction.FieldDestination:
-		return m.Destination()
-	case transaction.FieldDestSymbol:
-		return m.DestSymbol()
-	case transaction.FieldDestAmount:
-		return m.DestAmount()
-	case transaction.FieldRate:
-		return m.Rate()
-	case transaction.FieldSourceService:
-		return m.SourceService()
-	case transaction.FieldSourceID:
-		return m.SourceID()
-	case transaction.FieldStatus:
-		return m.Status()
+	case webhook.FieldOwner:
+		return m.Owner()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldTransTypes:
+		return m.TransTypes()
+	case webhook.FieldUserIds:
+		return m.UserIds()
+	case webhook.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -4578,304 +14026,271 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case transaction.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case transaction.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case transaction.FieldTransType:
-		return m.OldTransType(ctx)
-	case transaction.FieldSource:
-		return m.OldSource(ctx)
-	case transaction.FieldSrcSymbol:
-		return m.OldSrcSymbol(ctx)
-	case transaction.FieldSrcAmount:
-		return m.OldSrcAmount(ctx)
-	case transaction.FieldDestination:
-		return m.OldDestination(ctx)
-	case transaction.FieldDestSymbol:
-		return m.OldDestSymbol(ctx)
-	case transaction.FieldDestAmount:
-		return m.OldDestAmount(ctx)
-	case transaction.FieldRate:
-		return m.OldRate(ctx)
-	case transaction.FieldSourceService:
-		return m.OldSourceService(ctx)
-	case transaction.FieldSourceID:
-		return m.OldSourceID(ctx)
-	case transaction.FieldStatus:
-		return m.OldStatus(ctx)
-	}
-	return nil, fmt.Errorf("unknown Transaction field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TransactionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case transaction.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case transaction.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case transaction.FieldTransType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTransType(v)
-		return nil
-	case transaction.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case transaction.FieldSrcSymbol:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSrcSymbol(v)
-		return nil
-	case transaction.FieldSrcAmount:
-		v, ok := value.(string)
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhook.FieldOwner:
+		return m.OldOwner(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldTransTypes:
+		return m.OldTransTypes(ctx)
+	case webhook.FieldUserIds:
+		return m.OldUserIds(ctx)
+	case webhook.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSrcAmount(v)
+		m.SetCreatedAt(v)
 		return nil
-	case transaction.FieldDestination:
-		v, ok := value.(string)
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDestination(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case transaction.FieldDestSymbol:
+	case webhook.FieldOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDestSymbol(v)
+		m.SetOwner(v)
 		return nil
-	case transaction.FieldDestAmount:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDestAmount(v)
+		m.SetURL(v)
 		return nil
-	case transaction.FieldRate:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRate(v)
+		m.SetSecret(v)
 		return nil
-	case transaction.FieldSourceService:
-		v, ok := value.(string)
+	case webhook.FieldTransTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceService(v)
+		m.SetTransTypes(v)
 		return nil
-	case transaction.FieldSourceID:
-		v, ok := value.(string)
+	case webhook.FieldUserIds:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceID(v)
+		m.SetUserIds(v)
 		return nil
-	case transaction.FieldStatus:
-		v, ok := value.(string)
+	case webhook.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransactionMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Transaction numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransactionMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldTransTypes) {
+		fields = append(fields, webhook.FieldTransTypes)
+	}
+	if m.FieldCleared(webhook.FieldUserIds) {
+		fields = append(fields, webhook.FieldUserIds)
+	}
+	if m.FieldCleared(webhook.FieldDeletedAt) {
+		fields = append(fields, webhook.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransactionMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransactionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Transaction nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldTransTypes:
+		m.ClearTransTypes()
+		return nil
+	case webhook.FieldUserIds:
+		m.ClearUserIds()
+		return nil
+	case webhook.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransactionMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case transaction.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case transaction.FieldUpdatedAt:
+	case webhook.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case transaction.FieldTransType:
-		m.ResetTransType()
-		return nil
-	case transaction.FieldSource:
-		m.ResetSource()
-		return nil
-	case transaction.FieldSrcSymbol:
-		m.ResetSrcSymbol()
-		return nil
-	case transaction.FieldSrcAmount:
-		m.ResetSrcAmount()
-		return nil
-	case transaction.FieldDestination:
-		m.ResetDestination()
-		return nil
-	case transaction.FieldDestSymbol:
-		m.ResetDestSymbol()
+	case webhook.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case transaction.FieldDestAmount:
-		m.ResetDestAmount()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case transaction.FieldRate:
-		m.ResetRate()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case transaction.FieldSourceService:
-		m.ResetSourceService()
+	case webhook.FieldTransTypes:
+		m.ResetTransTypes()
 		return nil
-	case transaction.FieldSourceID:
-		m.ResetSourceID()
+	case webhook.FieldUserIds:
+		m.ResetUserIds()
 		return nil
-	case transaction.FieldStatus:
-		m.ResetStatus()
+	case webhook.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransactionMutation) AddedEdges() []string {
+func (m *WebhookMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransactionMutation) RemovedEdges() []string {
+func (m *WebhookMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransactionMutation) ClearedEdges() []string {
+func (m *WebhookMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransactionMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransactionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Transaction unique edge %s", name)
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransactionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Transaction edge %s", name)
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// UserWalletMutation represents an operation that mutates the UserWallet nodes in the graph.
-type UserWalletMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *xid.ID
-	created_at    *time.Time
-	updated_at    *time.Time
-	user_id       *string
-	_type         *string
-	symbol        *string
-	is_active     *bool
-	balance       *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*UserWallet, error)
-	predicates    []predicate.UserWallet
-}
-
-var _ ent.Mutation = (*UserWalletMutation)(nil)
-
-// userwalletOption allows management of the mutation configuration using functional options.
-type userwalletOption func(*UserWalletMutation)
-
-// newUserWalletMutation creates new mutation for the UserWallet entity.
-func newUserWalletMutation(c config, op Op, opts ...userwalletOption) *UserWalletMutation {
-	m := &UserWalletMutation{
+	op               Op
+	typ              string
+	id               *xid.ID
+	created_at       *time.Time
+	updated_at       *time.Time
+	webhook_id       *string
+	event_id         *string
+	event_type       *string
+	payload          *string
+	status           *string
+	attempts         *int32
+	addattempts      *int32
+	response_code    *int32
+	addresponse_code *int32
+	last_error       *string
+	delivered_at     *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*WebhookDelivery, error)
+	predicates       []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserWallet,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4884,20 +14299,20 @@ func newUserWalletMutation(c config, op Op, opts ...userwalletOption) *UserWalle
 	return m
 }
 
-// withUserWalletID sets the ID field of the mutation.
-func withUserWalletID(id xid.ID) userwalletOption {
-	return func(m *UserWalletMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id xid.ID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserWallet
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*UserWallet, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserWallet.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4906,10 +14321,10 @@ func withUserWalletID(id xid.ID) userwalletOption {
 	}
 }
 
-// withUserWallet sets the old UserWallet of the mutation.
-func withUserWallet(node *UserWallet) userwalletOption {
-	return func(m *UserWalletMutation) {
-		m.oldValue = func(context.Context) (*UserWallet, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4918,7 +14333,7 @@ func withUserWallet(node *UserWallet) userwalletOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserWalletMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4926,7 +14341,7 @@ func (m UserWalletMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserWalletMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4936,14 +14351,14 @@ func (m UserWalletMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of UserWallet entities.
-func (m *UserWalletMutation) SetID(id xid.ID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id xid.ID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserWalletMutation) ID() (id xid.ID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id xid.ID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4954,7 +14369,7 @@ func (m *UserWalletMutation) ID() (id xid.ID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserWalletMutation) IDs(ctx context.Context) ([]xid.ID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4963,19 +14378,19 @@ func (m *UserWalletMutation) IDs(ctx context.Context) ([]xid.ID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserWallet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserWalletMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserWalletMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4983,10 +14398,10 @@ func (m *UserWalletMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5001,17 +14416,17 @@ func (m *UserWalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserWalletMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserWalletMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserWalletMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5019,10 +14434,10 @@ func (m *UserWalletMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5037,199 +14452,409 @@ func (m *UserWalletMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserWalletMutation) ResetUpdatedAt() {
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserWalletMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(s string) {
+	m.webhook_id = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserWalletMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r string, exists bool) {
+	v := m.webhook_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.WebhookID, nil
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook_id = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserWalletMutation) ResetUserID() {
-	m.user_id = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetType sets the "type" field.
-func (m *UserWalletMutation) SetType(s string) {
-	m._type = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int32) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *UserWalletMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int32, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *UserWalletMutation) ResetType() {
-	m._type = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int32) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *UserWalletMutation) SetSymbol(s string) {
-	m.symbol = &s
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int32, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *UserWalletMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetResponseCode sets the "response_code" field.
+func (m *WebhookDeliveryMutation) SetResponseCode(i int32) {
+	m.response_code = &i
+	m.addresponse_code = nil
+}
+
+// ResponseCode returns the value of the "response_code" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseCode() (r int32, exists bool) {
+	v := m.response_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseCode returns the old "response_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldResponseCode(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldResponseCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseCode: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.ResponseCode, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *UserWalletMutation) ResetSymbol() {
-	m.symbol = nil
+// AddResponseCode adds i to the "response_code" field.
+func (m *WebhookDeliveryMutation) AddResponseCode(i int32) {
+	if m.addresponse_code != nil {
+		*m.addresponse_code += i
+	} else {
+		m.addresponse_code = &i
+	}
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *UserWalletMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// AddedResponseCode returns the value that was added to the "response_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseCode() (r int32, exists bool) {
+	v := m.addresponse_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *UserWalletMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// ResetResponseCode resets all changes to the "response_code" field.
+func (m *WebhookDeliveryMutation) ResetResponseCode() {
+	m.response_code = nil
+	m.addresponse_code = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.LastError, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *UserWalletMutation) ResetIsActive() {
-	m.is_active = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// SetBalance sets the "balance" field.
-func (m *UserWalletMutation) SetBalance(s string) {
-	m.balance = &s
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// Balance returns the value of the "balance" field in the mutation.
-func (m *UserWalletMutation) Balance() (r string, exists bool) {
-	v := m.balance
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBalance returns the old "balance" field's value of the UserWallet entity.
-// If the UserWallet object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserWalletMutation) OldBalance(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBalance requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.Balance, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetBalance resets all changes to the "balance" field.
-func (m *UserWalletMutation) ResetBalance() {
-	m.balance = nil
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// Where appends a list predicates to the UserWalletMutation builder.
-func (m *UserWalletMutation) Where(ps ...predicate.UserWallet) {
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserWalletMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserWalletMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserWallet, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5237,45 +14862,57 @@ func (m *UserWalletMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserWalletMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserWalletMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserWallet).
-func (m *UserWalletMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserWalletMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, userwallet.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, userwallet.FieldUpdatedAt)
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
-	if m.user_id != nil {
-		fields = append(fields, userwallet.FieldUserID)
+	if m.webhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m._type != nil {
-		fields = append(fields, userwallet.FieldType)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
-	if m.symbol != nil {
-		fields = append(fields, userwallet.FieldSymbol)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.is_active != nil {
-		fields = append(fields, userwallet.FieldIsActive)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.balance != nil {
-		fields = append(fields, userwallet.FieldBalance)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.response_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -5283,22 +14920,30 @@ func (m *UserWalletMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserWalletMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userwallet.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case userwallet.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case userwallet.FieldUserID:
-		return m.UserID()
-	case userwallet.FieldType:
-		return m.GetType()
-	case userwallet.FieldSymbol:
-		return m.Symbol()
-	case userwallet.FieldIsActive:
-		return m.IsActive()
-	case userwallet.FieldBalance:
-		return m.Balance()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.ResponseCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -5306,198 +14951,288 @@ func (m *UserWalletMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserWalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userwallet.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case userwallet.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case userwallet.FieldUserID:
-		return m.OldUserID(ctx)
-	case userwallet.FieldType:
-		return m.OldType(ctx)
-	case userwallet.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case userwallet.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case userwallet.FieldBalance:
-		return m.OldBalance(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseCode:
+		return m.OldResponseCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserWallet field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserWalletMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userwallet.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case userwallet.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case userwallet.FieldUserID:
+	case webhookdelivery.FieldWebhookID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetWebhookID(v)
 		return nil
-	case userwallet.FieldType:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetEventID(v)
 		return nil
-	case userwallet.FieldSymbol:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
+		m.SetEventType(v)
 		return nil
-	case userwallet.FieldIsActive:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetPayload(v)
 		return nil
-	case userwallet.FieldBalance:
+	case webhookdelivery.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBalance(v)
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseCode(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserWallet field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserWalletMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserWalletMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseCode:
+		return m.AddedResponseCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserWalletMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UserWallet numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserWalletMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserWalletMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserWalletMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserWallet nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserWalletMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case userwallet.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case userwallet.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case userwallet.FieldUserID:
-		m.ResetUserID()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case userwallet.FieldType:
-		m.ResetType()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case userwallet.FieldSymbol:
-		m.ResetSymbol()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case userwallet.FieldIsActive:
-		m.ResetIsActive()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case userwallet.FieldBalance:
-		m.ResetBalance()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldResponseCode:
+		m.ResetResponseCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserWallet field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserWalletMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserWalletMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserWalletMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserWalletMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserWalletMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserWalletMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserWalletMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown UserWallet unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserWalletMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown UserWallet edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AlertRule is the predicate function for alertrule builders.
+type AlertRule func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // CurrencyRate is the predicate function for currencyrate builders.
 type CurrencyRate func(*sql.Selector)
 
@@ -15,14 +21,41 @@ type Ico func(*sql.Selector)
 // IcoCoupon is the predicate function for icocoupon builders.
 type IcoCoupon func(*sql.Selector)
 
+// IcoCouponRedemption is the predicate function for icocouponredemption builders.
+type IcoCouponRedemption func(*sql.Selector)
+
+// IcoDailyStat is the predicate function for icodailystat builders.
+type IcoDailyStat func(*sql.Selector)
+
 // IcoHistory is the predicate function for icohistory builders.
 type IcoHistory func(*sql.Selector)
 
+// IcoOutstandingRefund is the predicate function for icooutstandingrefund builders.
+type IcoOutstandingRefund func(*sql.Selector)
+
 // IcoRound is the predicate function for icoround builders.
 type IcoRound func(*sql.Selector)
 
+// LeaderboardSnapshot is the predicate function for leaderboardsnapshot builders.
+type LeaderboardSnapshot func(*sql.Selector)
+
+// Referral is the predicate function for referral builders.
+type Referral func(*sql.Selector)
+
+// ReferralCommission is the predicate function for referralcommission builders.
+type ReferralCommission func(*sql.Selector)
+
+// TokenomicVersion is the predicate function for tokenomicversion builders.
+type TokenomicVersion func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
 // UserWallet is the predicate function for userwallet builders.
 type UserWallet func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
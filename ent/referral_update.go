@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/referral"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReferralMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ru *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReferralUpdate) SetUpdatedAt(t time.Time) *ReferralUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetUserID sets the "user_id" field.
+func (ru *ReferralUpdate) SetUserID(s string) *ReferralUpdate {
+	ru.mutation.SetUserID(s)
+	return ru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableUserID(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetUserID(*s)
+	}
+	return ru
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (ru *ReferralUpdate) SetReferrerID(s string) *ReferralUpdate {
+	ru.mutation.SetReferrerID(s)
+	return ru
+}
+
+// SetNillableReferrerID sets the "referrer_id" field if the given value is not nil.
+func (ru *ReferralUpdate) SetNillableReferrerID(s *string) *ReferralUpdate {
+	if s != nil {
+		ru.SetReferrerID(*s)
+	}
+	return ru
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ru *ReferralUpdate) Mutation() *ReferralMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReferralUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := referral.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReferralUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReferralUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReferralUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(referral.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.UserID(); ok {
+		_spec.SetField(referral.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.ReferrerID(); ok {
+		_spec.SetField(referral.FieldReferrerID, field.TypeString, value)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReferralMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReferralUpdateOne) SetUpdatedAt(t time.Time) *ReferralUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetUserID sets the "user_id" field.
+func (ruo *ReferralUpdateOne) SetUserID(s string) *ReferralUpdateOne {
+	ruo.mutation.SetUserID(s)
+	return ruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableUserID(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetUserID(*s)
+	}
+	return ruo
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (ruo *ReferralUpdateOne) SetReferrerID(s string) *ReferralUpdateOne {
+	ruo.mutation.SetReferrerID(s)
+	return ruo
+}
+
+// SetNillableReferrerID sets the "referrer_id" field if the given value is not nil.
+func (ruo *ReferralUpdateOne) SetNillableReferrerID(s *string) *ReferralUpdateOne {
+	if s != nil {
+		ruo.SetReferrerID(*s)
+	}
+	return ruo
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (ruo *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (ruo *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (ruo *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReferralUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := referral.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReferralUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReferralUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(referral.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.UserID(); ok {
+		_spec.SetField(referral.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.ReferrerID(); ok {
+		_spec.SetField(referral.FieldReferrerID, field.TypeString, value)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Referral{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
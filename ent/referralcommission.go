@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/rs/xid"
+)
+
+// ReferralCommission is the model entity for the ReferralCommission schema.
+type ReferralCommission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID string `json:"buyer_id,omitempty"`
+	// Coupon holds the value of the "coupon" field.
+	Coupon string `json:"coupon,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID string `json:"source_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol       string `json:"symbol,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReferralCommission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referralcommission.FieldLevel:
+			values[i] = new(sql.NullInt64)
+		case referralcommission.FieldUserID, referralcommission.FieldBuyerID, referralcommission.FieldCoupon, referralcommission.FieldSourceID, referralcommission.FieldAmount, referralcommission.FieldSymbol:
+			values[i] = new(sql.NullString)
+		case referralcommission.FieldCreatedAt, referralcommission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case referralcommission.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReferralCommission fields.
+func (rc *ReferralCommission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referralcommission.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rc.ID = *value
+			}
+		case referralcommission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rc.CreatedAt = value.Time
+			}
+		case referralcommission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rc.UpdatedAt = value.Time
+			}
+		case referralcommission.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rc.UserID = value.String
+			}
+		case referralcommission.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				rc.Level = int(value.Int64)
+			}
+		case referralcommission.FieldBuyerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value.Valid {
+				rc.BuyerID = value.String
+			}
+		case referralcommission.FieldCoupon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon", values[i])
+			} else if value.Valid {
+				rc.Coupon = value.String
+			}
+		case referralcommission.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				rc.SourceID = value.String
+			}
+		case referralcommission.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				rc.Amount = value.String
+			}
+		case referralcommission.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				rc.Symbol = value.String
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReferralCommission.
+// This includes values selected through modifiers, order, etc.
+func (rc *ReferralCommission) Value(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReferralCommission.
+// Note that you need to call ReferralCommission.Unwrap() before calling this method if this ReferralCommission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *ReferralCommission) Update() *ReferralCommissionUpdateOne {
+	return NewReferralCommissionClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the ReferralCommission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *ReferralCommission) Unwrap() *ReferralCommission {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReferralCommission is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *ReferralCommission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReferralCommission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(rc.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Level))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(rc.BuyerID)
+	builder.WriteString(", ")
+	builder.WriteString("coupon=")
+	builder.WriteString(rc.Coupon)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(rc.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(rc.Amount)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(rc.Symbol)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReferralCommissions is a parsable slice of ReferralCommission.
+type ReferralCommissions []*ReferralCommission
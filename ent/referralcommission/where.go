@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referralcommission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUserID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldLevel, v))
+}
+
+// BuyerID applies equality check predicate on the "buyer_id" field. It's identical to BuyerIDEQ.
+func BuyerID(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldBuyerID, v))
+}
+
+// Coupon applies equality check predicate on the "coupon" field. It's identical to CouponEQ.
+func Coupon(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCoupon, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAmount, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSymbol, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldLevel, v))
+}
+
+// BuyerIDEQ applies the EQ predicate on the "buyer_id" field.
+func BuyerIDEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldBuyerID, v))
+}
+
+// BuyerIDNEQ applies the NEQ predicate on the "buyer_id" field.
+func BuyerIDNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldBuyerID, v))
+}
+
+// BuyerIDIn applies the In predicate on the "buyer_id" field.
+func BuyerIDIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldBuyerID, vs...))
+}
+
+// BuyerIDNotIn applies the NotIn predicate on the "buyer_id" field.
+func BuyerIDNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldBuyerID, vs...))
+}
+
+// BuyerIDGT applies the GT predicate on the "buyer_id" field.
+func BuyerIDGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldBuyerID, v))
+}
+
+// BuyerIDGTE applies the GTE predicate on the "buyer_id" field.
+func BuyerIDGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldBuyerID, v))
+}
+
+// BuyerIDLT applies the LT predicate on the "buyer_id" field.
+func BuyerIDLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldBuyerID, v))
+}
+
+// BuyerIDLTE applies the LTE predicate on the "buyer_id" field.
+func BuyerIDLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldBuyerID, v))
+}
+
+// BuyerIDContains applies the Contains predicate on the "buyer_id" field.
+func BuyerIDContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldBuyerID, v))
+}
+
+// BuyerIDHasPrefix applies the HasPrefix predicate on the "buyer_id" field.
+func BuyerIDHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldBuyerID, v))
+}
+
+// BuyerIDHasSuffix applies the HasSuffix predicate on the "buyer_id" field.
+func BuyerIDHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldBuyerID, v))
+}
+
+// BuyerIDEqualFold applies the EqualFold predicate on the "buyer_id" field.
+func BuyerIDEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldBuyerID, v))
+}
+
+// BuyerIDContainsFold applies the ContainsFold predicate on the "buyer_id" field.
+func BuyerIDContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldBuyerID, v))
+}
+
+// CouponEQ applies the EQ predicate on the "coupon" field.
+func CouponEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCoupon, v))
+}
+
+// CouponNEQ applies the NEQ predicate on the "coupon" field.
+func CouponNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldCoupon, v))
+}
+
+// CouponIn applies the In predicate on the "coupon" field.
+func CouponIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldCoupon, vs...))
+}
+
+// CouponNotIn applies the NotIn predicate on the "coupon" field.
+func CouponNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldCoupon, vs...))
+}
+
+// CouponGT applies the GT predicate on the "coupon" field.
+func CouponGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldCoupon, v))
+}
+
+// CouponGTE applies the GTE predicate on the "coupon" field.
+func CouponGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldCoupon, v))
+}
+
+// CouponLT applies the LT predicate on the "coupon" field.
+func CouponLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldCoupon, v))
+}
+
+// CouponLTE applies the LTE predicate on the "coupon" field.
+func CouponLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldCoupon, v))
+}
+
+// CouponContains applies the Contains predicate on the "coupon" field.
+func CouponContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldCoupon, v))
+}
+
+// CouponHasPrefix applies the HasPrefix predicate on the "coupon" field.
+func CouponHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldCoupon, v))
+}
+
+// CouponHasSuffix applies the HasSuffix predicate on the "coupon" field.
+func CouponHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldCoupon, v))
+}
+
+// CouponEqualFold applies the EqualFold predicate on the "coupon" field.
+func CouponEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldCoupon, v))
+}
+
+// CouponContainsFold applies the ContainsFold predicate on the "coupon" field.
+func CouponContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldCoupon, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldAmount, v))
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldAmount, v))
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldAmount, v))
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldAmount, v))
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldAmount, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.NotPredicates(p))
+}
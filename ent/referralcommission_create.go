@@ -0,0 +1,943 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/rs/xid"
+)
+
+// ReferralCommissionCreate is the builder for creating a ReferralCommission entity.
+type ReferralCommissionCreate struct {
+	config
+	mutation *ReferralCommissionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *ReferralCommissionCreate) SetCreatedAt(t time.Time) *ReferralCommissionCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *ReferralCommissionCreate) SetNillableCreatedAt(t *time.Time) *ReferralCommissionCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcc *ReferralCommissionCreate) SetUpdatedAt(t time.Time) *ReferralCommissionCreate {
+	rcc.mutation.SetUpdatedAt(t)
+	return rcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcc *ReferralCommissionCreate) SetNillableUpdatedAt(t *time.Time) *ReferralCommissionCreate {
+	if t != nil {
+		rcc.SetUpdatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUserID sets the "user_id" field.
+func (rcc *ReferralCommissionCreate) SetUserID(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetUserID(s)
+	return rcc
+}
+
+// SetLevel sets the "level" field.
+func (rcc *ReferralCommissionCreate) SetLevel(i int) *ReferralCommissionCreate {
+	rcc.mutation.SetLevel(i)
+	return rcc
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (rcc *ReferralCommissionCreate) SetBuyerID(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetBuyerID(s)
+	return rcc
+}
+
+// SetCoupon sets the "coupon" field.
+func (rcc *ReferralCommissionCreate) SetCoupon(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetCoupon(s)
+	return rcc
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcc *ReferralCommissionCreate) SetSourceID(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetSourceID(s)
+	return rcc
+}
+
+// SetAmount sets the "amount" field.
+func (rcc *ReferralCommissionCreate) SetAmount(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetAmount(s)
+	return rcc
+}
+
+// SetSymbol sets the "symbol" field.
+func (rcc *ReferralCommissionCreate) SetSymbol(s string) *ReferralCommissionCreate {
+	rcc.mutation.SetSymbol(s)
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *ReferralCommissionCreate) SetID(x xid.ID) *ReferralCommissionCreate {
+	rcc.mutation.SetID(x)
+	return rcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rcc *ReferralCommissionCreate) SetNillableID(x *xid.ID) *ReferralCommissionCreate {
+	if x != nil {
+		rcc.SetID(*x)
+	}
+	return rcc
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (rcc *ReferralCommissionCreate) Mutation() *ReferralCommissionMutation {
+	return rcc.mutation
+}
+
+// Save creates the ReferralCommission in the database.
+func (rcc *ReferralCommissionCreate) Save(ctx context.Context) (*ReferralCommission, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ReferralCommissionCreate) SaveX(ctx context.Context) *ReferralCommission {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ReferralCommissionCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ReferralCommissionCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ReferralCommissionCreate) defaults() {
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := referralcommission.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		v := referralcommission.DefaultUpdatedAt()
+		rcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rcc.mutation.ID(); !ok {
+		v := referralcommission.DefaultID()
+		rcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ReferralCommissionCreate) check() error {
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReferralCommission.created_at"`)}
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReferralCommission.updated_at"`)}
+	}
+	if _, ok := rcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReferralCommission.user_id"`)}
+	}
+	if _, ok := rcc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "ReferralCommission.level"`)}
+	}
+	if _, ok := rcc.mutation.BuyerID(); !ok {
+		return &ValidationError{Name: "buyer_id", err: errors.New(`ent: missing required field "ReferralCommission.buyer_id"`)}
+	}
+	if _, ok := rcc.mutation.Coupon(); !ok {
+		return &ValidationError{Name: "coupon", err: errors.New(`ent: missing required field "ReferralCommission.coupon"`)}
+	}
+	if _, ok := rcc.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "ReferralCommission.source_id"`)}
+	}
+	if _, ok := rcc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ReferralCommission.amount"`)}
+	}
+	if _, ok := rcc.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "ReferralCommission.symbol"`)}
+	}
+	return nil
+}
+
+func (rcc *ReferralCommissionCreate) sqlSave(ctx context.Context) (*ReferralCommission, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ReferralCommissionCreate) createSpec() (*ReferralCommission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReferralCommission{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(referralcommission.Table, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = rcc.conflict
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(referralcommission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rcc.mutation.UserID(); ok {
+		_spec.SetField(referralcommission.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rcc.mutation.Level(); ok {
+		_spec.SetField(referralcommission.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := rcc.mutation.BuyerID(); ok {
+		_spec.SetField(referralcommission.FieldBuyerID, field.TypeString, value)
+		_node.BuyerID = value
+	}
+	if value, ok := rcc.mutation.Coupon(); ok {
+		_spec.SetField(referralcommission.FieldCoupon, field.TypeString, value)
+		_node.Coupon = value
+	}
+	if value, ok := rcc.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := rcc.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeString, value)
+		_node.Amount = value
+	}
+	if value, ok := rcc.mutation.Symbol(); ok {
+		_spec.SetField(referralcommission.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReferralCommission.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralCommissionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcc *ReferralCommissionCreate) OnConflict(opts ...sql.ConflictOption) *ReferralCommissionUpsertOne {
+	rcc.conflict = opts
+	return &ReferralCommissionUpsertOne{
+		create: rcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcc *ReferralCommissionCreate) OnConflictColumns(columns ...string) *ReferralCommissionUpsertOne {
+	rcc.conflict = append(rcc.conflict, sql.ConflictColumns(columns...))
+	return &ReferralCommissionUpsertOne{
+		create: rcc,
+	}
+}
+
+type (
+	// ReferralCommissionUpsertOne is the builder for "upsert"-ing
+	//  one ReferralCommission node.
+	ReferralCommissionUpsertOne struct {
+		create *ReferralCommissionCreate
+	}
+
+	// ReferralCommissionUpsert is the "OnConflict" setter.
+	ReferralCommissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsert) SetUpdatedAt(v time.Time) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateUpdatedAt() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReferralCommissionUpsert) SetUserID(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateUserID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldUserID)
+	return u
+}
+
+// SetLevel sets the "level" field.
+func (u *ReferralCommissionUpsert) SetLevel(v int) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldLevel, v)
+	return u
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateLevel() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldLevel)
+	return u
+}
+
+// AddLevel adds v to the "level" field.
+func (u *ReferralCommissionUpsert) AddLevel(v int) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldLevel, v)
+	return u
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *ReferralCommissionUpsert) SetBuyerID(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldBuyerID, v)
+	return u
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateBuyerID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldBuyerID)
+	return u
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *ReferralCommissionUpsert) SetCoupon(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldCoupon, v)
+	return u
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateCoupon() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldCoupon)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsert) SetSourceID(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateSourceID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldSourceID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsert) SetAmount(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateAmount() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldAmount)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *ReferralCommissionUpsert) SetSymbol(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateSymbol() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldSymbol)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(referralcommission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertOne) UpdateNewValues() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(referralcommission.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(referralcommission.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReferralCommissionUpsertOne) Ignore() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralCommissionUpsertOne) DoNothing() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCommissionCreate.OnConflict
+// documentation for more info.
+func (u *ReferralCommissionUpsertOne) Update(set func(*ReferralCommissionUpsert)) *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralCommissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsertOne) SetUpdatedAt(v time.Time) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateUpdatedAt() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReferralCommissionUpsertOne) SetUserID(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateUserID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *ReferralCommissionUpsertOne) SetLevel(v int) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *ReferralCommissionUpsertOne) AddLevel(v int) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateLevel() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *ReferralCommissionUpsertOne) SetBuyerID(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateBuyerID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *ReferralCommissionUpsertOne) SetCoupon(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetCoupon(v)
+	})
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateCoupon() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateCoupon()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsertOne) SetSourceID(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateSourceID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsertOne) SetAmount(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateAmount() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *ReferralCommissionUpsertOne) SetSymbol(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateSymbol() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralCommissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReferralCommissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralCommissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReferralCommissionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReferralCommissionUpsertOne.ID is not supported by MySQL driver. Use ReferralCommissionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReferralCommissionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReferralCommissionCreateBulk is the builder for creating many ReferralCommission entities in bulk.
+type ReferralCommissionCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCommissionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReferralCommission entities in the database.
+func (rccb *ReferralCommissionCreateBulk) Save(ctx context.Context) ([]*ReferralCommission, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ReferralCommission, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralCommissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ReferralCommissionCreateBulk) SaveX(ctx context.Context) []*ReferralCommission {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ReferralCommissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ReferralCommissionCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReferralCommission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralCommissionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rccb *ReferralCommissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReferralCommissionUpsertBulk {
+	rccb.conflict = opts
+	return &ReferralCommissionUpsertBulk{
+		create: rccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rccb *ReferralCommissionCreateBulk) OnConflictColumns(columns ...string) *ReferralCommissionUpsertBulk {
+	rccb.conflict = append(rccb.conflict, sql.ConflictColumns(columns...))
+	return &ReferralCommissionUpsertBulk{
+		create: rccb,
+	}
+}
+
+// ReferralCommissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReferralCommission nodes.
+type ReferralCommissionUpsertBulk struct {
+	create *ReferralCommissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(referralcommission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertBulk) UpdateNewValues() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(referralcommission.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(referralcommission.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertBulk) Ignore() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralCommissionUpsertBulk) DoNothing() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCommissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReferralCommissionUpsertBulk) Update(set func(*ReferralCommissionUpsert)) *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralCommissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsertBulk) SetUpdatedAt(v time.Time) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateUpdatedAt() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReferralCommissionUpsertBulk) SetUserID(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateUserID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *ReferralCommissionUpsertBulk) SetLevel(v int) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *ReferralCommissionUpsertBulk) AddLevel(v int) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateLevel() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *ReferralCommissionUpsertBulk) SetBuyerID(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateBuyerID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// SetCoupon sets the "coupon" field.
+func (u *ReferralCommissionUpsertBulk) SetCoupon(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetCoupon(v)
+	})
+}
+
+// UpdateCoupon sets the "coupon" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateCoupon() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateCoupon()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsertBulk) SetSourceID(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateSourceID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsertBulk) SetAmount(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateAmount() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *ReferralCommissionUpsertBulk) SetSymbol(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateSymbol() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralCommissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReferralCommissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReferralCommissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralCommissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+)
+
+// ReferralCommissionUpdate is the builder for updating ReferralCommission entities.
+type ReferralCommissionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReferralCommissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReferralCommissionUpdate builder.
+func (rcu *ReferralCommissionUpdate) Where(ps ...predicate.ReferralCommission) *ReferralCommissionUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcu *ReferralCommissionUpdate) SetUpdatedAt(t time.Time) *ReferralCommissionUpdate {
+	rcu.mutation.SetUpdatedAt(t)
+	return rcu
+}
+
+// SetUserID sets the "user_id" field.
+func (rcu *ReferralCommissionUpdate) SetUserID(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetUserID(s)
+	return rcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableUserID(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetUserID(*s)
+	}
+	return rcu
+}
+
+// SetLevel sets the "level" field.
+func (rcu *ReferralCommissionUpdate) SetLevel(i int) *ReferralCommissionUpdate {
+	rcu.mutation.ResetLevel()
+	rcu.mutation.SetLevel(i)
+	return rcu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableLevel(i *int) *ReferralCommissionUpdate {
+	if i != nil {
+		rcu.SetLevel(*i)
+	}
+	return rcu
+}
+
+// AddLevel adds i to the "level" field.
+func (rcu *ReferralCommissionUpdate) AddLevel(i int) *ReferralCommissionUpdate {
+	rcu.mutation.AddLevel(i)
+	return rcu
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (rcu *ReferralCommissionUpdate) SetBuyerID(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetBuyerID(s)
+	return rcu
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableBuyerID(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetBuyerID(*s)
+	}
+	return rcu
+}
+
+// SetCoupon sets the "coupon" field.
+func (rcu *ReferralCommissionUpdate) SetCoupon(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetCoupon(s)
+	return rcu
+}
+
+// SetNillableCoupon sets the "coupon" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableCoupon(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetCoupon(*s)
+	}
+	return rcu
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcu *ReferralCommissionUpdate) SetSourceID(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetSourceID(s)
+	return rcu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableSourceID(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetSourceID(*s)
+	}
+	return rcu
+}
+
+// SetAmount sets the "amount" field.
+func (rcu *ReferralCommissionUpdate) SetAmount(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetAmount(s)
+	return rcu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableAmount(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetAmount(*s)
+	}
+	return rcu
+}
+
+// SetSymbol sets the "symbol" field.
+func (rcu *ReferralCommissionUpdate) SetSymbol(s string) *ReferralCommissionUpdate {
+	rcu.mutation.SetSymbol(s)
+	return rcu
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (rcu *ReferralCommissionUpdate) SetNillableSymbol(s *string) *ReferralCommissionUpdate {
+	if s != nil {
+		rcu.SetSymbol(*s)
+	}
+	return rcu
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (rcu *ReferralCommissionUpdate) Mutation() *ReferralCommissionMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ReferralCommissionUpdate) Save(ctx context.Context) (int, error) {
+	rcu.defaults()
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ReferralCommissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ReferralCommissionUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ReferralCommissionUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *ReferralCommissionUpdate) defaults() {
+	if _, ok := rcu.mutation.UpdatedAt(); !ok {
+		v := referralcommission.UpdateDefaultUpdatedAt()
+		rcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcu *ReferralCommissionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReferralCommissionUpdate {
+	rcu.modifiers = append(rcu.modifiers, modifiers...)
+	return rcu
+}
+
+func (rcu *ReferralCommissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referralcommission.Table, referralcommission.Columns, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeString))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcu.mutation.UserID(); ok {
+		_spec.SetField(referralcommission.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Level(); ok {
+		_spec.SetField(referralcommission.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.AddedLevel(); ok {
+		_spec.AddField(referralcommission.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := rcu.mutation.BuyerID(); ok {
+		_spec.SetField(referralcommission.FieldBuyerID, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Coupon(); ok {
+		_spec.SetField(referralcommission.FieldCoupon, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Symbol(); ok {
+		_spec.SetField(referralcommission.FieldSymbol, field.TypeString, value)
+	}
+	_spec.AddModifiers(rcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ReferralCommissionUpdateOne is the builder for updating a single ReferralCommission entity.
+type ReferralCommissionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReferralCommissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcuo *ReferralCommissionUpdateOne) SetUpdatedAt(t time.Time) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetUpdatedAt(t)
+	return rcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rcuo *ReferralCommissionUpdateOne) SetUserID(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetUserID(s)
+	return rcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableUserID(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetUserID(*s)
+	}
+	return rcuo
+}
+
+// SetLevel sets the "level" field.
+func (rcuo *ReferralCommissionUpdateOne) SetLevel(i int) *ReferralCommissionUpdateOne {
+	rcuo.mutation.ResetLevel()
+	rcuo.mutation.SetLevel(i)
+	return rcuo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableLevel(i *int) *ReferralCommissionUpdateOne {
+	if i != nil {
+		rcuo.SetLevel(*i)
+	}
+	return rcuo
+}
+
+// AddLevel adds i to the "level" field.
+func (rcuo *ReferralCommissionUpdateOne) AddLevel(i int) *ReferralCommissionUpdateOne {
+	rcuo.mutation.AddLevel(i)
+	return rcuo
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (rcuo *ReferralCommissionUpdateOne) SetBuyerID(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetBuyerID(s)
+	return rcuo
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableBuyerID(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetBuyerID(*s)
+	}
+	return rcuo
+}
+
+// SetCoupon sets the "coupon" field.
+func (rcuo *ReferralCommissionUpdateOne) SetCoupon(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetCoupon(s)
+	return rcuo
+}
+
+// SetNillableCoupon sets the "coupon" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableCoupon(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetCoupon(*s)
+	}
+	return rcuo
+}
+
+// SetSourceID sets the "source_id" field.
+func (rcuo *ReferralCommissionUpdateOne) SetSourceID(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetSourceID(s)
+	return rcuo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableSourceID(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetSourceID(*s)
+	}
+	return rcuo
+}
+
+// SetAmount sets the "amount" field.
+func (rcuo *ReferralCommissionUpdateOne) SetAmount(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetAmount(s)
+	return rcuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableAmount(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetAmount(*s)
+	}
+	return rcuo
+}
+
+// SetSymbol sets the "symbol" field.
+func (rcuo *ReferralCommissionUpdateOne) SetSymbol(s string) *ReferralCommissionUpdateOne {
+	rcuo.mutation.SetSymbol(s)
+	return rcuo
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (rcuo *ReferralCommissionUpdateOne) SetNillableSymbol(s *string) *ReferralCommissionUpdateOne {
+	if s != nil {
+		rcuo.SetSymbol(*s)
+	}
+	return rcuo
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (rcuo *ReferralCommissionUpdateOne) Mutation() *ReferralCommissionMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the ReferralCommissionUpdate builder.
+func (rcuo *ReferralCommissionUpdateOne) Where(ps ...predicate.ReferralCommission) *ReferralCommissionUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ReferralCommissionUpdateOne) Select(field string, fields ...string) *ReferralCommissionUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ReferralCommission entity.
+func (rcuo *ReferralCommissionUpdateOne) Save(ctx context.Context) (*ReferralCommission, error) {
+	rcuo.defaults()
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ReferralCommissionUpdateOne) SaveX(ctx context.Context) *ReferralCommission {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ReferralCommissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ReferralCommissionUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *ReferralCommissionUpdateOne) defaults() {
+	if _, ok := rcuo.mutation.UpdatedAt(); !ok {
+		v := referralcommission.UpdateDefaultUpdatedAt()
+		rcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcuo *ReferralCommissionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReferralCommissionUpdateOne {
+	rcuo.modifiers = append(rcuo.modifiers, modifiers...)
+	return rcuo
+}
+
+func (rcuo *ReferralCommissionUpdateOne) sqlSave(ctx context.Context) (_node *ReferralCommission, err error) {
+	_spec := sqlgraph.NewUpdateSpec(referralcommission.Table, referralcommission.Columns, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeString))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReferralCommission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referralcommission.FieldID)
+		for _, f := range fields {
+			if !referralcommission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referralcommission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcuo.mutation.UserID(); ok {
+		_spec.SetField(referralcommission.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Level(); ok {
+		_spec.SetField(referralcommission.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.AddedLevel(); ok {
+		_spec.AddField(referralcommission.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := rcuo.mutation.BuyerID(); ok {
+		_spec.SetField(referralcommission.FieldBuyerID, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Coupon(); ok {
+		_spec.SetField(referralcommission.FieldCoupon, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Symbol(); ok {
+		_spec.SetField(referralcommission.FieldSymbol, field.TypeString, value)
+	}
+	_spec.AddModifiers(rcuo.modifiers...)
+	_node = &ReferralCommission{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
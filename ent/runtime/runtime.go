@@ -2,7 +2,390 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/indikay/wallet-service/ent/runtime.go
+import (
+	"time"
+
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/ent/auditlog"
+	"github.com/indikay/wallet-service/ent/currencyrate"
+	"github.com/indikay/wallet-service/ent/ico"
+	"github.com/indikay/wallet-service/ent/icocoupon"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/indikay/wallet-service/ent/icoround"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/ent/referral"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/indikay/wallet-service/ent/schema"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+	"github.com/indikay/wallet-service/ent/transaction"
+	"github.com/indikay/wallet-service/ent/userwallet"
+	"github.com/indikay/wallet-service/ent/webhook"
+	"github.com/indikay/wallet-service/ent/webhookdelivery"
+	"github.com/rs/xid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	alertruleMixin := schema.AlertRule{}.Mixin()
+	alertruleMixinFields0 := alertruleMixin[0].Fields()
+	_ = alertruleMixinFields0
+	alertruleFields := schema.AlertRule{}.Fields()
+	_ = alertruleFields
+	// alertruleDescCreatedAt is the schema descriptor for created_at field.
+	alertruleDescCreatedAt := alertruleMixinFields0[0].Descriptor()
+	// alertrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertrule.DefaultCreatedAt = alertruleDescCreatedAt.Default.(func() time.Time)
+	// alertruleDescUpdatedAt is the schema descriptor for updated_at field.
+	alertruleDescUpdatedAt := alertruleMixinFields0[1].Descriptor()
+	// alertrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertrule.DefaultUpdatedAt = alertruleDescUpdatedAt.Default.(func() time.Time)
+	// alertrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertrule.UpdateDefaultUpdatedAt = alertruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// alertruleDescID is the schema descriptor for id field.
+	alertruleDescID := alertruleFields[0].Descriptor()
+	// alertrule.DefaultID holds the default value on creation for the id field.
+	alertrule.DefaultID = alertruleDescID.Default.(func() xid.ID)
+	auditlogHooks := schema.AuditLog{}.Hooks()
+	auditlog.Hooks[0] = auditlogHooks[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[1].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() xid.ID)
+	currencyrateMixin := schema.CurrencyRate{}.Mixin()
+	currencyrateMixinFields0 := currencyrateMixin[0].Fields()
+	_ = currencyrateMixinFields0
+	currencyrateFields := schema.CurrencyRate{}.Fields()
+	_ = currencyrateFields
+	// currencyrateDescCreatedAt is the schema descriptor for created_at field.
+	currencyrateDescCreatedAt := currencyrateMixinFields0[0].Descriptor()
+	// currencyrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	currencyrate.DefaultCreatedAt = currencyrateDescCreatedAt.Default.(func() time.Time)
+	// currencyrateDescUpdatedAt is the schema descriptor for updated_at field.
+	currencyrateDescUpdatedAt := currencyrateMixinFields0[1].Descriptor()
+	// currencyrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	currencyrate.DefaultUpdatedAt = currencyrateDescUpdatedAt.Default.(func() time.Time)
+	// currencyrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	currencyrate.UpdateDefaultUpdatedAt = currencyrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	icoMixin := schema.Ico{}.Mixin()
+	icoMixinFields0 := icoMixin[0].Fields()
+	_ = icoMixinFields0
+	icoFields := schema.Ico{}.Fields()
+	_ = icoFields
+	// icoDescCreatedAt is the schema descriptor for created_at field.
+	icoDescCreatedAt := icoMixinFields0[0].Descriptor()
+	// ico.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ico.DefaultCreatedAt = icoDescCreatedAt.Default.(func() time.Time)
+	// icoDescUpdatedAt is the schema descriptor for updated_at field.
+	icoDescUpdatedAt := icoMixinFields0[1].Descriptor()
+	// ico.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ico.DefaultUpdatedAt = icoDescUpdatedAt.Default.(func() time.Time)
+	// ico.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ico.UpdateDefaultUpdatedAt = icoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icoDescLifetime is the schema descriptor for lifetime field.
+	icoDescLifetime := icoFields[7].Descriptor()
+	// ico.DefaultLifetime holds the default value on creation for the lifetime field.
+	ico.DefaultLifetime = icoDescLifetime.Default.(int32)
+	// icoDescID is the schema descriptor for id field.
+	icoDescID := icoFields[0].Descriptor()
+	// ico.DefaultID holds the default value on creation for the id field.
+	ico.DefaultID = icoDescID.Default.(func() xid.ID)
+	icocouponMixin := schema.IcoCoupon{}.Mixin()
+	icocouponMixinFields0 := icocouponMixin[0].Fields()
+	_ = icocouponMixinFields0
+	icocouponFields := schema.IcoCoupon{}.Fields()
+	_ = icocouponFields
+	// icocouponDescCreatedAt is the schema descriptor for created_at field.
+	icocouponDescCreatedAt := icocouponMixinFields0[0].Descriptor()
+	// icocoupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icocoupon.DefaultCreatedAt = icocouponDescCreatedAt.Default.(func() time.Time)
+	// icocouponDescUpdatedAt is the schema descriptor for updated_at field.
+	icocouponDescUpdatedAt := icocouponMixinFields0[1].Descriptor()
+	// icocoupon.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icocoupon.DefaultUpdatedAt = icocouponDescUpdatedAt.Default.(func() time.Time)
+	// icocoupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icocoupon.UpdateDefaultUpdatedAt = icocouponDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icocouponDescMaxUses is the schema descriptor for max_uses field.
+	icocouponDescMaxUses := icocouponFields[7].Descriptor()
+	// icocoupon.DefaultMaxUses holds the default value on creation for the max_uses field.
+	icocoupon.DefaultMaxUses = icocouponDescMaxUses.Default.(int)
+	// icocouponDescUsed is the schema descriptor for used field.
+	icocouponDescUsed := icocouponFields[8].Descriptor()
+	// icocoupon.DefaultUsed holds the default value on creation for the used field.
+	icocoupon.DefaultUsed = icocouponDescUsed.Default.(int)
+	// icocouponDescSingleUse is the schema descriptor for single_use field.
+	icocouponDescSingleUse := icocouponFields[9].Descriptor()
+	// icocoupon.DefaultSingleUse holds the default value on creation for the single_use field.
+	icocoupon.DefaultSingleUse = icocouponDescSingleUse.Default.(bool)
+	// icocouponDescID is the schema descriptor for id field.
+	icocouponDescID := icocouponFields[0].Descriptor()
+	// icocoupon.DefaultID holds the default value on creation for the id field.
+	icocoupon.DefaultID = icocouponDescID.Default.(func() xid.ID)
+	icocouponredemptionMixin := schema.IcoCouponRedemption{}.Mixin()
+	icocouponredemptionMixinFields0 := icocouponredemptionMixin[0].Fields()
+	_ = icocouponredemptionMixinFields0
+	icocouponredemptionFields := schema.IcoCouponRedemption{}.Fields()
+	_ = icocouponredemptionFields
+	// icocouponredemptionDescCreatedAt is the schema descriptor for created_at field.
+	icocouponredemptionDescCreatedAt := icocouponredemptionMixinFields0[0].Descriptor()
+	// icocouponredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icocouponredemption.DefaultCreatedAt = icocouponredemptionDescCreatedAt.Default.(func() time.Time)
+	// icocouponredemptionDescUpdatedAt is the schema descriptor for updated_at field.
+	icocouponredemptionDescUpdatedAt := icocouponredemptionMixinFields0[1].Descriptor()
+	// icocouponredemption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icocouponredemption.DefaultUpdatedAt = icocouponredemptionDescUpdatedAt.Default.(func() time.Time)
+	// icocouponredemption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icocouponredemption.UpdateDefaultUpdatedAt = icocouponredemptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icocouponredemptionDescID is the schema descriptor for id field.
+	icocouponredemptionDescID := icocouponredemptionFields[0].Descriptor()
+	// icocouponredemption.DefaultID holds the default value on creation for the id field.
+	icocouponredemption.DefaultID = icocouponredemptionDescID.Default.(func() xid.ID)
+	icodailystatMixin := schema.IcoDailyStat{}.Mixin()
+	icodailystatMixinFields0 := icodailystatMixin[0].Fields()
+	_ = icodailystatMixinFields0
+	icodailystatFields := schema.IcoDailyStat{}.Fields()
+	_ = icodailystatFields
+	// icodailystatDescCreatedAt is the schema descriptor for created_at field.
+	icodailystatDescCreatedAt := icodailystatMixinFields0[0].Descriptor()
+	// icodailystat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icodailystat.DefaultCreatedAt = icodailystatDescCreatedAt.Default.(func() time.Time)
+	// icodailystatDescUpdatedAt is the schema descriptor for updated_at field.
+	icodailystatDescUpdatedAt := icodailystatMixinFields0[1].Descriptor()
+	// icodailystat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icodailystat.DefaultUpdatedAt = icodailystatDescUpdatedAt.Default.(func() time.Time)
+	// icodailystat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icodailystat.UpdateDefaultUpdatedAt = icodailystatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icodailystatDescID is the schema descriptor for id field.
+	icodailystatDescID := icodailystatFields[0].Descriptor()
+	// icodailystat.DefaultID holds the default value on creation for the id field.
+	icodailystat.DefaultID = icodailystatDescID.Default.(func() xid.ID)
+	icohistoryMixin := schema.IcoHistory{}.Mixin()
+	icohistoryMixinFields0 := icohistoryMixin[0].Fields()
+	_ = icohistoryMixinFields0
+	icohistoryFields := schema.IcoHistory{}.Fields()
+	_ = icohistoryFields
+	// icohistoryDescCreatedAt is the schema descriptor for created_at field.
+	icohistoryDescCreatedAt := icohistoryMixinFields0[0].Descriptor()
+	// icohistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icohistory.DefaultCreatedAt = icohistoryDescCreatedAt.Default.(func() time.Time)
+	// icohistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	icohistoryDescUpdatedAt := icohistoryMixinFields0[1].Descriptor()
+	// icohistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icohistory.DefaultUpdatedAt = icohistoryDescUpdatedAt.Default.(func() time.Time)
+	// icohistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icohistory.UpdateDefaultUpdatedAt = icohistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icohistoryDescID is the schema descriptor for id field.
+	icohistoryDescID := icohistoryFields[0].Descriptor()
+	// icohistory.DefaultID holds the default value on creation for the id field.
+	icohistory.DefaultID = icohistoryDescID.Default.(func() xid.ID)
+	icooutstandingrefundMixin := schema.IcoOutstandingRefund{}.Mixin()
+	icooutstandingrefundMixinFields0 := icooutstandingrefundMixin[0].Fields()
+	_ = icooutstandingrefundMixinFields0
+	icooutstandingrefundFields := schema.IcoOutstandingRefund{}.Fields()
+	_ = icooutstandingrefundFields
+	// icooutstandingrefundDescCreatedAt is the schema descriptor for created_at field.
+	icooutstandingrefundDescCreatedAt := icooutstandingrefundMixinFields0[0].Descriptor()
+	// icooutstandingrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icooutstandingrefund.DefaultCreatedAt = icooutstandingrefundDescCreatedAt.Default.(func() time.Time)
+	// icooutstandingrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	icooutstandingrefundDescUpdatedAt := icooutstandingrefundMixinFields0[1].Descriptor()
+	// icooutstandingrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icooutstandingrefund.DefaultUpdatedAt = icooutstandingrefundDescUpdatedAt.Default.(func() time.Time)
+	// icooutstandingrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icooutstandingrefund.UpdateDefaultUpdatedAt = icooutstandingrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icooutstandingrefundDescID is the schema descriptor for id field.
+	icooutstandingrefundDescID := icooutstandingrefundFields[0].Descriptor()
+	// icooutstandingrefund.DefaultID holds the default value on creation for the id field.
+	icooutstandingrefund.DefaultID = icooutstandingrefundDescID.Default.(func() xid.ID)
+	icoroundMixin := schema.IcoRound{}.Mixin()
+	icoroundMixinFields0 := icoroundMixin[0].Fields()
+	_ = icoroundMixinFields0
+	icoroundFields := schema.IcoRound{}.Fields()
+	_ = icoroundFields
+	// icoroundDescCreatedAt is the schema descriptor for created_at field.
+	icoroundDescCreatedAt := icoroundMixinFields0[0].Descriptor()
+	// icoround.DefaultCreatedAt holds the default value on creation for the created_at field.
+	icoround.DefaultCreatedAt = icoroundDescCreatedAt.Default.(func() time.Time)
+	// icoroundDescUpdatedAt is the schema descriptor for updated_at field.
+	icoroundDescUpdatedAt := icoroundMixinFields0[1].Descriptor()
+	// icoround.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	icoround.DefaultUpdatedAt = icoroundDescUpdatedAt.Default.(func() time.Time)
+	// icoround.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	icoround.UpdateDefaultUpdatedAt = icoroundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// icoroundDescLifetime is the schema descriptor for lifetime field.
+	icoroundDescLifetime := icoroundFields[8].Descriptor()
+	// icoround.DefaultLifetime holds the default value on creation for the lifetime field.
+	icoround.DefaultLifetime = icoroundDescLifetime.Default.(int32)
+	// icoroundDescIsClose is the schema descriptor for is_close field.
+	icoroundDescIsClose := icoroundFields[9].Descriptor()
+	// icoround.DefaultIsClose holds the default value on creation for the is_close field.
+	icoround.DefaultIsClose = icoroundDescIsClose.Default.(bool)
+	// icoroundDescID is the schema descriptor for id field.
+	icoroundDescID := icoroundFields[0].Descriptor()
+	// icoround.DefaultID holds the default value on creation for the id field.
+	icoround.DefaultID = icoroundDescID.Default.(func() xid.ID)
+	leaderboardsnapshotMixin := schema.LeaderboardSnapshot{}.Mixin()
+	leaderboardsnapshotMixinFields0 := leaderboardsnapshotMixin[0].Fields()
+	_ = leaderboardsnapshotMixinFields0
+	leaderboardsnapshotFields := schema.LeaderboardSnapshot{}.Fields()
+	_ = leaderboardsnapshotFields
+	// leaderboardsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	leaderboardsnapshotDescCreatedAt := leaderboardsnapshotMixinFields0[0].Descriptor()
+	// leaderboardsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leaderboardsnapshot.DefaultCreatedAt = leaderboardsnapshotDescCreatedAt.Default.(func() time.Time)
+	// leaderboardsnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	leaderboardsnapshotDescUpdatedAt := leaderboardsnapshotMixinFields0[1].Descriptor()
+	// leaderboardsnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	leaderboardsnapshot.DefaultUpdatedAt = leaderboardsnapshotDescUpdatedAt.Default.(func() time.Time)
+	// leaderboardsnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	leaderboardsnapshot.UpdateDefaultUpdatedAt = leaderboardsnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// leaderboardsnapshotDescID is the schema descriptor for id field.
+	leaderboardsnapshotDescID := leaderboardsnapshotFields[0].Descriptor()
+	// leaderboardsnapshot.DefaultID holds the default value on creation for the id field.
+	leaderboardsnapshot.DefaultID = leaderboardsnapshotDescID.Default.(func() xid.ID)
+	referralMixin := schema.Referral{}.Mixin()
+	referralMixinFields0 := referralMixin[0].Fields()
+	_ = referralMixinFields0
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralMixinFields0[0].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	// referralDescUpdatedAt is the schema descriptor for updated_at field.
+	referralDescUpdatedAt := referralMixinFields0[1].Descriptor()
+	// referral.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	referral.DefaultUpdatedAt = referralDescUpdatedAt.Default.(func() time.Time)
+	// referral.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	referral.UpdateDefaultUpdatedAt = referralDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// referralDescID is the schema descriptor for id field.
+	referralDescID := referralFields[0].Descriptor()
+	// referral.DefaultID holds the default value on creation for the id field.
+	referral.DefaultID = referralDescID.Default.(func() xid.ID)
+	referralcommissionMixin := schema.ReferralCommission{}.Mixin()
+	referralcommissionMixinFields0 := referralcommissionMixin[0].Fields()
+	_ = referralcommissionMixinFields0
+	referralcommissionFields := schema.ReferralCommission{}.Fields()
+	_ = referralcommissionFields
+	// referralcommissionDescCreatedAt is the schema descriptor for created_at field.
+	referralcommissionDescCreatedAt := referralcommissionMixinFields0[0].Descriptor()
+	// referralcommission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referralcommission.DefaultCreatedAt = referralcommissionDescCreatedAt.Default.(func() time.Time)
+	// referralcommissionDescUpdatedAt is the schema descriptor for updated_at field.
+	referralcommissionDescUpdatedAt := referralcommissionMixinFields0[1].Descriptor()
+	// referralcommission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	referralcommission.DefaultUpdatedAt = referralcommissionDescUpdatedAt.Default.(func() time.Time)
+	// referralcommission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	referralcommission.UpdateDefaultUpdatedAt = referralcommissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// referralcommissionDescID is the schema descriptor for id field.
+	referralcommissionDescID := referralcommissionFields[0].Descriptor()
+	// referralcommission.DefaultID holds the default value on creation for the id field.
+	referralcommission.DefaultID = referralcommissionDescID.Default.(func() xid.ID)
+	tokenomicversionFields := schema.TokenomicVersion{}.Fields()
+	_ = tokenomicversionFields
+	// tokenomicversionDescCreatedAt is the schema descriptor for created_at field.
+	tokenomicversionDescCreatedAt := tokenomicversionFields[1].Descriptor()
+	// tokenomicversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tokenomicversion.DefaultCreatedAt = tokenomicversionDescCreatedAt.Default.(func() time.Time)
+	// tokenomicversionDescID is the schema descriptor for id field.
+	tokenomicversionDescID := tokenomicversionFields[0].Descriptor()
+	// tokenomicversion.DefaultID holds the default value on creation for the id field.
+	tokenomicversion.DefaultID = tokenomicversionDescID.Default.(func() xid.ID)
+	transactionMixin := schema.Transaction{}.Mixin()
+	transactionMixinFields0 := transactionMixin[0].Fields()
+	_ = transactionMixinFields0
+	transactionFields := schema.Transaction{}.Fields()
+	_ = transactionFields
+	// transactionDescCreatedAt is the schema descriptor for created_at field.
+	transactionDescCreatedAt := transactionMixinFields0[0].Descriptor()
+	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
+	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
+	transactionDescUpdatedAt := transactionMixinFields0[1].Descriptor()
+	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
+	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transaction.UpdateDefaultUpdatedAt = transactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transactionDescID is the schema descriptor for id field.
+	transactionDescID := transactionFields[0].Descriptor()
+	// transaction.DefaultID holds the default value on creation for the id field.
+	transaction.DefaultID = transactionDescID.Default.(func() xid.ID)
+	userwalletMixin := schema.UserWallet{}.Mixin()
+	userwalletMixinFields0 := userwalletMixin[0].Fields()
+	_ = userwalletMixinFields0
+	userwalletFields := schema.UserWallet{}.Fields()
+	_ = userwalletFields
+	// userwalletDescCreatedAt is the schema descriptor for created_at field.
+	userwalletDescCreatedAt := userwalletMixinFields0[0].Descriptor()
+	// userwallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userwallet.DefaultCreatedAt = userwalletDescCreatedAt.Default.(func() time.Time)
+	// userwalletDescUpdatedAt is the schema descriptor for updated_at field.
+	userwalletDescUpdatedAt := userwalletMixinFields0[1].Descriptor()
+	// userwallet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userwallet.DefaultUpdatedAt = userwalletDescUpdatedAt.Default.(func() time.Time)
+	// userwallet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userwallet.UpdateDefaultUpdatedAt = userwalletDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userwalletDescID is the schema descriptor for id field.
+	userwalletDescID := userwalletFields[0].Descriptor()
+	// userwallet.DefaultID holds the default value on creation for the id field.
+	userwallet.DefaultID = userwalletDescID.Default.(func() xid.ID)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() xid.ID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int32)
+	// webhookdeliveryDescResponseCode is the schema descriptor for response_code field.
+	webhookdeliveryDescResponseCode := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultResponseCode holds the default value on creation for the response_code field.
+	webhookdelivery.DefaultResponseCode = webhookdeliveryDescResponseCode.Default.(int32)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() xid.ID)
+}
 
 const (
 	Version = "v0.12.5"                                         // Version of ent codegen.
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AlertRule holds the schema definition for the AlertRule entity.
+type AlertRule struct {
+	ent.Schema
+}
+
+func (AlertRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AlertRule.
+func (AlertRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("user_id"),
+		field.String("type"), // LOW_BALANCE, LARGE_DEBIT, REWARD_RECEIVED
+		field.String("symbol"),
+		field.String("threshold"),
+	}
+}
+
+func (AlertRule) Indexes() []ent.Index {
+	return []ent.Index{
+		// one rule per user, type and symbol.
+		index.Fields("user_id", "type", "symbol").Unique(),
+	}
+}
+
+// Edges of the AlertRule.
+func (AlertRule) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.String("actor"),   // jwt subject, or the command for jobs
+		field.String("service"), // calling service
+		field.Strings("roles").Optional(),
+		field.String("rpc"),
+		field.String("target_user_id").Optional(),
+		field.String("payload_hash").Optional(),
+		field.Text("before").Optional(),
+		field.Text("after").Optional(),
+		field.String("outcome"), // SUCCESS, FAILED
+		field.Text("error").Optional(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor", "created_at"),
+		index.Fields("rpc", "created_at"),
+		index.Fields("target_user_id", "created_at"),
+	}
+}
+
+// Hooks of the AuditLog, rows are append-only.
+func (AuditLog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpCreate) {
+					return nil, errors.New("audit log is append-only")
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
@@ -27,8 +27,17 @@ func (Ico) Fields() []ent.Field {
 		field.String("price"),
 		field.String("num_token"),
 		field.Int32("num_sub"),
-		field.String("price_gap"), // percent
+		field.String("price_gap"),          // percent
+		field.Int32("lifetime").Default(0), // minutes each sub-round runs, 0 for SUBROUND_LIFETIME
 		field.Time("ended_at").Optional().Nillable(),
+		field.Text("limits").Optional(),  // purchase limits, in JSON
+		field.Text("pricing").Optional(), // pricing strategy, in JSON, step pricing when empty
+		field.Text("unsold").Optional(),  // unsold-token policy, in JSON, moved to SYS_ICO when empty
+
+		// caps on the value raised, in the unit of the price
+		field.String("soft_cap").Optional(), // the ended round fails below it, empty for none
+		field.String("hard_cap").Optional(), // the round closes early once it is reached, empty for none
+		field.String("status").Optional(),   // capped, failed or ended once settled
 	}
 }
 
@@ -27,6 +27,15 @@ func (IcoCoupon) Fields() []ent.Field {
 		field.String("coupon"),
 		field.String("reward"),
 		field.String("cashback"),
+		// the coupon applies in [starts_at, ends_at), an unset bound is open
+		field.Time("starts_at").Optional().Nillable(),
+		field.Time("ends_at").Optional().Nillable(),
+		// max_uses caps the redemptions of all users, 0 is no cap
+		field.Int("max_uses").Default(0),
+		field.Int("used").Default(0),
+		field.Bool("single_use").Default(false),
+		// min_purchase is the least a purchase buys to apply the coupon, in tokens
+		field.String("min_purchase").Optional(),
 		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
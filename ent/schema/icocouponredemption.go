@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// IcoCouponRedemption records a purchase that applied a coupon, with what the
+// coupon paid its owner and the buyer.
+type IcoCouponRedemption struct {
+	ent.Schema
+}
+
+func (IcoCouponRedemption) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the IcoCouponRedemption.
+func (IcoCouponRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("coupon_id").GoType(xid.ID{}),
+		field.String("coupon"),
+		field.String("user_id"),
+		field.String("source_id"),
+		field.String("amount"),
+		field.String("symbol"),
+		field.String("reward"),
+		field.String("cashback"),
+	}
+}
+
+func (IcoCouponRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coupon_id", "user_id"),
+		index.Fields("source_id"),
+	}
+}
+
+// Edges of the IcoCouponRedemption.
+func (IcoCouponRedemption) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// IcoDailyStat rolls up the ICO purchases of a day, UTC, for the analytics.
+// The coupon_ fields count the purchases paid with a coupon.
+type IcoDailyStat struct {
+	ent.Schema
+}
+
+func (IcoDailyStat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the IcoDailyStat.
+func (IcoDailyStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.Time("day"),
+		field.Int("purchases"),
+		field.Int("buyers"),
+		field.String("num_token"),
+		field.String("raised"),
+		field.Int("coupon_purchases"),
+		field.Int("coupon_buyers"),
+		field.String("coupon_num_token"),
+		field.String("coupon_raised"),
+	}
+}
+
+func (IcoDailyStat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("day").Unique(),
+	}
+}
+
+// Edges of the IcoDailyStat.
+func (IcoDailyStat) Edges() []ent.Edge {
+	return nil
+}
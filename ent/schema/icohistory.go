@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/rs/xid"
 )
 
@@ -28,6 +29,18 @@ func (IcoHistory) Fields() []ent.Field {
 		field.String("num_token"),
 		field.Int32("sub_round"),
 		field.String("type").Optional(),
+		// The payment the tokens were bought with, its transactions share source_id.
+		field.String("source_id").Optional(),
+		field.String("symbol").Optional(),
+		field.String("amount").Optional(),
+	}
+}
+
+func (IcoHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("source_id"),
+		index.Fields("round_id"),
 	}
 }
 
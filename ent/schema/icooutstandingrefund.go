@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// IcoOutstandingRefund records a payment of a failed round that could not be
+// refunded, its buyer no longer held the tokens.
+type IcoOutstandingRefund struct {
+	ent.Schema
+}
+
+func (IcoOutstandingRefund) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the IcoOutstandingRefund.
+func (IcoOutstandingRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.Int32("round_id"),
+		field.String("user_id"),
+		field.String("source_id").Unique(),
+		field.String("num_token"),
+	}
+}
+
+func (IcoOutstandingRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("round_id"),
+	}
+}
+
+// Edges of the IcoOutstandingRefund.
+func (IcoOutstandingRefund) Edges() []ent.Edge {
+	return nil
+}
@@ -29,7 +29,9 @@ func (IcoRound) Fields() []ent.Field {
 		field.String("bought_token"),
 		field.Time("start_at").Optional().Nillable(),
 		field.Time("end_at").Optional().Nillable(),
+		field.Int32("lifetime").Default(0), // minutes, 0 for the lifetime of the round
 		field.Bool("is_close").Default(false),
+		field.Time("paused_at").Optional().Nillable(), // set while the sale is paused
 	}
 }
 
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// LeaderboardSnapshot is a rank of the ICO leaderboard at taken_at, the prizes
+// are distributed from them.
+type LeaderboardSnapshot struct {
+	ent.Schema
+}
+
+func (LeaderboardSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LeaderboardSnapshot.
+func (LeaderboardSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.Time("taken_at"),
+		field.Int("rank"),
+		field.String("user_id"),
+		field.String("num_token"),
+	}
+}
+
+func (LeaderboardSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("taken_at", "rank").Unique(),
+	}
+}
+
+// Edges of the LeaderboardSnapshot.
+func (LeaderboardSnapshot) Edges() []ent.Edge {
+	return nil
+}
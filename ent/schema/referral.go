@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Referral links a user to the user who referred them, a user has one
+// referrer at most.
+type Referral struct {
+	ent.Schema
+}
+
+func (Referral) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("user_id"),
+		field.String("referrer_id"),
+	}
+}
+
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
+		index.Fields("referrer_id"),
+	}
+}
+
+// Edges of the Referral.
+func (Referral) Edges() []ent.Edge {
+	return nil
+}
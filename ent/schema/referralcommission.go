@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// ReferralCommission is what a referrer earned on a purchase made with the
+// coupon of someone in their downline. Level 2 is the referrer of the coupon
+// owner, level 3 their referrer and so on.
+type ReferralCommission struct {
+	ent.Schema
+}
+
+func (ReferralCommission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ReferralCommission.
+func (ReferralCommission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("user_id"),
+		field.Int("level"),
+		field.String("buyer_id"),
+		field.String("coupon"),
+		field.String("source_id"),
+		field.String("amount"),
+		field.String("symbol"),
+	}
+}
+
+func (ReferralCommission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("source_id"),
+	}
+}
+
+// Edges of the ReferralCommission.
+func (ReferralCommission) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/rs/xid"
+)
+
+// TokenomicVersion holds the schema definition for the TokenomicVersion entity,
+// a tokenomics definition that was applied.
+type TokenomicVersion struct {
+	ent.Schema
+}
+
+// Fields of the TokenomicVersion.
+func (TokenomicVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.String("version").Unique().Immutable(),
+		field.String("checksum").Immutable(), // sha256 of the definition
+		field.Text("definition").Immutable(), // as applied, in JSON
+		field.Text("changes").Optional().Immutable(),
+	}
+}
+
+// Edges of the TokenomicVersion.
+func (TokenomicVersion) Edges() []ent.Edge {
+	return nil
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/rs/xid"
 )
 
@@ -36,6 +37,12 @@ func (Transaction) Fields() []ent.Field {
 	}
 }
 
+func (Transaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_id"),
+	}
+}
+
 // Edges of the User.
 func (Transaction) Edges() []ent.Edge {
 	return nil
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("owner"), // partner the subscription belongs to
+		field.String("url"),
+		field.String("secret").Sensitive(),
+		field.Strings("trans_types").Optional(), // empty means every type
+		field.Strings("user_ids").Optional(),    // empty means every user
+		field.Time("deleted_at").Optional().Nillable(),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner"),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/rs/xid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").GoType(xid.ID{}).
+			DefaultFunc(xid.New).Unique().Immutable(),
+		field.String("webhook_id"),
+		field.String("event_id"),
+		field.String("event_type"),
+		field.Text("payload"),
+		field.String("status"), // PENDING, SUCCESS, FAILED
+		field.Int32("attempts").Default(0),
+		field.Int32("response_code").Default(0),
+		field.Text("last_error").Optional(),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// one delivery per event and subscription, redelivery reuses the row.
+		index.Fields("webhook_id", "event_id").Unique(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+	"github.com/rs/xid"
+)
+
+// TokenomicVersion is the model entity for the TokenomicVersion schema.
+type TokenomicVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID xid.ID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// Definition holds the value of the "definition" field.
+	Definition string `json:"definition,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes      string `json:"changes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenomicVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenomicversion.FieldVersion, tokenomicversion.FieldChecksum, tokenomicversion.FieldDefinition, tokenomicversion.FieldChanges:
+			values[i] = new(sql.NullString)
+		case tokenomicversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case tokenomicversion.FieldID:
+			values[i] = new(xid.ID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenomicVersion fields.
+func (tv *TokenomicVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenomicversion.FieldID:
+			if value, ok := values[i].(*xid.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tv.ID = *value
+			}
+		case tokenomicversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tv.CreatedAt = value.Time
+			}
+		case tokenomicversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				tv.Version = value.String
+			}
+		case tokenomicversion.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				tv.Checksum = value.String
+			}
+		case tokenomicversion.FieldDefinition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field definition", values[i])
+			} else if value.Valid {
+				tv.Definition = value.String
+			}
+		case tokenomicversion.FieldChanges:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value.Valid {
+				tv.Changes = value.String
+			}
+		default:
+			tv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenomicVersion.
+// This includes values selected through modifiers, order, etc.
+func (tv *TokenomicVersion) Value(name string) (ent.Value, error) {
+	return tv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TokenomicVersion.
+// Note that you need to call TokenomicVersion.Unwrap() before calling this method if this TokenomicVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tv *TokenomicVersion) Update() *TokenomicVersionUpdateOne {
+	return NewTokenomicVersionClient(tv.config).UpdateOne(tv)
+}
+
+// Unwrap unwraps the TokenomicVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tv *TokenomicVersion) Unwrap() *TokenomicVersion {
+	_tx, ok := tv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenomicVersion is not a transactional entity")
+	}
+	tv.config.driver = _tx.drv
+	return tv
+}
+
+// String implements the fmt.Stringer.
+func (tv *TokenomicVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenomicVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(tv.Version)
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(tv.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("definition=")
+	builder.WriteString(tv.Definition)
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(tv.Changes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenomicVersions is a parsable slice of TokenomicVersion.
+type TokenomicVersions []*TokenomicVersion
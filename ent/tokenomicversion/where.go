@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenomicversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/rs/xid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id xid.ID) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldChecksum, v))
+}
+
+// Definition applies equality check predicate on the "definition" field. It's identical to DefinitionEQ.
+func Definition(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldDefinition, v))
+}
+
+// Changes applies equality check predicate on the "changes" field. It's identical to ChangesEQ.
+func Changes(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldChanges, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// DefinitionEQ applies the EQ predicate on the "definition" field.
+func DefinitionEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldDefinition, v))
+}
+
+// DefinitionNEQ applies the NEQ predicate on the "definition" field.
+func DefinitionNEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldDefinition, v))
+}
+
+// DefinitionIn applies the In predicate on the "definition" field.
+func DefinitionIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldDefinition, vs...))
+}
+
+// DefinitionNotIn applies the NotIn predicate on the "definition" field.
+func DefinitionNotIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldDefinition, vs...))
+}
+
+// DefinitionGT applies the GT predicate on the "definition" field.
+func DefinitionGT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldDefinition, v))
+}
+
+// DefinitionGTE applies the GTE predicate on the "definition" field.
+func DefinitionGTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldDefinition, v))
+}
+
+// DefinitionLT applies the LT predicate on the "definition" field.
+func DefinitionLT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldDefinition, v))
+}
+
+// DefinitionLTE applies the LTE predicate on the "definition" field.
+func DefinitionLTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldDefinition, v))
+}
+
+// DefinitionContains applies the Contains predicate on the "definition" field.
+func DefinitionContains(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContains(FieldDefinition, v))
+}
+
+// DefinitionHasPrefix applies the HasPrefix predicate on the "definition" field.
+func DefinitionHasPrefix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasPrefix(FieldDefinition, v))
+}
+
+// DefinitionHasSuffix applies the HasSuffix predicate on the "definition" field.
+func DefinitionHasSuffix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasSuffix(FieldDefinition, v))
+}
+
+// DefinitionEqualFold applies the EqualFold predicate on the "definition" field.
+func DefinitionEqualFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEqualFold(FieldDefinition, v))
+}
+
+// DefinitionContainsFold applies the ContainsFold predicate on the "definition" field.
+func DefinitionContainsFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContainsFold(FieldDefinition, v))
+}
+
+// ChangesEQ applies the EQ predicate on the "changes" field.
+func ChangesEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEQ(FieldChanges, v))
+}
+
+// ChangesNEQ applies the NEQ predicate on the "changes" field.
+func ChangesNEQ(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNEQ(FieldChanges, v))
+}
+
+// ChangesIn applies the In predicate on the "changes" field.
+func ChangesIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIn(FieldChanges, vs...))
+}
+
+// ChangesNotIn applies the NotIn predicate on the "changes" field.
+func ChangesNotIn(vs ...string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotIn(FieldChanges, vs...))
+}
+
+// ChangesGT applies the GT predicate on the "changes" field.
+func ChangesGT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGT(FieldChanges, v))
+}
+
+// ChangesGTE applies the GTE predicate on the "changes" field.
+func ChangesGTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldGTE(FieldChanges, v))
+}
+
+// ChangesLT applies the LT predicate on the "changes" field.
+func ChangesLT(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLT(FieldChanges, v))
+}
+
+// ChangesLTE applies the LTE predicate on the "changes" field.
+func ChangesLTE(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldLTE(FieldChanges, v))
+}
+
+// ChangesContains applies the Contains predicate on the "changes" field.
+func ChangesContains(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContains(FieldChanges, v))
+}
+
+// ChangesHasPrefix applies the HasPrefix predicate on the "changes" field.
+func ChangesHasPrefix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasPrefix(FieldChanges, v))
+}
+
+// ChangesHasSuffix applies the HasSuffix predicate on the "changes" field.
+func ChangesHasSuffix(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldHasSuffix(FieldChanges, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldNotNull(FieldChanges))
+}
+
+// ChangesEqualFold applies the EqualFold predicate on the "changes" field.
+func ChangesEqualFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldEqualFold(FieldChanges, v))
+}
+
+// ChangesContainsFold applies the ContainsFold predicate on the "changes" field.
+func ChangesContainsFold(v string) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.FieldContainsFold(FieldChanges, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenomicVersion) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenomicVersion) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenomicVersion) predicate.TokenomicVersion {
+	return predicate.TokenomicVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+	"github.com/rs/xid"
+)
+
+// TokenomicVersionCreate is the builder for creating a TokenomicVersion entity.
+type TokenomicVersionCreate struct {
+	config
+	mutation *TokenomicVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tvc *TokenomicVersionCreate) SetCreatedAt(t time.Time) *TokenomicVersionCreate {
+	tvc.mutation.SetCreatedAt(t)
+	return tvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tvc *TokenomicVersionCreate) SetNillableCreatedAt(t *time.Time) *TokenomicVersionCreate {
+	if t != nil {
+		tvc.SetCreatedAt(*t)
+	}
+	return tvc
+}
+
+// SetVersion sets the "version" field.
+func (tvc *TokenomicVersionCreate) SetVersion(s string) *TokenomicVersionCreate {
+	tvc.mutation.SetVersion(s)
+	return tvc
+}
+
+// SetChecksum sets the "checksum" field.
+func (tvc *TokenomicVersionCreate) SetChecksum(s string) *TokenomicVersionCreate {
+	tvc.mutation.SetChecksum(s)
+	return tvc
+}
+
+// SetDefinition sets the "definition" field.
+func (tvc *TokenomicVersionCreate) SetDefinition(s string) *TokenomicVersionCreate {
+	tvc.mutation.SetDefinition(s)
+	return tvc
+}
+
+// SetChanges sets the "changes" field.
+func (tvc *TokenomicVersionCreate) SetChanges(s string) *TokenomicVersionCreate {
+	tvc.mutation.SetChanges(s)
+	return tvc
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (tvc *TokenomicVersionCreate) SetNillableChanges(s *string) *TokenomicVersionCreate {
+	if s != nil {
+		tvc.SetChanges(*s)
+	}
+	return tvc
+}
+
+// SetID sets the "id" field.
+func (tvc *TokenomicVersionCreate) SetID(x xid.ID) *TokenomicVersionCreate {
+	tvc.mutation.SetID(x)
+	return tvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tvc *TokenomicVersionCreate) SetNillableID(x *xid.ID) *TokenomicVersionCreate {
+	if x != nil {
+		tvc.SetID(*x)
+	}
+	return tvc
+}
+
+// Mutation returns the TokenomicVersionMutation object of the builder.
+func (tvc *TokenomicVersionCreate) Mutation() *TokenomicVersionMutation {
+	return tvc.mutation
+}
+
+// Save creates the TokenomicVersion in the database.
+func (tvc *TokenomicVersionCreate) Save(ctx context.Context) (*TokenomicVersion, error) {
+	tvc.defaults()
+	return withHooks(ctx, tvc.sqlSave, tvc.mutation, tvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tvc *TokenomicVersionCreate) SaveX(ctx context.Context) *TokenomicVersion {
+	v, err := tvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvc *TokenomicVersionCreate) Exec(ctx context.Context) error {
+	_, err := tvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvc *TokenomicVersionCreate) ExecX(ctx context.Context) {
+	if err := tvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvc *TokenomicVersionCreate) defaults() {
+	if _, ok := tvc.mutation.CreatedAt(); !ok {
+		v := tokenomicversion.DefaultCreatedAt()
+		tvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tvc.mutation.ID(); !ok {
+		v := tokenomicversion.DefaultID()
+		tvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvc *TokenomicVersionCreate) check() error {
+	if _, ok := tvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TokenomicVersion.created_at"`)}
+	}
+	if _, ok := tvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "TokenomicVersion.version"`)}
+	}
+	if _, ok := tvc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "TokenomicVersion.checksum"`)}
+	}
+	if _, ok := tvc.mutation.Definition(); !ok {
+		return &ValidationError{Name: "definition", err: errors.New(`ent: missing required field "TokenomicVersion.definition"`)}
+	}
+	return nil
+}
+
+func (tvc *TokenomicVersionCreate) sqlSave(ctx context.Context) (*TokenomicVersion, error) {
+	if err := tvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*xid.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tvc.mutation.id = &_node.ID
+	tvc.mutation.done = true
+	return _node, nil
+}
+
+func (tvc *TokenomicVersionCreate) createSpec() (*TokenomicVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenomicVersion{config: tvc.config}
+		_spec = sqlgraph.NewCreateSpec(tokenomicversion.Table, sqlgraph.NewFieldSpec(tokenomicversion.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = tvc.conflict
+	if id, ok := tvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tvc.mutation.CreatedAt(); ok {
+		_spec.SetField(tokenomicversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tvc.mutation.Version(); ok {
+		_spec.SetField(tokenomicversion.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := tvc.mutation.Checksum(); ok {
+		_spec.SetField(tokenomicversion.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := tvc.mutation.Definition(); ok {
+		_spec.SetField(tokenomicversion.FieldDefinition, field.TypeString, value)
+		_node.Definition = value
+	}
+	if value, ok := tvc.mutation.Changes(); ok {
+		_spec.SetField(tokenomicversion.FieldChanges, field.TypeString, value)
+		_node.Changes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenomicVersion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenomicVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tvc *TokenomicVersionCreate) OnConflict(opts ...sql.ConflictOption) *TokenomicVersionUpsertOne {
+	tvc.conflict = opts
+	return &TokenomicVersionUpsertOne{
+		create: tvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tvc *TokenomicVersionCreate) OnConflictColumns(columns ...string) *TokenomicVersionUpsertOne {
+	tvc.conflict = append(tvc.conflict, sql.ConflictColumns(columns...))
+	return &TokenomicVersionUpsertOne{
+		create: tvc,
+	}
+}
+
+type (
+	// TokenomicVersionUpsertOne is the builder for "upsert"-ing
+	//  one TokenomicVersion node.
+	TokenomicVersionUpsertOne struct {
+		create *TokenomicVersionCreate
+	}
+
+	// TokenomicVersionUpsert is the "OnConflict" setter.
+	TokenomicVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenomicversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenomicVersionUpsertOne) UpdateNewValues() *TokenomicVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenomicversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tokenomicversion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(tokenomicversion.FieldVersion)
+		}
+		if _, exists := u.create.mutation.Checksum(); exists {
+			s.SetIgnore(tokenomicversion.FieldChecksum)
+		}
+		if _, exists := u.create.mutation.Definition(); exists {
+			s.SetIgnore(tokenomicversion.FieldDefinition)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(tokenomicversion.FieldChanges)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenomicVersionUpsertOne) Ignore() *TokenomicVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenomicVersionUpsertOne) DoNothing() *TokenomicVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenomicVersionCreate.OnConflict
+// documentation for more info.
+func (u *TokenomicVersionUpsertOne) Update(set func(*TokenomicVersionUpsert)) *TokenomicVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenomicVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TokenomicVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenomicVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenomicVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenomicVersionUpsertOne) ID(ctx context.Context) (id xid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenomicVersionUpsertOne.ID is not supported by MySQL driver. Use TokenomicVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenomicVersionUpsertOne) IDX(ctx context.Context) xid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenomicVersionCreateBulk is the builder for creating many TokenomicVersion entities in bulk.
+type TokenomicVersionCreateBulk struct {
+	config
+	err      error
+	builders []*TokenomicVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenomicVersion entities in the database.
+func (tvcb *TokenomicVersionCreateBulk) Save(ctx context.Context) ([]*TokenomicVersion, error) {
+	if tvcb.err != nil {
+		return nil, tvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tvcb.builders))
+	nodes := make([]*TokenomicVersion, len(tvcb.builders))
+	mutators := make([]Mutator, len(tvcb.builders))
+	for i := range tvcb.builders {
+		func(i int, root context.Context) {
+			builder := tvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenomicVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvcb *TokenomicVersionCreateBulk) SaveX(ctx context.Context) []*TokenomicVersion {
+	v, err := tvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvcb *TokenomicVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvcb *TokenomicVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := tvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenomicVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenomicVersionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tvcb *TokenomicVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenomicVersionUpsertBulk {
+	tvcb.conflict = opts
+	return &TokenomicVersionUpsertBulk{
+		create: tvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tvcb *TokenomicVersionCreateBulk) OnConflictColumns(columns ...string) *TokenomicVersionUpsertBulk {
+	tvcb.conflict = append(tvcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenomicVersionUpsertBulk{
+		create: tvcb,
+	}
+}
+
+// TokenomicVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenomicVersion nodes.
+type TokenomicVersionUpsertBulk struct {
+	create *TokenomicVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenomicversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenomicVersionUpsertBulk) UpdateNewValues() *TokenomicVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenomicversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tokenomicversion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(tokenomicversion.FieldVersion)
+			}
+			if _, exists := b.mutation.Checksum(); exists {
+				s.SetIgnore(tokenomicversion.FieldChecksum)
+			}
+			if _, exists := b.mutation.Definition(); exists {
+				s.SetIgnore(tokenomicversion.FieldDefinition)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(tokenomicversion.FieldChanges)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenomicVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenomicVersionUpsertBulk) Ignore() *TokenomicVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenomicVersionUpsertBulk) DoNothing() *TokenomicVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenomicVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenomicVersionUpsertBulk) Update(set func(*TokenomicVersionUpsert)) *TokenomicVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenomicVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TokenomicVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenomicVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenomicVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenomicVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+)
+
+// TokenomicVersionDelete is the builder for deleting a TokenomicVersion entity.
+type TokenomicVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TokenomicVersionMutation
+}
+
+// Where appends a list predicates to the TokenomicVersionDelete builder.
+func (tvd *TokenomicVersionDelete) Where(ps ...predicate.TokenomicVersion) *TokenomicVersionDelete {
+	tvd.mutation.Where(ps...)
+	return tvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tvd *TokenomicVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tvd.sqlExec, tvd.mutation, tvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvd *TokenomicVersionDelete) ExecX(ctx context.Context) int {
+	n, err := tvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tvd *TokenomicVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tokenomicversion.Table, sqlgraph.NewFieldSpec(tokenomicversion.FieldID, field.TypeString))
+	if ps := tvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tvd.mutation.done = true
+	return affected, err
+}
+
+// TokenomicVersionDeleteOne is the builder for deleting a single TokenomicVersion entity.
+type TokenomicVersionDeleteOne struct {
+	tvd *TokenomicVersionDelete
+}
+
+// Where appends a list predicates to the TokenomicVersionDelete builder.
+func (tvdo *TokenomicVersionDeleteOne) Where(ps ...predicate.TokenomicVersion) *TokenomicVersionDeleteOne {
+	tvdo.tvd.mutation.Where(ps...)
+	return tvdo
+}
+
+// Exec executes the deletion query.
+func (tvdo *TokenomicVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tvdo.tvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tokenomicversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvdo *TokenomicVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := tvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+	"github.com/rs/xid"
+)
+
+// TokenomicVersionQuery is the builder for querying TokenomicVersion entities.
+type TokenomicVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tokenomicversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TokenomicVersion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TokenomicVersionQuery builder.
+func (tvq *TokenomicVersionQuery) Where(ps ...predicate.TokenomicVersion) *TokenomicVersionQuery {
+	tvq.predicates = append(tvq.predicates, ps...)
+	return tvq
+}
+
+// Limit the number of records to be returned by this query.
+func (tvq *TokenomicVersionQuery) Limit(limit int) *TokenomicVersionQuery {
+	tvq.ctx.Limit = &limit
+	return tvq
+}
+
+// Offset to start from.
+func (tvq *TokenomicVersionQuery) Offset(offset int) *TokenomicVersionQuery {
+	tvq.ctx.Offset = &offset
+	return tvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tvq *TokenomicVersionQuery) Unique(unique bool) *TokenomicVersionQuery {
+	tvq.ctx.Unique = &unique
+	return tvq
+}
+
+// Order specifies how the records should be ordered.
+func (tvq *TokenomicVersionQuery) Order(o ...tokenomicversion.OrderOption) *TokenomicVersionQuery {
+	tvq.order = append(tvq.order, o...)
+	return tvq
+}
+
+// First returns the first TokenomicVersion entity from the query.
+// Returns a *NotFoundError when no TokenomicVersion was found.
+func (tvq *TokenomicVersionQuery) First(ctx context.Context) (*TokenomicVersion, error) {
+	nodes, err := tvq.Limit(1).All(setContextOp(ctx, tvq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tokenomicversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) FirstX(ctx context.Context) *TokenomicVersion {
+	node, err := tvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TokenomicVersion ID from the query.
+// Returns a *NotFoundError when no TokenomicVersion ID was found.
+func (tvq *TokenomicVersionQuery) FirstID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = tvq.Limit(1).IDs(setContextOp(ctx, tvq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tokenomicversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) FirstIDX(ctx context.Context) xid.ID {
+	id, err := tvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TokenomicVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TokenomicVersion entity is found.
+// Returns a *NotFoundError when no TokenomicVersion entities are found.
+func (tvq *TokenomicVersionQuery) Only(ctx context.Context) (*TokenomicVersion, error) {
+	nodes, err := tvq.Limit(2).All(setContextOp(ctx, tvq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tokenomicversion.Label}
+	default:
+		return nil, &NotSingularError{tokenomicversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) OnlyX(ctx context.Context) *TokenomicVersion {
+	node, err := tvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TokenomicVersion ID in the query.
+// Returns a *NotSingularError when more than one TokenomicVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tvq *TokenomicVersionQuery) OnlyID(ctx context.Context) (id xid.ID, err error) {
+	var ids []xid.ID
+	if ids, err = tvq.Limit(2).IDs(setContextOp(ctx, tvq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tokenomicversion.Label}
+	default:
+		err = &NotSingularError{tokenomicversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) OnlyIDX(ctx context.Context) xid.ID {
+	id, err := tvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TokenomicVersions.
+func (tvq *TokenomicVersionQuery) All(ctx context.Context) ([]*TokenomicVersion, error) {
+	ctx = setContextOp(ctx, tvq.ctx, "All")
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TokenomicVersion, *TokenomicVersionQuery]()
+	return withInterceptors[[]*TokenomicVersion](ctx, tvq, qr, tvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) AllX(ctx context.Context) []*TokenomicVersion {
+	nodes, err := tvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TokenomicVersion IDs.
+func (tvq *TokenomicVersionQuery) IDs(ctx context.Context) (ids []xid.ID, err error) {
+	if tvq.ctx.Unique == nil && tvq.path != nil {
+		tvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tvq.ctx, "IDs")
+	if err = tvq.Select(tokenomicversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) IDsX(ctx context.Context) []xid.ID {
+	ids, err := tvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tvq *TokenomicVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tvq.ctx, "Count")
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tvq, querierCount[*TokenomicVersionQuery](), tvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) CountX(ctx context.Context) int {
+	count, err := tvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tvq *TokenomicVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tvq.ctx, "Exist")
+	switch _, err := tvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tvq *TokenomicVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := tvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TokenomicVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tvq *TokenomicVersionQuery) Clone() *TokenomicVersionQuery {
+	if tvq == nil {
+		return nil
+	}
+	return &TokenomicVersionQuery{
+		config:     tvq.config,
+		ctx:        tvq.ctx.Clone(),
+		order:      append([]tokenomicversion.OrderOption{}, tvq.order...),
+		inters:     append([]Interceptor{}, tvq.inters...),
+		predicates: append([]predicate.TokenomicVersion{}, tvq.predicates...),
+		// clone intermediate query.
+		sql:  tvq.sql.Clone(),
+		path: tvq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TokenomicVersion.Query().
+//		GroupBy(tokenomicversion.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tvq *TokenomicVersionQuery) GroupBy(field string, fields ...string) *TokenomicVersionGroupBy {
+	tvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TokenomicVersionGroupBy{build: tvq}
+	grbuild.flds = &tvq.ctx.Fields
+	grbuild.label = tokenomicversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TokenomicVersion.Query().
+//		Select(tokenomicversion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tvq *TokenomicVersionQuery) Select(fields ...string) *TokenomicVersionSelect {
+	tvq.ctx.Fields = append(tvq.ctx.Fields, fields...)
+	sbuild := &TokenomicVersionSelect{TokenomicVersionQuery: tvq}
+	sbuild.label = tokenomicversion.Label
+	sbuild.flds, sbuild.scan = &tvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TokenomicVersionSelect configured with the given aggregations.
+func (tvq *TokenomicVersionQuery) Aggregate(fns ...AggregateFunc) *TokenomicVersionSelect {
+	return tvq.Select().Aggregate(fns...)
+}
+
+func (tvq *TokenomicVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tvq.ctx.Fields {
+		if !tokenomicversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tvq.path != nil {
+		prev, err := tvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tvq.sql = prev
+	}
+	return nil
+}
+
+func (tvq *TokenomicVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TokenomicVersion, error) {
+	var (
+		nodes = []*TokenomicVersion{}
+		_spec = tvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TokenomicVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TokenomicVersion{config: tvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(tvq.modifiers) > 0 {
+		_spec.Modifiers = tvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tvq *TokenomicVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tvq.querySpec()
+	if len(tvq.modifiers) > 0 {
+		_spec.Modifiers = tvq.modifiers
+	}
+	_spec.Node.Columns = tvq.ctx.Fields
+	if len(tvq.ctx.Fields) > 0 {
+		_spec.Unique = tvq.ctx.Unique != nil && *tvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tvq.driver, _spec)
+}
+
+func (tvq *TokenomicVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tokenomicversion.Table, tokenomicversion.Columns, sqlgraph.NewFieldSpec(tokenomicversion.FieldID, field.TypeString))
+	_spec.From = tvq.sql
+	if unique := tvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenomicversion.FieldID)
+		for i := range fields {
+			if fields[i] != tokenomicversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tvq *TokenomicVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tvq.driver.Dialect())
+	t1 := builder.Table(tokenomicversion.Table)
+	columns := tvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tokenomicversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tvq.sql != nil {
+		selector = tvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tvq.ctx.Unique != nil && *tvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tvq.modifiers {
+		m(selector)
+	}
+	for _, p := range tvq.predicates {
+		p(selector)
+	}
+	for _, p := range tvq.order {
+		p(selector)
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tvq *TokenomicVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *TokenomicVersionSelect {
+	tvq.modifiers = append(tvq.modifiers, modifiers...)
+	return tvq.Select()
+}
+
+// TokenomicVersionGroupBy is the group-by builder for TokenomicVersion entities.
+type TokenomicVersionGroupBy struct {
+	selector
+	build *TokenomicVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tvgb *TokenomicVersionGroupBy) Aggregate(fns ...AggregateFunc) *TokenomicVersionGroupBy {
+	tvgb.fns = append(tvgb.fns, fns...)
+	return tvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvgb *TokenomicVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvgb.build.ctx, "GroupBy")
+	if err := tvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenomicVersionQuery, *TokenomicVersionGroupBy](ctx, tvgb.build, tvgb, tvgb.build.inters, v)
+}
+
+func (tvgb *TokenomicVersionGroupBy) sqlScan(ctx context.Context, root *TokenomicVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tvgb.fns))
+	for _, fn := range tvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tvgb.flds)+len(tvgb.fns))
+		for _, f := range *tvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TokenomicVersionSelect is the builder for selecting fields of TokenomicVersion entities.
+type TokenomicVersionSelect struct {
+	*TokenomicVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tvs *TokenomicVersionSelect) Aggregate(fns ...AggregateFunc) *TokenomicVersionSelect {
+	tvs.fns = append(tvs.fns, fns...)
+	return tvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvs *TokenomicVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvs.ctx, "Select")
+	if err := tvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenomicVersionQuery, *TokenomicVersionSelect](ctx, tvs.TokenomicVersionQuery, tvs, tvs.inters, v)
+}
+
+func (tvs *TokenomicVersionSelect) sqlScan(ctx context.Context, root *TokenomicVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tvs.fns))
+	for _, fn := range tvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tvs *TokenomicVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *TokenomicVersionSelect {
+	tvs.modifiers = append(tvs.modifiers, modifiers...)
+	return tvs
+}
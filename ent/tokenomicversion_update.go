@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+)
+
+// TokenomicVersionUpdate is the builder for updating TokenomicVersion entities.
+type TokenomicVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TokenomicVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TokenomicVersionUpdate builder.
+func (tvu *TokenomicVersionUpdate) Where(ps ...predicate.TokenomicVersion) *TokenomicVersionUpdate {
+	tvu.mutation.Where(ps...)
+	return tvu
+}
+
+// Mutation returns the TokenomicVersionMutation object of the builder.
+func (tvu *TokenomicVersionUpdate) Mutation() *TokenomicVersionMutation {
+	return tvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tvu *TokenomicVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tvu.sqlSave, tvu.mutation, tvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvu *TokenomicVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tvu *TokenomicVersionUpdate) Exec(ctx context.Context) error {
+	_, err := tvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvu *TokenomicVersionUpdate) ExecX(ctx context.Context) {
+	if err := tvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tvu *TokenomicVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TokenomicVersionUpdate {
+	tvu.modifiers = append(tvu.modifiers, modifiers...)
+	return tvu
+}
+
+func (tvu *TokenomicVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenomicversion.Table, tokenomicversion.Columns, sqlgraph.NewFieldSpec(tokenomicversion.FieldID, field.TypeString))
+	if ps := tvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tvu.mutation.ChangesCleared() {
+		_spec.ClearField(tokenomicversion.FieldChanges, field.TypeString)
+	}
+	_spec.AddModifiers(tvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenomicversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tvu.mutation.done = true
+	return n, nil
+}
+
+// TokenomicVersionUpdateOne is the builder for updating a single TokenomicVersion entity.
+type TokenomicVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TokenomicVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TokenomicVersionMutation object of the builder.
+func (tvuo *TokenomicVersionUpdateOne) Mutation() *TokenomicVersionMutation {
+	return tvuo.mutation
+}
+
+// Where appends a list predicates to the TokenomicVersionUpdate builder.
+func (tvuo *TokenomicVersionUpdateOne) Where(ps ...predicate.TokenomicVersion) *TokenomicVersionUpdateOne {
+	tvuo.mutation.Where(ps...)
+	return tvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tvuo *TokenomicVersionUpdateOne) Select(field string, fields ...string) *TokenomicVersionUpdateOne {
+	tvuo.fields = append([]string{field}, fields...)
+	return tvuo
+}
+
+// Save executes the query and returns the updated TokenomicVersion entity.
+func (tvuo *TokenomicVersionUpdateOne) Save(ctx context.Context) (*TokenomicVersion, error) {
+	return withHooks(ctx, tvuo.sqlSave, tvuo.mutation, tvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvuo *TokenomicVersionUpdateOne) SaveX(ctx context.Context) *TokenomicVersion {
+	node, err := tvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tvuo *TokenomicVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvuo *TokenomicVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := tvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tvuo *TokenomicVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TokenomicVersionUpdateOne {
+	tvuo.modifiers = append(tvuo.modifiers, modifiers...)
+	return tvuo
+}
+
+func (tvuo *TokenomicVersionUpdateOne) sqlSave(ctx context.Context) (_node *TokenomicVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenomicversion.Table, tokenomicversion.Columns, sqlgraph.NewFieldSpec(tokenomicversion.FieldID, field.TypeString))
+	id, ok := tvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenomicVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenomicversion.FieldID)
+		for _, f := range fields {
+			if !tokenomicversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenomicversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tvuo.mutation.ChangesCleared() {
+		_spec.ClearField(tokenomicversion.FieldChanges, field.TypeString)
+	}
+	_spec.AddModifiers(tvuo.modifiers...)
+	_node = &TokenomicVersion{config: tvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenomicversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tvuo.mutation.done = true
+	return _node, nil
+}
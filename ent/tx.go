@@ -14,20 +14,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AlertRule is the client for interacting with the AlertRule builders.
+	AlertRule *AlertRuleClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// CurrencyRate is the client for interacting with the CurrencyRate builders.
 	CurrencyRate *CurrencyRateClient
 	// Ico is the client for interacting with the Ico builders.
 	Ico *IcoClient
 	// IcoCoupon is the client for interacting with the IcoCoupon builders.
 	IcoCoupon *IcoCouponClient
+	// IcoCouponRedemption is the client for interacting with the IcoCouponRedemption builders.
+	IcoCouponRedemption *IcoCouponRedemptionClient
+	// IcoDailyStat is the client for interacting with the IcoDailyStat builders.
+	IcoDailyStat *IcoDailyStatClient
 	// IcoHistory is the client for interacting with the IcoHistory builders.
 	IcoHistory *IcoHistoryClient
+	// IcoOutstandingRefund is the client for interacting with the IcoOutstandingRefund builders.
+	IcoOutstandingRefund *IcoOutstandingRefundClient
 	// IcoRound is the client for interacting with the IcoRound builders.
 	IcoRound *IcoRoundClient
+	// LeaderboardSnapshot is the client for interacting with the LeaderboardSnapshot builders.
+	LeaderboardSnapshot *LeaderboardSnapshotClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// ReferralCommission is the client for interacting with the ReferralCommission builders.
+	ReferralCommission *ReferralCommissionClient
+	// TokenomicVersion is the client for interacting with the TokenomicVersion builders.
+	TokenomicVersion *TokenomicVersionClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// UserWallet is the client for interacting with the UserWallet builders.
 	UserWallet *UserWalletClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -159,13 +181,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AlertRule = NewAlertRuleClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.CurrencyRate = NewCurrencyRateClient(tx.config)
 	tx.Ico = NewIcoClient(tx.config)
 	tx.IcoCoupon = NewIcoCouponClient(tx.config)
+	tx.IcoCouponRedemption = NewIcoCouponRedemptionClient(tx.config)
+	tx.IcoDailyStat = NewIcoDailyStatClient(tx.config)
 	tx.IcoHistory = NewIcoHistoryClient(tx.config)
+	tx.IcoOutstandingRefund = NewIcoOutstandingRefundClient(tx.config)
 	tx.IcoRound = NewIcoRoundClient(tx.config)
+	tx.LeaderboardSnapshot = NewLeaderboardSnapshotClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.ReferralCommission = NewReferralCommissionClient(tx.config)
+	tx.TokenomicVersion = NewTokenomicVersionClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
 	tx.UserWallet = NewUserWalletClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -175,7 +208,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: CurrencyRate.QueryXXX(), the query will be executed
+// applies a query, for example: AlertRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
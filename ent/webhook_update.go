@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/webhook"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WebhookUpdate) SetUpdatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetOwner sets the "owner" field.
+func (wu *WebhookUpdate) SetOwner(s string) *WebhookUpdate {
+	wu.mutation.SetOwner(s)
+	return wu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableOwner(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetOwner(*s)
+	}
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetSecret(*s)
+	}
+	return wu
+}
+
+// SetTransTypes sets the "trans_types" field.
+func (wu *WebhookUpdate) SetTransTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetTransTypes(s)
+	return wu
+}
+
+// AppendTransTypes appends s to the "trans_types" field.
+func (wu *WebhookUpdate) AppendTransTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendTransTypes(s)
+	return wu
+}
+
+// ClearTransTypes clears the value of the "trans_types" field.
+func (wu *WebhookUpdate) ClearTransTypes() *WebhookUpdate {
+	wu.mutation.ClearTransTypes()
+	return wu
+}
+
+// SetUserIds sets the "user_ids" field.
+func (wu *WebhookUpdate) SetUserIds(s []string) *WebhookUpdate {
+	wu.mutation.SetUserIds(s)
+	return wu
+}
+
+// AppendUserIds appends s to the "user_ids" field.
+func (wu *WebhookUpdate) AppendUserIds(s []string) *WebhookUpdate {
+	wu.mutation.AppendUserIds(s)
+	return wu
+}
+
+// ClearUserIds clears the value of the "user_ids" field.
+func (wu *WebhookUpdate) ClearUserIds() *WebhookUpdate {
+	wu.mutation.ClearUserIds()
+	return wu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wu *WebhookUpdate) SetDeletedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetDeletedAt(t)
+	return wu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDeletedAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetDeletedAt(*t)
+	}
+	return wu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wu *WebhookUpdate) ClearDeletedAt() *WebhookUpdate {
+	wu.mutation.ClearDeletedAt()
+	return wu
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Owner(); ok {
+		_spec.SetField(webhook.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.TransTypes(); ok {
+		_spec.SetField(webhook.FieldTransTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedTransTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldTransTypes, value)
+		})
+	}
+	if wu.mutation.TransTypesCleared() {
+		_spec.ClearField(webhook.FieldTransTypes, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.UserIds(); ok {
+		_spec.SetField(webhook.FieldUserIds, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldUserIds, value)
+		})
+	}
+	if wu.mutation.UserIdsCleared() {
+		_spec.ClearField(webhook.FieldUserIds, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.DeletedAt(); ok {
+		_spec.SetField(webhook.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wu.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhook.FieldDeletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WebhookUpdateOne) SetUpdatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetOwner sets the "owner" field.
+func (wuo *WebhookUpdateOne) SetOwner(s string) *WebhookUpdateOne {
+	wuo.mutation.SetOwner(s)
+	return wuo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableOwner(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetOwner(*s)
+	}
+	return wuo
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetSecret(*s)
+	}
+	return wuo
+}
+
+// SetTransTypes sets the "trans_types" field.
+func (wuo *WebhookUpdateOne) SetTransTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetTransTypes(s)
+	return wuo
+}
+
+// AppendTransTypes appends s to the "trans_types" field.
+func (wuo *WebhookUpdateOne) AppendTransTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendTransTypes(s)
+	return wuo
+}
+
+// ClearTransTypes clears the value of the "trans_types" field.
+func (wuo *WebhookUpdateOne) ClearTransTypes() *WebhookUpdateOne {
+	wuo.mutation.ClearTransTypes()
+	return wuo
+}
+
+// SetUserIds sets the "user_ids" field.
+func (wuo *WebhookUpdateOne) SetUserIds(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetUserIds(s)
+	return wuo
+}
+
+// AppendUserIds appends s to the "user_ids" field.
+func (wuo *WebhookUpdateOne) AppendUserIds(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendUserIds(s)
+	return wuo
+}
+
+// ClearUserIds clears the value of the "user_ids" field.
+func (wuo *WebhookUpdateOne) ClearUserIds() *WebhookUpdateOne {
+	wuo.mutation.ClearUserIds()
+	return wuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wuo *WebhookUpdateOne) SetDeletedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetDeletedAt(t)
+	return wuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDeletedAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetDeletedAt(*t)
+	}
+	return wuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wuo *WebhookUpdateOne) ClearDeletedAt() *WebhookUpdateOne {
+	wuo.mutation.ClearDeletedAt()
+	return wuo
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Owner(); ok {
+		_spec.SetField(webhook.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.TransTypes(); ok {
+		_spec.SetField(webhook.FieldTransTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedTransTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldTransTypes, value)
+		})
+	}
+	if wuo.mutation.TransTypesCleared() {
+		_spec.ClearField(webhook.FieldTransTypes, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.UserIds(); ok {
+		_spec.SetField(webhook.FieldUserIds, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldUserIds, value)
+		})
+	}
+	if wuo.mutation.UserIdsCleared() {
+		_spec.ClearField(webhook.FieldUserIds, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.DeletedAt(); ok {
+		_spec.SetField(webhook.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhook.FieldDeletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
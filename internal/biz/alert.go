@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ALERT_LOW_BALANCE     = "LOW_BALANCE"
+	ALERT_LARGE_DEBIT     = "LARGE_DEBIT"
+	ALERT_REWARD_RECEIVED = "REWARD_RECEIVED"
+)
+
+var REWARD_TRANS_TYPES = []string{REFERRAL_REWARD, MarketingReward, ICO_COMISSION, ICO_CASHBACK}
+
+// Alert is a rule that fired on a committed transaction.
+type Alert struct {
+	ID            string
+	Rule          *AlertRule
+	TransactionID string
+	Amount        string
+	Balance       string
+	OccurredAt    time.Time
+}
+
+type AlertUsecase struct {
+	repo AlertRuleRepo
+	log  *log.Helper
+}
+
+func NewAlertUsecase(repo AlertRuleRepo) *AlertUsecase {
+	return &AlertUsecase{
+		repo: repo,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func (uc *AlertUsecase) SaveAlertRule(ctx context.Context, input *AlertRule) (*AlertRule, error) {
+	if input.Type != ALERT_LOW_BALANCE && input.Type != ALERT_LARGE_DEBIT && input.Type != ALERT_REWARD_RECEIVED {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if len(input.Threshold) == 0 {
+		input.Threshold = "0"
+	}
+	threshold, err := decimal.NewFromString(input.Threshold)
+	if err != nil || threshold.IsNegative() {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	input.Threshold = threshold.String()
+
+	return uc.repo.SaveAlertRule(ctx, input)
+}
+
+func (uc *AlertUsecase) GetAlertRules(ctx context.Context, userId string) ([]*AlertRule, error) {
+	return uc.repo.GetAlertRulesByUserIds(ctx, []string{userId})
+}
+
+func (uc *AlertUsecase) DeleteAlertRule(ctx context.Context, userId, id string) error {
+	return uc.repo.DeleteAlertRule(ctx, userId, id)
+}
+
+// Evaluate returns the alerts the committed transaction fires for its payer and
+// receiver, using the balances snapshotted in the event.
+func (uc *AlertUsecase) Evaluate(ctx context.Context, event *TransactionEvent) ([]*Alert, error) {
+	trans := event.Transaction
+	rules, err := uc.repo.GetAlertRulesByUserIds(ctx, []string{trans.Source, trans.Destination})
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []*Alert
+	for _, rule := range rules {
+		threshold, err := decimal.NewFromString(rule.Threshold)
+		if err != nil {
+			uc.log.Errorf("Evaluate rule %s threshold %s: %v", rule.ID, rule.Threshold, err)
+			continue
+		}
+
+		var amount decimal.Decimal
+		fired := false
+		switch rule.Type {
+		case ALERT_LOW_BALANCE, ALERT_LARGE_DEBIT:
+			symbol, debit := debitOf(trans)
+			if trans.Source != rule.UserID || symbol != rule.Symbol {
+				continue
+			}
+			amount, err = decimal.NewFromString(debit)
+			if err != nil || !amount.IsPositive() {
+				continue
+			}
+			if rule.Type == ALERT_LARGE_DEBIT {
+				fired = amount.GreaterThan(threshold)
+				break
+			}
+			// only when this debit crossed the threshold, not on every debit below it
+			after := balanceOf(event.Balances, rule.UserID, rule.Symbol)
+			fired = after.LessThan(threshold) && after.Add(amount).GreaterThanOrEqual(threshold)
+		case ALERT_REWARD_RECEIVED:
+			if trans.Destination != rule.UserID || trans.DestSymbol != rule.Symbol || !contains(REWARD_TRANS_TYPES, trans.TransType) {
+				continue
+			}
+			amount, err = decimal.NewFromString(trans.DestAmount)
+			if err != nil {
+				continue
+			}
+			fired = amount.IsPositive() && amount.GreaterThanOrEqual(threshold)
+		}
+		if !fired {
+			continue
+		}
+
+		alerts = append(alerts, &Alert{
+			ID:            event.ID + "-" + rule.ID.String(),
+			Rule:          rule,
+			TransactionID: trans.ID.String(),
+			Amount:        amount.String(),
+			Balance:       balanceOf(event.Balances, rule.UserID, rule.Symbol).String(),
+			OccurredAt:    event.OccurredAt,
+		})
+	}
+
+	return alerts, nil
+}
+
+// debitOf returns the symbol and amount taken from the source wallet. A
+// subscription is priced in its own currency but charged in IND, the charge is
+// the destination side of the transaction.
+func debitOf(trans *Transaction) (string, string) {
+	if trans.TransType == SUBSCRIPTION {
+		return trans.DestSymbol, trans.DestAmount
+	}
+	return trans.SrcSymbol, trans.SrcAmount
+}
+
+// balanceOf returns the balance of the user's USER wallet in symbol, the one
+// transactions debit.
+func balanceOf(wallets []*UserWallet, userId, symbol string) decimal.Decimal {
+	total := decimal.Zero
+	for _, w := range wallets {
+		if w.UserID != userId || w.Symbol != symbol || w.Type != constant.WALLET_TYPE_USER {
+			continue
+		}
+		balance, err := decimal.NewFromString(w.Balance)
+		if err == nil {
+			total = total.Add(balance)
+		}
+	}
+	return total
+}
@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+)
+
+const (
+	AUDIT_EXPORT_CSV   = "csv"
+	AUDIT_EXPORT_JSONL = "jsonl"
+
+	// upper bound of rows in one export, narrow the filter for more
+	AUDIT_EXPORT_LIMIT = 10000
+)
+
+// AuditState is the part of the data a privileged call can change, recorded
+// before and after the call.
+type AuditState struct {
+	Wallets   []*UserWallet  `json:"wallets,omitempty"`
+	Coupon    *IcoCoupon     `json:"coupon,omitempty"`
+	Round     *ICORound      `json:"round,omitempty"`
+	SubRounds []*ICOSubRound `json:"sub_rounds,omitempty"`
+}
+
+// AuditTarget is what a privileged call acts on, any field may be empty.
+type AuditTarget struct {
+	UserId string
+	Coupon string
+	// RoundId, or the round of sub-round SubRoundId, is snapshotted with its
+	// sub-rounds.
+	RoundId    int32
+	SubRoundId string
+	// CurrentRound snapshots the round of the running sub-round, for the calls
+	// on the sale rather than on a round.
+	CurrentRound bool
+}
+
+type AuditUsecase struct {
+	repo       AuditLogRepo
+	walletRepo UserWalletRepo
+	icoCoupon  IcoCouponRepo
+	icoRepo    ICORepo
+	log        *log.Helper
+}
+
+func NewAuditUsecase(repo AuditLogRepo, walletRepo UserWalletRepo, icoCoupon IcoCouponRepo, icoRepo ICORepo) *AuditUsecase {
+	return &AuditUsecase{
+		repo:       repo,
+		walletRepo: walletRepo,
+		icoCoupon:  icoCoupon,
+		icoRepo:    icoRepo,
+		log:        log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// Record appends an entry. Failures are logged, never returned: auditing must not
+// change the outcome of the audited call.
+func (uc *AuditUsecase) Record(ctx context.Context, entry *AuditLog) {
+	if err := uc.repo.CreateAuditLog(ctx, entry); err != nil {
+		data, _ := json.Marshal(entry)
+		uc.log.Errorf("Record audit log %s: %v", string(data), err)
+	}
+}
+
+// RecordJob runs fn and records it as an entry of actor, e.g. a migration run by
+// cmd/init. fn's error is returned as is.
+func (uc *AuditUsecase) RecordJob(ctx context.Context, actor, service, name string, fn func(ctx context.Context) (after string, err error)) error {
+	entry := &AuditLog{Actor: actor, Service: service, Rpc: name, Outcome: constant.SuccessStatus}
+	after, err := fn(ctx)
+	entry.After = after
+	if err != nil {
+		entry.Outcome = constant.FailedStatus
+		entry.Error = err.Error()
+	}
+	uc.Record(ctx, entry)
+	return err
+}
+
+// Snapshot returns the JSON state of the target: the user's wallets, the coupon
+// and the round with its sub-rounds. Errors are kept in the snapshot rather
+// than failing the call.
+func (uc *AuditUsecase) Snapshot(ctx context.Context, target *AuditTarget) string {
+	if len(target.UserId) == 0 && len(target.Coupon) == 0 && target.RoundId == 0 && len(target.SubRoundId) == 0 && !target.CurrentRound {
+		return ""
+	}
+
+	state := &AuditState{}
+	var errs []string
+	if len(target.UserId) > 0 {
+		wallets, err := uc.walletRepo.GetWalletByUserId(ctx, target.UserId, "", "")
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		state.Wallets = wallets
+	}
+	if len(target.Coupon) > 0 {
+		c, err := uc.icoCoupon.GetCoupon(ctx, strings.ToUpper(target.Coupon))
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		state.Coupon = c
+	}
+	if err := uc.snapshotRound(ctx, target, state); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err.Error()
+	}
+	if len(errs) > 0 {
+		return string(data) + " errors: " + strings.Join(errs, "; ")
+	}
+	return string(data)
+}
+
+// snapshotRound fills the round of target and its sub-rounds. A round that
+// does not exist, before a create or after a delete, is left empty.
+func (uc *AuditUsecase) snapshotRound(ctx context.Context, target *AuditTarget, state *AuditState) error {
+	roundId := target.RoundId
+	switch {
+	case len(target.SubRoundId) > 0:
+		subRound, err := uc.icoRepo.GetSubRoundById(ctx, target.SubRoundId)
+		if err != nil || subRound == nil {
+			// deleted, or not an id
+			return nil
+		}
+		roundId = subRound.RoundId
+	case target.CurrentRound:
+		subRounds, err := uc.icoRepo.GetSubRounds(ctx, 0)
+		if err != nil {
+			return err
+		}
+		for _, s := range subRounds {
+			if !s.IsEnded {
+				roundId = s.RoundId
+				break
+			}
+		}
+	}
+	if roundId == 0 {
+		return nil
+	}
+
+	subRounds, err := uc.icoRepo.GetSubRounds(ctx, roundId)
+	if err != nil {
+		return err
+	}
+	state.SubRounds = subRounds
+	if round, err := uc.icoRepo.GetRoundByRoundId(ctx, roundId); err == nil {
+		state.Round = round
+	}
+	return nil
+}
+
+func (uc *AuditUsecase) GetAuditLogs(ctx context.Context, filter *AuditLogFilter, cursor string, limit int32) ([]*AuditLog, string, error) {
+	if limit <= 0 || limit > constant.DEFAULT_LIMIT {
+		limit = constant.DEFAULT_LIMIT
+	}
+	return uc.repo.GetAuditLogs(ctx, filter, cursor, limit)
+}
+
+// ExportAuditLogs renders every entry matching filter, newest first, as CSV or
+// JSON lines.
+func (uc *AuditUsecase) ExportAuditLogs(ctx context.Context, filter *AuditLogFilter, format string) ([]byte, error) {
+	if len(format) == 0 {
+		format = AUDIT_EXPORT_CSV
+	}
+	if format != AUDIT_EXPORT_CSV && format != AUDIT_EXPORT_JSONL {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	var logs []*AuditLog
+	cursor := ""
+	for len(logs) < AUDIT_EXPORT_LIMIT {
+		page, next, err := uc.repo.GetAuditLogs(ctx, filter, cursor, 500)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, page...)
+		if len(next) == 0 {
+			break
+		}
+		cursor = next
+	}
+	if len(logs) > AUDIT_EXPORT_LIMIT {
+		logs = logs[:AUDIT_EXPORT_LIMIT]
+	}
+
+	buf := &bytes.Buffer{}
+	if format == AUDIT_EXPORT_JSONL {
+		encoder := json.NewEncoder(buf)
+		for _, l := range logs {
+			if err := encoder.Encode(l); err != nil {
+				return nil, err
+			}
+		}
+		return buf.Bytes(), nil
+	}
+
+	w := csv.NewWriter(buf)
+	w.Write([]string{"id", "created_at", "actor", "service", "roles", "rpc", "target_user_id", "payload_hash", "before", "after", "outcome", "error"})
+	for _, l := range logs {
+		w.Write([]string{l.ID.String(), l.CreatedAt.Format(time.RFC3339), l.Actor, l.Service, strings.Join(l.Roles, " "), l.Rpc,
+			l.TargetUserID, l.PayloadHash, l.Before, l.After, l.Outcome, l.Error})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
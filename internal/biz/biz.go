@@ -7,6 +7,6 @@ import (
 
 // ProviderSet is biz providers.
 var (
-	ProviderSet      = wire.NewSet(NewICOUseCase, NewWalletTransactionUseCase)
+	ProviderSet      = wire.NewSet(NewICOUseCase, NewWalletTransactionUseCase, NewWebhookUsecase, NewAlertUsecase, NewAuditUsecase, NewInvariantUsecase, NewTokenomicsUsecase, NewICOAdminUsecase, NewICOStatsUsecase, NewReferralUsecase, NewICORefundUsecase)
 	usdt_fee_percent = decimal.NewFromFloat32(0.125).Div(decimal.NewFromInt(100))
 )
@@ -2,27 +2,38 @@ package biz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
 	"github.com/shopspring/decimal"
 )
 
+// ICO_MAX_RETRY bounds how often a purchase re-reads the current sub-round
+// after losing a race against another purchase.
+const ICO_MAX_RETRY = 10
+
 type ICOUsecase struct {
 	repo             ICORepo
 	icoCoupon        IcoCouponRepo
 	currencyRateRepo CurrencyRateRepo
+	tierRepo         UserTierRepo
+	leaderboard      LeaderboardRepo
 	log              *log.Helper
 }
 
-func NewICOUseCase(repo ICORepo, icoCoupon IcoCouponRepo, currencyRateRepo CurrencyRateRepo) *ICOUsecase {
+func NewICOUseCase(repo ICORepo, icoCoupon IcoCouponRepo, currencyRateRepo CurrencyRateRepo, tierRepo UserTierRepo, leaderboard LeaderboardRepo) *ICOUsecase {
 	return &ICOUsecase{
 		repo:             repo,
 		icoCoupon:        icoCoupon,
 		currencyRateRepo: currencyRateRepo,
+		tierRepo:         tierRepo,
+		leaderboard:      leaderboard,
 		log:              log.NewHelper(log.DefaultLogger),
 	}
 }
@@ -31,13 +42,25 @@ func (uc *ICOUsecase) GetICORounds(ctx context.Context) ([]*ICORound, error) {
 	return uc.repo.GetRounds(ctx)
 }
 
+// GetRoundRaised is what round roundId raised, in the unit of its price.
+func (uc *ICOUsecase) GetRoundRaised(ctx context.Context, roundId int32) (string, error) {
+	return uc.repo.GetRoundRaised(ctx, roundId)
+}
+
 func (uc *ICOUsecase) GetICOCurrentRound(ctx context.Context) (*ICOSubRound, error) {
 	return uc.repo.GetCurrentSubRound(ctx)
 }
 
-func (uc *ICOUsecase) AddICOCoupon(ctx context.Context, userId, coupon, reward, cashback string) error {
-	coupon = strings.ToUpper(coupon)
-	return uc.icoCoupon.AddCoupon(ctx, &IcoCoupon{UserID: userId, Coupon: coupon, Reward: reward, Cashback: cashback})
+// AddICOCoupon adds a coupon, it fails with COUPON_EXISTS when the code is taken.
+func (uc *ICOUsecase) AddICOCoupon(ctx context.Context, coupon *IcoCoupon) error {
+	coupon.Coupon = strings.ToUpper(strings.TrimSpace(coupon.Coupon))
+	if len(coupon.UserID) == 0 || len(coupon.Coupon) == 0 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if err := validateCoupon(coupon); err != nil {
+		return err
+	}
+	return uc.icoCoupon.AddCoupon(ctx, coupon)
 }
 
 func (uc *ICOUsecase) GetICOCoupon(ctx context.Context, coupon string) (*IcoCoupon, error) {
@@ -49,9 +72,11 @@ func (uc *ICOUsecase) GetICOCoupon(ctx context.Context, coupon string) (*IcoCoup
 	return couponData, nil
 }
 
+// GetICOUserHistory pages the leaderboard. It is rebuilt when empty, and read
+// from ico_histories while it can't be read.
 func (uc *ICOUsecase) GetICOUserHistory(ctx context.Context, next string, limit int) ([]*ICOUserBought, int, error) {
-	totalUser, err := uc.repo.GetBuyICOTotalUser(ctx)
 	var offset = 0
+	var err error
 	if offset, err = strconv.Atoi(next); err != nil {
 		offset = 0
 	}
@@ -59,6 +84,31 @@ func (uc *ICOUsecase) GetICOUserHistory(ctx context.Context, next string, limit
 		limit = 50
 	}
 
+	totalUser, err := uc.leaderboard.CountUsers(ctx)
+	if err == nil && totalUser == 0 {
+		totalUser, err = uc.RebuildLeaderboard(ctx)
+	}
+	if err != nil {
+		uc.log.Error("GetICOUserHistory ", err)
+		return uc.getICOUserHistoryFromDB(ctx, offset, limit)
+	}
+	if offset >= totalUser {
+		return []*ICOUserBought{}, totalUser, nil
+	}
+
+	userBought, err := uc.leaderboard.GetRanks(ctx, offset, limit)
+	if err != nil {
+		uc.log.Error("GetICOUserHistory ", err)
+		return uc.getICOUserHistoryFromDB(ctx, offset, limit)
+	}
+	return userBought, totalUser, nil
+}
+
+func (uc *ICOUsecase) getICOUserHistoryFromDB(ctx context.Context, offset, limit int) ([]*ICOUserBought, int, error) {
+	totalUser, err := uc.repo.GetBuyICOTotalUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
 	if offset >= totalUser {
 		return []*ICOUserBought{}, totalUser, nil
 	}
@@ -70,100 +120,255 @@ func (uc *ICOUsecase) GetICOUserHistory(ctx context.Context, next string, limit
 	return userBought, totalUser, nil
 }
 
-func (uc *ICOUsecase) ICOHistories(ctx context.Context, userId, amount, symbol, icoType string) (decimal.Decimal, error) {
+// ICOHistories buys the tokens amount in symbol pays for, sub-round after
+// sub-round, and records them as bought with payment sourceId. limited holds
+// the purchase to the limits of every round it buys in.
+func (uc *ICOUsecase) ICOHistories(ctx context.Context, userId, amount, symbol, sourceId, icoType string, limited bool) (decimal.Decimal, error) {
 	totalToken := decimal.NewFromInt(0)
 
 	histories := []ICOHistory{}
 	remaining := decimal.RequireFromString(amount)
-	stop := false
+	retry := 0
 
-	for !stop && !remaining.IsZero() {
+	for !remaining.IsZero() {
 		currentRound, err := uc.repo.GetCurrentSubRound(ctx)
 		if err != nil {
 			uc.log.Error("ICOHistories ", err)
 			return totalToken, err
 		}
+		if !currentRound.PausedAt.IsZero() {
+			return totalToken, errors.New(constant.ERROR_ICO_PAUSED)
+		}
+		if currentRound.StartAt.After(time.Now()) {
+			return totalToken, errors.New(constant.ERROR_ROUND_NOT_STARTED)
+		}
 		currencySymbol := fmt.Sprintf("%s_%s", symbol, constant.TokenSymbolIND)
 		currency, err := uc.currencyRateRepo.GetCurrencyRate(ctx, currencySymbol)
 		if err != nil {
 			uc.log.Error("ICOHistories ", err)
 			return totalToken, err
 		}
-		boughtCurrent := decimal.RequireFromString(currentRound.BoughtToken)
-		roundToken := decimal.RequireFromString(currentRound.TotalToken)
-		roundRemainToken := roundToken.Sub(boughtCurrent)
 		rate := decimal.RequireFromString(currency.Rate)
-		roundRemainAmount := roundRemainToken.Mul(rate)
-		bought := roundRemainAmount
-		if roundRemainAmount.GreaterThan(remaining) {
-			stop = true
-			bought = remaining
-		}
 
-		numToken := bought.Div(rate)
-		totalToken = totalToken.Add(numToken)
-		histories = append(histories, ICOHistory{
-			RoundId:  currentRound.RoundId,
-			SubRound: currentRound.SubRound,
-			UserId:   userId,
-			Price:    currentRound.Price,
-			NumToken: numToken.String(),
-			Type:     icoType,
-		})
-
-		if !stop {
-			currentRound.BoughtToken = boughtCurrent.Add(numToken).String()
-			err = uc.CloseSubRound(ctx, currentRound)
+		round, err := uc.repo.GetRoundByRoundId(ctx, currentRound.RoundId)
+		if err != nil {
+			uc.log.Error("ICOHistories ", err)
+			return totalToken, err
+		}
+		// it reached its hard cap, its sub-round is about to close
+		if round.Status == ROUND_CAPPED {
+			return totalToken, errors.New(constant.ERROR_ROUND_CLOSED)
+		}
+		if !round.Pricing.IsStep() {
+			history, spent, err := uc.buyPriced(ctx, round, currentRound.ID, userId, remaining, rate, icoType)
 			if err != nil {
 				uc.log.Error("ICOHistories ", err)
 				return totalToken, err
 			}
-			currency, err = uc.currencyRateRepo.GetCurrencyRate(ctx, currencySymbol)
+			// nothing is spent when the sub-round ended in between, the next one is tried
+			remaining = remaining.Sub(spent)
+			if history != nil {
+				history.Amount = spent.String()
+				totalToken = totalToken.Add(decimal.RequireFromString(history.NumToken))
+				histories = append(histories, *history)
+			}
+			continue
+		}
+		numToken := remaining.Div(rate)
+
+		// Purchases that fit in the sub-round take their tokens with a single
+		// conditional update, so they don't wait for each other.
+		roundRemainToken := decimal.RequireFromString(currentRound.TotalToken).Sub(decimal.RequireFromString(currentRound.BoughtToken))
+		if numToken.LessThan(roundRemainToken) {
+			ok, err := uc.repo.TakeSubRoundToken(ctx, currentRound.ID, numToken.String())
 			if err != nil {
 				uc.log.Error("ICOHistories ", err)
 				return totalToken, err
 			}
-			remaining = remaining.Sub(bought)
+			if ok {
+				history := uc.newHistory(currentRound, userId, numToken, icoType)
+				history.Amount = remaining.String()
+				totalToken = totalToken.Add(numToken)
+				histories = append(histories, history)
+				break
+			}
 		} else {
-			err := uc.repo.UpdateSubRoundBought(ctx, currentRound.RoundId, currentRound.SubRound, boughtCurrent.Add(numToken).String())
+			// The purchase fills the sub-round. Lock the row, so only one purchase closes it.
+			lockedRound, err := uc.repo.LockSubRound(ctx, currentRound.ID)
 			if err != nil {
 				uc.log.Error("ICOHistories ", err)
 				return totalToken, err
 			}
+			if !lockedRound.PausedAt.IsZero() {
+				return totalToken, errors.New(constant.ERROR_ICO_PAUSED)
+			}
+			boughtCurrent := decimal.RequireFromString(lockedRound.BoughtToken)
+			roundToken := decimal.RequireFromString(lockedRound.TotalToken)
+			roundRemainToken = roundToken.Sub(boughtCurrent)
+			if !lockedRound.IsEnded && !numToken.LessThan(roundRemainToken) {
+				history := uc.newHistory(lockedRound, userId, roundRemainToken, icoType)
+				history.Amount = roundRemainToken.Mul(rate).String()
+				totalToken = totalToken.Add(roundRemainToken)
+				histories = append(histories, history)
+
+				lockedRound.BoughtToken = roundToken.String()
+				_, err = uc.CloseSubRound(ctx, lockedRound)
+				if err != nil {
+					uc.log.Error("ICOHistories ", err)
+					return totalToken, err
+				}
+				remaining = remaining.Sub(roundRemainToken.Mul(rate))
+				continue
+			}
+		}
+
+		// Another purchase changed the sub-round in between, try again with a fresh read.
+		retry++
+		if retry > ICO_MAX_RETRY {
+			uc.log.Error("ICOHistories too many concurrent purchases on sub-round ", currentRound.ID)
+			return totalToken, errors.New(constant.ERROR_LOCK)
 		}
 	}
 
+	if limited {
+		if err := uc.checkRoundLimits(ctx, userId, histories); err != nil {
+			uc.log.Error("ICOHistories ", err)
+			return totalToken, err
+		}
+		if err := uc.checkHardCaps(ctx, histories); err != nil {
+			uc.log.Error("ICOHistories ", err)
+			return totalToken, err
+		}
+	}
+	for i := range histories {
+		histories[i].SourceId, histories[i].Symbol = sourceId, symbol
+	}
 	err := uc.repo.SaveHistories(ctx, histories)
 	if err != nil {
 		uc.log.Error("ICOHistories ", err)
 		return totalToken, err
 	}
+	if err := uc.enforceHardCap(ctx, histories); err != nil {
+		uc.log.Error("ICOHistories ", err)
+		return totalToken, err
+	}
 	return totalToken, nil
 
 }
 
-func (uc *ICOUsecase) CloseSubRound(ctx context.Context, currentRound *ICOSubRound) error {
+// buyPriced buys with value, in a symbol at rate for the sub-round price, on
+// the pricing of round. It locks the sub-round, so the price counts every
+// earlier purchase, and returns what it bought and what that cost in the
+// symbol: all of value unless the purchase filled the sub-round. It buys
+// nothing when the sub-round ended meanwhile, and drops a value too small to
+// buy anything.
+func (uc *ICOUsecase) buyPriced(ctx context.Context, round *ICORound, id xid.ID, userId string, value, rate decimal.Decimal, icoType string) (*ICOHistory, decimal.Decimal, error) {
+	subRound, err := uc.repo.LockSubRound(ctx, id)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	if !subRound.PausedAt.IsZero() {
+		return nil, decimal.Zero, errors.New(constant.ERROR_ICO_PAUSED)
+	}
+	if subRound.IsEnded {
+		return nil, decimal.Zero, nil
+	}
+	strategy, state, err := uc.priceState(ctx, round, subRound)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	// symbol per unit of price
+	perPrice := rate.Div(decimal.RequireFromString(subRound.Price))
+	left := decimal.RequireFromString(subRound.TotalToken).Sub(decimal.RequireFromString(subRound.BoughtToken))
+	numToken := strategy.Tokens(state, value.Div(perPrice), left)
+	if !numToken.IsPositive() {
+		return nil, value, nil
+	}
+	cost := strategy.Cost(state, numToken)
+	history := uc.newHistory(subRound, userId, numToken, icoType)
+	history.Price = cost.Div(numToken).String()
+
+	if numToken.LessThan(left) {
+		ok, err := uc.repo.TakeSubRoundToken(ctx, id, numToken.String())
+		if err != nil {
+			return nil, decimal.Zero, err
+		}
+		if !ok {
+			// it fits and the row is locked
+			return nil, decimal.Zero, errors.New(constant.ERROR_LOCK)
+		}
+		return &history, value, nil
+	}
+
+	subRound.BoughtToken = subRound.TotalToken
+	if _, err := uc.CloseSubRound(ctx, subRound); err != nil {
+		return nil, decimal.Zero, err
+	}
+	return &history, cost.Mul(perPrice), nil
+}
+
+func (uc *ICOUsecase) newHistory(round *ICOSubRound, userId string, numToken decimal.Decimal, icoType string) ICOHistory {
+	return ICOHistory{
+		RoundId:  round.RoundId,
+		SubRound: round.SubRound,
+		UserId:   userId,
+		Price:    round.Price,
+		NumToken: numToken.String(),
+		Type:     icoType,
+	}
+}
+
+// CloseSubRound ends currentRound and returns the sub-round it opens after
+// it, nil when none is left.
+func (uc *ICOUsecase) CloseSubRound(ctx context.Context, currentRound *ICOSubRound) (*ICOSubRound, error) {
 	newSubRound, err := uc.repo.CloseSubRound(ctx, currentRound.RoundId, currentRound.SubRound, currentRound.BoughtToken)
 	if err != nil {
 		uc.log.Error("CloseSubRound ", err)
-		return err
+		return nil, err
 	}
-	if newSubRound.RoundId != currentRound.RoundId {
+	if newSubRound == nil || newSubRound.RoundId != currentRound.RoundId {
 		err = uc.repo.EndRoundByRoundId(ctx, currentRound.RoundId)
 		if err != nil {
 			uc.log.Error("CloseSubRound ", err)
-			return err
+			return nil, err
 		}
-
+	}
+	// The rates follow the price of the running sub-round, the pricing
+	// strategies price relative to it.
+	if newSubRound != nil && !decimalEqual(currentRound.Price, newSubRound.Price) {
 		err = uc.UpdateCurrencyRateICO(ctx, currentRound.Price, newSubRound.Price)
 		if err != nil {
 			uc.log.Error("CloseSubRound ", err)
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return newSubRound, nil
 }
 
 func (uc *ICOUsecase) UpdateCurrencyRateICO(ctx context.Context, oldPrice, newPrice string) error {
 	return uc.currencyRateRepo.UpdateCurrencyRateICO(ctx, decimal.RequireFromString(newPrice).Div(decimal.RequireFromString(oldPrice)).String())
 }
+
+// SubRoundWindow is when a sub-round opening at now runs: from its planned start
+// if that is later, until its planned end if that is later still, otherwise for
+// its lifetime, the lifetime of its round or SUBROUND_LIFETIME minutes.
+func SubRoundWindow(now time.Time, subRound *ICOSubRound, roundLifetime int32) (time.Time, time.Time) {
+	start := now
+	if subRound.StartAt.After(now) {
+		start = subRound.StartAt
+	}
+	if subRound.EndAt.After(start) {
+		return start, subRound.EndAt
+	}
+
+	lifetime := subRound.Lifetime
+	if lifetime <= 0 {
+		lifetime = roundLifetime
+	}
+	if lifetime <= 0 {
+		minutes, _ := strconv.Atoi(constant.SUBROUND_LIFETIME)
+		lifetime = int32(minutes)
+	}
+	return start, start.Add(time.Duration(lifetime) * time.Minute)
+}
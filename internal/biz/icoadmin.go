@@ -0,0 +1,606 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+// ICOAdminUsecase lets operations plan the rounds ahead of the sale. Only future
+// rounds and sub-rounds can change: not ended, not running and without sales.
+// The running sub-round can only have its end moved.
+type ICOAdminUsecase struct {
+	repo       ICORepo
+	walletRepo UserWalletRepo
+	queue      QueueJob
+	log        *log.Helper
+}
+
+func NewICOAdminUsecase(repo ICORepo, walletRepo UserWalletRepo, queue QueueJob) *ICOAdminUsecase {
+	return &ICOAdminUsecase{
+		repo:       repo,
+		walletRepo: walletRepo,
+		queue:      queue,
+		log:        log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func (uc *ICOAdminUsecase) GetSubRounds(ctx context.Context, roundId int32) ([]*ICOSubRound, error) {
+	return uc.repo.GetSubRounds(ctx, roundId)
+}
+
+// CreateRound adds a round after the running one and splits its tokens evenly
+// in NumSub sub-rounds at its price.
+func (uc *ICOAdminUsecase) CreateRound(ctx context.Context, input *ICORound) (*ICORound, error) {
+	if err := validateRound(input); err != nil {
+		return nil, err
+	}
+	if err := uc.checkUnsoldWallet(ctx, input.Unsold); err != nil {
+		return nil, err
+	}
+	if len(input.RoundName) == 0 {
+		input.RoundName = fmt.Sprintf("Round %d", input.RoundId)
+	}
+	if len(input.PriceGap) == 0 {
+		input.PriceGap = "0%"
+	}
+
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.GetRoundByRoundId(ctx, input.RoundId); err == nil {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+		current, err := uc.currentSubRound(ctx)
+		if err != nil {
+			return err
+		}
+		if current != nil && input.RoundId <= current.RoundId {
+			return errors.New(constant.ERROR_ROUND_STARTED)
+		}
+
+		if err := uc.repo.SaveRound(ctx, input); err != nil {
+			return err
+		}
+		return uc.createSubRounds(ctx, input)
+	})
+	if err != nil {
+		uc.log.Error("CreateRound ", err)
+		return nil, err
+	}
+	return input, uc.schedule(ctx)
+}
+
+// UpdateRound changes a future round. A new price, token count or number of
+// sub-rounds splits the round again, it fails with SUB_ROUNDS_EDITED when a
+// sub-round is no longer the even split it would drop.
+func (uc *ICOAdminUsecase) UpdateRound(ctx context.Context, input *ICORound) (*ICORound, error) {
+	if err := validateRound(input); err != nil {
+		return nil, err
+	}
+	if err := uc.checkUnsoldWallet(ctx, input.Unsold); err != nil {
+		return nil, err
+	}
+
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		round, subRounds, err := uc.lockFutureRound(ctx, input.RoundId)
+		if err != nil {
+			return err
+		}
+		if len(input.RoundName) == 0 {
+			input.RoundName = round.RoundName
+		}
+		if len(input.PriceGap) == 0 {
+			input.PriceGap = round.PriceGap
+		}
+		if err := uc.repo.SaveRound(ctx, input); err != nil {
+			return err
+		}
+		if decimalEqual(round.Price, input.Price) && decimalEqual(round.NumToken, input.NumToken) && round.NumSub == input.NumSub {
+			return nil
+		}
+		if !isEvenSplit(round, subRounds) {
+			return errors.New(constant.ERROR_SUB_ROUNDS_EDITED)
+		}
+
+		for _, s := range subRounds {
+			if err := uc.repo.DeleteSubRound(ctx, s.ID); err != nil {
+				return err
+			}
+		}
+		return uc.createSubRounds(ctx, input)
+	})
+	if err != nil {
+		uc.log.Error("UpdateRound ", err)
+		return nil, err
+	}
+	return input, uc.schedule(ctx)
+}
+
+// SetRoundLimits sets the purchase limits of a round that did not end. Unlike
+// its other settings they can change while the round runs.
+func (uc *ICOAdminUsecase) SetRoundLimits(ctx context.Context, roundId int32, limits ICOLimits) (*ICORound, error) {
+	if err := validateLimits(limits); err != nil {
+		return nil, err
+	}
+
+	var round *ICORound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		round, err = uc.repo.GetRoundByRoundId(ctx, roundId)
+		if err != nil {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+		if round.EndedAt != nil {
+			return errors.New(constant.ERROR_ROUND_CLOSED)
+		}
+		round.Limits = limits
+		return uc.repo.SaveRound(ctx, round)
+	})
+	if err != nil {
+		uc.log.Error("SetRoundLimits ", err)
+		return nil, err
+	}
+	return round, nil
+}
+
+// SetRoundUnsold sets the unsold policy of a round that did not end, it
+// applies to the sub-rounds ending from then on.
+func (uc *ICOAdminUsecase) SetRoundUnsold(ctx context.Context, roundId int32, unsold ICOUnsold) (*ICORound, error) {
+	if err := validateUnsold(unsold); err != nil {
+		return nil, err
+	}
+	if err := uc.checkUnsoldWallet(ctx, unsold); err != nil {
+		return nil, err
+	}
+
+	var round *ICORound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		round, err = uc.repo.GetRoundByRoundId(ctx, roundId)
+		if err != nil {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+		if round.EndedAt != nil {
+			return errors.New(constant.ERROR_ROUND_CLOSED)
+		}
+		round.Unsold = unsold
+		return uc.repo.SaveRound(ctx, round)
+	})
+	if err != nil {
+		uc.log.Error("SetRoundUnsold ", err)
+		return nil, err
+	}
+	return round, nil
+}
+
+// checkUnsoldWallet fails with NOT_FOUND when the system wallet unsold tokens
+// go to does not exist. The burn wallet is created when first needed.
+func (uc *ICOAdminUsecase) checkUnsoldWallet(ctx context.Context, unsold ICOUnsold) error {
+	if unsold.Policy == UNSOLD_BURN {
+		return nil
+	}
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, unsold.wallet(), constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		uc.log.Error("checkUnsoldWallet ", err)
+		return err
+	}
+	if len(wallets) == 0 {
+		return errors.New(constant.ERROR_NOT_FOUND)
+	}
+	return nil
+}
+
+// DeleteRound removes a future round and its sub-rounds.
+func (uc *ICOAdminUsecase) DeleteRound(ctx context.Context, roundId int32) error {
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		_, subRounds, err := uc.lockFutureRound(ctx, roundId)
+		if err != nil {
+			return err
+		}
+		for _, s := range subRounds {
+			if err := uc.repo.DeleteSubRound(ctx, s.ID); err != nil {
+				return err
+			}
+		}
+		return uc.repo.DeleteRound(ctx, roundId)
+	})
+	if err != nil {
+		uc.log.Error("DeleteRound ", err)
+	}
+	return err
+}
+
+// CreateSubRound appends a sub-round to a round that has not ended, at the
+// price of the round unless input sets one. The round grows by its tokens.
+func (uc *ICOAdminUsecase) CreateSubRound(ctx context.Context, input *ICOSubRound) (*ICOSubRound, error) {
+	// the price of the round, when input has none, was checked with the round
+	if len(input.Price) > 0 {
+		if err := validatePrice(input.Price); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateSubRoundPlan(input); err != nil {
+		return nil, err
+	}
+
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		round, err := uc.repo.GetRoundByRoundId(ctx, input.RoundId)
+		if err != nil {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+		if round.EndedAt != nil {
+			return errors.New(constant.ERROR_ROUND_CLOSED)
+		}
+		if len(input.Price) == 0 {
+			input.Price = round.Price
+		}
+
+		subRounds, err := uc.repo.GetSubRounds(ctx, round.RoundId)
+		if err != nil {
+			return err
+		}
+		input.SubRound = round.NumSub + 1
+		if len(subRounds) > 0 && subRounds[len(subRounds)-1].SubRound >= input.SubRound {
+			input.SubRound = subRounds[len(subRounds)-1].SubRound + 1
+		}
+		input.ID, input.BoughtToken = xid.ID{}, "0"
+		if err := uc.repo.SaveSubRound(ctx, input); err != nil {
+			return err
+		}
+
+		round.NumSub++
+		round.NumToken = decimal.RequireFromString(round.NumToken).Add(decimal.RequireFromString(input.TotalToken)).String()
+		return uc.repo.SaveRound(ctx, round)
+	})
+	if err != nil {
+		uc.log.Error("CreateSubRound ", err)
+		return nil, err
+	}
+	return input, uc.schedule(ctx)
+}
+
+// UpdateSubRound changes the price, tokens, planned times and lifetime of a
+// future sub-round. The round follows the change of its tokens.
+func (uc *ICOAdminUsecase) UpdateSubRound(ctx context.Context, input *ICOSubRound) (*ICOSubRound, error) {
+	if err := validateSubRound(input); err != nil {
+		return nil, err
+	}
+
+	var subRound *ICOSubRound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		subRound, err = uc.lockFutureSubRound(ctx, input.ID)
+		if err != nil {
+			return err
+		}
+		delta := decimal.RequireFromString(input.TotalToken).Sub(decimal.RequireFromString(subRound.TotalToken))
+		subRound.Price, subRound.TotalToken = input.Price, input.TotalToken
+		subRound.StartAt, subRound.EndAt, subRound.Lifetime = input.StartAt, input.EndAt, input.Lifetime
+		if err := uc.repo.SaveSubRound(ctx, subRound); err != nil {
+			return err
+		}
+		if delta.IsZero() {
+			return nil
+		}
+
+		round, err := uc.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+		if err != nil {
+			return err
+		}
+		round.NumToken = decimal.RequireFromString(round.NumToken).Add(delta).String()
+		return uc.repo.SaveRound(ctx, round)
+	})
+	if err != nil {
+		uc.log.Error("UpdateSubRound ", err)
+		return nil, err
+	}
+	return subRound, nil
+}
+
+// DeleteSubRound removes a future sub-round, the round shrinks by its tokens.
+func (uc *ICOAdminUsecase) DeleteSubRound(ctx context.Context, id xid.ID) error {
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		subRound, err := uc.lockFutureSubRound(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.DeleteSubRound(ctx, id); err != nil {
+			return err
+		}
+
+		round, err := uc.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+		if err != nil {
+			return err
+		}
+		round.NumSub--
+		round.NumToken = decimal.RequireFromString(round.NumToken).Sub(decimal.RequireFromString(subRound.TotalToken)).String()
+		return uc.repo.SaveRound(ctx, round)
+	})
+	if err != nil {
+		uc.log.Error("DeleteSubRound ", err)
+	}
+	return err
+}
+
+// ExtendSubRound moves the end of the running sub-round and schedules the
+// endround task at the new end. The task queued for the old end finds the
+// sub-round still running and does nothing.
+func (uc *ICOAdminUsecase) ExtendSubRound(ctx context.Context, id xid.ID, endAt time.Time) (*ICOSubRound, error) {
+	if !endAt.After(time.Now()) {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	var subRound *ICOSubRound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		subRound, err = uc.repo.LockSubRound(ctx, id)
+		if err != nil {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+		if subRound.IsEnded {
+			return errors.New(constant.ERROR_ROUND_CLOSED)
+		}
+		current, err := uc.currentSubRound(ctx)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.ID != id {
+			// not running yet, plan its end with UpdateSubRound
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+
+		subRound.EndAt = endAt
+		return uc.repo.SetSubRoundEnd(ctx, id, endAt)
+	})
+	if err != nil {
+		uc.log.Error("ExtendSubRound ", err)
+		return nil, err
+	}
+	return subRound, uc.schedule(ctx)
+}
+
+// PauseICO stops the sale on the running sub-round: purchases fail with
+// ICO_PAUSED and its endround task does nothing until ResumeICO.
+func (uc *ICOAdminUsecase) PauseICO(ctx context.Context) (*ICOSubRound, error) {
+	var subRound *ICOSubRound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		subRound, err = uc.lockCurrentSubRound(ctx)
+		if err != nil || !subRound.PausedAt.IsZero() {
+			return err
+		}
+
+		subRound.PausedAt = time.Now()
+		return uc.repo.PauseSubRound(ctx, subRound.ID, subRound.PausedAt)
+	})
+	if err != nil {
+		uc.log.Error("PauseICO ", err)
+		return nil, err
+	}
+	return subRound, nil
+}
+
+// ResumeICO restarts the sale. The running sub-round ends later by the time
+// it was paused, and its endround task is queued at the new end.
+func (uc *ICOAdminUsecase) ResumeICO(ctx context.Context) (*ICOSubRound, error) {
+	var subRound *ICOSubRound
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		subRound, err = uc.lockCurrentSubRound(ctx)
+		if err != nil || subRound.PausedAt.IsZero() {
+			return err
+		}
+
+		if !subRound.EndAt.IsZero() {
+			subRound.EndAt = subRound.EndAt.Add(time.Since(subRound.PausedAt))
+		}
+		subRound.PausedAt = time.Time{}
+		return uc.repo.ResumeSubRound(ctx, subRound.ID, subRound.EndAt)
+	})
+	if err != nil {
+		uc.log.Error("ResumeICO ", err)
+		return nil, err
+	}
+	return subRound, uc.schedule(ctx)
+}
+
+// lockCurrentSubRound returns the running sub-round, locked.
+func (uc *ICOAdminUsecase) lockCurrentSubRound(ctx context.Context) (*ICOSubRound, error) {
+	current, err := uc.currentSubRound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, errors.New(constant.ERROR_ROUND_CLOSED)
+	}
+	subRound, err := uc.repo.LockSubRound(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if subRound.IsEnded {
+		// a purchase closed it meanwhile
+		return nil, errors.New(constant.ERROR_LOCK)
+	}
+	return subRound, nil
+}
+
+// lockFutureRound returns the round and its sub-rounds, locked, when none of
+// them ended, sold tokens or runs.
+func (uc *ICOAdminUsecase) lockFutureRound(ctx context.Context, roundId int32) (*ICORound, []*ICOSubRound, error) {
+	round, err := uc.repo.GetRoundByRoundId(ctx, roundId)
+	if err != nil {
+		return nil, nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	if round.EndedAt != nil {
+		return nil, nil, errors.New(constant.ERROR_ROUND_CLOSED)
+	}
+
+	subRounds, err := uc.repo.GetSubRounds(ctx, roundId)
+	if err != nil {
+		return nil, nil, err
+	}
+	// lock them before reading the running one, so a purchase can't open one in between
+	for i, s := range subRounds {
+		if subRounds[i], err = uc.repo.LockSubRound(ctx, s.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	current, err := uc.currentSubRound(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, s := range subRounds {
+		if err := checkFutureSubRound(s, current); err != nil {
+			return nil, nil, err
+		}
+	}
+	return round, subRounds, nil
+}
+
+// lockFutureSubRound returns the sub-round, locked, when it did not end, sell
+// tokens or start running.
+func (uc *ICOAdminUsecase) lockFutureSubRound(ctx context.Context, id xid.ID) (*ICOSubRound, error) {
+	subRound, err := uc.repo.LockSubRound(ctx, id)
+	if err != nil {
+		return nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	current, err := uc.currentSubRound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFutureSubRound(subRound, current); err != nil {
+		return nil, err
+	}
+	return subRound, nil
+}
+
+func checkFutureSubRound(subRound, current *ICOSubRound) error {
+	if subRound.IsEnded {
+		return errors.New(constant.ERROR_ROUND_CLOSED)
+	}
+	if !decimal.RequireFromString(subRound.BoughtToken).IsZero() || (current != nil && current.ID == subRound.ID) {
+		return errors.New(constant.ERROR_ROUND_STARTED)
+	}
+	return nil
+}
+
+// currentSubRound is the running sub-round, nil when the sale is over.
+func (uc *ICOAdminUsecase) currentSubRound(ctx context.Context) (*ICOSubRound, error) {
+	subRounds, err := uc.repo.GetSubRounds(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range subRounds {
+		if !s.IsEnded {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// isEvenSplit reports whether the sub-rounds are the ones createSubRounds
+// makes for round, with no sub-round added, removed or changed since.
+func isEvenSplit(round *ICORound, subRounds []*ICOSubRound) bool {
+	if int32(len(subRounds)) != round.NumSub {
+		return false
+	}
+	numToken := decimal.RequireFromString(round.NumToken).Div(decimal.NewFromInt32(round.NumSub))
+	for i, s := range subRounds {
+		if s.SubRound != int32(i+1) || !decimalEqual(s.Price, round.Price) || !decimal.RequireFromString(s.TotalToken).Equal(numToken) ||
+			!s.StartAt.IsZero() || !s.EndAt.IsZero() || s.Lifetime != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (uc *ICOAdminUsecase) createSubRounds(ctx context.Context, round *ICORound) error {
+	numToken := decimal.RequireFromString(round.NumToken).Div(decimal.NewFromInt32(round.NumSub)).String()
+	for j := int32(1); j <= round.NumSub; j++ {
+		err := uc.repo.SaveSubRound(ctx, &ICOSubRound{RoundId: round.RoundId, SubRound: j, Price: round.Price, TotalToken: numToken, BoughtToken: "0"})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schedule opens the running sub-round when it has no end yet, e.g. the first
+// one of a round created after the sale ended, and queues its endround task.
+func (uc *ICOAdminUsecase) schedule(ctx context.Context) error {
+	current, err := uc.currentSubRound(ctx)
+	if err != nil || current == nil {
+		return err
+	}
+	if current.EndAt.IsZero() {
+		round, err := uc.repo.GetRoundByRoundId(ctx, current.RoundId)
+		if err != nil {
+			return err
+		}
+		current.StartAt, current.EndAt = SubRoundWindow(time.Now(), current, round.Lifetime)
+		if err := uc.repo.SaveSubRound(ctx, current); err != nil {
+			return err
+		}
+	}
+	return uc.queue.Enqueue(ctx, NewEndRoundTask(current))
+}
+
+func validateRound(input *ICORound) error {
+	price, err := decimal.NewFromString(input.Price)
+	if err != nil || !price.IsPositive() {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	numToken, err := decimal.NewFromString(input.NumToken)
+	if err != nil || !numToken.IsPositive() || input.RoundId <= 0 || input.NumSub <= 0 || input.Lifetime < 0 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if _, err := parsePercent(input.PriceGap); len(input.PriceGap) > 0 && err != nil {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if !numToken.Div(decimal.NewFromInt32(input.NumSub)).Mul(decimal.NewFromInt32(input.NumSub)).Equal(numToken) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if err := validatePricing(input); err != nil {
+		return err
+	}
+	if err := validateUnsold(input.Unsold); err != nil {
+		return err
+	}
+	if err := validateCaps(input); err != nil {
+		return err
+	}
+	return validateLimits(input.Limits)
+}
+
+func validateSubRound(input *ICOSubRound) error {
+	if err := validatePrice(input.Price); err != nil {
+		return err
+	}
+	return validateSubRoundPlan(input)
+}
+
+func validatePrice(value string) error {
+	price, err := decimal.NewFromString(value)
+	if err != nil || !price.IsPositive() {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
+
+// validateSubRoundPlan checks the tokens, lifetime and planned times of a
+// sub-round.
+func validateSubRoundPlan(input *ICOSubRound) error {
+	numToken, err := decimal.NewFromString(input.TotalToken)
+	if err != nil || !numToken.IsPositive() || input.Lifetime < 0 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if !input.StartAt.IsZero() && !input.EndAt.IsZero() && !input.EndAt.After(input.StartAt) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
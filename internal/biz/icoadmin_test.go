@@ -0,0 +1,161 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+// scheduled records the tasks enqueued, in place of the queue.
+type scheduled struct {
+	queue
+	tasks []*biz.Task
+}
+
+func (s *scheduled) Enqueue(ctx context.Context, task *biz.Task) error {
+	s.tasks = append(s.tasks, task)
+	return nil
+}
+
+// newAdmin returns the admin use case on the dev rounds: five rounds of 100
+// sub-rounds, the first one running.
+func newAdmin(t *testing.T) (*biz.ICOAdminUsecase, biz.ICORepo, *scheduled) {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, q := memrepo.NewIcoRepo(st), &scheduled{}
+	return biz.NewICOAdminUsecase(repo, memrepo.NewWalletRepo(st), q), repo, q
+}
+
+func wantErr(t *testing.T, what string, err error, code string) {
+	t.Helper()
+	if err == nil || err.Error() != code {
+		t.Errorf("%s: err %v, want %s", what, err, code)
+	}
+}
+
+func TestCreateRound(t *testing.T) {
+	ctx := context.Background()
+	admin, repo, q := newAdmin(t)
+
+	round, err := admin.CreateRound(ctx, &biz.ICORound{RoundId: 6, Price: "2", NumToken: "300", NumSub: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.RoundName != "Round 6" || round.PriceGap != "0%" {
+		t.Errorf("defaults not set: %+v", round)
+	}
+	subRounds, _ := repo.GetSubRounds(ctx, 6)
+	if len(subRounds) != 3 || subRounds[2].SubRound != 3 || subRounds[2].TotalToken != "100" {
+		t.Errorf("round 6 split in %d sub-rounds", len(subRounds))
+	}
+	if len(q.tasks) != 1 {
+		t.Errorf("%d tasks enqueued, want the end of the running sub-round", len(q.tasks))
+	}
+
+	_, err = admin.CreateRound(ctx, &biz.ICORound{RoundId: 6, Price: "2", NumToken: "300", NumSub: 3})
+	wantErr(t, "a round twice", err, constant.ERROR_BAD_REQUEST)
+	_, err = admin.CreateRound(ctx, &biz.ICORound{RoundId: 7, Price: "2", NumToken: "100", NumSub: 3})
+	wantErr(t, "an uneven split", err, constant.ERROR_BAD_REQUEST)
+	_, err = admin.CreateRound(ctx, &biz.ICORound{RoundId: 7, Price: "2", NumToken: "300", NumSub: 3, Unsold: biz.ICOUnsold{Policy: biz.UNSOLD_WALLET, Wallet: "SYS_NOWHERE"}})
+	wantErr(t, "unsold tokens to a missing wallet", err, constant.ERROR_NOT_FOUND)
+}
+
+func TestUpdateRound(t *testing.T) {
+	ctx := context.Background()
+	admin, repo, q := newAdmin(t)
+
+	if _, err := admin.UpdateRound(ctx, &biz.ICORound{RoundId: 5, Price: "1", NumToken: "400", NumSub: 4}); err != nil {
+		t.Fatal(err)
+	}
+	subRounds, _ := repo.GetSubRounds(ctx, 5)
+	if len(subRounds) != 4 || subRounds[0].Price != "1" || subRounds[0].TotalToken != "100" {
+		t.Errorf("round 5 split in %d sub-rounds: %+v", len(subRounds), subRounds[0])
+	}
+	if len(q.tasks) != 1 {
+		t.Errorf("%d tasks enqueued, want 1", len(q.tasks))
+	}
+
+	edited := *subRounds[1]
+	edited.TotalToken = "150"
+	if _, err := admin.UpdateSubRound(ctx, &edited); err != nil {
+		t.Fatal(err)
+	}
+	_, err := admin.UpdateRound(ctx, &biz.ICORound{RoundId: 5, Price: "3", NumToken: "450", NumSub: 3})
+	wantErr(t, "a re-split dropping an edit", err, constant.ERROR_SUB_ROUNDS_EDITED)
+	if _, err := admin.UpdateRound(ctx, &biz.ICORound{RoundId: 5, RoundName: "Last call", Price: "1", NumToken: "450", NumSub: 4}); err != nil {
+		t.Errorf("renaming without a re-split: %v", err)
+	}
+	if after, _ := repo.GetSubRounds(ctx, 5); after[1].TotalToken != "150" {
+		t.Errorf("edited sub-round holds %s, want 150", after[1].TotalToken)
+	}
+
+	_, err = admin.UpdateRound(ctx, &biz.ICORound{RoundId: 1, Price: "1", NumToken: "100", NumSub: 1})
+	wantErr(t, "the running round", err, constant.ERROR_ROUND_STARTED)
+	_, err = admin.UpdateRound(ctx, &biz.ICORound{RoundId: 9, Price: "1", NumToken: "100", NumSub: 1})
+	wantErr(t, "a missing round", err, constant.ERROR_NOT_FOUND)
+}
+
+func TestSubRounds(t *testing.T) {
+	ctx := context.Background()
+	admin, repo, _ := newAdmin(t)
+
+	_, err := admin.CreateSubRound(ctx, &biz.ICOSubRound{RoundId: 2, TotalToken: "0"})
+	wantErr(t, "a sub-round without tokens", err, constant.ERROR_BAD_REQUEST)
+	_, err = admin.CreateSubRound(ctx, &biz.ICOSubRound{RoundId: 2, Price: "-1", TotalToken: "10"})
+	wantErr(t, "a sub-round at a negative price", err, constant.ERROR_BAD_REQUEST)
+
+	added, err := admin.CreateSubRound(ctx, &biz.ICOSubRound{RoundId: 2, TotalToken: "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	round, _ := repo.GetRoundByRoundId(ctx, 2)
+	if added.SubRound != 101 || round.NumSub != 101 || round.NumToken != "80000010" || added.Price != round.Price {
+		t.Errorf("sub-round %d at %s, round of %d sub-rounds and %s tokens", added.SubRound, added.Price, round.NumSub, round.NumToken)
+	}
+
+	if err := admin.DeleteSubRound(ctx, added.ID); err != nil {
+		t.Fatal(err)
+	}
+	if round, _ := repo.GetRoundByRoundId(ctx, 2); round.NumSub != 100 || round.NumToken != "80000000" {
+		t.Errorf("round of %d sub-rounds and %s tokens after the delete", round.NumSub, round.NumToken)
+	}
+
+	running, _ := repo.GetSubRounds(ctx, 1)
+	wantErr(t, "deleting the running sub-round", admin.DeleteSubRound(ctx, running[0].ID), constant.ERROR_ROUND_STARTED)
+	wantErr(t, "deleting the running round", admin.DeleteRound(ctx, 1), constant.ERROR_ROUND_STARTED)
+	if err := admin.DeleteRound(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if subRounds, _ := repo.GetSubRounds(ctx, 5); len(subRounds) > 0 {
+		t.Errorf("%d sub-rounds left of a deleted round", len(subRounds))
+	}
+}
+
+func TestExtendSubRound(t *testing.T) {
+	ctx := context.Background()
+	admin, repo, q := newAdmin(t)
+	running, _ := repo.GetSubRounds(ctx, 1)
+
+	_, err := admin.ExtendSubRound(ctx, running[0].ID, time.Now().Add(-time.Minute))
+	wantErr(t, "an end in the past", err, constant.ERROR_BAD_REQUEST)
+	_, err = admin.ExtendSubRound(ctx, running[1].ID, time.Now().Add(time.Hour))
+	wantErr(t, "a sub-round not running", err, constant.ERROR_BAD_REQUEST)
+
+	endAt := running[0].EndAt.Add(time.Hour)
+	if _, err := admin.ExtendSubRound(ctx, running[0].ID, endAt); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.GetSubRounds(ctx, 1); !got[0].EndAt.Equal(endAt) {
+		t.Errorf("ends at %v, want %v", got[0].EndAt, endAt)
+	}
+	if len(q.tasks) != 1 || !q.tasks[0].ProcessAt.Equal(endAt) {
+		t.Errorf("tasks %v, want the endround task at %v", q.tasks, endAt)
+	}
+}
@@ -0,0 +1,284 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ROUND_OPEN is a round that did not end.
+	ROUND_OPEN = "open"
+	// ROUND_ENDED is a round that ended at or above its soft cap.
+	ROUND_ENDED = "ended"
+	// ROUND_CAPPED is a round that reached its hard cap, it sells no more and
+	// its sub-rounds close early.
+	ROUND_CAPPED = "capped"
+	// ROUND_FAILED is a round that ended below its soft cap, its purchases are
+	// refunded.
+	ROUND_FAILED = "failed"
+
+	// ICO_CAP_PLACES are the places raised values are compared to the caps
+	// at, the tokens of a purchase are a division and carry more.
+	ICO_CAP_PLACES = 8
+
+	ICO_ROUND_SETTLE = "icoround:settle"
+	// ICO_ROUND_SETTLE_MAX_RETRY is how many times a settlement that could not
+	// refund every purchase is retried.
+	ICO_ROUND_SETTLE_MAX_RETRY = 10
+)
+
+// State is ROUND_OPEN until the round ends, ROUND_ENDED after unless its
+// status tells otherwise.
+func (r *ICORound) State() string {
+	if len(r.Status) > 0 {
+		return r.Status
+	}
+	if r.EndedAt != nil {
+		return ROUND_ENDED
+	}
+	return ROUND_OPEN
+}
+
+// NewSettleRoundTask is the task settling round roundId, once it ended.
+func NewSettleRoundTask(roundId int32) *Task {
+	return &Task{Name: ICO_ROUND_SETTLE, Data: strconv.Itoa(int(roundId)), ProcessAt: time.Now(), MaxRetry: ICO_ROUND_SETTLE_MAX_RETRY}
+}
+
+func validateCaps(input *ICORound) error {
+	caps := make([]decimal.Decimal, 2)
+	for i, value := range []string{input.SoftCap, input.HardCap} {
+		if len(value) == 0 {
+			continue
+		}
+		capValue, err := decimal.NewFromString(value)
+		if err != nil || !capValue.IsPositive() {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+		caps[i] = capValue
+	}
+	if len(input.SoftCap) > 0 && len(input.HardCap) > 0 && caps[1].LessThan(caps[0]) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
+
+// checkHardCaps refuses with an *ICOLimitError the histories of a purchase
+// that would raise a round past its hard cap. GetRoundRaised sees the
+// purchases committed before, one in the same sub-round waits for the row it
+// updated until they commit.
+func (uc *ICOUsecase) checkHardCaps(ctx context.Context, histories []ICOHistory) error {
+	var roundIds []int32
+	raising := map[int32]decimal.Decimal{}
+	for _, h := range histories {
+		if _, ok := raising[h.RoundId]; !ok {
+			roundIds = append(roundIds, h.RoundId)
+		}
+		raising[h.RoundId] = raising[h.RoundId].Add(decimal.RequireFromString(h.Price).Mul(decimal.RequireFromString(h.NumToken)))
+	}
+
+	for _, roundId := range roundIds {
+		round, err := uc.repo.GetRoundByRoundId(ctx, roundId)
+		if err != nil {
+			return err
+		}
+		if len(round.HardCap) == 0 {
+			continue
+		}
+		raised, err := uc.repo.GetRoundRaised(ctx, roundId)
+		if err != nil {
+			return err
+		}
+		room := decimal.RequireFromString(round.HardCap).Sub(decimal.RequireFromString(raised))
+		if raising[roundId].Round(ICO_CAP_PLACES).GreaterThan(room.Round(ICO_CAP_PLACES)) {
+			return &ICOLimitError{Reason: constant.ERROR_ICO_ABOVE_HARD_CAP, Limit: round.HardCap, Remaining: decimal.Max(room, decimal.Zero).String()}
+		}
+	}
+	return nil
+}
+
+// enforceHardCap closes the rounds of histories that reached their hard cap:
+// the round is marked ROUND_CAPPED, which stops its sales, and its running
+// sub-round ends now, the end-round task closes the ones left. checkHardCaps
+// keeps a purchase from raising a round past its cap.
+func (uc *ICOUsecase) enforceHardCap(ctx context.Context, histories []ICOHistory) error {
+	seen := map[int32]bool{}
+	for _, h := range histories {
+		if seen[h.RoundId] {
+			continue
+		}
+		seen[h.RoundId] = true
+		round, err := uc.repo.GetRoundByRoundId(ctx, h.RoundId)
+		if err != nil {
+			return err
+		}
+		if len(round.HardCap) == 0 || round.EndedAt != nil || len(round.Status) > 0 {
+			continue
+		}
+		raised, err := uc.repo.GetRoundRaised(ctx, h.RoundId)
+		if err != nil {
+			return err
+		}
+		if decimal.RequireFromString(raised).Round(ICO_CAP_PLACES).LessThan(decimal.RequireFromString(round.HardCap)) {
+			continue
+		}
+
+		if err := uc.repo.SetRoundStatus(ctx, h.RoundId, ROUND_CAPPED); err != nil {
+			return err
+		}
+		current, err := uc.repo.GetCurrentSubRound(ctx)
+		if err != nil {
+			return err
+		}
+		if current.RoundId == h.RoundId && !current.IsEnded {
+			if err := uc.repo.SetSubRoundEnd(ctx, current.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+		uc.log.Infof("Round %d reached its hard cap of %s, %s raised", h.RoundId, round.HardCap, raised)
+	}
+	return nil
+}
+
+// reopenCapped sells again in the rounds of histories that a refund took back
+// under their hard cap, unless they ended. The sub-round enforceHardCap ended
+// still closes, the next one sells.
+func (uc *ICORefundUsecase) reopenCapped(ctx context.Context, histories []*ICOHistory) error {
+	seen := map[int32]bool{}
+	for _, h := range histories {
+		if seen[h.RoundId] {
+			continue
+		}
+		seen[h.RoundId] = true
+		round, err := uc.icoRepo.GetRoundByRoundId(ctx, h.RoundId)
+		if err != nil {
+			return err
+		}
+		if round.Status != ROUND_CAPPED || round.EndedAt != nil {
+			continue
+		}
+		raised, err := uc.icoRepo.GetRoundRaised(ctx, h.RoundId)
+		if err != nil {
+			return err
+		}
+		if !decimal.RequireFromString(raised).Round(ICO_CAP_PLACES).LessThan(decimal.RequireFromString(round.HardCap)) {
+			continue
+		}
+		if err := uc.icoRepo.SetRoundStatus(ctx, h.RoundId, ""); err != nil {
+			return err
+		}
+		uc.log.Infof("Round %d is back under its hard cap of %s, %s raised", h.RoundId, round.HardCap, raised)
+	}
+	return nil
+}
+
+// SettleRound settles round roundId once it ended. Below its soft cap it is
+// marked ROUND_FAILED and the ICO purchases made in it are refunded, it is
+// ROUND_ENDED otherwise, a capped round too: a refund after it ended may have
+// taken it back under the soft cap. The settlement of a failed round can run
+// again, it refunds the purchases left.
+func (uc *ICORefundUsecase) SettleRound(ctx context.Context, roundId int32) error {
+	round, err := uc.icoRepo.GetRoundByRoundId(ctx, roundId)
+	if err != nil {
+		uc.log.Error("SettleRound ", err)
+		return err
+	}
+	if round.EndedAt == nil {
+		return nil
+	}
+
+	if len(round.Status) == 0 || round.Status == ROUND_CAPPED {
+		round.Status = ROUND_ENDED
+		if len(round.SoftCap) > 0 {
+			raised, err := uc.icoRepo.GetRoundRaised(ctx, roundId)
+			if err != nil {
+				uc.log.Error("SettleRound ", err)
+				return err
+			}
+			if decimal.RequireFromString(raised).LessThan(decimal.RequireFromString(round.SoftCap)) {
+				round.Status = ROUND_FAILED
+				uc.log.Infof("Round %d failed, %s raised under its soft cap of %s", roundId, raised, round.SoftCap)
+			}
+		}
+		if err := uc.icoRepo.SetRoundStatus(ctx, roundId, round.Status); err != nil {
+			uc.log.Error("SettleRound ", err)
+			return err
+		}
+	}
+	if round.Status != ROUND_FAILED {
+		return nil
+	}
+	return uc.refundRound(ctx, roundId)
+}
+
+// refundRound refunds the payments that bought in round roundId, in full when
+// they bought in the next round too. The payments of buyers who no longer hold
+// the tokens are recorded as outstanding refunds, any other failure is
+// returned once every payment was tried.
+func (uc *ICORefundUsecase) refundRound(ctx context.Context, roundId int32) error {
+	histories, err := uc.repo.GetRoundHistories(ctx, roundId)
+	if err != nil {
+		uc.log.Error("SettleRound ", err)
+		return err
+	}
+	buyers, refunded := map[string]string{}, map[string]bool{}
+	bought := map[string]decimal.Decimal{}
+	payments := []string{}
+	for _, h := range histories {
+		switch {
+		case h.Type == ICO_REFUND:
+			refunded[h.SourceId] = true
+		case h.Type != ICO:
+		case len(h.SourceId) == 0:
+			uc.log.Warnf("SettleRound: purchase %s of round %d has no payment, it is not refunded", h.ID, roundId)
+		default:
+			if len(buyers[h.SourceId]) == 0 {
+				buyers[h.SourceId] = h.UserId
+				payments = append(payments, h.SourceId)
+			}
+			bought[h.SourceId] = bought[h.SourceId].Add(decimal.RequireFromString(h.NumToken))
+		}
+	}
+
+	failed := 0
+	for _, sourceId := range payments {
+		if refunded[sourceId] {
+			continue
+		}
+		_, err := uc.refund(ctx, buyers[sourceId], sourceId, 0)
+		if err == nil || err.Error() == constant.ERROR_ALREADY_REFUNDED {
+			continue
+		}
+		if err.Error() == constant.ERROR_BALANCE_NOT_ENOUGH {
+			uc.log.Warnf("SettleRound: %s no longer holds the tokens of %s, its refund is outstanding", buyers[sourceId], sourceId)
+			outstanding := &ICOOutstandingRefund{RoundId: roundId, UserId: buyers[sourceId], SourceId: sourceId, NumToken: bought[sourceId].String()}
+			if err := uc.repo.SaveOutstandingRefund(ctx, outstanding); err != nil {
+				uc.log.Errorf("SettleRound: outstanding refund of %s not recorded: %v", sourceId, err)
+				failed++
+			}
+			continue
+		}
+		uc.log.Errorf("SettleRound: %s of round %d not refunded: %v", sourceId, roundId, err)
+		failed++
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d payments of round %d not refunded", failed, roundId)
+	}
+	return nil
+}
+
+// GetOutstandingRefunds returns the payments of failed rounds the settlement
+// could not refund, of round roundId or of every round when 0.
+func (uc *ICORefundUsecase) GetOutstandingRefunds(ctx context.Context, roundId int32) ([]*ICOOutstandingRefund, error) {
+	refunds, err := uc.repo.GetOutstandingRefunds(ctx, roundId)
+	if err != nil {
+		uc.log.Error("GetOutstandingRefunds ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	return refunds, nil
+}
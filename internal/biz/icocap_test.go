@@ -0,0 +1,344 @@
+package biz_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type queue struct{}
+
+func (queue) Enqueue(ctx context.Context, task *biz.Task) error { return nil }
+func (queue) Execute(ctx context.Context, task *biz.Task) error { return nil }
+func (queue) Start(ctx context.Context) error                   { return nil }
+func (queue) Stop(ctx context.Context) error                    { return nil }
+
+type publisher struct{}
+
+func (publisher) Publish(ctx context.Context, event *biz.TransactionEvent) {}
+
+// sale is the ICO over a dev store, purchases refundable for an hour.
+type sale struct {
+	ir       biz.ICORepo
+	wr       biz.UserWalletRepo
+	refunds  biz.ICORefundRepo
+	ico      *biz.ICOUsecase
+	referral *biz.ReferralUsecase
+	trans    *biz.WalletTransactionUseCase
+	refund   *biz.ICORefundUsecase
+	runner   *biz.QueueRunner
+	// supply is the IND the store was seeded with
+	invariant *biz.InvariantUsecase
+	supply    map[string]string
+}
+
+func newSale(t *testing.T, c *conf.Data) *sale {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Refund = &conf.Refund{Window: durationpb.New(time.Hour)}
+	rr, err := memrepo.NewReferralRepo(c, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &sale{ir: memrepo.NewIcoRepo(st), wr: memrepo.NewWalletRepo(st), refunds: memrepo.NewICORefundRepo(c, st)}
+	cp, tr, lock := memrepo.NewIcoCouponRepo(st), memrepo.NewTransactionRepo(st), memrepo.NewLockRepo(&conf.Data{})
+	s.ico = biz.NewICOUseCase(s.ir, cp, memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	s.referral = biz.NewReferralUsecase(rr, s.wr, tr, publisher{})
+	s.trans = biz.NewWalletTransactionUseCase(tr, s.wr, s.ir, memrepo.NewCurrencyRepo(st), cp, queue{}, publisher{}, s.ico, s.referral, lock)
+	s.refund = biz.NewICORefundUsecase(s.refunds, s.ir, s.wr, tr, cp, rr, s.ico, lock, publisher{})
+	s.runner = biz.NewQueueRunner(s.ir, s.wr, tr, s.ico, lock, publisher{})
+
+	s.invariant = biz.NewInvariantUsecase(memrepo.NewInvariantRepo(st))
+	supplies, err := memrepo.NewInvariantRepo(st).GetSupplies(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.supply = map[string]string{}
+	for _, supply := range supplies {
+		if supply.Symbol == constant.TokenSymbolIND {
+			s.supply[supply.Symbol] = supply.Total
+		}
+	}
+	return s
+}
+
+func (s *sale) setCaps(t *testing.T, roundId int32, softCap, hardCap string) {
+	t.Helper()
+	ctx := context.Background()
+	round, err := s.ir.GetRoundByRoundId(ctx, roundId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	round.SoftCap, round.HardCap = softCap, hardCap
+	if err := s.ir.SaveRound(ctx, round); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// endRound closes the sub-rounds of the running round until the next one runs.
+func (s *sale) endRound(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	current, err := s.ir.GetCurrentSubRound(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for roundId := current.RoundId; current.RoundId == roundId; current, err = s.ir.GetCurrentSubRound(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.ir.SetSubRoundEnd(ctx, current.ID, time.Now().Add(-time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.runner.Execute(ctx, biz.NewEndRoundTask(current)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func (s *sale) balance(t *testing.T, userId, symbol, walletType string) string {
+	t.Helper()
+	wallets, err := s.wr.GetWalletByUserId(context.Background(), userId, symbol, walletType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallets) == 0 {
+		return "0"
+	}
+	return wallets[0].Balance
+}
+
+func TestSettleRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		softCap string
+		// the buyers who spent their tokens before the round ended
+		spent []string
+		// a buyer who refunded their purchase within the window
+		refunded    string
+		wantStatus  string
+		wantHeld    []string
+		outstanding []string
+	}{
+		{name: "no soft cap", wantStatus: biz.ROUND_ENDED, wantHeld: []string{"u1", "u2", "u3"}},
+		{name: "above the soft cap", softCap: "1", wantStatus: biz.ROUND_ENDED, wantHeld: []string{"u1", "u2", "u3"}},
+		{name: "below the soft cap", softCap: "1000000", wantStatus: biz.ROUND_FAILED},
+		{name: "a buyer spent the tokens", softCap: "1000000", spent: []string{"u2"}, wantStatus: biz.ROUND_FAILED, outstanding: []string{"p2"}},
+		{name: "a payment refunded before", softCap: "1000000", refunded: "u3", wantStatus: biz.ROUND_FAILED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{})
+			s.setCaps(t, 1, tt.softCap, "")
+			for _, buyer := range []string{"u1", "u2", "u3"} {
+				if err := s.trans.BuyICO(ctx, buyer, "100", "USDT", "p"+buyer[1:], ""); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, buyer := range tt.spent {
+				held := s.balance(t, buyer, constant.TokenSymbolIND, constant.WALLET_TYPE_USER)
+				if _, err := s.wr.DecreaseBalance(ctx, buyer, constant.TokenSymbolIND, held, constant.WALLET_TYPE_USER); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if len(tt.refunded) > 0 {
+				if _, err := s.refund.RefundICOPurchase(ctx, tt.refunded, "p"+tt.refunded[1:]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s.endRound(t)
+
+			// a settlement runs again on a retry
+			for i := 0; i < 2; i++ {
+				if err := s.refund.SettleRound(ctx, 1); err != nil {
+					t.Fatal(err)
+				}
+			}
+			round, err := s.ir.GetRoundByRoundId(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if round.State() != tt.wantStatus {
+				t.Errorf("State = %s, want %s", round.State(), tt.wantStatus)
+			}
+			held := map[string]bool{}
+			for _, buyer := range tt.wantHeld {
+				held[buyer] = true
+			}
+			for _, buyer := range []string{"u1", "u2", "u3"} {
+				balance := decimal.RequireFromString(s.balance(t, buyer, constant.TokenSymbolIND, constant.WALLET_TYPE_USER))
+				if balance.IsPositive() != held[buyer] {
+					t.Errorf("%s holds %s, want tokens %v", buyer, balance, held[buyer])
+				}
+			}
+
+			outstanding, err := s.refund.GetOutstandingRefunds(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(outstanding) != len(tt.outstanding) {
+				t.Fatalf("%d outstanding refunds, want %v", len(outstanding), tt.outstanding)
+			}
+			for i, o := range outstanding {
+				if o.SourceId != tt.outstanding[i] || !decimal.RequireFromString(o.NumToken).IsPositive() {
+					t.Errorf("outstanding refund %+v, want %s", o, tt.outstanding[i])
+				}
+			}
+		})
+	}
+}
+
+// An outstanding refund is cleared by the settlement that gets it through.
+func TestSettleRoundClearsOutstanding(t *testing.T) {
+	ctx := context.Background()
+	s := newSale(t, &conf.Data{})
+	s.setCaps(t, 1, "1000000", "")
+	if err := s.trans.BuyICO(ctx, "u1", "100", "USDT", "p1", ""); err != nil {
+		t.Fatal(err)
+	}
+	held := s.balance(t, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER)
+	if _, err := s.wr.DecreaseBalance(ctx, "u1", constant.TokenSymbolIND, held, constant.WALLET_TYPE_USER); err != nil {
+		t.Fatal(err)
+	}
+	s.endRound(t)
+	if err := s.refund.SettleRound(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if outstanding, _ := s.refund.GetOutstandingRefunds(ctx, 0); len(outstanding) != 1 {
+		t.Fatalf("%d outstanding refunds, want 1", len(outstanding))
+	}
+
+	if _, err := s.wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, held, constant.WALLET_TYPE_USER); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.refund.SettleRound(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if outstanding, _ := s.refund.GetOutstandingRefunds(ctx, 0); len(outstanding) != 0 {
+		t.Errorf("%d outstanding refunds, want none", len(outstanding))
+	}
+	if balance := s.balance(t, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER); !decimal.RequireFromString(balance).IsZero() {
+		t.Errorf("u1 holds %s, want the tokens refunded", balance)
+	}
+}
+
+func TestHardCap(t *testing.T) {
+	tests := []struct {
+		name string
+		// USDT paid in turn, the round raises in USDT at the dev rates
+		payments []string
+		want     []string
+		// whether the last payment is refunded
+		refundLast bool
+		wantState  string
+	}{
+		{name: "under the cap", payments: []string{"10", "10"}, want: []string{"", ""}, wantState: biz.ROUND_OPEN},
+		{name: "past the cap", payments: []string{"10", "25"}, want: []string{"", constant.ERROR_ICO_ABOVE_HARD_CAP}, wantState: biz.ROUND_OPEN},
+		{name: "up to the cap", payments: []string{"10", "20"}, want: []string{"", ""}, wantState: biz.ROUND_CAPPED},
+		{name: "after the cap", payments: []string{"30", "1"}, want: []string{"", constant.ERROR_ROUND_CLOSED}, wantState: biz.ROUND_CAPPED},
+		{name: "a refund under the cap reopens", payments: []string{"10", "20"}, want: []string{"", ""}, refundLast: true, wantState: biz.ROUND_OPEN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{})
+			s.setCaps(t, 1, "", "30")
+			var last string
+			for i, amount := range tt.payments {
+				last = "p" + string(rune('1'+i))
+				err := s.trans.BuyICO(ctx, "u1", amount, "USDT", last, "")
+				if got := limitReason(err); got != tt.want[i] {
+					t.Fatalf("purchase %d = %q, want %q", i+1, got, tt.want[i])
+				}
+				var limitErr *biz.ICOLimitError
+				if errors.As(err, &limitErr) && !near(decimal.RequireFromString(limitErr.Remaining), decimal.NewFromInt(20)) {
+					t.Errorf("Remaining = %s, want 20", limitErr.Remaining)
+				}
+			}
+			if tt.refundLast {
+				if _, err := s.refund.RefundICOPurchase(ctx, "u1", last); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			round, err := s.ir.GetRoundByRoundId(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if round.State() != tt.wantState {
+				t.Errorf("State = %s, want %s", round.State(), tt.wantState)
+			}
+			raised, err := s.ico.GetRoundRaised(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decimal.RequireFromString(raised).Round(biz.ICO_CAP_PLACES).GreaterThan(decimal.NewFromInt(30)) {
+				t.Errorf("raised %s, past the hard cap", raised)
+			}
+		})
+	}
+}
+
+// A capped round settles on what it kept once it ended, the refunds made after
+// take it under its soft cap.
+func TestSettleCappedRound(t *testing.T) {
+	tests := []struct {
+		name string
+		// the payments refunded after the round ended
+		refunded  []string
+		wantState string
+	}{
+		{name: "nothing refunded", wantState: biz.ROUND_ENDED},
+		{name: "refunded above the soft cap", refunded: []string{"p1"}, wantState: biz.ROUND_ENDED},
+		{name: "refunded under the soft cap", refunded: []string{"p2"}, wantState: biz.ROUND_FAILED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{})
+			s.setCaps(t, 1, "15", "30")
+			for i, amount := range []string{"10", "20"} {
+				if err := s.trans.BuyICO(ctx, "u1", amount, "USDT", fmt.Sprintf("p%d", i+1), ""); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if round, _ := s.ir.GetRoundByRoundId(ctx, 1); round.State() != biz.ROUND_CAPPED {
+				t.Fatalf("State = %s, want %s", round.State(), biz.ROUND_CAPPED)
+			}
+			s.endRound(t)
+			for _, sourceId := range tt.refunded {
+				if _, err := s.refund.RefundICOPurchase(ctx, "u1", sourceId); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := s.refund.SettleRound(ctx, 1); err != nil {
+				t.Fatal(err)
+			}
+			round, err := s.ir.GetRoundByRoundId(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if round.State() != tt.wantState {
+				t.Errorf("State = %s, want %s", round.State(), tt.wantState)
+			}
+			held := decimal.RequireFromString(s.balance(t, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER))
+			if held.IsPositive() != (tt.wantState == biz.ROUND_ENDED) {
+				t.Errorf("u1 holds %s tokens in a %s round", held, round.State())
+			}
+		})
+	}
+}
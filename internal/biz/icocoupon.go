@@ -0,0 +1,305 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/util"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// the letters and digits but 0, 1, I and O, easily mistaken
+	COUPON_ALPHABET = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	COUPON_LENGTH   = 8
+	COUPON_EXPORT   = "csv"
+
+	// upper bound of coupons generated at once
+	COUPON_GENERATE_LIMIT = 10000
+	// coupons added by one insert
+	couponBatchSize = 500
+	// rounds in a row that add no coupon before giving up
+	couponGenerateRetries = 10
+)
+
+// CouponBatch is Count coupons sharing the terms of Coupon, their codes are
+// Prefix followed by Length characters of Alphabet.
+type CouponBatch struct {
+	Coupon   IcoCoupon
+	Prefix   string
+	Length   int
+	Alphabet string
+	Count    int
+}
+
+// GenerateICOCoupons adds the coupons of batch under random codes and returns
+// them. A code taken, by a coupon added meanwhile too, is drawn again; when
+// none is found it fails with ERROR_COUPON_CODES_EXHAUSTED and the codes
+// added so far.
+func (uc *ICOUsecase) GenerateICOCoupons(ctx context.Context, batch *CouponBatch) ([]string, error) {
+	if err := validateCouponBatch(batch); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, batch.Count)
+	seen := map[string]bool{}
+	for misses := 0; len(codes) < batch.Count; {
+		if misses >= couponGenerateRetries {
+			return codes, errors.New(constant.ERROR_COUPON_CODES_EXHAUSTED)
+		}
+
+		size := min(couponBatchSize, batch.Count-len(codes))
+		candidates := make([]string, 0, size)
+		for i := 0; len(candidates) < size && i < 10*size; i++ {
+			code := batch.Prefix + util.RandomString(batch.Alphabet, batch.Length)
+			if !seen[code] {
+				seen[code] = true
+				candidates = append(candidates, code)
+			}
+		}
+		taken, err := uc.icoCoupon.TakenCoupons(ctx, candidates)
+		if err != nil {
+			uc.log.Error("GenerateICOCoupons ", err)
+			return codes, errors.New(constant.ERROR_INTERNAL)
+		}
+
+		coupons := make([]*IcoCoupon, 0, len(candidates))
+		for _, code := range candidates {
+			if !containsFold(taken, code) {
+				coupon := batch.Coupon
+				coupon.Coupon = code
+				coupons = append(coupons, &coupon)
+			}
+		}
+		if len(coupons) == 0 {
+			misses++
+			continue
+		}
+		if err := uc.icoCoupon.AddCoupons(ctx, coupons); err != nil {
+			if err.Error() == constant.ERROR_COUPON_EXISTS {
+				misses++
+				continue
+			}
+			uc.log.Error("GenerateICOCoupons ", err)
+			return codes, errors.New(constant.ERROR_INTERNAL)
+		}
+		misses = 0
+		for _, v := range coupons {
+			codes = append(codes, v.Coupon)
+		}
+	}
+	return codes, nil
+}
+
+// ExportICOCoupons renders the coupons of batch under codes as CSV.
+func (uc *ICOUsecase) ExportICOCoupons(batch *CouponBatch, codes []string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Write([]string{"coupon", "user_id", "reward", "cashback", "starts_at", "ends_at", "max_uses", "single_use", "min_purchase"})
+	c := batch.Coupon
+	startsAt, endsAt := "", ""
+	if !c.StartsAt.IsZero() {
+		startsAt = c.StartsAt.Format(time.RFC3339)
+	}
+	if !c.EndsAt.IsZero() {
+		endsAt = c.EndsAt.Format(time.RFC3339)
+	}
+	for _, code := range codes {
+		w.Write([]string{code, c.UserID, c.Reward, c.Cashback, startsAt, endsAt, strconv.Itoa(c.MaxUses), strconv.FormatBool(c.SingleUse), c.MinPurchase})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// UpdateICOCoupon replaces the terms of the coupon input.Coupon, deleted or
+// not. Its owner and what it was used stay.
+func (uc *ICOUsecase) UpdateICOCoupon(ctx context.Context, input *IcoCoupon) (*IcoCoupon, error) {
+	coupon, err := uc.findCoupon(ctx, input.Coupon)
+	if err != nil {
+		return nil, err
+	}
+	coupon.Reward, coupon.Cashback = input.Reward, input.Cashback
+	coupon.StartsAt, coupon.EndsAt = input.StartsAt, input.EndsAt
+	coupon.MaxUses, coupon.SingleUse, coupon.MinPurchase = input.MaxUses, input.SingleUse, input.MinPurchase
+	if err := validateCoupon(coupon); err != nil {
+		return nil, err
+	}
+
+	if err := uc.icoCoupon.UpdateCoupon(ctx, coupon); err != nil {
+		uc.log.Error("UpdateICOCoupon ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	return uc.findCoupon(ctx, coupon.Coupon)
+}
+
+// DeleteICOCoupon soft deletes the coupon, purchases stop applying it and
+// its code stays taken.
+func (uc *ICOUsecase) DeleteICOCoupon(ctx context.Context, code string) (*IcoCoupon, error) {
+	coupon, err := uc.findCoupon(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if coupon.DeletedAt != nil {
+		return coupon, nil
+	}
+
+	now := time.Now()
+	if err := uc.icoCoupon.SetCouponDeletedAt(ctx, coupon.ID, &now); err != nil {
+		uc.log.Error("DeleteICOCoupon ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	coupon.DeletedAt = &now
+	return coupon, nil
+}
+
+// RestoreICOCoupon undoes DeleteICOCoupon.
+func (uc *ICOUsecase) RestoreICOCoupon(ctx context.Context, code string) (*IcoCoupon, error) {
+	coupon, err := uc.findCoupon(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if coupon.DeletedAt == nil {
+		return coupon, nil
+	}
+
+	if err := uc.icoCoupon.SetCouponDeletedAt(ctx, coupon.ID, nil); err != nil {
+		uc.log.Error("RestoreICOCoupon ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	coupon.DeletedAt = nil
+	return coupon, nil
+}
+
+// ListICOCoupons returns the coupons userId owns, newest first.
+func (uc *ICOUsecase) ListICOCoupons(ctx context.Context, userId string, withDeleted bool) ([]*IcoCoupon, error) {
+	if len(userId) == 0 {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	coupons, err := uc.icoCoupon.ListCoupons(ctx, userId, withDeleted)
+	if err != nil {
+		uc.log.Error("ListICOCoupons ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	return coupons, nil
+}
+
+// CheckCoupon refuses a purchase of amount symbol the coupon can't apply to
+// now. The redemptions of userId are only checked when it is set.
+func (uc *ICOUsecase) CheckCoupon(ctx context.Context, coupon *IcoCoupon, userId, amount, symbol string) error {
+	now := time.Now()
+	if !coupon.StartsAt.IsZero() && now.Before(coupon.StartsAt) {
+		return errors.New(constant.ERROR_COUPON_NOT_STARTED)
+	}
+	if !coupon.EndsAt.IsZero() && !now.Before(coupon.EndsAt) {
+		return errors.New(constant.ERROR_COUPON_EXPIRED)
+	}
+	if coupon.MaxUses > 0 && coupon.Used >= coupon.MaxUses {
+		return errors.New(constant.ERROR_COUPON_USED_UP)
+	}
+	if coupon.SingleUse && len(userId) > 0 {
+		count, err := uc.icoCoupon.CountRedemptions(ctx, coupon.ID, userId)
+		if err != nil {
+			uc.log.Error("CheckCoupon ", err)
+			return errors.New(constant.ERROR_INTERNAL)
+		}
+		if count > 0 {
+			return errors.New(constant.ERROR_COUPON_ALREADY_USED)
+		}
+	}
+	if len(coupon.MinPurchase) > 0 {
+		currency, err := uc.GetRate(ctx, fmt.Sprintf("%s_%s", symbol, constant.TokenSymbolIND))
+		if err != nil {
+			uc.log.Error("CheckCoupon ", err)
+			return errors.New(constant.ERROR_INTERNAL)
+		}
+		numToken := decimal.RequireFromString(amount).Div(decimal.RequireFromString(currency.Rate))
+		if numToken.LessThan(decimal.RequireFromString(coupon.MinPurchase)) {
+			return errors.New(constant.ERROR_COUPON_BELOW_MIN_PURCHASE)
+		}
+	}
+	return nil
+}
+
+func (uc *ICOUsecase) findCoupon(ctx context.Context, code string) (*IcoCoupon, error) {
+	coupon, err := uc.icoCoupon.FindCoupon(ctx, strings.ToUpper(code))
+	if err != nil {
+		uc.log.Error("findCoupon ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	if coupon == nil {
+		return nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	return coupon, nil
+}
+
+// validateCouponBatch checks batch and fills its defaults. It refuses a count
+// over a tenth of the codes there are, which would be slow to find.
+func validateCouponBatch(batch *CouponBatch) error {
+	batch.Prefix = strings.ToUpper(strings.TrimSpace(batch.Prefix))
+	batch.Alphabet = strings.ToUpper(batch.Alphabet)
+	if len(batch.Alphabet) == 0 {
+		batch.Alphabet = COUPON_ALPHABET
+	}
+	if batch.Length == 0 {
+		batch.Length = COUPON_LENGTH
+	}
+	if len(batch.Coupon.UserID) == 0 || batch.Count <= 0 || batch.Count > COUPON_GENERATE_LIMIT || batch.Length < 0 || batch.Length > 32 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if !isCouponCode(batch.Prefix) || !isCouponCode(batch.Alphabet) || len(batch.Alphabet) < 2 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	for i := range batch.Alphabet {
+		if strings.IndexByte(batch.Alphabet[i+1:], batch.Alphabet[i]) >= 0 {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+	}
+	if math.Pow(float64(len(batch.Alphabet)), float64(batch.Length)) < 10*float64(batch.Count) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return validateCoupon(&batch.Coupon)
+}
+
+func isCouponCode(s string) bool {
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateCoupon(coupon *IcoCoupon) error {
+	for _, rate := range []string{coupon.Reward, coupon.Cashback} {
+		if value, err := decimal.NewFromString(rate); err != nil || value.IsNegative() {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+	}
+	if value, err := decimal.NewFromString(coupon.MinPurchase); len(coupon.MinPurchase) > 0 && (err != nil || value.IsNegative()) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if coupon.MaxUses < 0 {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if !coupon.StartsAt.IsZero() && !coupon.EndsAt.IsZero() && !coupon.StartsAt.Before(coupon.EndsAt) {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+func newCoupons(t *testing.T) (*biz.ICOUsecase, biz.IcoCouponRepo) {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := memrepo.NewIcoCouponRepo(st)
+	return biz.NewICOUseCase(memrepo.NewIcoRepo(st), cp, memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore())), cp
+}
+
+func TestCouponLifecycle(t *testing.T) {
+	ctx := context.Background()
+	icoUc, _ := newCoupons(t)
+
+	if err := icoUc.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: "kol1", Coupon: " spring ", Reward: "0.02", Cashback: "0"}); err != nil {
+		t.Fatal(err)
+	}
+	wantErr(t, "the code again", icoUc.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: "kol2", Coupon: "SPRING", Reward: "0", Cashback: "0"}), constant.ERROR_COUPON_EXISTS)
+	wantErr(t, "a negative reward", icoUc.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: "kol1", Coupon: "AUTUMN", Reward: "-1", Cashback: "0"}), constant.ERROR_BAD_REQUEST)
+
+	updated, err := icoUc.UpdateICOCoupon(ctx, &biz.IcoCoupon{UserID: "someone else", Coupon: "spring", Reward: "0.03", Cashback: "0.01", MaxUses: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.UserID != "kol1" || updated.Reward != "0.03" || updated.MaxUses != 5 {
+		t.Errorf("updated to %+v", updated)
+	}
+	_, err = icoUc.UpdateICOCoupon(ctx, &biz.IcoCoupon{Coupon: "WINTER", Reward: "0", Cashback: "0"})
+	wantErr(t, "updating a missing coupon", err, constant.ERROR_NOT_FOUND)
+	_, err = icoUc.UpdateICOCoupon(ctx, &biz.IcoCoupon{Coupon: "SPRING", Reward: "0", Cashback: "0", StartsAt: time.Now(), EndsAt: time.Now().Add(-time.Hour)})
+	wantErr(t, "ending before it starts", err, constant.ERROR_BAD_REQUEST)
+
+	deleted, err := icoUc.DeleteICOCoupon(ctx, "SPRING")
+	if err != nil || deleted.DeletedAt == nil {
+		t.Fatalf("delete: %+v, %v", deleted, err)
+	}
+	if again, err := icoUc.DeleteICOCoupon(ctx, "SPRING"); err != nil || !again.DeletedAt.Equal(*deleted.DeletedAt) {
+		t.Errorf("deleting twice: %v, %v", again.DeletedAt, err)
+	}
+	if coupon, err := icoUc.GetICOCoupon(ctx, "SPRING"); err != nil || coupon != nil {
+		t.Errorf("a deleted coupon applies: %+v, %v", coupon, err)
+	}
+	wantErr(t, "the code of a deleted coupon", icoUc.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: "kol2", Coupon: "spring", Reward: "0", Cashback: "0"}), constant.ERROR_COUPON_EXISTS)
+	if listed, _ := icoUc.ListICOCoupons(ctx, "kol1", false); len(listed) != 0 {
+		t.Errorf("%d coupons listed, the deleted one is hidden", len(listed))
+	}
+	if listed, _ := icoUc.ListICOCoupons(ctx, "kol1", true); len(listed) != 1 {
+		t.Errorf("%d coupons listed with the deleted ones, want 1", len(listed))
+	}
+
+	if _, err := icoUc.RestoreICOCoupon(ctx, "spring"); err != nil {
+		t.Fatal(err)
+	}
+	if coupon, _ := icoUc.GetICOCoupon(ctx, "spring"); coupon == nil || coupon.Reward != "0.03" {
+		t.Errorf("restored coupon %+v, want the updated terms", coupon)
+	}
+	_, err = icoUc.ListICOCoupons(ctx, "", true)
+	wantErr(t, "listing without an owner", err, constant.ERROR_BAD_REQUEST)
+}
+
+func TestCheckCoupon(t *testing.T) {
+	ctx := context.Background()
+	icoUc, cp := newCoupons(t)
+	used := &biz.IcoCoupon{UserID: "kol1", Coupon: "ONCE", Reward: "0", Cashback: "0", SingleUse: true}
+	if err := icoUc.AddICOCoupon(ctx, used); err != nil {
+		t.Fatal(err)
+	}
+	if err := cp.SaveRedemption(ctx, &biz.IcoCouponRedemption{CouponID: used.ID, Coupon: used.Coupon, UserID: "u1", SourceID: "p0", Amount: "10", Symbol: "USDT"}); err != nil {
+		t.Fatal(err)
+	}
+	// what 1000 tokens cost in USDT
+	thousand := usdt(t, icoUc, "1000")
+
+	hour := time.Hour
+	for name, tt := range map[string]struct {
+		coupon *biz.IcoCoupon
+		userId string
+		amount string
+		want   string
+	}{
+		"applies":            {coupon: &biz.IcoCoupon{StartsAt: time.Now().Add(-hour), EndsAt: time.Now().Add(hour)}, amount: "10"},
+		"not started":        {coupon: &biz.IcoCoupon{StartsAt: time.Now().Add(hour)}, amount: "10", want: constant.ERROR_COUPON_NOT_STARTED},
+		"expired":            {coupon: &biz.IcoCoupon{EndsAt: time.Now().Add(-hour)}, amount: "10", want: constant.ERROR_COUPON_EXPIRED},
+		"used up":            {coupon: &biz.IcoCoupon{MaxUses: 3, Used: 3}, amount: "10", want: constant.ERROR_COUPON_USED_UP},
+		"used once already":  {coupon: used, userId: "u1", amount: "10", want: constant.ERROR_COUPON_ALREADY_USED},
+		"once, by another":   {coupon: used, userId: "u2", amount: "10"},
+		"once, in a preview": {coupon: used, amount: "10"},
+		"below its minimum":  {coupon: &biz.IcoCoupon{MinPurchase: "1000.5"}, amount: thousand, want: constant.ERROR_COUPON_BELOW_MIN_PURCHASE},
+		"at its minimum":     {coupon: &biz.IcoCoupon{MinPurchase: "1000"}, amount: thousand},
+	} {
+		err := icoUc.CheckCoupon(ctx, tt.coupon, tt.userId, tt.amount, "USDT")
+		if got := limitReason(err); got != tt.want {
+			t.Errorf("%s: err %q, want %q", name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package biz_test
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+// racingCoupons adds the first code of the first batch added through it just
+// before that batch, like a concurrent GenerateICOCoupons would.
+type racingCoupons struct {
+	biz.IcoCouponRepo
+	raced string
+}
+
+func (r *racingCoupons) AddCoupons(ctx context.Context, coupons []*biz.IcoCoupon) error {
+	if len(r.raced) == 0 {
+		r.raced = coupons[0].Coupon
+		if err := r.IcoCouponRepo.AddCoupon(ctx, &biz.IcoCoupon{UserID: "rival", Coupon: r.raced, Reward: "0", Cashback: "0"}); err != nil {
+			return err
+		}
+	}
+	return r.IcoCouponRepo.AddCoupons(ctx, coupons)
+}
+
+func newGenerator(cp biz.IcoCouponRepo) *biz.ICOUsecase {
+	st := memrepo.NewStore()
+	return biz.NewICOUseCase(memrepo.NewIcoRepo(st), cp, memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(st))
+}
+
+func TestGenerateICOCoupons(t *testing.T) {
+	ctx := context.Background()
+	cp := &racingCoupons{IcoCouponRepo: memrepo.NewIcoCouponRepo(memrepo.NewStore())}
+	icoUc := newGenerator(cp)
+
+	batch := &biz.CouponBatch{Coupon: biz.IcoCoupon{UserID: "kol1", Reward: "0.01", Cashback: "0.02", MaxUses: 1}, Prefix: " fall", Count: 600}
+	codes, err := icoUc.GenerateICOCoupons(ctx, batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != batch.Count {
+		t.Fatalf("%d codes, want %d", len(codes), batch.Count)
+	}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		rest, ok := strings.CutPrefix(code, "FALL")
+		if !ok || len(rest) != biz.COUPON_LENGTH || strings.Trim(rest, biz.COUPON_ALPHABET) != "" {
+			t.Errorf("code %s is not FALL and %d characters of the alphabet", code, biz.COUPON_LENGTH)
+		}
+		if seen[code] || code == cp.raced {
+			t.Errorf("code %s given twice", code)
+		}
+		seen[code] = true
+	}
+	stored, _ := cp.ListCoupons(ctx, "kol1", false)
+	if len(stored) != batch.Count || stored[0].Cashback != "0.02" || stored[0].MaxUses != 1 {
+		t.Errorf("%d coupons stored, the first %+v", len(stored), stored[0])
+	}
+
+	data, err := icoUc.ExportICOCoupons(batch, codes[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[0][0] != "coupon" || rows[1][0] != codes[0] || rows[2][3] != "0.02" {
+		t.Errorf("exported %q", rows)
+	}
+}
+
+// A code space nearly taken runs out, with the codes found so far.
+func TestGenerateICOCouponsExhausted(t *testing.T) {
+	ctx := context.Background()
+	cp := memrepo.NewIcoCouponRepo(memrepo.NewStore())
+	icoUc := newGenerator(cp)
+	// the 32 codes of 5 characters of AB but BBBBA and BBBBB
+	for i := 0; i < 30; i++ {
+		code := strings.NewReplacer("0", "A", "1", "B").Replace(fmt.Sprintf("%05b", i))
+		if err := cp.AddCoupon(ctx, &biz.IcoCoupon{UserID: "kol1", Coupon: code, Reward: "0", Cashback: "0"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	codes, err := icoUc.GenerateICOCoupons(ctx, &biz.CouponBatch{Coupon: biz.IcoCoupon{UserID: "kol2", Reward: "0", Cashback: "0"}, Alphabet: "ab", Length: 5, Count: 3})
+	wantErr(t, "generating", err, constant.ERROR_COUPON_CODES_EXHAUSTED)
+	for _, code := range codes {
+		if code != "BBBBA" && code != "BBBBB" {
+			t.Errorf("code %s was taken", code)
+		}
+	}
+}
+
+func TestGenerateICOCouponsValidates(t *testing.T) {
+	icoUc := newGenerator(memrepo.NewIcoCouponRepo(memrepo.NewStore()))
+	terms := biz.IcoCoupon{UserID: "kol1", Reward: "0", Cashback: "0"}
+	for name, batch := range map[string]biz.CouponBatch{
+		"no owner":             {Coupon: biz.IcoCoupon{Reward: "0", Cashback: "0"}, Count: 1},
+		"no coupon":            {Coupon: terms},
+		"too many":             {Coupon: terms, Count: biz.COUPON_GENERATE_LIMIT + 1},
+		"a prefix with a dash": {Coupon: terms, Prefix: "A-", Count: 1},
+		"a letter twice":       {Coupon: terms, Alphabet: "ABA", Count: 1},
+		"a single letter":      {Coupon: terms, Alphabet: "A", Count: 1},
+		"too few codes":        {Coupon: terms, Alphabet: "AB", Length: 3, Count: 1},
+		"codes longer than 32": {Coupon: terms, Length: 33, Count: 1},
+		"terms not valid":      {Coupon: biz.IcoCoupon{UserID: "kol1", Reward: "x", Cashback: "0"}, Count: 1},
+	} {
+		codes, err := icoUc.GenerateICOCoupons(context.Background(), &batch)
+		if err == nil || err.Error() != constant.ERROR_BAD_REQUEST || len(codes) > 0 {
+			t.Errorf("%s: codes %v, err %v", name, codes, err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// ICOLimit bounds the purchases of a user in a round, in tokens. An empty
+// bound is no bound.
+type ICOLimit struct {
+	MinPurchase string `json:"min_purchase,omitempty"`
+	MaxPerTx    string `json:"max_per_tx,omitempty"`
+	// MaxPerUser bounds what a user buys in the round over all their purchases.
+	MaxPerUser string `json:"max_per_user,omitempty"`
+}
+
+// ICOLimits are the purchase limits of a round. Users of a tier listed in
+// Tiers get its limit instead of Base, when TiersOnly is set the others can't
+// buy at all.
+type ICOLimits struct {
+	Base      ICOLimit            `json:"base"`
+	Tiers     map[string]ICOLimit `json:"tiers,omitempty"`
+	TiersOnly bool                `json:"tiers_only,omitempty"`
+}
+
+func (l ICOLimits) IsZero() bool {
+	return l.Base == ICOLimit{} && len(l.Tiers) == 0 && !l.TiersOnly
+}
+
+// ICOLimitError tells why a purchase was refused. Error returns the reason,
+// one of the ERROR_ICO_* limit constants.
+type ICOLimitError struct {
+	Reason string
+	Tier   string
+	// Limit is the bound the purchase broke, Remaining what the user can
+	// still buy in the round under MaxPerUser. For ERROR_ICO_ABOVE_HARD_CAP
+	// they are the hard cap and what the round can still raise.
+	Limit     string
+	Remaining string
+}
+
+func (e *ICOLimitError) Error() string {
+	return e.Reason
+}
+
+// CheckPurchase refuses a purchase of amount symbol by userId that breaks the
+// limits of the running round with an *ICOLimitError.
+func (uc *ICOUsecase) CheckPurchase(ctx context.Context, userId, amount, symbol string) error {
+	current, err := uc.repo.GetCurrentSubRound(ctx)
+	if err != nil {
+		return err
+	}
+	round, err := uc.repo.GetRoundByRoundId(ctx, current.RoundId)
+	if err != nil {
+		return err
+	}
+	if round.Limits.IsZero() {
+		return nil
+	}
+
+	currency, err := uc.GetRate(ctx, fmt.Sprintf("%s_%s", symbol, constant.TokenSymbolIND))
+	if err != nil {
+		return err
+	}
+	numToken := decimal.RequireFromString(amount).Div(decimal.RequireFromString(currency.Rate))
+	return uc.checkLimits(ctx, userId, round, numToken, true)
+}
+
+// checkRoundLimits refuses with an *ICOLimitError the histories of a purchase
+// that break the limits of a round they buy in, a purchase running into the
+// next round is held to its limits too. The minimum is left to CheckPurchase,
+// the part bought in the next round may be below it.
+func (uc *ICOUsecase) checkRoundLimits(ctx context.Context, userId string, histories []ICOHistory) error {
+	var roundIds []int32
+	tokens := map[int32]decimal.Decimal{}
+	for _, h := range histories {
+		if _, ok := tokens[h.RoundId]; !ok {
+			roundIds = append(roundIds, h.RoundId)
+		}
+		tokens[h.RoundId] = tokens[h.RoundId].Add(decimal.RequireFromString(h.NumToken))
+	}
+
+	for _, roundId := range roundIds {
+		round, err := uc.repo.GetRoundByRoundId(ctx, roundId)
+		if err != nil {
+			return err
+		}
+		if err := uc.checkLimits(ctx, userId, round, tokens[roundId], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLimits refuses numToken bought by userId in round when it breaks the
+// limit of the user's tier, withMin checks the minimum purchase as well.
+func (uc *ICOUsecase) checkLimits(ctx context.Context, userId string, round *ICORound, numToken decimal.Decimal, withMin bool) error {
+	if round.Limits.IsZero() {
+		return nil
+	}
+
+	var err error
+	limit, tier := round.Limits.Base, ""
+	if len(round.Limits.Tiers) > 0 || round.Limits.TiersOnly {
+		if tier, err = uc.tierRepo.GetUserTier(ctx, userId); err != nil {
+			uc.log.Error("CheckPurchase ", err)
+			return err
+		}
+		if l, ok := round.Limits.Tiers[tier]; ok {
+			limit = l
+		} else if round.Limits.TiersOnly {
+			return &ICOLimitError{Reason: constant.ERROR_ICO_TIER_NOT_ALLOWED, Tier: tier}
+		}
+	}
+
+	if lower, ok := limitValue(limit.MinPurchase); withMin && ok && numToken.LessThan(lower) {
+		return &ICOLimitError{Reason: constant.ERROR_ICO_BELOW_MIN_PURCHASE, Tier: tier, Limit: limit.MinPurchase}
+	}
+	if upper, ok := limitValue(limit.MaxPerTx); ok && numToken.GreaterThan(upper) {
+		return &ICOLimitError{Reason: constant.ERROR_ICO_ABOVE_MAX_PER_TX, Tier: tier, Limit: limit.MaxPerTx}
+	}
+	if upper, ok := limitValue(limit.MaxPerUser); ok {
+		bought, err := uc.repo.GetUserBoughtToken(ctx, userId, round.RoundId)
+		if err != nil {
+			return err
+		}
+		remaining := upper.Sub(decimal.RequireFromString(bought))
+		if numToken.GreaterThan(remaining) {
+			return &ICOLimitError{Reason: constant.ERROR_ICO_ABOVE_MAX_PER_USER, Tier: tier, Limit: limit.MaxPerUser, Remaining: decimal.Max(remaining, decimal.Zero).String()}
+		}
+	}
+	return nil
+}
+
+func limitValue(bound string) (decimal.Decimal, bool) {
+	if len(bound) == 0 {
+		return decimal.Zero, false
+	}
+	return decimal.RequireFromString(bound), true
+}
+
+func validateLimits(limits ICOLimits) error {
+	if err := validateLimit(limits.Base); err != nil {
+		return err
+	}
+	for _, l := range limits.Tiers {
+		if err := validateLimit(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLimit(limit ICOLimit) error {
+	for _, bound := range []string{limit.MinPurchase, limit.MaxPerTx, limit.MaxPerUser} {
+		if value, err := decimal.NewFromString(bound); len(bound) > 0 && (err != nil || value.IsNegative()) {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+	}
+	lower, ok := limitValue(limit.MinPurchase)
+	if !ok {
+		return nil
+	}
+	for _, bound := range []string{limit.MaxPerTx, limit.MaxPerUser} {
+		if upper, ok := limitValue(bound); ok && upper.LessThan(lower) {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+	}
+	return nil
+}
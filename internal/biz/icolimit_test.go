@@ -0,0 +1,162 @@
+package biz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"github.com/shopspring/decimal"
+)
+
+type tiers map[string]string
+
+func (t tiers) GetUserTier(ctx context.Context, userId string) (string, error) {
+	return t[userId], nil
+}
+
+// newICO returns the ICO use case over a dev store, with the limits of round 1.
+func newICO(t *testing.T, limits biz.ICOLimits) (*biz.ICOUsecase, biz.ICORepo) {
+	t.Helper()
+	ctx := context.Background()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ir := memrepo.NewIcoRepo(st)
+	round, err := ir.GetRoundByRoundId(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	round.Limits = limits
+	if err := ir.SaveRound(ctx, round); err != nil {
+		t.Fatal(err)
+	}
+	icoUc := biz.NewICOUseCase(ir, memrepo.NewIcoCouponRepo(st), memrepo.NewCurrencyRepo(st), tiers{"gold": "gold"}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	return icoUc, ir
+}
+
+// usdt is what numToken costs in USDT at the running rate.
+func usdt(t *testing.T, icoUc *biz.ICOUsecase, numToken string) string {
+	t.Helper()
+	rate, err := icoUc.GetRate(context.Background(), "USDT_IND")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decimal.RequireFromString(numToken).Mul(decimal.RequireFromString(rate.Rate)).String()
+}
+
+func limitReason(err error) string {
+	var limitErr *biz.ICOLimitError
+	if errors.As(err, &limitErr) {
+		return limitErr.Reason
+	}
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func TestCheckPurchase(t *testing.T) {
+	base := biz.ICOLimit{MinPurchase: "100", MaxPerTx: "1000", MaxPerUser: "1500"}
+	gold := biz.ICOLimit{MaxPerTx: "5000", MaxPerUser: "10000"}
+
+	tests := []struct {
+		name   string
+		limits biz.ICOLimits
+		userId string
+		// tokens the user bought in the round before
+		bought   string
+		numToken string
+		want     string
+		// the Remaining of ICO_ABOVE_MAX_PER_USER
+		remaining string
+	}{
+		{name: "no limits", userId: "u1", numToken: "1000000"},
+		{name: "within the limits", limits: biz.ICOLimits{Base: base}, userId: "u1", numToken: "500"},
+		{name: "below the minimum", limits: biz.ICOLimits{Base: base}, userId: "u1", numToken: "50", want: constant.ERROR_ICO_BELOW_MIN_PURCHASE},
+		{name: "above the max per purchase", limits: biz.ICOLimits{Base: base}, userId: "u1", numToken: "1200", want: constant.ERROR_ICO_ABOVE_MAX_PER_TX},
+		{name: "within the max per user", limits: biz.ICOLimits{Base: base}, userId: "u1", bought: "1000", numToken: "400"},
+		{name: "above the max per user", limits: biz.ICOLimits{Base: base}, userId: "u1", bought: "1000", numToken: "600",
+			want: constant.ERROR_ICO_ABOVE_MAX_PER_USER, remaining: "500"},
+		{name: "the tier limit replaces the base", limits: biz.ICOLimits{Base: base, Tiers: map[string]biz.ICOLimit{"gold": gold}}, userId: "gold", numToken: "3000"},
+		{name: "users without a tier get the base", limits: biz.ICOLimits{Base: base, Tiers: map[string]biz.ICOLimit{"gold": gold}}, userId: "u1", numToken: "3000",
+			want: constant.ERROR_ICO_ABOVE_MAX_PER_TX},
+		{name: "tiers only", limits: biz.ICOLimits{Tiers: map[string]biz.ICOLimit{"gold": gold}, TiersOnly: true}, userId: "u1", numToken: "500",
+			want: constant.ERROR_ICO_TIER_NOT_ALLOWED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			icoUc, _ := newICO(t, tt.limits)
+			if len(tt.bought) > 0 {
+				if _, err := icoUc.ICOHistories(ctx, tt.userId, usdt(t, icoUc, tt.bought), "USDT", "before", biz.ICO, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := icoUc.CheckPurchase(ctx, tt.userId, usdt(t, icoUc, tt.numToken), "USDT")
+			if got := limitReason(err); got != tt.want {
+				t.Fatalf("CheckPurchase = %q, want %q", got, tt.want)
+			}
+			var limitErr *biz.ICOLimitError
+			if len(tt.remaining) > 0 && errors.As(err, &limitErr) && !near(decimal.RequireFromString(limitErr.Remaining), decimal.RequireFromString(tt.remaining)) {
+				t.Errorf("Remaining = %s, want %s", limitErr.Remaining, tt.remaining)
+			}
+		})
+	}
+}
+
+// A purchase running into the next round is held to the limits of that round.
+func TestPurchaseHeldToTheNextRoundLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		next biz.ICOLimits
+		want string
+	}{
+		{"within the next round limits", biz.ICOLimits{Base: biz.ICOLimit{MaxPerTx: "1000"}}, ""},
+		{"above the next round max per purchase", biz.ICOLimits{Base: biz.ICOLimit{MaxPerTx: "10"}}, constant.ERROR_ICO_ABOVE_MAX_PER_TX},
+		// the minimum is checked on the whole purchase only
+		{"below the next round minimum", biz.ICOLimits{Base: biz.ICOLimit{MinPurchase: "1000000"}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			icoUc, ir := newICO(t, biz.ICOLimits{})
+			next, err := ir.GetRoundByRoundId(ctx, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next.Limits = tt.next
+			if err := ir.SaveRound(ctx, next); err != nil {
+				t.Fatal(err)
+			}
+
+			// the last sub-round of round 1 has 10 tokens left
+			subRounds, err := ir.GetSubRounds(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			last := subRounds[len(subRounds)-1].SubRound
+			current, err := ir.GetCurrentSubRound(ctx)
+			for ; err == nil && current.SubRound != last; current, err = ir.GetCurrentSubRound(ctx) {
+				current.BoughtToken = current.TotalToken
+				_, err = icoUc.CloseSubRound(ctx, current)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			left := decimal.RequireFromString(current.TotalToken).Sub(decimal.NewFromInt(10))
+			if ok, err := ir.TakeSubRoundToken(ctx, current.ID, left.String()); err != nil || !ok {
+				t.Fatal("TakeSubRoundToken", ok, err)
+			}
+
+			_, err = icoUc.ICOHistories(ctx, "u1", usdt(t, icoUc, "100"), "USDT", "p1", biz.ICO, true)
+			if got := limitReason(err); got != tt.want {
+				t.Fatalf("ICOHistories = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
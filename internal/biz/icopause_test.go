@@ -0,0 +1,102 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+// pausable is the sale with what pauses it and ends its sub-rounds.
+type pausable struct {
+	ir     biz.ICORepo
+	ico    *biz.ICOUsecase
+	admin  *biz.ICOAdminUsecase
+	runner *biz.QueueRunner
+	queue  *scheduled
+}
+
+func newPausable(t *testing.T) *pausable {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &pausable{ir: memrepo.NewIcoRepo(st), queue: &scheduled{}}
+	wr := memrepo.NewWalletRepo(st)
+	p.ico = biz.NewICOUseCase(p.ir, memrepo.NewIcoCouponRepo(st), memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	p.admin = biz.NewICOAdminUsecase(p.ir, wr, p.queue)
+	p.runner = biz.NewQueueRunner(p.ir, wr, memrepo.NewTransactionRepo(st), p.ico, memrepo.NewLockRepo(&conf.Data{}), publisher{})
+	return p
+}
+
+func TestPauseICO(t *testing.T) {
+	ctx := context.Background()
+	p := newPausable(t)
+
+	paused, err := p.admin.PauseICO(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paused.PausedAt.IsZero() {
+		t.Fatal("not paused")
+	}
+	again, err := p.admin.PauseICO(ctx)
+	if err != nil || !again.PausedAt.Equal(paused.PausedAt) {
+		t.Errorf("pausing twice moved the pause to %v, %v", again.PausedAt, err)
+	}
+
+	_, err = p.ico.ICOHistories(ctx, "u1", "10", "USDT", "p1", biz.ICO, true)
+	wantErr(t, "a purchase", err, constant.ERROR_ICO_PAUSED)
+	_, err = p.ico.PreviewICO(ctx, "10", "USDT", "")
+	wantErr(t, "a preview", err, constant.ERROR_ICO_PAUSED)
+
+	// its end passes while paused, the endround task leaves it running
+	end := time.Now().Add(-time.Second)
+	if err := p.ir.SetSubRoundEnd(ctx, paused.ID, end); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.runner.Execute(ctx, biz.NewEndRoundTask(paused)); err != nil {
+		t.Fatal(err)
+	}
+	if current, _ := p.ir.GetCurrentSubRound(ctx); current.ID != paused.ID {
+		t.Fatalf("sub-round %d-%d running, the paused one ended", current.RoundId, current.SubRound)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	resumed, err := p.admin.ResumeICO(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resumed.PausedAt.IsZero() || resumed.EndAt.Sub(end) < 20*time.Millisecond {
+		t.Errorf("resumed paused at %v, end moved by %v", resumed.PausedAt, resumed.EndAt.Sub(end))
+	}
+	if n := len(p.queue.tasks); n == 0 || !p.queue.tasks[n-1].ProcessAt.Equal(resumed.EndAt) {
+		t.Errorf("tasks %v, want the endround task at %v", p.queue.tasks, resumed.EndAt)
+	}
+
+	if _, err := p.ico.ICOHistories(ctx, "u1", "10", "USDT", "p1", biz.ICO, true); err != nil {
+		t.Errorf("a purchase once resumed: %v", err)
+	}
+}
+
+// Resuming a sale that runs changes nothing.
+func TestResumeRunningICO(t *testing.T) {
+	ctx := context.Background()
+	p := newPausable(t)
+	before, err := p.ir.GetCurrentSubRound(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed, err := p.admin.ResumeICO(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resumed.EndAt.Equal(before.EndAt) || !resumed.PausedAt.IsZero() {
+		t.Errorf("resumed ends at %v, was %v", resumed.EndAt, before.EndAt)
+	}
+}
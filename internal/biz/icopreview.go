@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// ICOPreview is what a purchase would buy now, in the symbol it pays with.
+type ICOPreview struct {
+	Lines      []*ICOPreviewLine
+	TotalToken string
+	// Cashback is what the coupon pays back to the buyer, in the symbol.
+	Cashback string
+	// Rounding is the part of the amount that buys no token.
+	Rounding string
+}
+
+// ICOPreviewLine is the part of a purchase a sub-round sells.
+type ICOPreviewLine struct {
+	RoundId  int32
+	SubRound int32
+	// Price is the average price of the tokens, Rate what they cost in the symbol each.
+	Price    string
+	NumToken string
+	Rate     string
+}
+
+// PreviewICO walks the sub-rounds like ICOHistories does for a purchase of
+// amount in symbol, without writing or locking anything. A concurrent
+// purchase can still make the real one buy less. A coupon that can't apply
+// fails it as it fails BuyICO, though its single use is not checked.
+func (uc *ICOUsecase) PreviewICO(ctx context.Context, amount, symbol, couponCode string) (*ICOPreview, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil || !value.IsPositive() {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	current, err := uc.repo.GetCurrentSubRound(ctx)
+	if err != nil {
+		uc.log.Error("PreviewICO ", err)
+		return nil, errors.New(constant.ERROR_ROUND_CLOSED)
+	}
+	if !current.PausedAt.IsZero() {
+		return nil, errors.New(constant.ERROR_ICO_PAUSED)
+	}
+	if current.StartAt.After(time.Now()) {
+		return nil, errors.New(constant.ERROR_ROUND_NOT_STARTED)
+	}
+	currency, err := uc.currencyRateRepo.GetCurrencyRate(ctx, fmt.Sprintf("%s_%s", symbol, constant.TokenSymbolIND))
+	if err != nil {
+		uc.log.Error("PreviewICO ", err)
+		return nil, err
+	}
+	// symbol per unit of price, the rates follow the price of the running sub-round
+	perPrice := decimal.RequireFromString(currency.Rate).Div(decimal.RequireFromString(current.Price))
+
+	subRounds, err := uc.repo.GetSubRounds(ctx, 0)
+	if err != nil {
+		uc.log.Error("PreviewICO ", err)
+		return nil, err
+	}
+
+	preview := &ICOPreview{}
+	rounds := map[int32]*ICORound{}
+	// tokens the preview took from the sub-rounds of each round
+	sold := map[int32]decimal.Decimal{}
+	totalToken, converted := decimal.Zero, decimal.Zero
+	remaining := value
+	dropped := false
+	for _, subRound := range subRounds {
+		if !remaining.IsPositive() {
+			break
+		}
+		left := decimal.RequireFromString(subRound.TotalToken).Sub(decimal.RequireFromString(subRound.BoughtToken))
+		if subRound.IsEnded || !left.IsPositive() {
+			continue
+		}
+		round, ok := rounds[subRound.RoundId]
+		if !ok {
+			round, err = uc.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+			if err != nil {
+				uc.log.Error("PreviewICO ", err)
+				return nil, err
+			}
+			rounds[subRound.RoundId] = round
+		}
+		strategy, state, err := uc.priceState(ctx, round, subRound)
+		if err != nil {
+			uc.log.Error("PreviewICO ", err)
+			return nil, err
+		}
+		state.Sold = state.Sold.Add(sold[round.RoundId])
+
+		numToken := strategy.Tokens(state, remaining.Div(perPrice), left)
+		if !numToken.IsPositive() {
+			// too small to buy anything, the purchase drops it
+			dropped = true
+			break
+		}
+		cost := strategy.Cost(state, numToken)
+		// a purchase that fits in the sub-round spends all of what remains
+		spent := remaining
+		if !numToken.LessThan(left) {
+			spent = cost.Mul(perPrice)
+		}
+		preview.Lines = append(preview.Lines, &ICOPreviewLine{RoundId: subRound.RoundId, SubRound: subRound.SubRound, Price: cost.Div(numToken).String(),
+			NumToken: numToken.String(), Rate: spent.Div(numToken).String()})
+
+		sold[round.RoundId] = sold[round.RoundId].Add(numToken)
+		totalToken = totalToken.Add(numToken)
+		converted = converted.Add(cost.Mul(perPrice))
+		remaining = remaining.Sub(spent)
+	}
+	if remaining.IsPositive() && !dropped {
+		// the ICO sells out before the purchase does, which fails it
+		return nil, errors.New(constant.ERROR_ROUND_CLOSED)
+	}
+	preview.TotalToken = totalToken.String()
+	preview.Rounding = decimal.Max(value.Sub(converted), decimal.Zero).String()
+	preview.Cashback = "0"
+
+	couponCode = strings.Trim(couponCode, " ")
+	if len(couponCode) == 0 {
+		return preview, nil
+	}
+	coupon, err := uc.icoCoupon.GetCoupon(ctx, couponCode)
+	if err != nil {
+		uc.log.Error("PreviewICO ", err)
+		return nil, err
+	}
+	if coupon != nil {
+		if err := uc.CheckCoupon(ctx, coupon, "", amount, symbol); err != nil {
+			return nil, err
+		}
+		preview.Cashback = value.Mul(decimal.RequireFromString(coupon.Cashback)).String()
+	}
+	return preview, nil
+}
@@ -0,0 +1,117 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+// calls records the writes and row locks made through the repositories below.
+type calls []string
+
+// watchedICO is an ICORepo recording its writes and locks.
+type watchedICO struct {
+	biz.ICORepo
+	calls *calls
+}
+
+func (r watchedICO) SaveHistories(ctx context.Context, histories []biz.ICOHistory) error {
+	*r.calls = append(*r.calls, "SaveHistories")
+	return r.ICORepo.SaveHistories(ctx, histories)
+}
+
+func (r watchedICO) TakeSubRoundToken(ctx context.Context, id xid.ID, numToken string) (bool, error) {
+	*r.calls = append(*r.calls, "TakeSubRoundToken")
+	return r.ICORepo.TakeSubRoundToken(ctx, id, numToken)
+}
+
+func (r watchedICO) LockSubRound(ctx context.Context, id xid.ID) (*biz.ICOSubRound, error) {
+	*r.calls = append(*r.calls, "LockSubRound")
+	return r.ICORepo.LockSubRound(ctx, id)
+}
+
+func (r watchedICO) CloseSubRound(ctx context.Context, roundId, subRound int32, boughtToken string) (*biz.ICOSubRound, error) {
+	*r.calls = append(*r.calls, "CloseSubRound")
+	return r.ICORepo.CloseSubRound(ctx, roundId, subRound, boughtToken)
+}
+
+func (r watchedICO) SaveSubRound(ctx context.Context, input *biz.ICOSubRound) error {
+	*r.calls = append(*r.calls, "SaveSubRound")
+	return r.ICORepo.SaveSubRound(ctx, input)
+}
+
+// watchedCoupons is an IcoCouponRepo recording the redemptions.
+type watchedCoupons struct {
+	biz.IcoCouponRepo
+	calls *calls
+}
+
+func (r watchedCoupons) UseCoupon(ctx context.Context, id xid.ID) (bool, error) {
+	*r.calls = append(*r.calls, "UseCoupon")
+	return r.IcoCouponRepo.UseCoupon(ctx, id)
+}
+
+func (r watchedCoupons) SaveRedemption(ctx context.Context, redemption *biz.IcoCouponRedemption) error {
+	*r.calls = append(*r.calls, "SaveRedemption")
+	return r.IcoCouponRepo.SaveRedemption(ctx, redemption)
+}
+
+func TestPreviewICOIsReadOnly(t *testing.T) {
+	ctx := context.Background()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	made := &calls{}
+	ir := watchedICO{ICORepo: memrepo.NewIcoRepo(st), calls: made}
+	coupons := watchedCoupons{IcoCouponRepo: memrepo.NewIcoCouponRepo(st), calls: made}
+	icoUc := biz.NewICOUseCase(ir, coupons, memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	if err := coupons.AddCoupon(ctx, &biz.IcoCoupon{UserID: "owner", Coupon: "SPRING", Reward: "0", Cashback: "0.05", SingleUse: true,
+		StartsAt: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a little more than the running sub-round sells, the preview spans two
+	amount := usdt(t, icoUc, "800100")
+	before, _ := ir.GetSubRounds(ctx, 1)
+	preview, err := icoUc.PreviewICO(ctx, amount, "USDT", "SPRING")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*made) > 0 {
+		t.Errorf("the preview made %v", *made)
+	}
+	after, _ := ir.GetSubRounds(ctx, 1)
+	for i := range before {
+		if before[i].BoughtToken != after[i].BoughtToken {
+			t.Errorf("sub-round %d sold %s after the preview, %s before", before[i].SubRound, after[i].BoughtToken, before[i].BoughtToken)
+		}
+	}
+	if coupon, _ := coupons.GetCoupon(ctx, "SPRING"); coupon.Used != 0 {
+		t.Errorf("coupon used %d times by the preview", coupon.Used)
+	}
+	if len(preview.Lines) != 2 {
+		t.Errorf("%d lines, want 2 sub-rounds", len(preview.Lines))
+	}
+	wantCashback := decimal.RequireFromString(amount).Mul(decimal.RequireFromString("0.05"))
+	if !decimal.RequireFromString(preview.Cashback).Equal(wantCashback) {
+		t.Errorf("cashback %s, want %s", preview.Cashback, wantCashback)
+	}
+
+	// the purchase buys what the preview said
+	bought, err := icoUc.ICOHistories(ctx, "u1", amount, "USDT", "p1", biz.ICO, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bought.Equal(decimal.RequireFromString(preview.TotalToken)) {
+		t.Errorf("bought %s, previewed %s", bought, preview.TotalToken)
+	}
+	if len(*made) == 0 {
+		t.Error("the purchase made no write, the repositories are not watched")
+	}
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// ICOPurchase is a history of a user with the transactions of its payment.
+type ICOPurchase struct {
+	History *ICOHistory
+	// Transaction credited the tokens, Commission paid the coupon owner and
+	// Cashback paid the buyer back. Nil when the payment has none. On a
+	// refund, History of type ICO_REFUND, they are the transactions taking
+	// them back.
+	Transaction *Transaction
+	Commission  *Transaction
+	Cashback    *Transaction
+}
+
+// ICOPurchaseSummary sums every purchase of a user.
+type ICOPurchaseSummary struct {
+	TotalToken   string
+	AveragePrice string
+	// Spend is what the user paid per symbol, purchases made before the
+	// payment was recorded are missing.
+	Spend map[string]string
+}
+
+// GetMyICOPurchases pages the purchases of userId, newest first, and sums all of them.
+func (uc *WalletTransactionUseCase) GetMyICOPurchases(ctx context.Context, userId, nextCursor string, limit int32) ([]*ICOPurchase, *ICOPurchaseSummary, string, error) {
+	if limit <= 0 || limit > constant.DEFAULT_LIMIT {
+		limit = constant.DEFAULT_LIMIT
+	}
+	histories, next, err := uc.icoRepo.GetUserHistories(ctx, userId, nextCursor, limit)
+	if err != nil {
+		uc.log.Error("GetMyICOPurchases ", err)
+		return nil, nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+
+	sourceIds := []string{}
+	seen := map[string]bool{}
+	for _, h := range histories {
+		if len(h.SourceId) > 0 && !seen[h.SourceId] {
+			seen[h.SourceId] = true
+			sourceIds = append(sourceIds, h.SourceId)
+		}
+	}
+	transactions, err := uc.transRepo.GetTransactionsBySourceIds(ctx, sourceIds)
+	if err != nil {
+		uc.log.Error("GetMyICOPurchases ", err)
+		return nil, nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+
+	purchases := make([]*ICOPurchase, len(histories))
+	for i, h := range histories {
+		purchase := &ICOPurchase{History: h}
+		for _, t := range transactions {
+			if len(h.SourceId) == 0 || t.SourceId != h.SourceId {
+				continue
+			}
+			// a refund links the transactions taking the purchase back
+			refund := h.Type == ICO_REFUND
+			switch {
+			case t.TransType == ICO_COMISSION && !refund, t.TransType == ICO_COMISSION_REVERSAL && refund:
+				purchase.Commission = t
+			case t.TransType == ICO_CASHBACK && t.Destination == userId && !refund, t.TransType == ICO_CASHBACK_REVERSAL && t.Source == userId && refund:
+				purchase.Cashback = t
+			case t.Source == constant.WALLET_ICO && t.Destination == userId && !refund, t.TransType == ICO_REFUND && t.Source == userId && refund:
+				purchase.Transaction = t
+			}
+		}
+		purchases[i] = purchase
+	}
+
+	all, _, err := uc.icoRepo.GetUserHistories(ctx, userId, "", 0)
+	if err != nil {
+		uc.log.Error("GetMyICOPurchases ", err)
+		return nil, nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+	return purchases, summarizePurchases(all), next, nil
+}
+
+func summarizePurchases(histories []*ICOHistory) *ICOPurchaseSummary {
+	totalToken, cost := decimal.Zero, decimal.Zero
+	spend := map[string]decimal.Decimal{}
+	for _, h := range histories {
+		numToken := decimal.RequireFromString(h.NumToken)
+		totalToken = totalToken.Add(numToken)
+		cost = cost.Add(numToken.Mul(decimal.RequireFromString(h.Price)))
+		if len(h.Symbol) > 0 && len(h.Amount) > 0 {
+			spend[h.Symbol] = spend[h.Symbol].Add(decimal.RequireFromString(h.Amount))
+		}
+	}
+
+	summary := &ICOPurchaseSummary{TotalToken: totalToken.String(), AveragePrice: "0", Spend: make(map[string]string, len(spend))}
+	if totalToken.IsPositive() {
+		summary.AveragePrice = cost.Div(totalToken).String()
+	}
+	for symbol, amount := range spend {
+		summary.Spend[symbol] = amount.String()
+	}
+	return summary
+}
@@ -0,0 +1,404 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// ICORefund is a refunded purchase: the tokens the buyer gave back and what
+// they paid for them, for the caller to refund the payment.
+type ICORefund struct {
+	SourceId string
+	NumToken string
+	Amount   string
+	Symbol   string
+}
+
+// ICOOutstandingRefund is a payment of a failed round the settlement could not
+// refund, its buyer no longer held the tokens. NumToken is what it bought in
+// the round.
+type ICOOutstandingRefund struct {
+	CreatedAt time.Time
+	RoundId   int32
+	UserId    string
+	SourceId  string
+	NumToken  string
+}
+
+// ICORefundUsecase cancels ICO purchases within the refund window. The tokens
+// go back to the sub-round they were bought in while it is open, to SYS_ICO
+// once it closed, and the coupon and referral payouts of the purchase are
+// taken back. Refunds are recorded as ico_histories of type ICO_REFUND with
+// negative tokens and amount, so the sums of the histories leave them out.
+type ICORefundUsecase struct {
+	repo         ICORefundRepo
+	icoRepo      ICORepo
+	walletRepo   UserWalletRepo
+	transRepo    TransactionRepo
+	icoCoupon    IcoCouponRepo
+	referralRepo ReferralRepo
+	icoUc        *ICOUsecase
+	lockRepo     LockRepo
+	publisher    TransactionPublisher
+	log          *log.Helper
+}
+
+func NewICORefundUsecase(repo ICORefundRepo, icoRepo ICORepo, walletRepo UserWalletRepo, transRepo TransactionRepo, icoCoupon IcoCouponRepo, referralRepo ReferralRepo,
+	icoUc *ICOUsecase, lockRepo LockRepo, publisher TransactionPublisher) *ICORefundUsecase {
+	return &ICORefundUsecase{
+		repo:         repo,
+		icoRepo:      icoRepo,
+		walletRepo:   walletRepo,
+		transRepo:    transRepo,
+		icoCoupon:    icoCoupon,
+		referralRepo: referralRepo,
+		icoUc:        icoUc,
+		lockRepo:     lockRepo,
+		publisher:    publisher,
+		log:          log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// RefundICOPurchase refunds the purchase userId paid with sourceId. The buyer
+// must still hold the tokens. The commission and cashback are taken back as
+// far as the wallets they were paid to hold them, what is missing is logged.
+func (uc *ICORefundUsecase) RefundICOPurchase(ctx context.Context, userId, sourceId string) (*ICORefund, error) {
+	if len(userId) == 0 || len(sourceId) == 0 {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	window := uc.repo.GetRefundWindow(ctx)
+	if window <= 0 {
+		return nil, errors.New(constant.ERROR_REFUND_WINDOW_CLOSED)
+	}
+	return uc.refund(ctx, userId, sourceId, window)
+}
+
+// refund refunds the purchase userId paid with sourceId, made within window
+// unless it is 0.
+func (uc *ICORefundUsecase) refund(ctx context.Context, userId, sourceId string, window time.Duration) (*ICORefund, error) {
+	// the same lock as the purchases of the user, a payment is refunded once
+	lockKey := fmt.Sprintf("%s:%s", constant.ICO_USER_LOCK, userId)
+	token, err := uc.lockRepo.Lock(ctx, lockKey)
+	if err != nil {
+		uc.log.Error("RefundICOPurchase ", err)
+		return nil, errors.New(constant.ERROR_LOCK)
+	}
+	defer uc.lockRepo.UnLock(ctx, token, lockKey)
+
+	histories, err := uc.repo.GetPaymentHistories(ctx, sourceId)
+	if err != nil {
+		uc.log.Error("RefundICOPurchase ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	purchases := []*ICOHistory{}
+	for _, h := range histories {
+		if h.UserId != userId {
+			continue
+		}
+		if h.Type == ICO_REFUND {
+			return nil, errors.New(constant.ERROR_ALREADY_REFUNDED)
+		}
+		if h.Type == ICO {
+			purchases = append(purchases, h)
+		}
+	}
+	if len(purchases) == 0 {
+		return nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	if window > 0 && time.Since(purchases[0].CreatedAt) >= window {
+		return nil, errors.New(constant.ERROR_REFUND_WINDOW_CLOSED)
+	}
+
+	refund := &ICORefund{SourceId: sourceId, Symbol: purchases[0].Symbol}
+	numToken, amount := decimal.Zero, decimal.Zero
+	for _, h := range purchases {
+		numToken = numToken.Add(decimal.RequireFromString(h.NumToken))
+		if len(h.Amount) > 0 {
+			amount = amount.Add(decimal.RequireFromString(h.Amount))
+		}
+	}
+	refund.NumToken, refund.Amount = numToken.String(), amount.String()
+
+	err = withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
+		rs, err := uc.walletRepo.DecreaseBalance(ctx, userId, constant.TokenSymbolIND, refund.NumToken, constant.WALLET_TYPE_USER)
+		if err != nil {
+			return err
+		}
+		if rs == 0 {
+			return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+		}
+
+		refunds := make([]ICOHistory, len(purchases))
+		for i, h := range purchases {
+			if err := uc.returnTokens(ctx, h); err != nil {
+				return err
+			}
+			refunds[i] = ICOHistory{RoundId: h.RoundId, SubRound: h.SubRound, UserId: userId, Price: h.Price, NumToken: negate(h.NumToken), Type: ICO_REFUND,
+				SourceId: sourceId, Symbol: h.Symbol, Amount: negate(h.Amount)}
+		}
+		if err := uc.icoRepo.SaveHistories(ctx, refunds); err != nil {
+			return err
+		}
+		if err := uc.reopenCapped(ctx, purchases); err != nil {
+			return err
+		}
+		if err := uc.repo.DeleteOutstandingRefund(ctx, sourceId); err != nil {
+			return err
+		}
+
+		payers, err := uc.payers(ctx, sourceId)
+		if err != nil {
+			return err
+		}
+		if err := uc.reverseCoupon(ctx, sourceId, payers); err != nil {
+			return err
+		}
+		if err := uc.reverseReferrals(ctx, sourceId, payers); err != nil {
+			return err
+		}
+		return uc.returnPayment(ctx, refund)
+	})
+	if err != nil {
+		if err.Error() == constant.ERROR_BALANCE_NOT_ENOUGH {
+			return nil, err
+		}
+		uc.log.Error("RefundICOPurchase ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+
+	uc.icoUc.RecordRefund(ctx, userId, numToken)
+	return refund, nil
+}
+
+// returnTokens gives the tokens of purchase h back to its sub-round, they go
+// to the ICO wallet while it is open. Once it closed they go where its unsold
+// tokens went: to the wallet of the unsold policy of the round, burned by the
+// burn policy.
+func (uc *ICORefundUsecase) returnTokens(ctx context.Context, h *ICOHistory) error {
+	subRounds, err := uc.icoRepo.GetSubRounds(ctx, h.RoundId)
+	if err != nil {
+		return err
+	}
+	var subRound *ICOSubRound
+	for _, s := range subRounds {
+		if s.SubRound == h.SubRound {
+			subRound = s
+		}
+	}
+	if subRound == nil {
+		return fmt.Errorf("sub-round %d-%d not found", h.RoundId, h.SubRound)
+	}
+	// serializes with the purchase that could close it
+	if subRound, err = uc.icoRepo.LockSubRound(ctx, subRound.ID); err != nil {
+		return err
+	}
+	if err := uc.repo.ReturnSubRoundToken(ctx, subRound.ID, h.NumToken); err != nil {
+		return err
+	}
+
+	wallet := constant.WALLET_ICO
+	if subRound.IsEnded {
+		round, err := uc.icoRepo.GetRoundByRoundId(ctx, h.RoundId)
+		if err != nil {
+			return err
+		}
+		wallet = round.Unsold.wallet()
+		if round.Unsold.Policy == UNSOLD_BURN {
+			return uc.record(ctx, ICO_BURN, h.UserId, wallet, h.NumToken, constant.TokenSymbolIND, h.SourceId)
+		}
+		if err := uc.createWallet(ctx, wallet, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+	}
+	if _, err := uc.walletRepo.IncreaseBalance(ctx, wallet, constant.TokenSymbolIND, h.NumToken, constant.WALLET_TYPE_SYSTEM); err != nil {
+		return err
+	}
+	return uc.record(ctx, ICO_REFUND, h.UserId, wallet, h.NumToken, constant.TokenSymbolIND, h.SourceId)
+}
+
+// returnPayment takes what refund pays back out of SYS_ICO_PROCEEDS, as far
+// as it holds it: the payments made before it kept count of them aren't there.
+func (uc *ICORefundUsecase) returnPayment(ctx context.Context, refund *ICORefund) error {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, constant.WALLET_SYS_ICO_PROCEEDS, refund.Symbol, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	amount := decimal.RequireFromString(refund.Amount)
+	taken := decimal.Zero
+	if len(wallets) > 0 {
+		taken = decimal.Min(decimal.RequireFromString(wallets[0].Balance), amount)
+	}
+	if taken.LessThan(amount) {
+		uc.log.Warnf("RefundICOPurchase: %s of %s %s refunded isn't in %s", amount.Sub(taken), amount, refund.Symbol, constant.WALLET_SYS_ICO_PROCEEDS)
+	}
+	if !taken.IsPositive() {
+		return nil
+	}
+	rs, err := uc.walletRepo.DecreaseBalance(ctx, constant.WALLET_SYS_ICO_PROCEEDS, refund.Symbol, taken.String(), constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if rs == 0 {
+		return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+	}
+	return uc.record(ctx, ICO_REFUND, constant.WALLET_SYS_ICO_PROCEEDS, constant.WALLET_SYS_DEPOSIT, taken.String(), refund.Symbol, refund.SourceId)
+}
+
+// payers returns the wallet each payout of the payment sourceId was paid out
+// of, by its type and user.
+func (uc *ICORefundUsecase) payers(ctx context.Context, sourceId string) (map[string]string, error) {
+	transactions, err := uc.transRepo.GetTransactionsBySourceIds(ctx, []string{sourceId})
+	if err != nil {
+		return nil, err
+	}
+	payers := map[string]string{}
+	for _, trans := range transactions {
+		payers[trans.TransType+":"+trans.Destination] = trans.Source
+	}
+	return payers, nil
+}
+
+// payer is the wallet the transType payout to userId was paid out of,
+// SYS_ICO_REWARD unless it was SYS_ICO_PROCEEDS.
+func payer(payers map[string]string, transType, userId string) string {
+	if payers[transType+":"+userId] == constant.WALLET_SYS_ICO_PROCEEDS {
+		return constant.WALLET_SYS_ICO_PROCEEDS
+	}
+	return constant.WALLET_SYS_ICO_REWARD
+}
+
+// reverseCoupon takes back the commission and the cashback of the coupon the
+// payment sourceId redeemed into the wallet that paid them. The coupon keeps
+// the use.
+func (uc *ICORefundUsecase) reverseCoupon(ctx context.Context, sourceId string, payers map[string]string) error {
+	redemption, err := uc.icoCoupon.GetRedemption(ctx, sourceId)
+	if err != nil || redemption == nil {
+		return err
+	}
+	coupon, err := uc.icoCoupon.FindCoupon(ctx, redemption.Coupon)
+	if err != nil {
+		return err
+	}
+	if coupon != nil {
+		to := payer(payers, ICO_COMISSION, coupon.UserID)
+		if err := uc.takeBack(ctx, ICO_COMISSION_REVERSAL, coupon.UserID, to, redemption.Reward, redemption.Symbol, sourceId); err != nil {
+			return err
+		}
+	}
+	to := payer(payers, ICO_CASHBACK, redemption.UserID)
+	return uc.takeBack(ctx, ICO_CASHBACK_REVERSAL, redemption.UserID, to, redemption.Cashback, redemption.Symbol, sourceId)
+}
+
+// reverseReferrals takes back the referral commissions of the payment
+// sourceId into the wallet that paid them, and records what was taken as
+// negative commissions.
+func (uc *ICORefundUsecase) reverseReferrals(ctx context.Context, sourceId string, payers map[string]string) error {
+	commissions, err := uc.referralRepo.GetCommissionsBySource(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+	for _, c := range commissions {
+		if !decimal.RequireFromString(c.Amount).IsPositive() {
+			continue
+		}
+		taken, err := uc.take(ctx, c.UserID, c.Amount, c.Symbol)
+		if err != nil {
+			return err
+		}
+		if !taken.IsPositive() {
+			continue
+		}
+		to := payer(payers, ICO_REFERRAL, c.UserID)
+		if err := uc.createWallet(ctx, to, c.Symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+		if _, err := uc.walletRepo.IncreaseBalance(ctx, to, c.Symbol, taken.String(), constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+		if err := uc.record(ctx, ICO_REFERRAL_REVERSAL, c.UserID, to, taken.String(), c.Symbol, sourceId); err != nil {
+			return err
+		}
+		err = uc.referralRepo.SaveCommission(ctx, &ReferralCommission{UserID: c.UserID, Level: c.Level, BuyerID: c.BuyerID, Coupon: c.Coupon,
+			SourceID: sourceId, Amount: taken.Neg().String(), Symbol: c.Symbol})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takeBack moves what it can of amount from the reward wallet of userId back
+// to the system wallet to and records it as transType.
+func (uc *ICORefundUsecase) takeBack(ctx context.Context, transType, userId, to, amount, symbol, sourceId string) error {
+	if len(amount) == 0 || !decimal.RequireFromString(amount).IsPositive() {
+		return nil
+	}
+	taken, err := uc.take(ctx, userId, amount, symbol)
+	if err != nil || !taken.IsPositive() {
+		return err
+	}
+	if err := uc.createWallet(ctx, to, symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+		return err
+	}
+	if _, err := uc.walletRepo.IncreaseBalance(ctx, to, symbol, taken.String(), constant.WALLET_TYPE_SYSTEM); err != nil {
+		return err
+	}
+	return uc.record(ctx, transType, userId, to, taken.String(), symbol, sourceId)
+}
+
+// take debits up to amount from the reward wallet of userId and returns what it took.
+func (uc *ICORefundUsecase) take(ctx context.Context, userId, amount, symbol string) (decimal.Decimal, error) {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, userId, symbol, constant.WALLET_TYPE_REWARD)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	taken := decimal.Zero
+	if len(wallets) > 0 {
+		taken = decimal.Min(decimal.RequireFromString(wallets[0].Balance), decimal.RequireFromString(amount))
+	}
+	if taken.LessThan(decimal.RequireFromString(amount)) {
+		uc.log.Warnf("RefundICOPurchase: %s of %s %s paid to %s can't be taken back", decimal.RequireFromString(amount).Sub(taken), amount, symbol, userId)
+	}
+	if !taken.IsPositive() {
+		return decimal.Zero, nil
+	}
+	rs, err := uc.walletRepo.DecreaseBalance(ctx, userId, symbol, taken.String(), constant.WALLET_TYPE_REWARD)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if rs == 0 {
+		return decimal.Zero, errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+	}
+	return taken, nil
+}
+
+func (uc *ICORefundUsecase) record(ctx context.Context, transType, source, destination, amount, symbol, sourceId string) error {
+	trans := &Transaction{TransType: transType, Source: source, SrcAmount: amount, SrcSymbol: symbol, Destination: destination, DestSymbol: symbol, DestAmount: amount, SourceId: sourceId, Status: TRANS_STATUS}
+	trans, err := uc.transRepo.CreateTransaction(ctx, trans)
+	if err != nil {
+		return err
+	}
+	return collectEvent(ctx, uc.walletRepo, trans)
+}
+
+func (uc *ICORefundUsecase) createWallet(ctx context.Context, userId, symbol, walletType string) error {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, userId, symbol, walletType)
+	if err != nil || len(wallets) > 0 {
+		return err
+	}
+	_, err = uc.walletRepo.CreateWallet(ctx, userId, symbol, walletType)
+	return err
+}
+
+func negate(amount string) string {
+	if len(amount) == 0 {
+		return amount
+	}
+	return decimal.RequireFromString(amount).Neg().String()
+}
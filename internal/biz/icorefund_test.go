@@ -0,0 +1,87 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// A purchase refunded after its sub-round closed gives the tokens where the
+// unsold ones of the round went.
+func TestRefundAfterTheSubRoundClosed(t *testing.T) {
+	for _, tc := range []struct {
+		unsold biz.ICOUnsold
+		// the wallet the tokens go to, none when they are burned
+		wallet string
+	}{
+		{unsold: biz.ICOUnsold{}, wallet: constant.WALLET_SYS_ICO_BACKUP},
+		{unsold: biz.ICOUnsold{Policy: biz.UNSOLD_WALLET, Wallet: "SYS_RESERVE"}, wallet: "SYS_RESERVE"},
+		{unsold: biz.ICOUnsold{Policy: biz.UNSOLD_ROLLOVER}, wallet: constant.WALLET_SYS_ICO_BACKUP},
+		{unsold: biz.ICOUnsold{Policy: biz.UNSOLD_BURN}},
+	} {
+		t.Run(tc.unsold.Policy+tc.unsold.Wallet, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{})
+			round, err := s.ir.GetRoundByRoundId(ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			round.Unsold = tc.unsold
+			if err := s.ir.SaveRound(ctx, round); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.wr.CreateWallet(ctx, "SYS_RESERVE", constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.trans.BuyICO(ctx, "u1", "100", "USDT", "p1", ""); err != nil {
+				t.Fatal(err)
+			}
+			current, err := s.ir.GetCurrentSubRound(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := s.ir.SetSubRoundEnd(ctx, current.ID, time.Now().Add(-time.Second)); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.runner.Execute(ctx, biz.NewEndRoundTask(current)); err != nil {
+				t.Fatal(err)
+			}
+
+			held := decimal.RequireFromString(s.balance(t, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER))
+			ico := s.balance(t, constant.WALLET_ICO, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM)
+			before := map[string]decimal.Decimal{}
+			for _, wallet := range []string{constant.WALLET_SYS_ICO_BACKUP, "SYS_RESERVE", constant.WALLET_SYS_BURN} {
+				before[wallet] = decimal.RequireFromString(s.balance(t, wallet, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM))
+			}
+			if _, err := s.refund.RefundICOPurchase(ctx, "u1", "p1"); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := s.balance(t, constant.WALLET_ICO, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM); got != ico {
+				t.Errorf("the ICO wallet went from %s to %s", ico, got)
+			}
+			for wallet, balance := range before {
+				want := balance
+				if wallet == tc.wallet {
+					want = want.Add(held)
+				}
+				if got := decimal.RequireFromString(s.balance(t, wallet, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM)); !got.Equal(want) {
+					t.Errorf("%s holds %s, want %s", wallet, got, want)
+				}
+			}
+			// the burned tokens leave the supply
+			violations, err := s.invariant.Check(ctx, s.supply)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(violations) > 0 {
+				t.Errorf("violations %v", violations)
+			}
+		})
+	}
+}
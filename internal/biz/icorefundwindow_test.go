@@ -0,0 +1,152 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// agedRefunds is an ICORefundRepo whose purchases were made age ago.
+type agedRefunds struct {
+	biz.ICORefundRepo
+	age time.Duration
+}
+
+func (r *agedRefunds) GetPaymentHistories(ctx context.Context, sourceId string) ([]*biz.ICOHistory, error) {
+	histories, err := r.ICORefundRepo.GetPaymentHistories(ctx, sourceId)
+	for _, h := range histories {
+		h.CreatedAt = h.CreatedAt.Add(-r.age)
+	}
+	return histories, err
+}
+
+// purchases is the dev store with the purchases of sub-round 1-1 recorded and
+// their tokens moved to the buyers, and the refunds of window.
+type purchases struct {
+	ir     biz.ICORepo
+	wr     biz.UserWalletRepo
+	ico    *biz.ICOUsecase
+	aged   *agedRefunds
+	refund *biz.ICORefundUsecase
+}
+
+func newPurchases(t *testing.T, window time.Duration) *purchases {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &conf.Data{Refund: &conf.Refund{Window: durationpb.New(window)}}
+	rr, err := memrepo.NewReferralRepo(c, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &purchases{ir: memrepo.NewIcoRepo(st), wr: memrepo.NewWalletRepo(st), aged: &agedRefunds{ICORefundRepo: memrepo.NewICORefundRepo(c, st)}}
+	cp := memrepo.NewIcoCouponRepo(st)
+	p.ico = biz.NewICOUseCase(p.ir, cp, memrepo.NewCurrencyRepo(st), tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	p.refund = biz.NewICORefundUsecase(p.aged, p.ir, p.wr, memrepo.NewTransactionRepo(st), cp, rr, p.ico, memrepo.NewLockRepo(&conf.Data{}), publisher{})
+	return p
+}
+
+// buy records a purchase of userId paid with sourceId and gives it the tokens.
+func (p *purchases) buy(t *testing.T, userId, sourceId string) string {
+	t.Helper()
+	ctx := context.Background()
+	numToken, err := p.ico.ICOHistories(ctx, userId, "50", "USDT", sourceId, biz.ICO, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.wr.DecreaseBalance(ctx, constant.WALLET_ICO, constant.TokenSymbolIND, numToken.String(), constant.WALLET_TYPE_SYSTEM); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.wr.CreateWallet(ctx, userId, constant.TokenSymbolIND, constant.WALLET_TYPE_USER); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.wr.IncreaseBalance(ctx, userId, constant.TokenSymbolIND, numToken.String(), constant.WALLET_TYPE_USER); err != nil {
+		t.Fatal(err)
+	}
+	return numToken.String()
+}
+
+func (p *purchases) sold(t *testing.T) string {
+	t.Helper()
+	current, err := p.ir.GetCurrentSubRound(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return current.BoughtToken
+}
+
+func TestRefundWindow(t *testing.T) {
+	hour := time.Hour
+	tests := []struct {
+		name   string
+		window time.Duration
+		age    time.Duration
+		// spend is what the buyer moved out of the wallet before the refund
+		spend  string
+		userId string
+		want   string
+	}{
+		{name: "within the window", window: hour, age: 59 * time.Minute, userId: "u1"},
+		{name: "past the window", window: hour, age: hour, userId: "u1", want: constant.ERROR_REFUND_WINDOW_CLOSED},
+		{name: "no window configured", userId: "u1", want: constant.ERROR_REFUND_WINDOW_CLOSED},
+		{name: "the payment of another user", window: hour, userId: "u2", want: constant.ERROR_NOT_FOUND},
+		{name: "tokens no longer held", window: hour, spend: "1", userId: "u1", want: constant.ERROR_BALANCE_NOT_ENOUGH},
+		{name: "no user", window: hour, want: constant.ERROR_BAD_REQUEST},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			p := newPurchases(t, tt.window)
+			bought := p.buy(t, "u1", "p1")
+			if len(tt.spend) > 0 {
+				if _, err := p.wr.DecreaseBalance(ctx, "u1", constant.TokenSymbolIND, tt.spend, constant.WALLET_TYPE_USER); err != nil {
+					t.Fatal(err)
+				}
+			}
+			p.aged.age = tt.age
+
+			refund, err := p.refund.RefundICOPurchase(ctx, tt.userId, "p1")
+			if got := limitReason(err); got != tt.want {
+				t.Fatalf("err %q, want %q", got, tt.want)
+			}
+			if len(tt.want) > 0 {
+				if got := p.sold(t); got != bought {
+					t.Errorf("sub-round sold %s after a refused refund, want %s", got, bought)
+				}
+				return
+			}
+			if refund.NumToken != bought || refund.Amount != "50" {
+				t.Errorf("refunded %s tokens and %s, want %s and 50", refund.NumToken, refund.Amount, bought)
+			}
+			if got := p.sold(t); got != "0" {
+				t.Errorf("sub-round sold %s after the refund, want 0", got)
+			}
+		})
+	}
+}
+
+// A payment is refunded once, the purchases of the same user with other
+// payments stay.
+func TestRefundOnce(t *testing.T) {
+	ctx := context.Background()
+	p := newPurchases(t, time.Hour)
+	first := p.buy(t, "u1", "p1")
+	p.buy(t, "u1", "p2")
+
+	if _, err := p.refund.RefundICOPurchase(ctx, "u1", "p1"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := p.refund.RefundICOPurchase(ctx, "u1", "p1")
+	wantErr(t, "refunding again", err, constant.ERROR_ALREADY_REFUNDED)
+	if got := p.sold(t); got != first {
+		t.Errorf("sub-round sold %s, want the %s of the second purchase", got, first)
+	}
+}
@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ICO_STATS_ROLLUP = "icostats:rollup"
+	// ICO_STATS_ROLLUP_DELAY lets the purchases of the day commit before it is rolled up.
+	ICO_STATS_ROLLUP_DELAY = 5 * time.Minute
+	// ICO_STATS_MAX_DAYS bounds the days of a series or a rollup.
+	ICO_STATS_MAX_DAYS = 366
+
+	oneDay = 24 * time.Hour
+)
+
+// ICOStats answers the analytics of the ICO over a range of purchases.
+type ICOStats struct {
+	Total ICOVolume
+	// Coupon is the part of Total paid with a coupon.
+	Coupon ICOVolume
+	// AverageTicket is what a purchase raised on average.
+	AverageTicket string
+	Rounds        []*ICORoundStats
+	Currencies    []*ICOCurrencyStats
+}
+
+type ICOStatsUsecase struct {
+	repo ICOStatsRepo
+	log  *log.Helper
+}
+
+func NewICOStatsUsecase(repo ICOStatsRepo) *ICOStatsUsecase {
+	return &ICOStatsUsecase{
+		repo: repo,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// NextICOStatsRollup is the task rolling up the day that ends next, shortly
+// after it ends. Data is the day, the task is the same on every replica.
+func NextICOStatsRollup(now time.Time) *Task {
+	end := now.Add(-ICO_STATS_ROLLUP_DELAY).Truncate(oneDay).Add(oneDay)
+	return &Task{Name: ICO_STATS_ROLLUP, Data: end.Add(-oneDay).UTC().Format(time.DateOnly), ProcessAt: end.Add(ICO_STATS_ROLLUP_DELAY)}
+}
+
+// GetStats sums the purchases created in [from, to), a zero bound leaves the
+// range open on its side.
+func (uc *ICOStatsUsecase) GetStats(ctx context.Context, from, to time.Time) (*ICOStats, error) {
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	total, err := uc.repo.GetVolume(ctx, from, to, false)
+	if err != nil {
+		uc.log.Error("GetStats ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	coupon, err := uc.repo.GetVolume(ctx, from, to, true)
+	if err != nil {
+		uc.log.Error("GetStats ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	rounds, err := uc.repo.GetRoundVolumes(ctx, from, to)
+	if err != nil {
+		uc.log.Error("GetStats ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	currencies, err := uc.repo.GetCurrencyVolumes(ctx, from, to)
+	if err != nil {
+		uc.log.Error("GetStats ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+
+	stats := &ICOStats{Total: *total, Coupon: *coupon, AverageTicket: "0", Rounds: rounds, Currencies: currencies}
+	if total.Purchases > 0 {
+		stats.AverageTicket = decimal.RequireFromString(total.Raised).Div(decimal.NewFromInt(int64(total.Purchases))).String()
+	}
+	return stats, nil
+}
+
+// GetDailyStats returns the volume of every day in [from, to), the last 30
+// days by default. Days that are not rolled up yet, today among them, are
+// summed from the purchases.
+func (uc *ICOStatsUsecase) GetDailyStats(ctx context.Context, from, to time.Time) ([]*ICODailyStats, error) {
+	today := time.Now().UTC().Truncate(oneDay)
+	from, to, err := dayRange(from, to, today.Add(oneDay), 30)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := uc.repo.GetDailyStats(ctx, from, to)
+	if err != nil {
+		uc.log.Error("GetDailyStats ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
+	byDay := make(map[time.Time]*ICODailyStats, len(rollups))
+	for _, r := range rollups {
+		byDay[r.Day.UTC()] = r
+	}
+
+	series := []*ICODailyStats{}
+	for d := from; d.Before(to) && !d.After(today); d = d.Add(oneDay) {
+		stats, ok := byDay[d]
+		if !ok {
+			if stats, err = uc.sumDay(ctx, d); err != nil {
+				uc.log.Error("GetDailyStats ", err)
+				return nil, errors.New(constant.ERROR_INTERNAL)
+			}
+		}
+		series = append(series, stats)
+	}
+	return series, nil
+}
+
+// RollupDay sums the purchases of day and keeps them as its rollup.
+func (uc *ICOStatsUsecase) RollupDay(ctx context.Context, d time.Time) (*ICODailyStats, error) {
+	stats, err := uc.sumDay(ctx, d.UTC().Truncate(oneDay))
+	if err != nil {
+		uc.log.Error("RollupDay ", err)
+		return nil, err
+	}
+	if err := uc.repo.SaveDailyStats(ctx, stats); err != nil {
+		uc.log.Error("RollupDay ", err)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// RollupDays rolls up again every day of [from, to) that ended, the previous
+// day by default. Purchases written or fixed afterwards show up in the series
+// this way.
+func (uc *ICOStatsUsecase) RollupDays(ctx context.Context, from, to time.Time) ([]*ICODailyStats, error) {
+	today := time.Now().UTC().Truncate(oneDay)
+	from, to, err := dayRange(from, to, today, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups := []*ICODailyStats{}
+	for d := from; d.Before(to) && d.Before(today); d = d.Add(oneDay) {
+		stats, err := uc.RollupDay(ctx, d)
+		if err != nil {
+			return nil, errors.New(constant.ERROR_INTERNAL)
+		}
+		rollups = append(rollups, stats)
+	}
+	return rollups, nil
+}
+
+func (uc *ICOStatsUsecase) sumDay(ctx context.Context, d time.Time) (*ICODailyStats, error) {
+	total, err := uc.repo.GetVolume(ctx, d, d.Add(oneDay), false)
+	if err != nil {
+		return nil, err
+	}
+	coupon, err := uc.repo.GetVolume(ctx, d, d.Add(oneDay), true)
+	if err != nil {
+		return nil, err
+	}
+	return &ICODailyStats{Day: d, Total: *total, Coupon: *coupon}, nil
+}
+
+// dayRange aligns [from, to) on UTC days. to defaults to defaultTo and from to
+// days before to, the range can't span more than ICO_STATS_MAX_DAYS.
+func dayRange(from, to, defaultTo time.Time, days int) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = defaultTo
+	} else {
+		// a day that starts before to is in the range
+		to = to.UTC().Add(oneDay - time.Nanosecond).Truncate(oneDay)
+	}
+	if from.IsZero() {
+		from = to.Add(-time.Duration(days) * oneDay)
+	} else {
+		from = from.UTC().Truncate(oneDay)
+	}
+	if !from.Before(to) || to.Sub(from) > ICO_STATS_MAX_DAYS*oneDay {
+		return time.Time{}, time.Time{}, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return from, to, nil
+}
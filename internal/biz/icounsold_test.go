@@ -0,0 +1,151 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+// twoSubRounds is a store holding one round of two sub-rounds of 100 tokens,
+// the first one running, and the ICO wallet holding them.
+type twoSubRounds struct {
+	ir     biz.ICORepo
+	wr     biz.UserWalletRepo
+	runner *biz.QueueRunner
+	burned biz.InvariantRepo
+}
+
+func newTwoSubRounds(t *testing.T, unsold biz.ICOUnsold) *twoSubRounds {
+	t.Helper()
+	ctx := context.Background()
+	st := memrepo.NewStore()
+	r := &twoSubRounds{ir: memrepo.NewIcoRepo(st), wr: memrepo.NewWalletRepo(st), burned: memrepo.NewInvariantRepo(st)}
+	cr := memrepo.NewCurrencyRepo(st)
+	if err := cr.InitData(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ico := biz.NewICOUseCase(r.ir, memrepo.NewIcoCouponRepo(st), cr, tiers{}, memrepo.NewLeaderboardRepo(memrepo.NewStore()))
+	r.runner = biz.NewQueueRunner(r.ir, r.wr, memrepo.NewTransactionRepo(st), ico, memrepo.NewLockRepo(&conf.Data{}), publisher{})
+
+	for _, wallet := range []string{constant.WALLET_ICO, constant.WALLET_SYS_ICO_BACKUP, "SYS_RESERVE"} {
+		if _, err := r.wr.CreateWallet(ctx, wallet, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := r.wr.IncreaseBalance(ctx, constant.WALLET_ICO, constant.TokenSymbolIND, "200", constant.WALLET_TYPE_SYSTEM); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ir.SaveRound(ctx, &biz.ICORound{RoundId: 1, RoundName: "Round 1", Price: "1", NumToken: "200", NumSub: 2, PriceGap: "0%", Unsold: unsold}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Add(-time.Hour)
+	for j := int32(1); j <= 2; j++ {
+		if err := r.ir.SaveSubRound(ctx, &biz.ICOSubRound{RoundId: 1, SubRound: j, Price: "1", TotalToken: "100", StartAt: start, EndAt: start.Add(time.Minute)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return r
+}
+
+// end ends the running sub-round after it sold numToken.
+func (r *twoSubRounds) end(t *testing.T, numToken string) error {
+	t.Helper()
+	ctx := context.Background()
+	current, err := r.ir.GetCurrentSubRound(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := r.ir.TakeSubRoundToken(ctx, current.ID, numToken); err != nil || !ok {
+		t.Fatalf("sell %s: %v %v", numToken, ok, err)
+	}
+	if err := r.ir.SetSubRoundEnd(ctx, current.ID, time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	return r.runner.Execute(ctx, biz.NewEndRoundTask(current))
+}
+
+func (r *twoSubRounds) holds(t *testing.T, wallet string) string {
+	t.Helper()
+	wallets, err := r.wr.GetWalletByUserId(context.Background(), wallet, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallets) == 0 {
+		return "none"
+	}
+	return wallets[0].Balance
+}
+
+func TestUnsoldPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		unsold biz.ICOUnsold
+		// sold by each sub-round ended
+		sold []string
+		// balances after, "none" for a wallet that does not exist
+		want map[string]string
+		// tokens of the second sub-round after
+		wantNext string
+		burned   string
+	}{
+		{name: "the default wallet", sold: []string{"30"},
+			want: map[string]string{constant.WALLET_ICO: "130", constant.WALLET_SYS_ICO_BACKUP: "70", "SYS_RESERVE": "0"}, wantNext: "100"},
+		{name: "a wallet named", unsold: biz.ICOUnsold{Policy: biz.UNSOLD_WALLET, Wallet: "SYS_RESERVE"}, sold: []string{"30"},
+			want: map[string]string{constant.WALLET_ICO: "130", constant.WALLET_SYS_ICO_BACKUP: "0", "SYS_RESERVE": "70"}, wantNext: "100"},
+		{name: "rollover to the next sub-round", unsold: biz.ICOUnsold{Policy: biz.UNSOLD_ROLLOVER}, sold: []string{"30"},
+			want: map[string]string{constant.WALLET_ICO: "200", constant.WALLET_SYS_ICO_BACKUP: "0"}, wantNext: "170"},
+		{name: "rollover with no sub-round left", unsold: biz.ICOUnsold{Policy: biz.UNSOLD_ROLLOVER, Wallet: "SYS_RESERVE"}, sold: []string{"30", "150"},
+			want: map[string]string{constant.WALLET_ICO: "180", "SYS_RESERVE": "20"}, wantNext: "170"},
+		{name: "burn", unsold: biz.ICOUnsold{Policy: biz.UNSOLD_BURN}, sold: []string{"30"},
+			want: map[string]string{constant.WALLET_ICO: "130", constant.WALLET_SYS_ICO_BACKUP: "0", constant.WALLET_SYS_BURN: "none"}, wantNext: "100", burned: "70"},
+		{name: "nothing unsold", unsold: biz.ICOUnsold{Policy: biz.UNSOLD_BURN}, sold: []string{"100"},
+			want: map[string]string{constant.WALLET_ICO: "200"}, wantNext: "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTwoSubRounds(t, tt.unsold)
+			for _, sold := range tt.sold {
+				if err := r.end(t, sold); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for wallet, want := range tt.want {
+				if got := r.holds(t, wallet); got != want {
+					t.Errorf("%s holds %s, want %s", wallet, got, want)
+				}
+			}
+			subRounds, _ := r.ir.GetSubRounds(ctx, 1)
+			if subRounds[1].TotalToken != tt.wantNext {
+				t.Errorf("the second sub-round sells %s, want %s", subRounds[1].TotalToken, tt.wantNext)
+			}
+			burned, _ := r.burned.GetBurned(ctx)
+			got := ""
+			if len(burned) > 0 {
+				got = burned[0].Total
+			}
+			if got != tt.burned {
+				t.Errorf("burned %q, want %q", got, tt.burned)
+			}
+		})
+	}
+}
+
+// Unsold tokens sent to a wallet that is gone fail the end of the sub-round,
+// which keeps running.
+func TestUnsoldToMissingWallet(t *testing.T) {
+	ctx := context.Background()
+	r := newTwoSubRounds(t, biz.ICOUnsold{Policy: biz.UNSOLD_WALLET, Wallet: "SYS_GONE"})
+	wantErr(t, "ending the sub-round", r.end(t, "30"), constant.ERROR_NOT_FOUND)
+	if got := r.holds(t, constant.WALLET_ICO); got != "200" {
+		t.Errorf("ICO holds %s, want 200", got)
+	}
+	if current, _ := r.ir.GetCurrentSubRound(ctx); current.SubRound != 1 {
+		t.Errorf("sub-round %d running, want 1", current.SubRound)
+	}
+}
@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	INVARIANT_CHECK = "invariant:check"
+
+	INVARIANT_SUPPLY           = "SUPPLY"
+	INVARIANT_NEGATIVE_BALANCE = "NEGATIVE_BALANCE"
+	INVARIANT_SUBROUND_SOLD    = "SUBROUND_SOLD"
+)
+
+// MINT_TYPES are the transactions crediting a wallet without debiting another,
+// the rewards of the referral and marketing services.
+var MINT_TYPES = []string{REFERRAL_REWARD, MarketingReward}
+
+// Violation is an invariant that does not hold, Subject is what breaks it: a
+// symbol, a wallet or a sub-round.
+type Violation struct {
+	Invariant string
+	Subject   string
+	Expected  string
+	Actual    string
+}
+
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s %s: expected %s, got %s", v.Invariant, v.Subject, v.Expected, v.Actual)
+}
+
+type InvariantUsecase struct {
+	repo InvariantRepo
+	log  *log.Helper
+}
+
+func NewInvariantUsecase(repo InvariantRepo) *InvariantUsecase {
+	return &InvariantUsecase{
+		repo: repo,
+		log:  log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// Check returns every violation of the invariants:
+//   - the total balance of each symbol in supply equals its expected supply,
+//     plus what was minted and less what was burned
+//   - no wallet has a negative balance
+//   - the bought_token of each sub-round equals the purchases in ico_histories
+func (uc *InvariantUsecase) Check(ctx context.Context, supply map[string]string) ([]*Violation, error) {
+	var violations []*Violation
+
+	supplies, err := uc.repo.GetSupplies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totals := map[string]decimal.Decimal{}
+	for _, s := range supplies {
+		totals[s.Symbol] = decimal.RequireFromString(s.Total)
+	}
+	burned, err := uc.repo.GetBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burnedTotals := map[string]decimal.Decimal{}
+	for _, s := range burned {
+		burnedTotals[s.Symbol] = decimal.RequireFromString(s.Total)
+	}
+	minted, err := uc.repo.GetMinted(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mintedTotals := map[string]decimal.Decimal{}
+	for _, s := range minted {
+		mintedTotals[s.Symbol] = decimal.RequireFromString(s.Total)
+	}
+	symbols := make([]string, 0, len(supply))
+	for symbol := range supply {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		expected, err := decimal.NewFromString(supply[symbol])
+		if err != nil {
+			return nil, fmt.Errorf("supply of %s: %w", symbol, err)
+		}
+		expected = expected.Add(mintedTotals[symbol]).Sub(burnedTotals[symbol])
+		if !totals[symbol].Equal(expected) {
+			violations = append(violations, &Violation{Invariant: INVARIANT_SUPPLY, Subject: symbol, Expected: expected.String(), Actual: totals[symbol].String()})
+		}
+	}
+
+	wallets, err := uc.repo.GetNegativeWallets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range wallets {
+		violations = append(violations, &Violation{Invariant: INVARIANT_NEGATIVE_BALANCE, Subject: fmt.Sprintf("%s/%s/%s", w.UserID, w.Symbol, w.Type), Expected: ">= 0", Actual: w.Balance})
+	}
+
+	sales, err := uc.repo.GetSubRoundSales(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sales {
+		if !decimal.RequireFromString(s.BoughtToken).Equal(decimal.RequireFromString(s.HistoryToken)) {
+			violations = append(violations, &Violation{Invariant: INVARIANT_SUBROUND_SOLD, Subject: fmt.Sprintf("%d-%d", s.RoundId, s.SubRound), Expected: s.HistoryToken, Actual: s.BoughtToken})
+		}
+	}
+
+	return violations, nil
+}
+
+// Verify runs Check and logs every violation as an error. It fails when an
+// invariant does not hold, so a migration that breaks one is rolled back.
+func (uc *InvariantUsecase) Verify(ctx context.Context, supply map[string]string) error {
+	violations, err := uc.Check(ctx, supply)
+	if err != nil {
+		uc.log.Errorf("Verify invariants: %v", err)
+		return err
+	}
+	if len(violations) == 0 {
+		uc.log.Infof("Verify invariants: ok")
+		return nil
+	}
+
+	for _, v := range violations {
+		uc.log.Errorf("Invariant violated: %s", v)
+	}
+	return errors.New(constant.ERROR_INVARIANT)
+}
+
+// NextInvariantCheck is the task of the next scheduled check. It is aligned on
+// interval, so every replica enqueues the same task.
+func NextInvariantCheck(now time.Time, interval time.Duration) *Task {
+	return &Task{Name: INVARIANT_CHECK, Data: INVARIANT_CHECK, ProcessAt: now.Truncate(interval).Add(interval)}
+}
@@ -0,0 +1,156 @@
+package biz_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+// ledger is a dev store with what crediting and checking its wallets takes,
+// supply holds the IND it was seeded with.
+type ledger struct {
+	wr        biz.UserWalletRepo
+	trans     *biz.WalletTransactionUseCase
+	invariant *biz.InvariantUsecase
+	supply    map[string]string
+}
+
+func newLedger(t *testing.T) *ledger {
+	t.Helper()
+	st, err := memrepo.NewDevStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &ledger{wr: memrepo.NewWalletRepo(st), invariant: biz.NewInvariantUsecase(memrepo.NewInvariantRepo(st))}
+	l.trans = biz.NewWalletTransactionUseCase(memrepo.NewTransactionRepo(st), l.wr, nil, nil, nil, nil, publisher{}, nil, nil, nil)
+
+	supplies, err := memrepo.NewInvariantRepo(st).GetSupplies(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.supply = map[string]string{}
+	for _, s := range supplies {
+		if s.Symbol == constant.TokenSymbolIND {
+			l.supply[s.Symbol] = s.Total
+		}
+	}
+	return l
+}
+
+// The referral and marketing rewards mint what they pay, the supply grows by it.
+func TestCheckCountsMintedRewards(t *testing.T) {
+	tests := []struct {
+		name    string
+		pay     func(ctx context.Context, l *ledger) error
+		wantErr bool
+	}{
+		{name: "a referral reward", pay: func(ctx context.Context, l *ledger) error {
+			return l.trans.ReferralReward(ctx, "u1", "12.5", constant.TokenSymbolIND, "r1")
+		}},
+		{name: "a marketing reward", pay: func(ctx context.Context, l *ledger) error {
+			_, err := l.trans.MarketingRewardInternal(ctx, "u1", "7", constant.TokenSymbolIND, "m1")
+			return err
+		}},
+		{name: "both, to the same wallet", pay: func(ctx context.Context, l *ledger) error {
+			if err := l.trans.ReferralReward(ctx, "u1", "1", constant.TokenSymbolIND, "r1"); err != nil {
+				return err
+			}
+			_, err := l.trans.MarketingRewardInternal(ctx, "u1", "2", constant.TokenSymbolIND, "m1")
+			return err
+		}},
+		{name: "a credit no transaction records", pay: func(ctx context.Context, l *ledger) error {
+			_, err := l.wr.IncreaseBalance(ctx, constant.WALLET_ICO, constant.TokenSymbolIND, "1", constant.WALLET_TYPE_SYSTEM)
+			return err
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			l := newLedger(t)
+			if err := tt.pay(ctx, l); err != nil {
+				t.Fatal(err)
+			}
+			violations, err := l.invariant.Check(ctx, l.supply)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(violations) > 0) != tt.wantErr {
+				t.Errorf("violations %v, want some %v", violations, tt.wantErr)
+			}
+		})
+	}
+}
+
+// figures is an InvariantRepo returning fixed sums, in place of the tables.
+type figures struct {
+	supplies, burned, minted []*biz.SymbolSupply
+	negative                 []*biz.UserWallet
+	sales                    []*biz.SubRoundSale
+}
+
+func (f *figures) GetSupplies(context.Context) ([]*biz.SymbolSupply, error) { return f.supplies, nil }
+func (f *figures) GetBurned(context.Context) ([]*biz.SymbolSupply, error)   { return f.burned, nil }
+func (f *figures) GetMinted(context.Context) ([]*biz.SymbolSupply, error)   { return f.minted, nil }
+func (f *figures) GetNegativeWallets(context.Context) ([]*biz.UserWallet, error) {
+	return f.negative, nil
+}
+func (f *figures) GetSubRoundSales(context.Context) ([]*biz.SubRoundSale, error) {
+	return f.sales, nil
+}
+
+func ind(total string) []*biz.SymbolSupply {
+	return []*biz.SymbolSupply{{Symbol: constant.TokenSymbolIND, Total: total}}
+}
+
+func TestCheck(t *testing.T) {
+	supply := map[string]string{constant.TokenSymbolIND: "100"}
+	tests := []struct {
+		name    string
+		figures figures
+		want    []string
+	}{
+		{name: "minted and burned add up", figures: figures{supplies: ind("95"), minted: ind("5"), burned: ind("10")}},
+		{name: "a burn taken from no wallet", figures: figures{supplies: ind("100"), burned: ind("10")},
+			want: []string{"SUPPLY IND: expected 90, got 100"}},
+		{name: "a negative wallet", figures: figures{supplies: ind("100"),
+			negative: []*biz.UserWallet{{UserID: "u1", Symbol: constant.TokenSymbolIND, Type: constant.WALLET_TYPE_USER, Balance: "-2"}}},
+			want: []string{"NEGATIVE_BALANCE u1/IND/USER: expected >= 0, got -2"}},
+		{name: "a sub-round sold more than its purchases", figures: figures{supplies: ind("100"),
+			sales: []*biz.SubRoundSale{{RoundId: 1, SubRound: 1, BoughtToken: "30", HistoryToken: "30"}, {RoundId: 1, SubRound: 2, BoughtToken: "25", HistoryToken: "20.5"}}},
+			want: []string{"SUBROUND_SOLD 1-2: expected 20.5, got 25"}},
+		{name: "a symbol no wallet holds", figures: figures{},
+			want: []string{"SUPPLY IND: expected 100, got 0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := biz.NewInvariantUsecase(&tt.figures)
+			violations, err := uc.Check(context.Background(), supply)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range violations {
+				got = append(got, v.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations %q, want %q", got, tt.want)
+			}
+
+			err = uc.Verify(context.Background(), supply)
+			if len(tt.want) == 0 && err != nil {
+				t.Errorf("Verify: %v", err)
+			}
+			if len(tt.want) > 0 && (err == nil || err.Error() != constant.ERROR_INVARIANT) {
+				t.Errorf("Verify: err %v, want %s", err, constant.ERROR_INVARIANT)
+			}
+		})
+	}
+
+	if _, err := biz.NewInvariantUsecase(&figures{}).Check(context.Background(), map[string]string{constant.TokenSymbolIND: "a lot"}); err == nil {
+		t.Error("Check of an unparsable supply succeeded")
+	}
+}
@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	LEADERBOARD_SNAPSHOT = "leaderboard:snapshot"
+	// LEADERBOARD_SNAPSHOT_SIZE is how many users a snapshot keeps by default.
+	LEADERBOARD_SNAPSHOT_SIZE = 100
+)
+
+// NextLeaderboardSnapshot is the task of the next scheduled snapshot, taken at
+// ProcessAt. It is aligned on interval, so every replica enqueues the same task.
+func NextLeaderboardSnapshot(now time.Time, interval time.Duration) *Task {
+	takenAt := now.Truncate(interval).Add(interval)
+	return &Task{Name: LEADERBOARD_SNAPSHOT, Data: takenAt.UTC().Format(time.RFC3339), ProcessAt: takenAt}
+}
+
+// RecordPurchase adds the tokens of a committed purchase to the leaderboard. A
+// failure is only logged, RebuildLeaderboard puts the leaderboard right.
+func (uc *ICOUsecase) RecordPurchase(ctx context.Context, userId string, numToken decimal.Decimal) {
+	if !numToken.IsPositive() {
+		return
+	}
+	if err := uc.leaderboard.AddUserToken(ctx, userId, numToken.String()); err != nil {
+		uc.log.Error("RecordPurchase ", err)
+	}
+}
+
+// RecordRefund takes the tokens of a committed refund off the leaderboard.
+func (uc *ICOUsecase) RecordRefund(ctx context.Context, userId string, numToken decimal.Decimal) {
+	if !numToken.IsPositive() {
+		return
+	}
+	if err := uc.leaderboard.AddUserToken(ctx, userId, numToken.Neg().String()); err != nil {
+		uc.log.Error("RecordRefund ", err)
+	}
+}
+
+// RebuildLeaderboard replaces the leaderboard by the sums of ico_histories and
+// returns how many users it ranks. A purchase committing meanwhile can be
+// missed, until the next rebuild.
+func (uc *ICOUsecase) RebuildLeaderboard(ctx context.Context) (int, error) {
+	total, err := uc.repo.GetBuyICOTotalUser(ctx)
+	if err != nil {
+		uc.log.Error("RebuildLeaderboard ", err)
+		return 0, err
+	}
+	users, err := uc.repo.GetBuyICOUser(ctx, total, 0)
+	if err != nil {
+		uc.log.Error("RebuildLeaderboard ", err)
+		return 0, err
+	}
+	if err := uc.leaderboard.Replace(ctx, users); err != nil {
+		uc.log.Error("RebuildLeaderboard ", err)
+		return 0, err
+	}
+	return len(users), nil
+}
+
+// GetICOUserRank returns the rank of userId, nil when they bought nothing.
+func (uc *ICOUsecase) GetICOUserRank(ctx context.Context, userId string) (*ICOUserBought, error) {
+	return uc.leaderboard.GetUserRank(ctx, userId)
+}
+
+// SnapshotLeaderboard keeps the size first users of the leaderboard as it is
+// at takenAt, LEADERBOARD_SNAPSHOT_SIZE when size is 0.
+func (uc *ICOUsecase) SnapshotLeaderboard(ctx context.Context, takenAt time.Time, size int) error {
+	if size <= 0 {
+		size = LEADERBOARD_SNAPSHOT_SIZE
+	}
+	users, err := uc.leaderboard.GetRanks(ctx, 0, size)
+	if err != nil {
+		uc.log.Error("SnapshotLeaderboard ", err)
+		return err
+	}
+	if err := uc.leaderboard.SaveSnapshot(ctx, takenAt, users); err != nil {
+		uc.log.Error("SnapshotLeaderboard ", err)
+		return err
+	}
+	return nil
+}
+
+// GetLeaderboardSnapshot returns the snapshot taken at takenAt, the latest one
+// when it is zero, and when it was taken.
+func (uc *ICOUsecase) GetLeaderboardSnapshot(ctx context.Context, takenAt time.Time) ([]*ICOUserBought, time.Time, error) {
+	return uc.leaderboard.GetSnapshot(ctx, takenAt)
+}
@@ -1,13 +1,88 @@
 package biz
 
-type TransactionMessage struct {
-	Id        string `json:"id"`
-	UserId    string `json:"user_id"`
-	Amount    string `json:"amount"`
-	Symbol    string `json:"symbol"`
-	TransType string `json:"type"`
+import (
+	"context"
+	"time"
+)
+
+// TransactionEvent is emitted once per committed transaction. ID is the
+// transaction id so consumers can dedupe redeliveries.
+type TransactionEvent struct {
+	ID          string
+	Transaction *Transaction
+	Balances    []*UserWallet
+	OccurredAt  time.Time
 }
 
 type TransactionPublisher interface {
-	Publish(msg *TransactionMessage)
+	Publish(ctx context.Context, event *TransactionEvent)
+}
+
+// EVENT_FANOUT is the task taking a published event to the webhooks and the
+// alert rules, off the path of the transaction that emitted it.
+const EVENT_FANOUT = "event:fanout"
+
+// EventQueue queues the fan-out of a published event.
+type EventQueue interface {
+	EnqueueEvent(ctx context.Context, event *TransactionEvent) error
+}
+
+// EventFanout dispatches an event to the webhooks whose filters match it and
+// sends the alerts it fires.
+type EventFanout interface {
+	Fanout(ctx context.Context, event *TransactionEvent) error
+}
+
+// avoid error: should not use built-in type string as key for value; define your own type to avoid collisions
+type txEvents string
+
+const txEventsKey = txEvents("txEvents")
+
+type pendingEvents struct {
+	events []*TransactionEvent
+}
+
+// withEvents runs fn in a db transaction and publishes the events collected by
+// collectEvent only after it commits. Nested calls share the outer buffer.
+func withEvents(ctx context.Context, tx Tx, publisher TransactionPublisher, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txEventsKey).(*pendingEvents); ok {
+		return tx.WithTx(ctx, fn)
+	}
+
+	pending := &pendingEvents{}
+	err := tx.WithTx(context.WithValue(ctx, txEventsKey, pending), fn)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range pending.events {
+		publisher.Publish(ctx, event)
+	}
+	return nil
+}
+
+// collectEvent snapshots the balances touched by trans and queues the event on
+// the surrounding withEvents call.
+func collectEvent(ctx context.Context, walletRepo UserWalletRepo, trans *Transaction) error {
+	pending, ok := ctx.Value(txEventsKey).(*pendingEvents)
+	if !ok {
+		return nil
+	}
+
+	// the source in the symbol actually debited, which is IND for a subscription
+	srcSymbol, _ := debitOf(trans)
+	balances, err := walletRepo.GetWalletByUserId(ctx, trans.Source, srcSymbol, "")
+	if err != nil {
+		return err
+	}
+	if trans.Destination != trans.Source || trans.DestSymbol != srcSymbol {
+		destBalances, err := walletRepo.GetWalletByUserId(ctx, trans.Destination, trans.DestSymbol, "")
+		if err != nil {
+			return err
+		}
+		balances = append(balances, destBalances...)
+	}
+
+	pending.events = append(pending.events, &TransactionEvent{ID: trans.ID.String(), Transaction: trans, Balances: balances, OccurredAt: time.Now()})
+	return nil
 }
@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// PRICING_STEP sells every sub-round at its price, the default.
+	PRICING_STEP = "step"
+	// PRICING_LINEAR and PRICING_EXPONENTIAL move the price along a curve from
+	// the round price to EndPrice as the round sells its tokens.
+	PRICING_LINEAR      = "linear"
+	PRICING_EXPONENTIAL = "exponential"
+	// PRICING_DUTCH lowers the price from the round price to EndPrice in
+	// Duration minutes from the start of the round.
+	PRICING_DUTCH = "dutch"
+
+	// pricingSteps bounds the bisection finding what a value buys on a curve.
+	pricingSteps = 64
+)
+
+// ICOPricing selects the pricing strategy of a round.
+type ICOPricing struct {
+	Strategy string `json:"strategy,omitempty"`
+	EndPrice string `json:"end_price,omitempty"`
+	// Duration is how many minutes a Dutch auction takes to reach EndPrice.
+	Duration int32 `json:"duration,omitempty"`
+}
+
+func (p ICOPricing) IsStep() bool {
+	return len(p.Strategy) == 0 || p.Strategy == PRICING_STEP
+}
+
+// PriceState is what the price of the next token depends on.
+type PriceState struct {
+	Round    *ICORound
+	SubRound *ICOSubRound
+	// Sold is how many tokens the round sold, StartedAt when it started.
+	Sold      decimal.Decimal
+	StartedAt time.Time
+	Now       time.Time
+}
+
+// PricingStrategy prices the tokens of a round, in the unit of its price. The
+// currency rates follow the price of the running sub-round, a purchase in a
+// symbol pays its rate times the strategy price over the sub-round price.
+type PricingStrategy interface {
+	// Price is the price of the next token.
+	Price(s *PriceState) decimal.Decimal
+	// Cost is what the next numToken tokens cost together.
+	Cost(s *PriceState, numToken decimal.Decimal) decimal.Decimal
+	// Tokens is how many of the next tokens value buys, up to max.
+	Tokens(s *PriceState, value, max decimal.Decimal) decimal.Decimal
+}
+
+func NewPricingStrategy(pricing ICOPricing) (PricingStrategy, error) {
+	if pricing.IsStep() {
+		return stepPricing{}, nil
+	}
+	endPrice, err := decimal.NewFromString(pricing.EndPrice)
+	if err != nil || !endPrice.IsPositive() {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	switch pricing.Strategy {
+	case PRICING_LINEAR:
+		return linearPricing{endPrice: endPrice}, nil
+	case PRICING_EXPONENTIAL:
+		return exponentialPricing{endPrice: endPrice}, nil
+	case PRICING_DUTCH:
+		if pricing.Duration <= 0 {
+			return nil, errors.New(constant.ERROR_BAD_REQUEST)
+		}
+		return dutchPricing{endPrice: endPrice, duration: time.Duration(pricing.Duration) * time.Minute}, nil
+	}
+	return nil, errors.New(constant.ERROR_BAD_REQUEST)
+}
+
+type stepPricing struct{}
+
+func (stepPricing) Price(s *PriceState) decimal.Decimal {
+	return decimal.RequireFromString(s.SubRound.Price)
+}
+
+func (p stepPricing) Cost(s *PriceState, numToken decimal.Decimal) decimal.Decimal {
+	return numToken.Mul(p.Price(s))
+}
+
+func (p stepPricing) Tokens(s *PriceState, value, max decimal.Decimal) decimal.Decimal {
+	return decimal.Min(value.Div(p.Price(s)), max)
+}
+
+type linearPricing struct {
+	endPrice decimal.Decimal
+}
+
+func (p linearPricing) priceAt(s *PriceState, sold decimal.Decimal) decimal.Decimal {
+	start := decimal.RequireFromString(s.Round.Price)
+	return start.Add(p.endPrice.Sub(start).Mul(sold).Div(decimal.RequireFromString(s.Round.NumToken)))
+}
+
+func (p linearPricing) Price(s *PriceState) decimal.Decimal {
+	return p.priceAt(s, s.Sold)
+}
+
+func (p linearPricing) Cost(s *PriceState, numToken decimal.Decimal) decimal.Decimal {
+	return numToken.Mul(p.priceAt(s, s.Sold).Add(p.priceAt(s, s.Sold.Add(numToken)))).Div(decimal.NewFromInt(2))
+}
+
+func (p linearPricing) Tokens(s *PriceState, value, max decimal.Decimal) decimal.Decimal {
+	return curveTokens(p, s, value, max)
+}
+
+// exponentialPricing multiplies the price by the same factor for every token
+// sold, so it reaches endPrice on the last token of the round.
+type exponentialPricing struct {
+	endPrice decimal.Decimal
+}
+
+func (p exponentialPricing) growth(s *PriceState) float64 {
+	return math.Log(p.endPrice.Div(decimal.RequireFromString(s.Round.Price)).InexactFloat64()) / decimal.RequireFromString(s.Round.NumToken).InexactFloat64()
+}
+
+func (p exponentialPricing) priceAt(s *PriceState, sold decimal.Decimal) decimal.Decimal {
+	start := decimal.RequireFromString(s.Round.Price)
+	return start.Mul(decimal.NewFromFloat(math.Exp(p.growth(s) * sold.InexactFloat64())))
+}
+
+func (p exponentialPricing) Price(s *PriceState) decimal.Decimal {
+	return p.priceAt(s, s.Sold)
+}
+
+func (p exponentialPricing) Cost(s *PriceState, numToken decimal.Decimal) decimal.Decimal {
+	growth := p.growth(s)
+	if growth == 0 {
+		return numToken.Mul(p.priceAt(s, s.Sold))
+	}
+	return p.priceAt(s, s.Sold.Add(numToken)).Sub(p.priceAt(s, s.Sold)).Div(decimal.NewFromFloat(growth))
+}
+
+func (p exponentialPricing) Tokens(s *PriceState, value, max decimal.Decimal) decimal.Decimal {
+	return curveTokens(p, s, value, max)
+}
+
+type dutchPricing struct {
+	endPrice decimal.Decimal
+	duration time.Duration
+}
+
+func (p dutchPricing) Price(s *PriceState) decimal.Decimal {
+	start := decimal.RequireFromString(s.Round.Price)
+	elapsed := s.Now.Sub(s.StartedAt)
+	if s.StartedAt.IsZero() || elapsed <= 0 {
+		return start
+	}
+	if elapsed >= p.duration {
+		return p.endPrice
+	}
+	return start.Sub(start.Sub(p.endPrice).Mul(decimal.NewFromInt(int64(elapsed))).Div(decimal.NewFromInt(int64(p.duration))))
+}
+
+func (p dutchPricing) Cost(s *PriceState, numToken decimal.Decimal) decimal.Decimal {
+	return numToken.Mul(p.Price(s))
+}
+
+func (p dutchPricing) Tokens(s *PriceState, value, max decimal.Decimal) decimal.Decimal {
+	return decimal.Min(value.Div(p.Price(s)), max)
+}
+
+// curveTokens finds by bisection how many tokens value buys on a curve.
+func curveTokens(strategy PricingStrategy, s *PriceState, value, max decimal.Decimal) decimal.Decimal {
+	if !strategy.Cost(s, max).GreaterThan(value) {
+		return max
+	}
+	low, high := decimal.Zero, max
+	for i := 0; i < pricingSteps; i++ {
+		mid := low.Add(high).Div(decimal.NewFromInt(2))
+		if strategy.Cost(s, mid).GreaterThan(value) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return low.Truncate(int32(decimal.DivisionPrecision))
+}
+
+// priceState is the pricing of round and its state while subRound runs.
+func (uc *ICOUsecase) priceState(ctx context.Context, round *ICORound, subRound *ICOSubRound) (PricingStrategy, *PriceState, error) {
+	strategy, err := NewPricingStrategy(round.Pricing)
+	if err != nil {
+		return nil, nil, err
+	}
+	state := &PriceState{Round: round, SubRound: subRound, Sold: decimal.Zero, Now: time.Now()}
+	if round.Pricing.IsStep() {
+		return strategy, state, nil
+	}
+
+	subRounds, err := uc.repo.GetSubRounds(ctx, round.RoundId)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, s := range subRounds {
+		if s.ID == subRound.ID {
+			s = subRound
+		}
+		state.Sold = state.Sold.Add(decimal.RequireFromString(s.BoughtToken))
+		if !s.StartAt.IsZero() && (state.StartedAt.IsZero() || s.StartAt.Before(state.StartedAt)) {
+			state.StartedAt = s.StartAt
+		}
+	}
+	return strategy, state, nil
+}
+
+// GetRate quotes the rate of symbol for the next token sold, symbol per token.
+func (uc *ICOUsecase) GetRate(ctx context.Context, symbol string) (*CurrencyRate, error) {
+	currency, err := uc.currencyRateRepo.GetCurrencyRate(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	subRound, err := uc.repo.GetCurrentSubRound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	price, err := uc.GetPrice(ctx, subRound)
+	if err != nil {
+		return nil, err
+	}
+	rate := decimal.RequireFromString(currency.Rate).Mul(price).Div(decimal.RequireFromString(subRound.Price))
+	return &CurrencyRate{Symbol: currency.Symbol, Rate: rate.String()}, nil
+}
+
+// GetPrice quotes the price of the next token sold in subRound.
+func (uc *ICOUsecase) GetPrice(ctx context.Context, subRound *ICOSubRound) (decimal.Decimal, error) {
+	round, err := uc.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	strategy, state, err := uc.priceState(ctx, round, subRound)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return strategy.Price(state), nil
+}
+
+func validatePricing(round *ICORound) error {
+	if round.Pricing.IsStep() {
+		return nil
+	}
+	if _, err := NewPricingStrategy(round.Pricing); err != nil {
+		return err
+	}
+	if round.Pricing.Strategy == PRICING_DUTCH && !decimal.RequireFromString(round.Pricing.EndPrice).LessThan(decimal.RequireFromString(round.Price)) {
+		// an auction only goes down
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
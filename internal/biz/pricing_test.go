@@ -0,0 +1,114 @@
+package biz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+var epsilon = decimal.RequireFromString("0.000001")
+
+func near(got, want decimal.Decimal) bool {
+	return got.Sub(want).Abs().LessThan(epsilon)
+}
+
+func TestNewPricingStrategy(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing biz.ICOPricing
+		wantErr bool
+	}{
+		{"default is step", biz.ICOPricing{}, false},
+		{"step", biz.ICOPricing{Strategy: biz.PRICING_STEP}, false},
+		{"linear", biz.ICOPricing{Strategy: biz.PRICING_LINEAR, EndPrice: "2"}, false},
+		{"exponential", biz.ICOPricing{Strategy: biz.PRICING_EXPONENTIAL, EndPrice: "2"}, false},
+		{"dutch", biz.ICOPricing{Strategy: biz.PRICING_DUTCH, EndPrice: "0.5", Duration: 10}, false},
+		{"no end price", biz.ICOPricing{Strategy: biz.PRICING_LINEAR}, true},
+		{"zero end price", biz.ICOPricing{Strategy: biz.PRICING_LINEAR, EndPrice: "0"}, true},
+		{"dutch without duration", biz.ICOPricing{Strategy: biz.PRICING_DUTCH, EndPrice: "0.5"}, true},
+		{"unknown strategy", biz.ICOPricing{Strategy: "spiral", EndPrice: "2"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := biz.NewPricingStrategy(tt.pricing)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPricingStrategy(%+v) error = %v, want error %v", tt.pricing, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPricingStrategies(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	state := func(sold string, elapsed time.Duration) *biz.PriceState {
+		return &biz.PriceState{
+			Round:     &biz.ICORound{Price: "1", NumToken: "1000"},
+			SubRound:  &biz.ICOSubRound{Price: "0.5"},
+			Sold:      decimal.RequireFromString(sold),
+			StartedAt: start,
+			Now:       start.Add(elapsed),
+		}
+	}
+	linear := biz.ICOPricing{Strategy: biz.PRICING_LINEAR, EndPrice: "2"}
+	exponential := biz.ICOPricing{Strategy: biz.PRICING_EXPONENTIAL, EndPrice: "2"}
+	dutch := biz.ICOPricing{Strategy: biz.PRICING_DUTCH, EndPrice: "0.5", Duration: 10}
+
+	tests := []struct {
+		name     string
+		pricing  biz.ICOPricing
+		state    *biz.PriceState
+		numToken string
+		// price of the next token and cost of the next numToken tokens
+		price, cost string
+	}{
+		{"step sells at the sub-round price", biz.ICOPricing{}, state("0", 0), "10", "0.5", "5"},
+		{"step ignores what was sold", biz.ICOPricing{}, state("900", time.Hour), "10", "0.5", "5"},
+		{"linear starts at the round price", linear, state("0", 0), "1000", "1", "1500"},
+		{"linear halfway", linear, state("500", 0), "100", "1.5", "155"},
+		{"exponential starts at the round price", exponential, state("0", 0), "1000", "1", "1442.6950409"},
+		{"exponential ends at the end price", exponential, state("1000", 0), "0", "2", "0"},
+		{"dutch before the start", dutch, state("0", -time.Minute), "10", "1", "10"},
+		{"dutch halfway", dutch, state("0", 5*time.Minute), "10", "0.75", "7.5"},
+		{"dutch after its duration", dutch, state("0", time.Hour), "10", "0.5", "5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := biz.NewPricingStrategy(tt.pricing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strategy.Price(tt.state); !near(got, decimal.RequireFromString(tt.price)) {
+				t.Errorf("Price = %s, want %s", got, tt.price)
+			}
+			numToken := decimal.RequireFromString(tt.numToken)
+			cost := strategy.Cost(tt.state, numToken)
+			if !near(cost, decimal.RequireFromString(tt.cost)) {
+				t.Errorf("Cost(%s) = %s, want %s", tt.numToken, cost, tt.cost)
+			}
+			// what a cost buys is the tokens it was the cost of
+			if got := strategy.Tokens(tt.state, cost, decimal.NewFromInt(1000)); !near(got, numToken) {
+				t.Errorf("Tokens(%s) = %s, want %s", cost, got, tt.numToken)
+			}
+		})
+	}
+}
+
+func TestPricingTokensUpToMax(t *testing.T) {
+	state := &biz.PriceState{Round: &biz.ICORound{Price: "1", NumToken: "1000"}, SubRound: &biz.ICOSubRound{Price: "1"}, Sold: decimal.Zero}
+	for _, pricing := range []biz.ICOPricing{
+		{},
+		{Strategy: biz.PRICING_LINEAR, EndPrice: "2"},
+		{Strategy: biz.PRICING_EXPONENTIAL, EndPrice: "2"},
+		{Strategy: biz.PRICING_DUTCH, EndPrice: "0.5", Duration: 10},
+	} {
+		strategy, err := biz.NewPricingStrategy(pricing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strategy.Tokens(state, decimal.NewFromInt(1000000), decimal.NewFromInt(50)); !got.Equal(decimal.NewFromInt(50)) {
+			t.Errorf("%q: Tokens = %s, want the 50 left", pricing.Strategy, got)
+		}
+	}
+}
@@ -8,12 +8,15 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/wallet-service/internal/constant"
-	"github.com/shopspring/decimal"
+	"github.com/rs/xid"
 )
 
 const (
 	QUEUE_PREFIX      = "ico-subround:"
 	ENDROUND_SCHEDULE = "endround"
+	// ENDROUND_MAX_RETRY is how many times a failed end of sub-round is
+	// retried, the sub-round stays open until it succeeds.
+	ENDROUND_MAX_RETRY = 10
 )
 
 type Task struct {
@@ -21,6 +24,13 @@ type Task struct {
 	Data      string
 	Name      string
 	ProcessAt time.Time
+	// MaxRetry is how many times the task is retried when it fails, 1 when 0.
+	MaxRetry int
+}
+
+// NewEndRoundTask is the task ending subRound at its end.
+func NewEndRoundTask(subRound *ICOSubRound) *Task {
+	return &Task{Data: subRound.ID.String(), Name: fmt.Sprintf("%s%s", QUEUE_PREFIX, ENDROUND_SCHEDULE), ProcessAt: subRound.EndAt, MaxRetry: ENDROUND_MAX_RETRY}
 }
 
 type QueueJob interface {
@@ -36,16 +46,18 @@ type QueueRunner struct {
 	transRepo  TransactionRepo
 	lockRepo   LockRepo
 	icoUc      *ICOUsecase
+	publisher  TransactionPublisher
 	log        *log.Helper
 }
 
-func NewQueueRunner(repo ICORepo, walletRepo UserWalletRepo, transRepo TransactionRepo, icoUc *ICOUsecase, lockRepo LockRepo) *QueueRunner {
+func NewQueueRunner(repo ICORepo, walletRepo UserWalletRepo, transRepo TransactionRepo, icoUc *ICOUsecase, lockRepo LockRepo, publisher TransactionPublisher) *QueueRunner {
 	return &QueueRunner{
 		repo:       repo,
 		walletRepo: walletRepo,
 		transRepo:  transRepo,
 		lockRepo:   lockRepo,
 		icoUc:      icoUc,
+		publisher:  publisher,
 		log:        log.NewHelper(log.DefaultLogger),
 	}
 }
@@ -54,52 +66,89 @@ func (r *QueueRunner) GetICOCurrentRound(ctx context.Context) (*ICOSubRound, err
 	return r.repo.GetCurrentSubRound(ctx)
 }
 
+// Execute ends the sub-round of an endround task and settles its unsold
+// tokens. A failure rolls both back and is returned, for the queue to retry.
 func (q *QueueRunner) Execute(ctx context.Context, task *Task) error {
-	err := q.lockRepo.Lock(ctx, constant.ICO_LOCK)
+	taskName := strings.Replace(task.Name, QUEUE_PREFIX, "", 1)
+	if taskName != ENDROUND_SCHEDULE {
+		return nil
+	}
+	id, err := xid.FromString(task.Data)
 	if err != nil {
-		q.log.Error("ICOTransaction ", err)
+		q.log.Error("Execute End Round ", err)
+		return err
+	}
+
+	// The row lock below already serializes with purchases, the lock only
+	// keeps two workers from running the same sub-round at once.
+	lockKey := fmt.Sprintf("%s:%s", constant.ICO_LOCK, task.Data)
+	token, err := q.lockRepo.Lock(ctx, lockKey)
+	if err != nil {
+		q.log.Error("Execute End Round ", err)
+		return err
 	}
 	defer func() {
-		q.lockRepo.UnLock(ctx, constant.ICO_LOCK)
+		q.lockRepo.UnLock(ctx, token, lockKey)
 	}()
-	taskName := strings.Replace(task.Name, QUEUE_PREFIX, "", 1)
-	if taskName == ENDROUND_SCHEDULE {
-		subRound, err := q.repo.GetSubRoundById(ctx, task.Data)
+
+	err = withEvents(ctx, q.repo, q.publisher, func(ctx context.Context) error {
+		subRound, err := q.repo.LockSubRound(ctx, id)
 		if err != nil {
 			return err
 		}
-		endedTime := time.Since(subRound.EndAt)
-		if !subRound.IsEnded && endedTime >= 0 {
-			err := q.icoUc.CloseSubRound(ctx, subRound)
-			if err != nil {
-				q.log.Error("Execute End Round ", err)
-				return nil
-			}
-
-			q.repo.WithTx(ctx, func(ctx context.Context) error {
-				amount := decimal.RequireFromString(subRound.TotalToken).Sub(decimal.RequireFromString(subRound.BoughtToken)).String()
-				rs, err := q.walletRepo.DecreaseBalance(ctx, constant.WALLET_ICO, constant.TokenSymbolIND, amount, constant.WALLET_TYPE_SYSTEM)
-				if err != nil || rs == 0 {
-					q.log.Error("DecreaseBalance ", err)
-					return nil
-				}
-
-				rs, err = q.walletRepo.IncreaseBalance(ctx, constant.WALLET_SYS_ICO_BACKUP, constant.TokenSymbolIND, amount, constant.WALLET_TYPE_SYSTEM)
-				if err != nil || rs == 0 {
-					q.log.Error("IncreaseBalance ", err)
-					return nil
-				}
+		// a paused sub-round ends once resumed, ResumeICO queues its new end
+		if subRound.IsEnded || !subRound.PausedAt.IsZero() || time.Since(subRound.EndAt) < 0 {
+			return nil
+		}
 
-				trans := &Transaction{TransType: DEPOSITE, Source: constant.WALLET_ICO, SrcAmount: amount, SrcSymbol: constant.TokenSymbolIND, Destination: constant.WALLET_SYS_ICO_BACKUP, DestSymbol: constant.TokenSymbolIND, DestAmount: amount, Status: TRANS_STATUS, SourceId: fmt.Sprintf("%d-%d", subRound.RoundId, subRound.SubRound)}
-				_, err = q.transRepo.CreateTransaction(ctx, trans)
-				if err != nil {
-					q.log.Error("CreateTransaction ", err)
-					return nil
-				}
+		next, err := q.icoUc.CloseSubRound(ctx, subRound)
+		if err != nil {
+			return err
+		}
+		if err := q.settleUnsold(ctx, subRound, next); err != nil {
+			return err
+		}
+		return q.closeCapped(ctx, next)
+	})
+	if err != nil {
+		q.log.Error("Execute End Round ", err)
+		return err
+	}
+	return nil
+}
 
-				return nil
-			})
+// closeCapped closes next and the sub-rounds after it while their round
+// reached its hard cap, settling their unsold tokens.
+func (q *QueueRunner) closeCapped(ctx context.Context, next *ICOSubRound) error {
+	for next != nil {
+		round, err := q.repo.GetRoundByRoundId(ctx, next.RoundId)
+		if err != nil {
+			return err
+		}
+		if round.Status != ROUND_CAPPED {
+			return nil
+		}
+		subRound := next
+		if next, err = q.icoUc.CloseSubRound(ctx, subRound); err != nil {
+			return err
+		}
+		if err := q.settleUnsold(ctx, subRound, next); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// SettleTask is the task settling the round the sub-round of an endround task
+// belongs to, nil while the round goes on.
+func (q *QueueRunner) SettleTask(ctx context.Context, task *Task) (*Task, error) {
+	subRound, err := q.repo.GetSubRoundById(ctx, task.Data)
+	if err != nil {
+		return nil, err
+	}
+	round, err := q.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+	if err != nil || round.EndedAt == nil {
+		return nil, err
+	}
+	return NewSettleRoundTask(round.RoundId), nil
+}
@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// ReferralEarnings is what a user earned from their downline.
+type ReferralEarnings struct {
+	// Referrals counts the users they referred.
+	Referrals   int
+	Earnings    []*ReferralEarning
+	Commissions []*ReferralCommission
+}
+
+// ReferralUsecase pays the referrers of a coupon owner on the purchases made
+// with the coupon. The owner earns the coupon reward, tier 1, their referrer
+// the first commission rate, tier 2, and so on up the tree. Commissions are
+// debited from the SYS_ICO_REWARD wallet of the symbol paid, as the coupon
+// payouts are.
+type ReferralUsecase struct {
+	repo       ReferralRepo
+	walletRepo UserWalletRepo
+	transRepo  TransactionRepo
+	publisher  TransactionPublisher
+	log        *log.Helper
+}
+
+func NewReferralUsecase(repo ReferralRepo, walletRepo UserWalletRepo, transRepo TransactionRepo, publisher TransactionPublisher) *ReferralUsecase {
+	return &ReferralUsecase{
+		repo:       repo,
+		walletRepo: walletRepo,
+		transRepo:  transRepo,
+		publisher:  publisher,
+		log:        log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// SetReferrer records that referrerId referred userId. A user is referred
+// once, and never by their own downline.
+func (uc *ReferralUsecase) SetReferrer(ctx context.Context, userId, referrerId string) error {
+	if len(userId) == 0 || len(referrerId) == 0 || userId == referrerId {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	seen := map[string]bool{}
+	for upline := referrerId; len(upline) > 0 && !seen[upline]; {
+		seen[upline] = true
+		next, err := uc.repo.GetReferrer(ctx, upline)
+		if err != nil {
+			uc.log.Error("SetReferrer ", err)
+			return errors.New(constant.ERROR_INTERNAL)
+		}
+		if next == userId {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+		upline = next
+	}
+
+	if err := uc.repo.SetReferrer(ctx, userId, referrerId); err != nil {
+		if err.Error() == constant.ERROR_REFERRER_EXISTS {
+			return err
+		}
+		uc.log.Error("SetReferrer ", err)
+		return errors.New(constant.ERROR_INTERNAL)
+	}
+	return nil
+}
+
+// PayCommissions pays the referrers of the coupon owner on a purchase of amount
+// symbol made by buyerId with the coupon, in the purchase transaction when ctx
+// carries one. ERROR_REWARD_POOL_SHORT tells SYS_ICO_REWARD can't fund them.
+func (uc *ReferralUsecase) PayCommissions(ctx context.Context, coupon *IcoCoupon, buyerId, amount, symbol, sourceId string) error {
+	rates, err := uc.repo.GetCommissionRates(ctx)
+	if err != nil || len(rates) == 0 {
+		return err
+	}
+
+	return withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
+		referrer := coupon.UserID
+		for i, rate := range rates {
+			if referrer, err = uc.repo.GetReferrer(ctx, referrer); err != nil || len(referrer) == 0 {
+				return err
+			}
+			commission := decimal.RequireFromString(amount).Mul(decimal.RequireFromString(rate))
+			if !commission.IsPositive() {
+				continue
+			}
+			if err := uc.PayReward(ctx, ICO_REFERRAL, referrer, commission.String(), symbol, sourceId); err != nil {
+				return err
+			}
+			err = uc.repo.SaveCommission(ctx, &ReferralCommission{UserID: referrer, Level: i + 2, BuyerID: buyerId, Coupon: coupon.Coupon,
+				SourceID: sourceId, Amount: commission.String(), Symbol: symbol})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PayReward moves amount symbol from the SYS_ICO_REWARD pool to the REWARD
+// wallet of userId and records it as transType. Every coupon and referral
+// payout goes through it. What the pool can't fund is paid out of
+// SYS_ICO_PROCEEDS, which the purchases are paid into, and it fails with
+// ERROR_REWARD_POOL_SHORT when neither can.
+func (uc *ReferralUsecase) PayReward(ctx context.Context, transType, userId, amount, symbol, sourceId string) error {
+	if !decimal.RequireFromString(amount).IsPositive() {
+		return nil
+	}
+	source := constant.WALLET_SYS_ICO_REWARD
+	rs, err := uc.walletRepo.DecreaseBalance(ctx, source, symbol, amount, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if rs == 0 {
+		source = constant.WALLET_SYS_ICO_PROCEEDS
+		if rs, err = uc.walletRepo.DecreaseBalance(ctx, source, symbol, amount, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+		if rs == 0 {
+			return errors.New(constant.ERROR_REWARD_POOL_SHORT)
+		}
+		uc.log.Warnf("PayReward: the %s reward pool is short, %s %s of %s paid out of %s", symbol, transType, amount, sourceId, source)
+	}
+	if _, err := uc.wallet(ctx, userId, symbol, constant.WALLET_TYPE_REWARD); err != nil {
+		return err
+	}
+	if _, err := uc.walletRepo.IncreaseBalance(ctx, userId, symbol, amount, constant.WALLET_TYPE_REWARD); err != nil {
+		return err
+	}
+
+	trans := &Transaction{TransType: transType, Source: source, SrcAmount: amount, SrcSymbol: symbol, Destination: userId, DestSymbol: symbol, DestAmount: amount, SourceId: sourceId, Status: TRANS_STATUS}
+	trans, err = uc.transRepo.CreateTransaction(ctx, trans)
+	if err != nil {
+		return err
+	}
+	return collectEvent(ctx, uc.walletRepo, trans)
+}
+
+// FundRewardPool moves amount symbol from the system wallet from,
+// SYS_ICO_PROCEEDS when empty, to the SYS_ICO_REWARD wallet of symbol, which
+// funds the coupon and referral payouts, and returns its balance.
+func (uc *ReferralUsecase) FundRewardPool(ctx context.Context, from, amount, symbol, sourceId string) (string, error) {
+	if len(from) == 0 {
+		from = constant.WALLET_SYS_ICO_PROCEEDS
+	}
+	if value, err := decimal.NewFromString(amount); err != nil || !value.IsPositive() || len(symbol) == 0 || from == constant.WALLET_SYS_ICO_REWARD {
+		return "", errors.New(constant.ERROR_BAD_REQUEST)
+	}
+
+	var balance string
+	err := withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
+		source, err := uc.walletRepo.GetWalletByUserId(ctx, from, symbol, constant.WALLET_TYPE_SYSTEM)
+		if err != nil {
+			return err
+		}
+		if len(source) == 0 {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+		rs, err := uc.walletRepo.DecreaseBalance(ctx, from, symbol, amount, constant.WALLET_TYPE_SYSTEM)
+		if err != nil {
+			return err
+		}
+		if rs == 0 {
+			return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+		}
+
+		if _, err := uc.wallet(ctx, constant.WALLET_SYS_ICO_REWARD, symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+		if _, err := uc.walletRepo.IncreaseBalance(ctx, constant.WALLET_SYS_ICO_REWARD, symbol, amount, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+		trans := &Transaction{TransType: ICO_REWARD_FUND, Source: from, SrcAmount: amount, SrcSymbol: symbol, Destination: constant.WALLET_SYS_ICO_REWARD, DestSymbol: symbol, DestAmount: amount, SourceId: sourceId, Status: TRANS_STATUS}
+		trans, err = uc.transRepo.CreateTransaction(ctx, trans)
+		if err != nil {
+			return err
+		}
+		wallet, err := uc.wallet(ctx, constant.WALLET_SYS_ICO_REWARD, symbol, constant.WALLET_TYPE_SYSTEM)
+		if err != nil {
+			return err
+		}
+		balance = wallet.Balance
+		return collectEvent(ctx, uc.walletRepo, trans)
+	})
+	if err != nil {
+		if err.Error() == constant.ERROR_NOT_FOUND || err.Error() == constant.ERROR_BALANCE_NOT_ENOUGH {
+			return "", err
+		}
+		uc.log.Error("FundRewardPool ", err)
+		return "", errors.New(constant.ERROR_INTERNAL)
+	}
+	return balance, nil
+}
+
+// wallet returns the wallet of userId, creating it the first time.
+func (uc *ReferralUsecase) wallet(ctx context.Context, userId, symbol, walletType string) (*UserWallet, error) {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, userId, symbol, walletType)
+	if err != nil {
+		return nil, err
+	}
+	if len(wallets) > 0 {
+		return wallets[0], nil
+	}
+	return uc.walletRepo.CreateWallet(ctx, userId, symbol, walletType)
+}
+
+// GetEarnings sums the commissions userId earned and pages them, newest first.
+func (uc *ReferralUsecase) GetEarnings(ctx context.Context, userId, nextCursor string, limit int32) (*ReferralEarnings, string, error) {
+	if limit <= 0 || limit > constant.DEFAULT_LIMIT {
+		limit = constant.DEFAULT_LIMIT
+	}
+	referrals, err := uc.repo.CountReferrals(ctx, userId)
+	if err != nil {
+		uc.log.Error("GetEarnings ", err)
+		return nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+	earnings, err := uc.repo.GetEarnings(ctx, userId)
+	if err != nil {
+		uc.log.Error("GetEarnings ", err)
+		return nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+	commissions, next, err := uc.repo.GetCommissions(ctx, userId, nextCursor, limit)
+	if err != nil {
+		uc.log.Error("GetEarnings ", err)
+		return nil, "", errors.New(constant.ERROR_INTERNAL)
+	}
+	return &ReferralEarnings{Referrals: referrals, Earnings: earnings, Commissions: commissions}, next, nil
+}
+
+// ReferralRates checks the commission rates of the config and keeps the first
+// maxDepth of them, all of them when it is 0.
+func ReferralRates(commissions []string, maxDepth int) ([]string, error) {
+	for _, rate := range commissions {
+		if value, err := decimal.NewFromString(rate); err != nil || value.IsNegative() {
+			return nil, fmt.Errorf("bad referral commission %q", rate)
+		}
+	}
+	if maxDepth > 0 && maxDepth < len(commissions) {
+		commissions = commissions[:maxDepth]
+	}
+	return commissions, nil
+}
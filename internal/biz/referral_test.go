@@ -0,0 +1,198 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+// fundPool funds the USDT reward pool with amount, out of a treasury wallet.
+func (s *sale) fundPool(t *testing.T, amount string) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := s.wr.CreateWallet(ctx, "SYS_TREASURY", "USDT", constant.WALLET_TYPE_SYSTEM); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.wr.IncreaseBalance(ctx, "SYS_TREASURY", "USDT", amount, constant.WALLET_TYPE_SYSTEM); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.referral.FundRewardPool(ctx, "SYS_TREASURY", amount, "USDT", "fund"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (s *sale) rewards(t *testing.T, users ...string) map[string]string {
+	t.Helper()
+	rs := map[string]string{}
+	for _, userId := range users {
+		if balance := s.balance(t, userId, "USDT", constant.WALLET_TYPE_REWARD); !decimal.RequireFromString(balance).IsZero() {
+			rs[userId] = balance
+		}
+	}
+	rs["pool"] = s.balance(t, constant.WALLET_SYS_ICO_REWARD, "USDT", constant.WALLET_TYPE_SYSTEM)
+	return rs
+}
+
+func sameRewards(got, want map[string]string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for k, v := range want {
+		if _, ok := got[k]; !ok || !decimal.RequireFromString(got[k]).Equal(decimal.RequireFromString(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPayCommissions(t *testing.T) {
+	// D owns the coupon, C referred D, B referred C and A referred B
+	tree := map[string]string{"D": "C", "C": "B", "B": "A"}
+	tests := []struct {
+		name     string
+		referral *conf.Referral
+		pool     string
+		referrer map[string]string
+		wantErr  string
+		// the reward balances after, the pool under "pool"
+		want map[string]string
+	}{
+		{name: "up to the max depth", referral: &conf.Referral{Commissions: []string{"0.03", "0.02", "0.01"}, MaxDepth: 2}, pool: "100", referrer: tree,
+			want: map[string]string{"C": "30", "B": "20", "pool": "50"}},
+		{name: "every rate", referral: &conf.Referral{Commissions: []string{"0.03", "0.02", "0.01"}}, pool: "100", referrer: tree,
+			want: map[string]string{"C": "30", "B": "20", "A": "10", "pool": "40"}},
+		{name: "up the tree there is", referral: &conf.Referral{Commissions: []string{"0.03", "0.02"}}, pool: "100", referrer: map[string]string{"D": "C"},
+			want: map[string]string{"C": "30", "pool": "70"}},
+		{name: "no referrer", referral: &conf.Referral{Commissions: []string{"0.03"}}, pool: "100",
+			want: map[string]string{"pool": "100"}},
+		{name: "no commissions", referral: &conf.Referral{}, pool: "100", referrer: tree,
+			want: map[string]string{"pool": "100"}},
+		{name: "a zero rate is skipped", referral: &conf.Referral{Commissions: []string{"0", "0.02"}}, pool: "100", referrer: tree,
+			want: map[string]string{"B": "20", "pool": "80"}},
+		{name: "all or nothing on a short pool", referral: &conf.Referral{Commissions: []string{"0.03", "0.02"}}, pool: "40", referrer: tree,
+			wantErr: constant.ERROR_REWARD_POOL_SHORT, want: map[string]string{"pool": "40"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{Referral: tt.referral})
+			s.fundPool(t, tt.pool)
+			for userId, referrerId := range tt.referrer {
+				if err := s.referral.SetReferrer(ctx, userId, referrerId); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			coupon := &biz.IcoCoupon{UserID: "D", Coupon: "DCOUPON"}
+			err := s.referral.PayCommissions(ctx, coupon, "buyer", "1000", "USDT", "p1")
+			if got := limitReason(err); got != tt.wantErr {
+				t.Fatalf("PayCommissions = %q, want %q", got, tt.wantErr)
+			}
+			if got := s.rewards(t, "A", "B", "C", "D", "buyer"); !sameRewards(got, tt.want) {
+				t.Errorf("rewards %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// The coupon payouts and the commissions are paid with the purchase, out of
+// the proceeds of the purchases what the pool can't fund.
+func TestBuyICOPaysFromThePool(t *testing.T) {
+	tests := []struct {
+		name string
+		// the pool before, not funded when empty
+		pool string
+		want map[string]string
+		// SYS_ICO_PROCEEDS after the 1000 USDT paid
+		wantProceeds string
+	}{
+		{name: "funded", pool: "100", want: map[string]string{"D": "50", "buyer": "20", "C": "30", "pool": "0"}, wantProceeds: "1000"},
+		// the reward and the cashback leave 29, the commission is paid out of the proceeds
+		{name: "short", pool: "99", want: map[string]string{"D": "50", "buyer": "20", "C": "30", "pool": "29"}, wantProceeds: "970"},
+		{name: "empty", want: map[string]string{"D": "50", "buyer": "20", "C": "30", "pool": "0"}, wantProceeds: "900"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{Referral: &conf.Referral{Commissions: []string{"0.03"}}})
+			if len(tt.pool) > 0 {
+				s.fundPool(t, tt.pool)
+			}
+			if err := s.referral.SetReferrer(ctx, "D", "C"); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.ico.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: "D", Coupon: "DCOUPON", Reward: "0.05", Cashback: "0.02"}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := s.trans.BuyICO(ctx, "buyer", "1000", "USDT", "p1", "DCOUPON"); err != nil {
+				t.Fatal(err)
+			}
+			if got := s.rewards(t, "C", "D", "buyer"); !sameRewards(got, tt.want) {
+				t.Errorf("rewards %v, want %v", got, tt.want)
+			}
+			if proceeds := s.balance(t, constant.WALLET_SYS_ICO_PROCEEDS, "USDT", constant.WALLET_TYPE_SYSTEM); !decimal.RequireFromString(proceeds).Equal(decimal.RequireFromString(tt.wantProceeds)) {
+				t.Errorf("proceeds %s, want %s", proceeds, tt.wantProceeds)
+			}
+
+			// the refund takes the payouts back to the pool and the payment out of the proceeds
+			if _, err := s.refund.RefundICOPurchase(ctx, "buyer", "p1"); err != nil {
+				t.Fatal(err)
+			}
+			pool := decimal.RequireFromString(s.balance(t, constant.WALLET_SYS_ICO_REWARD, "USDT", constant.WALLET_TYPE_SYSTEM))
+			proceeds := decimal.RequireFromString(s.balance(t, constant.WALLET_SYS_ICO_PROCEEDS, "USDT", constant.WALLET_TYPE_SYSTEM))
+			if funded, _ := decimal.NewFromString(tt.pool); !pool.Add(proceeds).Equal(funded) {
+				t.Errorf("pool %s and proceeds %s after the refund, want %s together", pool, proceeds, funded)
+			}
+		})
+	}
+}
+
+func TestFundRewardPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		amount  string
+		wantErr string
+	}{
+		{name: "from a system wallet", from: "SYS_TREASURY", amount: "10"},
+		{name: "from the proceeds by default", amount: "10"},
+		{name: "more than the wallet holds", from: "SYS_TREASURY", amount: "11", wantErr: constant.ERROR_BALANCE_NOT_ENOUGH},
+		{name: "from a wallet without the symbol", from: constant.WALLET_SYS_MARKETING, amount: "1", wantErr: constant.ERROR_NOT_FOUND},
+		{name: "from the pool itself", from: constant.WALLET_SYS_ICO_REWARD, amount: "1", wantErr: constant.ERROR_BAD_REQUEST},
+		{name: "not a positive amount", from: "SYS_TREASURY", amount: "0", wantErr: constant.ERROR_BAD_REQUEST},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newSale(t, &conf.Data{})
+			if _, err := s.wr.CreateWallet(ctx, "SYS_TREASURY", "USDT", constant.WALLET_TYPE_SYSTEM); err != nil {
+				t.Fatal(err)
+			}
+			for _, userId := range []string{"SYS_TREASURY", constant.WALLET_SYS_ICO_PROCEEDS} {
+				if _, err := s.wr.IncreaseBalance(ctx, userId, "USDT", "10", constant.WALLET_TYPE_SYSTEM); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			balance, err := s.referral.FundRewardPool(ctx, tt.from, tt.amount, "USDT", "fund")
+			if got := limitReason(err); got != tt.wantErr {
+				t.Fatalf("FundRewardPool = %q, want %q", got, tt.wantErr)
+			}
+			// what the pool gains the other wallets lose, nothing is minted
+			pool := decimal.RequireFromString(s.balance(t, constant.WALLET_SYS_ICO_REWARD, "USDT", constant.WALLET_TYPE_SYSTEM))
+			treasury := decimal.RequireFromString(s.balance(t, "SYS_TREASURY", "USDT", constant.WALLET_TYPE_SYSTEM))
+			proceeds := decimal.RequireFromString(s.balance(t, constant.WALLET_SYS_ICO_PROCEEDS, "USDT", constant.WALLET_TYPE_SYSTEM))
+			if !pool.Add(treasury).Add(proceeds).Equal(decimal.NewFromInt(20)) {
+				t.Errorf("pool %s, treasury %s and proceeds %s, want 20 together", pool, treasury, proceeds)
+			}
+			if len(tt.wantErr) == 0 && !decimal.RequireFromString(balance).Equal(pool) {
+				t.Errorf("balance %s, want the pool's %s", balance, pool)
+			}
+		})
+	}
+}
@@ -15,7 +15,18 @@ type ICORound struct {
 	NumToken  string
 	PriceGap  string
 	NumSub    int32
-	EndedAt   *time.Time
+	// Lifetime is how many minutes each sub-round runs, 0 for SUBROUND_LIFETIME.
+	Lifetime int32
+	EndedAt  *time.Time
+	Limits   ICOLimits
+	Pricing  ICOPricing
+	Unsold   ICOUnsold
+	// SoftCap and HardCap bound the value the round raises, in the unit of
+	// its price, empty for no bound. Status is ROUND_CAPPED, ROUND_FAILED or
+	// ROUND_ENDED once set, see State.
+	SoftCap string
+	HardCap string
+	Status  string
 }
 
 type ICOSubRound struct {
@@ -26,18 +37,29 @@ type ICOSubRound struct {
 	Price       string
 	BoughtToken string
 	TotalToken  string
+	StartAt     time.Time
 	EndAt       time.Time
-	IsEnded     bool
+	// Lifetime is how many minutes the sub-round runs, 0 for the lifetime of its round.
+	Lifetime int32
+	IsEnded  bool
+	// PausedAt is when the sale was paused, zero while it runs.
+	PausedAt time.Time
 }
 
 type ICOHistory struct {
-	ID       xid.ID
-	RoundId  int32
-	SubRound int32
-	UserId   string
-	Price    string
-	NumToken string
-	Type     string
+	ID        xid.ID
+	CreatedAt time.Time
+	RoundId   int32
+	SubRound  int32
+	UserId    string
+	Price     string
+	NumToken  string
+	Type      string
+	// SourceId is the payment of the purchase, Amount what it paid in Symbol
+	// for these tokens. Empty on purchases made before they were recorded.
+	SourceId string
+	Symbol   string
+	Amount   string
 }
 
 type ICOUserBought struct {
@@ -46,13 +68,23 @@ type ICOUserBought struct {
 	NumToken string
 }
 
+// UserTierRepo tells the tier of a user, the purchase limits of a round can
+// vary by tier.
+type UserTierRepo interface {
+	GetUserTier(ctx context.Context, userId string) (string, error)
+}
+
 type Tx interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type LockRepo interface {
-	Lock(ctx context.Context, key string) error
-	UnLock(ctx context.Context, key string) error
+	// Lock waits until every key is free, takes them in order and returns the
+	// token that owns them. A caller takes all the locks it needs in one call,
+	// it never waits for a lock while holding one.
+	Lock(ctx context.Context, keys ...string) (string, error)
+	// UnLock releases keys, as long as token still owns them.
+	UnLock(ctx context.Context, token string, keys ...string) error
 }
 
 type ICORepo interface {
@@ -64,15 +96,133 @@ type ICORepo interface {
 
 	GetCurrentSubRound(context.Context) (*ICOSubRound, error)
 	CloseSubRound(ctx context.Context, roundId, subRound int32, boughtToken string) (*ICOSubRound, error)
-	UpdateSubRoundBought(ctx context.Context, roundId, subRound int32, boughtToken string) error
+	// TakeSubRoundToken atomically adds numToken to bought_token of an open
+	// sub-round, if it still fits. It reports false when it did not.
+	TakeSubRoundToken(ctx context.Context, id xid.ID, numToken string) (bool, error)
+	// LockSubRound reads a sub-round holding its row lock until the transaction ends.
+	LockSubRound(ctx context.Context, id xid.ID) (*ICOSubRound, error)
 	GetSubRoundById(ctx context.Context, id string) (*ICOSubRound, error)
 
+	// SaveRound creates round RoundId or updates it.
+	SaveRound(ctx context.Context, input *ICORound) error
+	DeleteRound(ctx context.Context, roundId int32) error
+	// GetSubRounds returns the sub-rounds of roundId, of every round when it is 0.
+	GetSubRounds(ctx context.Context, roundId int32) ([]*ICOSubRound, error)
+	// SaveSubRound creates the sub-round when ID is zero and sets its ID, it
+	// updates its price, tokens, times and lifetime otherwise.
+	SaveSubRound(ctx context.Context, input *ICOSubRound) error
+	// SetSubRoundEnd moves the end of an open sub-round.
+	SetSubRoundEnd(ctx context.Context, id xid.ID, endAt time.Time) error
+	// PauseSubRound marks an open sub-round paused since pausedAt.
+	PauseSubRound(ctx context.Context, id xid.ID, pausedAt time.Time) error
+	// ResumeSubRound clears the pause of a sub-round and moves its end.
+	ResumeSubRound(ctx context.Context, id xid.ID, endAt time.Time) error
+	DeleteSubRound(ctx context.Context, id xid.ID) error
+
 	InitData(ctx context.Context, startTime time.Time) error
 	GetBuyICOUser(ctx context.Context, limit, offset int) ([]*ICOUserBought, error)
 	GetBuyICOTotalUser(ctx context.Context) (int, error)
+	// GetUserHistories pages the histories of userId, newest first, from the
+	// cursor returned with the previous page. All of them when limit is 0.
+	GetUserHistories(ctx context.Context, userId, cursor string, limit int32) ([]*ICOHistory, string, error)
+	// GetUserBoughtToken sums the tokens userId bought in round roundId.
+	GetUserBoughtToken(ctx context.Context, userId string, roundId int32) (string, error)
+	// GetRoundRaised sums price times tokens over the histories of roundId,
+	// refunds take theirs off.
+	GetRoundRaised(ctx context.Context, roundId int32) (string, error)
+	// SetRoundStatus sets the status of roundId, SaveRound leaves it.
+	SetRoundStatus(ctx context.Context, roundId int32, status string) error
 	// WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+// LeaderboardRepo ranks the users by the tokens they bought, biggest buyer first.
+type LeaderboardRepo interface {
+	// AddUserToken adds numToken to the tokens userId bought.
+	AddUserToken(ctx context.Context, userId, numToken string) error
+	GetRanks(ctx context.Context, offset, limit int) ([]*ICOUserBought, error)
+	// GetUserRank returns nil when userId bought nothing.
+	GetUserRank(ctx context.Context, userId string) (*ICOUserBought, error)
+	CountUsers(ctx context.Context) (int, error)
+	// Replace swaps the whole leaderboard for users at once.
+	Replace(ctx context.Context, users []*ICOUserBought) error
+	// SaveSnapshot keeps users as the leaderboard at takenAt, once.
+	SaveSnapshot(ctx context.Context, takenAt time.Time, users []*ICOUserBought) error
+	// GetSnapshot returns the snapshot taken at takenAt, the latest one when it
+	// is zero, and when it was taken. Nil when there is none.
+	GetSnapshot(ctx context.Context, takenAt time.Time) ([]*ICOUserBought, time.Time, error)
+}
+
+// ICORefundRepo reads the purchases to refund and gives their tokens back to
+// the sub-rounds.
+type ICORefundRepo interface {
+	// GetRefundWindow is how long after a purchase it can be refunded, 0 when
+	// none can.
+	GetRefundWindow(ctx context.Context) time.Duration
+	// GetPaymentHistories returns the histories of payment sourceId, its
+	// refund too, oldest first.
+	GetPaymentHistories(ctx context.Context, sourceId string) ([]*ICOHistory, error)
+	// ReturnSubRoundToken takes numToken off bought_token of a sub-round,
+	// locked by LockSubRound.
+	ReturnSubRoundToken(ctx context.Context, id xid.ID, numToken string) error
+	// GetRoundHistories returns the histories of roundId, oldest first.
+	GetRoundHistories(ctx context.Context, roundId int32) ([]*ICOHistory, error)
+	// SaveOutstandingRefund records a payment the settlement could not refund,
+	// once per payment.
+	SaveOutstandingRefund(ctx context.Context, refund *ICOOutstandingRefund) error
+	// DeleteOutstandingRefund drops the record of payment sourceId, if any.
+	DeleteOutstandingRefund(ctx context.Context, sourceId string) error
+	// GetOutstandingRefunds returns the outstanding refunds of roundId, of
+	// every round when 0, oldest first.
+	GetOutstandingRefunds(ctx context.Context, roundId int32) ([]*ICOOutstandingRefund, error)
+}
+
+// ICO stats
+
+// ICOVolume sums a set of ICO purchases. A purchase is a payment, the
+// histories of a payment share their source_id. Raised is in the unit of the
+// prices.
+type ICOVolume struct {
+	Purchases int
+	Buyers    int
+	NumToken  string
+	Raised    string
+}
+
+type ICORoundStats struct {
+	RoundId  int32
+	SubRound int32
+	ICOVolume
+}
+
+// ICOCurrencyStats is the volume paid with a symbol, Amount is what was paid in it.
+type ICOCurrencyStats struct {
+	Symbol string
+	Amount string
+	ICOVolume
+}
+
+// ICODailyStats is the volume of a day, UTC. Coupon is the part of it paid with a coupon.
+type ICODailyStats struct {
+	Day    time.Time
+	Total  ICOVolume
+	Coupon ICOVolume
+}
+
+// ICOStatsRepo aggregates the purchases created in [from, to), a zero bound
+// leaves the range open on its side.
+type ICOStatsRepo interface {
+	// GetVolume sums the purchases, only the ones paid with a coupon when coupon is set.
+	GetVolume(ctx context.Context, from, to time.Time, coupon bool) (*ICOVolume, error)
+	GetRoundVolumes(ctx context.Context, from, to time.Time) ([]*ICORoundStats, error)
+	// GetCurrencyVolumes leaves out the purchases made before the payment was recorded.
+	GetCurrencyVolumes(ctx context.Context, from, to time.Time) ([]*ICOCurrencyStats, error)
+
+	// SaveDailyStats replaces the rollup of stats.Day.
+	SaveDailyStats(ctx context.Context, stats *ICODailyStats) error
+	// GetDailyStats returns the rollups of the days in [from, to), oldest first.
+	GetDailyStats(ctx context.Context, from, to time.Time) ([]*ICODailyStats, error)
+}
+
 // Transaction
 
 type Transaction struct {
@@ -108,6 +258,7 @@ type TransactionRepo interface {
 	Tx
 	CreateTransaction(ctx context.Context, input *Transaction) (*Transaction, error)
 	GetTransactionsByUserId(ctx context.Context, userId, cursor string, limit int32) ([]*Transaction, string, error)
+	GetTransactionsBySourceIds(ctx context.Context, sourceIds []string) ([]*Transaction, error)
 }
 
 // Wallet
@@ -161,9 +312,243 @@ type IcoCoupon struct {
 	Reward string `json:"reward,omitempty"`
 	// Cashback holds the value of the "cashback" field.
 	Cashback string `json:"cashback,omitempty"`
+	// The coupon applies in [StartsAt, EndsAt), a zero bound is open.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	EndsAt   time.Time `json:"ends_at,omitempty"`
+	// MaxUses caps the redemptions of all users, 0 is no cap. Used counts them.
+	MaxUses int `json:"max_uses,omitempty"`
+	Used    int `json:"used,omitempty"`
+	// SingleUse lets a user redeem the coupon once.
+	SingleUse bool `json:"single_use,omitempty"`
+	// MinPurchase is the least a purchase buys to apply the coupon, in tokens.
+	MinPurchase string     `json:"min_purchase,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// IcoCouponRedemption is a purchase that applied a coupon. Reward went to the
+// owner of the coupon and Cashback to the buyer, in Symbol.
+type IcoCouponRedemption struct {
+	ID        xid.ID    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	CouponID  xid.ID    `json:"coupon_id,omitempty"`
+	Coupon    string    `json:"coupon,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	SourceID  string    `json:"source_id,omitempty"`
+	Amount    string    `json:"amount,omitempty"`
+	Symbol    string    `json:"symbol,omitempty"`
+	Reward    string    `json:"reward,omitempty"`
+	Cashback  string    `json:"cashback,omitempty"`
 }
 
 type IcoCouponRepo interface {
+	// GetCoupon returns the coupon unless it is deleted, nil when there is none.
 	GetCoupon(ctx context.Context, coupon string) (*IcoCoupon, error)
+	// FindCoupon is GetCoupon for deleted coupons too.
+	FindCoupon(ctx context.Context, coupon string) (*IcoCoupon, error)
+	// AddCoupon fails with ERROR_COUPON_EXISTS when the code is taken, by a
+	// deleted coupon too.
 	AddCoupon(ctx context.Context, icoCoupon *IcoCoupon) error
+	// AddCoupons adds all the coupons or none, failing with ERROR_COUPON_EXISTS
+	// when a code is taken.
+	AddCoupons(ctx context.Context, icoCoupons []*IcoCoupon) error
+	// TakenCoupons returns the codes of coupons taken, by deleted coupons too,
+	// ignoring case.
+	TakenCoupons(ctx context.Context, coupons []string) ([]string, error)
+	// UpdateCoupon saves the terms of the coupon, its owner and code stay.
+	UpdateCoupon(ctx context.Context, icoCoupon *IcoCoupon) error
+	// SetCouponDeletedAt deletes the coupon, a nil deletedAt restores it.
+	SetCouponDeletedAt(ctx context.Context, id xid.ID, deletedAt *time.Time) error
+	// ListCoupons returns the coupons of userId, newest first.
+	ListCoupons(ctx context.Context, userId string, withDeleted bool) ([]*IcoCoupon, error)
+	// UseCoupon counts a redemption unless the coupon is used up, which it reports.
+	UseCoupon(ctx context.Context, id xid.ID) (bool, error)
+	CountRedemptions(ctx context.Context, couponId xid.ID, userId string) (int, error)
+	SaveRedemption(ctx context.Context, redemption *IcoCouponRedemption) error
+	// GetRedemption returns the redemption of payment sourceId, nil when there is none.
+	GetRedemption(ctx context.Context, sourceId string) (*IcoCouponRedemption, error)
+}
+
+// Webhook
+
+type Webhook struct {
+	ID         xid.ID    `json:"id,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	Owner      string    `json:"owner,omitempty"`
+	URL        string    `json:"url,omitempty"`
+	Secret     string    `json:"-"`
+	TransTypes []string  `json:"trans_types,omitempty"`
+	UserIDs    []string  `json:"user_ids,omitempty"`
+}
+
+type WebhookDelivery struct {
+	ID           xid.ID     `json:"id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at,omitempty"`
+	WebhookID    string     `json:"webhook_id,omitempty"`
+	EventID      string     `json:"event_id,omitempty"`
+	EventType    string     `json:"event_type,omitempty"`
+	Payload      string     `json:"payload,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	Attempts     int32      `json:"attempts,omitempty"`
+	ResponseCode int32      `json:"response_code,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty"`
+}
+
+type WebhookRepo interface {
+	CreateWebhook(ctx context.Context, input *Webhook) (*Webhook, error)
+	GetWebhook(ctx context.Context, id string) (*Webhook, error)
+	GetWebhooksByOwner(ctx context.Context, owner string) ([]*Webhook, error)
+	GetActiveWebhooks(ctx context.Context) ([]*Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// CreateDelivery returns nil when the event was already queued for the webhook.
+	CreateDelivery(ctx context.Context, input *WebhookDelivery) (*WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id string) (*WebhookDelivery, error)
+	GetDeliveries(ctx context.Context, webhookId, cursor string, limit int32) ([]*WebhookDelivery, string, error)
+	UpdateDelivery(ctx context.Context, input *WebhookDelivery) error
+}
+
+// Alert
+
+type AlertRule struct {
+	ID        xid.ID    `json:"id,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Symbol    string    `json:"symbol,omitempty"`
+	Threshold string    `json:"threshold,omitempty"`
+}
+
+type AlertRuleRepo interface {
+	// SaveAlertRule creates the rule or replaces the threshold of the existing one.
+	SaveAlertRule(ctx context.Context, input *AlertRule) (*AlertRule, error)
+	GetAlertRulesByUserIds(ctx context.Context, userIds []string) ([]*AlertRule, error)
+	DeleteAlertRule(ctx context.Context, userId, id string) error
+}
+
+// Audit
+
+type AuditLog struct {
+	ID           xid.ID    `json:"id,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	Actor        string    `json:"actor,omitempty"`
+	Service      string    `json:"service,omitempty"`
+	Roles        []string  `json:"roles,omitempty"`
+	Rpc          string    `json:"rpc,omitempty"`
+	TargetUserID string    `json:"target_user_id,omitempty"`
+	PayloadHash  string    `json:"payload_hash,omitempty"`
+	Before       string    `json:"before,omitempty"`
+	After        string    `json:"after,omitempty"`
+	Outcome      string    `json:"outcome,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+type AuditLogFilter struct {
+	Actor        string
+	Service      string
+	Rpc          string
+	TargetUserID string
+	Outcome      string
+	From         *time.Time
+	To           *time.Time
+}
+
+// AuditLogRepo is append-only, there is no update or delete.
+type AuditLogRepo interface {
+	CreateAuditLog(ctx context.Context, input *AuditLog) error
+	GetAuditLogs(ctx context.Context, filter *AuditLogFilter, cursor string, limit int32) ([]*AuditLog, string, error)
+}
+
+// Invariant
+
+// SymbolSupply is the total balance of the wallets of a symbol.
+type SymbolSupply struct {
+	Symbol string
+	Total  string
+}
+
+// SubRoundSale is what a sub-round recorded as sold, next to the sum of the
+// purchases in its histories.
+type SubRoundSale struct {
+	RoundId      int32
+	SubRound     int32
+	BoughtToken  string
+	HistoryToken string
+}
+
+type InvariantRepo interface {
+	GetSupplies(ctx context.Context) ([]*SymbolSupply, error)
+	// GetBurned sums the ICO_BURN transactions per symbol.
+	GetBurned(ctx context.Context) ([]*SymbolSupply, error)
+	// GetMinted sums the transactions of MINT_TYPES per symbol.
+	GetMinted(ctx context.Context) ([]*SymbolSupply, error)
+	// GetNegativeWallets returns the wallets with a balance below zero.
+	GetNegativeWallets(ctx context.Context) ([]*UserWallet, error)
+	GetSubRoundSales(ctx context.Context) ([]*SubRoundSale, error)
+}
+
+// Tokenomics
+
+type TokenomicVersion struct {
+	ID         xid.ID    `json:"id,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	Version    string    `json:"version,omitempty"`
+	Checksum   string    `json:"checksum,omitempty"`
+	Definition string    `json:"definition,omitempty"`
+	Changes    string    `json:"changes,omitempty"`
+}
+
+type TokenomicsRepo interface {
+	Tx
+	// GetVersion returns nil when the version was not applied.
+	GetVersion(ctx context.Context, version string) (*TokenomicVersion, error)
+	CreateVersion(ctx context.Context, input *TokenomicVersion) error
+
+	// GetAllocated is the net amount of symbol moved from one wallet to the
+	// other by internal transactions.
+	GetAllocated(ctx context.Context, symbol, from, to string) (string, error)
+}
+
+// Referrals
+
+// ReferralCommission is what UserID earned on a purchase of BuyerID with the
+// coupon of someone in their downline, Level 2 when they referred its owner.
+type ReferralCommission struct {
+	ID        xid.ID    `json:"id,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Level     int       `json:"level,omitempty"`
+	BuyerID   string    `json:"buyer_id,omitempty"`
+	Coupon    string    `json:"coupon,omitempty"`
+	SourceID  string    `json:"source_id,omitempty"`
+	Amount    string    `json:"amount,omitempty"`
+	Symbol    string    `json:"symbol,omitempty"`
+}
+
+// ReferralEarning sums the commissions of a user at a level in a symbol.
+type ReferralEarning struct {
+	Level       int
+	Symbol      string
+	Amount      string
+	Commissions int
+}
+
+type ReferralRepo interface {
+	// GetCommissionRates returns the part of a purchase paid at every level,
+	// level 2 first.
+	GetCommissionRates(ctx context.Context) ([]string, error)
+	// GetReferrer returns who referred userId, empty when nobody did.
+	GetReferrer(ctx context.Context, userId string) (string, error)
+	// SetReferrer fails with ERROR_REFERRER_EXISTS when userId has one.
+	SetReferrer(ctx context.Context, userId, referrerId string) error
+	CountReferrals(ctx context.Context, referrerId string) (int, error)
+
+	SaveCommission(ctx context.Context, commission *ReferralCommission) error
+	// GetCommissions pages the commissions of userId, newest first.
+	GetCommissions(ctx context.Context, userId, cursor string, limit int32) ([]*ReferralCommission, string, error)
+	// GetEarnings sums the commissions of userId by level and symbol.
+	GetEarnings(ctx context.Context, userId string) ([]*ReferralEarning, error)
+	// GetCommissionsBySource returns the commissions paid on payment sourceId.
+	GetCommissionsBySource(ctx context.Context, sourceId string) ([]*ReferralCommission, error)
 }
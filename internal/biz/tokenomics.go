@@ -0,0 +1,505 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TOKENOMIC_CREATE   = "CREATE"
+	TOKENOMIC_UPDATE   = "UPDATE"
+	TOKENOMIC_DELETE   = "DELETE"
+	TOKENOMIC_ALLOCATE = "ALLOCATE"
+)
+
+// Tokenomics is a versioned definition of the ICO rounds, their price curve and
+// the allocations of the system wallets. Applying a version brings the database
+// to it, a version is applied once and can't change afterwards.
+type Tokenomics struct {
+	Version    string              `yaml:"version" json:"version"`
+	Symbol     string              `yaml:"symbol" json:"symbol"`
+	Source     string              `yaml:"source" json:"source"` // wallet the allocations are minted from
+	PriceCurve TokenomicPriceCurve `yaml:"price_curve" json:"price_curve"`
+	Rounds     []*TokenomicRound   `yaml:"rounds" json:"rounds"`
+	// Allocations are the totals moved from one wallet to another. Only the
+	// difference with what was already moved is applied, and they can't shrink.
+	Allocations []*TokenomicAllocation `yaml:"allocations" json:"allocations"`
+}
+
+type TokenomicPriceCurve struct {
+	Start string `yaml:"start" json:"start"`
+	Gap   string `yaml:"gap" json:"gap"` // percent added to the price of each round, e.g. 100%
+}
+
+type TokenomicRound struct {
+	RoundId  int32  `yaml:"round_id" json:"round_id"`
+	Name     string `yaml:"name" json:"name"`
+	Price    string `yaml:"price" json:"price"` // set from the price curve when empty
+	NumToken string `yaml:"num_token" json:"num_token"`
+	NumSub   int32  `yaml:"num_sub" json:"num_sub"`
+	// FirstSubRound is the first sub-round the definition manages, the ones
+	// before it are left as they are.
+	FirstSubRound int32 `yaml:"first_sub_round" json:"first_sub_round"`
+}
+
+type TokenomicAllocation struct {
+	From   string `yaml:"from" json:"from"` // Source when empty
+	To     string `yaml:"to" json:"to"`
+	Amount string `yaml:"amount" json:"amount"`
+}
+
+// ParseTokenomics reads a YAML or JSON definition, fills in the defaults and
+// validates it.
+func ParseTokenomics(data []byte) (*Tokenomics, error) {
+	def := &Tokenomics{}
+	if err := yaml.Unmarshal(data, def); err != nil {
+		return nil, err
+	}
+	def.setDefaults()
+	if problems := def.Validate(); len(problems) > 0 {
+		return nil, fmt.Errorf("invalid tokenomics: %s", strings.Join(problems, "; "))
+	}
+	return def, nil
+}
+
+func (t *Tokenomics) setDefaults() {
+	if len(t.Symbol) == 0 {
+		t.Symbol = constant.TokenSymbolIND
+	}
+	if len(t.Source) == 0 {
+		t.Source = constant.WALLET_SYS_TOKEN
+	}
+	sort.SliceStable(t.Rounds, func(i, j int) bool { return t.Rounds[i].RoundId < t.Rounds[j].RoundId })
+	for _, r := range t.Rounds {
+		if len(r.Name) == 0 {
+			r.Name = fmt.Sprintf("Round %d", r.RoundId)
+		}
+		if r.FirstSubRound == 0 {
+			r.FirstSubRound = 1
+		}
+	}
+	for _, a := range t.Allocations {
+		if len(a.From) == 0 {
+			a.From = t.Source
+		}
+	}
+
+	// each round costs gap more than the previous one, unless it sets its price
+	start, err1 := decimal.NewFromString(t.PriceCurve.Start)
+	gap, err2 := parsePercent(t.PriceCurve.Gap)
+	if err1 != nil || err2 != nil {
+		return
+	}
+	price := start
+	for i, r := range t.Rounds {
+		if i > 0 {
+			price = price.Add(price.Mul(gap))
+		}
+		if len(r.Price) > 0 {
+			if p, err := decimal.NewFromString(r.Price); err == nil {
+				price = p
+			}
+			continue
+		}
+		r.Price = price.String()
+	}
+}
+
+// Validate returns the problems of the definition, none when it is valid.
+func (t *Tokenomics) Validate() []string {
+	var problems []string
+	if len(t.Version) == 0 {
+		problems = append(problems, "version is required")
+	}
+	if start, err := decimal.NewFromString(t.PriceCurve.Start); err != nil || !start.IsPositive() {
+		problems = append(problems, fmt.Sprintf("price_curve.start %q must be a positive number", t.PriceCurve.Start))
+	}
+	if gap, err := parsePercent(t.PriceCurve.Gap); err != nil || gap.IsNegative() {
+		problems = append(problems, fmt.Sprintf("price_curve.gap %q must be a percent, e.g. 100%%", t.PriceCurve.Gap))
+	}
+
+	rounds := map[int32]bool{}
+	for _, r := range t.Rounds {
+		name := fmt.Sprintf("round %d", r.RoundId)
+		if r.RoundId <= 0 {
+			problems = append(problems, fmt.Sprintf("%s: round_id must be positive", name))
+		}
+		if rounds[r.RoundId] {
+			problems = append(problems, fmt.Sprintf("%s: defined twice", name))
+		}
+		rounds[r.RoundId] = true
+		if price, err := decimal.NewFromString(r.Price); err != nil || !price.IsPositive() {
+			problems = append(problems, fmt.Sprintf("%s: price %q must be a positive number", name, r.Price))
+		}
+		numToken, err := decimal.NewFromString(r.NumToken)
+		if err != nil || !numToken.IsPositive() {
+			problems = append(problems, fmt.Sprintf("%s: num_token %q must be a positive number", name, r.NumToken))
+			continue
+		}
+		if r.NumSub <= 0 {
+			problems = append(problems, fmt.Sprintf("%s: num_sub must be positive", name))
+			continue
+		}
+		if r.FirstSubRound < 1 || r.FirstSubRound > r.NumSub {
+			problems = append(problems, fmt.Sprintf("%s: first_sub_round must be between 1 and num_sub", name))
+		}
+		if !r.subRoundToken().Mul(decimal.NewFromInt32(r.NumSub)).Equal(numToken) {
+			problems = append(problems, fmt.Sprintf("%s: num_token %s can't be split evenly in %d sub-rounds", name, r.NumToken, r.NumSub))
+		}
+	}
+
+	pairs := map[string]bool{}
+	for _, a := range t.Allocations {
+		name := fmt.Sprintf("allocation %s -> %s", a.From, a.To)
+		if len(a.To) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: to is required", name))
+		}
+		if a.From == a.To {
+			problems = append(problems, fmt.Sprintf("%s: from and to are the same wallet", name))
+		}
+		if pairs[a.From+"/"+a.To] {
+			problems = append(problems, fmt.Sprintf("%s: defined twice", name))
+		}
+		pairs[a.From+"/"+a.To] = true
+		if amount, err := decimal.NewFromString(a.Amount); err != nil || !amount.IsPositive() {
+			problems = append(problems, fmt.Sprintf("%s: amount %q must be a positive number", name, a.Amount))
+		}
+	}
+	return problems
+}
+
+// Checksum identifies the content of the definition, with its defaults.
+func (t *Tokenomics) Checksum() string {
+	data, _ := json.Marshal(t)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *TokenomicRound) subRoundToken() decimal.Decimal {
+	return decimal.RequireFromString(r.NumToken).Div(decimal.NewFromInt32(r.NumSub))
+}
+
+func parsePercent(value string) (decimal.Decimal, error) {
+	if !strings.HasSuffix(value, "%") {
+		return decimal.Zero, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	percent, err := decimal.NewFromString(strings.TrimSuffix(value, "%"))
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return percent.Div(decimal.NewFromInt(100)), nil
+}
+
+// TokenomicChange is a change applying a definition makes to the database.
+type TokenomicChange struct {
+	Action string `json:"action"`
+	Target string `json:"target"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+
+	round      *ICORound
+	subRound   *ICOSubRound
+	allocation *TokenomicAllocation
+	amount     string
+}
+
+// TokenomicPlan is the difference between a definition and the database.
+type TokenomicPlan struct {
+	Version  string
+	Checksum string
+	// Applied is set when the version was already applied, there is nothing to do.
+	Applied bool
+	Changes []*TokenomicChange
+	// Conflicts are differences the definition can't change, e.g. the price of
+	// a sub-round that sold tokens. A plan with conflicts is not applied.
+	Conflicts []string
+}
+
+type TokenomicsUsecase struct {
+	repo       TokenomicsRepo
+	icoRepo    ICORepo
+	walletRepo UserWalletRepo
+	transRepo  TransactionRepo
+	log        *log.Helper
+}
+
+func NewTokenomicsUsecase(repo TokenomicsRepo, icoRepo ICORepo, walletRepo UserWalletRepo, transRepo TransactionRepo) *TokenomicsUsecase {
+	return &TokenomicsUsecase{
+		repo:       repo,
+		icoRepo:    icoRepo,
+		walletRepo: walletRepo,
+		transRepo:  transRepo,
+		log:        log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// Plan diffs def against the database. It fails when the version was applied
+// with a different content.
+func (uc *TokenomicsUsecase) Plan(ctx context.Context, def *Tokenomics) (*TokenomicPlan, error) {
+	plan := &TokenomicPlan{Version: def.Version, Checksum: def.Checksum()}
+	applied, err := uc.repo.GetVersion(ctx, def.Version)
+	if err != nil {
+		return nil, err
+	}
+	if applied != nil {
+		if applied.Checksum != plan.Checksum {
+			return nil, errors.New(constant.ERROR_TOKENOMICS_APPLIED)
+		}
+		plan.Applied = true
+		return plan, nil
+	}
+
+	if err := uc.planRounds(ctx, def, plan); err != nil {
+		return nil, err
+	}
+	if err := uc.planAllocations(ctx, def, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (uc *TokenomicsUsecase) planRounds(ctx context.Context, def *Tokenomics, plan *TokenomicPlan) error {
+	rounds, err := uc.icoRepo.GetRounds(ctx)
+	if err != nil {
+		return err
+	}
+	current := map[int32]*ICORound{}
+	for _, r := range rounds {
+		current[r.RoundId] = r
+	}
+	defined := map[int32]*TokenomicRound{}
+	for _, r := range def.Rounds {
+		defined[r.RoundId] = r
+		want := &ICORound{RoundId: r.RoundId, RoundName: r.Name, Price: r.Price, NumToken: r.NumToken, NumSub: r.NumSub, PriceGap: def.PriceCurve.Gap}
+		target := fmt.Sprintf("round %d", r.RoundId)
+		have, ok := current[r.RoundId]
+		if !ok {
+			plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_CREATE, Target: target, After: describeRound(want), round: want})
+			continue
+		}
+		// the definition has no lifetime, limits, pricing, unsold policy or caps, the ones set by the admin API stay
+		want.Lifetime, want.Limits, want.Pricing, want.Unsold = have.Lifetime, have.Limits, have.Pricing, have.Unsold
+		want.SoftCap, want.HardCap = have.SoftCap, have.HardCap
+		if have.RoundName != want.RoundName || !decimalEqual(have.Price, want.Price) || !decimalEqual(have.NumToken, want.NumToken) ||
+			have.NumSub != want.NumSub || have.PriceGap != want.PriceGap {
+			plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_UPDATE, Target: target, Before: describeRound(have), After: describeRound(want), round: want})
+		}
+	}
+	for _, r := range rounds {
+		if _, ok := defined[r.RoundId]; !ok {
+			plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_DELETE, Target: fmt.Sprintf("round %d", r.RoundId), Before: describeRound(r), round: r})
+		}
+	}
+
+	subRounds, err := uc.icoRepo.GetSubRounds(ctx, 0)
+	if err != nil {
+		return err
+	}
+	currentSub := map[[2]int32]*ICOSubRound{}
+	for _, s := range subRounds {
+		currentSub[[2]int32{s.RoundId, s.SubRound}] = s
+	}
+	for _, r := range def.Rounds {
+		for j := r.FirstSubRound; j <= r.NumSub; j++ {
+			want := &ICOSubRound{RoundId: r.RoundId, SubRound: j, Price: r.Price, TotalToken: r.subRoundToken().String(), BoughtToken: "0"}
+			target := fmt.Sprintf("sub-round %d-%d", r.RoundId, j)
+			have, ok := currentSub[[2]int32{r.RoundId, j}]
+			if !ok {
+				plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_CREATE, Target: target, After: describeSubRound(want), subRound: want})
+				continue
+			}
+			if decimalEqual(have.Price, want.Price) && decimalEqual(have.TotalToken, want.TotalToken) {
+				continue
+			}
+			if !isUnsold(have) {
+				plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("%s has sold %s tokens or is closed, it can't change from %s to %s", target, have.BoughtToken, describeSubRound(have), describeSubRound(want)))
+				continue
+			}
+			want.ID, want.StartAt, want.EndAt, want.Lifetime = have.ID, have.StartAt, have.EndAt, have.Lifetime
+			plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_UPDATE, Target: target, Before: describeSubRound(have), After: describeSubRound(want), subRound: want})
+		}
+	}
+	for _, s := range subRounds {
+		if r, ok := defined[s.RoundId]; ok && s.SubRound <= r.NumSub {
+			// managed, or before first_sub_round and left as it is
+			continue
+		}
+		target := fmt.Sprintf("sub-round %d-%d", s.RoundId, s.SubRound)
+		if !isUnsold(s) {
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("%s has sold %s tokens or is closed, it can't be removed", target, s.BoughtToken))
+			continue
+		}
+		plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_DELETE, Target: target, Before: describeSubRound(s), subRound: s})
+	}
+	return nil
+}
+
+func (uc *TokenomicsUsecase) planAllocations(ctx context.Context, def *Tokenomics, plan *TokenomicPlan) error {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, def.Source, def.Symbol, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if len(wallets) == 0 {
+		plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_CREATE, Target: "wallet " + def.Source, After: "0"})
+	}
+
+	for _, a := range def.Allocations {
+		allocated, err := uc.repo.GetAllocated(ctx, def.Symbol, a.From, a.To)
+		if err != nil {
+			return err
+		}
+		target := fmt.Sprintf("allocation %s -> %s", a.From, a.To)
+		delta := decimal.RequireFromString(a.Amount).Sub(decimal.RequireFromString(allocated))
+		if delta.IsNegative() {
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("%s already moved %s, it can't shrink to %s", target, allocated, a.Amount))
+			continue
+		}
+		if delta.IsPositive() {
+			plan.Changes = append(plan.Changes, &TokenomicChange{Action: TOKENOMIC_ALLOCATE, Target: target, Before: allocated, After: a.Amount, allocation: a, amount: delta.String()})
+		}
+	}
+	return nil
+}
+
+// Apply brings the database to def and records the version, in one
+// transaction. Applying a version again does nothing.
+func (uc *TokenomicsUsecase) Apply(ctx context.Context, def *Tokenomics) (*TokenomicPlan, error) {
+	var plan *TokenomicPlan
+	err := uc.repo.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		plan, err = uc.Plan(ctx, def)
+		if err != nil {
+			return err
+		}
+		if plan.Applied {
+			return nil
+		}
+		if len(plan.Conflicts) > 0 {
+			for _, c := range plan.Conflicts {
+				uc.log.Errorf("Apply tokenomics %s: %s", def.Version, c)
+			}
+			return errors.New(constant.ERROR_TOKENOMICS_CONFLICT)
+		}
+
+		for _, c := range plan.Changes {
+			if err := uc.applyChange(ctx, def, c); err != nil {
+				return fmt.Errorf("%s %s: %w", c.Action, c.Target, err)
+			}
+		}
+
+		definition, err := json.Marshal(def)
+		if err != nil {
+			return err
+		}
+		changes, err := json.Marshal(plan.Changes)
+		if err != nil {
+			return err
+		}
+		return uc.repo.CreateVersion(ctx, &TokenomicVersion{Version: def.Version, Checksum: plan.Checksum, Definition: string(definition), Changes: string(changes)})
+	})
+	return plan, err
+}
+
+// CreatePaymentWallets creates the SYS_ICO_REWARD and SYS_ICO_PROCEEDS
+// wallets of the purchase currencies missing, and returns them.
+func (uc *TokenomicsUsecase) CreatePaymentWallets(ctx context.Context) ([]string, error) {
+	created := []string{}
+	for _, userId := range []string{constant.WALLET_SYS_ICO_REWARD, constant.WALLET_SYS_ICO_PROCEEDS} {
+		for _, symbol := range CURRENCY_SUPPORT {
+			wallets, err := uc.walletRepo.GetWalletByUserId(ctx, userId, symbol, constant.WALLET_TYPE_SYSTEM)
+			if err != nil {
+				return nil, err
+			}
+			if len(wallets) > 0 {
+				continue
+			}
+			if _, err := uc.walletRepo.CreateWallet(ctx, userId, symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+				return nil, err
+			}
+			created = append(created, userId+" "+symbol)
+		}
+	}
+	return created, nil
+}
+
+func (uc *TokenomicsUsecase) applyChange(ctx context.Context, def *Tokenomics, c *TokenomicChange) error {
+	switch {
+	case c.round != nil && c.Action == TOKENOMIC_DELETE:
+		return uc.icoRepo.DeleteRound(ctx, c.round.RoundId)
+	case c.round != nil:
+		return uc.icoRepo.SaveRound(ctx, c.round)
+	case c.subRound != nil && c.Action == TOKENOMIC_DELETE:
+		return uc.icoRepo.DeleteSubRound(ctx, c.subRound.ID)
+	case c.subRound != nil:
+		return uc.icoRepo.SaveSubRound(ctx, c.subRound)
+	case c.allocation != nil:
+		return uc.allocate(ctx, def, c.allocation, c.amount)
+	default:
+		_, err := uc.walletRepo.CreateWallet(ctx, def.Source, def.Symbol, constant.WALLET_TYPE_SYSTEM)
+		return err
+	}
+}
+
+// allocate moves amount between the system wallets. The source of the
+// tokenomics mints it, any other wallet must hold it.
+func (uc *TokenomicsUsecase) allocate(ctx context.Context, def *Tokenomics, a *TokenomicAllocation, amount string) error {
+	wallets, err := uc.walletRepo.GetWalletByUserId(ctx, a.To, def.Symbol, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if len(wallets) == 0 {
+		if _, err := uc.walletRepo.CreateWallet(ctx, a.To, def.Symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+			return err
+		}
+	}
+
+	if a.From != def.Source {
+		rs, err := uc.walletRepo.DecreaseBalance(ctx, a.From, def.Symbol, amount, constant.WALLET_TYPE_SYSTEM)
+		if err != nil {
+			return err
+		}
+		if rs == 0 {
+			return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+		}
+	}
+	rs, err := uc.walletRepo.IncreaseBalance(ctx, a.To, def.Symbol, amount, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if rs == 0 {
+		return errors.New(constant.ERROR_NOT_FOUND)
+	}
+
+	_, err = uc.transRepo.CreateTransaction(ctx, &Transaction{TransType: constant.TRANS_INTERNAL, Source: a.From, SrcSymbol: def.Symbol, SrcAmount: amount,
+		Destination: a.To, DestSymbol: def.Symbol, DestAmount: amount, Status: TRANS_STATUS, SourceId: "tokenomics:" + def.Version})
+	return err
+}
+
+func isUnsold(s *ICOSubRound) bool {
+	return !s.IsEnded && decimal.RequireFromString(s.BoughtToken).IsZero()
+}
+
+func decimalEqual(a, b string) bool {
+	x, err1 := decimal.NewFromString(a)
+	y, err2 := decimal.NewFromString(b)
+	return err1 == nil && err2 == nil && x.Equal(y)
+}
+
+func describeRound(r *ICORound) string {
+	return fmt.Sprintf("%s price %s, %s tokens in %d sub-rounds, gap %s", r.RoundName, r.Price, r.NumToken, r.NumSub, r.PriceGap)
+}
+
+func describeSubRound(s *ICOSubRound) string {
+	return fmt.Sprintf("price %s, %s tokens", s.Price, s.TotalToken)
+}
@@ -0,0 +1,204 @@
+package biz_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+const plan1 = `
+version: "1"
+source: SYS_TOKENNOMIC
+price_curve: {start: "0.02", gap: "50%"}
+rounds:
+  - {round_id: 1, num_token: "1000", num_sub: 2}
+  - {round_id: 2, num_token: "600", num_sub: 3}
+allocations:
+  - {to: ICO, amount: "1600"}
+`
+
+func TestParseTokenomics(t *testing.T) {
+	def, err := biz.ParseTokenomics([]byte(plan1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Symbol != constant.TokenSymbolIND || def.Rounds[0].Name != "Round 1" || def.Rounds[1].FirstSubRound != 1 || def.Allocations[0].From != "SYS_TOKENNOMIC" {
+		t.Errorf("defaults not set: %+v", def)
+	}
+	if def.Rounds[0].Price != "0.02" || def.Rounds[1].Price != "0.03" {
+		t.Errorf("prices %s, %s, want 0.02, 0.03 from the curve", def.Rounds[0].Price, def.Rounds[1].Price)
+	}
+
+	for name, tt := range map[string]struct {
+		data    string
+		problem string
+	}{
+		"no version":      {`price_curve: {start: "1", gap: "0%"}`, "version is required"},
+		"gap not percent": {`{version: "1", price_curve: {start: "1", gap: "0.5"}}`, "price_curve.gap"},
+		"uneven split": {`{version: "1", price_curve: {start: "1", gap: "0%"}, rounds: [{round_id: 1, num_token: "10", num_sub: 3}]}`,
+			"can't be split evenly"},
+		"round twice": {`{version: "1", price_curve: {start: "1", gap: "0%"}, rounds: [{round_id: 1, num_token: "1", num_sub: 1}, {round_id: 1, num_token: "1", num_sub: 1}]}`,
+			"round 1: defined twice"},
+		"first sub-round past the end": {`{version: "1", price_curve: {start: "1", gap: "0%"}, rounds: [{round_id: 1, num_token: "2", num_sub: 2, first_sub_round: 3}]}`,
+			"first_sub_round"},
+		"allocation to the source": {`{version: "1", source: S, price_curve: {start: "1", gap: "0%"}, allocations: [{to: S, amount: "1"}]}`,
+			"from and to are the same wallet"},
+	} {
+		if _, err := biz.ParseTokenomics([]byte(tt.data)); err == nil || !strings.Contains(err.Error(), tt.problem) {
+			t.Errorf("%s: err %v, want %q", name, err, tt.problem)
+		}
+	}
+}
+
+// tokenomics is an empty database and the use case applying definitions to it.
+type tokenomics struct {
+	uc  *biz.TokenomicsUsecase
+	ico biz.ICORepo
+	wr  biz.UserWalletRepo
+}
+
+func newTokenomics() *tokenomics {
+	st := memrepo.NewStore()
+	tk := &tokenomics{ico: memrepo.NewIcoRepo(st), wr: memrepo.NewWalletRepo(st)}
+	tk.uc = biz.NewTokenomicsUsecase(memrepo.NewTokenomicsRepo(st), tk.ico, tk.wr, memrepo.NewTransactionRepo(st))
+	return tk
+}
+
+func (tk *tokenomics) apply(t *testing.T, data string) (*biz.TokenomicPlan, error) {
+	t.Helper()
+	def, err := biz.ParseTokenomics([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tk.uc.Apply(context.Background(), def)
+}
+
+func (tk *tokenomics) balance(t *testing.T, userId string) string {
+	t.Helper()
+	wallets, err := tk.wr.GetWalletByUserId(context.Background(), userId, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM)
+	if err != nil || len(wallets) == 0 {
+		t.Fatalf("wallet %s: %v", userId, err)
+	}
+	return wallets[0].Balance
+}
+
+func actions(plan *biz.TokenomicPlan) map[string]int {
+	counts := map[string]int{}
+	for _, c := range plan.Changes {
+		counts[c.Action]++
+	}
+	return counts
+}
+
+func TestApplyTokenomics(t *testing.T) {
+	ctx := context.Background()
+	tk := newTokenomics()
+
+	plan, err := tk.apply(t, plan1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the source wallet, 2 rounds and 5 sub-rounds, then the allocation
+	if got := actions(plan); got[biz.TOKENOMIC_CREATE] != 8 || got[biz.TOKENOMIC_ALLOCATE] != 1 || len(plan.Conflicts) > 0 {
+		t.Errorf("plan %v, conflicts %v", got, plan.Conflicts)
+	}
+	subRounds, _ := tk.ico.GetSubRounds(ctx, 2)
+	if len(subRounds) != 3 || subRounds[0].TotalToken != "200" || subRounds[0].Price != "0.03" {
+		t.Errorf("round 2 split in %d sub-rounds: %+v", len(subRounds), subRounds[0])
+	}
+	if got := tk.balance(t, constant.WALLET_ICO); got != "1600" {
+		t.Errorf("ICO holds %s, want 1600", got)
+	}
+
+	plan, err = tk.apply(t, plan1)
+	if err != nil || !plan.Applied || len(plan.Changes) > 0 {
+		t.Errorf("applying again: %+v, %v", plan, err)
+	}
+	if _, err := tk.apply(t, strings.Replace(plan1, `"1600"`, `"1700"`, 1)); err == nil || err.Error() != constant.ERROR_TOKENOMICS_APPLIED {
+		t.Errorf("changing an applied version: err %v, want %s", err, constant.ERROR_TOKENOMICS_APPLIED)
+	}
+
+	// a new version reprices round 2, drops round 1 and allocates the difference
+	plan2 := `
+version: "2"
+source: SYS_TOKENNOMIC
+price_curve: {start: "0.02", gap: "50%"}
+rounds:
+  - {round_id: 2, price: "0.04", num_token: "600", num_sub: 3}
+allocations:
+  - {to: ICO, amount: "2000"}
+`
+	plan, err = tk.apply(t, plan2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := actions(plan); got[biz.TOKENOMIC_UPDATE] != 4 || got[biz.TOKENOMIC_DELETE] != 3 || got[biz.TOKENOMIC_ALLOCATE] != 1 {
+		t.Errorf("plan %v", got)
+	}
+	if got := tk.balance(t, constant.WALLET_ICO); got != "2000" {
+		t.Errorf("ICO holds %s, want 2000", got)
+	}
+}
+
+// A version changing what was sold or shrinking an allocation is not applied,
+// nothing of it is.
+func TestApplyTokenomicsConflicts(t *testing.T) {
+	ctx := context.Background()
+	for name, data := range map[string]string{
+		"a sold sub-round repriced": `
+version: "2"
+source: SYS_TOKENNOMIC
+price_curve: {start: "0.05", gap: "50%"}
+rounds:
+  - {round_id: 1, num_token: "1000", num_sub: 2}
+  - {round_id: 2, num_token: "600", num_sub: 3}
+allocations:
+  - {to: ICO, amount: "1800"}
+`,
+		"a sold sub-round removed": `
+version: "2"
+source: SYS_TOKENNOMIC
+price_curve: {start: "0.02", gap: "50%"}
+rounds:
+  - {round_id: 2, num_token: "600", num_sub: 3}
+allocations:
+  - {to: ICO, amount: "1800"}
+`,
+		"an allocation shrunk": `
+version: "2"
+source: SYS_TOKENNOMIC
+price_curve: {start: "0.02", gap: "50%"}
+rounds:
+  - {round_id: 1, num_token: "1000", num_sub: 2}
+  - {round_id: 2, num_token: "600", num_sub: 3}
+allocations:
+  - {to: ICO, amount: "1000"}
+`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			tk := newTokenomics()
+			if _, err := tk.apply(t, plan1); err != nil {
+				t.Fatal(err)
+			}
+			subRounds, _ := tk.ico.GetSubRounds(ctx, 1)
+			if ok, err := tk.ico.TakeSubRoundToken(ctx, subRounds[0].ID, "10"); err != nil || !ok {
+				t.Fatalf("sell: %v %v", ok, err)
+			}
+
+			plan, err := tk.apply(t, data)
+			if err == nil || err.Error() != constant.ERROR_TOKENOMICS_CONFLICT || len(plan.Conflicts) != 1 {
+				t.Fatalf("err %v, conflicts %v", err, plan.Conflicts)
+			}
+			if got := tk.balance(t, constant.WALLET_ICO); got != "1600" {
+				t.Errorf("ICO holds %s after a conflict, want 1600", got)
+			}
+			if rounds, _ := tk.ico.GetRounds(ctx); len(rounds) != 2 || rounds[0].Price != "0.02" {
+				t.Errorf("rounds changed by a conflict: %+v", rounds)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// UNSOLD_WALLET moves the tokens a sub-round did not sell from the ICO
+	// wallet to a system wallet, SYS_ICO unless Wallet names another one. The
+	// default.
+	UNSOLD_WALLET = "wallet"
+	// UNSOLD_ROLLOVER adds them to the next sub-round, the ICO wallet keeps
+	// them. They go to the wallet when no sub-round is left.
+	UNSOLD_ROLLOVER = "rollover"
+	// UNSOLD_BURN takes them off the ICO wallet and credits no other, the
+	// supply of the symbol shrinks by them. The supply invariant counts the
+	// burn transactions.
+	UNSOLD_BURN = "burn"
+)
+
+// ICOUnsold is what becomes of the tokens the sub-rounds of a round did not sell.
+type ICOUnsold struct {
+	Policy string `json:"policy,omitempty"`
+	Wallet string `json:"wallet,omitempty"`
+}
+
+func (u ICOUnsold) IsDefault() bool {
+	return (len(u.Policy) == 0 || u.Policy == UNSOLD_WALLET) && (len(u.Wallet) == 0 || u.Wallet == constant.WALLET_SYS_ICO_BACKUP)
+}
+
+// wallet is where the tokens go when they leave the ICO wallet.
+func (u ICOUnsold) wallet() string {
+	if u.Policy == UNSOLD_BURN {
+		return constant.WALLET_SYS_BURN
+	}
+	if len(u.Wallet) > 0 {
+		return u.Wallet
+	}
+	return constant.WALLET_SYS_ICO_BACKUP
+}
+
+func validateUnsold(u ICOUnsold) error {
+	switch u.Policy {
+	case "", UNSOLD_WALLET, UNSOLD_ROLLOVER:
+	case UNSOLD_BURN:
+		if len(u.Wallet) > 0 {
+			return errors.New(constant.ERROR_BAD_REQUEST)
+		}
+	default:
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	// burning takes the burn policy
+	if u.Wallet == constant.WALLET_ICO || u.Wallet == constant.WALLET_SYS_BURN {
+		return errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	return nil
+}
+
+// settleUnsold applies the unsold policy of its round to the tokens subRound
+// did not sell. next is the sub-round that opened after it, nil when none did.
+func (q *QueueRunner) settleUnsold(ctx context.Context, subRound, next *ICOSubRound) error {
+	unsold := decimal.RequireFromString(subRound.TotalToken).Sub(decimal.RequireFromString(subRound.BoughtToken))
+	if !unsold.IsPositive() {
+		return nil
+	}
+	round, err := q.repo.GetRoundByRoundId(ctx, subRound.RoundId)
+	if err != nil {
+		return err
+	}
+	policy := round.Unsold
+	if policy.Policy == UNSOLD_ROLLOVER && next != nil {
+		return q.rollOver(ctx, next, unsold)
+	}
+
+	transType, wallet, amount := ICO_UNSOLD, policy.wallet(), unsold.String()
+	rs, err := q.walletRepo.DecreaseBalance(ctx, constant.WALLET_ICO, constant.TokenSymbolIND, amount, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if rs == 0 {
+		return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
+	}
+	if policy.Policy == UNSOLD_BURN {
+		transType = ICO_BURN
+	} else {
+		rs, err = q.walletRepo.IncreaseBalance(ctx, wallet, constant.TokenSymbolIND, amount, constant.WALLET_TYPE_SYSTEM)
+		if err != nil {
+			return err
+		}
+		if rs == 0 {
+			return errors.New(constant.ERROR_NOT_FOUND)
+		}
+	}
+
+	trans := &Transaction{TransType: transType, Source: constant.WALLET_ICO, SrcAmount: amount, SrcSymbol: constant.TokenSymbolIND, Destination: wallet, DestSymbol: constant.TokenSymbolIND, DestAmount: amount, Status: TRANS_STATUS, SourceId: fmt.Sprintf("%d-%d", subRound.RoundId, subRound.SubRound)}
+	trans, err = q.transRepo.CreateTransaction(ctx, trans)
+	if err != nil {
+		return err
+	}
+	return collectEvent(ctx, q.walletRepo, trans)
+}
+
+// rollOver adds unsold to the tokens of next, its round grows by them too.
+func (q *QueueRunner) rollOver(ctx context.Context, next *ICOSubRound, unsold decimal.Decimal) error {
+	next.TotalToken = decimal.RequireFromString(next.TotalToken).Add(unsold).String()
+	if err := q.repo.SaveSubRound(ctx, next); err != nil {
+		return err
+	}
+	round, err := q.repo.GetRoundByRoundId(ctx, next.RoundId)
+	if err != nil {
+		return err
+	}
+	round.NumToken = decimal.RequireFromString(round.NumToken).Add(unsold).String()
+	if err := q.repo.SaveRound(ctx, round); err != nil {
+		return err
+	}
+	q.log.Infof("Rolled %s unsold tokens over to sub-round %d-%d", unsold, next.RoundId, next.SubRound)
+	return nil
+}
@@ -22,10 +22,23 @@ var (
 	ICO_COMISSION    = "ICO_COMISSION"
 	ICO_CASHBACK     = "ICO_CASHBACK"
 	DEPOSITE         = "DEPOSITE"
+	ICO_UNSOLD       = "ICO_UNSOLD"
+	ICO_BURN         = "ICO_BURN"
+	ICO_REFERRAL     = "ICO_REFERRAL"
+	ICO_REWARD_FUND  = "ICO_REWARD_FUND"
+	ICO_REFUND       = "ICO_REFUND"
+	ICO_PAYMENT      = "ICO_PAYMENT"
 	TRANS_STATUS     = "COMPLETED"
 	CURRENCY_SUPPORT = []string{"VND", "USD", "USDT"}
 )
 
+// The transactions taking back what a refunded purchase paid.
+var (
+	ICO_COMISSION_REVERSAL = "ICO_COMISSION_REVERSAL"
+	ICO_CASHBACK_REVERSAL  = "ICO_CASHBACK_REVERSAL"
+	ICO_REFERRAL_REVERSAL  = "ICO_REFERRAL_REVERSAL"
+)
+
 type WalletTransactionUseCase struct {
 	transRepo        TransactionRepo
 	walletRepo       UserWalletRepo
@@ -33,13 +46,14 @@ type WalletTransactionUseCase struct {
 	icoRepo          ICORepo
 	icoCoupon        IcoCouponRepo
 	queue            QueueJob
-	lockRepo         LockRepo
 	icoUc            *ICOUsecase
+	referralUc       *ReferralUsecase
+	lockRepo         LockRepo
 	log              *log.Helper
 	publisher        TransactionPublisher
 }
 
-func NewWalletTransactionUseCase(repo TransactionRepo, walletRepo UserWalletRepo, icoRepo ICORepo, currencyRateRepo CurrencyRateRepo, icoCoupon IcoCouponRepo, queue QueueJob, publisher TransactionPublisher, icoUc *ICOUsecase, lockRepo LockRepo) *WalletTransactionUseCase {
+func NewWalletTransactionUseCase(repo TransactionRepo, walletRepo UserWalletRepo, icoRepo ICORepo, currencyRateRepo CurrencyRateRepo, icoCoupon IcoCouponRepo, queue QueueJob, publisher TransactionPublisher, icoUc *ICOUsecase, referralUc *ReferralUsecase, lockRepo LockRepo) *WalletTransactionUseCase {
 	return &WalletTransactionUseCase{
 		transRepo:        repo,
 		walletRepo:       walletRepo,
@@ -49,6 +63,7 @@ func NewWalletTransactionUseCase(repo TransactionRepo, walletRepo UserWalletRepo
 		queue:            queue,
 		publisher:        publisher,
 		icoUc:            icoUc,
+		referralUc:       referralUc,
 		lockRepo:         lockRepo,
 		log:              log.NewHelper(log.DefaultLogger),
 	}
@@ -72,7 +87,7 @@ func (uc *WalletTransactionUseCase) InitData(ctx context.Context) {
 	if err != nil {
 		panic(err)
 	}
-	uc.queue.Enqueue(ctx, &Task{Data: round.ID.String(), Name: fmt.Sprintf("%s%s", QUEUE_PREFIX, ENDROUND_SCHEDULE), ProcessAt: round.EndAt})
+	uc.queue.Enqueue(ctx, NewEndRoundTask(round))
 }
 
 func (uc *WalletTransactionUseCase) GetUserWallet(ctx context.Context, userId string) ([]*UserWallet, error) {
@@ -127,11 +142,11 @@ func (uc *WalletTransactionUseCase) GetTransactionsByUserId(ctx context.Context,
 
 func (uc *WalletTransactionUseCase) Subscription(ctx context.Context, userId, amount, symbol, sourceId string) error {
 	log.Infof("Subscription:Context: %v, userId: %s, amount: %s, symbol: %s, sourceId: %s", ctx != nil, userId, amount, symbol, sourceId)
-	err := uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
+	err := withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
 		rate := decimal.RequireFromString("1")
 		if symbol != constant.TokenSymbolIND {
 			currencySymbol := fmt.Sprintf("%s_%s", symbol, constant.TokenSymbolIND)
-			currency, err := uc.currencyRateRepo.GetCurrencyRate(ctx, currencySymbol)
+			currency, err := uc.icoUc.GetRate(ctx, currencySymbol)
 			if err != nil {
 				uc.log.Errorf("Subscription: %s", err.Error())
 				return errors.New(constant.ERROR_INTERNAL)
@@ -154,12 +169,11 @@ func (uc *WalletTransactionUseCase) Subscription(ctx context.Context, userId, am
 
 		trans := &Transaction{TransType: SUBSCRIPTION, Source: userId, SrcAmount: amount, SrcSymbol: symbol, Destination: constant.WALLET_SYS_INCOME, DestSymbol: chargeSymbol, DestAmount: amountRated.String(), Rate: rate.String(), Status: TRANS_STATUS, SourceId: sourceId}
 
-		trans, err = uc.CreateTransaction(ctx, trans)
+		_, err = uc.CreateTransaction(ctx, trans)
 		if err != nil {
 			log.Errorf("Subscription - CreateTransaction: %s", err.Error())
 			return err
 		}
-		uc.publisher.Publish(&TransactionMessage{Id: trans.ID.String(), UserId: userId, Amount: amount, Symbol: symbol, TransType: SUBSCRIPTION})
 		return nil
 	})
 
@@ -218,14 +232,19 @@ func (uc *WalletTransactionUseCase) calculateFeeUsdt(amount, symbol, typeFee str
 		uc.log.Error("get currency rate has an error >>> ", err)
 		return amount, symbol
 	}
-	amountIndChargeFee := amoutUsdtFee.Div(decimal.RequireFromString(currency.Price))
+	price, err := uc.icoUc.GetPrice(context.Background(), currency)
+	if err != nil {
+		uc.log.Error("get currency rate has an error >>> ", err)
+		return amount, symbol
+	}
+	amountIndChargeFee := amoutUsdtFee.Div(price)
 	return amountIndChargeFee.String(), constant.TokenSymbolIND
 }
 
 func (uc *WalletTransactionUseCase) ChargeFee(ctx context.Context, userId, amount, symbol, sourceId, typeFee string) (string, error) {
 	log.Infof("ChargeFee:Context: %v, userId: %s, amount: %s, symbol: %s, sourceId: %s, typeFee: %s", ctx != nil, userId, amount, symbol, sourceId, typeFee)
 	amount, symbol = uc.calculateFeeUsdt(amount, symbol, typeFee)
-	err := uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
+	err := withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
 		rs, err := uc.walletRepo.DecreaseBalance(ctx, userId, symbol, amount, constant.WALLET_TYPE_USER)
 		if err != nil {
 			return errors.New(constant.ERROR_INTERNAL)
@@ -236,12 +255,7 @@ func (uc *WalletTransactionUseCase) ChargeFee(ctx context.Context, userId, amoun
 		}
 
 		trans := &Transaction{TransType: CHARGE_FEE, Source: userId, SrcAmount: amount, SrcSymbol: symbol, Destination: constant.WALLET_SYS_INCOME, DestSymbol: symbol, DestAmount: amount, Status: TRANS_STATUS, SourceId: sourceId}
-		trans, err = uc.CreateTransaction(ctx, trans)
-		if err != nil {
-			return err
-		}
-
-		uc.publisher.Publish(&TransactionMessage{Id: trans.ID.String(), UserId: userId, Amount: amount, Symbol: symbol, TransType: CHARGE_FEE})
+		_, err = uc.CreateTransaction(ctx, trans)
 		return err
 	})
 
@@ -249,77 +263,129 @@ func (uc *WalletTransactionUseCase) ChargeFee(ctx context.Context, userId, amoun
 }
 
 func (uc *WalletTransactionUseCase) BuyICO(ctx context.Context, userId, amount, symbol, sourceId, couponCode string) error {
-	err := uc.ICOTransaction(ctx, userId, amount, symbol, sourceId, ICO, ICO)
-	if err != nil {
-		return err
+	// An unknown or deleted coupon is ignored, one that can't apply fails the purchase.
+	var coupon *IcoCoupon
+	var err error
+	if couponCode = strings.Trim(couponCode, " "); len(couponCode) > 0 {
+		if coupon, err = uc.icoCoupon.GetCoupon(ctx, couponCode); err != nil {
+			return err
+		}
 	}
 
-	couponCode = strings.Trim(couponCode, " ")
-	if len(couponCode) == 0 {
-		return nil
+	// The cap per user counts the earlier purchases, so the purchases of a user
+	// run one at a time, and the last uses of a capped coupon go to the
+	// purchases that checked it. Both are taken at once.
+	lockKeys := []string{fmt.Sprintf("%s:%s", constant.ICO_USER_LOCK, userId)}
+	if coupon != nil && coupon.MaxUses > 0 {
+		lockKeys = append(lockKeys, fmt.Sprintf("%s:%s", constant.ICO_COUPON_LOCK, coupon.ID))
 	}
-	coupon, err := uc.icoCoupon.GetCoupon(ctx, couponCode)
+	token, err := uc.lockRepo.Lock(ctx, lockKeys...)
 	if err != nil {
-		return err
-	}
-
-	if coupon == nil {
-		return nil
+		uc.log.Error("BuyICO ", err)
+		return errors.New(constant.ERROR_LOCK)
 	}
-	return uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
-		_, err = uc.GetUserWalletOrCreateWithSymbol(ctx, coupon.UserID, symbol, constant.WALLET_TYPE_REWARD)
-		if err != nil {
+	defer uc.lockRepo.UnLock(ctx, token, lockKeys...)
+	if coupon != nil && coupon.MaxUses > 0 {
+		if coupon, err = uc.icoCoupon.GetCoupon(ctx, couponCode); err != nil {
 			return err
 		}
-		ownReward := decimal.RequireFromString(amount).Mul(decimal.RequireFromString(coupon.Reward)).String()
-		_, err = uc.walletRepo.IncreaseBalance(ctx, coupon.UserID, symbol, ownReward, constant.WALLET_TYPE_REWARD)
-		if err != nil {
+	}
+	if coupon != nil {
+		if err := uc.icoUc.CheckCoupon(ctx, coupon, userId, amount, symbol); err != nil {
 			return err
 		}
-		trans := &Transaction{TransType: ICO_COMISSION, Source: constant.WALLET_SYS_ICO_REWARD, SrcAmount: ownReward, SrcSymbol: symbol, Destination: coupon.UserID, DestSymbol: symbol, DestAmount: ownReward, SourceId: sourceId, Status: TRANS_STATUS}
+	}
 
-		_, err = uc.CreateTransaction(ctx, trans)
-		if err != nil {
-			return err
+	// the coupon is redeemed in the purchase transaction, a purchase is never
+	// kept without its coupon terms
+	var redeem func(ctx context.Context) error
+	if coupon != nil {
+		redeem = func(ctx context.Context) error {
+			return uc.redeemCoupon(ctx, coupon, userId, amount, symbol, sourceId)
 		}
+	}
+	return uc.icoTransaction(ctx, userId, amount, symbol, sourceId, ICO, ICO, redeem)
+}
 
-		_, err = uc.GetUserWalletOrCreateWithSymbol(ctx, userId, symbol, constant.WALLET_TYPE_REWARD)
-		if err != nil {
-			return err
-		}
-		cashback := decimal.RequireFromString(amount).Mul(decimal.RequireFromString(coupon.Cashback)).String()
-		_, err = uc.walletRepo.IncreaseBalance(ctx, userId, symbol, cashback, constant.WALLET_TYPE_REWARD)
-		if err != nil {
-			return err
-		}
-		trans = &Transaction{TransType: ICO_CASHBACK, Source: constant.WALLET_SYS_ICO_REWARD, SrcAmount: cashback, SrcSymbol: symbol, Destination: userId, DestSymbol: symbol, DestAmount: cashback, SourceId: sourceId, Status: TRANS_STATUS}
+// redeemCoupon uses coupon on a purchase of amount symbol by userId, pays its
+// reward, cashback and referral commissions and records the redemption.
+func (uc *WalletTransactionUseCase) redeemCoupon(ctx context.Context, coupon *IcoCoupon, userId, amount, symbol, sourceId string) error {
+	used, err := uc.icoCoupon.UseCoupon(ctx, coupon.ID)
+	if err != nil {
+		uc.log.Error("BuyICO ", err)
+		return errors.New(constant.ERROR_INTERNAL)
+	}
+	if !used {
+		return errors.New(constant.ERROR_COUPON_USED_UP)
+	}
 
-		_, err = uc.CreateTransaction(ctx, trans)
-		return err
-	})
+	// all are paid out of SYS_ICO_REWARD, or SYS_ICO_PROCEEDS when it can't fund them
+	ownReward := decimal.RequireFromString(amount).Mul(decimal.RequireFromString(coupon.Reward)).String()
+	if err := uc.referralUc.PayReward(ctx, ICO_COMISSION, coupon.UserID, ownReward, symbol, sourceId); err != nil {
+		return uc.payoutError(err)
+	}
+	cashback := decimal.RequireFromString(amount).Mul(decimal.RequireFromString(coupon.Cashback)).String()
+	if err := uc.referralUc.PayReward(ctx, ICO_CASHBACK, userId, cashback, symbol, sourceId); err != nil {
+		return uc.payoutError(err)
+	}
+	if err := uc.referralUc.PayCommissions(ctx, coupon, userId, amount, symbol, sourceId); err != nil {
+		return uc.payoutError(err)
+	}
 
+	redemption := &IcoCouponRedemption{CouponID: coupon.ID, Coupon: coupon.Coupon, UserID: userId, SourceID: sourceId, Amount: amount, Symbol: symbol, Reward: ownReward, Cashback: cashback}
+	if err := uc.icoCoupon.SaveRedemption(ctx, redemption); err != nil {
+		uc.log.Error("BuyICO ", err)
+		return errors.New(constant.ERROR_INTERNAL)
+	}
+	return nil
+}
+
+func (uc *WalletTransactionUseCase) payoutError(err error) error {
+	if err.Error() == constant.ERROR_REWARD_POOL_SHORT {
+		return err
+	}
+	uc.log.Error("BuyICO ", err)
+	return errors.New(constant.ERROR_INTERNAL)
 }
 
 func (uc *WalletTransactionUseCase) ICOTransaction(ctx context.Context, userId, amount, symbol, sourceId, transType, icoType string) error {
+	return uc.icoTransaction(ctx, userId, amount, symbol, sourceId, transType, icoType, nil)
+}
+
+// icoTransaction buys the tokens, then runs redeem, when set, in the same
+// transaction.
+func (uc *WalletTransactionUseCase) icoTransaction(ctx context.Context, userId, amount, symbol, sourceId, transType, icoType string, redeem func(ctx context.Context) error) error {
+	paid, paidSymbol := amount, symbol
 	uc.GetUserWalletOrCreateWithSymbol(ctx, userId, constant.TokenSymbolIND, constant.WALLET_TYPE_USER)
-	err := uc.lockRepo.Lock(ctx, constant.ICO_LOCK)
-	if err != nil {
-		uc.log.Error("ICOTransaction ", err)
-		return errors.New(constant.ERROR_LOCK)
-	}
-	defer func() {
-		uc.lockRepo.UnLock(ctx, constant.ICO_LOCK)
-	}()
 	log.Debugf("ICOTransaction:Context: %v, userId: %s, amount: %s, symbol: %s, sourceId: %s, transType: %s", ctx != nil, userId, amount, symbol, sourceId, transType)
-	return uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
+	var bought decimal.Decimal
+	var firstRound, currentRound *ICOSubRound
+	err := withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
 		uc.GetUserWalletWithSymbol(ctx, userId, constant.TokenSymbolIND)
-		totalToken, err := uc.icoUc.ICOHistories(ctx, userId, amount, symbol, icoType)
+		firstRound, _ = uc.icoRepo.GetCurrentSubRound(ctx)
+		if transType == ICO {
+			if err := uc.icoUc.CheckPurchase(ctx, userId, amount, symbol); err != nil {
+				uc.log.Error("ICOTransaction ", err)
+				var limitErr *ICOLimitError
+				if errors.As(err, &limitErr) {
+					return err
+				}
+				return errors.New(constant.ERROR_INTERNAL)
+			}
+		}
+		totalToken, err := uc.icoUc.ICOHistories(ctx, userId, amount, symbol, sourceId, icoType, transType == ICO)
 		if err != nil {
 			uc.log.Error("ICOTransaction ", err)
+			var limitErr *ICOLimitError
+			if errors.As(err, &limitErr) || err.Error() == constant.ERROR_LOCK || err.Error() == constant.ERROR_ROUND_NOT_STARTED || err.Error() == constant.ERROR_ICO_PAUSED ||
+				err.Error() == constant.ERROR_ROUND_CLOSED {
+				return err
+			}
 			return errors.New(constant.ERROR_INTERNAL)
 		}
 
 		log.Debugf("ICOTransaction:TotalToken: %v", totalToken)
+		bought = totalToken
 
 		amount = totalToken.String()
 		symbol = constant.TokenSymbolIND
@@ -333,7 +399,11 @@ func (uc *WalletTransactionUseCase) ICOTransaction(ctx context.Context, userId,
 			return errors.New(constant.ERROR_BALANCE_NOT_ENOUGH)
 		}
 
-		uc.walletRepo.IncreaseBalance(ctx, userId, symbol, amount, constant.WALLET_TYPE_USER)
+		rs, err = uc.walletRepo.IncreaseBalance(ctx, userId, symbol, amount, constant.WALLET_TYPE_USER)
+		if err != nil || rs == 0 {
+			uc.log.Error("ICOTransaction ", err)
+			return errors.New(constant.ERROR_INTERNAL)
+		}
 		trans := &Transaction{TransType: transType, Source: constant.WALLET_ICO, SrcAmount: amount, SrcSymbol: symbol, Destination: userId, DestSymbol: symbol,
 			DestAmount: amount, SourceId: sourceId, Status: TRANS_STATUS}
 
@@ -342,10 +412,52 @@ func (uc *WalletTransactionUseCase) ICOTransaction(ctx context.Context, userId,
 			uc.log.Error("ICOTransaction ", err)
 			return errors.New(constant.ERROR_INTERNAL)
 		}
-		currentRound, _ := uc.icoRepo.GetCurrentSubRound(ctx)
-		uc.queue.Enqueue(ctx, &Task{Data: currentRound.ID.String(), Name: fmt.Sprintf("%s%s", QUEUE_PREFIX, ENDROUND_SCHEDULE), ProcessAt: currentRound.EndAt})
+		if err := uc.receivePayment(ctx, paid, paidSymbol, sourceId); err != nil {
+			uc.log.Error("ICOTransaction ", err)
+			return errors.New(constant.ERROR_INTERNAL)
+		}
+		if redeem != nil {
+			if err := redeem(ctx); err != nil {
+				uc.log.Error("ICOTransaction ", err)
+				return err
+			}
+		}
+		currentRound, _ = uc.icoRepo.GetCurrentSubRound(ctx)
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	// once committed, the sub-round of a capped round ends now
+	if currentRound != nil {
+		uc.queue.Enqueue(ctx, NewEndRoundTask(currentRound))
+	}
+	// the purchase ended the round it started in
+	if firstRound != nil && (currentRound == nil || currentRound.RoundId != firstRound.RoundId) {
+		uc.queue.Enqueue(ctx, NewSettleRoundTask(firstRound.RoundId))
+	}
+	// once committed, the leaderboard is not part of the transaction
+	uc.icoUc.RecordPurchase(ctx, userId, bought)
+	return nil
+}
+
+// receivePayment credits SYS_ICO_PROCEEDS with the amount symbol a purchase was
+// paid, it funds the reward payouts the reward pool can't.
+func (uc *WalletTransactionUseCase) receivePayment(ctx context.Context, amount, symbol, sourceId string) error {
+	if _, err := uc.GetUserWalletOrCreateWithSymbol(ctx, constant.WALLET_SYS_ICO_PROCEEDS, symbol, constant.WALLET_TYPE_SYSTEM); err != nil {
+		return err
+	}
+	rs, err := uc.walletRepo.IncreaseBalance(ctx, constant.WALLET_SYS_ICO_PROCEEDS, symbol, amount, constant.WALLET_TYPE_SYSTEM)
+	if err != nil {
+		return err
+	}
+	if rs == 0 {
+		return errors.New(constant.ERROR_NOT_FOUND)
+	}
+	trans := &Transaction{TransType: ICO_PAYMENT, Source: constant.WALLET_SYS_DEPOSIT, SrcAmount: amount, SrcSymbol: symbol, Destination: constant.WALLET_SYS_ICO_PROCEEDS, DestSymbol: symbol,
+		DestAmount: amount, SourceId: sourceId, Status: TRANS_STATUS}
+	_, err = uc.CreateTransaction(ctx, trans)
+	return err
 }
 
 func (uc *WalletTransactionUseCase) CreateTransaction(ctx context.Context, trans *Transaction) (*Transaction, error) {
@@ -354,6 +466,11 @@ func (uc *WalletTransactionUseCase) CreateTransaction(ctx context.Context, trans
 		uc.log.Error("CreateTransaction ", err)
 		return nil, errors.New(constant.ERROR_INTERNAL)
 	}
+
+	if err := collectEvent(ctx, uc.walletRepo, transResult); err != nil {
+		uc.log.Error("CreateTransaction ", err)
+		return nil, errors.New(constant.ERROR_INTERNAL)
+	}
 	return transResult, nil
 }
 
@@ -382,7 +499,7 @@ func (uc *WalletTransactionUseCase) ReferralReward(ctx context.Context, userId,
 		return err
 	}
 
-	err = uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
+	err = withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
 		_, err := uc.walletRepo.IncreaseBalance(ctx, userId, symbol, amount, constant.WALLET_TYPE_REWARD)
 		if err != nil {
 			return errors.New(constant.ERROR_INTERNAL)
@@ -404,7 +521,7 @@ func (uc *WalletTransactionUseCase) ReferralReward(ctx context.Context, userId,
 func (uc *WalletTransactionUseCase) GetCurrentRate(ctx context.Context, req *v1.CurrentRateRequest) (*CurrencyRate, error) {
 	currencySymbol := fmt.Sprintf("%s_%s", req.Symbol, constant.TokenSymbolIND)
 	uc.log.Infof("Input symbol value: %s, current value symbol for query: %s", req.Symbol, currencySymbol)
-	currency, err := uc.currencyRateRepo.GetCurrencyRate(ctx, currencySymbol)
+	currency, err := uc.icoUc.GetRate(ctx, currencySymbol)
 	if err != nil {
 		uc.log.Errorf("Get current rate has an error: %s", err.Error())
 		return nil, errors.New(err.Error())
@@ -439,7 +556,7 @@ func (uc *WalletTransactionUseCase) MarketingRewardInternal(ctx context.Context,
 
 	// increase balance and create transaction with the same transaction
 	var transactionID string
-	if err := uc.walletRepo.WithTx(ctx, func(ctx context.Context) error {
+	if err := withEvents(ctx, uc.walletRepo, uc.publisher, func(ctx context.Context) error {
 		_, err := uc.walletRepo.IncreaseBalance(ctx, userID, symbol, amount, constant.WALLET_TYPE_REWARD)
 		if err != nil {
 			return errors.New(constant.ERROR_INTERNAL)
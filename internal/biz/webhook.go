@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/constant"
+)
+
+const (
+	WEBHOOK_DELIVER = "webhook:deliver"
+
+	WEBHOOK_PENDING = "PENDING"
+	WEBHOOK_SUCCESS = "SUCCESS"
+	WEBHOOK_FAILED  = "FAILED"
+
+	WEBHOOK_HEADER_ID        = "X-Webhook-Id"
+	WEBHOOK_HEADER_EVENT     = "X-Webhook-Event"
+	WEBHOOK_HEADER_TIMESTAMP = "X-Webhook-Timestamp"
+	WEBHOOK_HEADER_SIGNATURE = "X-Webhook-Signature"
+)
+
+// WebhookQueue schedules a delivery attempt, retries are left to the queue.
+type WebhookQueue interface {
+	EnqueueDelivery(ctx context.Context, deliveryId string) error
+}
+
+// WebhookSender posts body to url and returns the response status code.
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+type WebhookUsecase struct {
+	repo   WebhookRepo
+	queue  WebhookQueue
+	sender WebhookSender
+	log    *log.Helper
+}
+
+func NewWebhookUsecase(repo WebhookRepo, queue WebhookQueue, sender WebhookSender) *WebhookUsecase {
+	return &WebhookUsecase{
+		repo:   repo,
+		queue:  queue,
+		sender: sender,
+		log:    log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// CreateWebhook registers a subscription, a secret is generated when none is
+// given. A subscription without users gets the wallet changes of everyone, it
+// needs allUsers.
+func (uc *WebhookUsecase) CreateWebhook(ctx context.Context, input *Webhook, allUsers bool) (*Webhook, error) {
+	if len(input.Owner) == 0 {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if len(input.UserIDs) == 0 && !allUsers {
+		return nil, errors.New(constant.ERROR_WEBHOOK_SCOPE)
+	}
+	u, err := url.Parse(input.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Hostname()) == 0 {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	if err := checkPublicHost(ctx, u.Hostname()); err != nil {
+		return nil, err
+	}
+
+	if len(input.Secret) == 0 {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		input.Secret = hex.EncodeToString(secret)
+	}
+
+	return uc.repo.CreateWebhook(ctx, input)
+}
+
+func (uc *WebhookUsecase) GetWebhooks(ctx context.Context, owner string) ([]*Webhook, error) {
+	return uc.repo.GetWebhooksByOwner(ctx, owner)
+}
+
+func (uc *WebhookUsecase) DeleteWebhook(ctx context.Context, owner, id string) error {
+	if _, err := uc.getOwnedWebhook(ctx, owner, id); err != nil {
+		return err
+	}
+	return uc.repo.DeleteWebhook(ctx, id)
+}
+
+func (uc *WebhookUsecase) GetDeliveries(ctx context.Context, owner, webhookId, cursor string, limit int32) ([]*WebhookDelivery, string, error) {
+	if _, err := uc.getOwnedWebhook(ctx, owner, webhookId); err != nil {
+		return nil, "", err
+	}
+	if limit <= 0 || limit > constant.DEFAULT_LIMIT {
+		limit = constant.DEFAULT_LIMIT
+	}
+	return uc.repo.GetDeliveries(ctx, webhookId, cursor, limit)
+}
+
+// Redeliver resets a delivery of a webhook of owner and queues it again,
+// whatever its current status.
+func (uc *WebhookUsecase) Redeliver(ctx context.Context, owner, deliveryId string) (*WebhookDelivery, error) {
+	delivery, err := uc.repo.GetDelivery(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	if _, err := uc.getOwnedWebhook(ctx, owner, delivery.WebhookID); err != nil {
+		return nil, err
+	}
+
+	delivery.Status = WEBHOOK_PENDING
+	if err := uc.repo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, uc.queue.EnqueueDelivery(ctx, delivery.ID.String())
+}
+
+// getOwnedWebhook returns webhook id, ERROR_NOT_FOUND when it does not belong
+// to owner so other owners' ids are not disclosed.
+func (uc *WebhookUsecase) getOwnedWebhook(ctx context.Context, owner, id string) (*Webhook, error) {
+	if len(owner) == 0 {
+		return nil, errors.New(constant.ERROR_BAD_REQUEST)
+	}
+	webhook, err := uc.repo.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil || webhook.Owner != owner {
+		return nil, errors.New(constant.ERROR_NOT_FOUND)
+	}
+	return webhook, nil
+}
+
+// Dispatch records a delivery for every webhook whose filters match the event and
+// queues it. payload is sent as is, it must be the JSON form of the event.
+func (uc *WebhookUsecase) Dispatch(ctx context.Context, event *TransactionEvent, eventType string, payload []byte) {
+	webhooks, err := uc.repo.GetActiveWebhooks(ctx)
+	if err != nil {
+		uc.log.Errorf("Dispatch %s get webhooks: %v", event.ID, err)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Match(event.Transaction) {
+			continue
+		}
+
+		delivery, err := uc.repo.CreateDelivery(ctx, &WebhookDelivery{WebhookID: webhook.ID.String(), EventID: event.ID, EventType: eventType, Payload: string(payload), Status: WEBHOOK_PENDING})
+		if err != nil {
+			uc.log.Errorf("Dispatch %s to webhook %s: %v", event.ID, webhook.ID, err)
+			continue
+		}
+		if delivery == nil {
+			continue
+		}
+
+		if err := uc.queue.EnqueueDelivery(ctx, delivery.ID.String()); err != nil {
+			uc.log.Errorf("Dispatch enqueue delivery %s: %v", delivery.ID, err)
+		}
+	}
+}
+
+// Deliver makes one attempt of a delivery. It returns an error when the attempt
+// failed so the queue retries it, lastAttempt marks the delivery as FAILED.
+func (uc *WebhookUsecase) Deliver(ctx context.Context, deliveryId string, lastAttempt bool) error {
+	delivery, err := uc.repo.GetDelivery(ctx, deliveryId)
+	if err != nil {
+		return err
+	}
+	if delivery == nil || delivery.Status == WEBHOOK_SUCCESS {
+		return nil
+	}
+
+	webhook, err := uc.repo.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	if webhook == nil {
+		// subscription removed, nothing to retry
+		delivery.Status = WEBHOOK_FAILED
+		delivery.LastError = constant.ERROR_NOT_FOUND
+		return uc.repo.UpdateDelivery(ctx, delivery)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	headers := map[string]string{
+		WEBHOOK_HEADER_ID:        delivery.EventID,
+		WEBHOOK_HEADER_EVENT:     delivery.EventType,
+		WEBHOOK_HEADER_TIMESTAMP: timestamp,
+		WEBHOOK_HEADER_SIGNATURE: "sha256=" + Sign(webhook.Secret, timestamp, []byte(delivery.Payload)),
+	}
+
+	code, sendErr := uc.sender.Send(ctx, webhook.URL, headers, []byte(delivery.Payload))
+	if sendErr == nil && (code < 200 || code > 299) {
+		sendErr = fmt.Errorf("unexpected status %d", code)
+	}
+
+	delivery.Attempts++
+	delivery.ResponseCode = int32(code)
+	if sendErr == nil {
+		now := time.Now()
+		delivery.Status = WEBHOOK_SUCCESS
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.LastError = sendErr.Error()
+		if lastAttempt {
+			delivery.Status = WEBHOOK_FAILED
+		}
+	}
+
+	if err := uc.repo.UpdateDelivery(ctx, delivery); err != nil {
+		uc.log.Errorf("Deliver %s update: %v", deliveryId, err)
+	}
+	return sendErr
+}
+
+// Match reports whether the transaction passes the webhook filters. Empty
+// filters match everything.
+func (w *Webhook) Match(trans *Transaction) bool {
+	if len(w.TransTypes) > 0 && !contains(w.TransTypes, trans.TransType) {
+		return false
+	}
+	if len(w.UserIDs) > 0 && !contains(w.UserIDs, trans.Source) && !contains(w.UserIDs, trans.Destination) {
+		return false
+	}
+	return true
+}
+
+// Sign returns the hex HMAC-SHA256 of "timestamp.body" keyed by secret, as sent
+// in the X-Webhook-Signature header.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkPublicHost rejects a webhook host that is, or resolves to, an address
+// the service must not call: loopback, link-local, private or unspecified.
+func checkPublicHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return errors.New(constant.ERROR_WEBHOOK_HOST)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return errors.New(constant.ERROR_WEBHOOK_HOST)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return errors.New(constant.ERROR_WEBHOOK_HOST)
+		}
+	}
+	return nil
+}
+
+// IsPublicIP reports whether webhooks may be delivered to ip. The sender checks
+// it again on connect, the host may resolve elsewhere after CreateWebhook.
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() || sharedAddressSpace.Contains(ip))
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, private to
+// the network of the provider.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
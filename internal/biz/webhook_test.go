@@ -0,0 +1,55 @@
+package biz_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for ip, want := range map[string]bool{
+		"93.184.216.34":     true,
+		"100.63.255.255":    true,
+		"100.64.0.1":        false,
+		"100.127.255.254":   false,
+		"::ffff:100.64.0.1": false,
+		"100.128.0.1":       true,
+		"10.1.2.3":          false,
+		"127.0.0.1":         false,
+		"169.254.169.254":   false,
+		"::1":               false,
+		"2606:4700::1111":   true,
+	} {
+		if got := biz.IsPublicIP(net.ParseIP(ip)); got != want {
+			t.Errorf("IsPublicIP(%s) = %v, want %v", ip, got, want)
+		}
+	}
+}
+
+// Only a caller allowed every user's changes may leave the users out.
+func TestCreateWebhookScope(t *testing.T) {
+	uc := biz.NewWebhookUsecase(memrepo.NewWebhookRepo(memrepo.NewStore()), nil, nil)
+	ctx := context.Background()
+
+	scoped := &biz.Webhook{Owner: "o1", URL: "https://93.184.216.34/hook", UserIDs: []string{"u1"}}
+	if _, err := uc.CreateWebhook(ctx, scoped, false); err != nil {
+		t.Fatalf("scoped webhook: %v", err)
+	}
+
+	unscoped := &biz.Webhook{Owner: "o1", URL: "https://93.184.216.34/hook"}
+	if _, err := uc.CreateWebhook(ctx, unscoped, false); err == nil || err.Error() != constant.ERROR_WEBHOOK_SCOPE {
+		t.Errorf("unscoped webhook of a user: err %v, want %s", err, constant.ERROR_WEBHOOK_SCOPE)
+	}
+	if _, err := uc.CreateWebhook(ctx, unscoped, true); err != nil {
+		t.Errorf("unscoped webhook of an admin: %v", err)
+	}
+
+	cgnat := &biz.Webhook{Owner: "o1", URL: "http://100.100.100.200/hook", UserIDs: []string{"u1"}}
+	if _, err := uc.CreateWebhook(ctx, cgnat, true); err == nil || err.Error() != constant.ERROR_WEBHOOK_HOST {
+		t.Errorf("webhook to a shared address: err %v, want %s", err, constant.ERROR_WEBHOOK_HOST)
+	}
+}
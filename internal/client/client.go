@@ -11,7 +11,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-var ProviderSet = wire.NewSet(NewProfileClient)
+var ProviderSet = wire.NewSet(NewProfileClient, NewWebhookClient, NewUserTierClient)
 
 func grpcConnection(host string, timeout time.Duration) (*grpc.ClientConn, error) {
 	return kgrpc.DialInsecure(
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+)
+
+const tierSourceProfile = "profile"
+
+type userTierClient struct {
+	profile     *ProfileClient
+	defaultTier string
+}
+
+// NewUserTierClient returns the biz.UserTierRepo configured by conf.Tier, users
+// get the default tier unless the source is the profile service.
+func NewUserTierClient(conf *conf.Data) (biz.UserTierRepo, error) {
+	c := &userTierClient{defaultTier: conf.Tier.GetDefaultTier()}
+	if conf.Tier.GetSource() == tierSourceProfile {
+		profile, err := NewProfileClient(conf)
+		if err != nil {
+			return nil, err
+		}
+		c.profile = profile
+	}
+	return c, nil
+}
+
+// GetUserTier implements biz.UserTierRepo.
+func (c *userTierClient) GetUserTier(ctx context.Context, userId string) (string, error) {
+	if c.profile == nil {
+		return c.defaultTier, nil
+	}
+	profiles, err := c.profile.GetProfileByUsers(ctx, []string{userId})
+	if err != nil {
+		return "", err
+	}
+	profile, ok := profiles[userId]
+	if !ok {
+		return c.defaultTier, nil
+	}
+	return fmt.Sprintf("KYC%d", profile.KycLevel), nil
+}
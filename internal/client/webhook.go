@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+type webhookClient struct {
+	client *http.Client
+}
+
+func NewWebhookClient(conf *conf.Data) biz.WebhookSender {
+	timeout := defaultWebhookTimeout
+	if conf.Webhook != nil && conf.Webhook.Timeout != nil {
+		timeout = conf.Webhook.Timeout.AsDuration()
+	}
+	// the host was checked on CreateWebhook, the addresses dialed are checked
+	// again as it may resolve elsewhere since, or redirect
+	dialer := &net.Dialer{Timeout: timeout, Control: dialPublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &webhookClient{client: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !biz.IsPublicIP(ip) {
+		return errors.New(constant.ERROR_WEBHOOK_HOST)
+	}
+	return nil
+}
+
+// Send implements biz.WebhookSender.
+func (c *webhookClient) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
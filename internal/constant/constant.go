@@ -22,6 +22,7 @@ const (
 	WALLET_SYS_DEPOSIT         = "SYS_DEPOSIT"
 	WALLET_SYS_ICO_BACKUP      = "SYS_ICO"
 	WALLET_SYS_ICO_REWARD      = "SYS_ICO_REWARD"
+	WALLET_SYS_ICO_PROCEEDS    = "SYS_ICO_PROCEEDS" // what the ICO purchases were paid, the payments are taken outside the service
 	WALLET_SYS_REFERRAL_REWARD = "SYS_REFERRAL_REWARD"
 	WALLET_SYS_TOKEN           = "SYS_TOKENNOMIC"
 	WALLET_SYS_TEAM            = "SYS_TEAM"
@@ -32,6 +33,9 @@ const (
 	WALLET_SYS_ADVISOR         = "SYS_ADVISOR"
 	WALLET_SYS_ECOFUND         = "SYS_ECOFUND"
 	WalletSysMarketingReward   = "SYS_MARKETING_REWARD"
+	// WALLET_SYS_BURN is the destination of the burn transactions, no wallet
+	// holds the burned tokens.
+	WALLET_SYS_BURN = "SYS_BURN"
 
 	// Price map
 	VND_IND = "550"
@@ -46,10 +50,56 @@ const (
 	ERROR_BAD_REQUEST        = "BAD_REQUEST"
 	ERROR_BALANCE_NOT_ENOUGH = "BALANCE_NOT_ENOUGH"
 	ERROR_LOCK               = "ICO_INPROCESS"
+	ERROR_INVARIANT          = "INVARIANT_VIOLATED"
+	// a tokenomics version was applied with a different content
+	ERROR_TOKENOMICS_APPLIED  = "TOKENOMICS_VERSION_APPLIED"
+	ERROR_TOKENOMICS_CONFLICT = "TOKENOMICS_CONFLICT"
+	// a round or sub-round that ended, or one that sold tokens or is running, can't be edited
+	ERROR_ROUND_CLOSED      = "ROUND_CLOSED"
+	ERROR_ROUND_STARTED     = "ROUND_STARTED"
+	ERROR_ROUND_NOT_STARTED = "ROUND_NOT_STARTED"
+	ERROR_SUB_ROUNDS_EDITED = "SUB_ROUNDS_EDITED" // splitting the round again would drop sub-round changes
+	// purchases are refused while the sale is paused
+	ERROR_ICO_PAUSED = "ICO_PAUSED"
+	// a purchase outside the limits of the round, or from a tier it doesn't admit
+	ERROR_ICO_BELOW_MIN_PURCHASE = "ICO_BELOW_MIN_PURCHASE"
+	ERROR_ICO_ABOVE_MAX_PER_TX   = "ICO_ABOVE_MAX_PER_TX"
+	ERROR_ICO_ABOVE_MAX_PER_USER = "ICO_ABOVE_MAX_PER_USER"
+	ERROR_ICO_TIER_NOT_ALLOWED   = "ICO_TIER_NOT_ALLOWED"
+	// a purchase that would raise the round past its hard cap
+	ERROR_ICO_ABOVE_HARD_CAP = "ICO_ABOVE_HARD_CAP"
 
-	ICO_LOCK = "ICO_LOCK"
+	ERROR_COUPON_EXISTS = "COUPON_EXISTS"
+	// a coupon that can't apply to the purchase
+	ERROR_COUPON_NOT_STARTED        = "COUPON_NOT_STARTED"
+	ERROR_COUPON_EXPIRED            = "COUPON_EXPIRED"
+	ERROR_COUPON_USED_UP            = "COUPON_USED_UP"
+	ERROR_COUPON_ALREADY_USED       = "COUPON_ALREADY_USED"
+	ERROR_COUPON_BELOW_MIN_PURCHASE = "COUPON_BELOW_MIN_PURCHASE"
+	// no free code found for a generated coupon, the alphabet and length are too few
+	ERROR_COUPON_CODES_EXHAUSTED = "COUPON_CODES_EXHAUSTED"
+
+	ERROR_REFERRER_EXISTS = "REFERRER_EXISTS"
+	// SYS_ICO_REWARD can't fund a coupon or referral payout
+	ERROR_REWARD_POOL_SHORT = "REWARD_POOL_SHORT"
+
+	// a webhook url whose host is loopback, link-local or private, a webhook
+	// to every user asked by a caller other than ADMIN
+	ERROR_WEBHOOK_HOST  = "WEBHOOK_HOST_NOT_ALLOWED"
+	ERROR_WEBHOOK_SCOPE = "WEBHOOK_SCOPE_REQUIRED"
+
+	// a purchase past the refund window, or refunded before
+	ERROR_REFUND_WINDOW_CLOSED = "REFUND_WINDOW_CLOSED"
+	ERROR_ALREADY_REFUNDED     = "ALREADY_REFUNDED"
+
+	ICO_LOCK      = "ICO_LOCK"
+	ICO_USER_LOCK = "ICO_USER_LOCK"
+	// ICO_COUPON_LOCK serializes the purchases with a coupon that has a cap
+	ICO_COUPON_LOCK = "ICO_COUPON_LOCK"
 
 	TRANS_INTERNAL = "INTERNAL"
+
+	SERVICE_NAME = "wallet-service"
 )
 
 var (
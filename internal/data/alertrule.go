@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/alertrule"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type alertRuleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAlertRuleRepo(data *Data) biz.AlertRuleRepo {
+	return &alertRuleRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// SaveAlertRule implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) SaveAlertRule(ctx context.Context, input *biz.AlertRule) (*biz.AlertRule, error) {
+	client := r.data.GetClient(ctx)
+	err := client.AlertRule.Create().SetUserID(input.UserID).SetType(input.Type).SetSymbol(input.Symbol).SetThreshold(input.Threshold).
+		OnConflictColumns(alertrule.FieldUserID, alertrule.FieldType, alertrule.FieldSymbol).UpdateThreshold().UpdateUpdatedAt().Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := client.AlertRule.Query().Where(alertrule.UserID(input.UserID), alertrule.Type(input.Type), alertrule.Symbol(input.Symbol)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapToBiz(rs), nil
+}
+
+// GetAlertRulesByUserIds implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) GetAlertRulesByUserIds(ctx context.Context, userIds []string) ([]*biz.AlertRule, error) {
+	rules, err := r.data.GetClient(ctx).AlertRule.Query().Where(alertrule.UserIDIn(userIds...)).Order(ent.Asc(alertrule.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.AlertRule, len(rules))
+	for i, rule := range rules {
+		rs[i] = r.mapToBiz(rule)
+	}
+	return rs, nil
+}
+
+// DeleteAlertRule implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) DeleteAlertRule(ctx context.Context, userId, id string) error {
+	ruleId, err := xid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.GetClient(ctx).AlertRule.Delete().Where(alertrule.ID(ruleId), alertrule.UserID(userId)).Exec(ctx)
+	return err
+}
+
+func (r *alertRuleRepo) mapToBiz(en *ent.AlertRule) *biz.AlertRule {
+	return &biz.AlertRule{ID: en.ID, UpdatedAt: en.UpdatedAt, UserID: en.UserID, Type: en.Type, Symbol: en.Symbol, Threshold: en.Threshold}
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/auditlog"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type auditLogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditLogRepo(data *Data) biz.AuditLogRepo {
+	return &auditLogRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// CreateAuditLog implements biz.AuditLogRepo.
+func (r *auditLogRepo) CreateAuditLog(ctx context.Context, input *biz.AuditLog) error {
+	return r.data.GetClient(ctx).AuditLog.Create().SetActor(input.Actor).SetService(input.Service).SetRoles(input.Roles).
+		SetRPC(input.Rpc).SetTargetUserID(input.TargetUserID).SetPayloadHash(input.PayloadHash).SetBefore(input.Before).
+		SetAfter(input.After).SetOutcome(input.Outcome).SetError(input.Error).Exec(ctx)
+}
+
+// GetAuditLogs implements biz.AuditLogRepo.
+func (r *auditLogRepo) GetAuditLogs(ctx context.Context, filter *biz.AuditLogFilter, cursor string, limit int32) ([]*biz.AuditLog, string, error) {
+	var where []predicate.AuditLog
+	if len(filter.Actor) > 0 {
+		where = append(where, auditlog.Actor(filter.Actor))
+	}
+	if len(filter.Service) > 0 {
+		where = append(where, auditlog.Service(filter.Service))
+	}
+	if len(filter.Rpc) > 0 {
+		where = append(where, auditlog.RPC(filter.Rpc))
+	}
+	if len(filter.TargetUserID) > 0 {
+		where = append(where, auditlog.TargetUserID(filter.TargetUserID))
+	}
+	if len(filter.Outcome) > 0 {
+		where = append(where, auditlog.Outcome(filter.Outcome))
+	}
+	if filter.From != nil {
+		where = append(where, auditlog.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		where = append(where, auditlog.CreatedAtLT(*filter.To))
+	}
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		where = append(where, auditlog.IDLT(id))
+	}
+
+	logs, err := r.data.GetClient(ctx).AuditLog.Query().Where(where...).Order(ent.Desc(auditlog.FieldID)).Limit(int(limit)).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rs = make([]*biz.AuditLog, len(logs))
+	next := ""
+	for i, l := range logs {
+		rs[i] = r.mapToBiz(l)
+		next = l.ID.String()
+	}
+
+	if len(rs) < int(limit) {
+		next = ""
+	}
+
+	return rs, next, nil
+}
+
+func (r *auditLogRepo) mapToBiz(en *ent.AuditLog) *biz.AuditLog {
+	return &biz.AuditLog{ID: en.ID, CreatedAt: en.CreatedAt, Actor: en.Actor, Service: en.Service, Roles: en.Roles, Rpc: en.RPC,
+		TargetUserID: en.TargetUserID, PayloadHash: en.PayloadHash, Before: en.Before, After: en.After, Outcome: en.Outcome, Error: en.Error}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -13,19 +14,27 @@ import (
 	"github.com/indikay/go-core/database/redisdb"
 	"github.com/indikay/wallet-service/ent"
 	"github.com/indikay/wallet-service/ent/migrate/migratedata"
+	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/conf"
 
 	// init postgres driver
 	_ "github.com/jackc/pgx/v5/stdlib"
+	// init sqlite driver
+	_ "github.com/mattn/go-sqlite3"
+	// ent defaults and hooks, the schema has hooks so they live in a separate package
+	_ "github.com/indikay/wallet-service/ent/runtime"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewIcoRepo, NewWalletRepo, NewTransactionRepo, NewCurrencyRepo, NewIcoCouponRepo, NewLockRepo)
+var ProviderSet = wire.NewSet(NewData, NewIcoRepo, NewWalletRepo, NewTransactionRepo, NewCurrencyRepo, NewIcoCouponRepo, NewLockRepo, NewWebhookRepo, NewAlertRuleRepo, NewAuditLogRepo, NewInvariantRepo, NewTokenomicsRepo, NewLeaderboardRepo, NewICOStatsRepo, NewReferralRepo, NewICORefundRepo)
 
 type Data struct {
 	db       *ent.Client
 	sql      *entsql.Driver
+	dialect  string
 	redisCli *redisdb.RedisClient
+	// inTx is set on the data of the migration, which already runs in a transaction
+	inTx bool
 }
 
 var DataClient *ent.Client
@@ -33,16 +42,21 @@ var DataClient *ent.Client
 // NewData .
 func NewData(conf *conf.Data) (*Data, func(), error) {
 	log := log.NewHelper(log.DefaultLogger)
+	dialectName := dialectOf(conf.Database.Driver)
+	source := conf.Database.Source
+	if dialectName == dialect.SQLite {
+		source = sqliteSource(source)
+	}
 	db, err := sql.Open(
 		conf.Database.Driver,
-		conf.Database.Source,
+		source,
 	)
 	if err != nil {
 		log.Errorf("failed opening connection to db: %v", err)
 		return nil, nil, err
 	}
 
-	sqlDrv := entsql.OpenDB(dialect.Postgres, db)
+	sqlDrv := entsql.OpenDB(dialectName, db)
 	// sqlDrv := dialect.DebugWithContext(drv, func(ctx context.Context, i ...interface{}) {
 	// 	log.WithContext(ctx).Debug(i...)
 	// 	tracer := otel.Tracer("ent.")
@@ -59,7 +73,33 @@ func NewData(conf *conf.Data) (*Data, func(), error) {
 	client := ent.NewClient(ent.Driver(sqlDrv))
 
 	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background(), schema.WithApplyHook(migratedata.ApplyTokenomic)); err != nil {
+	// the embedded tokenomics is applied and the invariants checked on the migration transaction,
+	// a migration breaking them is rolled back
+	seed := func(ctx context.Context, client *ent.Client) ([]string, error) {
+		def, err := biz.ParseTokenomics(migratedata.Tokenomics)
+		if err != nil {
+			return nil, err
+		}
+		d := &Data{db: client, dialect: dialectName, inTx: true}
+		tokenomicsUc := biz.NewTokenomicsUsecase(NewTokenomicsRepo(d), NewIcoRepo(d), NewWalletRepo(d), NewTransactionRepo(d))
+		plan, err := tokenomicsUc.Apply(ctx, def)
+		if err != nil {
+			return nil, err
+		}
+		// the wallets the purchases are paid into and their rewards paid out of
+		seeded, err := tokenomicsUc.CreatePaymentWallets(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !plan.Applied {
+			seeded = append(seeded, "tokenomics "+def.Version)
+		}
+		return seeded, nil
+	}
+	verify := func(ctx context.Context, client *ent.Client) error {
+		return biz.NewInvariantUsecase(NewInvariantRepo(&Data{db: client, dialect: dialectName, inTx: true})).Verify(ctx, conf.Invariant.GetSupply())
+	}
+	if err := client.Schema.Create(context.Background(), schema.WithApplyHook(migratedata.ApplyTokenomic(dialectName, seed, verify))); err != nil {
 		log.Errorf("failed creating schema resources: %v", err)
 		return nil, nil, err
 	}
@@ -68,6 +108,7 @@ func NewData(conf *conf.Data) (*Data, func(), error) {
 	d := &Data{
 		db:       client,
 		sql:      sqlDrv,
+		dialect:  dialectName,
 		redisCli: redisdb.NewRedisClient(conf.Redis),
 	}
 	return d, func() {
@@ -78,6 +119,35 @@ func NewData(conf *conf.Data) (*Data, func(), error) {
 	}, nil
 }
 
+// dialectOf maps the database/sql driver name to the ent dialect, postgres by default.
+func dialectOf(driver string) string {
+	switch driver {
+	case dialect.SQLite:
+		return dialect.SQLite
+	default:
+		return dialect.Postgres
+	}
+}
+
+// sqliteSource adds the connection options the data layer relies on, unless
+// the source sets them: foreign keys for ent, and transactions that take the
+// write lock when they begin, so they run one at a time instead of failing.
+func sqliteSource(source string) string {
+	options := []string{"_fk=1", "_busy_timeout=10000", "_txlock=immediate"}
+	for _, option := range options {
+		name := strings.SplitN(option, "=", 2)[0]
+		if strings.Contains(source, name+"=") {
+			continue
+		}
+		if strings.Contains(source, "?") {
+			source += "&" + option
+		} else {
+			source += "?" + option
+		}
+	}
+	return source
+}
+
 func (d *Data) GetSQL(ctx context.Context) *entsql.Driver {
 	return d.sql
 }
@@ -111,17 +181,22 @@ func (d *Data) TxCompleted(ctx context.Context) context.Context {
 }
 
 func (r *Data) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if r.inTx {
+		// the caller commits or rolls back
+		return fn(ctx)
+	}
 	var err error
 	tx, ok := r.TxFromContext(ctx)
-	if !ok || tx == nil {
-		log.Infof("Transaction: %v", tx)
-		ntx, err := r.GetClient(ctx).Tx(ctx)
-		if err != nil {
-			return err
-		}
-		tx = ntx
-		ctx = r.NewTxContext(ctx, tx)
+	if ok && tx != nil {
+		// joins the transaction of the caller, which commits or rolls back
+		return fn(ctx)
+	}
+	log.Infof("Transaction: %v", tx)
+	tx, err = r.GetClient(ctx).Tx(ctx)
+	if err != nil {
+		return err
 	}
+	ctx = r.NewTxContext(ctx, tx)
 	// tx, err := r.db.Tx(ctx)
 	// if err != nil {
 	// 	return err
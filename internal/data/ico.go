@@ -2,14 +2,19 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/wallet-service/ent"
 	"github.com/indikay/wallet-service/ent/ico"
+	"github.com/indikay/wallet-service/ent/icohistory"
 	"github.com/indikay/wallet-service/ent/icoround"
+	"github.com/indikay/wallet-service/ent/predicate"
 	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/constant"
 	"github.com/rs/xid"
@@ -36,20 +41,7 @@ func (r *icoRepo) GetCurrentSubRound(ctx context.Context) (*biz.ICOSubRound, err
 		return nil, err
 	}
 
-	resp := biz.ICOSubRound{ID: round.ID,
-		RoundId:     round.RoundID,
-		SubRound:    round.SubRound,
-		Price:       round.Price,
-		TotalToken:  round.NumToken,
-		BoughtToken: round.BoughtToken,
-		IsEnded:     round.IsClose,
-	}
-
-	if round.EndAt != nil {
-		resp.EndAt = *round.EndAt
-	}
-
-	return &resp, nil
+	return r.mapSubRoundToBiz(round), nil
 }
 
 // GetSubRoundById implements biz.ICORepo.
@@ -62,18 +54,7 @@ func (r *icoRepo) GetSubRoundById(ctx context.Context, id string) (*biz.ICOSubRo
 	if err != nil {
 		return nil, err
 	}
-
-	resp := &biz.ICOSubRound{ID: round.ID,
-		RoundId:     round.RoundID,
-		SubRound:    round.SubRound,
-		Price:       round.Price,
-		TotalToken:  round.NumToken,
-		BoughtToken: round.BoughtToken,
-		IsEnded:     round.IsClose}
-	if round.EndAt != nil {
-		resp.EndAt = *round.EndAt
-	}
-	return resp, nil
+	return r.mapSubRoundToBiz(round), nil
 }
 
 func (r *icoRepo) CloseSubRound(ctx context.Context, roundId, subRound int32, boughtToken string) (*biz.ICOSubRound, error) {
@@ -94,24 +75,88 @@ func (r *icoRepo) CloseSubRound(ctx context.Context, roundId, subRound int32, bo
 		return nil, err
 	}
 
-	now := time.Now()
-	lifetime, _ := strconv.Atoi(constant.SUBROUND_LIFETIME)
-	round, err = r.data.GetClient(ctx).IcoRound.UpdateOneID(round.ID).SetStartAt(now).SetEndAt(now.Add(time.Duration(lifetime) * time.Minute)).Save(ctx)
+	var roundLifetime int32
+	parent, err := r.data.GetClient(ctx).Ico.Query().Where(ico.RoundID(round.RoundID)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if parent != nil {
+		roundLifetime = parent.Lifetime
+	}
+	startAt, endAt := biz.SubRoundWindow(time.Now(), r.mapSubRoundToBiz(round), roundLifetime)
+	round, err = r.data.GetClient(ctx).IcoRound.UpdateOneID(round.ID).SetStartAt(startAt).SetEndAt(endAt).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &biz.ICOSubRound{ID: round.ID, RoundId: round.RoundID, SubRound: round.SubRound, Price: round.Price, TotalToken: round.NumToken, BoughtToken: round.BoughtToken, EndAt: *round.EndAt, IsEnded: round.IsClose}, nil
+	return r.mapSubRoundToBiz(round), nil
 }
 
-func (r *icoRepo) UpdateSubRoundBought(ctx context.Context, roundId, subRound int32, boughtToken string) error {
-	_, err := r.data.GetClient(ctx).IcoRound.Update().SetBoughtToken(boughtToken).Where(icoround.RoundID(roundId), icoround.SubRound(subRound)).Save(ctx)
-	return err
+// TakeSubRoundToken implements biz.ICORepo. The check and the increment are one
+// statement, so concurrent purchases never oversell a sub-round.
+func (r *icoRepo) TakeSubRoundToken(ctx context.Context, id xid.ID, numToken string) (bool, error) {
+	if r.data.dialect == dialect.SQLite {
+		return r.swapSubRoundToken(ctx, id, numToken)
+	}
+	rs, err := r.data.GetClient(ctx).ExecContext(ctx, `UPDATE ico_rounds SET bought_token = (bought_token::numeric + $1::numeric)::text, updated_at = now()
+		WHERE id = $2 AND is_close = false AND paused_at IS NULL AND bought_token::numeric + $1::numeric <= num_token::numeric`, numToken, id.String())
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := rs.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+// swapSubRoundToken is TakeSubRoundToken for dialects without decimal arithmetic
+// in SQL: bought_token is only written if no other purchase changed it meanwhile.
+func (r *icoRepo) swapSubRoundToken(ctx context.Context, id xid.ID, numToken string) (bool, error) {
+	value, err := decimal.NewFromString(numToken)
+	if err != nil {
+		return false, err
+	}
+
+	round, err := r.data.GetClient(ctx).IcoRound.Query().Where(icoround.ID(id), icoround.IsClose(false), icoround.PausedAtIsNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	bought := decimal.RequireFromString(round.BoughtToken).Add(value)
+	if bought.GreaterThan(decimal.RequireFromString(round.NumToken)) {
+		return false, nil
+	}
+	updated, err := r.data.GetClient(ctx).IcoRound.Update().SetBoughtToken(bought.String()).
+		Where(icoround.ID(id), icoround.IsClose(false), icoround.BoughtToken(round.BoughtToken)).Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+// LockSubRound implements biz.ICORepo. SQLite has no row locks, its
+// transactions already hold the database write lock.
+func (r *icoRepo) LockSubRound(ctx context.Context, id xid.ID) (*biz.ICOSubRound, error) {
+	round, err := r.data.GetClient(ctx).IcoRound.Query().Where(icoround.ID(id)).Modify(func(s *sql.Selector) {
+		if r.data.dialect != dialect.SQLite {
+			s.ForUpdate()
+		}
+	}).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapSubRoundToBiz(round), nil
 }
 
 func (r *icoRepo) SaveHistories(ctx context.Context, histories []biz.ICOHistory) error {
 	domainHistories := make([]*ent.IcoHistoryCreate, len(histories))
 	for i, v := range histories {
-		domainHistories[i] = r.data.GetClient(ctx).IcoHistory.Create().SetRoundID(v.RoundId).SetNumToken(v.NumToken).SetPrice(v.Price).SetSubRound(v.SubRound).SetUserID(v.UserId).SetType(v.Type)
+		domainHistories[i] = r.data.GetClient(ctx).IcoHistory.Create().SetRoundID(v.RoundId).SetNumToken(v.NumToken).SetPrice(v.Price).SetSubRound(v.SubRound).SetUserID(v.UserId).SetType(v.Type).
+			SetSourceID(v.SourceId).SetSymbol(v.Symbol).SetAmount(v.Amount)
 	}
 	_, err := r.data.GetClient(ctx).IcoHistory.CreateBulk(domainHistories...).Save(ctx)
 	return err
@@ -123,7 +168,7 @@ func (r *icoRepo) GetRoundByRoundId(ctx context.Context, roundId int32) (*biz.IC
 		return nil, err
 	}
 
-	return &biz.ICORound{ID: round.ID, RoundId: int32(round.RoundID), RoundName: round.RoundName, Price: round.Price, NumToken: round.NumToken, PriceGap: round.PriceGap}, nil
+	return r.mapRoundToBiz(round), nil
 }
 
 func (r *icoRepo) EndRoundByRoundId(ctx context.Context, roundId int32) error {
@@ -140,11 +185,116 @@ func (r *icoRepo) GetRounds(ctx context.Context) ([]*biz.ICORound, error) {
 
 	var rs = make([]*biz.ICORound, len(rounds))
 	for i, round := range rounds {
-		rs[i] = &biz.ICORound{ID: round.ID, RoundId: int32(round.RoundID), RoundName: round.RoundName, Price: round.Price, NumToken: round.NumToken, PriceGap: round.PriceGap}
+		rs[i] = r.mapRoundToBiz(round)
 	}
 	return rs, nil
 }
 
+// SaveRound implements biz.ICORepo.
+func (r *icoRepo) SaveRound(ctx context.Context, input *biz.ICORound) error {
+	limits, err := marshalOptional(input.Limits, input.Limits.IsZero())
+	if err != nil {
+		return err
+	}
+	pricing, err := marshalOptional(input.Pricing, input.Pricing.IsStep())
+	if err != nil {
+		return err
+	}
+	unsold, err := marshalOptional(input.Unsold, input.Unsold.IsDefault())
+	if err != nil {
+		return err
+	}
+
+	updated, err := r.data.GetClient(ctx).Ico.Update().Where(ico.RoundID(input.RoundId)).SetRoundName(input.RoundName).SetPrice(input.Price).
+		SetNumToken(input.NumToken).SetNumSub(input.NumSub).SetPriceGap(input.PriceGap).SetLifetime(input.Lifetime).SetLimits(limits).SetPricing(pricing).SetUnsold(unsold).
+		SetSoftCap(input.SoftCap).SetHardCap(input.HardCap).Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+	return r.data.GetClient(ctx).Ico.Create().SetRoundID(input.RoundId).SetRoundName(input.RoundName).SetPrice(input.Price).
+		SetNumToken(input.NumToken).SetNumSub(input.NumSub).SetPriceGap(input.PriceGap).SetLifetime(input.Lifetime).SetLimits(limits).SetPricing(pricing).SetUnsold(unsold).
+		SetSoftCap(input.SoftCap).SetHardCap(input.HardCap).Exec(ctx)
+}
+
+// marshalOptional is value in JSON, empty when it has the default value.
+func marshalOptional(value interface{}, isDefault bool) (string, error) {
+	if isDefault {
+		return "", nil
+	}
+	rs, err := json.Marshal(value)
+	return string(rs), err
+}
+
+// DeleteRound implements biz.ICORepo.
+func (r *icoRepo) DeleteRound(ctx context.Context, roundId int32) error {
+	_, err := r.data.GetClient(ctx).Ico.Delete().Where(ico.RoundID(roundId)).Exec(ctx)
+	return err
+}
+
+// GetSubRounds implements biz.ICORepo.
+func (r *icoRepo) GetSubRounds(ctx context.Context, roundId int32) ([]*biz.ICOSubRound, error) {
+	query := r.data.GetClient(ctx).IcoRound.Query()
+	if roundId > 0 {
+		query = query.Where(icoround.RoundID(roundId))
+	}
+	rounds, err := query.Order(ent.Asc(icoround.FieldRoundID, icoround.FieldSubRound)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICOSubRound, len(rounds))
+	for i, round := range rounds {
+		rs[i] = r.mapSubRoundToBiz(round)
+	}
+	return rs, nil
+}
+
+// SaveSubRound implements biz.ICORepo.
+func (r *icoRepo) SaveSubRound(ctx context.Context, input *biz.ICOSubRound) error {
+	if input.ID.IsZero() {
+		round, err := r.data.GetClient(ctx).IcoRound.Create().SetRoundID(input.RoundId).SetSubRound(input.SubRound).SetPrice(input.Price).
+			SetNumToken(input.TotalToken).SetBoughtToken("0").SetIsClose(false).SetLifetime(input.Lifetime).
+			SetNillableStartAt(nillableTime(input.StartAt)).SetNillableEndAt(nillableTime(input.EndAt)).Save(ctx)
+		if err != nil {
+			return err
+		}
+		input.ID = round.ID
+		return nil
+	}
+	update := r.data.GetClient(ctx).IcoRound.UpdateOneID(input.ID).SetPrice(input.Price).SetNumToken(input.TotalToken).SetLifetime(input.Lifetime)
+	if input.StartAt.IsZero() {
+		update.ClearStartAt()
+	} else {
+		update.SetStartAt(input.StartAt)
+	}
+	if input.EndAt.IsZero() {
+		update.ClearEndAt()
+	} else {
+		update.SetEndAt(input.EndAt)
+	}
+	return update.Exec(ctx)
+}
+
+// SetSubRoundEnd implements biz.ICORepo.
+func (r *icoRepo) SetSubRoundEnd(ctx context.Context, id xid.ID, endAt time.Time) error {
+	return r.data.GetClient(ctx).IcoRound.UpdateOneID(id).SetEndAt(endAt).Exec(ctx)
+}
+
+// PauseSubRound implements biz.ICORepo.
+func (r *icoRepo) PauseSubRound(ctx context.Context, id xid.ID, pausedAt time.Time) error {
+	return r.data.GetClient(ctx).IcoRound.UpdateOneID(id).SetPausedAt(pausedAt).Exec(ctx)
+}
+
+// ResumeSubRound implements biz.ICORepo.
+func (r *icoRepo) ResumeSubRound(ctx context.Context, id xid.ID, endAt time.Time) error {
+	return r.data.GetClient(ctx).IcoRound.UpdateOneID(id).ClearPausedAt().SetEndAt(endAt).Exec(ctx)
+}
+
+// DeleteSubRound implements biz.ICORepo.
+func (r *icoRepo) DeleteSubRound(ctx context.Context, id xid.ID) error {
+	return r.data.GetClient(ctx).IcoRound.DeleteOneID(id).Exec(ctx)
+}
+
 func (r *icoRepo) InitData(ctx context.Context, startTime time.Time) error {
 	return r.WithTx(ctx, func(ctx context.Context) error {
 		icos := make([]*ent.IcoCreate, 5)
@@ -182,7 +332,7 @@ func (r *icoRepo) InitData(ctx context.Context, startTime time.Time) error {
 }
 
 func (r *icoRepo) GetBuyICOUser(ctx context.Context, limit, offset int) ([]*biz.ICOUserBought, error) {
-	rs, err := r.data.GetClient(ctx).QueryContext(ctx, fmt.Sprintf("SELECT ROW_NUMBER () OVER ( ORDER BY ih.num_token DESC) rank, ih.user_id, ih.num_token FROM (select user_id, SUM(num_token::DECIMAL) as num_token from ico_histories group by user_id) as ih limit %d offset %d", limit, offset))
+	rs, err := r.data.GetClient(ctx).QueryContext(ctx, fmt.Sprintf("SELECT ROW_NUMBER () OVER ( ORDER BY ih.num_token DESC) rank, ih.user_id, ih.num_token FROM (select user_id, SUM(CAST(num_token AS DECIMAL)) as num_token from ico_histories group by user_id having SUM(CAST(num_token AS DECIMAL)) > 0) as ih limit %d offset %d", limit, offset))
 	if err != nil {
 		return nil, err
 	}
@@ -192,12 +342,13 @@ func (r *icoRepo) GetBuyICOUser(ctx context.Context, limit, offset int) ([]*biz.
 	for rs.Next() {
 		var rank int
 		var userId string
-		var token string
+		// numeric on postgres, a float on sqlite
+		var token decimal.Decimal
 		err := rs.Scan(&rank, &userId, &token)
 		if err != nil {
 			return userBought, err
 		}
-		userBought = append(userBought, &biz.ICOUserBought{Rank: rank, UserId: userId, NumToken: token})
+		userBought = append(userBought, &biz.ICOUserBought{Rank: rank, UserId: userId, NumToken: token.String()})
 	}
 	rs.Close()
 
@@ -205,8 +356,74 @@ func (r *icoRepo) GetBuyICOUser(ctx context.Context, limit, offset int) ([]*biz.
 
 }
 
+// GetUserHistories implements biz.ICORepo.
+func (r *icoRepo) GetUserHistories(ctx context.Context, userId, cursor string, limit int32) ([]*biz.ICOHistory, string, error) {
+	where := []predicate.IcoHistory{icohistory.UserID(userId)}
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		where = append(where, icohistory.IDLT(id))
+	}
+
+	query := r.data.GetClient(ctx).IcoHistory.Query().Where(where...).Order(ent.Desc(icohistory.FieldCreatedAt), ent.Desc(icohistory.FieldID))
+	if limit > 0 {
+		query = query.Limit(int(limit))
+	}
+	histories, err := query.All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rs := make([]*biz.ICOHistory, len(histories))
+	for i, h := range histories {
+		rs[i] = &biz.ICOHistory{ID: h.ID, CreatedAt: h.CreatedAt, RoundId: h.RoundID, SubRound: h.SubRound, UserId: h.UserID, Price: h.Price, NumToken: h.NumToken,
+			Type: h.Type, SourceId: h.SourceID, Symbol: h.Symbol, Amount: h.Amount}
+	}
+	next := ""
+	if limit > 0 && len(rs) == int(limit) {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// GetUserBoughtToken implements biz.ICORepo.
+func (r *icoRepo) GetUserBoughtToken(ctx context.Context, userId string, roundId int32) (string, error) {
+	histories, err := r.data.GetClient(ctx).IcoHistory.Query().Where(icohistory.UserID(userId), icohistory.RoundID(roundId)).All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	bought := decimal.Zero
+	for _, h := range histories {
+		bought = bought.Add(decimal.RequireFromString(h.NumToken))
+	}
+	return bought.String(), nil
+}
+
+// GetRoundRaised implements biz.ICORepo. The amounts are numeric on postgres,
+// sqlite sums them as floats.
+func (r *icoRepo) GetRoundRaised(ctx context.Context, roundId int32) (string, error) {
+	var rows []struct {
+		Raised decimal.Decimal `sql:"raised"`
+	}
+	err := r.data.GetClient(ctx).IcoHistory.Query().Where(icohistory.RoundID(roundId)).Modify(func(s *sql.Selector) {
+		s.Select("COALESCE(SUM(CAST(price AS DECIMAL) * CAST(num_token AS DECIMAL)), 0) AS raised")
+	}).Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return "0", err
+	}
+	return rows[0].Raised.String(), nil
+}
+
+// SetRoundStatus implements biz.ICORepo.
+func (r *icoRepo) SetRoundStatus(ctx context.Context, roundId int32, status string) error {
+	return r.data.GetClient(ctx).Ico.Update().Where(ico.RoundID(roundId)).SetStatus(status).Exec(ctx)
+}
+
 func (r *icoRepo) GetBuyICOTotalUser(ctx context.Context) (int, error) {
-	rs, err := r.data.GetClient(ctx).QueryContext(ctx, "select COUNT(distinct user_id) from ico_histories")
+	rs, err := r.data.GetClient(ctx).QueryContext(ctx, "select COUNT(*) from (select user_id from ico_histories group by user_id having SUM(CAST(num_token AS DECIMAL)) > 0) as ih")
 	if err != nil {
 		return 0, err
 	}
@@ -222,3 +439,47 @@ func (r *icoRepo) GetBuyICOTotalUser(ctx context.Context) (int, error) {
 	return count, nil
 
 }
+
+func (r *icoRepo) mapRoundToBiz(en *ent.Ico) *biz.ICORound {
+	rs := &biz.ICORound{ID: en.ID, RoundId: en.RoundID, RoundName: en.RoundName, Price: en.Price, NumToken: en.NumToken, NumSub: en.NumSub,
+		PriceGap: en.PriceGap, Lifetime: en.Lifetime, EndedAt: en.EndedAt, SoftCap: en.SoftCap, HardCap: en.HardCap, Status: en.Status}
+	if len(en.Limits) > 0 {
+		if err := json.Unmarshal([]byte(en.Limits), &rs.Limits); err != nil {
+			r.log.Errorf("round %d has invalid limits: %v", en.RoundID, err)
+		}
+	}
+	if len(en.Pricing) > 0 {
+		if err := json.Unmarshal([]byte(en.Pricing), &rs.Pricing); err != nil {
+			r.log.Errorf("round %d has invalid pricing: %v", en.RoundID, err)
+		}
+	}
+	if len(en.Unsold) > 0 {
+		if err := json.Unmarshal([]byte(en.Unsold), &rs.Unsold); err != nil {
+			r.log.Errorf("round %d has invalid unsold policy: %v", en.RoundID, err)
+		}
+	}
+	return rs
+}
+
+func (r *icoRepo) mapSubRoundToBiz(en *ent.IcoRound) *biz.ICOSubRound {
+	rs := &biz.ICOSubRound{ID: en.ID, RoundId: en.RoundID, SubRound: en.SubRound, Price: en.Price, TotalToken: en.NumToken,
+		BoughtToken: en.BoughtToken, Lifetime: en.Lifetime, IsEnded: en.IsClose}
+	if en.StartAt != nil {
+		rs.StartAt = *en.StartAt
+	}
+	if en.EndAt != nil {
+		rs.EndAt = *en.EndAt
+	}
+	if en.PausedAt != nil {
+		rs.PausedAt = *en.PausedAt
+	}
+	return rs
+}
+
+// nillableTime maps the zero time, which the biz layer uses for unset, to nil.
+func nillableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -2,11 +2,19 @@ package data
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/wallet-service/ent"
 	"github.com/indikay/wallet-service/ent/icocoupon"
+	"github.com/indikay/wallet-service/ent/icocouponredemption"
+	"github.com/indikay/wallet-service/ent/predicate"
 	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
 )
 
 type icoCouponRepo struct {
@@ -20,12 +28,81 @@ func NewIcoCouponRepo(data *Data) biz.IcoCouponRepo {
 
 // AddCoupon implements biz.IcoCouponRepo.
 func (r *icoCouponRepo) AddCoupon(ctx context.Context, icoCoupon *biz.IcoCoupon) error {
-	return r.data.GetClient(ctx).IcoCoupon.Create().SetUserID(icoCoupon.UserID).SetCoupon(icoCoupon.Coupon).SetCashback(icoCoupon.Cashback).SetReward(icoCoupon.Reward).OnConflictColumns(icocoupon.FieldCoupon).UpdateNewValues().Exec(ctx)
+	err := r.data.GetClient(ctx).IcoCoupon.Create().SetUserID(icoCoupon.UserID).SetCoupon(icoCoupon.Coupon).SetCashback(icoCoupon.Cashback).SetReward(icoCoupon.Reward).
+		SetNillableStartsAt(nillableTime(icoCoupon.StartsAt)).SetNillableEndsAt(nillableTime(icoCoupon.EndsAt)).SetMaxUses(icoCoupon.MaxUses).
+		SetSingleUse(icoCoupon.SingleUse).SetMinPurchase(icoCoupon.MinPurchase).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return errors.New(constant.ERROR_COUPON_EXISTS)
+	}
+	return err
+}
+
+// AddCoupons implements biz.IcoCouponRepo, with one insert.
+func (r *icoCouponRepo) AddCoupons(ctx context.Context, icoCoupons []*biz.IcoCoupon) error {
+	builders := make([]*ent.IcoCouponCreate, len(icoCoupons))
+	for i, v := range icoCoupons {
+		builders[i] = r.data.GetClient(ctx).IcoCoupon.Create().SetUserID(v.UserID).SetCoupon(v.Coupon).SetCashback(v.Cashback).SetReward(v.Reward).
+			SetNillableStartsAt(nillableTime(v.StartsAt)).SetNillableEndsAt(nillableTime(v.EndsAt)).SetMaxUses(v.MaxUses).
+			SetSingleUse(v.SingleUse).SetMinPurchase(v.MinPurchase)
+	}
+	err := r.data.GetClient(ctx).IcoCoupon.CreateBulk(builders...).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return errors.New(constant.ERROR_COUPON_EXISTS)
+	}
+	return err
+}
+
+// TakenCoupons implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) TakenCoupons(ctx context.Context, coupons []string) ([]string, error) {
+	args := make([]any, len(coupons))
+	for i, v := range coupons {
+		args[i] = strings.ToLower(v)
+	}
+	return r.data.GetClient(ctx).IcoCoupon.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.In(sql.Lower(s.C(icocoupon.FieldCoupon)), args...))
+	}).Select(icocoupon.FieldCoupon).Strings(ctx)
+}
+
+// UpdateCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) UpdateCoupon(ctx context.Context, icoCoupon *biz.IcoCoupon) error {
+	update := r.data.GetClient(ctx).IcoCoupon.UpdateOneID(icoCoupon.ID).SetCashback(icoCoupon.Cashback).SetReward(icoCoupon.Reward).
+		SetMaxUses(icoCoupon.MaxUses).SetSingleUse(icoCoupon.SingleUse).SetMinPurchase(icoCoupon.MinPurchase)
+	if icoCoupon.StartsAt.IsZero() {
+		update.ClearStartsAt()
+	} else {
+		update.SetStartsAt(icoCoupon.StartsAt)
+	}
+	if icoCoupon.EndsAt.IsZero() {
+		update.ClearEndsAt()
+	} else {
+		update.SetEndsAt(icoCoupon.EndsAt)
+	}
+	return update.Exec(ctx)
+}
+
+// SetCouponDeletedAt implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) SetCouponDeletedAt(ctx context.Context, id xid.ID, deletedAt *time.Time) error {
+	update := r.data.GetClient(ctx).IcoCoupon.UpdateOneID(id)
+	if deletedAt == nil {
+		update.ClearDeletedAt()
+	} else {
+		update.SetDeletedAt(*deletedAt)
+	}
+	return update.Exec(ctx)
 }
 
 // GetCoupon implements biz.IcoCouponRepo.
 func (r *icoCouponRepo) GetCoupon(ctx context.Context, coupon string) (*biz.IcoCoupon, error) {
-	ico, err := r.data.GetClient(ctx).IcoCoupon.Query().Where(icocoupon.CouponEqualFold(coupon), icocoupon.DeletedAtIsNil()).First(ctx)
+	return r.getCoupon(ctx, icocoupon.CouponEqualFold(coupon), icocoupon.DeletedAtIsNil())
+}
+
+// FindCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) FindCoupon(ctx context.Context, coupon string) (*biz.IcoCoupon, error) {
+	return r.getCoupon(ctx, icocoupon.CouponEqualFold(coupon))
+}
+
+func (r *icoCouponRepo) getCoupon(ctx context.Context, where ...predicate.IcoCoupon) (*biz.IcoCoupon, error) {
+	ico, err := r.data.GetClient(ctx).IcoCoupon.Query().Where(where...).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
@@ -33,5 +110,73 @@ func (r *icoCouponRepo) GetCoupon(ctx context.Context, coupon string) (*biz.IcoC
 		return nil, err
 	}
 
-	return &biz.IcoCoupon{ID: ico.ID, UserID: ico.UserID, Coupon: ico.Coupon, Cashback: ico.Cashback, Reward: ico.Reward}, nil
+	return r.mapCouponToBiz(ico), nil
+}
+
+// ListCoupons implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) ListCoupons(ctx context.Context, userId string, withDeleted bool) ([]*biz.IcoCoupon, error) {
+	query := r.data.GetClient(ctx).IcoCoupon.Query().Where(icocoupon.UserID(userId))
+	if !withDeleted {
+		query.Where(icocoupon.DeletedAtIsNil())
+	}
+	rows, err := query.Order(ent.Desc(icocoupon.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.IcoCoupon, len(rows))
+	for i, row := range rows {
+		rs[i] = r.mapCouponToBiz(row)
+	}
+	return rs, nil
+}
+
+// UseCoupon implements biz.IcoCouponRepo. The cap is checked by the update
+// itself, so concurrent redemptions can't go past it.
+func (r *icoCouponRepo) UseCoupon(ctx context.Context, id xid.ID) (bool, error) {
+	n, err := r.data.GetClient(ctx).IcoCoupon.Update().Where(icocoupon.ID(id), func(s *sql.Selector) {
+		s.Where(sql.Or(sql.EQ(s.C(icocoupon.FieldMaxUses), 0), sql.ColumnsLT(s.C(icocoupon.FieldUsed), s.C(icocoupon.FieldMaxUses))))
+	}).AddUsed(1).Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// CountRedemptions implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) CountRedemptions(ctx context.Context, couponId xid.ID, userId string) (int, error) {
+	return r.data.GetClient(ctx).IcoCouponRedemption.Query().
+		Where(icocouponredemption.CouponID(couponId), icocouponredemption.UserID(userId)).Count(ctx)
+}
+
+// SaveRedemption implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) SaveRedemption(ctx context.Context, redemption *biz.IcoCouponRedemption) error {
+	return r.data.GetClient(ctx).IcoCouponRedemption.Create().SetCouponID(redemption.CouponID).SetCoupon(redemption.Coupon).
+		SetUserID(redemption.UserID).SetSourceID(redemption.SourceID).SetAmount(redemption.Amount).SetSymbol(redemption.Symbol).
+		SetReward(redemption.Reward).SetCashback(redemption.Cashback).Exec(ctx)
+}
+
+// GetRedemption implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) GetRedemption(ctx context.Context, sourceId string) (*biz.IcoCouponRedemption, error) {
+	v, err := r.data.GetClient(ctx).IcoCouponRedemption.Query().Where(icocouponredemption.SourceID(sourceId)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &biz.IcoCouponRedemption{ID: v.ID, CreatedAt: v.CreatedAt, CouponID: v.CouponID, Coupon: v.Coupon, UserID: v.UserID, SourceID: v.SourceID,
+		Amount: v.Amount, Symbol: v.Symbol, Reward: v.Reward, Cashback: v.Cashback}, nil
+}
+
+func (r *icoCouponRepo) mapCouponToBiz(en *ent.IcoCoupon) *biz.IcoCoupon {
+	rs := &biz.IcoCoupon{ID: en.ID, CreatedAt: en.CreatedAt, UpdatedAt: en.UpdatedAt, UserID: en.UserID, Coupon: en.Coupon, Cashback: en.Cashback, Reward: en.Reward,
+		MaxUses: en.MaxUses, Used: en.Used, SingleUse: en.SingleUse, MinPurchase: en.MinPurchase, DeletedAt: en.DeletedAt}
+	if en.StartsAt != nil {
+		rs.StartsAt = *en.StartsAt
+	}
+	if en.EndsAt != nil {
+		rs.EndsAt = *en.EndsAt
+	}
+	return rs
 }
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/icooutstandingrefund"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type icoRefundRepo struct {
+	data   *Data
+	window time.Duration
+	log    *log.Helper
+}
+
+// NewICORefundRepo returns a biz.ICORefundRepo refunding within conf.Refund.
+func NewICORefundRepo(c *conf.Data, data *Data) biz.ICORefundRepo {
+	return &icoRefundRepo{data: data, window: c.GetRefund().GetWindow().AsDuration(), log: log.NewHelper(log.DefaultLogger)}
+}
+
+// GetRefundWindow implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetRefundWindow(ctx context.Context) time.Duration {
+	return r.window
+}
+
+// GetPaymentHistories implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetPaymentHistories(ctx context.Context, sourceId string) ([]*biz.ICOHistory, error) {
+	histories, err := r.data.GetClient(ctx).IcoHistory.Query().Where(icohistory.SourceID(sourceId)).
+		Order(ent.Asc(icohistory.FieldCreatedAt), ent.Asc(icohistory.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapHistoriesToBiz(histories), nil
+}
+
+// GetRoundHistories implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetRoundHistories(ctx context.Context, roundId int32) ([]*biz.ICOHistory, error) {
+	histories, err := r.data.GetClient(ctx).IcoHistory.Query().Where(icohistory.RoundID(roundId)).
+		Order(ent.Asc(icohistory.FieldCreatedAt), ent.Asc(icohistory.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapHistoriesToBiz(histories), nil
+}
+
+// ReturnSubRoundToken implements biz.ICORefundRepo.
+func (r *icoRefundRepo) ReturnSubRoundToken(ctx context.Context, id xid.ID, numToken string) error {
+	round, err := r.data.GetClient(ctx).IcoRound.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	bought := decimal.RequireFromString(round.BoughtToken).Sub(decimal.RequireFromString(numToken))
+	return r.data.GetClient(ctx).IcoRound.UpdateOneID(id).SetBoughtToken(bought.String()).Exec(ctx)
+}
+
+// SaveOutstandingRefund implements biz.ICORefundRepo.
+func (r *icoRefundRepo) SaveOutstandingRefund(ctx context.Context, refund *biz.ICOOutstandingRefund) error {
+	client := r.data.GetClient(ctx)
+	exists, err := client.IcoOutstandingRefund.Query().Where(icooutstandingrefund.SourceID(refund.SourceId)).Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return client.IcoOutstandingRefund.Create().SetRoundID(refund.RoundId).SetUserID(refund.UserId).SetSourceID(refund.SourceId).
+		SetNumToken(refund.NumToken).Exec(ctx)
+}
+
+// DeleteOutstandingRefund implements biz.ICORefundRepo.
+func (r *icoRefundRepo) DeleteOutstandingRefund(ctx context.Context, sourceId string) error {
+	_, err := r.data.GetClient(ctx).IcoOutstandingRefund.Delete().Where(icooutstandingrefund.SourceID(sourceId)).Exec(ctx)
+	return err
+}
+
+// GetOutstandingRefunds implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetOutstandingRefunds(ctx context.Context, roundId int32) ([]*biz.ICOOutstandingRefund, error) {
+	query := r.data.GetClient(ctx).IcoOutstandingRefund.Query()
+	if roundId != 0 {
+		query = query.Where(icooutstandingrefund.RoundID(roundId))
+	}
+	refunds, err := query.Order(ent.Asc(icooutstandingrefund.FieldCreatedAt), ent.Asc(icooutstandingrefund.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]*biz.ICOOutstandingRefund, len(refunds))
+	for i, o := range refunds {
+		rs[i] = &biz.ICOOutstandingRefund{CreatedAt: o.CreatedAt, RoundId: o.RoundID, UserId: o.UserID, SourceId: o.SourceID, NumToken: o.NumToken}
+	}
+	return rs, nil
+}
+
+func (r *icoRefundRepo) mapHistoriesToBiz(histories []*ent.IcoHistory) []*biz.ICOHistory {
+	rs := make([]*biz.ICOHistory, len(histories))
+	for i, h := range histories {
+		rs[i] = &biz.ICOHistory{ID: h.ID, CreatedAt: h.CreatedAt, RoundId: h.RoundID, SubRound: h.SubRound, UserId: h.UserID, Price: h.Price, NumToken: h.NumToken,
+			Type: h.Type, SourceId: h.SourceID, Symbol: h.Symbol, Amount: h.Amount}
+	}
+	return rs
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/icodailystat"
+	"github.com/indikay/wallet-service/ent/icohistory"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/transaction"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+// volumeColumns sum a set of histories. A history without source_id was paid
+// alone, before the payment was recorded. The amounts are numeric on
+// postgres, sqlite sums them as floats.
+var volumeColumns = []string{
+	"COUNT(DISTINCT COALESCE(NULLIF(source_id, ''), id)) AS purchases",
+	"COUNT(DISTINCT user_id) AS buyers",
+	"COALESCE(SUM(CAST(num_token AS DECIMAL)), 0) AS num_token",
+	"COALESCE(SUM(CAST(price AS DECIMAL) * CAST(num_token AS DECIMAL)), 0) AS raised",
+}
+
+type volumeRow struct {
+	RoundId   int32           `sql:"round_id"`
+	SubRound  int32           `sql:"sub_round"`
+	Symbol    string          `sql:"symbol"`
+	Amount    decimal.Decimal `sql:"amount"`
+	Purchases int             `sql:"purchases"`
+	Buyers    int             `sql:"buyers"`
+	NumToken  decimal.Decimal `sql:"num_token"`
+	Raised    decimal.Decimal `sql:"raised"`
+}
+
+func (v *volumeRow) volume() biz.ICOVolume {
+	return biz.ICOVolume{Purchases: v.Purchases, Buyers: v.Buyers, NumToken: v.NumToken.String(), Raised: v.Raised.String()}
+}
+
+type icoStatsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewICOStatsRepo(data *Data) biz.ICOStatsRepo {
+	return &icoStatsRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func (r *icoStatsRepo) histories(ctx context.Context, from, to time.Time) *ent.IcoHistoryQuery {
+	where := []predicate.IcoHistory{}
+	if !from.IsZero() {
+		where = append(where, icohistory.CreatedAtGTE(from))
+	}
+	if !to.IsZero() {
+		where = append(where, icohistory.CreatedAtLT(to))
+	}
+	return r.data.GetClient(ctx).IcoHistory.Query().Where(where...)
+}
+
+// GetVolume implements biz.ICOStatsRepo. A payment with a coupon paid a
+// commission or a cashback.
+func (r *icoStatsRepo) GetVolume(ctx context.Context, from, to time.Time, coupon bool) (*biz.ICOVolume, error) {
+	var rows []*volumeRow
+	err := r.histories(ctx, from, to).Modify(func(s *sql.Selector) {
+		if coupon {
+			paid := sql.Select(transaction.FieldSourceID).From(sql.Table(transaction.Table)).
+				Where(sql.In(transaction.FieldTransType, biz.ICO_COMISSION, biz.ICO_CASHBACK))
+			s.Where(sql.In(s.C(icohistory.FieldSourceID), paid))
+		}
+		s.Select(volumeColumns...)
+	}).Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return &biz.ICOVolume{NumToken: "0", Raised: "0"}, err
+	}
+	volume := rows[0].volume()
+	return &volume, nil
+}
+
+// GetRoundVolumes implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetRoundVolumes(ctx context.Context, from, to time.Time) ([]*biz.ICORoundStats, error) {
+	var rows []*volumeRow
+	err := r.histories(ctx, from, to).Modify(func(s *sql.Selector) {
+		s.Select(append([]string{s.C(icohistory.FieldRoundID), s.C(icohistory.FieldSubRound)}, volumeColumns...)...).
+			GroupBy(s.C(icohistory.FieldRoundID), s.C(icohistory.FieldSubRound)).
+			OrderBy(s.C(icohistory.FieldRoundID), s.C(icohistory.FieldSubRound))
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICORoundStats, len(rows))
+	for i, row := range rows {
+		rs[i] = &biz.ICORoundStats{RoundId: row.RoundId, SubRound: row.SubRound, ICOVolume: row.volume()}
+	}
+	return rs, nil
+}
+
+// GetCurrencyVolumes implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetCurrencyVolumes(ctx context.Context, from, to time.Time) ([]*biz.ICOCurrencyStats, error) {
+	var rows []*volumeRow
+	err := r.histories(ctx, from, to).Where(icohistory.SymbolNEQ("")).Modify(func(s *sql.Selector) {
+		s.Select(append([]string{s.C(icohistory.FieldSymbol), "COALESCE(SUM(CAST(amount AS DECIMAL)), 0) AS amount"}, volumeColumns...)...).
+			GroupBy(s.C(icohistory.FieldSymbol)).
+			OrderBy(s.C(icohistory.FieldSymbol))
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICOCurrencyStats, len(rows))
+	for i, row := range rows {
+		rs[i] = &biz.ICOCurrencyStats{Symbol: row.Symbol, Amount: row.Amount.String(), ICOVolume: row.volume()}
+	}
+	return rs, nil
+}
+
+// SaveDailyStats implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) SaveDailyStats(ctx context.Context, stats *biz.ICODailyStats) error {
+	return r.data.GetClient(ctx).IcoDailyStat.Create().SetDay(stats.Day).
+		SetPurchases(stats.Total.Purchases).SetBuyers(stats.Total.Buyers).SetNumToken(stats.Total.NumToken).SetRaised(stats.Total.Raised).
+		SetCouponPurchases(stats.Coupon.Purchases).SetCouponBuyers(stats.Coupon.Buyers).SetCouponNumToken(stats.Coupon.NumToken).SetCouponRaised(stats.Coupon.Raised).
+		OnConflictColumns(icodailystat.FieldDay).UpdateNewValues().Exec(ctx)
+}
+
+// GetDailyStats implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetDailyStats(ctx context.Context, from, to time.Time) ([]*biz.ICODailyStats, error) {
+	rows, err := r.data.GetClient(ctx).IcoDailyStat.Query().Where(icodailystat.DayGTE(from), icodailystat.DayLT(to)).
+		Order(ent.Asc(icodailystat.FieldDay)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICODailyStats, len(rows))
+	for i, row := range rows {
+		rs[i] = &biz.ICODailyStats{Day: row.Day,
+			Total:  biz.ICOVolume{Purchases: row.Purchases, Buyers: row.Buyers, NumToken: row.NumToken, Raised: row.Raised},
+			Coupon: biz.ICOVolume{Purchases: row.CouponPurchases, Buyers: row.CouponBuyers, NumToken: row.CouponNumToken, Raised: row.CouponRaised}}
+	}
+	return rs, nil
+}
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent/transaction"
+	"github.com/indikay/wallet-service/ent/userwallet"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+type invariantRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewInvariantRepo(data *Data) biz.InvariantRepo {
+	return &invariantRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// GetSupplies implements biz.InvariantRepo. The balances are summed here rather
+// than in SQL, sqlite keeps them as text and would sum them as floats.
+func (r *invariantRepo) GetSupplies(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	rs, err := r.data.GetClient(ctx).QueryContext(ctx, "SELECT symbol, balance FROM user_wallets")
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	totals := map[string]decimal.Decimal{}
+	for rs.Next() {
+		var symbol string
+		var balance decimal.Decimal
+		if err := rs.Scan(&symbol, &balance); err != nil {
+			return nil, err
+		}
+		totals[symbol] = totals[symbol].Add(balance)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+
+	supplies := make([]*biz.SymbolSupply, 0, len(totals))
+	for symbol, total := range totals {
+		supplies = append(supplies, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+	}
+	sort.Slice(supplies, func(i, j int) bool { return supplies[i].Symbol < supplies[j].Symbol })
+	return supplies, nil
+}
+
+// GetBurned implements biz.InvariantRepo. The amounts are summed here, as the
+// balances in GetSupplies.
+func (r *invariantRepo) GetBurned(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	burns, err := r.data.GetClient(ctx).Transaction.Query().Where(transaction.TransType(biz.ICO_BURN)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]decimal.Decimal{}
+	for _, t := range burns {
+		totals[t.SrcSymbol] = totals[t.SrcSymbol].Add(decimal.RequireFromString(t.SrcAmount))
+	}
+	burned := make([]*biz.SymbolSupply, 0, len(totals))
+	for symbol, total := range totals {
+		burned = append(burned, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+	}
+	return burned, nil
+}
+
+// GetMinted implements biz.InvariantRepo, summed as GetBurned.
+func (r *invariantRepo) GetMinted(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	mints, err := r.data.GetClient(ctx).Transaction.Query().Where(transaction.TransTypeIn(biz.MINT_TYPES...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]decimal.Decimal{}
+	for _, t := range mints {
+		totals[t.DestSymbol] = totals[t.DestSymbol].Add(decimal.RequireFromString(t.DestAmount))
+	}
+	minted := make([]*biz.SymbolSupply, 0, len(totals))
+	for symbol, total := range totals {
+		minted = append(minted, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+	}
+	return minted, nil
+}
+
+// GetNegativeWallets implements biz.InvariantRepo.
+func (r *invariantRepo) GetNegativeWallets(ctx context.Context) ([]*biz.UserWallet, error) {
+	query := r.data.GetClient(ctx).UserWallet.Query()
+	if r.data.dialect != dialect.SQLite {
+		query = query.Where(userwallet.BalanceLT("0"))
+	}
+	wallets, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := []*biz.UserWallet{}
+	for _, w := range wallets {
+		// balances are text on sqlite, compare them as decimals
+		if r.data.dialect == dialect.SQLite && !decimal.RequireFromString(w.Balance).IsNegative() {
+			continue
+		}
+		resp = append(resp, &biz.UserWallet{ID: w.ID, UserID: w.UserID, Type: w.Type, Symbol: w.Symbol, Balance: w.Balance, IsActive: w.IsActive,
+			CreatedAt: w.CreatedAt, UpdatedAt: w.UpdatedAt})
+	}
+	return resp, nil
+}
+
+// GetSubRoundSales implements biz.InvariantRepo.
+func (r *invariantRepo) GetSubRoundSales(ctx context.Context) ([]*biz.SubRoundSale, error) {
+	rounds, err := r.data.GetClient(ctx).IcoRound.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := r.data.GetClient(ctx).QueryContext(ctx, "SELECT round_id, sub_round, num_token FROM ico_histories")
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	bought := map[string]decimal.Decimal{}
+	for rs.Next() {
+		var roundId, subRound int32
+		var numToken decimal.Decimal
+		if err := rs.Scan(&roundId, &subRound, &numToken); err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%d-%d", roundId, subRound)
+		bought[key] = bought[key].Add(numToken)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+
+	sales := make([]*biz.SubRoundSale, len(rounds))
+	for i, round := range rounds {
+		sales[i] = &biz.SubRoundSale{RoundId: round.RoundID, SubRound: round.SubRound, BoughtToken: round.BoughtToken,
+			HistoryToken: bought[fmt.Sprintf("%d-%d", round.RoundID, round.SubRound)].String()}
+	}
+	return sales, nil
+}
@@ -0,0 +1,230 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/leaderboardsnapshot"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// leaderboardKey is the sorted set ranking the users, its float scores only
+	// order them.
+	leaderboardKey = "ico:leaderboard"
+	// leaderboardTokensKey is the hash of the tokens each user bought, decimal
+	// strings read as they are.
+	leaderboardTokensKey = "ico:leaderboard:tokens"
+	// leaderboardBatch bounds the members a rebuild adds per command.
+	leaderboardBatch = 1000
+	// leaderboardRetries bounds the tries of an update another one raced.
+	leaderboardRetries = 10
+)
+
+type leaderboardRepo struct {
+	data   *Data
+	client redis.UniversalClient
+	log    *log.Helper
+}
+
+// NewLeaderboardRepo returns a biz.LeaderboardRepo keeping the leaderboard in
+// Redis and its snapshots in the database.
+func NewLeaderboardRepo(data *Data) biz.LeaderboardRepo {
+	return &leaderboardRepo{data: data, client: data.redisCli.GetClient(), log: log.NewHelper(log.DefaultLogger)}
+}
+
+// AddUserToken implements biz.LeaderboardRepo. The total of the user is added
+// up exactly in the hash, the score follows it. A user refunded of everything
+// they bought leaves the leaderboard.
+func (r *leaderboardRepo) AddUserToken(ctx context.Context, userId, numToken string) error {
+	client := r.client
+	add := func(tx *redis.Tx) error {
+		total := decimal.Zero
+		value, err := tx.HGet(ctx, leaderboardTokensKey, userId).Result()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			return err
+		default:
+			if total, err = decimal.NewFromString(value); err != nil {
+				return err
+			}
+		}
+		total = total.Add(decimal.RequireFromString(numToken))
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if !total.IsPositive() {
+				pipe.HDel(ctx, leaderboardTokensKey, userId)
+				pipe.ZRem(ctx, leaderboardKey, userId)
+				return nil
+			}
+			pipe.HSet(ctx, leaderboardTokensKey, userId, total.String())
+			pipe.ZAdd(ctx, leaderboardKey, redis.Z{Score: total.InexactFloat64(), Member: userId})
+			return nil
+		})
+		return err
+	}
+
+	var err error
+	for i := 0; i < leaderboardRetries; i++ {
+		if err = client.Watch(ctx, add, leaderboardTokensKey); err != redis.TxFailedErr {
+			return err
+		}
+	}
+	return err
+}
+
+// GetRanks implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetRanks(ctx context.Context, offset, limit int) ([]*biz.ICOUserBought, error) {
+	members, err := r.client.ZRevRangeWithScores(ctx, leaderboardKey, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+	userIds := make([]string, len(members))
+	for i, m := range members {
+		userIds[i] = m.Member.(string)
+	}
+	tokens, err := r.tokens(ctx, members, userIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICOUserBought, len(members))
+	for i, userId := range userIds {
+		rs[i] = &biz.ICOUserBought{Rank: offset + i + 1, UserId: userId, NumToken: tokens[i]}
+	}
+	return rs, nil
+}
+
+// tokens reads the totals of userIds from the hash. A user missing from it,
+// ranked before the hash was kept, gets the score of members until the next
+// rebuild.
+func (r *leaderboardRepo) tokens(ctx context.Context, members []redis.Z, userIds ...string) ([]string, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	values, err := r.client.HMGet(ctx, leaderboardTokensKey, userIds...).Result()
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, len(userIds))
+	for i, value := range values {
+		if total, ok := value.(string); ok {
+			tokens[i] = total
+			continue
+		}
+		r.log.Warnf("Leaderboard: %s has no exact total, rebuild the leaderboard", userIds[i])
+		tokens[i] = decimal.NewFromFloat(members[i].Score).String()
+	}
+	return tokens, nil
+}
+
+// GetUserRank implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetUserRank(ctx context.Context, userId string) (*biz.ICOUserBought, error) {
+	client := r.client
+	rank, err := client.ZRevRank(ctx, leaderboardKey, userId).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	score, err := client.ZScore(ctx, leaderboardKey, userId).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	tokens, err := r.tokens(ctx, []redis.Z{{Score: score, Member: userId}}, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.ICOUserBought{Rank: int(rank) + 1, UserId: userId, NumToken: tokens[0]}, nil
+}
+
+// CountUsers implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) CountUsers(ctx context.Context) (int, error) {
+	count, err := r.client.ZCard(ctx, leaderboardKey).Result()
+	return int(count), err
+}
+
+// Replace implements biz.LeaderboardRepo. The new leaderboard is built aside
+// and renamed over the old one, readers never see it half done.
+func (r *leaderboardRepo) Replace(ctx context.Context, users []*biz.ICOUserBought) error {
+	tmpKey, tmpTokensKey := leaderboardKey+":rebuild", leaderboardTokensKey+":rebuild"
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, tmpKey, tmpTokensKey)
+	for start := 0; start < len(users); start += leaderboardBatch {
+		end := start + leaderboardBatch
+		if end > len(users) {
+			end = len(users)
+		}
+		members := make([]redis.Z, 0, end-start)
+		tokens := make([]any, 0, 2*(end-start))
+		for _, u := range users[start:end] {
+			members = append(members, redis.Z{Score: decimal.RequireFromString(u.NumToken).InexactFloat64(), Member: u.UserId})
+			tokens = append(tokens, u.UserId, u.NumToken)
+		}
+		pipe.ZAdd(ctx, tmpKey, members...)
+		pipe.HSet(ctx, tmpTokensKey, tokens...)
+	}
+	if len(users) > 0 {
+		pipe.Rename(ctx, tmpKey, leaderboardKey)
+		pipe.Rename(ctx, tmpTokensKey, leaderboardTokensKey)
+	} else {
+		pipe.Del(ctx, leaderboardKey, leaderboardTokensKey)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SaveSnapshot implements biz.LeaderboardRepo. The replicas all take the
+// scheduled snapshot, the first one keeps it.
+func (r *leaderboardRepo) SaveSnapshot(ctx context.Context, takenAt time.Time, users []*biz.ICOUserBought) error {
+	client := r.data.GetClient(ctx)
+	exist, err := client.LeaderboardSnapshot.Query().Where(leaderboardsnapshot.TakenAt(takenAt)).Exist(ctx)
+	if err != nil || exist || len(users) == 0 {
+		return err
+	}
+
+	rows := make([]*ent.LeaderboardSnapshotCreate, len(users))
+	for i, u := range users {
+		rows[i] = client.LeaderboardSnapshot.Create().SetTakenAt(takenAt).SetRank(u.Rank).SetUserID(u.UserId).SetNumToken(u.NumToken)
+	}
+	err = client.LeaderboardSnapshot.CreateBulk(rows...).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
+
+// GetSnapshot implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetSnapshot(ctx context.Context, takenAt time.Time) ([]*biz.ICOUserBought, time.Time, error) {
+	client := r.data.GetClient(ctx)
+	if takenAt.IsZero() {
+		latest, err := client.LeaderboardSnapshot.Query().Order(ent.Desc(leaderboardsnapshot.FieldTakenAt)).First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, time.Time{}, nil
+			}
+			return nil, time.Time{}, err
+		}
+		takenAt = latest.TakenAt
+	}
+
+	rows, err := client.LeaderboardSnapshot.Query().Where(leaderboardsnapshot.TakenAt(takenAt)).Order(ent.Asc(leaderboardsnapshot.FieldRank)).All(ctx)
+	if err != nil || len(rows) == 0 {
+		return nil, time.Time{}, err
+	}
+
+	rs := make([]*biz.ICOUserBought, len(rows))
+	for i, row := range rows {
+		rs[i] = &biz.ICOUserBought{Rank: row.Rank, UserId: row.UserID, NumToken: row.NumToken}
+	}
+	return rs, takenAt, nil
+}
@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/redis/go-redis/v9"
+)
+
+func newRedisLeaderboard(t *testing.T) *leaderboardRepo {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return &leaderboardRepo{client: client, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// The totals are exact whatever a float score would round them to.
+func TestLeaderboardTotals(t *testing.T) {
+	tests := []struct {
+		name string
+		// tokens added in turn, by user
+		adds []biz.ICOUserBought
+		want []biz.ICOUserBought
+	}{
+		{name: "more places than a float holds",
+			adds: []biz.ICOUserBought{{UserId: "u1", NumToken: "45454.5454545454545455"}, {UserId: "u1", NumToken: "0.0000000000000001"}},
+			want: []biz.ICOUserBought{{Rank: 1, UserId: "u1", NumToken: "45454.5454545454545456"}}},
+		{name: "a tiny holder stays",
+			adds: []biz.ICOUserBought{{UserId: "u1", NumToken: "100"}, {UserId: "u2", NumToken: "1"}, {UserId: "u2", NumToken: "-0.9999999999999999"}},
+			want: []biz.ICOUserBought{{Rank: 1, UserId: "u1", NumToken: "100"}, {Rank: 2, UserId: "u2", NumToken: "0.0000000000000001"}}},
+		{name: "a full refund leaves",
+			adds: []biz.ICOUserBought{{UserId: "u1", NumToken: "0.1"}, {UserId: "u1", NumToken: "0.2"}, {UserId: "u2", NumToken: "5"}, {UserId: "u1", NumToken: "-0.3"}},
+			want: []biz.ICOUserBought{{Rank: 1, UserId: "u2", NumToken: "5"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newRedisLeaderboard(t)
+			for _, add := range tt.adds {
+				if err := r.AddUserToken(ctx, add.UserId, add.NumToken); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ranks, err := r.GetRanks(ctx, 0, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ranks) != len(tt.want) {
+				t.Fatalf("%d ranked, want %v", len(ranks), tt.want)
+			}
+			for i, u := range ranks {
+				if *u != tt.want[i] {
+					t.Errorf("rank %d is %+v, want %+v", i+1, *u, tt.want[i])
+				}
+				rank, err := r.GetUserRank(ctx, u.UserId)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *rank != tt.want[i] {
+					t.Errorf("GetUserRank = %+v, want %+v", *rank, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// A rebuild keeps the totals it is given as they are.
+func TestLeaderboardReplace(t *testing.T) {
+	ctx := context.Background()
+	r := newRedisLeaderboard(t)
+	if err := r.AddUserToken(ctx, "gone", "3"); err != nil {
+		t.Fatal(err)
+	}
+	users := []*biz.ICOUserBought{{UserId: "u1", NumToken: "2.0000000000000000001"}, {UserId: "u2", NumToken: "1"}}
+	if err := r.Replace(ctx, users); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddUserToken(ctx, "u2", "0.5"); err != nil {
+		t.Fatal(err)
+	}
+
+	ranks, err := r.GetRanks(ctx, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []biz.ICOUserBought{{Rank: 1, UserId: "u1", NumToken: "2.0000000000000000001"}, {Rank: 2, UserId: "u2", NumToken: "1.5"}}
+	if len(ranks) != len(want) {
+		t.Fatalf("%d ranked, want %v", len(ranks), want)
+	}
+	for i, u := range ranks {
+		if *u != want[i] {
+			t.Errorf("rank %d is %+v, want %+v", i+1, *u, want[i])
+		}
+	}
+
+	if err := r.Replace(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := r.CountUsers(ctx); err != nil || count != 0 {
+		t.Errorf("CountUsers = %d, %v after an empty rebuild", count, err)
+	}
+	if rank, err := r.GetUserRank(ctx, "u1"); err != nil || rank != nil {
+		t.Errorf("GetUserRank = %v, %v after an empty rebuild", rank, err)
+	}
+}
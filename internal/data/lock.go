@@ -2,39 +2,184 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	redsync "github.com/go-redsync/redsync/v4"
 	"github.com/go-redsync/redsync/v4/redis/goredis/v9"
 	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/xid"
 )
 
-type lockRepo struct {
+const (
+	LOCK_DRIVER_REDIS    = "redis"
+	LOCK_DRIVER_POSTGRES = "postgres"
+	LOCK_DRIVER_MEMORY   = "memory"
+
+	defaultLockTimeout  = 100 * time.Second
+	defaultLockPoolSize = 10
+	lockRetryDelay      = time.Second
+)
+
+// NewLockRepo returns the biz.LockRepo selected by conf.Lock.Driver, redis by default.
+func NewLockRepo(c *conf.Data, data *Data) (biz.LockRepo, func(), error) {
+	timeout := defaultLockTimeout
+	driver := LOCK_DRIVER_REDIS
+	poolSize := defaultLockPoolSize
+	if c.Lock != nil {
+		if c.Lock.Timeout != nil {
+			timeout = c.Lock.Timeout.AsDuration()
+		}
+		if len(c.Lock.Driver) > 0 {
+			driver = c.Lock.Driver
+		}
+		if c.Lock.PoolSize > 0 {
+			poolSize = int(c.Lock.PoolSize)
+		}
+	}
+
+	switch driver {
+	case LOCK_DRIVER_POSTGRES:
+		return NewPostgresLockRepo(c.Database.Driver, c.Database.Source, poolSize, timeout)
+	case LOCK_DRIVER_MEMORY:
+		return memrepo.NewLockRepo(c), func() {}, nil
+	default:
+		return NewRedisLockRepo(data.redisCli.GetClient(), timeout), func() {}, nil
+	}
+}
+
+type redisLockRepo struct {
 	redisLock *redsync.Redsync
+	tries     int
 	log       *log.Helper
 }
 
-func NewLockRepo(data *Data) biz.LockRepo {
-	pool := goredis.NewPool(data.redisCli.GetClient())
+// NewRedisLockRepo returns a biz.LockRepo backed by redsync.
+func NewRedisLockRepo(client redis.UniversalClient, timeout time.Duration) biz.LockRepo {
+	pool := goredis.NewPool(client)
 	rs := redsync.New(pool)
-	return &lockRepo{redisLock: rs, log: log.NewHelper(log.DefaultLogger)}
+	tries := int(timeout / lockRetryDelay)
+	if tries < 1 {
+		tries = 1
+	}
+	return &redisLockRepo{redisLock: rs, tries: tries, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// Lock implements biz.LockRepo. The token is the random value redsync stored under every key.
+func (l *redisLockRepo) Lock(ctx context.Context, keys ...string) (string, error) {
+	token := xid.New().String()
+	for i, key := range keys {
+		mutex := l.redisLock.NewMutex(key, redsync.WithRetryDelay(lockRetryDelay), redsync.WithTries(l.tries),
+			redsync.WithGenValueFunc(func() (string, error) { return token, nil }))
+		if err := mutex.LockContext(ctx); err != nil {
+			if uerr := l.UnLock(ctx, token, keys[:i]...); uerr != nil {
+				l.log.Error(uerr)
+			}
+			return "", err
+		}
+	}
+	return token, nil
+}
+
+// UnLock implements biz.LockRepo.
+func (l *redisLockRepo) UnLock(ctx context.Context, token string, keys ...string) error {
+	var err error
+	for _, key := range keys {
+		mutex := l.redisLock.NewMutex(key, redsync.WithValue(token))
+		if ok, uerr := mutex.UnlockContext(ctx); !ok && err == nil {
+			if err = uerr; err == nil {
+				err = errors.New(constant.ERROR_LOCK)
+			}
+		}
+	}
+	return err
+}
+
+type postgresLockRepo struct {
+	db      *sql.DB
+	timeout time.Duration
+	// advisory locks belong to a session, the connection is kept until UnLock
+	conns sync.Map
+	log   *log.Helper
+}
+
+// NewPostgresLockRepo returns a biz.LockRepo backed by Postgres session advisory
+// locks. They are taken on a pool of their own of at most poolSize connections,
+// so the locks held can't take the connections the transactions under them
+// need. The locks of a call share one connection: a caller holding locks never
+// waits for a connection, so a full pool only makes the next callers wait for
+// one until timeout.
+func NewPostgresLockRepo(driverName, source string, poolSize int, timeout time.Duration) (biz.LockRepo, func(), error) {
+	db, err := sql.Open(driverName, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	db.SetMaxOpenConns(poolSize)
+	db.SetMaxIdleConns(poolSize)
+
+	l := &postgresLockRepo{db: db, timeout: timeout, log: log.NewHelper(log.DefaultLogger)}
+	cleanup := func() {
+		if err := db.Close(); err != nil {
+			l.log.Error(err)
+		}
+	}
+	return l, cleanup, nil
 }
 
 // Lock implements biz.LockRepo.
-func (l *lockRepo) Lock(ctx context.Context, key string) error {
-	mutex := l.redisLock.NewMutex(key, redsync.WithRetryDelay(time.Second), redsync.WithTries(100))
-	if err := mutex.LockContext(ctx); err != nil {
-		return err
+func (l *postgresLockRepo) Lock(ctx context.Context, keys ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return "", err
 	}
-	return nil
+	for _, key := range keys {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", key); err != nil {
+			// closing the session releases the locks it took
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+			conn.Close()
+			return "", err
+		}
+	}
+
+	token := xid.New().String()
+	l.conns.Store(token, conn)
+	return token, nil
 }
 
 // UnLock implements biz.LockRepo.
-func (l *lockRepo) UnLock(ctx context.Context, key string) error {
-	mutex := l.redisLock.NewMutex(key)
-	if ok, err := mutex.UnlockContext(ctx); !ok {
-		return err
+func (l *postgresLockRepo) UnLock(ctx context.Context, token string, keys ...string) error {
+	v, ok := l.conns.LoadAndDelete(token)
+	if !ok {
+		return errors.New(constant.ERROR_LOCK)
+	}
+
+	conn := v.(*sql.Conn)
+	defer conn.Close()
+	var err error
+	for _, key := range keys {
+		var unlocked bool
+		if uerr := conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", key).Scan(&unlocked); uerr != nil || !unlocked {
+			if err == nil {
+				if err = uerr; err == nil {
+					err = errors.New(constant.ERROR_LOCK)
+				}
+			}
+		}
+	}
+	if err != nil {
+		// the session may still hold a lock, it isn't given back to the pool
+		conn.Raw(func(any) error { return driver.ErrBadConn })
 	}
-	return nil
+	return err
 }
@@ -0,0 +1,134 @@
+package data_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/data"
+	"github.com/indikay/wallet-service/internal/memrepo"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const lockPoolSize = 2
+
+// lockBackends returns the lock backends to test, postgres when
+// TEST_POSTGRES_SOURCE is the source of a database to lock on.
+func lockBackends(t *testing.T) map[string]biz.LockRepo {
+	t.Helper()
+	// redis retries once a second, enough for the callers below to take turns
+	timeout := 20 * time.Second
+	backends := map[string]biz.LockRepo{
+		"memory": memrepo.NewLockRepo(&conf.Data{Lock: &conf.Lock{Timeout: durationpb.New(timeout)}}),
+	}
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	backends["redis"] = data.NewRedisLockRepo(client, timeout)
+
+	if source := os.Getenv("TEST_POSTGRES_SOURCE"); len(source) > 0 {
+		lockRepo, cleanup, err := data.NewPostgresLockRepo("pgx", source, lockPoolSize, timeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(cleanup)
+		backends["postgres"] = lockRepo
+	}
+	return backends
+}
+
+func TestLockExcludes(t *testing.T) {
+	for name, lockRepo := range lockBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			token, err := lockRepo.Lock(ctx, "user:u1", "coupon:c1")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// a caller needing one of the keys waits for both to be released
+			locked := make(chan string)
+			go func() {
+				token, err := lockRepo.Lock(ctx, "coupon:c1")
+				if err != nil {
+					t.Error(err)
+				}
+				locked <- token
+			}()
+			select {
+			case <-locked:
+				t.Fatal("coupon:c1 locked twice")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			if err := lockRepo.UnLock(ctx, "not the token", "user:u1", "coupon:c1"); err == nil {
+				t.Error("UnLock with another token succeeded")
+			}
+			if err := lockRepo.UnLock(ctx, token, "user:u1", "coupon:c1"); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case token := <-locked:
+				if err := lockRepo.UnLock(ctx, token, "coupon:c1"); err != nil {
+					t.Error(err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("coupon:c1 not locked once released")
+			}
+		})
+	}
+}
+
+// Every caller takes a lock of its own and a shared one, more callers than
+// the postgres pool has connections. None of them may hold a lock while it
+// waits for a connection.
+func TestLockManyCallers(t *testing.T) {
+	for name, lockRepo := range lockBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			holders, done := 0, 0
+			for i := 0; i < 3*lockPoolSize; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					keys := []string{fmt.Sprintf("user:u%d", i), "coupon:c1"}
+					token, err := lockRepo.Lock(ctx, keys...)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					mu.Lock()
+					holders++
+					if holders > 1 {
+						t.Error("coupon:c1 held by two callers")
+					}
+					mu.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					mu.Lock()
+					holders--
+					done++
+					mu.Unlock()
+					if err := lockRepo.UnLock(ctx, token, keys...); err != nil {
+						t.Error(err)
+					}
+				}(i)
+			}
+			wg.Wait()
+			if done != 3*lockPoolSize {
+				t.Errorf("%d callers got their locks, want %d", done, 3*lockPoolSize)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/referral"
+	"github.com/indikay/wallet-service/ent/referralcommission"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type referralRepo struct {
+	data  *Data
+	rates []string
+	log   *log.Helper
+}
+
+// NewReferralRepo returns a biz.ReferralRepo paying the commissions of conf.Referral.
+func NewReferralRepo(c *conf.Data, data *Data) (biz.ReferralRepo, error) {
+	rates, err := biz.ReferralRates(c.GetReferral().GetCommissions(), int(c.GetReferral().GetMaxDepth()))
+	if err != nil {
+		return nil, err
+	}
+	return &referralRepo{data: data, rates: rates, log: log.NewHelper(log.DefaultLogger)}, nil
+}
+
+// GetCommissionRates implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissionRates(ctx context.Context) ([]string, error) {
+	return r.rates, nil
+}
+
+// GetReferrer implements biz.ReferralRepo.
+func (r *referralRepo) GetReferrer(ctx context.Context, userId string) (string, error) {
+	rs, err := r.data.GetClient(ctx).Referral.Query().Where(referral.UserID(userId)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return rs.ReferrerID, nil
+}
+
+// SetReferrer implements biz.ReferralRepo.
+func (r *referralRepo) SetReferrer(ctx context.Context, userId, referrerId string) error {
+	err := r.data.GetClient(ctx).Referral.Create().SetUserID(userId).SetReferrerID(referrerId).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return errors.New(constant.ERROR_REFERRER_EXISTS)
+	}
+	return err
+}
+
+// CountReferrals implements biz.ReferralRepo.
+func (r *referralRepo) CountReferrals(ctx context.Context, referrerId string) (int, error) {
+	return r.data.GetClient(ctx).Referral.Query().Where(referral.ReferrerID(referrerId)).Count(ctx)
+}
+
+// SaveCommission implements biz.ReferralRepo.
+func (r *referralRepo) SaveCommission(ctx context.Context, commission *biz.ReferralCommission) error {
+	return r.data.GetClient(ctx).ReferralCommission.Create().SetUserID(commission.UserID).SetLevel(commission.Level).SetBuyerID(commission.BuyerID).
+		SetCoupon(commission.Coupon).SetSourceID(commission.SourceID).SetAmount(commission.Amount).SetSymbol(commission.Symbol).Exec(ctx)
+}
+
+// GetCommissions implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissions(ctx context.Context, userId, cursor string, limit int32) ([]*biz.ReferralCommission, string, error) {
+	where := []predicate.ReferralCommission{referralcommission.UserID(userId)}
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		where = append(where, referralcommission.IDLT(id))
+	}
+
+	rows, err := r.data.GetClient(ctx).ReferralCommission.Query().Where(where...).
+		Order(ent.Desc(referralcommission.FieldCreatedAt), ent.Desc(referralcommission.FieldID)).Limit(int(limit)).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rs := make([]*biz.ReferralCommission, len(rows))
+	for i, v := range rows {
+		rs[i] = r.mapCommissionToBiz(v)
+	}
+	next := ""
+	if len(rs) == int(limit) {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// GetCommissionsBySource implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissionsBySource(ctx context.Context, sourceId string) ([]*biz.ReferralCommission, error) {
+	rows, err := r.data.GetClient(ctx).ReferralCommission.Query().Where(referralcommission.SourceID(sourceId)).
+		Order(ent.Asc(referralcommission.FieldLevel)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ReferralCommission, len(rows))
+	for i, v := range rows {
+		rs[i] = r.mapCommissionToBiz(v)
+	}
+	return rs, nil
+}
+
+// GetEarnings implements biz.ReferralRepo. The amounts are numeric on
+// postgres, sqlite sums them as floats.
+func (r *referralRepo) GetEarnings(ctx context.Context, userId string) ([]*biz.ReferralEarning, error) {
+	var rows []struct {
+		Level       int             `sql:"level"`
+		Symbol      string          `sql:"symbol"`
+		Amount      decimal.Decimal `sql:"amount"`
+		Commissions int             `sql:"commissions"`
+	}
+	err := r.data.GetClient(ctx).ReferralCommission.Query().Where(referralcommission.UserID(userId)).Modify(func(s *sql.Selector) {
+		s.Select(s.C(referralcommission.FieldLevel), s.C(referralcommission.FieldSymbol),
+			"COALESCE(SUM(CAST(amount AS DECIMAL)), 0) AS amount", "COUNT(*) AS commissions").
+			GroupBy(s.C(referralcommission.FieldLevel), s.C(referralcommission.FieldSymbol)).
+			OrderBy(s.C(referralcommission.FieldLevel), s.C(referralcommission.FieldSymbol))
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ReferralEarning, len(rows))
+	for i, row := range rows {
+		rs[i] = &biz.ReferralEarning{Level: row.Level, Symbol: row.Symbol, Amount: row.Amount.String(), Commissions: row.Commissions}
+	}
+	return rs, nil
+}
+
+func (r *referralRepo) mapCommissionToBiz(v *ent.ReferralCommission) *biz.ReferralCommission {
+	return &biz.ReferralCommission{ID: v.ID, CreatedAt: v.CreatedAt, UserID: v.UserID, Level: v.Level, BuyerID: v.BuyerID, Coupon: v.Coupon,
+		SourceID: v.SourceID, Amount: v.Amount, Symbol: v.Symbol}
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/tokenomicversion"
+	"github.com/indikay/wallet-service/ent/transaction"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/shopspring/decimal"
+)
+
+type tokenomicsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTokenomicsRepo(data *Data) biz.TokenomicsRepo {
+	return &tokenomicsRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func (r *tokenomicsRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.data.WithTx(ctx, fn)
+}
+
+// GetVersion implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) GetVersion(ctx context.Context, version string) (*biz.TokenomicVersion, error) {
+	v, err := r.data.GetClient(ctx).TokenomicVersion.Query().Where(tokenomicversion.Version(version)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &biz.TokenomicVersion{ID: v.ID, CreatedAt: v.CreatedAt, Version: v.Version, Checksum: v.Checksum, Definition: v.Definition, Changes: v.Changes}, nil
+}
+
+// CreateVersion implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) CreateVersion(ctx context.Context, input *biz.TokenomicVersion) error {
+	return r.data.GetClient(ctx).TokenomicVersion.Create().SetVersion(input.Version).SetChecksum(input.Checksum).
+		SetDefinition(input.Definition).SetChanges(input.Changes).Exec(ctx)
+}
+
+// GetAllocated implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) GetAllocated(ctx context.Context, symbol, from, to string) (string, error) {
+	transactions, err := r.data.GetClient(ctx).Transaction.Query().Where(transaction.TransType(constant.TRANS_INTERNAL), transaction.SrcSymbol(symbol),
+		transaction.Or(
+			transaction.And(transaction.Source(from), transaction.Destination(to)),
+			transaction.And(transaction.Source(to), transaction.Destination(from)),
+		)).All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	allocated := decimal.Zero
+	for _, t := range transactions {
+		amount := decimal.RequireFromString(t.SrcAmount)
+		if t.Source == to {
+			amount = amount.Neg()
+		}
+		allocated = allocated.Add(amount)
+	}
+	return allocated.String(), nil
+}
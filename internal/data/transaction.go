@@ -68,8 +68,25 @@ func (r *transactionRepo) GetTransactionsByUserId(ctx context.Context, userId st
 	return rs, next, nil
 }
 
+// GetTransactionsBySourceIds implements biz.TransactionRepo.
+func (r *transactionRepo) GetTransactionsBySourceIds(ctx context.Context, sourceIds []string) ([]*biz.Transaction, error) {
+	if len(sourceIds) == 0 {
+		return nil, nil
+	}
+	trans, err := r.data.GetClient(ctx).Transaction.Query().Where(transaction.SourceIDIn(sourceIds...)).Order(ent.Asc(transaction.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.Transaction, len(trans))
+	for i, tr := range trans {
+		rs[i] = r.mapToBiz(tr)
+	}
+	return rs, nil
+}
+
 func (r *transactionRepo) mapToBiz(en *ent.Transaction) *biz.Transaction {
 	return &biz.Transaction{ID: en.ID, TransType: en.TransType, Source: en.Source, SrcSymbol: en.SrcSymbol, SrcAmount: en.SrcAmount,
 		Destination: en.Destination, DestSymbol: en.DestSymbol, DestAmount: en.DestAmount, Rate: en.Rate, SourceService: en.SourceService,
-		Status: en.Status, CreatedAt: en.CreatedAt, UpdatedAt: en.UpdatedAt}
+		SourceId: en.SourceID, Status: en.Status, CreatedAt: en.CreatedAt, UpdatedAt: en.UpdatedAt}
 }
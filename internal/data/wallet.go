@@ -2,7 +2,9 @@ package data
 
 import (
 	"context"
+	"errors"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/wallet-service/ent"
@@ -10,8 +12,13 @@ import (
 	"github.com/indikay/wallet-service/ent/userwallet"
 	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
 )
 
+// maxSwapRetry bounds the compare-and-swap updates on dialects without decimal arithmetic.
+const maxSwapRetry = 10
+
 type walletRepo struct {
 	data *Data
 	log  *log.Helper
@@ -41,10 +48,30 @@ func (r *walletRepo) CreateWallet(ctx context.Context, userId string, symbol, wa
 }
 
 func (r *walletRepo) CalculateBalance(ctx context.Context, userId, amount, symbol string) (bool, error) {
-	resp, err := r.data.GetClient(ctx).UserWallet.Query().
-		Where(userwallet.UserID(userId), userwallet.Symbol(symbol)).
-		Where(userwallet.BalanceGTE(amount)).
-		First(ctx)
+	query := r.data.GetClient(ctx).UserWallet.Query().
+		Where(userwallet.UserID(userId), userwallet.Symbol(symbol))
+	if r.data.dialect == dialect.SQLite {
+		// balances are text there, compare them as decimals
+		value, err := decimal.NewFromString(amount)
+		if err != nil {
+			return false, err
+		}
+		wallets, err := query.Clone().All(ctx)
+		if err != nil {
+			return false, err
+		}
+		var ids []xid.ID
+		for _, w := range wallets {
+			if decimal.RequireFromString(w.Balance).GreaterThanOrEqual(value) {
+				ids = append(ids, w.ID)
+			}
+		}
+		query = query.Where(userwallet.IDIn(ids...))
+	} else {
+		query = query.Where(userwallet.BalanceGTE(amount))
+	}
+
+	resp, err := query.First(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -53,6 +80,9 @@ func (r *walletRepo) CalculateBalance(ctx context.Context, userId, amount, symbo
 
 // DecreaseBalance implements biz.UserWalletRepo.
 func (r *walletRepo) DecreaseBalance(ctx context.Context, userId string, symbol string, amount string, walletType string) (int, error) {
+	if r.data.dialect == dialect.SQLite {
+		return r.swapBalance(ctx, userId, symbol, amount, walletType, true)
+	}
 	return r.data.GetClient(ctx).UserWallet.Update().Modify(func(u *sql.UpdateBuilder) {
 		u.Set(userwallet.FieldBalance, sql.ExprFunc(func(b *sql.Builder) {
 			b.Ident(userwallet.FieldBalance).WriteOp(sql.OpSub).Arg(amount)
@@ -62,6 +92,9 @@ func (r *walletRepo) DecreaseBalance(ctx context.Context, userId string, symbol
 
 // IncreaseBalance implements biz.UserWalletRepo.
 func (r *walletRepo) IncreaseBalance(ctx context.Context, userId string, symbol string, amount string, walletType string) (int, error) {
+	if r.data.dialect == dialect.SQLite {
+		return r.swapBalance(ctx, userId, symbol, amount, walletType, false)
+	}
 	return r.data.GetClient(ctx).UserWallet.Update().Modify(func(u *sql.UpdateBuilder) {
 		u.Set(userwallet.FieldBalance, sql.ExprFunc(func(b *sql.Builder) {
 			b.Ident(userwallet.FieldBalance).WriteOp(sql.OpAdd).Arg(amount)
@@ -69,6 +102,43 @@ func (r *walletRepo) IncreaseBalance(ctx context.Context, userId string, symbol
 	}).Where(userwallet.UserID(userId), userwallet.Symbol(symbol), userwallet.TypeEQ(walletType)).Save(ctx)
 }
 
+// swapBalance updates the balance for dialects without decimal arithmetic in
+// SQL. The new balance is computed here and only written if the row still has
+// the balance it was computed from, so a concurrent update makes it retry.
+func (r *walletRepo) swapBalance(ctx context.Context, userId, symbol, amount, walletType string, decrease bool) (int, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	for retry := 0; retry < maxSwapRetry; retry++ {
+		wallet, err := r.data.GetClient(ctx).UserWallet.Query().Where(userwallet.UserID(userId), userwallet.Symbol(symbol), userwallet.TypeEQ(walletType)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, nil
+			}
+			return 0, err
+		}
+
+		balance := decimal.RequireFromString(wallet.Balance)
+		if decrease {
+			if balance.LessThan(value) {
+				return 0, nil
+			}
+			balance = balance.Sub(value)
+		} else {
+			balance = balance.Add(value)
+		}
+
+		updated, err := r.data.GetClient(ctx).UserWallet.Update().SetBalance(balance.String()).
+			Where(userwallet.ID(wallet.ID), userwallet.Balance(wallet.Balance)).Save(ctx)
+		if err != nil || updated > 0 {
+			return updated, err
+		}
+	}
+	return 0, errors.New(constant.ERROR_LOCK)
+}
+
 // GetWalletByUserId implements biz.UserWalletRepo.
 func (r *walletRepo) GetWalletByUserId(ctx context.Context, userId, symbol, walletType string) ([]*biz.UserWallet, error) {
 	where := []predicate.UserWallet{userwallet.UserIDEQ(userId)}
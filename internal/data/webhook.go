@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/ent"
+	"github.com/indikay/wallet-service/ent/predicate"
+	"github.com/indikay/wallet-service/ent/webhook"
+	"github.com/indikay/wallet-service/ent/webhookdelivery"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type webhookRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWebhookRepo(data *Data) biz.WebhookRepo {
+	return &webhookRepo{data: data, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// CreateWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) CreateWebhook(ctx context.Context, input *biz.Webhook) (*biz.Webhook, error) {
+	rs, err := r.data.GetClient(ctx).Webhook.Create().SetOwner(input.Owner).SetURL(input.URL).SetSecret(input.Secret).
+		SetTransTypes(input.TransTypes).SetUserIds(input.UserIDs).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapWebhookToBiz(rs), nil
+}
+
+// GetWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) GetWebhook(ctx context.Context, id string) (*biz.Webhook, error) {
+	webhookId, err := xid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := r.data.GetClient(ctx).Webhook.Query().Where(webhook.ID(webhookId), webhook.DeletedAtIsNil()).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.mapWebhookToBiz(rs), nil
+}
+
+// GetWebhooksByOwner implements biz.WebhookRepo.
+func (r *webhookRepo) GetWebhooksByOwner(ctx context.Context, owner string) ([]*biz.Webhook, error) {
+	return r.getWebhooks(ctx, webhook.Owner(owner), webhook.DeletedAtIsNil())
+}
+
+// GetActiveWebhooks implements biz.WebhookRepo.
+func (r *webhookRepo) GetActiveWebhooks(ctx context.Context) ([]*biz.Webhook, error) {
+	return r.getWebhooks(ctx, webhook.DeletedAtIsNil())
+}
+
+func (r *webhookRepo) getWebhooks(ctx context.Context, where ...predicate.Webhook) ([]*biz.Webhook, error) {
+	webhooks, err := r.data.GetClient(ctx).Webhook.Query().Where(where...).Order(ent.Desc(webhook.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		rs[i] = r.mapWebhookToBiz(w)
+	}
+	return rs, nil
+}
+
+// DeleteWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) DeleteWebhook(ctx context.Context, id string) error {
+	webhookId, err := xid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.data.GetClient(ctx).Webhook.Update().Where(webhook.ID(webhookId), webhook.DeletedAtIsNil()).SetDeletedAt(time.Now()).Exec(ctx)
+}
+
+// CreateDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) CreateDelivery(ctx context.Context, input *biz.WebhookDelivery) (*biz.WebhookDelivery, error) {
+	rs, err := r.data.GetClient(ctx).WebhookDelivery.Create().SetWebhookID(input.WebhookID).SetEventID(input.EventID).
+		SetEventType(input.EventType).SetPayload(input.Payload).SetStatus(input.Status).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.mapDeliveryToBiz(rs), nil
+}
+
+// GetDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) GetDelivery(ctx context.Context, id string) (*biz.WebhookDelivery, error) {
+	deliveryId, err := xid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := r.data.GetClient(ctx).WebhookDelivery.Get(ctx, deliveryId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.mapDeliveryToBiz(rs), nil
+}
+
+// GetDeliveries implements biz.WebhookRepo.
+func (r *webhookRepo) GetDeliveries(ctx context.Context, webhookId, cursor string, limit int32) ([]*biz.WebhookDelivery, string, error) {
+	where := []predicate.WebhookDelivery{webhookdelivery.WebhookID(webhookId)}
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		where = append(where, webhookdelivery.IDLT(id))
+	}
+
+	deliveries, err := r.data.GetClient(ctx).WebhookDelivery.Query().Where(where...).Order(ent.Desc(webhookdelivery.FieldID)).Limit(int(limit)).All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rs = make([]*biz.WebhookDelivery, len(deliveries))
+	next := ""
+	for i, d := range deliveries {
+		rs[i] = r.mapDeliveryToBiz(d)
+		next = d.ID.String()
+	}
+
+	if len(rs) < int(limit) {
+		next = ""
+	}
+
+	return rs, next, nil
+}
+
+// UpdateDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) UpdateDelivery(ctx context.Context, input *biz.WebhookDelivery) error {
+	return r.data.GetClient(ctx).WebhookDelivery.UpdateOneID(input.ID).SetStatus(input.Status).SetAttempts(input.Attempts).
+		SetResponseCode(input.ResponseCode).SetLastError(input.LastError).SetNillableDeliveredAt(input.DeliveredAt).Exec(ctx)
+}
+
+func (r *webhookRepo) mapWebhookToBiz(en *ent.Webhook) *biz.Webhook {
+	return &biz.Webhook{ID: en.ID, CreatedAt: en.CreatedAt, Owner: en.Owner, URL: en.URL, Secret: en.Secret, TransTypes: en.TransTypes, UserIDs: en.UserIds}
+}
+
+func (r *webhookRepo) mapDeliveryToBiz(en *ent.WebhookDelivery) *biz.WebhookDelivery {
+	return &biz.WebhookDelivery{ID: en.ID, CreatedAt: en.CreatedAt, UpdatedAt: en.UpdatedAt, WebhookID: en.WebhookID, EventID: en.EventID,
+		EventType: en.EventType, Payload: en.Payload, Status: en.Status, Attempts: en.Attempts, ResponseCode: en.ResponseCode,
+		LastError: en.LastError, DeliveredAt: en.DeliveredAt}
+}
@@ -0,0 +1,85 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type alertRuleRepo struct {
+	store *Store
+}
+
+func NewAlertRuleRepo(store *Store) biz.AlertRuleRepo {
+	return &alertRuleRepo{store: store}
+}
+
+// SaveAlertRule implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) SaveAlertRule(ctx context.Context, input *biz.AlertRule) (*biz.AlertRule, error) {
+	var rs biz.AlertRule
+	err := r.store.run(ctx, func(st *state) error {
+		now := time.Now()
+		for i, rule := range st.alertRules {
+			if rule.UserID == input.UserID && rule.Type == input.Type && rule.Symbol == input.Symbol {
+				rule.Threshold = input.Threshold
+				rule.UpdatedAt = now
+				st.alertRules[i] = rule
+				rs = rule
+				return nil
+			}
+		}
+
+		rs = biz.AlertRule{ID: xid.New(), UpdatedAt: now, UserID: input.UserID, Type: input.Type, Symbol: input.Symbol, Threshold: input.Threshold}
+		st.alertRules = append(st.alertRules, rs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// GetAlertRulesByUserIds implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) GetAlertRulesByUserIds(ctx context.Context, userIds []string) ([]*biz.AlertRule, error) {
+	rs := []*biz.AlertRule{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, rule := range st.alertRules {
+			if contains(userIds, rule.UserID) {
+				rule := rule
+				rs = append(rs, &rule)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// DeleteAlertRule implements biz.AlertRuleRepo.
+func (r *alertRuleRepo) DeleteAlertRule(ctx context.Context, userId, id string) error {
+	ruleId, err := xid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.store.run(ctx, func(st *state) error {
+		rules := st.alertRules[:0:0]
+		for _, rule := range st.alertRules {
+			if rule.ID != ruleId || rule.UserID != userId {
+				rules = append(rules, rule)
+			}
+		}
+		st.alertRules = rules
+		return nil
+	})
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
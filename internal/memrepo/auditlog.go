@@ -0,0 +1,75 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type auditLogRepo struct {
+	store *Store
+}
+
+func NewAuditLogRepo(store *Store) biz.AuditLogRepo {
+	return &auditLogRepo{store: store}
+}
+
+// CreateAuditLog implements biz.AuditLogRepo.
+func (r *auditLogRepo) CreateAuditLog(ctx context.Context, input *biz.AuditLog) error {
+	entry := *input
+	entry.ID = xid.New()
+	entry.CreatedAt = time.Now()
+	return r.store.run(ctx, func(st *state) error {
+		st.auditLogs = append(st.auditLogs, entry)
+		return nil
+	})
+}
+
+// GetAuditLogs implements biz.AuditLogRepo.
+func (r *auditLogRepo) GetAuditLogs(ctx context.Context, filter *biz.AuditLogFilter, cursor string, limit int32) ([]*biz.AuditLog, string, error) {
+	var before *xid.ID
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		before = &id
+	}
+
+	rs := []*biz.AuditLog{}
+	err := r.store.run(ctx, func(st *state) error {
+		for i := len(st.auditLogs) - 1; i >= 0 && len(rs) < int(limit); i-- {
+			entry := st.auditLogs[i]
+			if (before != nil && entry.ID.Compare(*before) >= 0) || !r.match(filter, &entry) {
+				continue
+			}
+			rs = append(rs, &entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(rs) == int(limit) && len(rs) > 0 {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+func (r *auditLogRepo) match(filter *biz.AuditLogFilter, entry *biz.AuditLog) bool {
+	switch {
+	case len(filter.Actor) > 0 && entry.Actor != filter.Actor,
+		len(filter.Service) > 0 && entry.Service != filter.Service,
+		len(filter.Rpc) > 0 && entry.Rpc != filter.Rpc,
+		len(filter.TargetUserID) > 0 && entry.TargetUserID != filter.TargetUserID,
+		len(filter.Outcome) > 0 && entry.Outcome != filter.Outcome,
+		filter.From != nil && entry.CreatedAt.Before(*filter.From),
+		filter.To != nil && !entry.CreatedAt.Before(*filter.To):
+		return false
+	}
+	return true
+}
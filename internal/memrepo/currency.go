@@ -0,0 +1,57 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+type currencyRateRepo struct {
+	store *Store
+}
+
+func NewCurrencyRepo(store *Store) biz.CurrencyRateRepo {
+	return &currencyRateRepo{store: store}
+}
+
+func (r *currencyRateRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.store.WithTx(ctx, fn)
+}
+
+// GetCurrencyRate implements biz.CurrencyRateRepo.
+func (r *currencyRateRepo) GetCurrencyRate(ctx context.Context, symbol string) (*biz.CurrencyRate, error) {
+	var rs *biz.CurrencyRate
+	err := r.store.run(ctx, func(st *state) error {
+		rate, ok := st.rates[symbol]
+		if !ok {
+			return errNotFound
+		}
+		rs = &biz.CurrencyRate{Symbol: symbol, Rate: rate}
+		return nil
+	})
+	return rs, err
+}
+
+// UpdateCurrencyRateICO implements biz.CurrencyRateRepo.
+func (r *currencyRateRepo) UpdateCurrencyRateICO(ctx context.Context, rate string) error {
+	value, err := decimal.NewFromString(rate)
+	if err != nil {
+		return err
+	}
+	return r.store.run(ctx, func(st *state) error {
+		for symbol, v := range st.rates {
+			st.rates[symbol] = decimal.RequireFromString(v).Mul(value).String()
+		}
+		return nil
+	})
+}
+
+func (r *currencyRateRepo) InitData(ctx context.Context) error {
+	return r.store.run(ctx, func(st *state) error {
+		st.rates["VND_IND"] = "550"
+		st.rates["USD_IND"] = "0.022"
+		st.rates["USDT_IND"] = "0.022"
+		return nil
+	})
+}
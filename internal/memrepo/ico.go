@@ -0,0 +1,478 @@
+package memrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type icoRepo struct {
+	store *Store
+}
+
+func NewIcoRepo(store *Store) biz.ICORepo {
+	return &icoRepo{store: store}
+}
+
+func (r *icoRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.store.WithTx(ctx, fn)
+}
+
+// currentSubRound returns the index of the first open sub-round, or -1.
+func (r *icoRepo) currentSubRound(st *state) int {
+	current := -1
+	for i, s := range st.subRounds {
+		if s.IsEnded {
+			continue
+		}
+		if current < 0 || s.RoundId < st.subRounds[current].RoundId ||
+			(s.RoundId == st.subRounds[current].RoundId && s.SubRound < st.subRounds[current].SubRound) {
+			current = i
+		}
+	}
+	return current
+}
+
+func (r *icoRepo) subRoundById(st *state, id xid.ID) int {
+	for i, s := range st.subRounds {
+		if s.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetCurrentSubRound implements biz.ICORepo.
+func (r *icoRepo) GetCurrentSubRound(ctx context.Context) (*biz.ICOSubRound, error) {
+	var rs biz.ICOSubRound
+	err := r.store.run(ctx, func(st *state) error {
+		i := r.currentSubRound(st)
+		if i < 0 {
+			return errNotFound
+		}
+		rs = st.subRounds[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// GetSubRoundById implements biz.ICORepo.
+func (r *icoRepo) GetSubRoundById(ctx context.Context, id string) (*biz.ICOSubRound, error) {
+	rid, err := xid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.LockSubRound(ctx, rid)
+}
+
+// LockSubRound implements biz.ICORepo. Transactions already run one at a time.
+func (r *icoRepo) LockSubRound(ctx context.Context, id xid.ID) (*biz.ICOSubRound, error) {
+	var rs biz.ICOSubRound
+	err := r.store.run(ctx, func(st *state) error {
+		i := r.subRoundById(st, id)
+		if i < 0 {
+			return errNotFound
+		}
+		rs = st.subRounds[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+func (r *icoRepo) CloseSubRound(ctx context.Context, roundId, subRound int32, boughtToken string) (*biz.ICOSubRound, error) {
+	var rs *biz.ICOSubRound
+	err := r.store.run(ctx, func(st *state) error {
+		closed := false
+		for i, s := range st.subRounds {
+			if s.RoundId == roundId && s.SubRound == subRound && !s.IsEnded {
+				s.IsEnded = true
+				s.BoughtToken = boughtToken
+				st.subRounds[i] = s
+				closed = true
+			}
+		}
+		if !closed {
+			return fmt.Errorf("Round %d has already closed", roundId)
+		}
+
+		next := r.currentSubRound(st)
+		if next < 0 {
+			return nil
+		}
+		var roundLifetime int32
+		for _, round := range st.rounds {
+			if round.RoundId == st.subRounds[next].RoundId {
+				roundLifetime = round.Lifetime
+			}
+		}
+		st.subRounds[next].StartAt, st.subRounds[next].EndAt = biz.SubRoundWindow(time.Now(), &st.subRounds[next], roundLifetime)
+		round := st.subRounds[next]
+		rs = &round
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// TakeSubRoundToken implements biz.ICORepo.
+func (r *icoRepo) TakeSubRoundToken(ctx context.Context, id xid.ID, numToken string) (bool, error) {
+	value, err := decimal.NewFromString(numToken)
+	if err != nil {
+		return false, err
+	}
+
+	taken := false
+	err = r.store.run(ctx, func(st *state) error {
+		i := r.subRoundById(st, id)
+		if i < 0 || st.subRounds[i].IsEnded || !st.subRounds[i].PausedAt.IsZero() {
+			return nil
+		}
+		bought := decimal.RequireFromString(st.subRounds[i].BoughtToken).Add(value)
+		if bought.GreaterThan(decimal.RequireFromString(st.subRounds[i].TotalToken)) {
+			return nil
+		}
+		st.subRounds[i].BoughtToken = bought.String()
+		taken = true
+		return nil
+	})
+	return taken, err
+}
+
+func (r *icoRepo) SaveHistories(ctx context.Context, histories []biz.ICOHistory) error {
+	return r.store.run(ctx, func(st *state) error {
+		for _, h := range histories {
+			h.ID = xid.New()
+			h.CreatedAt = time.Now()
+			st.histories = append(st.histories, h)
+		}
+		return nil
+	})
+}
+
+func (r *icoRepo) GetRoundByRoundId(ctx context.Context, roundId int32) (*biz.ICORound, error) {
+	var rs *biz.ICORound
+	err := r.store.run(ctx, func(st *state) error {
+		for _, round := range st.rounds {
+			if round.RoundId == roundId {
+				rs = &round
+				return nil
+			}
+		}
+		return errNotFound
+	})
+	return rs, err
+}
+
+func (r *icoRepo) EndRoundByRoundId(ctx context.Context, roundId int32) error {
+	return r.store.run(ctx, func(st *state) error {
+		now := time.Now()
+		for i, round := range st.rounds {
+			if round.RoundId == roundId {
+				st.rounds[i].EndedAt = &now
+			}
+		}
+		return nil
+	})
+}
+
+// GetRounds implements biz.ICORepo.
+func (r *icoRepo) GetRounds(ctx context.Context) ([]*biz.ICORound, error) {
+	rs := []*biz.ICORound{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, round := range st.rounds {
+			round := round
+			rs = append(rs, &round)
+		}
+		return nil
+	})
+	sort.Slice(rs, func(i, j int) bool { return rs[i].RoundId < rs[j].RoundId })
+	return rs, err
+}
+
+// SaveRound implements biz.ICORepo.
+func (r *icoRepo) SaveRound(ctx context.Context, input *biz.ICORound) error {
+	return r.store.run(ctx, func(st *state) error {
+		round := biz.ICORound{ID: xid.New(), RoundId: input.RoundId, RoundName: input.RoundName, Price: input.Price, NumToken: input.NumToken,
+			PriceGap: input.PriceGap, NumSub: input.NumSub, Lifetime: input.Lifetime, Limits: input.Limits, Pricing: input.Pricing, Unsold: input.Unsold,
+			SoftCap: input.SoftCap, HardCap: input.HardCap}
+		for i, rd := range st.rounds {
+			if rd.RoundId == input.RoundId {
+				round.ID, round.EndedAt, round.Status = rd.ID, rd.EndedAt, rd.Status
+				st.rounds[i] = round
+				return nil
+			}
+		}
+		st.rounds = append(st.rounds, round)
+		return nil
+	})
+}
+
+// DeleteRound implements biz.ICORepo.
+func (r *icoRepo) DeleteRound(ctx context.Context, roundId int32) error {
+	return r.store.run(ctx, func(st *state) error {
+		rounds := st.rounds[:0:0]
+		for _, rd := range st.rounds {
+			if rd.RoundId != roundId {
+				rounds = append(rounds, rd)
+			}
+		}
+		st.rounds = rounds
+		return nil
+	})
+}
+
+// GetSubRounds implements biz.ICORepo.
+func (r *icoRepo) GetSubRounds(ctx context.Context, roundId int32) ([]*biz.ICOSubRound, error) {
+	var rs []*biz.ICOSubRound
+	err := r.store.run(ctx, func(st *state) error {
+		for _, s := range st.subRounds {
+			if roundId > 0 && s.RoundId != roundId {
+				continue
+			}
+			s := s
+			rs = append(rs, &s)
+		}
+		return nil
+	})
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].RoundId < rs[j].RoundId || (rs[i].RoundId == rs[j].RoundId && rs[i].SubRound < rs[j].SubRound)
+	})
+	return rs, err
+}
+
+// SaveSubRound implements biz.ICORepo.
+func (r *icoRepo) SaveSubRound(ctx context.Context, input *biz.ICOSubRound) error {
+	return r.store.run(ctx, func(st *state) error {
+		if input.ID.IsZero() {
+			input.ID = xid.New()
+			st.subRounds = append(st.subRounds, biz.ICOSubRound{ID: input.ID, RoundId: input.RoundId, SubRound: input.SubRound, Price: input.Price,
+				BoughtToken: "0", TotalToken: input.TotalToken, StartAt: input.StartAt, EndAt: input.EndAt, Lifetime: input.Lifetime})
+			return nil
+		}
+		for i, s := range st.subRounds {
+			if s.ID == input.ID {
+				st.subRounds[i].Price = input.Price
+				st.subRounds[i].TotalToken = input.TotalToken
+				st.subRounds[i].StartAt = input.StartAt
+				st.subRounds[i].EndAt = input.EndAt
+				st.subRounds[i].Lifetime = input.Lifetime
+				return nil
+			}
+		}
+		return errNotFound
+	})
+}
+
+// SetSubRoundEnd implements biz.ICORepo.
+func (r *icoRepo) SetSubRoundEnd(ctx context.Context, id xid.ID, endAt time.Time) error {
+	return r.store.run(ctx, func(st *state) error {
+		i := r.subRoundById(st, id)
+		if i < 0 {
+			return errNotFound
+		}
+		st.subRounds[i].EndAt = endAt
+		return nil
+	})
+}
+
+// PauseSubRound implements biz.ICORepo.
+func (r *icoRepo) PauseSubRound(ctx context.Context, id xid.ID, pausedAt time.Time) error {
+	return r.store.run(ctx, func(st *state) error {
+		i := r.subRoundById(st, id)
+		if i < 0 {
+			return errNotFound
+		}
+		st.subRounds[i].PausedAt = pausedAt
+		return nil
+	})
+}
+
+// ResumeSubRound implements biz.ICORepo.
+func (r *icoRepo) ResumeSubRound(ctx context.Context, id xid.ID, endAt time.Time) error {
+	return r.store.run(ctx, func(st *state) error {
+		i := r.subRoundById(st, id)
+		if i < 0 {
+			return errNotFound
+		}
+		st.subRounds[i].PausedAt, st.subRounds[i].EndAt = time.Time{}, endAt
+		return nil
+	})
+}
+
+// DeleteSubRound implements biz.ICORepo.
+func (r *icoRepo) DeleteSubRound(ctx context.Context, id xid.ID) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, s := range st.subRounds {
+			if s.ID == id {
+				st.subRounds = append(st.subRounds[:i:i], st.subRounds[i+1:]...)
+				return nil
+			}
+		}
+		return errNotFound
+	})
+}
+
+func (r *icoRepo) InitData(ctx context.Context, startTime time.Time) error {
+	return r.store.run(ctx, func(st *state) error {
+		startPrice, _ := decimal.NewFromString("0.022")
+		numSub := int32(100)
+		numToken := decimal.NewFromInt(80000000)
+		subRoundToken := numToken.Div(decimal.NewFromInt32(numSub)).String()
+
+		for i := 0; i < 5; i++ {
+			if i > 0 {
+				startPrice = startPrice.Add(startPrice.Mul(decimal.NewFromFloat(float64(0.5))))
+			}
+			st.rounds = append(st.rounds, biz.ICORound{ID: xid.New(), RoundId: int32(i + 1), RoundName: fmt.Sprintf("Round %d", i+1),
+				Price: startPrice.String(), NumToken: numToken.String(), PriceGap: "50%", NumSub: numSub})
+			for j := int32(1); j <= numSub; j++ {
+				st.subRounds = append(st.subRounds, biz.ICOSubRound{ID: xid.New(), RoundId: int32(i + 1), SubRound: j, Price: startPrice.String(),
+					BoughtToken: "0", TotalToken: subRoundToken})
+			}
+		}
+
+		first := r.currentSubRound(st)
+		st.subRounds[first].StartAt, st.subRounds[first].EndAt = startTime, startTime.Add(subRoundLifetime())
+		return nil
+	})
+}
+
+func (r *icoRepo) GetBuyICOUser(ctx context.Context, limit, offset int) ([]*biz.ICOUserBought, error) {
+	var userBought []*biz.ICOUserBought
+	err := r.store.run(ctx, func(st *state) error {
+		userBought = r.rankUsers(st)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if offset >= len(userBought) {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > len(userBought) {
+		end = len(userBought)
+	}
+	return userBought[offset:end], nil
+}
+
+// GetUserHistories implements biz.ICORepo.
+func (r *icoRepo) GetUserHistories(ctx context.Context, userId, cursor string, limit int32) ([]*biz.ICOHistory, string, error) {
+	var before *xid.ID
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		before = &id
+	}
+
+	rs := []*biz.ICOHistory{}
+	err := r.store.run(ctx, func(st *state) error {
+		// newest first
+		for i := len(st.histories) - 1; i >= 0 && (limit <= 0 || len(rs) < int(limit)); i-- {
+			h := st.histories[i]
+			if h.UserId != userId || (before != nil && h.ID.Compare(*before) >= 0) {
+				continue
+			}
+			rs = append(rs, &h)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if limit > 0 && len(rs) == int(limit) {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// GetUserBoughtToken implements biz.ICORepo.
+func (r *icoRepo) GetUserBoughtToken(ctx context.Context, userId string, roundId int32) (string, error) {
+	bought := decimal.Zero
+	err := r.store.run(ctx, func(st *state) error {
+		for _, h := range st.histories {
+			if h.UserId == userId && h.RoundId == roundId {
+				bought = bought.Add(decimal.RequireFromString(h.NumToken))
+			}
+		}
+		return nil
+	})
+	return bought.String(), err
+}
+
+// GetRoundRaised implements biz.ICORepo.
+func (r *icoRepo) GetRoundRaised(ctx context.Context, roundId int32) (string, error) {
+	raised := decimal.Zero
+	err := r.store.run(ctx, func(st *state) error {
+		for _, h := range st.histories {
+			if h.RoundId == roundId {
+				raised = raised.Add(decimal.RequireFromString(h.Price).Mul(decimal.RequireFromString(h.NumToken)))
+			}
+		}
+		return nil
+	})
+	return raised.String(), err
+}
+
+// SetRoundStatus implements biz.ICORepo.
+func (r *icoRepo) SetRoundStatus(ctx context.Context, roundId int32, status string) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, round := range st.rounds {
+			if round.RoundId == roundId {
+				st.rounds[i].Status = status
+				return nil
+			}
+		}
+		return errNotFound
+	})
+}
+
+func (r *icoRepo) GetBuyICOTotalUser(ctx context.Context) (int, error) {
+	count := 0
+	err := r.store.run(ctx, func(st *state) error {
+		count = len(r.rankUsers(st))
+		return nil
+	})
+	return count, err
+}
+
+// rankUsers sums the histories per user, biggest buyer first. The users
+// refunded of everything they bought are not ranked.
+func (r *icoRepo) rankUsers(st *state) []*biz.ICOUserBought {
+	totals := map[string]decimal.Decimal{}
+	for _, h := range st.histories {
+		totals[h.UserId] = totals[h.UserId].Add(decimal.RequireFromString(h.NumToken))
+	}
+
+	users := make([]string, 0, len(totals))
+	for userId, total := range totals {
+		if total.IsPositive() {
+			users = append(users, userId)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return totals[users[i]].GreaterThan(totals[users[j]]) })
+
+	rs := make([]*biz.ICOUserBought, len(users))
+	for i, userId := range users {
+		rs[i] = &biz.ICOUserBought{Rank: i + 1, UserId: userId, NumToken: totals[userId].String()}
+	}
+	return rs
+}
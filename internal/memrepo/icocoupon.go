@@ -0,0 +1,198 @@
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+)
+
+type icoCouponRepo struct {
+	store *Store
+}
+
+func NewIcoCouponRepo(store *Store) biz.IcoCouponRepo {
+	return &icoCouponRepo{store: store}
+}
+
+// AddCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) AddCoupon(ctx context.Context, icoCoupon *biz.IcoCoupon) error {
+	return r.store.run(ctx, func(st *state) error {
+		key := strings.ToUpper(icoCoupon.Coupon)
+		if _, ok := st.coupons[key]; ok {
+			return errors.New(constant.ERROR_COUPON_EXISTS)
+		}
+		now := time.Now()
+		coupon := *icoCoupon
+		coupon.ID, coupon.CreatedAt, coupon.UpdatedAt = xid.New(), now, now
+		coupon.Used, coupon.DeletedAt = 0, nil
+		st.coupons[key] = coupon
+		return nil
+	})
+}
+
+// AddCoupons implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) AddCoupons(ctx context.Context, icoCoupons []*biz.IcoCoupon) error {
+	return r.store.run(ctx, func(st *state) error {
+		now := time.Now()
+		for _, v := range icoCoupons {
+			key := strings.ToUpper(v.Coupon)
+			if _, ok := st.coupons[key]; ok {
+				return errors.New(constant.ERROR_COUPON_EXISTS)
+			}
+			coupon := *v
+			coupon.ID, coupon.CreatedAt, coupon.UpdatedAt = xid.New(), now, now
+			coupon.Used, coupon.DeletedAt = 0, nil
+			st.coupons[key] = coupon
+		}
+		return nil
+	})
+}
+
+// TakenCoupons implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) TakenCoupons(ctx context.Context, coupons []string) ([]string, error) {
+	rs := []string{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range coupons {
+			if c, ok := st.coupons[strings.ToUpper(v)]; ok {
+				rs = append(rs, c.Coupon)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// UpdateCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) UpdateCoupon(ctx context.Context, icoCoupon *biz.IcoCoupon) error {
+	return r.update(ctx, icoCoupon.ID, func(coupon *biz.IcoCoupon) bool {
+		coupon.Reward = icoCoupon.Reward
+		coupon.Cashback = icoCoupon.Cashback
+		coupon.StartsAt = icoCoupon.StartsAt
+		coupon.EndsAt = icoCoupon.EndsAt
+		coupon.MaxUses = icoCoupon.MaxUses
+		coupon.SingleUse = icoCoupon.SingleUse
+		coupon.MinPurchase = icoCoupon.MinPurchase
+		return true
+	})
+}
+
+// SetCouponDeletedAt implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) SetCouponDeletedAt(ctx context.Context, id xid.ID, deletedAt *time.Time) error {
+	return r.update(ctx, id, func(coupon *biz.IcoCoupon) bool {
+		coupon.DeletedAt = deletedAt
+		return true
+	})
+}
+
+// UseCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) UseCoupon(ctx context.Context, id xid.ID) (bool, error) {
+	used := false
+	err := r.update(ctx, id, func(coupon *biz.IcoCoupon) bool {
+		if coupon.MaxUses > 0 && coupon.Used >= coupon.MaxUses {
+			return false
+		}
+		coupon.Used++
+		used = true
+		return true
+	})
+	return used, err
+}
+
+// update applies fn to the coupon id and saves it when fn returns true.
+func (r *icoCouponRepo) update(ctx context.Context, id xid.ID, fn func(coupon *biz.IcoCoupon) bool) error {
+	return r.store.run(ctx, func(st *state) error {
+		for key, coupon := range st.coupons {
+			if coupon.ID != id {
+				continue
+			}
+			if fn(&coupon) {
+				coupon.UpdatedAt = time.Now()
+				st.coupons[key] = coupon
+			}
+			return nil
+		}
+		return nil
+	})
+}
+
+// GetCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) GetCoupon(ctx context.Context, coupon string) (*biz.IcoCoupon, error) {
+	rs, err := r.FindCoupon(ctx, coupon)
+	if rs != nil && rs.DeletedAt != nil {
+		return nil, err
+	}
+	return rs, err
+}
+
+// FindCoupon implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) FindCoupon(ctx context.Context, coupon string) (*biz.IcoCoupon, error) {
+	var rs *biz.IcoCoupon
+	err := r.store.run(ctx, func(st *state) error {
+		if c, ok := st.coupons[strings.ToUpper(coupon)]; ok {
+			rs = &c
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// ListCoupons implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) ListCoupons(ctx context.Context, userId string, withDeleted bool) ([]*biz.IcoCoupon, error) {
+	rs := []*biz.IcoCoupon{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, c := range st.coupons {
+			if c.UserID == userId && (withDeleted || c.DeletedAt == nil) {
+				coupon := c
+				rs = append(rs, &coupon)
+			}
+		}
+		return nil
+	})
+	sort.Slice(rs, func(i, j int) bool { return rs[i].CreatedAt.After(rs[j].CreatedAt) })
+	return rs, err
+}
+
+// CountRedemptions implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) CountRedemptions(ctx context.Context, couponId xid.ID, userId string) (int, error) {
+	count := 0
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.redemptions {
+			if v.CouponID == couponId && v.UserID == userId {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+// SaveRedemption implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) SaveRedemption(ctx context.Context, redemption *biz.IcoCouponRedemption) error {
+	return r.store.run(ctx, func(st *state) error {
+		rs := *redemption
+		rs.ID, rs.CreatedAt = xid.New(), time.Now()
+		st.redemptions = append(st.redemptions, rs)
+		return nil
+	})
+}
+
+// GetRedemption implements biz.IcoCouponRepo.
+func (r *icoCouponRepo) GetRedemption(ctx context.Context, sourceId string) (*biz.IcoCouponRedemption, error) {
+	var rs *biz.IcoCouponRedemption
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.redemptions {
+			if v.SourceID == sourceId {
+				rs = &v
+				return nil
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
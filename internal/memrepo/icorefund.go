@@ -0,0 +1,112 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type icoRefundRepo struct {
+	store  *Store
+	window time.Duration
+}
+
+func NewICORefundRepo(c *conf.Data, store *Store) biz.ICORefundRepo {
+	return &icoRefundRepo{store: store, window: c.GetRefund().GetWindow().AsDuration()}
+}
+
+// GetRefundWindow implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetRefundWindow(ctx context.Context) time.Duration {
+	return r.window
+}
+
+// GetPaymentHistories implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetPaymentHistories(ctx context.Context, sourceId string) ([]*biz.ICOHistory, error) {
+	rs := []*biz.ICOHistory{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, h := range st.histories {
+			if h.SourceId == sourceId {
+				history := h
+				rs = append(rs, &history)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// GetRoundHistories implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetRoundHistories(ctx context.Context, roundId int32) ([]*biz.ICOHistory, error) {
+	rs := []*biz.ICOHistory{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, h := range st.histories {
+			if h.RoundId == roundId {
+				history := h
+				rs = append(rs, &history)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// ReturnSubRoundToken implements biz.ICORefundRepo.
+func (r *icoRefundRepo) ReturnSubRoundToken(ctx context.Context, id xid.ID, numToken string) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, s := range st.subRounds {
+			if s.ID == id {
+				s.BoughtToken = decimal.RequireFromString(s.BoughtToken).Sub(decimal.RequireFromString(numToken)).String()
+				st.subRounds[i] = s
+				return nil
+			}
+		}
+		return errNotFound
+	})
+}
+
+// SaveOutstandingRefund implements biz.ICORefundRepo.
+func (r *icoRefundRepo) SaveOutstandingRefund(ctx context.Context, refund *biz.ICOOutstandingRefund) error {
+	return r.store.run(ctx, func(st *state) error {
+		for _, o := range st.outstanding {
+			if o.SourceId == refund.SourceId {
+				return nil
+			}
+		}
+		row := *refund
+		row.CreatedAt = time.Now()
+		st.outstanding = append(st.outstanding, row)
+		return nil
+	})
+}
+
+// DeleteOutstandingRefund implements biz.ICORefundRepo.
+func (r *icoRefundRepo) DeleteOutstandingRefund(ctx context.Context, sourceId string) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, o := range st.outstanding {
+			if o.SourceId == sourceId {
+				st.outstanding = append(st.outstanding[:i:i], st.outstanding[i+1:]...)
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// GetOutstandingRefunds implements biz.ICORefundRepo.
+func (r *icoRefundRepo) GetOutstandingRefunds(ctx context.Context, roundId int32) ([]*biz.ICOOutstandingRefund, error) {
+	rs := []*biz.ICOOutstandingRefund{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, o := range st.outstanding {
+			if roundId == 0 || o.RoundId == roundId {
+				refund := o
+				rs = append(rs, &refund)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
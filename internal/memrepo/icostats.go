@@ -0,0 +1,173 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+// volume sums histories like the SQL of the data repository does.
+type volume struct {
+	purchases map[string]bool
+	buyers    map[string]bool
+	numToken  decimal.Decimal
+	raised    decimal.Decimal
+	amount    decimal.Decimal
+}
+
+func newVolume() *volume {
+	return &volume{purchases: map[string]bool{}, buyers: map[string]bool{}}
+}
+
+func (v *volume) add(h biz.ICOHistory) {
+	// a history without source_id was paid alone
+	purchase := h.SourceId
+	if len(purchase) == 0 {
+		purchase = h.ID.String()
+	}
+	v.purchases[purchase] = true
+	v.buyers[h.UserId] = true
+	numToken := decimal.RequireFromString(h.NumToken)
+	v.numToken = v.numToken.Add(numToken)
+	v.raised = v.raised.Add(numToken.Mul(decimal.RequireFromString(h.Price)))
+	if len(h.Amount) > 0 {
+		v.amount = v.amount.Add(decimal.RequireFromString(h.Amount))
+	}
+}
+
+func (v *volume) stats() biz.ICOVolume {
+	return biz.ICOVolume{Purchases: len(v.purchases), Buyers: len(v.buyers), NumToken: v.numToken.String(), Raised: v.raised.String()}
+}
+
+type icoStatsRepo struct {
+	store *Store
+}
+
+func NewICOStatsRepo(store *Store) biz.ICOStatsRepo {
+	return &icoStatsRepo{store: store}
+}
+
+// histories calls fn with every history created in [from, to).
+func histories(st *state, from, to time.Time, fn func(h biz.ICOHistory)) {
+	for _, h := range st.histories {
+		if (!from.IsZero() && h.CreatedAt.Before(from)) || (!to.IsZero() && !h.CreatedAt.Before(to)) {
+			continue
+		}
+		fn(h)
+	}
+}
+
+// GetVolume implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetVolume(ctx context.Context, from, to time.Time, coupon bool) (*biz.ICOVolume, error) {
+	v := newVolume()
+	err := r.store.run(ctx, func(st *state) error {
+		paid := map[string]bool{}
+		for _, t := range st.transactions {
+			if t.TransType == biz.ICO_COMISSION || t.TransType == biz.ICO_CASHBACK {
+				paid[t.SourceId] = true
+			}
+		}
+		histories(st, from, to, func(h biz.ICOHistory) {
+			if !coupon || (len(h.SourceId) > 0 && paid[h.SourceId]) {
+				v.add(h)
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats := v.stats()
+	return &stats, nil
+}
+
+// GetRoundVolumes implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetRoundVolumes(ctx context.Context, from, to time.Time) ([]*biz.ICORoundStats, error) {
+	type subRound struct{ roundId, subRound int32 }
+	volumes := map[subRound]*volume{}
+	err := r.store.run(ctx, func(st *state) error {
+		histories(st, from, to, func(h biz.ICOHistory) {
+			key := subRound{h.RoundId, h.SubRound}
+			if volumes[key] == nil {
+				volumes[key] = newVolume()
+			}
+			volumes[key].add(h)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICORoundStats, 0, len(volumes))
+	for key, v := range volumes {
+		rs = append(rs, &biz.ICORoundStats{RoundId: key.roundId, SubRound: key.subRound, ICOVolume: v.stats()})
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].RoundId != rs[j].RoundId {
+			return rs[i].RoundId < rs[j].RoundId
+		}
+		return rs[i].SubRound < rs[j].SubRound
+	})
+	return rs, nil
+}
+
+// GetCurrencyVolumes implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetCurrencyVolumes(ctx context.Context, from, to time.Time) ([]*biz.ICOCurrencyStats, error) {
+	volumes := map[string]*volume{}
+	err := r.store.run(ctx, func(st *state) error {
+		histories(st, from, to, func(h biz.ICOHistory) {
+			if len(h.Symbol) == 0 {
+				return
+			}
+			if volumes[h.Symbol] == nil {
+				volumes[h.Symbol] = newVolume()
+			}
+			volumes[h.Symbol].add(h)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]*biz.ICOCurrencyStats, 0, len(volumes))
+	for symbol, v := range volumes {
+		rs = append(rs, &biz.ICOCurrencyStats{Symbol: symbol, Amount: v.amount.String(), ICOVolume: v.stats()})
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Symbol < rs[j].Symbol })
+	return rs, nil
+}
+
+// SaveDailyStats implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) SaveDailyStats(ctx context.Context, stats *biz.ICODailyStats) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, s := range st.dailyStats {
+			if s.Day.Equal(stats.Day) {
+				st.dailyStats[i] = *stats
+				return nil
+			}
+		}
+		st.dailyStats = append(st.dailyStats, *stats)
+		return nil
+	})
+}
+
+// GetDailyStats implements biz.ICOStatsRepo.
+func (r *icoStatsRepo) GetDailyStats(ctx context.Context, from, to time.Time) ([]*biz.ICODailyStats, error) {
+	rs := []*biz.ICODailyStats{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, s := range st.dailyStats {
+			if !s.Day.Before(from) && s.Day.Before(to) {
+				s := s
+				rs = append(rs, &s)
+			}
+		}
+		return nil
+	})
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Day.Before(rs[j].Day) })
+	return rs, err
+}
@@ -0,0 +1,104 @@
+package memrepo
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+type invariantRepo struct {
+	store *Store
+}
+
+func NewInvariantRepo(store *Store) biz.InvariantRepo {
+	return &invariantRepo{store: store}
+}
+
+// GetSupplies implements biz.InvariantRepo.
+func (r *invariantRepo) GetSupplies(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	var supplies []*biz.SymbolSupply
+	err := r.store.run(ctx, func(st *state) error {
+		totals := map[string]decimal.Decimal{}
+		for _, w := range st.wallets {
+			totals[w.Symbol] = totals[w.Symbol].Add(decimal.RequireFromString(w.Balance))
+		}
+		for symbol, total := range totals {
+			supplies = append(supplies, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+		}
+		return nil
+	})
+	sort.Slice(supplies, func(i, j int) bool { return supplies[i].Symbol < supplies[j].Symbol })
+	return supplies, err
+}
+
+// GetBurned implements biz.InvariantRepo.
+func (r *invariantRepo) GetBurned(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	var burned []*biz.SymbolSupply
+	err := r.store.run(ctx, func(st *state) error {
+		totals := map[string]decimal.Decimal{}
+		for _, t := range st.transactions {
+			if t.TransType == biz.ICO_BURN {
+				totals[t.SrcSymbol] = totals[t.SrcSymbol].Add(decimal.RequireFromString(t.SrcAmount))
+			}
+		}
+		for symbol, total := range totals {
+			burned = append(burned, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+		}
+		return nil
+	})
+	return burned, err
+}
+
+// GetMinted implements biz.InvariantRepo.
+func (r *invariantRepo) GetMinted(ctx context.Context) ([]*biz.SymbolSupply, error) {
+	var minted []*biz.SymbolSupply
+	err := r.store.run(ctx, func(st *state) error {
+		totals := map[string]decimal.Decimal{}
+		for _, t := range st.transactions {
+			if slices.Contains(biz.MINT_TYPES, t.TransType) {
+				totals[t.DestSymbol] = totals[t.DestSymbol].Add(decimal.RequireFromString(t.DestAmount))
+			}
+		}
+		for symbol, total := range totals {
+			minted = append(minted, &biz.SymbolSupply{Symbol: symbol, Total: total.String()})
+		}
+		return nil
+	})
+	return minted, err
+}
+
+// GetNegativeWallets implements biz.InvariantRepo.
+func (r *invariantRepo) GetNegativeWallets(ctx context.Context) ([]*biz.UserWallet, error) {
+	var rs []*biz.UserWallet
+	err := r.store.run(ctx, func(st *state) error {
+		for _, w := range st.wallets {
+			if decimal.RequireFromString(w.Balance).IsNegative() {
+				w := w
+				rs = append(rs, &w)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// GetSubRoundSales implements biz.InvariantRepo.
+func (r *invariantRepo) GetSubRoundSales(ctx context.Context) ([]*biz.SubRoundSale, error) {
+	var sales []*biz.SubRoundSale
+	err := r.store.run(ctx, func(st *state) error {
+		bought := map[[2]int32]decimal.Decimal{}
+		for _, h := range st.histories {
+			key := [2]int32{h.RoundId, h.SubRound}
+			bought[key] = bought[key].Add(decimal.RequireFromString(h.NumToken))
+		}
+		for _, s := range st.subRounds {
+			sales = append(sales, &biz.SubRoundSale{RoundId: s.RoundId, SubRound: s.SubRound, BoughtToken: s.BoughtToken,
+				HistoryToken: bought[[2]int32{s.RoundId, s.SubRound}].String()})
+		}
+		return nil
+	})
+	return sales, err
+}
@@ -0,0 +1,146 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/shopspring/decimal"
+)
+
+type leaderboardSnapshot struct {
+	takenAt time.Time
+	users   []biz.ICOUserBought
+}
+
+// leaderboardRepo keeps the leaderboard outside the store, like Redis it is
+// not rolled back with a transaction. The snapshots are in the store.
+type leaderboardRepo struct {
+	store  *Store
+	mu     sync.Mutex
+	totals map[string]decimal.Decimal
+}
+
+func NewLeaderboardRepo(store *Store) biz.LeaderboardRepo {
+	return &leaderboardRepo{store: store, totals: map[string]decimal.Decimal{}}
+}
+
+// AddUserToken implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) AddUserToken(ctx context.Context, userId, numToken string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.totals[userId] = r.totals[userId].Add(decimal.RequireFromString(numToken))
+	if !r.totals[userId].IsPositive() {
+		delete(r.totals, userId)
+	}
+	return nil
+}
+
+// GetRanks implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetRanks(ctx context.Context, offset, limit int) ([]*biz.ICOUserBought, error) {
+	users := r.rank()
+	if offset >= len(users) {
+		return []*biz.ICOUserBought{}, nil
+	}
+	end := offset + limit
+	if end > len(users) {
+		end = len(users)
+	}
+	return users[offset:end], nil
+}
+
+// GetUserRank implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetUserRank(ctx context.Context, userId string) (*biz.ICOUserBought, error) {
+	for _, u := range r.rank() {
+		if u.UserId == userId {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+// CountUsers implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) CountUsers(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.totals), nil
+}
+
+// Replace implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) Replace(ctx context.Context, users []*biz.ICOUserBought) error {
+	totals := make(map[string]decimal.Decimal, len(users))
+	for _, u := range users {
+		totals[u.UserId] = decimal.RequireFromString(u.NumToken)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.totals = totals
+	return nil
+}
+
+// SaveSnapshot implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) SaveSnapshot(ctx context.Context, takenAt time.Time, users []*biz.ICOUserBought) error {
+	return r.store.run(ctx, func(st *state) error {
+		for _, s := range st.leaderboardSnapshots {
+			if s.takenAt.Equal(takenAt) {
+				return nil
+			}
+		}
+		if len(users) == 0 {
+			return nil
+		}
+		snapshot := leaderboardSnapshot{takenAt: takenAt, users: make([]biz.ICOUserBought, len(users))}
+		for i, u := range users {
+			snapshot.users[i] = *u
+		}
+		st.leaderboardSnapshots = append(st.leaderboardSnapshots, snapshot)
+		return nil
+	})
+}
+
+// GetSnapshot implements biz.LeaderboardRepo.
+func (r *leaderboardRepo) GetSnapshot(ctx context.Context, takenAt time.Time) ([]*biz.ICOUserBought, time.Time, error) {
+	var found *leaderboardSnapshot
+	err := r.store.run(ctx, func(st *state) error {
+		for i, s := range st.leaderboardSnapshots {
+			if (takenAt.IsZero() && (found == nil || s.takenAt.After(found.takenAt))) || s.takenAt.Equal(takenAt) {
+				found = &st.leaderboardSnapshots[i]
+			}
+		}
+		return nil
+	})
+	if err != nil || found == nil {
+		return nil, time.Time{}, err
+	}
+
+	rs := make([]*biz.ICOUserBought, len(found.users))
+	for i := range found.users {
+		u := found.users[i]
+		rs[i] = &u
+	}
+	return rs, found.takenAt, nil
+}
+
+// rank orders the users, biggest buyer first.
+func (r *leaderboardRepo) rank() []*biz.ICOUserBought {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	users := make([]string, 0, len(r.totals))
+	for userId := range r.totals {
+		users = append(users, userId)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if !r.totals[users[i]].Equal(r.totals[users[j]]) {
+			return r.totals[users[i]].GreaterThan(r.totals[users[j]])
+		}
+		return users[i] > users[j]
+	})
+
+	rs := make([]*biz.ICOUserBought, len(users))
+	for i, userId := range users {
+		rs[i] = &biz.ICOUserBought{Rank: i + 1, UserId: userId, NumToken: r.totals[userId].String()}
+	}
+	return rs
+}
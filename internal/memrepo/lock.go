@@ -0,0 +1,87 @@
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+)
+
+const defaultLockTimeout = 100 * time.Second
+
+type lock struct {
+	token string
+	done  chan struct{}
+}
+
+type lockRepo struct {
+	mu      sync.Mutex
+	locks   map[string]*lock
+	timeout time.Duration
+}
+
+// NewLockRepo returns a biz.LockRepo that only locks within this process.
+func NewLockRepo(c *conf.Data) biz.LockRepo {
+	timeout := defaultLockTimeout
+	if c.Lock != nil && c.Lock.Timeout != nil {
+		timeout = c.Lock.Timeout.AsDuration()
+	}
+	return &lockRepo{locks: map[string]*lock{}, timeout: timeout}
+}
+
+// Lock implements biz.LockRepo.
+func (l *lockRepo) Lock(ctx context.Context, keys ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	token := xid.New().String()
+	for i, key := range keys {
+		if err := l.lock(ctx, key, token); err != nil {
+			l.UnLock(ctx, token, keys[:i]...)
+			return "", err
+		}
+	}
+	return token, nil
+}
+
+func (l *lockRepo) lock(ctx context.Context, key, token string) error {
+	for {
+		l.mu.Lock()
+		held, ok := l.locks[key]
+		if !ok {
+			l.locks[key] = &lock{token: token, done: make(chan struct{})}
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		select {
+		case <-held.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// UnLock implements biz.LockRepo.
+func (l *lockRepo) UnLock(ctx context.Context, token string, keys ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err error
+	for _, key := range keys {
+		held, ok := l.locks[key]
+		if !ok || held.token != token {
+			err = errors.New(constant.ERROR_LOCK)
+			continue
+		}
+		delete(l.locks, key)
+		close(held.done)
+	}
+	return err
+}
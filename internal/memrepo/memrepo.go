@@ -0,0 +1,158 @@
+// Package memrepo implements the biz repositories, lock, queue and publisher in
+// memory, so the use cases can run without Postgres, Redis or NATS.
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+)
+
+// ProviderSet boots the service fully in memory, it replaces data.ProviderSet,
+// messaging.ProviderSet and the queue constructors.
+var ProviderSet = wire.NewSet(NewDevStore, NewBroker, NewIcoRepo, NewWalletRepo, NewTransactionRepo, NewCurrencyRepo, NewIcoCouponRepo,
+	NewLockRepo, NewWebhookRepo, NewAlertRuleRepo, NewAuditLogRepo, NewInvariantRepo, NewTokenomicsRepo, NewLeaderboardRepo, NewICOStatsRepo, NewReferralRepo, NewICORefundRepo, NewQueue, NewWebhookQueue, NewPublisher)
+
+var errNotFound = errors.New(constant.ERROR_NOT_FOUND)
+
+// state holds every table. Rows are values and updates replace them, so a
+// shallow copy of the collections is a full snapshot.
+type state struct {
+	wallets      []biz.UserWallet
+	transactions []biz.Transaction
+	rounds       []biz.ICORound
+	subRounds    []biz.ICOSubRound
+	histories    []biz.ICOHistory
+	rates        map[string]string
+	coupons      map[string]biz.IcoCoupon
+	redemptions  []biz.IcoCouponRedemption
+	referrers    map[string]string
+	commissions  []biz.ReferralCommission
+	outstanding  []biz.ICOOutstandingRefund
+	webhooks     []webhookRow
+	deliveries   []biz.WebhookDelivery
+	alertRules   []biz.AlertRule
+	auditLogs    []biz.AuditLog
+
+	tokenomicVersions    []biz.TokenomicVersion
+	leaderboardSnapshots []leaderboardSnapshot
+	dailyStats           []biz.ICODailyStats
+}
+
+func (s *state) clone() *state {
+	c := &state{
+		wallets:      append([]biz.UserWallet(nil), s.wallets...),
+		transactions: append([]biz.Transaction(nil), s.transactions...),
+		rounds:       append([]biz.ICORound(nil), s.rounds...),
+		subRounds:    append([]biz.ICOSubRound(nil), s.subRounds...),
+		histories:    append([]biz.ICOHistory(nil), s.histories...),
+		rates:        make(map[string]string, len(s.rates)),
+		coupons:      make(map[string]biz.IcoCoupon, len(s.coupons)),
+		redemptions:  append([]biz.IcoCouponRedemption(nil), s.redemptions...),
+		referrers:    make(map[string]string, len(s.referrers)),
+		commissions:  append([]biz.ReferralCommission(nil), s.commissions...),
+		outstanding:  append([]biz.ICOOutstandingRefund(nil), s.outstanding...),
+		webhooks:     append([]webhookRow(nil), s.webhooks...),
+		deliveries:   append([]biz.WebhookDelivery(nil), s.deliveries...),
+		alertRules:   append([]biz.AlertRule(nil), s.alertRules...),
+		auditLogs:    append([]biz.AuditLog(nil), s.auditLogs...),
+
+		tokenomicVersions:    append([]biz.TokenomicVersion(nil), s.tokenomicVersions...),
+		leaderboardSnapshots: append([]leaderboardSnapshot(nil), s.leaderboardSnapshots...),
+		dailyStats:           append([]biz.ICODailyStats(nil), s.dailyStats...),
+	}
+	for k, v := range s.rates {
+		c.rates[k] = v
+	}
+	for k, v := range s.coupons {
+		c.coupons[k] = v
+	}
+	for k, v := range s.referrers {
+		c.referrers[k] = v
+	}
+	return c
+}
+
+// Store is the in-memory database shared by the repositories. Transactions run
+// one at a time, a failed one restores the state it started from.
+type Store struct {
+	tx    chan struct{}
+	state *state
+}
+
+// avoid error: should not use built-in type string as key for value; define your own type to avoid collisions
+type storeTransaction string
+
+const (
+	storeTransactionKey = storeTransaction("storeTransaction")
+)
+
+// NewStore returns an empty store.
+func NewStore() *Store {
+	return &Store{tx: make(chan struct{}, 1), state: &state{rates: map[string]string{}, coupons: map[string]biz.IcoCoupon{}, referrers: map[string]string{}}}
+}
+
+// NewDevStore returns a store seeded with the ICO rounds, currency rates and
+// system wallets the migration creates.
+func NewDevStore() (*Store, error) {
+	s := NewStore()
+	ctx := context.Background()
+	if err := NewWalletRepo(s).InitData(ctx); err != nil {
+		return nil, err
+	}
+	if err := NewCurrencyRepo(s).InitData(ctx); err != nil {
+		return nil, err
+	}
+	if err := NewIcoRepo(s).InitData(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	tokenomicsUc := biz.NewTokenomicsUsecase(NewTokenomicsRepo(s), NewIcoRepo(s), NewWalletRepo(s), NewTransactionRepo(s))
+	if _, err := tokenomicsUc.CreatePaymentWallets(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WithTx runs fn in a transaction, joining the one already in ctx.
+func (s *Store) WithTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if ctx.Value(storeTransactionKey) == s {
+		return fn(ctx)
+	}
+
+	select {
+	case s.tx <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-s.tx }()
+
+	saved := s.state.clone()
+	defer func() {
+		if v := recover(); v != nil {
+			s.state = saved
+			panic(v)
+		}
+	}()
+	if err := fn(context.WithValue(ctx, storeTransactionKey, s)); err != nil {
+		s.state = saved
+		return err
+	}
+	return nil
+}
+
+// run gives fn the state, inside the caller's transaction or a new one.
+func (s *Store) run(ctx context.Context, fn func(st *state) error) error {
+	return s.WithTx(ctx, func(ctx context.Context) error {
+		return fn(s.state)
+	})
+}
+
+func subRoundLifetime() time.Duration {
+	lifetime, _ := strconv.Atoi(constant.SUBROUND_LIFETIME)
+	return time.Duration(lifetime) * time.Minute
+}
@@ -0,0 +1,113 @@
+package memrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/indikay/wallet-service/internal/memrepo"
+)
+
+var errAbort = errors.New("abort")
+
+func balance(t *testing.T, wr biz.UserWalletRepo, userId string) string {
+	t.Helper()
+	wallets, err := wr.GetWalletByUserId(context.Background(), userId, constant.TokenSymbolIND, constant.WALLET_TYPE_USER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallets) == 0 {
+		return ""
+	}
+	return wallets[0].Balance
+}
+
+func TestWithTxRollsBack(t *testing.T) {
+	tests := []struct {
+		name        string
+		fn          func(ctx context.Context, wr biz.UserWalletRepo) error
+		wantBalance string
+	}{
+		{name: "commit", fn: func(ctx context.Context, wr biz.UserWalletRepo) error {
+			_, err := wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, "5", constant.WALLET_TYPE_USER)
+			return err
+		}, wantBalance: "15"},
+		{name: "error", fn: func(ctx context.Context, wr biz.UserWalletRepo) error {
+			if _, err := wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, "5", constant.WALLET_TYPE_USER); err != nil {
+				return err
+			}
+			if _, err := wr.CreateWallet(ctx, "u2", constant.TokenSymbolIND, constant.WALLET_TYPE_USER); err != nil {
+				return err
+			}
+			return errAbort
+		}, wantBalance: "10"},
+		{name: "error of a joined transaction", fn: func(ctx context.Context, wr biz.UserWalletRepo) error {
+			if _, err := wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, "5", constant.WALLET_TYPE_USER); err != nil {
+				return err
+			}
+			// the inner call joins, its failure undoes the outer credit too
+			return wr.WithTx(ctx, func(ctx context.Context) error {
+				if _, err := wr.DecreaseBalance(ctx, "u1", constant.TokenSymbolIND, "1", constant.WALLET_TYPE_USER); err != nil {
+					return err
+				}
+				return errAbort
+			})
+		}, wantBalance: "10"},
+		{name: "panic", fn: func(ctx context.Context, wr biz.UserWalletRepo) error {
+			if _, err := wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, "5", constant.WALLET_TYPE_USER); err != nil {
+				return err
+			}
+			panic(errAbort)
+		}, wantBalance: "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			wr := memrepo.NewWalletRepo(memrepo.NewStore())
+			if _, err := wr.CreateWallet(ctx, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := wr.IncreaseBalance(ctx, "u1", constant.TokenSymbolIND, "10", constant.WALLET_TYPE_USER); err != nil {
+				t.Fatal(err)
+			}
+
+			func() {
+				defer func() {
+					if v := recover(); v != nil && v != errAbort {
+						panic(v)
+					}
+				}()
+				_ = wr.WithTx(ctx, func(ctx context.Context) error { return tt.fn(ctx, wr) })
+			}()
+
+			if got := balance(t, wr, "u1"); got != tt.wantBalance {
+				t.Errorf("balance %s, want %s", got, tt.wantBalance)
+			}
+			if tt.wantBalance == "10" && balance(t, wr, "u2") != "" {
+				t.Error("wallet u2 created by a rolled back transaction")
+			}
+		})
+	}
+}
+
+// A transaction rolled back, by an error or a panic, lets the next one run.
+func TestWithTxReleases(t *testing.T) {
+	ctx := context.Background()
+	wr := memrepo.NewWalletRepo(memrepo.NewStore())
+	if err := wr.WithTx(ctx, func(ctx context.Context) error { return errAbort }); err != errAbort {
+		t.Fatalf("err %v, want %v", err, errAbort)
+	}
+	func() {
+		defer func() { recover() }()
+		_ = wr.WithTx(ctx, func(ctx context.Context) error { panic(errAbort) })
+	}()
+
+	timeout, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if _, err := wr.CreateWallet(timeout, "u1", constant.TokenSymbolIND, constant.WALLET_TYPE_USER); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,60 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/messaging"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Publisher keeps the published events in memory and dispatches them to
+// webhooks as it publishes them, the NATS publisher queues that. Fired alerts
+// are only logged.
+type Publisher struct {
+	mu        sync.Mutex
+	events    []*biz.TransactionEvent
+	webhookUc *biz.WebhookUsecase
+	alertUc   *biz.AlertUsecase
+	logger    *log.Helper
+}
+
+func NewPublisher(webhookUc *biz.WebhookUsecase, alertUc *biz.AlertUsecase) biz.TransactionPublisher {
+	return &Publisher{webhookUc: webhookUc, alertUc: alertUc, logger: log.NewHelper(log.DefaultLogger)}
+}
+
+// Publish implements biz.TransactionPublisher.
+func (p *Publisher) Publish(ctx context.Context, event *biz.TransactionEvent) {
+	p.mu.Lock()
+	p.events = append(p.events, event)
+	p.mu.Unlock()
+
+	alerts, err := p.alertUc.Evaluate(ctx, event)
+	if err != nil {
+		p.logger.Errorf("error evaluate alerts %s: %v", event.ID, err)
+	}
+	for _, alert := range alerts {
+		p.logger.Infof("alert %s fired for %s on %s", alert.Rule.Type, alert.Rule.UserID, alert.TransactionID)
+	}
+
+	envelope, err := messaging.NewTransactionEnvelope(event)
+	if err != nil {
+		p.logger.Errorf("error build event %s: %v", event.ID, err)
+		return
+	}
+	payload, err := protojson.Marshal(envelope)
+	if err != nil {
+		p.logger.Errorf("error marshal webhook event %s: %v", event.ID, err)
+		return
+	}
+	p.webhookUc.Dispatch(ctx, event, envelope.Type, payload)
+}
+
+// Events returns the events published so far, oldest first.
+func (p *Publisher) Events() []*biz.TransactionEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*biz.TransactionEvent(nil), p.events...)
+}
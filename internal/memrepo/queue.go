@@ -0,0 +1,262 @@
+package memrepo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/rs/xid"
+)
+
+const (
+	defaultWebhookMaxRetry   = 10
+	retryDelay               = 30 * time.Second
+	defaultInvariantInterval = time.Hour
+	defaultSnapshotInterval  = 24 * time.Hour
+)
+
+type brokerTask struct {
+	*biz.Task
+	maxRetry int
+	retried  int
+}
+
+type brokerHandler func(ctx context.Context, task *biz.Task, lastAttempt bool) error
+
+// Broker runs scheduled tasks in process, it stands in for asynq. Tasks with
+// the same ID are queued once and handlers are picked by name prefix.
+type Broker struct {
+	mu       sync.Mutex
+	handlers map[string]brokerHandler
+	timers   map[string]*time.Timer
+	pending  []*brokerTask
+	started  bool
+	stopped  bool
+	log      *log.Helper
+}
+
+func NewBroker() *Broker {
+	return &Broker{handlers: map[string]brokerHandler{}, timers: map[string]*time.Timer{}, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func (b *Broker) handle(prefix string, handler brokerHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[prefix] = handler
+}
+
+func (b *Broker) enqueue(task *biz.Task, maxRetry int) {
+	if len(task.ID) == 0 {
+		task.ID = xid.New().String()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.timers[task.ID]; ok || b.stopped {
+		return
+	}
+	bt := &brokerTask{Task: task, maxRetry: maxRetry}
+	if !b.started {
+		b.timers[task.ID] = nil
+		b.pending = append(b.pending, bt)
+		return
+	}
+	b.schedule(bt, time.Until(task.ProcessAt))
+}
+
+// schedule must be called with b.mu held.
+func (b *Broker) schedule(bt *brokerTask, delay time.Duration) {
+	b.timers[bt.ID] = time.AfterFunc(delay, func() { b.run(bt) })
+}
+
+func (b *Broker) run(bt *brokerTask) {
+	b.mu.Lock()
+	var handler brokerHandler
+	matched := ""
+	for prefix, h := range b.handlers {
+		if strings.HasPrefix(bt.Name, prefix) && len(prefix) > len(matched) {
+			handler, matched = h, prefix
+		}
+	}
+	b.mu.Unlock()
+
+	var err error
+	if handler == nil {
+		err = fmt.Errorf("no handler for task %s", bt.Name)
+	} else {
+		err = handler(context.Background(), bt.Task, bt.retried >= bt.maxRetry)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil && bt.retried < bt.maxRetry && !b.stopped {
+		b.log.Errorf("task %s failed, retry %d: %v", bt.ID, bt.retried+1, err)
+		bt.retried++
+		b.schedule(bt, retryDelay)
+		return
+	}
+	if err != nil {
+		b.log.Errorf("task %s failed: %v", bt.ID, err)
+	}
+	delete(b.timers, bt.ID)
+}
+
+func (b *Broker) start() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.started = true
+	for _, bt := range b.pending {
+		b.schedule(bt, time.Until(bt.ProcessAt))
+	}
+	b.pending = nil
+}
+
+func (b *Broker) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stopped = true
+	for _, t := range b.timers {
+		if t != nil {
+			t.Stop()
+		}
+	}
+}
+
+type queue struct {
+	*biz.QueueRunner
+	broker      *Broker
+	invariant   *conf.Invariant
+	leaderboard *conf.Leaderboard
+	log         *log.Helper
+}
+
+// NewQueue returns a biz.QueueJob running the ICO, webhook, invariant, leaderboard, stats and round settlement tasks on broker.
+func NewQueue(c *conf.Data, broker *Broker, repo biz.ICORepo, walletRepo biz.UserWalletRepo, transRepo biz.TransactionRepo, icoUc *biz.ICOUsecase, lockRepo biz.LockRepo, publisher biz.TransactionPublisher, webhookUc *biz.WebhookUsecase, invariantUc *biz.InvariantUsecase, statsUc *biz.ICOStatsUsecase, refundUc *biz.ICORefundUsecase) biz.QueueJob {
+	q := &queue{broker: broker, invariant: c.Invariant, leaderboard: c.Leaderboard, log: log.NewHelper(log.DefaultLogger), QueueRunner: biz.NewQueueRunner(repo, walletRepo, transRepo, icoUc, lockRepo, publisher)}
+
+	broker.handle(biz.QUEUE_PREFIX, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		err := q.Execute(ctx, task)
+		if err != nil {
+			if lastAttempt {
+				q.log.Errorf("%s %s failed for good, the sub-round stays open: %v", task.Name, task.Data, err)
+			}
+			return err
+		}
+		settle, err := q.QueueRunner.SettleTask(ctx, task)
+		if err != nil {
+			return err
+		}
+		if settle != nil {
+			q.Enqueue(ctx, settle)
+		}
+		round, err := q.QueueRunner.GetICOCurrentRound(ctx)
+		if err != nil {
+			return err
+		}
+		q.Enqueue(ctx, biz.NewEndRoundTask(round))
+		return nil
+	})
+	broker.handle(biz.ICO_ROUND_SETTLE, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		roundId, err := strconv.ParseInt(task.Data, 10, 32)
+		if err != nil {
+			return err
+		}
+		err = refundUc.SettleRound(ctx, int32(roundId))
+		if err != nil && lastAttempt {
+			q.log.Errorf("%s %s failed for good: %v", task.Name, task.Data, err)
+		}
+		return err
+	})
+	broker.handle(biz.WEBHOOK_DELIVER, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		return webhookUc.Deliver(ctx, task.Data, lastAttempt)
+	})
+	broker.handle(biz.INVARIANT_CHECK, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		q.Enqueue(ctx, biz.NextInvariantCheck(time.Now(), q.invariantInterval()))
+		return invariantUc.Verify(ctx, q.invariant.GetSupply())
+	})
+	broker.handle(biz.LEADERBOARD_SNAPSHOT, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		q.Enqueue(ctx, biz.NextLeaderboardSnapshot(time.Now(), q.snapshotInterval()))
+		takenAt, err := time.Parse(time.RFC3339, task.Data)
+		if err != nil {
+			return err
+		}
+		return icoUc.SnapshotLeaderboard(ctx, takenAt, int(q.leaderboard.GetSnapshotSize()))
+	})
+	broker.handle(biz.ICO_STATS_ROLLUP, func(ctx context.Context, task *biz.Task, lastAttempt bool) error {
+		q.Enqueue(ctx, biz.NextICOStatsRollup(time.Now()))
+		day, err := time.Parse(time.DateOnly, task.Data)
+		if err != nil {
+			return err
+		}
+		_, err = statsUc.RollupDay(ctx, day)
+		return err
+	})
+	return q
+}
+
+func (q *queue) invariantInterval() time.Duration {
+	if q.invariant.GetInterval().AsDuration() > 0 {
+		return q.invariant.GetInterval().AsDuration()
+	}
+	return defaultInvariantInterval
+}
+
+func (q *queue) snapshotInterval() time.Duration {
+	if q.leaderboard.GetSnapshotInterval().AsDuration() > 0 {
+		return q.leaderboard.GetSnapshotInterval().AsDuration()
+	}
+	return defaultSnapshotInterval
+}
+
+// Enqueue implements biz.QueueJob.
+func (q *queue) Enqueue(ctx context.Context, task *biz.Task) error {
+	task.ID = fmt.Sprintf("%s-%d", task.Data, task.ProcessAt.Unix())
+	maxRetry := task.MaxRetry
+	if maxRetry <= 0 {
+		maxRetry = 1
+	}
+	q.broker.enqueue(task, maxRetry)
+	q.log.Infof("Enqueue %s - %s", task.ID, task.ProcessAt)
+	return nil
+}
+
+// Start implements biz.QueueJob.
+func (q *queue) Start(ctx context.Context) error {
+	q.Enqueue(ctx, biz.NextInvariantCheck(time.Now(), q.invariantInterval()))
+	q.Enqueue(ctx, biz.NextLeaderboardSnapshot(time.Now(), q.snapshotInterval()))
+	q.Enqueue(ctx, biz.NextICOStatsRollup(time.Now()))
+	q.broker.start()
+	return nil
+}
+
+// Stop implements biz.QueueJob.
+func (q *queue) Stop(ctx context.Context) error {
+	q.broker.stop()
+	return nil
+}
+
+type webhookQueue struct {
+	broker   *Broker
+	maxRetry int
+}
+
+// NewWebhookQueue returns a biz.WebhookQueue queuing deliveries on broker.
+func NewWebhookQueue(c *conf.Data, broker *Broker) biz.WebhookQueue {
+	maxRetry := defaultWebhookMaxRetry
+	if c.Webhook != nil && c.Webhook.MaxRetry > 0 {
+		maxRetry = int(c.Webhook.MaxRetry)
+	}
+	return &webhookQueue{broker: broker, maxRetry: maxRetry}
+}
+
+// EnqueueDelivery implements biz.WebhookQueue.
+func (q *webhookQueue) EnqueueDelivery(ctx context.Context, deliveryId string) error {
+	q.broker.enqueue(&biz.Task{Name: biz.WEBHOOK_DELIVER, Data: deliveryId, ProcessAt: time.Now()}, q.maxRetry)
+	return nil
+}
@@ -0,0 +1,163 @@
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type referralRepo struct {
+	store *Store
+	rates []string
+}
+
+func NewReferralRepo(c *conf.Data, store *Store) (biz.ReferralRepo, error) {
+	rates, err := biz.ReferralRates(c.GetReferral().GetCommissions(), int(c.GetReferral().GetMaxDepth()))
+	if err != nil {
+		return nil, err
+	}
+	return &referralRepo{store: store, rates: rates}, nil
+}
+
+// GetCommissionRates implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissionRates(ctx context.Context) ([]string, error) {
+	return r.rates, nil
+}
+
+// GetReferrer implements biz.ReferralRepo.
+func (r *referralRepo) GetReferrer(ctx context.Context, userId string) (string, error) {
+	var rs string
+	err := r.store.run(ctx, func(st *state) error {
+		rs = st.referrers[userId]
+		return nil
+	})
+	return rs, err
+}
+
+// SetReferrer implements biz.ReferralRepo.
+func (r *referralRepo) SetReferrer(ctx context.Context, userId, referrerId string) error {
+	return r.store.run(ctx, func(st *state) error {
+		if _, ok := st.referrers[userId]; ok {
+			return errors.New(constant.ERROR_REFERRER_EXISTS)
+		}
+		st.referrers[userId] = referrerId
+		return nil
+	})
+}
+
+// CountReferrals implements biz.ReferralRepo.
+func (r *referralRepo) CountReferrals(ctx context.Context, referrerId string) (int, error) {
+	count := 0
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.referrers {
+			if v == referrerId {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+// SaveCommission implements biz.ReferralRepo.
+func (r *referralRepo) SaveCommission(ctx context.Context, commission *biz.ReferralCommission) error {
+	return r.store.run(ctx, func(st *state) error {
+		rs := *commission
+		rs.ID, rs.CreatedAt = xid.New(), time.Now()
+		st.commissions = append(st.commissions, rs)
+		return nil
+	})
+}
+
+// GetCommissions implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissions(ctx context.Context, userId, cursor string, limit int32) ([]*biz.ReferralCommission, string, error) {
+	var before *xid.ID
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		before = &id
+	}
+
+	rs := []*biz.ReferralCommission{}
+	err := r.store.run(ctx, func(st *state) error {
+		// newest first
+		for i := len(st.commissions) - 1; i >= 0 && len(rs) < int(limit); i-- {
+			v := st.commissions[i]
+			if v.UserID != userId || (before != nil && v.ID.Compare(*before) >= 0) {
+				continue
+			}
+			rs = append(rs, &v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(rs) == int(limit) {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// GetCommissionsBySource implements biz.ReferralRepo.
+func (r *referralRepo) GetCommissionsBySource(ctx context.Context, sourceId string) ([]*biz.ReferralCommission, error) {
+	rs := []*biz.ReferralCommission{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.commissions {
+			if v.SourceID == sourceId {
+				commission := v
+				rs = append(rs, &commission)
+			}
+		}
+		return nil
+	})
+	sort.SliceStable(rs, func(i, j int) bool { return rs[i].Level < rs[j].Level })
+	return rs, err
+}
+
+// GetEarnings implements biz.ReferralRepo.
+func (r *referralRepo) GetEarnings(ctx context.Context, userId string) ([]*biz.ReferralEarning, error) {
+	type key struct {
+		level  int
+		symbol string
+	}
+	sums := map[key]decimal.Decimal{}
+	counts := map[key]int{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.commissions {
+			if v.UserID != userId {
+				continue
+			}
+			k := key{v.Level, v.Symbol}
+			sums[k] = sums[k].Add(decimal.RequireFromString(v.Amount))
+			counts[k]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rs := []*biz.ReferralEarning{}
+	for k, sum := range sums {
+		rs = append(rs, &biz.ReferralEarning{Level: k.level, Symbol: k.symbol, Amount: sum.String(), Commissions: counts[k]})
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Level != rs[j].Level {
+			return rs[i].Level < rs[j].Level
+		}
+		return rs[i].Symbol < rs[j].Symbol
+	})
+	return rs, nil
+}
@@ -0,0 +1,75 @@
+package memrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type tokenomicsRepo struct {
+	store *Store
+}
+
+func NewTokenomicsRepo(store *Store) biz.TokenomicsRepo {
+	return &tokenomicsRepo{store: store}
+}
+
+func (r *tokenomicsRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.store.WithTx(ctx, fn)
+}
+
+// GetVersion implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) GetVersion(ctx context.Context, version string) (*biz.TokenomicVersion, error) {
+	var rs *biz.TokenomicVersion
+	err := r.store.run(ctx, func(st *state) error {
+		for _, v := range st.tokenomicVersions {
+			if v.Version == version {
+				v := v
+				rs = &v
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// CreateVersion implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) CreateVersion(ctx context.Context, input *biz.TokenomicVersion) error {
+	return r.store.run(ctx, func(st *state) error {
+		for _, v := range st.tokenomicVersions {
+			if v.Version == input.Version {
+				return errors.New(constant.ERROR_TOKENOMICS_APPLIED)
+			}
+		}
+		v := *input
+		v.ID = xid.New()
+		v.CreatedAt = time.Now()
+		st.tokenomicVersions = append(st.tokenomicVersions, v)
+		return nil
+	})
+}
+
+// GetAllocated implements biz.TokenomicsRepo.
+func (r *tokenomicsRepo) GetAllocated(ctx context.Context, symbol, from, to string) (string, error) {
+	allocated := decimal.Zero
+	err := r.store.run(ctx, func(st *state) error {
+		for _, t := range st.transactions {
+			if t.TransType != constant.TRANS_INTERNAL || t.SrcSymbol != symbol {
+				continue
+			}
+			switch {
+			case t.Source == from && t.Destination == to:
+				allocated = allocated.Add(decimal.RequireFromString(t.SrcAmount))
+			case t.Source == to && t.Destination == from:
+				allocated = allocated.Sub(decimal.RequireFromString(t.SrcAmount))
+			}
+		}
+		return nil
+	})
+	return allocated.String(), err
+}
@@ -0,0 +1,94 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type transactionRepo struct {
+	store *Store
+}
+
+func NewTransactionRepo(store *Store) biz.TransactionRepo {
+	return &transactionRepo{store: store}
+}
+
+func (r *transactionRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.store.WithTx(ctx, fn)
+}
+
+// CreateTransaction implements biz.TransactionRepo.
+func (r *transactionRepo) CreateTransaction(ctx context.Context, input *biz.Transaction) (*biz.Transaction, error) {
+	trans := *input
+	trans.ID = xid.New()
+	trans.CreatedAt = time.Now()
+	trans.UpdatedAt = trans.CreatedAt
+	err := r.store.run(ctx, func(st *state) error {
+		st.transactions = append(st.transactions, trans)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &trans, nil
+}
+
+// GetTransactionsByUserId implements biz.TransactionRepo.
+func (r *transactionRepo) GetTransactionsByUserId(ctx context.Context, userId, cursor string, limit int32) ([]*biz.Transaction, string, error) {
+	var before *xid.ID
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		before = &id
+	}
+
+	rs := []*biz.Transaction{}
+	err := r.store.run(ctx, func(st *state) error {
+		// newest first
+		for i := len(st.transactions) - 1; i >= 0 && len(rs) < int(limit); i-- {
+			trans := st.transactions[i]
+			if trans.TransType == biz.DEPOSITE || (trans.Source != userId && trans.Destination != userId) {
+				continue
+			}
+			if before != nil && trans.ID.Compare(*before) >= 0 {
+				continue
+			}
+			rs = append(rs, &trans)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(rs) == int(limit) && len(rs) > 0 {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// GetTransactionsBySourceIds implements biz.TransactionRepo.
+func (r *transactionRepo) GetTransactionsBySourceIds(ctx context.Context, sourceIds []string) ([]*biz.Transaction, error) {
+	ids := make(map[string]bool, len(sourceIds))
+	for _, id := range sourceIds {
+		ids[id] = true
+	}
+
+	rs := []*biz.Transaction{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, trans := range st.transactions {
+			if ids[trans.SourceId] {
+				trans := trans
+				rs = append(rs, &trans)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
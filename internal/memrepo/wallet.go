@@ -0,0 +1,133 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"github.com/shopspring/decimal"
+)
+
+type walletRepo struct {
+	store *Store
+}
+
+func NewWalletRepo(store *Store) biz.UserWalletRepo {
+	return &walletRepo{store: store}
+}
+
+func (r *walletRepo) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.store.WithTx(ctx, fn)
+}
+
+// CreateWallet implements biz.UserWalletRepo.
+func (r *walletRepo) CreateWallet(ctx context.Context, userId, symbol, walletType string) (*biz.UserWallet, error) {
+	var rs *biz.UserWallet
+	err := r.store.run(ctx, func(st *state) error {
+		r.createWallet(st, userId, symbol, walletType, "0")
+		for _, w := range st.wallets {
+			if w.UserID == userId && w.Symbol == symbol {
+				rs = &w
+				return nil
+			}
+		}
+		return errNotFound
+	})
+	return rs, err
+}
+
+// createWallet adds the wallet unless it exists, like the unique index does.
+func (r *walletRepo) createWallet(st *state, userId, symbol, walletType, balance string) {
+	for _, w := range st.wallets {
+		if w.UserID == userId && w.Symbol == symbol && w.Type == walletType {
+			return
+		}
+	}
+	now := time.Now()
+	st.wallets = append(st.wallets, biz.UserWallet{ID: xid.New(), CreatedAt: now, UpdatedAt: now, UserID: userId, Type: walletType,
+		Symbol: symbol, Balance: balance, IsActive: true})
+}
+
+// CalculateBalance implements biz.UserWalletRepo.
+func (r *walletRepo) CalculateBalance(ctx context.Context, userId, amount, symbol string) (bool, error) {
+	found := false
+	err := r.store.run(ctx, func(st *state) error {
+		for _, w := range st.wallets {
+			if w.UserID == userId && w.Symbol == symbol && decimal.RequireFromString(w.Balance).GreaterThanOrEqual(decimal.RequireFromString(amount)) {
+				found = true
+				return nil
+			}
+		}
+		return errNotFound
+	})
+	return found, err
+}
+
+// DecreaseBalance implements biz.UserWalletRepo.
+func (r *walletRepo) DecreaseBalance(ctx context.Context, userId, symbol, amount, walletType string) (int, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return 0, err
+	}
+	return r.updateBalance(ctx, userId, symbol, walletType, func(balance decimal.Decimal) (decimal.Decimal, bool) {
+		return balance.Sub(value), balance.GreaterThanOrEqual(value)
+	})
+}
+
+// IncreaseBalance implements biz.UserWalletRepo.
+func (r *walletRepo) IncreaseBalance(ctx context.Context, userId, symbol, amount, walletType string) (int, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return 0, err
+	}
+	return r.updateBalance(ctx, userId, symbol, walletType, func(balance decimal.Decimal) (decimal.Decimal, bool) {
+		return balance.Add(value), true
+	})
+}
+
+func (r *walletRepo) updateBalance(ctx context.Context, userId, symbol, walletType string, update func(balance decimal.Decimal) (decimal.Decimal, bool)) (int, error) {
+	updated := 0
+	err := r.store.run(ctx, func(st *state) error {
+		for i, w := range st.wallets {
+			if w.UserID != userId || w.Symbol != symbol || w.Type != walletType {
+				continue
+			}
+			balance, ok := update(decimal.RequireFromString(w.Balance))
+			if !ok {
+				continue
+			}
+			w.Balance = balance.String()
+			w.UpdatedAt = time.Now()
+			st.wallets[i] = w
+			updated++
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// GetWalletByUserId implements biz.UserWalletRepo.
+func (r *walletRepo) GetWalletByUserId(ctx context.Context, userId, symbol, walletType string) ([]*biz.UserWallet, error) {
+	rs := []*biz.UserWallet{}
+	err := r.store.run(ctx, func(st *state) error {
+		for _, w := range st.wallets {
+			if w.UserID != userId || (len(symbol) > 0 && w.Symbol != symbol) || (len(walletType) > 0 && w.Type != walletType) {
+				continue
+			}
+			wallet := w
+			rs = append(rs, &wallet)
+		}
+		return nil
+	})
+	return rs, err
+}
+
+func (r *walletRepo) InitData(ctx context.Context) error {
+	return r.store.run(ctx, func(st *state) error {
+		r.createWallet(st, constant.WALLET_ICO, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM, "400000000")
+		r.createWallet(st, constant.WALLET_SYS_ICO_BACKUP, constant.TokenSymbolIND, constant.WALLET_TYPE_SYSTEM, "0")
+		return nil
+	})
+}
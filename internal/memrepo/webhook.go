@@ -0,0 +1,195 @@
+package memrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/rs/xid"
+)
+
+type webhookRow struct {
+	biz.Webhook
+	deletedAt *time.Time
+}
+
+type webhookRepo struct {
+	store *Store
+}
+
+func NewWebhookRepo(store *Store) biz.WebhookRepo {
+	return &webhookRepo{store: store}
+}
+
+// CreateWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) CreateWebhook(ctx context.Context, input *biz.Webhook) (*biz.Webhook, error) {
+	hook := *input
+	hook.ID = xid.New()
+	hook.CreatedAt = time.Now()
+	err := r.store.run(ctx, func(st *state) error {
+		st.webhooks = append(st.webhooks, webhookRow{Webhook: hook})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// GetWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) GetWebhook(ctx context.Context, id string) (*biz.Webhook, error) {
+	webhookId, err := xid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rs *biz.Webhook
+	err = r.store.run(ctx, func(st *state) error {
+		for _, row := range st.webhooks {
+			if row.ID == webhookId && row.deletedAt == nil {
+				hook := row.Webhook
+				rs = &hook
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// GetWebhooksByOwner implements biz.WebhookRepo.
+func (r *webhookRepo) GetWebhooksByOwner(ctx context.Context, owner string) ([]*biz.Webhook, error) {
+	return r.getWebhooks(ctx, func(row *webhookRow) bool { return row.Owner == owner })
+}
+
+// GetActiveWebhooks implements biz.WebhookRepo.
+func (r *webhookRepo) GetActiveWebhooks(ctx context.Context) ([]*biz.Webhook, error) {
+	return r.getWebhooks(ctx, func(row *webhookRow) bool { return true })
+}
+
+func (r *webhookRepo) getWebhooks(ctx context.Context, match func(row *webhookRow) bool) ([]*biz.Webhook, error) {
+	rs := []*biz.Webhook{}
+	err := r.store.run(ctx, func(st *state) error {
+		// newest first
+		for i := len(st.webhooks) - 1; i >= 0; i-- {
+			row := st.webhooks[i]
+			if row.deletedAt == nil && match(&row) {
+				rs = append(rs, &row.Webhook)
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// DeleteWebhook implements biz.WebhookRepo.
+func (r *webhookRepo) DeleteWebhook(ctx context.Context, id string) error {
+	webhookId, err := xid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.store.run(ctx, func(st *state) error {
+		now := time.Now()
+		for i, row := range st.webhooks {
+			if row.ID == webhookId && row.deletedAt == nil {
+				st.webhooks[i].deletedAt = &now
+			}
+		}
+		return nil
+	})
+}
+
+// CreateDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) CreateDelivery(ctx context.Context, input *biz.WebhookDelivery) (*biz.WebhookDelivery, error) {
+	var rs *biz.WebhookDelivery
+	err := r.store.run(ctx, func(st *state) error {
+		for _, d := range st.deliveries {
+			if d.WebhookID == input.WebhookID && d.EventID == input.EventID {
+				return nil
+			}
+		}
+
+		now := time.Now()
+		delivery := biz.WebhookDelivery{ID: xid.New(), CreatedAt: now, UpdatedAt: now, WebhookID: input.WebhookID, EventID: input.EventID,
+			EventType: input.EventType, Payload: input.Payload, Status: input.Status}
+		st.deliveries = append(st.deliveries, delivery)
+		rs = &delivery
+		return nil
+	})
+	return rs, err
+}
+
+// GetDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) GetDelivery(ctx context.Context, id string) (*biz.WebhookDelivery, error) {
+	deliveryId, err := xid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rs *biz.WebhookDelivery
+	err = r.store.run(ctx, func(st *state) error {
+		for _, d := range st.deliveries {
+			if d.ID == deliveryId {
+				delivery := d
+				rs = &delivery
+			}
+		}
+		return nil
+	})
+	return rs, err
+}
+
+// GetDeliveries implements biz.WebhookRepo.
+func (r *webhookRepo) GetDeliveries(ctx context.Context, webhookId, cursor string, limit int32) ([]*biz.WebhookDelivery, string, error) {
+	var before *xid.ID
+	if len(cursor) > 0 {
+		id, err := xid.FromString(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		before = &id
+	}
+
+	rs := []*biz.WebhookDelivery{}
+	err := r.store.run(ctx, func(st *state) error {
+		for i := len(st.deliveries) - 1; i >= 0 && len(rs) < int(limit); i-- {
+			d := st.deliveries[i]
+			if d.WebhookID != webhookId || (before != nil && d.ID.Compare(*before) >= 0) {
+				continue
+			}
+			rs = append(rs, &d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(rs) == int(limit) && len(rs) > 0 {
+		next = rs[len(rs)-1].ID.String()
+	}
+	return rs, next, nil
+}
+
+// UpdateDelivery implements biz.WebhookRepo.
+func (r *webhookRepo) UpdateDelivery(ctx context.Context, input *biz.WebhookDelivery) error {
+	return r.store.run(ctx, func(st *state) error {
+		for i, d := range st.deliveries {
+			if d.ID != input.ID {
+				continue
+			}
+			d.UpdatedAt = time.Now()
+			d.Status = input.Status
+			d.Attempts = input.Attempts
+			d.ResponseCode = input.ResponseCode
+			d.LastError = input.LastError
+			if input.DeliveredAt != nil {
+				d.DeliveredAt = input.DeliveredAt
+			}
+			st.deliveries[i] = d
+			return nil
+		}
+		return errNotFound
+	})
+}
@@ -1,25 +1,37 @@
 package messaging
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	eventv1 "github.com/indikay/wallet-service/api/event/v1"
+	"github.com/indikay/wallet-service/consumer"
 	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/conf"
 	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewPublisher)
+var ProviderSet = wire.NewSet(NewPublisher, wire.Bind(new(biz.TransactionPublisher), new(*Publisher)), wire.Bind(new(biz.EventFanout), new(*Publisher)))
 
-type transPublisher struct {
-	natsCli *nats.Conn
-	topic   string
-	logger  *log.Helper
+// Publisher publishes the events on NATS and queues their fan-out to the
+// webhooks and the alert rules, which Fanout runs from the queue.
+type Publisher struct {
+	natsCli           *nats.Conn
+	topic             string
+	notificationTopic string
+	events            biz.EventQueue
+	webhookUc         *biz.WebhookUsecase
+	alertUc           *biz.AlertUsecase
+	logger            *log.Helper
 }
 
-func NewPublisher(c *conf.Data) biz.TransactionPublisher {
+func NewPublisher(c *conf.Data, events biz.EventQueue, webhookUc *biz.WebhookUsecase, alertUc *biz.AlertUsecase) *Publisher {
 	logger := log.NewHelper(log.DefaultLogger)
 	nc, err := nats.Connect(c.Nats.NatsHost)
 	if err != nil {
@@ -27,14 +39,157 @@ func NewPublisher(c *conf.Data) biz.TransactionPublisher {
 		return nil
 	}
 
-	return &transPublisher{natsCli: nc, topic: c.Nats.TopicPublishTransaction, logger: logger}
+	return &Publisher{natsCli: nc, topic: c.Nats.TopicPublishTransaction, notificationTopic: c.Nats.TopicPublishNotification,
+		events: events, webhookUc: webhookUc, alertUc: alertUc, logger: logger}
 }
 
 // Publish implements biz.TransactionPublisher.
-func (t *transPublisher) Publish(msg *biz.TransactionMessage) {
-	data, err := json.Marshal(msg)
+func (t *Publisher) Publish(ctx context.Context, event *biz.TransactionEvent) {
+	envelope, err := NewTransactionEnvelope(event)
 	if err != nil {
-		t.logger.Errorf("error marshal message %v", err)
+		t.logger.Errorf("error build event %s: %v", event.ID, err)
+		return
 	}
-	t.natsCli.Publish(t.topic, data)
+
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		t.logger.Errorf("error marshal event %s: %v", event.ID, err)
+		return
+	}
+
+	if err := t.natsCli.Publish(t.topic, data); err != nil {
+		t.logger.Errorf("error publish event %s: %v", event.ID, err)
+	}
+
+	if err := t.events.EnqueueEvent(ctx, event); err != nil {
+		t.logger.Errorf("error enqueue event %s: %v", event.ID, err)
+	}
+}
+
+// Fanout implements biz.EventFanout.
+func (t *Publisher) Fanout(ctx context.Context, event *biz.TransactionEvent) error {
+	envelope, err := NewTransactionEnvelope(event)
+	if err != nil {
+		return err
+	}
+	if err := t.publishAlerts(ctx, event); err != nil {
+		return err
+	}
+
+	// webhooks get the same envelope as JSON
+	payload, err := protojson.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	t.webhookUc.Dispatch(ctx, event, envelope.Type, payload)
+	return nil
+}
+
+// publishAlerts evaluates the users' alert rules against the event and sends the
+// ones that fired to the notification topic.
+func (t *Publisher) publishAlerts(ctx context.Context, event *biz.TransactionEvent) error {
+	if len(t.notificationTopic) == 0 {
+		return nil
+	}
+
+	alerts, err := t.alertUc.Evaluate(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		envelope, err := NewAlertEnvelope(alert)
+		if err != nil {
+			t.logger.Errorf("error build alert %s: %v", alert.ID, err)
+			continue
+		}
+		data, err := proto.Marshal(envelope)
+		if err != nil {
+			t.logger.Errorf("error marshal alert %s: %v", alert.ID, err)
+			continue
+		}
+		if err := t.natsCli.Publish(t.notificationTopic, data); err != nil {
+			t.logger.Errorf("error publish alert %s: %v", alert.ID, err)
+		}
+	}
+	return nil
+}
+
+// NewTransactionEnvelope wraps a biz.TransactionEvent in the versioned envelope
+// described by api/event/v1.
+func NewTransactionEnvelope(event *biz.TransactionEvent) (*eventv1.Envelope, error) {
+	trans := event.Transaction
+	payload := &eventv1.TransactionCreated{
+		TransactionId: trans.ID.String(),
+		TransType:     trans.TransType,
+		Source:        trans.Source,
+		SrcSymbol:     trans.SrcSymbol,
+		SrcAmount:     trans.SrcAmount,
+		Destination:   trans.Destination,
+		DestSymbol:    trans.DestSymbol,
+		DestAmount:    trans.DestAmount,
+		Rate:          trans.Rate,
+		SourceService: trans.SourceService,
+		SourceId:      trans.SourceId,
+		Status:        trans.Status,
+		CreatedAt:     timestamppb.New(trans.CreatedAt),
+	}
+	for _, w := range event.Balances {
+		payload.Balances = append(payload.Balances, &eventv1.WalletBalance{UserId: w.UserID, WalletType: w.Type, Symbol: w.Symbol, Balance: w.Balance})
+	}
+
+	data, err := anypb.New(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.Envelope{
+		Id:              event.ID,
+		Source:          consumer.EventSource,
+		SpecVersion:     consumer.SpecVersion,
+		Type:            consumer.TransactionCreatedType,
+		Version:         consumer.TransactionCreatedVersion,
+		Time:            timestamppb.New(event.OccurredAt),
+		Subject:         subjectOf(trans),
+		DataContentType: consumer.DataContentType,
+		Data:            data,
+	}, nil
+}
+
+// subjectOf returns the wallet owner the event is about: the payer for charges,
+// the receiver for everything else.
+func subjectOf(trans *biz.Transaction) string {
+	if trans.TransType == biz.SUBSCRIPTION || trans.TransType == biz.CHARGE_FEE {
+		return trans.Source
+	}
+	return trans.Destination
+}
+
+// NewAlertEnvelope wraps a fired alert in the api/event/v1 envelope.
+func NewAlertEnvelope(alert *biz.Alert) (*eventv1.Envelope, error) {
+	data, err := anypb.New(&eventv1.AlertTriggered{
+		RuleId:        alert.Rule.ID.String(),
+		UserId:        alert.Rule.UserID,
+		AlertType:     alert.Rule.Type,
+		Symbol:        alert.Rule.Symbol,
+		Threshold:     alert.Rule.Threshold,
+		TransactionId: alert.TransactionID,
+		Amount:        alert.Amount,
+		Balance:       alert.Balance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.Envelope{
+		Id:              alert.ID,
+		Source:          consumer.EventSource,
+		SpecVersion:     consumer.SpecVersion,
+		Type:            consumer.AlertTriggeredType,
+		Version:         consumer.AlertTriggeredVersion,
+		Time:            timestamppb.New(alert.OccurredAt),
+		Subject:         alert.Rule.UserID,
+		DataContentType: consumer.DataContentType,
+		Data:            data,
+	}, nil
 }
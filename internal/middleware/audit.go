@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	authjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	icoProto "github.com/indikay/wallet-service/api/ico/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"google.golang.org/protobuf/proto"
+)
+
+// ICO_ADMIN_SERVICE prefixes the operations of the ICO admin service, whose
+// rounds and sub-rounds are snapshotted.
+const ICO_ADMIN_SERVICE = "/ico.v1.ICOAdminService/"
+
+// Audit records every call to an operation covered by policy, including the ones
+// Authorization rejects, so it must run before it.
+func Audit(auditUc *biz.AuditUsecase, policy Policy) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || policy.rolesOf(tr.Operation()) == nil {
+				return handler(ctx, req)
+			}
+
+			actor, service := callerOf(ctx)
+			target := targetOf(tr.Operation(), req)
+			entry := &biz.AuditLog{
+				Actor:        actor,
+				Service:      service,
+				Roles:        RolesFromContext(ctx),
+				Rpc:          tr.Operation(),
+				TargetUserID: target.UserId,
+				PayloadHash:  payloadHash(req),
+				Before:       auditUc.Snapshot(ctx, target),
+				Outcome:      constant.SuccessStatus,
+			}
+
+			reply, err := handler(ctx, req)
+
+			entry.After = auditUc.Snapshot(ctx, target)
+			if err != nil {
+				entry.Outcome = constant.FailedStatus
+				entry.Error = errors.FromError(err).Error()
+			} else if r, ok := reply.(interface{ GetCode() int64 }); ok && r.GetCode() != 0 {
+				// business failures are returned as code != 0 with a nil error
+				entry.Outcome = constant.FailedStatus
+				if m, ok := reply.(interface{ GetMsgKey() string }); ok {
+					entry.Error = m.GetMsgKey()
+				}
+			}
+			auditUc.Record(context.WithoutCancel(ctx), entry)
+
+			return reply, err
+		}
+	}
+}
+
+// callerOf returns the jwt subject and the calling service: the "service" claim
+// when present, the issuer otherwise.
+func callerOf(ctx context.Context) (string, string) {
+	claims, ok := authjwt.FromContext(ctx)
+	if !ok || claims == nil {
+		return "", ""
+	}
+
+	subject, _ := claims.GetSubject()
+	service, _ := claims.GetIssuer()
+	if mapClaims, ok := claims.(jwtlib.MapClaims); ok {
+		if s, ok := mapClaims["service"].(string); ok && len(s) > 0 {
+			service = s
+		}
+	}
+	return subject, service
+}
+
+// targetOf returns what a request acts on: the user and coupon it carries, and
+// for the ICO admin calls the round or sub-round, the running one for the calls
+// on the whole sale.
+func targetOf(operation string, req interface{}) *biz.AuditTarget {
+	target := &biz.AuditTarget{}
+	if r, ok := req.(interface{ GetUserId() string }); ok {
+		target.UserId = r.GetUserId()
+	}
+	if r, ok := req.(interface{ GetCoupon() string }); ok {
+		target.Coupon = r.GetCoupon()
+	}
+	if !strings.HasPrefix(operation, ICO_ADMIN_SERVICE) {
+		return target
+	}
+
+	if r, ok := req.(interface{ GetRoundId() int32 }); ok {
+		target.RoundId = r.GetRoundId()
+	}
+	// the sub-round calls carry the sub-round as id, the create ones the round
+	if r, ok := req.(interface{ GetId() string }); ok && len(r.GetId()) > 0 {
+		target.SubRoundId = r.GetId()
+	}
+	switch operation {
+	case icoProto.OperationICOAdminServicePauseICO, icoProto.OperationICOAdminServiceResumeICO:
+		target.CurrentRound = true
+	}
+	return target
+}
+
+func payloadHash(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	authjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"github.com/indikay/wallet-service/internal/util"
+)
+
+const (
+	ROLE_USER    = "USER"
+	ROLE_SERVICE = "SERVICE"
+	ROLE_ADMIN   = "ADMIN"
+
+	// tokens minted by other services for service-to-service calls
+	ISSUER_SYSTEM = "SYSTEM"
+)
+
+// Policy maps an operation, or a service prefix ending with "/", to the roles
+// allowed to call it. Operations without an entry are left to the jwt middleware.
+type Policy map[string][]string
+
+// Authorization rejects calls whose jwt claims carry none of the roles the policy
+// requires for the operation.
+func Authorization(policy Policy) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			allowed := policy.rolesOf(tr.Operation())
+			if allowed == nil {
+				return handler(ctx, req)
+			}
+
+			roles := RolesFromContext(ctx)
+			if len(roles) == 0 {
+				return nil, util.UnAuthorizeError()
+			}
+			for _, role := range roles {
+				for _, r := range allowed {
+					if role == r {
+						return handler(ctx, req)
+					}
+				}
+			}
+			return nil, util.ForbiddenError()
+		}
+	}
+}
+
+func (p Policy) rolesOf(operation string) []string {
+	if roles, ok := p[operation]; ok {
+		return roles
+	}
+	for key, roles := range p {
+		if strings.HasSuffix(key, "/") && strings.HasPrefix(operation, key) {
+			return roles
+		}
+	}
+	return nil
+}
+
+// RolesFromContext returns the roles of the caller: the "roles" (or "role") claim,
+// plus SERVICE for tokens issued by SYSTEM. Authenticated callers without any
+// role claim are plain users.
+func RolesFromContext(ctx context.Context) []string {
+	claims, ok := authjwt.FromContext(ctx)
+	if !ok || claims == nil {
+		return nil
+	}
+
+	var roles []string
+	if mapClaims, ok := claims.(jwtlib.MapClaims); ok {
+		roles = append(roles, claimStrings(mapClaims["roles"])...)
+		roles = append(roles, claimStrings(mapClaims["role"])...)
+	}
+	if issuer, err := claims.GetIssuer(); err == nil && issuer == ISSUER_SYSTEM {
+		roles = append(roles, ROLE_SERVICE)
+	}
+	if len(roles) == 0 {
+		roles = append(roles, ROLE_USER)
+	}
+	return roles
+}
+
+func claimStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{strings.ToUpper(v)}
+	case []string:
+		rs := make([]string, len(v))
+		for i, s := range v {
+			rs[i] = strings.ToUpper(s)
+		}
+		return rs
+	case []interface{}:
+		var rs []string
+		for _, s := range v {
+			if str, ok := s.(string); ok {
+				rs = append(rs, strings.ToUpper(str))
+			}
+		}
+		return rs
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+)
+
+// eventFanoutMaxRetry bounds the retries of a fan-out, a delivery already
+// recorded is not recorded twice.
+const eventFanoutMaxRetry = 3
+
+type eventQueue struct {
+	asynqCli *asynq.Client
+	log      *log.Helper
+}
+
+func NewEventQueue(c *conf.Data) biz.EventQueue {
+	return &eventQueue{asynqCli: asynq.NewClient(redisClientOpt(c)), log: log.NewHelper(log.DefaultLogger)}
+}
+
+// EnqueueEvent implements biz.EventQueue. The event is queued once by its id.
+func (q *eventQueue) EnqueueEvent(ctx context.Context, event *biz.TransactionEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	t := asynq.NewTask(biz.EVENT_FANOUT, data, asynq.MaxRetry(eventFanoutMaxRetry))
+	if _, err := q.asynqCli.EnqueueContext(ctx, t, asynq.TaskID(biz.EVENT_FANOUT+":"+event.ID)); err != nil && err != asynq.ErrTaskIDConflict {
+		return err
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package queue
 
 import (
 	"context"
-	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
@@ -11,26 +13,35 @@ import (
 	"github.com/indikay/wallet-service/internal/conf"
 )
 
+const (
+	defaultInvariantInterval = time.Hour
+	defaultSnapshotInterval  = 24 * time.Hour
+)
+
 type redisQueue struct {
 	*biz.QueueRunner
-	asynqCli  *asynq.Client
-	asynqSrv  *asynq.Server
-	asynqConf asynq.RedisClientOpt
-	log       *log.Helper
+	asynqCli    *asynq.Client
+	asynqSrv    *asynq.Server
+	asynqConf   asynq.RedisClientOpt
+	webhookUc   *biz.WebhookUsecase
+	fanout      biz.EventFanout
+	invariantUc *biz.InvariantUsecase
+	invariant   *conf.Invariant
+	icoUc       *biz.ICOUsecase
+	leaderboard *conf.Leaderboard
+	statsUc     *biz.ICOStatsUsecase
+	refundUc    *biz.ICORefundUsecase
+	log         *log.Helper
 }
 
 // NewData .
-func NewQueue(c *conf.Data, repo biz.ICORepo, walletRepo biz.UserWalletRepo, transRepo biz.TransactionRepo, icoUc *biz.ICOUsecase, lockRepo biz.LockRepo) biz.QueueJob {
+func NewQueue(c *conf.Data, repo biz.ICORepo, walletRepo biz.UserWalletRepo, transRepo biz.TransactionRepo, icoUc *biz.ICOUsecase, lockRepo biz.LockRepo, publisher biz.TransactionPublisher, webhookUc *biz.WebhookUsecase, fanout biz.EventFanout, invariantUc *biz.InvariantUsecase, statsUc *biz.ICOStatsUsecase, refundUc *biz.ICORefundUsecase) biz.QueueJob {
 	logHelper := log.NewHelper(log.DefaultLogger)
 
-	clienConfig := asynq.RedisClientOpt{Addr: c.Redis.Addr, Password: c.Redis.Pass}
-	if c.Redis.Ssl {
-		clienConfig.TLSConfig = &tls.Config{
-			MinVersion: tls.VersionTLS12,
-		}
-	}
+	clienConfig := redisClientOpt(c)
 	client := asynq.NewClient(clienConfig)
-	queue := &redisQueue{asynqCli: client, asynqConf: clienConfig, log: logHelper, QueueRunner: biz.NewQueueRunner(repo, walletRepo, transRepo, icoUc, lockRepo)}
+	queue := &redisQueue{asynqCli: client, asynqConf: clienConfig, webhookUc: webhookUc, fanout: fanout, invariantUc: invariantUc, invariant: c.Invariant, icoUc: icoUc, leaderboard: c.Leaderboard, statsUc: statsUc, refundUc: refundUc, log: logHelper,
+		QueueRunner: biz.NewQueueRunner(repo, walletRepo, transRepo, icoUc, lockRepo, publisher)}
 
 	return queue
 }
@@ -38,7 +49,11 @@ func NewQueue(c *conf.Data, repo biz.ICORepo, walletRepo biz.UserWalletRepo, tra
 // Enqueue implements biz.QueueJob.
 func (r *redisQueue) Enqueue(ctx context.Context, bizTask *biz.Task) error {
 	// new task without conflict with other
-	t := asynq.NewTask(bizTask.Name, []byte(bizTask.Data), asynq.ProcessAt(bizTask.ProcessAt), asynq.MaxRetry(1))
+	maxRetry := bizTask.MaxRetry
+	if maxRetry <= 0 {
+		maxRetry = 1
+	}
+	t := asynq.NewTask(bizTask.Name, []byte(bizTask.Data), asynq.ProcessAt(bizTask.ProcessAt), asynq.MaxRetry(maxRetry))
 	info, err := r.asynqCli.Enqueue(t, asynq.TaskID(fmt.Sprintf("%s-%d", bizTask.Data, bizTask.ProcessAt.Unix())))
 	log.Infof("Enqueue %v - %s", info != nil, bizTask.ProcessAt)
 	if err != nil {
@@ -62,27 +77,107 @@ func (r *redisQueue) Start(ctx context.Context) error {
 				"default":  3,
 				"low":      1,
 			},
+			RetryDelayFunc: retryDelay,
 			// See the godoc for other configuration options
 		},
 	)
 
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(biz.QUEUE_PREFIX, func(ctx context.Context, t *asynq.Task) error {
-		err := r.Execute(ctx, &biz.Task{Name: t.Type(), Data: string(t.Payload())})
+		task := &biz.Task{Name: t.Type(), Data: string(t.Payload())}
+		err := r.Execute(ctx, task)
 		if err != nil {
+			retried, _ := asynq.GetRetryCount(ctx)
+			maxRetry, _ := asynq.GetMaxRetry(ctx)
+			if retried >= maxRetry {
+				r.log.Errorf("%s %s failed for good, the sub-round stays open: %v", t.Type(), t.Payload(), err)
+			}
 			return err
 		}
+		settle, err := r.QueueRunner.SettleTask(ctx, task)
+		if err != nil {
+			return err
+		}
+		if settle != nil {
+			r.Enqueue(ctx, settle)
+		}
 		round, err := r.QueueRunner.GetICOCurrentRound(ctx)
 		if err != nil {
 			return err
 		}
-		r.Enqueue(ctx, &biz.Task{Data: round.ID.String(), Name: fmt.Sprintf("%s%s", biz.QUEUE_PREFIX, biz.ENDROUND_SCHEDULE), ProcessAt: round.EndAt})
+		r.Enqueue(ctx, biz.NewEndRoundTask(round))
 		return nil
 	})
+	mux.HandleFunc(biz.ICO_ROUND_SETTLE, func(ctx context.Context, t *asynq.Task) error {
+		roundId, err := strconv.ParseInt(string(t.Payload()), 10, 32)
+		if err != nil {
+			return err
+		}
+		err = r.refundUc.SettleRound(ctx, int32(roundId))
+		if err != nil {
+			retried, _ := asynq.GetRetryCount(ctx)
+			maxRetry, _ := asynq.GetMaxRetry(ctx)
+			if retried >= maxRetry {
+				r.log.Errorf("%s %s failed for good: %v", t.Type(), t.Payload(), err)
+			}
+		}
+		return err
+	})
+	mux.HandleFunc(biz.WEBHOOK_DELIVER, func(ctx context.Context, t *asynq.Task) error {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		return r.webhookUc.Deliver(ctx, string(t.Payload()), retried >= maxRetry)
+	})
+	mux.HandleFunc(biz.EVENT_FANOUT, func(ctx context.Context, t *asynq.Task) error {
+		event := &biz.TransactionEvent{}
+		if err := json.Unmarshal(t.Payload(), event); err != nil {
+			return err
+		}
+		return r.fanout.Fanout(ctx, event)
+	})
+	mux.HandleFunc(biz.INVARIANT_CHECK, func(ctx context.Context, t *asynq.Task) error {
+		// schedule the next check first, a violation must not stop the checks
+		r.Enqueue(ctx, biz.NextInvariantCheck(time.Now(), r.invariantInterval()))
+		return r.invariantUc.Verify(ctx, r.invariant.GetSupply())
+	})
+	mux.HandleFunc(biz.LEADERBOARD_SNAPSHOT, func(ctx context.Context, t *asynq.Task) error {
+		r.Enqueue(ctx, biz.NextLeaderboardSnapshot(time.Now(), r.snapshotInterval()))
+		takenAt, err := time.Parse(time.RFC3339, string(t.Payload()))
+		if err != nil {
+			return err
+		}
+		return r.icoUc.SnapshotLeaderboard(ctx, takenAt, int(r.leaderboard.GetSnapshotSize()))
+	})
+	mux.HandleFunc(biz.ICO_STATS_ROLLUP, func(ctx context.Context, t *asynq.Task) error {
+		r.Enqueue(ctx, biz.NextICOStatsRollup(time.Now()))
+		day, err := time.Parse(time.DateOnly, string(t.Payload()))
+		if err != nil {
+			return err
+		}
+		_, err = r.statsUc.RollupDay(ctx, day)
+		return err
+	})
+	r.Enqueue(ctx, biz.NextInvariantCheck(time.Now(), r.invariantInterval()))
+	r.Enqueue(ctx, biz.NextLeaderboardSnapshot(time.Now(), r.snapshotInterval()))
+	r.Enqueue(ctx, biz.NextICOStatsRollup(time.Now()))
 
 	return r.asynqSrv.Run(mux)
 }
 
+func (r *redisQueue) invariantInterval() time.Duration {
+	if r.invariant.GetInterval().AsDuration() > 0 {
+		return r.invariant.GetInterval().AsDuration()
+	}
+	return defaultInvariantInterval
+}
+
+func (r *redisQueue) snapshotInterval() time.Duration {
+	if r.leaderboard.GetSnapshotInterval().AsDuration() > 0 {
+		return r.leaderboard.GetSnapshotInterval().AsDuration()
+	}
+	return defaultSnapshotInterval
+}
+
 func (r *redisQueue) Stop(ctx context.Context) error {
 	r.asynqSrv.Shutdown()
 	return r.asynqCli.Close()
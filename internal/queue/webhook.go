@@ -0,0 +1,70 @@
+package queue
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/conf"
+)
+
+const (
+	defaultWebhookMaxRetry = 10
+	webhookRetryBase       = 30 * time.Second
+	webhookRetryMax        = 6 * time.Hour
+)
+
+type webhookQueue struct {
+	asynqCli *asynq.Client
+	maxRetry int
+	log      *log.Helper
+}
+
+func NewWebhookQueue(c *conf.Data) biz.WebhookQueue {
+	clienConfig := redisClientOpt(c)
+	maxRetry := defaultWebhookMaxRetry
+	if c.Webhook != nil && c.Webhook.MaxRetry > 0 {
+		maxRetry = int(c.Webhook.MaxRetry)
+	}
+	return &webhookQueue{asynqCli: asynq.NewClient(clienConfig), maxRetry: maxRetry, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// EnqueueDelivery implements biz.WebhookQueue.
+func (q *webhookQueue) EnqueueDelivery(ctx context.Context, deliveryId string) error {
+	t := asynq.NewTask(biz.WEBHOOK_DELIVER, []byte(deliveryId), asynq.MaxRetry(q.maxRetry))
+	info, err := q.asynqCli.EnqueueContext(ctx, t)
+	if err != nil {
+		q.log.Errorf("EnqueueDelivery %s: %v", deliveryId, err)
+		return err
+	}
+	q.log.Infof("EnqueueDelivery %s - %s", deliveryId, info.ID)
+	return nil
+}
+
+// redisClientOpt is the asynq connection to the Redis of c.
+func redisClientOpt(c *conf.Data) asynq.RedisClientOpt {
+	clienConfig := asynq.RedisClientOpt{Addr: c.Redis.Addr, Password: c.Redis.Pass}
+	if c.Redis.Ssl {
+		clienConfig.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return clienConfig
+}
+
+// retryDelay backs webhook deliveries off exponentially (30s, 1m, 2m, ... up to
+// 6h), other tasks keep the asynq default.
+func retryDelay(n int, e error, t *asynq.Task) time.Duration {
+	if t.Type() != biz.WEBHOOK_DELIVER {
+		return asynq.DefaultRetryDelayFunc(n, e, t)
+	}
+	delay := time.Duration(float64(webhookRetryBase) * math.Pow(2, float64(n)))
+	if delay <= 0 || delay > webhookRetryMax {
+		delay = webhookRetryMax
+	}
+	return delay
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indikay/go-core/middleware/jwt"
+	pb "github.com/indikay/wallet-service/api/wallet/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AlertService struct {
+	pb.UnimplementedAlertServiceServer
+	alertUc *biz.AlertUsecase
+}
+
+func NewAlertService(alertUc *biz.AlertUsecase) *AlertService {
+	return &AlertService{alertUc: alertUc}
+}
+
+func (s *AlertService) SetAlertRule(ctx context.Context, req *pb.SetAlertRuleRequest) (*pb.SetAlertRuleResponse, error) {
+	userId, _ := jwt.GetUserId(ctx)
+	if len(userId) == 0 {
+		return nil, util.UnAuthorizeError()
+	}
+
+	rule, err := s.alertUc.SaveAlertRule(ctx, &biz.AlertRule{UserID: userId, Type: req.Type.String(), Symbol: req.Symbol.String(), Threshold: req.Threshold})
+	if err != nil {
+		return &pb.SetAlertRuleResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SetAlertRuleResponse{Code: 0, Msg: "SET ALERT RULE SUCCESS", MsgKey: "SET_ALERT_RULE_SUCCESS", Data: toAlertRuleProto(rule)}, nil
+}
+
+func (s *AlertService) GetAlertRules(ctx context.Context, req *emptypb.Empty) (*pb.GetAlertRulesResponse, error) {
+	userId, _ := jwt.GetUserId(ctx)
+	if len(userId) == 0 {
+		return nil, util.UnAuthorizeError()
+	}
+
+	rules, err := s.alertUc.GetAlertRules(ctx, userId)
+	if err != nil {
+		return nil, util.InternalServerError(err)
+	}
+
+	data := make([]*pb.AlertRule, len(rules))
+	for i, v := range rules {
+		data[i] = toAlertRuleProto(v)
+	}
+	return &pb.GetAlertRulesResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
+}
+
+func (s *AlertService) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	userId, _ := jwt.GetUserId(ctx)
+	if len(userId) == 0 {
+		return nil, util.UnAuthorizeError()
+	}
+
+	if err := s.alertUc.DeleteAlertRule(ctx, userId, req.Id); err != nil {
+		return &pb.DeleteAlertRuleResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.DeleteAlertRuleResponse{Code: 0, Msg: "DELETE ALERT RULE SUCCESS", MsgKey: "DELETE_ALERT_RULE_SUCCESS"}, nil
+}
+
+func toAlertRuleProto(v *biz.AlertRule) *pb.AlertRule {
+	return &pb.AlertRule{Id: v.ID.String(), Type: pb.AlertType(pb.AlertType_value[v.Type]), Symbol: pb.SymbolType(pb.SymbolType_value[v.Symbol]),
+		Threshold: v.Threshold, UpdatedAt: timestamppb.New(v.UpdatedAt)}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/indikay/wallet-service/api/audit/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditService struct {
+	pb.UnimplementedAuditServiceServer
+	auditUc *biz.AuditUsecase
+}
+
+func NewAuditService(auditUc *biz.AuditUsecase) *AuditService {
+	return &AuditService{auditUc: auditUc}
+}
+
+func (s *AuditService) GetAuditLogs(ctx context.Context, req *pb.GetAuditLogsRequest) (*pb.GetAuditLogsResponse, error) {
+	logs, next, err := s.auditUc.GetAuditLogs(ctx, toAuditLogFilter(req.Filter), req.Next, req.Limit)
+	if err != nil {
+		return &pb.GetAuditLogsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := make([]*pb.AuditLog, len(logs))
+	for i, v := range logs {
+		data[i] = &pb.AuditLog{Id: v.ID.String(), CreatedAt: timestamppb.New(v.CreatedAt), Actor: v.Actor, Service: v.Service, Roles: v.Roles, Rpc: v.Rpc,
+			TargetUserId: v.TargetUserID, PayloadHash: v.PayloadHash, Before: v.Before, After: v.After, Outcome: v.Outcome, Error: v.Error}
+	}
+	return &pb.GetAuditLogsResponse{Code: 0, MsgKey: "SUCCESS", Data: data, Next: next}, nil
+}
+
+func (s *AuditService) ExportAuditLogs(ctx context.Context, req *pb.ExportAuditLogsRequest) (*pb.ExportAuditLogsResponse, error) {
+	format := req.Format
+	if len(format) == 0 {
+		format = biz.AUDIT_EXPORT_CSV
+	}
+	content, err := s.auditUc.ExportAuditLogs(ctx, toAuditLogFilter(req.Filter), format)
+	if err != nil {
+		return &pb.ExportAuditLogsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	contentType := "text/csv"
+	if format == biz.AUDIT_EXPORT_JSONL {
+		contentType = "application/x-ndjson"
+	}
+	return &pb.ExportAuditLogsResponse{Code: 0, MsgKey: "SUCCESS", Data: &pb.ExportAuditLogsResponse_Data{
+		FileName:    fmt.Sprintf("audit-%s.%s", time.Now().UTC().Format("20060102150405"), format),
+		ContentType: contentType,
+		Content:     content,
+	}}, nil
+}
+
+func toAuditLogFilter(f *pb.AuditLogFilter) *biz.AuditLogFilter {
+	filter := &biz.AuditLogFilter{}
+	if f == nil {
+		return filter
+	}
+
+	filter.Actor, filter.Service, filter.Rpc, filter.TargetUserID, filter.Outcome = f.Actor, f.Service, f.Rpc, f.TargetUserId, f.Outcome
+	if f.From != nil {
+		from := f.From.AsTime()
+		filter.From = &from
+	}
+	if f.To != nil {
+		to := f.To.AsTime()
+		filter.To = &to
+	}
+	return filter
+}
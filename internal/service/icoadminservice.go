@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/indikay/wallet-service/api/ico/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/constant"
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ICOAdminService struct {
+	pb.UnimplementedICOAdminServiceServer
+	adminUc    *biz.ICOAdminUsecase
+	icoUc      *biz.ICOUsecase
+	referralUc *biz.ReferralUsecase
+	refundUc   *biz.ICORefundUsecase
+}
+
+func NewICOAdminService(adminUc *biz.ICOAdminUsecase, icoUc *biz.ICOUsecase, referralUc *biz.ReferralUsecase, refundUc *biz.ICORefundUsecase) *ICOAdminService {
+	return &ICOAdminService{adminUc: adminUc, icoUc: icoUc, referralUc: referralUc, refundUc: refundUc}
+}
+
+func (s *ICOAdminService) CreateRound(ctx context.Context, req *pb.SaveRoundRequest) (*pb.SaveRoundResponse, error) {
+	round, err := s.adminUc.CreateRound(ctx, toRoundBiz(req))
+	if err != nil {
+		return &pb.SaveRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveRoundResponse{Code: 0, Msg: "CREATE ROUND SUCCESS", MsgKey: "CREATE_ROUND_SUCCESS", Data: toRoundProto(round)}, nil
+}
+
+func (s *ICOAdminService) UpdateRound(ctx context.Context, req *pb.SaveRoundRequest) (*pb.SaveRoundResponse, error) {
+	round, err := s.adminUc.UpdateRound(ctx, toRoundBiz(req))
+	if err != nil {
+		return &pb.SaveRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveRoundResponse{Code: 0, Msg: "UPDATE ROUND SUCCESS", MsgKey: "UPDATE_ROUND_SUCCESS", Data: toRoundProto(round)}, nil
+}
+
+func (s *ICOAdminService) SetRoundLimits(ctx context.Context, req *pb.SetRoundLimitsRequest) (*pb.SaveRoundResponse, error) {
+	round, err := s.adminUc.SetRoundLimits(ctx, req.RoundId, toLimitsBiz(req.Limits))
+	if err != nil {
+		return &pb.SaveRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveRoundResponse{Code: 0, Msg: "SET ROUND LIMITS SUCCESS", MsgKey: "SET_ROUND_LIMITS_SUCCESS", Data: toRoundProto(round)}, nil
+}
+
+func (s *ICOAdminService) SetRoundUnsold(ctx context.Context, req *pb.SetRoundUnsoldRequest) (*pb.SaveRoundResponse, error) {
+	round, err := s.adminUc.SetRoundUnsold(ctx, req.RoundId, toUnsoldBiz(req.Unsold))
+	if err != nil {
+		return &pb.SaveRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveRoundResponse{Code: 0, Msg: "SET ROUND UNSOLD SUCCESS", MsgKey: "SET_ROUND_UNSOLD_SUCCESS", Data: toRoundProto(round)}, nil
+}
+
+func (s *ICOAdminService) DeleteRound(ctx context.Context, req *pb.DeleteRoundRequest) (*pb.DeleteRoundResponse, error) {
+	if err := s.adminUc.DeleteRound(ctx, req.RoundId); err != nil {
+		return &pb.DeleteRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.DeleteRoundResponse{Code: 0, Msg: "DELETE ROUND SUCCESS", MsgKey: "DELETE_ROUND_SUCCESS"}, nil
+}
+
+func (s *ICOAdminService) GetSubRounds(ctx context.Context, req *pb.GetSubRoundsRequest) (*pb.GetSubRoundsResponse, error) {
+	subRounds, err := s.adminUc.GetSubRounds(ctx, req.RoundId)
+	if err != nil {
+		return &pb.GetSubRoundsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := make([]*pb.SubRound, len(subRounds))
+	for i, v := range subRounds {
+		data[i] = toSubRoundProto(v)
+	}
+	return &pb.GetSubRoundsResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
+}
+
+func (s *ICOAdminService) CreateSubRound(ctx context.Context, req *pb.SaveSubRoundRequest) (*pb.SaveSubRoundResponse, error) {
+	subRound, err := s.adminUc.CreateSubRound(ctx, toSubRoundBiz(req))
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveSubRoundResponse{Code: 0, Msg: "CREATE SUBROUND SUCCESS", MsgKey: "CREATE_SUBROUND_SUCCESS", Data: toSubRoundProto(subRound)}, nil
+}
+
+func (s *ICOAdminService) UpdateSubRound(ctx context.Context, req *pb.SaveSubRoundRequest) (*pb.SaveSubRoundResponse, error) {
+	input := toSubRoundBiz(req)
+	id, err := xid.FromString(req.Id)
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: constant.ERROR_BAD_REQUEST, MsgKey: constant.ERROR_BAD_REQUEST}, nil
+	}
+	input.ID = id
+
+	subRound, err := s.adminUc.UpdateSubRound(ctx, input)
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveSubRoundResponse{Code: 0, Msg: "UPDATE SUBROUND SUCCESS", MsgKey: "UPDATE_SUBROUND_SUCCESS", Data: toSubRoundProto(subRound)}, nil
+}
+
+func (s *ICOAdminService) DeleteSubRound(ctx context.Context, req *pb.DeleteSubRoundRequest) (*pb.DeleteSubRoundResponse, error) {
+	id, err := xid.FromString(req.Id)
+	if err != nil {
+		return &pb.DeleteSubRoundResponse{Code: 1, Msg: constant.ERROR_BAD_REQUEST, MsgKey: constant.ERROR_BAD_REQUEST}, nil
+	}
+
+	if err := s.adminUc.DeleteSubRound(ctx, id); err != nil {
+		return &pb.DeleteSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.DeleteSubRoundResponse{Code: 0, Msg: "DELETE SUBROUND SUCCESS", MsgKey: "DELETE_SUBROUND_SUCCESS"}, nil
+}
+
+func (s *ICOAdminService) ExtendSubRound(ctx context.Context, req *pb.ExtendSubRoundRequest) (*pb.SaveSubRoundResponse, error) {
+	id, err := xid.FromString(req.Id)
+	if err != nil || req.EndAt == nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: constant.ERROR_BAD_REQUEST, MsgKey: constant.ERROR_BAD_REQUEST}, nil
+	}
+
+	subRound, err := s.adminUc.ExtendSubRound(ctx, id, req.EndAt.AsTime())
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveSubRoundResponse{Code: 0, Msg: "EXTEND SUBROUND SUCCESS", MsgKey: "EXTEND_SUBROUND_SUCCESS", Data: toSubRoundProto(subRound)}, nil
+}
+
+func (s *ICOAdminService) PauseICO(ctx context.Context, req *emptypb.Empty) (*pb.SaveSubRoundResponse, error) {
+	subRound, err := s.adminUc.PauseICO(ctx)
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveSubRoundResponse{Code: 0, Msg: "PAUSE ICO SUCCESS", MsgKey: "PAUSE_ICO_SUCCESS", Data: toSubRoundProto(subRound)}, nil
+}
+
+func (s *ICOAdminService) ResumeICO(ctx context.Context, req *emptypb.Empty) (*pb.SaveSubRoundResponse, error) {
+	subRound, err := s.adminUc.ResumeICO(ctx)
+	if err != nil {
+		return &pb.SaveSubRoundResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SaveSubRoundResponse{Code: 0, Msg: "RESUME ICO SUCCESS", MsgKey: "RESUME_ICO_SUCCESS", Data: toSubRoundProto(subRound)}, nil
+}
+
+func (s *ICOAdminService) RebuildLeaderboard(ctx context.Context, req *emptypb.Empty) (*pb.RebuildLeaderboardResponse, error) {
+	total, err := s.icoUc.RebuildLeaderboard(ctx)
+	if err != nil {
+		return &pb.RebuildLeaderboardResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.RebuildLeaderboardResponse{Code: 0, Msg: "REBUILD LEADERBOARD SUCCESS", MsgKey: "REBUILD_LEADERBOARD_SUCCESS", Total: int32(total)}, nil
+}
+
+func (s *ICOAdminService) TakeLeaderboardSnapshot(ctx context.Context, req *pb.TakeLeaderboardSnapshotRequest) (*pb.LeaderboardSnapshotResponse, error) {
+	// a second is what the snapshots are told apart by
+	takenAt := time.Now().Truncate(time.Second)
+	if err := s.icoUc.SnapshotLeaderboard(ctx, takenAt, int(req.Size)); err != nil {
+		return &pb.LeaderboardSnapshotResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return s.getLeaderboardSnapshot(ctx, takenAt, "TAKE LEADERBOARD SNAPSHOT SUCCESS", "TAKE_LEADERBOARD_SNAPSHOT_SUCCESS")
+}
+
+func (s *ICOAdminService) GetLeaderboardSnapshot(ctx context.Context, req *pb.GetLeaderboardSnapshotRequest) (*pb.LeaderboardSnapshotResponse, error) {
+	return s.getLeaderboardSnapshot(ctx, toTime(req.TakenAt), "", "SUCCESS")
+}
+
+func (s *ICOAdminService) FundRewardPool(ctx context.Context, req *pb.FundRewardPoolRequest) (*pb.FundRewardPoolResponse, error) {
+	balance, err := s.referralUc.FundRewardPool(ctx, req.From, req.Amount, req.Symbol, req.SourceId)
+	if err != nil {
+		return &pb.FundRewardPoolResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.FundRewardPoolResponse{Code: 0, Msg: "FUND REWARD POOL SUCCESS", MsgKey: "FUND_REWARD_POOL_SUCCESS", Balance: balance}, nil
+}
+
+func (s *ICOAdminService) GetOutstandingRefunds(ctx context.Context, req *pb.GetOutstandingRefundsRequest) (*pb.GetOutstandingRefundsResponse, error) {
+	refunds, err := s.refundUc.GetOutstandingRefunds(ctx, req.RoundId)
+	if err != nil {
+		return &pb.GetOutstandingRefundsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := make([]*pb.OutstandingRefund, len(refunds))
+	for i, v := range refunds {
+		data[i] = &pb.OutstandingRefund{RoundId: v.RoundId, UserId: v.UserId, SourceId: v.SourceId, NumToken: v.NumToken, CreatedAt: timestamppb.New(v.CreatedAt)}
+	}
+	return &pb.GetOutstandingRefundsResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
+}
+
+func (s *ICOAdminService) getLeaderboardSnapshot(ctx context.Context, takenAt time.Time, msg, msgKey string) (*pb.LeaderboardSnapshotResponse, error) {
+	users, takenAt, err := s.icoUc.GetLeaderboardSnapshot(ctx, takenAt)
+	if err != nil {
+		return &pb.LeaderboardSnapshotResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	if users == nil {
+		return &pb.LeaderboardSnapshotResponse{Code: 1, Msg: constant.ERROR_NOT_FOUND, MsgKey: constant.ERROR_NOT_FOUND}, nil
+	}
+
+	data := make([]*pb.LeaderboardEntry, len(users))
+	for i, v := range users {
+		data[i] = &pb.LeaderboardEntry{Rank: int32(v.Rank), UserId: v.UserId, NumToken: v.NumToken}
+	}
+	return &pb.LeaderboardSnapshotResponse{Code: 0, Msg: msg, MsgKey: msgKey, TakenAt: timestamppb.New(takenAt), Data: data}, nil
+}
+
+func toRoundBiz(req *pb.SaveRoundRequest) *biz.ICORound {
+	return &biz.ICORound{RoundId: req.RoundId, RoundName: req.RoundName, Price: req.Price, NumToken: req.NumToken, NumSub: req.NumSub,
+		PriceGap: req.PriceGap, Lifetime: req.Lifetime, Limits: toLimitsBiz(req.Limits), Pricing: toPricingBiz(req.Pricing), Unsold: toUnsoldBiz(req.Unsold),
+		SoftCap: req.SoftCap, HardCap: req.HardCap}
+}
+
+func toSubRoundBiz(req *pb.SaveSubRoundRequest) *biz.ICOSubRound {
+	return &biz.ICOSubRound{RoundId: req.RoundId, Price: req.Price, TotalToken: req.NumToken, StartAt: toTime(req.StartAt), EndAt: toTime(req.EndAt),
+		Lifetime: req.Lifetime}
+}
+
+func toRoundProto(v *biz.ICORound) *pb.Round {
+	round := &pb.Round{RoundId: v.RoundId, RoundName: v.RoundName, Price: v.Price, NumToken: v.NumToken, NumSub: v.NumSub, PriceGap: v.PriceGap,
+		Lifetime: v.Lifetime, Limits: toLimitsProto(v.Limits), Pricing: &pb.Pricing{Strategy: v.Pricing.Strategy, EndPrice: v.Pricing.EndPrice, Duration: v.Pricing.Duration},
+		Unsold: &pb.Unsold{Policy: v.Unsold.Policy, Wallet: v.Unsold.Wallet}, SoftCap: v.SoftCap, HardCap: v.HardCap, Status: v.State()}
+	if v.EndedAt != nil {
+		round.EndedAt = timestamppb.New(*v.EndedAt)
+	}
+	return round
+}
+
+func toSubRoundProto(v *biz.ICOSubRound) *pb.SubRound {
+	subRound := &pb.SubRound{Id: v.ID.String(), RoundId: v.RoundId, SubRound: v.SubRound, Price: v.Price, NumToken: v.TotalToken,
+		BoughtToken: v.BoughtToken, Lifetime: v.Lifetime, IsClose: v.IsEnded}
+	if !v.StartAt.IsZero() {
+		subRound.StartAt = timestamppb.New(v.StartAt)
+	}
+	if !v.EndAt.IsZero() {
+		subRound.EndAt = timestamppb.New(v.EndAt)
+	}
+	if !v.PausedAt.IsZero() {
+		subRound.PausedAt = timestamppb.New(v.PausedAt)
+	}
+	return subRound
+}
+
+func toPricingBiz(v *pb.Pricing) biz.ICOPricing {
+	return biz.ICOPricing{Strategy: v.GetStrategy(), EndPrice: v.GetEndPrice(), Duration: v.GetDuration()}
+}
+
+func toUnsoldBiz(v *pb.Unsold) biz.ICOUnsold {
+	return biz.ICOUnsold{Policy: v.GetPolicy(), Wallet: v.GetWallet()}
+}
+
+func toLimitsBiz(v *pb.PurchaseLimits) biz.ICOLimits {
+	limits := biz.ICOLimits{Base: toLimitBiz(v.GetBase()), TiersOnly: v.GetTiersOnly()}
+	if len(v.GetTiers()) > 0 {
+		limits.Tiers = make(map[string]biz.ICOLimit, len(v.Tiers))
+		for tier, l := range v.Tiers {
+			limits.Tiers[tier] = toLimitBiz(l)
+		}
+	}
+	return limits
+}
+
+func toLimitBiz(v *pb.PurchaseLimit) biz.ICOLimit {
+	return biz.ICOLimit{MinPurchase: v.GetMinPurchase(), MaxPerTx: v.GetMaxPerTx(), MaxPerUser: v.GetMaxPerUser()}
+}
+
+func toLimitsProto(v biz.ICOLimits) *pb.PurchaseLimits {
+	limits := &pb.PurchaseLimits{Base: toLimitProto(v.Base), TiersOnly: v.TiersOnly}
+	if len(v.Tiers) > 0 {
+		limits.Tiers = make(map[string]*pb.PurchaseLimit, len(v.Tiers))
+		for tier, l := range v.Tiers {
+			limits.Tiers[tier] = toLimitProto(l)
+		}
+	}
+	return limits
+}
+
+func toLimitProto(v biz.ICOLimit) *pb.PurchaseLimit {
+	return &pb.PurchaseLimit{MinPurchase: v.MinPurchase, MaxPerTx: v.MaxPerTx, MaxPerUser: v.MaxPerUser}
+}
+
+// toTime maps an unset timestamp to the zero time.
+func toTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
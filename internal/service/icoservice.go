@@ -3,13 +3,16 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/indikay/go-core/middleware/jwt"
 	pb "github.com/indikay/wallet-service/api/ico/v1"
 	"github.com/indikay/wallet-service/internal/biz"
 	"github.com/indikay/wallet-service/internal/client"
+	"github.com/indikay/wallet-service/internal/constant"
 	"github.com/indikay/wallet-service/internal/util"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -34,7 +37,12 @@ func (s *ICOService) GetICOInfo(ctx context.Context, req *emptypb.Empty) (*pb.Ge
 
 	respData := make([]*pb.ICOInfo, len(data))
 	for i, v := range data {
-		respData[i] = &pb.ICOInfo{RoundId: v.RoundId, RoundName: v.RoundName, Price: v.Price, NumToken: v.NumToken, PriceGap: v.PriceGap}
+		raised, err := s.icoUc.GetRoundRaised(ctx, v.RoundId)
+		if err != nil {
+			return nil, util.InternalServerError(err)
+		}
+		respData[i] = &pb.ICOInfo{RoundId: v.RoundId, RoundName: v.RoundName, Price: v.Price, NumToken: v.NumToken, PriceGap: v.PriceGap,
+			SoftCap: v.SoftCap, HardCap: v.HardCap, Raised: raised, Status: v.State()}
 	}
 
 	return &pb.GetICOInfoResponse{Data: respData}, nil
@@ -45,11 +53,33 @@ func (s *ICOService) GetCurrentRound(ctx context.Context, req *emptypb.Empty) (*
 	if err != nil {
 		return nil, util.InternalServerError(err)
 	}
-	return &pb.GetCurrentRoundResponse{Data: &pb.ICORound{RoundId: data.RoundId, SubRound: data.SubRound, Price: data.Price, BoughtToken: data.BoughtToken, TotalToken: data.TotalToken, EndAt: timestamppb.New(data.EndAt)}}, nil
+	price, err := s.icoUc.GetPrice(ctx, data)
+	if err != nil {
+		return nil, util.InternalServerError(err)
+	}
+	round := &pb.ICORound{RoundId: data.RoundId, SubRound: data.SubRound, Price: price.String(), BoughtToken: data.BoughtToken, TotalToken: data.TotalToken, EndAt: timestamppb.New(data.EndAt)}
+	if !data.PausedAt.IsZero() {
+		round.Paused, round.PausedAt = true, timestamppb.New(data.PausedAt)
+	}
+	return &pb.GetCurrentRoundResponse{Data: round}, nil
+}
+
+func (s *ICOService) PreviewBuyICO(ctx context.Context, req *pb.PreviewBuyICORequest) (*pb.PreviewBuyICOResponse, error) {
+	preview, err := s.icoUc.PreviewICO(ctx, req.Amount, req.Symbol, req.Coupon)
+	if err != nil {
+		return &pb.PreviewBuyICOResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := &pb.ICOPreview{TotalToken: preview.TotalToken, Cashback: preview.Cashback, Rounding: preview.Rounding}
+	for _, v := range preview.Lines {
+		data.Lines = append(data.Lines, &pb.PreviewLine{RoundId: v.RoundId, SubRound: v.SubRound, Price: v.Price, NumToken: v.NumToken, Rate: v.Rate})
+	}
+	return &pb.PreviewBuyICOResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
 }
 
 func (s *ICOService) AddICOCoupon(ctx context.Context, req *pb.AddICOCouponRequest) (*emptypb.Empty, error) {
-	err := s.icoUc.AddICOCoupon(ctx, req.UserId, req.Coupon, req.Reward, req.Cashback)
+	err := s.icoUc.AddICOCoupon(ctx, &biz.IcoCoupon{UserID: req.UserId, Coupon: req.Coupon, Reward: req.Reward, Cashback: req.Cashback,
+		StartsAt: toTime(req.StartsAt), EndsAt: toTime(req.EndsAt), MaxUses: int(req.MaxUses), SingleUse: req.SingleUse, MinPurchase: req.MinPurchase})
 	if err != nil {
 		return nil, util.InternalServerError(err)
 	}
@@ -69,7 +99,70 @@ func (s *ICOService) GetCoupon(ctx context.Context, req *pb.GetCouponRequest) (*
 	}
 	data, _ := json.Marshal(coupon)
 	log.Infof("COUPON RESPONSE: %s", string(data))
-	return &pb.GetCouponResponse{Code: 0, MsgKey: "SUCCESS", Data: &pb.Coupon{UserId: coupon.UserID, Coupon: coupon.Coupon, Reward: coupon.Reward, CashBack: coupon.Cashback}}, nil
+	return &pb.GetCouponResponse{Code: 0, MsgKey: "SUCCESS", Data: toCouponProto(coupon)}, nil
+}
+
+func (s *ICOService) UpdateICOCoupon(ctx context.Context, req *pb.UpdateICOCouponRequest) (*pb.GetCouponResponse, error) {
+	coupon, err := s.icoUc.UpdateICOCoupon(ctx, &biz.IcoCoupon{Coupon: req.Coupon, Reward: req.Reward, Cashback: req.Cashback,
+		StartsAt: toTime(req.StartsAt), EndsAt: toTime(req.EndsAt), MaxUses: int(req.MaxUses), SingleUse: req.SingleUse, MinPurchase: req.MinPurchase})
+	if err != nil {
+		return &pb.GetCouponResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.GetCouponResponse{Code: 0, Msg: "UPDATE COUPON SUCCESS", MsgKey: "UPDATE_COUPON_SUCCESS", Data: toCouponProto(coupon)}, nil
+}
+
+func (s *ICOService) DeleteICOCoupon(ctx context.Context, req *pb.GetCouponRequest) (*pb.GetCouponResponse, error) {
+	coupon, err := s.icoUc.DeleteICOCoupon(ctx, req.Coupon)
+	if err != nil {
+		return &pb.GetCouponResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.GetCouponResponse{Code: 0, Msg: "DELETE COUPON SUCCESS", MsgKey: "DELETE_COUPON_SUCCESS", Data: toCouponProto(coupon)}, nil
+}
+
+func (s *ICOService) RestoreICOCoupon(ctx context.Context, req *pb.GetCouponRequest) (*pb.GetCouponResponse, error) {
+	coupon, err := s.icoUc.RestoreICOCoupon(ctx, req.Coupon)
+	if err != nil {
+		return &pb.GetCouponResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.GetCouponResponse{Code: 0, Msg: "RESTORE COUPON SUCCESS", MsgKey: "RESTORE_COUPON_SUCCESS", Data: toCouponProto(coupon)}, nil
+}
+
+func (s *ICOService) GenerateICOCoupons(ctx context.Context, req *pb.GenerateICOCouponsRequest) (*pb.GenerateICOCouponsResponse, error) {
+	if len(req.Format) > 0 && req.Format != biz.COUPON_EXPORT {
+		return &pb.GenerateICOCouponsResponse{Code: 1, Msg: constant.ERROR_BAD_REQUEST, MsgKey: constant.ERROR_BAD_REQUEST}, nil
+	}
+	batch := &biz.CouponBatch{Prefix: req.Prefix, Length: int(req.Length), Alphabet: req.Alphabet, Count: int(req.Count),
+		Coupon: biz.IcoCoupon{UserID: req.UserId, Reward: req.Reward, Cashback: req.Cashback, StartsAt: toTime(req.StartsAt), EndsAt: toTime(req.EndsAt),
+			MaxUses: int(req.MaxUses), SingleUse: req.SingleUse, MinPurchase: req.MinPurchase}}
+	codes, err := s.icoUc.GenerateICOCoupons(ctx, batch)
+	if err != nil {
+		return &pb.GenerateICOCouponsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error(), Data: &pb.GenerateICOCouponsResponse_Data{Coupons: codes}}, nil
+	}
+
+	data := &pb.GenerateICOCouponsResponse_Data{Coupons: codes}
+	if req.Format == biz.COUPON_EXPORT {
+		content, err := s.icoUc.ExportICOCoupons(batch, codes)
+		if err != nil {
+			s.logger.Error("GenerateICOCoupons ", err)
+			return &pb.GenerateICOCouponsResponse{Code: 1, Msg: constant.ERROR_INTERNAL, MsgKey: constant.ERROR_INTERNAL, Data: data}, nil
+		}
+		data.FileName = fmt.Sprintf("coupons-%s.%s", time.Now().UTC().Format("20060102150405"), biz.COUPON_EXPORT)
+		data.ContentType = "text/csv"
+		data.Content = content
+	}
+	return &pb.GenerateICOCouponsResponse{Code: 0, Msg: "GENERATE COUPON SUCCESS", MsgKey: "GENERATE_COUPON_SUCCESS", Data: data}, nil
+}
+
+func (s *ICOService) ListICOCoupons(ctx context.Context, req *pb.ListICOCouponsRequest) (*pb.ListICOCouponsResponse, error) {
+	coupons, err := s.icoUc.ListICOCoupons(ctx, req.UserId, req.WithDeleted)
+	if err != nil {
+		return &pb.ListICOCouponsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	data := make([]*pb.Coupon, len(coupons))
+	for i, v := range coupons {
+		data[i] = toCouponProto(v)
+	}
+	return &pb.ListICOCouponsResponse{Code: 0, Msg: "LIST COUPON SUCCESS", MsgKey: "LIST_COUPON_SUCCESS", Data: data}, nil
 }
 
 func (s *ICOService) GetBuyICOUserHistory(ctx context.Context, req *pb.GetBuyICOUserHistoryRequest) (*pb.GetBuyICOUserHistoryResponse, error) {
@@ -85,6 +178,15 @@ func (s *ICOService) GetBuyICOUserHistory(ctx context.Context, req *pb.GetBuyICO
 	for _, v := range userBought {
 		userId = append(userId, v.UserId)
 	}
+	var me *biz.ICOUserBought
+	if len(userLogged) > 0 {
+		if me, err = s.icoUc.GetICOUserRank(ctx, userLogged); err != nil {
+			log.Error("GetBuyICOUserHistory ", err)
+		}
+		if me != nil {
+			userId = append(userId, userLogged)
+		}
+	}
 
 	profiles, err := s.profileClient.GetProfileByUsers(ctx, userId)
 	if err != nil {
@@ -103,6 +205,24 @@ func (s *ICOService) GetBuyICOUserHistory(ctx context.Context, req *pb.GetBuyICO
 			resp.Next = strconv.Itoa(v.Rank)
 		}
 	}
+	if me != nil {
+		resp.Me = &pb.GetBuyICOUserHistoryResponse_Data{NumToken: me.NumToken, Order: int32(me.Rank), Email: profiles[userLogged].Email, FullName: profiles[userLogged].FullName, Stack: "0"}
+	}
 
 	return resp, nil
 }
+
+func toCouponProto(v *biz.IcoCoupon) *pb.Coupon {
+	coupon := &pb.Coupon{UserId: v.UserID, Coupon: v.Coupon, Reward: v.Reward, CashBack: v.Cashback, MaxUses: int32(v.MaxUses), Used: int32(v.Used),
+		SingleUse: v.SingleUse, MinPurchase: v.MinPurchase}
+	if !v.StartsAt.IsZero() {
+		coupon.StartsAt = timestamppb.New(v.StartsAt)
+	}
+	if !v.EndsAt.IsZero() {
+		coupon.EndsAt = timestamppb.New(v.EndsAt)
+	}
+	if v.DeletedAt != nil {
+		coupon.DeletedAt = timestamppb.New(*v.DeletedAt)
+	}
+	return coupon
+}
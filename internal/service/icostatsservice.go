@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/indikay/wallet-service/api/ico/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ICOStatsService struct {
+	pb.UnimplementedICOStatsServiceServer
+	uc *biz.ICOStatsUsecase
+}
+
+func NewICOStatsService(uc *biz.ICOStatsUsecase) *ICOStatsService {
+	return &ICOStatsService{uc: uc}
+}
+
+func (s *ICOStatsService) GetICOStats(ctx context.Context, req *pb.GetICOStatsRequest) (*pb.GetICOStatsResponse, error) {
+	stats, err := s.uc.GetStats(ctx, toTime(req.From), toTime(req.To))
+	if err != nil {
+		return &pb.GetICOStatsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := &pb.ICOStats{Total: toVolumeProto(stats.Total), Coupon: toVolumeProto(stats.Coupon), AverageTicket: stats.AverageTicket,
+		Rounds: make([]*pb.ICORoundStats, len(stats.Rounds)), Currencies: make([]*pb.ICOCurrencyStats, len(stats.Currencies))}
+	for i, v := range stats.Rounds {
+		data.Rounds[i] = &pb.ICORoundStats{RoundId: v.RoundId, SubRound: v.SubRound, Volume: toVolumeProto(v.ICOVolume)}
+	}
+	for i, v := range stats.Currencies {
+		data.Currencies[i] = &pb.ICOCurrencyStats{Symbol: v.Symbol, Amount: v.Amount, Volume: toVolumeProto(v.ICOVolume)}
+	}
+	return &pb.GetICOStatsResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
+}
+
+func (s *ICOStatsService) GetICODailyStats(ctx context.Context, req *pb.GetICODailyStatsRequest) (*pb.GetICODailyStatsResponse, error) {
+	series, err := s.uc.GetDailyStats(ctx, toTime(req.From), toTime(req.To))
+	if err != nil {
+		return &pb.GetICODailyStatsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.GetICODailyStatsResponse{Code: 0, MsgKey: "SUCCESS", Data: toDailyStatsProto(series)}, nil
+}
+
+func (s *ICOStatsService) RollupICOStats(ctx context.Context, req *pb.RollupICOStatsRequest) (*pb.GetICODailyStatsResponse, error) {
+	rollups, err := s.uc.RollupDays(ctx, toTime(req.From), toTime(req.To))
+	if err != nil {
+		return &pb.GetICODailyStatsResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.GetICODailyStatsResponse{Code: 0, Msg: "ROLLUP ICO STATS SUCCESS", MsgKey: "ROLLUP_ICO_STATS_SUCCESS", Data: toDailyStatsProto(rollups)}, nil
+}
+
+func toVolumeProto(v biz.ICOVolume) *pb.ICOVolume {
+	return &pb.ICOVolume{Purchases: int32(v.Purchases), Buyers: int32(v.Buyers), NumToken: v.NumToken, Raised: v.Raised}
+}
+
+func toDailyStatsProto(series []*biz.ICODailyStats) []*pb.ICODailyStats {
+	data := make([]*pb.ICODailyStats, len(series))
+	for i, v := range series {
+		data[i] = &pb.ICODailyStats{Day: timestamppb.New(v.Day), Total: toVolumeProto(v.Total), Coupon: toVolumeProto(v.Coupon)}
+	}
+	return data
+}
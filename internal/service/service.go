@@ -2,4 +2,4 @@ package service
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewTransactionService, NewUserWalletService, NewICOService)
+var ProviderSet = wire.NewSet(NewTransactionService, NewUserWalletService, NewICOService, NewICOAdminService, NewICOStatsService, NewWebhookService, NewAlertService, NewAuditService)
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/indikay/go-core/middleware/jwt"
 	pb "github.com/indikay/wallet-service/api/wallet/v1"
@@ -9,13 +10,18 @@ import (
 	"github.com/indikay/wallet-service/internal/util"
 )
 
+// internal RPCs act on the user in the request, never on the caller's token.
+var errUserIdRequired = errors.New("user_id is required")
+
 type TransactionService struct {
 	pb.UnimplementedTransactionServiceServer
-	transUC *biz.WalletTransactionUseCase
+	transUC    *biz.WalletTransactionUseCase
+	referralUc *biz.ReferralUsecase
+	refundUc   *biz.ICORefundUsecase
 }
 
-func NewTransactionService(transUC *biz.WalletTransactionUseCase) *TransactionService {
-	return &TransactionService{transUC: transUC}
+func NewTransactionService(transUC *biz.WalletTransactionUseCase, referralUc *biz.ReferralUsecase, refundUc *biz.ICORefundUsecase) *TransactionService {
+	return &TransactionService{transUC: transUC, referralUc: referralUc, refundUc: refundUc}
 }
 
 func (s *TransactionService) CalcChargeFee(ctx context.Context, req *pb.CalcChargeFeeRequest) (*pb.CalcChargeFeeResponse, error) {
@@ -26,9 +32,9 @@ func (s *TransactionService) CalcChargeFee(ctx context.Context, req *pb.CalcChar
 	return s.transUC.CalcChargeFee(ctx, userId, req)
 }
 func (s *TransactionService) ChargeFee(ctx context.Context, req *pb.ChargeFeeRequest) (*pb.ChargeFeeResponse, error) {
-	userId, _ := jwt.GetUserId(ctx)
+	userId := req.UserId
 	if len(userId) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 	amount, err := s.transUC.ChargeFee(ctx, userId, req.Amount, req.Symbol.String(), req.SourceId, req.TypeFee.String())
 	if err != nil {
@@ -38,9 +44,9 @@ func (s *TransactionService) ChargeFee(ctx context.Context, req *pb.ChargeFeeReq
 	return &pb.ChargeFeeResponse{Code: 0, MsgKey: "CHARGE_FEE_SUCCESS", Msg: "CHARGE FEE SUCCESS", Fee: amount}, nil
 }
 func (s *TransactionService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositResponse, error) {
-	userId, _ := jwt.GetUserId(ctx)
+	userId := req.UserId
 	if len(userId) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 
 	err := s.transUC.DepositICO(ctx, userId, req.Amount, req.Symbol.String(), req.SourceId, req.IcoType)
@@ -51,22 +57,27 @@ func (s *TransactionService) Deposit(ctx context.Context, req *pb.DepositRequest
 }
 
 func (s *TransactionService) BuyICO(ctx context.Context, req *pb.BuyICORequest) (*pb.BuyICOResponse, error) {
-	userId, _ := jwt.GetUserId(ctx)
+	userId := req.UserId
 	if len(userId) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 
 	err := s.transUC.BuyICO(ctx, userId, req.Amount, req.Symbol.String(), req.SourceId, req.Coupon)
 	if err != nil {
-		return &pb.BuyICOResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+		resp := &pb.BuyICOResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}
+		var limitErr *biz.ICOLimitError
+		if errors.As(err, &limitErr) {
+			resp.Limit = &pb.BuyICOResponse_Limit{Reason: limitErr.Reason, Tier: limitErr.Tier, Limit: limitErr.Limit, Remaining: limitErr.Remaining}
+		}
+		return resp, nil
 	}
 
 	return &pb.BuyICOResponse{Code: 0, Msg: "SUCCESS", MsgKey: "BUY ICO SUCCESS"}, nil
 }
 func (s *TransactionService) Subscription(ctx context.Context, req *pb.SubsciptionRequest) (*pb.SubsciptionResponse, error) {
-	userId, _ := jwt.GetUserId(ctx)
+	userId := req.UserId
 	if len(userId) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 
 	err := s.transUC.Subscription(ctx, userId, req.Amount, req.Symbol.String(), req.SourceId)
@@ -77,9 +88,9 @@ func (s *TransactionService) Subscription(ctx context.Context, req *pb.Subscipti
 }
 
 func (s *TransactionService) ReferralReward(ctx context.Context, req *pb.ReferralRewardRequest) (*pb.ReferralRewardResponse, error) {
-	userId, _ := jwt.GetUserId(ctx)
+	userId := req.UserId
 	if len(userId) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 
 	err := s.transUC.ReferralReward(ctx, userId, req.Amount, req.Symbol.String(), req.SourceId)
@@ -89,19 +100,34 @@ func (s *TransactionService) ReferralReward(ctx context.Context, req *pb.Referra
 	return &pb.ReferralRewardResponse{Code: 0, Msg: "", MsgKey: "REFERRAL_REWARD_SUCCESS"}, nil
 }
 
-func (s *TransactionService) MarketingRewardInternal(ctx context.Context, req *pb.MarketingRewardRequest) (*pb.MarketingRewardResponse, error) {
-	userID, err := jwt.GetUserId(ctx)
+func (s *TransactionService) SetReferrer(ctx context.Context, req *pb.SetReferrerRequest) (*pb.SetReferrerResponse, error) {
+	if len(req.UserId) == 0 {
+		return nil, util.BadRequestError(errUserIdRequired)
+	}
+
+	if err := s.referralUc.SetReferrer(ctx, req.UserId, req.ReferrerId); err != nil {
+		return &pb.SetReferrerResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.SetReferrerResponse{Code: 0, Msg: "", MsgKey: "SET_REFERRER_SUCCESS"}, nil
+}
+
+func (s *TransactionService) RefundICOPurchase(ctx context.Context, req *pb.RefundICOPurchaseRequest) (*pb.RefundICOPurchaseResponse, error) {
+	if len(req.UserId) == 0 {
+		return nil, util.BadRequestError(errUserIdRequired)
+	}
+
+	refund, err := s.refundUc.RefundICOPurchase(ctx, req.UserId, req.SourceId)
 	if err != nil {
-		return &pb.MarketingRewardResponse{
-			Code:   1,
-			Msg:    err.Error(),
-			MsgKey: err.Error(),
-			Data:   nil,
-		}, nil
+		return &pb.RefundICOPurchaseResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
 	}
+	return &pb.RefundICOPurchaseResponse{Code: 0, Msg: "REFUND ICO SUCCESS", MsgKey: "REFUND_ICO_SUCCESS", Data: &pb.RefundICOPurchaseResponse_Data{
+		SourceId: refund.SourceId, NumToken: refund.NumToken, Amount: refund.Amount, Symbol: refund.Symbol}}, nil
+}
 
+func (s *TransactionService) MarketingRewardInternal(ctx context.Context, req *pb.MarketingRewardRequest) (*pb.MarketingRewardResponse, error) {
+	userID := req.UserId
 	if len(userID) == 0 {
-		return nil, util.UnAuthorizeError()
+		return nil, util.BadRequestError(errUserIdRequired)
 	}
 
 	transactionID, err := s.transUC.MarketingRewardInternal(ctx, userID, req.Amount, req.Symbol.String(), req.SourceId)
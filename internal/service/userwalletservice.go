@@ -12,12 +12,13 @@ import (
 )
 
 type UserWalletService struct {
-	walletUC *biz.WalletTransactionUseCase
+	walletUC   *biz.WalletTransactionUseCase
+	referralUc *biz.ReferralUsecase
 	pb.UnimplementedUserWalletServiceServer
 }
 
-func NewUserWalletService(walletUC *biz.WalletTransactionUseCase) *UserWalletService {
-	return &UserWalletService{walletUC: walletUC}
+func NewUserWalletService(walletUC *biz.WalletTransactionUseCase, referralUc *biz.ReferralUsecase) *UserWalletService {
+	return &UserWalletService{walletUC: walletUC, referralUc: referralUc}
 }
 
 func (s *UserWalletService) GetWalletByUserId(ctx context.Context, req *emptypb.Empty) (*pb.UserWalletResponse, error) {
@@ -61,6 +62,60 @@ func (s *UserWalletService) GetWalletHistories(ctx context.Context, req *pb.GetW
 	return &pb.GetWalletHistoryResponse{Data: &pb.GetWalletHistoryResponse_Data{Histories: data, Next: next}}, nil
 }
 
+func (s *UserWalletService) GetMyICOPurchases(ctx context.Context, req *pb.GetMyICOPurchasesRequest) (*pb.GetMyICOPurchasesResponse, error) {
+	userId, _ := jwt.GetUserId(ctx)
+	if len(userId) == 0 {
+		return nil, util.UnAuthorizeError()
+	}
+
+	purchases, summary, next, err := s.walletUC.GetMyICOPurchases(ctx, userId, req.Next, req.Limit)
+	if err != nil {
+		return nil, util.InternalServerError(err)
+	}
+
+	data := make([]*pb.ICOPurchase, len(purchases))
+	for i, v := range purchases {
+		h := v.History
+		data[i] = &pb.ICOPurchase{Id: h.ID.String(), RoundId: h.RoundId, SubRound: h.SubRound, Price: h.Price, NumToken: h.NumToken, Type: h.Type,
+			CreatedAt: int32(h.CreatedAt.Unix()), Symbol: h.Symbol, Amount: h.Amount, SourceId: h.SourceId,
+			Transaction: toPurchaseTransaction(v.Transaction), Commission: toPurchaseTransaction(v.Commission), Cashback: toPurchaseTransaction(v.Cashback)}
+	}
+
+	return &pb.GetMyICOPurchasesResponse{Data: &pb.GetMyICOPurchasesResponse_Data{Purchases: data, Next: next,
+		Summary: &pb.GetMyICOPurchasesResponse_Summary{TotalToken: summary.TotalToken, AveragePrice: summary.AveragePrice, Spend: summary.Spend}}}, nil
+}
+
+func (s *UserWalletService) GetMyReferralEarnings(ctx context.Context, req *pb.GetMyReferralEarningsRequest) (*pb.GetMyReferralEarningsResponse, error) {
+	userId, _ := jwt.GetUserId(ctx)
+	if len(userId) == 0 {
+		return nil, util.UnAuthorizeError()
+	}
+
+	earnings, next, err := s.referralUc.GetEarnings(ctx, userId, req.Next, req.Limit)
+	if err != nil {
+		return nil, util.InternalServerError(err)
+	}
+
+	data := &pb.GetMyReferralEarningsResponse_Data{Referrals: int32(earnings.Referrals), Next: next}
+	for _, v := range earnings.Earnings {
+		data.Earnings = append(data.Earnings, &pb.GetMyReferralEarningsResponse_Earning{Level: int32(v.Level), Symbol: v.Symbol, Amount: v.Amount, Commissions: int32(v.Commissions)})
+	}
+	for _, v := range earnings.Commissions {
+		data.Commissions = append(data.Commissions, &pb.ReferralCommission{Id: v.ID.String(), Level: int32(v.Level), Coupon: v.Coupon, Symbol: v.Symbol, Amount: v.Amount,
+			SourceId: v.SourceID, CreatedAt: int32(v.CreatedAt.Unix())})
+	}
+	return &pb.GetMyReferralEarningsResponse{Data: data}, nil
+}
+
+// toPurchaseTransaction leaves out the user, the commission goes to the coupon owner.
+func toPurchaseTransaction(v *biz.Transaction) *pb.Transaction {
+	if v == nil {
+		return nil
+	}
+	return &pb.Transaction{Id: v.ID.String(), Type: v.TransType, Symbol: pb.SymbolType(pb.SymbolType_value[v.DestSymbol]), Amount: v.DestAmount,
+		CreatedAt: int32(v.CreatedAt.Unix()), Status: v.Status, TransInOut: "IN"}
+}
+
 func (c *UserWalletService) GetCurrentRateBySymbol(ctx context.Context, req *pb.CurrentRateRequest) (*pb.CurrentRate, error) {
 	if req == nil {
 		return &pb.CurrentRate{Code: 1, Msg: "input for get current rate by symbol invalid", MsgKey: "FAILED"}, util.BadRequestError(errors.New("input request invalid"))
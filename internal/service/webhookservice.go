@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	pb "github.com/indikay/wallet-service/api/webhook/v1"
+	"github.com/indikay/wallet-service/internal/biz"
+	"github.com/indikay/wallet-service/internal/middleware"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookService struct {
+	pb.UnimplementedWebhookServiceServer
+	webhookUc *biz.WebhookUsecase
+}
+
+func NewWebhookService(webhookUc *biz.WebhookUsecase) *WebhookService {
+	return &WebhookService{webhookUc: webhookUc}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	admin := slices.Contains(middleware.RolesFromContext(ctx), middleware.ROLE_ADMIN)
+	webhook, err := s.webhookUc.CreateWebhook(ctx, &biz.Webhook{Owner: req.Owner, URL: req.Url, Secret: req.Secret, TransTypes: req.TransTypes, UserIDs: req.UserIds}, admin)
+	if err != nil {
+		return &pb.CreateWebhookResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := toWebhookProto(webhook)
+	data.Secret = webhook.Secret
+	return &pb.CreateWebhookResponse{Code: 0, Msg: "CREATE WEBHOOK SUCCESS", MsgKey: "CREATE_WEBHOOK_SUCCESS", Data: data}, nil
+}
+
+func (s *WebhookService) GetWebhooks(ctx context.Context, req *pb.GetWebhooksRequest) (*pb.GetWebhooksResponse, error) {
+	webhooks, err := s.webhookUc.GetWebhooks(ctx, req.Owner)
+	if err != nil {
+		return &pb.GetWebhooksResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := make([]*pb.Webhook, len(webhooks))
+	for i, v := range webhooks {
+		data[i] = toWebhookProto(v)
+	}
+	return &pb.GetWebhooksResponse{Code: 0, MsgKey: "SUCCESS", Data: data}, nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if err := s.webhookUc.DeleteWebhook(ctx, req.Owner, req.Id); err != nil {
+		return &pb.DeleteWebhookResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.DeleteWebhookResponse{Code: 0, Msg: "DELETE WEBHOOK SUCCESS", MsgKey: "DELETE_WEBHOOK_SUCCESS"}, nil
+}
+
+func (s *WebhookService) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	deliveries, next, err := s.webhookUc.GetDeliveries(ctx, req.Owner, req.WebhookId, req.Next, req.Limit)
+	if err != nil {
+		return &pb.GetWebhookDeliveriesResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+
+	data := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, v := range deliveries {
+		data[i] = toWebhookDeliveryProto(v)
+	}
+	return &pb.GetWebhookDeliveriesResponse{Code: 0, MsgKey: "SUCCESS", Data: data, Next: next}, nil
+}
+
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	delivery, err := s.webhookUc.Redeliver(ctx, req.Owner, req.Id)
+	if err != nil {
+		return &pb.RedeliverWebhookResponse{Code: 1, Msg: err.Error(), MsgKey: err.Error()}, nil
+	}
+	return &pb.RedeliverWebhookResponse{Code: 0, Msg: "REDELIVER WEBHOOK SUCCESS", MsgKey: "REDELIVER_WEBHOOK_SUCCESS", Data: toWebhookDeliveryProto(delivery)}, nil
+}
+
+func toWebhookProto(v *biz.Webhook) *pb.Webhook {
+	return &pb.Webhook{Id: v.ID.String(), Owner: v.Owner, Url: v.URL, TransTypes: v.TransTypes, UserIds: v.UserIDs, CreatedAt: timestamppb.New(v.CreatedAt)}
+}
+
+func toWebhookDeliveryProto(v *biz.WebhookDelivery) *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{Id: v.ID.String(), WebhookId: v.WebhookID, EventId: v.EventID, EventType: v.EventType, Status: v.Status,
+		Attempts: v.Attempts, ResponseCode: v.ResponseCode, LastError: v.LastError, CreatedAt: timestamppb.New(v.CreatedAt)}
+	if v.DeliveredAt != nil {
+		delivery.DeliveredAt = timestamppb.New(*v.DeliveredAt)
+	}
+	return delivery
+}
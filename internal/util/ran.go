@@ -1,7 +1,7 @@
 package util
 
 import (
-	"math/rand"
+	"crypto/rand"
 )
 
 const (
@@ -9,15 +9,26 @@ const (
 	codeSize   = 12
 )
 
-func generateRandomString(dictionary string, length int) string {
-	result := make([]byte, length)
-	for i := 0; i < length; i++ {
-		result[i] = dictionary[rand.Intn(len(dictionary))]
+// RandomString returns length characters of dictionary, at most 256, drawn
+// from crypto/rand. Bytes past the last multiple of len(dictionary) are
+// dropped so each character is as likely.
+func RandomString(dictionary string, length int) string {
+	result := make([]byte, 0, length)
+	limit := 256 - 256%len(dictionary)
+	buf := make([]byte, length)
+	for len(result) < length {
+		// never fails, see crypto/rand.Read
+		rand.Read(buf)
+		for _, b := range buf {
+			if int(b) < limit && len(result) < length {
+				result = append(result, dictionary[int(b)%len(dictionary)])
+			}
+		}
 	}
 
 	return string(result)
 }
 
 func GenTransactionCode() string {
-	return generateRandomString(dictionary, codeSize)
+	return RandomString(dictionary, codeSize)
 }
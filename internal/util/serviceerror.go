@@ -13,3 +13,7 @@ func UnAuthorizeError() *errors.Error {
 func InternalServerError(err error) *errors.Error {
 	return errors.InternalServer(err.Error(), "")
 }
+
+func ForbiddenError() *errors.Error {
+	return errors.Forbidden("", "")
+}